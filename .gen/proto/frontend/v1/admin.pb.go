@@ -0,0 +1,1621 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/frontend/v1/admin.proto
+
+package frontendv1
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetDomainReplicationStatusRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDomainReplicationStatusRequest) Reset()         { *m = GetDomainReplicationStatusRequest{} }
+func (m *GetDomainReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDomainReplicationStatusRequest) ProtoMessage()    {}
+func (*GetDomainReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{0}
+}
+func (m *GetDomainReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDomainReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDomainReplicationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetDomainReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDomainReplicationStatusRequest.Merge(m, src)
+}
+func (m *GetDomainReplicationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDomainReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDomainReplicationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDomainReplicationStatusRequest proto.InternalMessageInfo
+
+func (m *GetDomainReplicationStatusRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type GetDomainReplicationStatusResponse struct {
+	Domain   string                      `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Clusters []*ReplicationClusterStatus `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// Shards whose status could not be read, they are not part of the cluster statuses.
+	FailedShards         []int32  `protobuf:"varint,3,rep,packed,name=failed_shards,json=failedShards,proto3" json:"failed_shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDomainReplicationStatusResponse) Reset()         { *m = GetDomainReplicationStatusResponse{} }
+func (m *GetDomainReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDomainReplicationStatusResponse) ProtoMessage()    {}
+func (*GetDomainReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{1}
+}
+func (m *GetDomainReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDomainReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDomainReplicationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetDomainReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDomainReplicationStatusResponse.Merge(m, src)
+}
+func (m *GetDomainReplicationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDomainReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDomainReplicationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDomainReplicationStatusResponse proto.InternalMessageInfo
+
+func (m *GetDomainReplicationStatusResponse) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *GetDomainReplicationStatusResponse) GetClusters() []*ReplicationClusterStatus {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func (m *GetDomainReplicationStatusResponse) GetFailedShards() []int32 {
+	if m != nil {
+		return m.FailedShards
+	}
+	return nil
+}
+
+// ReplicationClusterStatus is the replication status of a domain to a remote cluster.
+type ReplicationClusterStatus struct {
+	ClusterName          string           `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	LastReplicatedTaskId int64            `protobuf:"varint,2,opt,name=last_replicated_task_id,json=lastReplicatedTaskId,proto3" json:"last_replicated_task_id,omitempty"`
+	LastReplicatedTime   *types.Timestamp `protobuf:"bytes,3,opt,name=last_replicated_time,json=lastReplicatedTime,proto3" json:"last_replicated_time,omitempty"`
+	PendingTaskCount     int64            `protobuf:"varint,4,opt,name=pending_task_count,json=pendingTaskCount,proto3" json:"pending_task_count,omitempty"`
+	HasMorePendingTasks  bool             `protobuf:"varint,5,opt,name=has_more_pending_tasks,json=hasMorePendingTasks,proto3" json:"has_more_pending_tasks,omitempty"`
+	DlqMessageCount      int64            `protobuf:"varint,6,opt,name=dlq_message_count,json=dlqMessageCount,proto3" json:"dlq_message_count,omitempty"`
+	EstimatedLag         *types.Duration  `protobuf:"bytes,7,opt,name=estimated_lag,json=estimatedLag,proto3" json:"estimated_lag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplicationClusterStatus) Reset()         { *m = ReplicationClusterStatus{} }
+func (m *ReplicationClusterStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationClusterStatus) ProtoMessage()    {}
+func (*ReplicationClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{2}
+}
+func (m *ReplicationClusterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationClusterStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationClusterStatus.Merge(m, src)
+}
+func (m *ReplicationClusterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationClusterStatus proto.InternalMessageInfo
+
+func (m *ReplicationClusterStatus) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *ReplicationClusterStatus) GetLastReplicatedTaskId() int64 {
+	if m != nil {
+		return m.LastReplicatedTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationClusterStatus) GetLastReplicatedTime() *types.Timestamp {
+	if m != nil {
+		return m.LastReplicatedTime
+	}
+	return nil
+}
+
+func (m *ReplicationClusterStatus) GetPendingTaskCount() int64 {
+	if m != nil {
+		return m.PendingTaskCount
+	}
+	return 0
+}
+
+func (m *ReplicationClusterStatus) GetHasMorePendingTasks() bool {
+	if m != nil {
+		return m.HasMorePendingTasks
+	}
+	return false
+}
+
+func (m *ReplicationClusterStatus) GetDlqMessageCount() int64 {
+	if m != nil {
+		return m.DlqMessageCount
+	}
+	return 0
+}
+
+func (m *ReplicationClusterStatus) GetEstimatedLag() *types.Duration {
+	if m != nil {
+		return m.EstimatedLag
+	}
+	return nil
+}
+
+type UpdateDomainIsolationRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Isolated             bool     `protobuf:"varint,2,opt,name=isolated,proto3" json:"isolated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateDomainIsolationRequest) Reset()         { *m = UpdateDomainIsolationRequest{} }
+func (m *UpdateDomainIsolationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDomainIsolationRequest) ProtoMessage()    {}
+func (*UpdateDomainIsolationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{3}
+}
+func (m *UpdateDomainIsolationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDomainIsolationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDomainIsolationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateDomainIsolationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDomainIsolationRequest.Merge(m, src)
+}
+func (m *UpdateDomainIsolationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDomainIsolationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDomainIsolationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDomainIsolationRequest proto.InternalMessageInfo
+
+func (m *UpdateDomainIsolationRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *UpdateDomainIsolationRequest) GetIsolated() bool {
+	if m != nil {
+		return m.Isolated
+	}
+	return false
+}
+
+type UpdateDomainIsolationResponse struct {
+	// Shards which failed to apply the change, the request can be retried for them.
+	FailedShards         []int32  `protobuf:"varint,1,rep,packed,name=failed_shards,json=failedShards,proto3" json:"failed_shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateDomainIsolationResponse) Reset()         { *m = UpdateDomainIsolationResponse{} }
+func (m *UpdateDomainIsolationResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDomainIsolationResponse) ProtoMessage()    {}
+func (*UpdateDomainIsolationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{4}
+}
+func (m *UpdateDomainIsolationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDomainIsolationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDomainIsolationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateDomainIsolationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDomainIsolationResponse.Merge(m, src)
+}
+func (m *UpdateDomainIsolationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDomainIsolationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDomainIsolationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDomainIsolationResponse proto.InternalMessageInfo
+
+func (m *UpdateDomainIsolationResponse) GetFailedShards() []int32 {
+	if m != nil {
+		return m.FailedShards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetDomainReplicationStatusRequest)(nil), "uber.cadence.frontend.v1.GetDomainReplicationStatusRequest")
+	proto.RegisterType((*GetDomainReplicationStatusResponse)(nil), "uber.cadence.frontend.v1.GetDomainReplicationStatusResponse")
+	proto.RegisterType((*ReplicationClusterStatus)(nil), "uber.cadence.frontend.v1.ReplicationClusterStatus")
+	proto.RegisterType((*UpdateDomainIsolationRequest)(nil), "uber.cadence.frontend.v1.UpdateDomainIsolationRequest")
+	proto.RegisterType((*UpdateDomainIsolationResponse)(nil), "uber.cadence.frontend.v1.UpdateDomainIsolationResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/frontend/v1/admin.proto", fileDescriptor_33be5c6332dbd43a)
+}
+
+var fileDescriptor_33be5c6332dbd43a = []byte{
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xdf, 0x4e, 0xd4, 0x4c,
+	0x14, 0xcf, 0xb0, 0x1f, 0x7c, 0xeb, 0x00, 0x11, 0x47, 0xc4, 0xda, 0xe8, 0x5a, 0xaa, 0x17, 0x8d,
+	0x31, 0x6d, 0x58, 0xa2, 0xc6, 0x60, 0x4c, 0x10, 0x22, 0x21, 0x01, 0x42, 0x0a, 0xde, 0x78, 0xd3,
+	0xcc, 0x76, 0x0e, 0xdd, 0x09, 0x9d, 0x4e, 0xe9, 0x4c, 0x79, 0x06, 0x6f, 0xbd, 0xf2, 0x39, 0xbc,
+	0xf2, 0x15, 0xbc, 0xf4, 0x11, 0x0c, 0x4f, 0x62, 0xb6, 0x33, 0xbb, 0x22, 0x6b, 0x31, 0x7a, 0xd7,
+	0x9e, 0xf3, 0xfb, 0x33, 0x67, 0xe6, 0x37, 0x83, 0x1f, 0xd7, 0x03, 0xa8, 0xa2, 0x94, 0x32, 0x28,
+	0x52, 0x88, 0x4e, 0x2a, 0x59, 0x68, 0x28, 0x58, 0x74, 0xbe, 0x16, 0x51, 0x26, 0x78, 0x11, 0x96,
+	0x95, 0xd4, 0x92, 0x38, 0x23, 0x54, 0x68, 0x51, 0xe1, 0x18, 0x15, 0x9e, 0xaf, 0xb9, 0xbd, 0x4c,
+	0xca, 0x2c, 0x87, 0xa8, 0xc1, 0x0d, 0xea, 0x93, 0x88, 0xd5, 0x15, 0xd5, 0x5c, 0x5a, 0xa6, 0xfb,
+	0xf0, 0x6a, 0x5f, 0x73, 0x01, 0x4a, 0x53, 0x51, 0x1a, 0x80, 0xbf, 0x81, 0x57, 0x77, 0x40, 0x6f,
+	0x4b, 0x41, 0x79, 0x11, 0x43, 0x99, 0xf3, 0xb4, 0xa1, 0x1f, 0x69, 0xaa, 0x6b, 0x15, 0xc3, 0x59,
+	0x0d, 0x4a, 0x93, 0x15, 0x3c, 0xc7, 0x1a, 0x84, 0x83, 0x3c, 0x14, 0xdc, 0x88, 0xed, 0x9f, 0xff,
+	0x19, 0x61, 0xff, 0x3a, 0xb6, 0x2a, 0x65, 0xa1, 0xa0, 0x8d, 0x4e, 0x0e, 0x70, 0x37, 0xcd, 0x6b,
+	0xa5, 0xa1, 0x52, 0xce, 0x8c, 0xd7, 0x09, 0xe6, 0xfb, 0xfd, 0xb0, 0x6d, 0xd2, 0xf0, 0x92, 0xfc,
+	0x96, 0x21, 0x59, 0x97, 0x89, 0x06, 0x79, 0x84, 0x17, 0x4f, 0x28, 0xcf, 0x81, 0x25, 0x6a, 0x48,
+	0x2b, 0xa6, 0x9c, 0x8e, 0xd7, 0x09, 0x66, 0xe3, 0x05, 0x53, 0x3c, 0x6a, 0x6a, 0xfe, 0xc7, 0x0e,
+	0x76, 0xda, 0xb4, 0xc8, 0x2a, 0x5e, 0xb0, 0x6a, 0x49, 0x41, 0x05, 0xd8, 0xf5, 0xce, 0xdb, 0xda,
+	0x01, 0x15, 0x40, 0x9e, 0xe1, 0xbb, 0x39, 0x55, 0x3a, 0xa9, 0xac, 0x06, 0xb0, 0x44, 0x53, 0x75,
+	0x9a, 0x70, 0xe6, 0xcc, 0x78, 0x28, 0xe8, 0xc4, 0xcb, 0xa3, 0x76, 0x3c, 0xe9, 0x1e, 0x53, 0x75,
+	0xba, 0xcb, 0xc8, 0x1e, 0x5e, 0x9e, 0xa2, 0x71, 0x01, 0x4e, 0xc7, 0x43, 0xc1, 0x7c, 0xdf, 0x0d,
+	0xcd, 0x39, 0x85, 0xe3, 0x73, 0x0a, 0x8f, 0xc7, 0xe7, 0x14, 0x93, 0x2b, 0x7a, 0x5c, 0x00, 0x79,
+	0x8a, 0x49, 0x09, 0x05, 0xe3, 0x45, 0x66, 0xcc, 0x53, 0x59, 0x17, 0xda, 0xf9, 0xaf, 0xf1, 0x5f,
+	0xb2, 0x9d, 0x91, 0xf1, 0xd6, 0xa8, 0x4e, 0xd6, 0xf1, 0xca, 0x90, 0xaa, 0x44, 0xc8, 0x0a, 0x92,
+	0xcb, 0x34, 0xe5, 0xcc, 0x7a, 0x28, 0xe8, 0xc6, 0xb7, 0x87, 0x54, 0xed, 0xcb, 0x0a, 0x0e, 0x7f,
+	0x12, 0x15, 0x79, 0x82, 0x6f, 0xb1, 0xfc, 0x2c, 0x11, 0xa0, 0x14, 0xcd, 0xc0, 0x3a, 0xcc, 0x35,
+	0x0e, 0x37, 0x59, 0x7e, 0xb6, 0x6f, 0xea, 0xc6, 0xe0, 0x35, 0x5e, 0x04, 0xa5, 0xb9, 0x68, 0xc6,
+	0xca, 0x69, 0xe6, 0xfc, 0xdf, 0x4c, 0x75, 0x6f, 0x6a, 0xaa, 0x6d, 0x9b, 0xce, 0x78, 0x61, 0x82,
+	0xdf, 0xa3, 0x99, 0x1f, 0xe3, 0xfb, 0xef, 0x4a, 0x46, 0x35, 0x98, 0x24, 0xed, 0x2a, 0x99, 0x1b,
+	0xd8, 0xf5, 0xf9, 0x23, 0x2e, 0xee, 0xf2, 0x06, 0x0b, 0x66, 0xf3, 0xbb, 0xf1, 0xe4, 0xdf, 0xdf,
+	0xc6, 0x0f, 0x5a, 0x34, 0x6d, 0x2a, 0xa7, 0xd2, 0x82, 0xa6, 0xd3, 0xd2, 0xff, 0x32, 0x83, 0x97,
+	0xde, 0xda, 0x14, 0x6e, 0x8e, 0x6e, 0xe4, 0xe6, 0xe1, 0x2e, 0xf9, 0x84, 0xb0, 0xdb, 0x1e, 0x7b,
+	0xb2, 0xd1, 0x1e, 0xe2, 0x3f, 0x5e, 0x35, 0xf7, 0xd5, 0xbf, 0x91, 0xed, 0x4c, 0x1f, 0x10, 0xbe,
+	0xf3, 0xdb, 0xa9, 0xc9, 0xf3, 0x76, 0xdd, 0xeb, 0xb6, 0xde, 0x7d, 0xf1, 0xd7, 0x3c, 0xb3, 0x94,
+	0x37, 0x3b, 0x5f, 0x2f, 0x7a, 0xe8, 0xdb, 0x45, 0x0f, 0x7d, 0xbf, 0xe8, 0xa1, 0xf7, 0x2f, 0x33,
+	0xae, 0x87, 0xf5, 0x20, 0x4c, 0xa5, 0x88, 0x7e, 0x79, 0xf2, 0xc2, 0x0c, 0x0a, 0xf3, 0x3a, 0x5d,
+	0x7e, 0xfd, 0x36, 0xc6, 0xdf, 0xe7, 0x6b, 0x83, 0xb9, 0xa6, 0xbb, 0xfe, 0x63, 0x00, 0x85, 0x7d,
+	0xaa, 0x5e, 0x2b, 0x05, 0x00, 0x00,
+}
+
+func (m *GetDomainReplicationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDomainReplicationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDomainReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDomainReplicationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDomainReplicationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDomainReplicationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FailedShards) > 0 {
+		dAtA2 := make([]byte, len(m.FailedShards)*10)
+		var j1 int
+		for _, num1 := range m.FailedShards {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAdmin(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationClusterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationClusterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EstimatedLag != nil {
+		{
+			size, err := m.EstimatedLag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DlqMessageCount != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DlqMessageCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HasMorePendingTasks {
+		i--
+		if m.HasMorePendingTasks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PendingTaskCount != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PendingTaskCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastReplicatedTime != nil {
+		{
+			size, err := m.LastReplicatedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastReplicatedTaskId != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LastReplicatedTaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDomainIsolationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDomainIsolationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDomainIsolationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Isolated {
+		i--
+		if m.Isolated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDomainIsolationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDomainIsolationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDomainIsolationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FailedShards) > 0 {
+		dAtA6 := make([]byte, len(m.FailedShards)*10)
+		var j5 int
+		for _, num1 := range m.FailedShards {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintAdmin(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetDomainReplicationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDomainReplicationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.FailedShards) > 0 {
+		l = 0
+		for _, e := range m.FailedShards {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationClusterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.LastReplicatedTaskId != 0 {
+		n += 1 + sovAdmin(uint64(m.LastReplicatedTaskId))
+	}
+	if m.LastReplicatedTime != nil {
+		l = m.LastReplicatedTime.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.PendingTaskCount != 0 {
+		n += 1 + sovAdmin(uint64(m.PendingTaskCount))
+	}
+	if m.HasMorePendingTasks {
+		n += 2
+	}
+	if m.DlqMessageCount != 0 {
+		n += 1 + sovAdmin(uint64(m.DlqMessageCount))
+	}
+	if m.EstimatedLag != nil {
+		l = m.EstimatedLag.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDomainIsolationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Isolated {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDomainIsolationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedShards) > 0 {
+		l = 0
+		for _, e := range m.FailedShards {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetDomainReplicationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDomainReplicationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDomainReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDomainReplicationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDomainReplicationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDomainReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, &ReplicationClusterStatus{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedShards = append(m.FailedShards, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedShards) == 0 {
+					m.FailedShards = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedShards = append(m.FailedShards, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedShards", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationClusterStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationClusterStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationClusterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplicatedTaskId", wireType)
+			}
+			m.LastReplicatedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReplicatedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplicatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastReplicatedTime == nil {
+				m.LastReplicatedTime = &types.Timestamp{}
+			}
+			if err := m.LastReplicatedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTaskCount", wireType)
+			}
+			m.PendingTaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTaskCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMorePendingTasks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMorePendingTasks = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqMessageCount", wireType)
+			}
+			m.DlqMessageCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqMessageCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedLag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedLag == nil {
+				m.EstimatedLag = &types.Duration{}
+			}
+			if err := m.EstimatedLag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDomainIsolationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDomainIsolationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDomainIsolationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isolated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Isolated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDomainIsolationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDomainIsolationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDomainIsolationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedShards = append(m.FailedShards, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedShards) == 0 {
+					m.FailedShards = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedShards = append(m.FailedShards, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedShards", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAdmin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAdmin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAdmin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAdmin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdmin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAdmin = fmt.Errorf("proto: unexpected end of group")
+)
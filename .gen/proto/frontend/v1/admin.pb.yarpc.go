@@ -0,0 +1,364 @@
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/frontend/v1/admin.proto
+
+package frontendv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// FrontendAdminAPIYARPCClient is the YARPC client-side interface for the FrontendAdminAPI service.
+type FrontendAdminAPIYARPCClient interface {
+	GetDomainReplicationStatus(context.Context, *GetDomainReplicationStatusRequest, ...yarpc.CallOption) (*GetDomainReplicationStatusResponse, error)
+	UpdateDomainIsolation(context.Context, *UpdateDomainIsolationRequest, ...yarpc.CallOption) (*UpdateDomainIsolationResponse, error)
+}
+
+func newFrontendAdminAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) FrontendAdminAPIYARPCClient {
+	return &_FrontendAdminAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.frontend.v1.FrontendAdminAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewFrontendAdminAPIYARPCClient builds a new YARPC client for the FrontendAdminAPI service.
+func NewFrontendAdminAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) FrontendAdminAPIYARPCClient {
+	return newFrontendAdminAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// FrontendAdminAPIYARPCServer is the YARPC server-side interface for the FrontendAdminAPI service.
+type FrontendAdminAPIYARPCServer interface {
+	GetDomainReplicationStatus(context.Context, *GetDomainReplicationStatusRequest) (*GetDomainReplicationStatusResponse, error)
+	UpdateDomainIsolation(context.Context, *UpdateDomainIsolationRequest) (*UpdateDomainIsolationResponse, error)
+}
+
+type buildFrontendAdminAPIYARPCProceduresParams struct {
+	Server      FrontendAdminAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildFrontendAdminAPIYARPCProcedures(params buildFrontendAdminAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_FrontendAdminAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.frontend.v1.FrontendAdminAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "GetDomainReplicationStatus",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDomainReplicationStatus,
+							NewRequest:  newFrontendAdminAPIServiceGetDomainReplicationStatusYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomainIsolation",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateDomainIsolation,
+							NewRequest:  newFrontendAdminAPIServiceUpdateDomainIsolationYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildFrontendAdminAPIYARPCProcedures prepares an implementation of the FrontendAdminAPI service for YARPC registration.
+func BuildFrontendAdminAPIYARPCProcedures(server FrontendAdminAPIYARPCServer) []transport.Procedure {
+	return buildFrontendAdminAPIYARPCProcedures(buildFrontendAdminAPIYARPCProceduresParams{Server: server})
+}
+
+// FxFrontendAdminAPIYARPCClientParams defines the input
+// for NewFxFrontendAdminAPIYARPCClient. It provides the
+// paramaters to get a FrontendAdminAPIYARPCClient in an
+// Fx application.
+type FxFrontendAdminAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxFrontendAdminAPIYARPCClientResult defines the output
+// of NewFxFrontendAdminAPIYARPCClient. It provides a
+// FrontendAdminAPIYARPCClient to an Fx application.
+type FxFrontendAdminAPIYARPCClientResult struct {
+	fx.Out
+
+	Client FrontendAdminAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxFrontendAdminAPIYARPCClient provides a FrontendAdminAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  frontendv1.NewFxFrontendAdminAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxFrontendAdminAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxFrontendAdminAPIYARPCClientParams) FxFrontendAdminAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxFrontendAdminAPIYARPCClientResult{
+			Client: newFrontendAdminAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxFrontendAdminAPIYARPCProceduresParams defines the input
+// for NewFxFrontendAdminAPIYARPCProcedures. It provides the
+// paramaters to get FrontendAdminAPIYARPCServer procedures in an
+// Fx application.
+type FxFrontendAdminAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      FrontendAdminAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxFrontendAdminAPIYARPCProceduresResult defines the output
+// of NewFxFrontendAdminAPIYARPCProcedures. It provides
+// FrontendAdminAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxFrontendAdminAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxFrontendAdminAPIYARPCProcedures provides FrontendAdminAPIYARPCServer procedures to an Fx application.
+// It expects a FrontendAdminAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  frontendv1.NewFxFrontendAdminAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxFrontendAdminAPIYARPCProcedures() interface{} {
+	return func(params FxFrontendAdminAPIYARPCProceduresParams) FxFrontendAdminAPIYARPCProceduresResult {
+		return FxFrontendAdminAPIYARPCProceduresResult{
+			Procedures: buildFrontendAdminAPIYARPCProcedures(buildFrontendAdminAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: FrontendAdminAPIReflectionMeta,
+		}
+	}
+}
+
+// FrontendAdminAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var FrontendAdminAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.frontend.v1.FrontendAdminAPI",
+	FileDescriptors: yarpcFileDescriptorClosure33be5c6332dbd43a,
+}
+
+type _FrontendAdminAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_FrontendAdminAPIYARPCCaller) GetDomainReplicationStatus(ctx context.Context, request *GetDomainReplicationStatusRequest, options ...yarpc.CallOption) (*GetDomainReplicationStatusResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDomainReplicationStatus", request, newFrontendAdminAPIServiceGetDomainReplicationStatusYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDomainReplicationStatusResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyFrontendAdminAPIServiceGetDomainReplicationStatusYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_FrontendAdminAPIYARPCCaller) UpdateDomainIsolation(ctx context.Context, request *UpdateDomainIsolationRequest, options ...yarpc.CallOption) (*UpdateDomainIsolationResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomainIsolation", request, newFrontendAdminAPIServiceUpdateDomainIsolationYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainIsolationResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyFrontendAdminAPIServiceUpdateDomainIsolationYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _FrontendAdminAPIYARPCHandler struct {
+	server FrontendAdminAPIYARPCServer
+}
+
+func (h *_FrontendAdminAPIYARPCHandler) GetDomainReplicationStatus(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDomainReplicationStatusRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDomainReplicationStatusRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyFrontendAdminAPIServiceGetDomainReplicationStatusYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDomainReplicationStatus(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_FrontendAdminAPIYARPCHandler) UpdateDomainIsolation(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainIsolationRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainIsolationRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyFrontendAdminAPIServiceUpdateDomainIsolationYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomainIsolation(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newFrontendAdminAPIServiceGetDomainReplicationStatusYARPCRequest() proto.Message {
+	return &GetDomainReplicationStatusRequest{}
+}
+
+func newFrontendAdminAPIServiceGetDomainReplicationStatusYARPCResponse() proto.Message {
+	return &GetDomainReplicationStatusResponse{}
+}
+
+func newFrontendAdminAPIServiceUpdateDomainIsolationYARPCRequest() proto.Message {
+	return &UpdateDomainIsolationRequest{}
+}
+
+func newFrontendAdminAPIServiceUpdateDomainIsolationYARPCResponse() proto.Message {
+	return &UpdateDomainIsolationResponse{}
+}
+
+var (
+	emptyFrontendAdminAPIServiceGetDomainReplicationStatusYARPCRequest  = &GetDomainReplicationStatusRequest{}
+	emptyFrontendAdminAPIServiceGetDomainReplicationStatusYARPCResponse = &GetDomainReplicationStatusResponse{}
+	emptyFrontendAdminAPIServiceUpdateDomainIsolationYARPCRequest       = &UpdateDomainIsolationRequest{}
+	emptyFrontendAdminAPIServiceUpdateDomainIsolationYARPCResponse      = &UpdateDomainIsolationResponse{}
+)
+
+var yarpcFileDescriptorClosure33be5c6332dbd43a = [][]byte{
+	// uber/cadence/frontend/v1/admin.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+		0x14, 0x57, 0x16, 0x36, 0x8a, 0xb7, 0x89, 0x61, 0xc6, 0x08, 0x11, 0x7f, 0xb2, 0xc0, 0x21, 0x42,
+		0x28, 0xd1, 0x3a, 0x01, 0x42, 0x45, 0x48, 0x63, 0x13, 0x68, 0xd2, 0x36, 0x4d, 0xd9, 0xb8, 0x70,
+		0x89, 0xdc, 0xf8, 0x35, 0xb5, 0x16, 0xc7, 0x69, 0xec, 0xf4, 0x33, 0x70, 0xe5, 0xc4, 0xe7, 0xe0,
+		0xc4, 0xd7, 0x43, 0x8d, 0xdd, 0x52, 0x56, 0x52, 0x04, 0xb7, 0xe4, 0xbd, 0xdf, 0x1f, 0x3f, 0xfb,
+		0x67, 0xa3, 0x67, 0x75, 0x1f, 0xaa, 0x28, 0x25, 0x14, 0x8a, 0x14, 0xa2, 0x41, 0x25, 0x0a, 0x05,
+		0x05, 0x8d, 0xc6, 0x7b, 0x11, 0xa1, 0x9c, 0x15, 0x61, 0x59, 0x09, 0x25, 0xb0, 0x33, 0x41, 0x85,
+		0x06, 0x15, 0x4e, 0x51, 0xe1, 0x78, 0xcf, 0x7d, 0x9c, 0x09, 0x91, 0xe5, 0x10, 0x35, 0xb8, 0x7e,
+		0x3d, 0x88, 0x68, 0x5d, 0x11, 0xc5, 0x84, 0x61, 0xba, 0x4f, 0xae, 0xf7, 0x15, 0xe3, 0x20, 0x15,
+		0xe1, 0xa5, 0x06, 0xf8, 0x3d, 0xb4, 0xfb, 0x11, 0xd4, 0x91, 0xe0, 0x84, 0x15, 0x31, 0x94, 0x39,
+		0x4b, 0x1b, 0xfa, 0x85, 0x22, 0xaa, 0x96, 0x31, 0x8c, 0x6a, 0x90, 0x0a, 0xef, 0xa0, 0x35, 0xda,
+		0x20, 0x1c, 0xcb, 0xb3, 0x82, 0x5b, 0xb1, 0xf9, 0xf3, 0xbf, 0x5b, 0xc8, 0x5f, 0xc6, 0x96, 0xa5,
+		0x28, 0x24, 0xb4, 0xd1, 0xf1, 0x19, 0xea, 0xa4, 0x79, 0x2d, 0x15, 0x54, 0xd2, 0x59, 0xf1, 0xec,
+		0x60, 0xbd, 0xdb, 0x0d, 0xdb, 0x26, 0x0d, 0xe7, 0xe4, 0x0f, 0x35, 0xc9, 0xb8, 0xcc, 0x34, 0xf0,
+		0x53, 0xb4, 0x39, 0x20, 0x2c, 0x07, 0x9a, 0xc8, 0x21, 0xa9, 0xa8, 0x74, 0x6c, 0xcf, 0x0e, 0x56,
+		0xe3, 0x0d, 0x5d, 0xbc, 0x68, 0x6a, 0xfe, 0x57, 0x1b, 0x39, 0x6d, 0x5a, 0x78, 0x17, 0x6d, 0x18,
+		0xb5, 0xa4, 0x20, 0x1c, 0xcc, 0x7a, 0xd7, 0x4d, 0xed, 0x8c, 0x70, 0xc0, 0x2f, 0xd1, 0xfd, 0x9c,
+		0x48, 0x95, 0x54, 0x46, 0x03, 0x68, 0xa2, 0x88, 0xbc, 0x4a, 0x18, 0x75, 0x56, 0x3c, 0x2b, 0xb0,
+		0xe3, 0xed, 0x49, 0x3b, 0x9e, 0x75, 0x2f, 0x89, 0xbc, 0x3a, 0xa6, 0xf8, 0x04, 0x6d, 0x2f, 0xd0,
+		0x18, 0x07, 0xc7, 0xf6, 0xac, 0x60, 0xbd, 0xeb, 0x86, 0xfa, 0x9c, 0xc2, 0xe9, 0x39, 0x85, 0x97,
+		0xd3, 0x73, 0x8a, 0xf1, 0x35, 0x3d, 0xc6, 0x01, 0xbf, 0x40, 0xb8, 0x84, 0x82, 0xb2, 0x22, 0xd3,
+		0xe6, 0xa9, 0xa8, 0x0b, 0xe5, 0xdc, 0x68, 0xfc, 0xb7, 0x4c, 0x67, 0x62, 0x7c, 0x38, 0xa9, 0xe3,
+		0x7d, 0xb4, 0x33, 0x24, 0x32, 0xe1, 0xa2, 0x82, 0x64, 0x9e, 0x26, 0x9d, 0x55, 0xcf, 0x0a, 0x3a,
+		0xf1, 0xdd, 0x21, 0x91, 0xa7, 0xa2, 0x82, 0xf3, 0x5f, 0x44, 0x89, 0x9f, 0xa3, 0x3b, 0x34, 0x1f,
+		0x25, 0x1c, 0xa4, 0x24, 0x19, 0x18, 0x87, 0xb5, 0xc6, 0xe1, 0x36, 0xcd, 0x47, 0xa7, 0xba, 0xae,
+		0x0d, 0xde, 0xa1, 0x4d, 0x90, 0x8a, 0xf1, 0x66, 0xac, 0x9c, 0x64, 0xce, 0xcd, 0x66, 0xaa, 0x07,
+		0x0b, 0x53, 0x1d, 0x99, 0x74, 0xc6, 0x1b, 0x33, 0xfc, 0x09, 0xc9, 0xfc, 0x18, 0x3d, 0xfc, 0x54,
+		0x52, 0xa2, 0x40, 0x27, 0xe9, 0x58, 0x8a, 0x5c, 0xc3, 0x96, 0xe7, 0x0f, 0xbb, 0xa8, 0xc3, 0x1a,
+		0x2c, 0xe8, 0xcd, 0xef, 0xc4, 0xb3, 0x7f, 0xff, 0x08, 0x3d, 0x6a, 0xd1, 0x34, 0xa9, 0x5c, 0x48,
+		0x8b, 0xb5, 0x98, 0x96, 0xee, 0x8f, 0x15, 0xb4, 0xf5, 0xc1, 0xa4, 0xf0, 0x60, 0x72, 0x23, 0x0f,
+		0xce, 0x8f, 0xf1, 0x37, 0x0b, 0xb9, 0xed, 0xb1, 0xc7, 0xbd, 0xf6, 0x10, 0xff, 0xf5, 0xaa, 0xb9,
+		0x6f, 0xff, 0x8f, 0x6c, 0x66, 0xfa, 0x62, 0xa1, 0x7b, 0x7f, 0x9c, 0x1a, 0xbf, 0x6a, 0xd7, 0x5d,
+		0xb6, 0xf5, 0xee, 0xeb, 0x7f, 0xe6, 0xe9, 0xa5, 0xbc, 0xef, 0x7d, 0x7e, 0x93, 0x31, 0x35, 0xac,
+		0xfb, 0x61, 0x2a, 0x78, 0xf4, 0xdb, 0x33, 0x17, 0x66, 0x50, 0xe8, 0x17, 0x69, 0xfe, 0xc5, 0xeb,
+		0x4d, 0xbf, 0xc7, 0x7b, 0xfd, 0xb5, 0xa6, 0xbb, 0xff, 0x73, 0x00, 0xdd, 0x3f, 0x70, 0xda, 0x1f,
+		0x05, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0x54, 0x7f, 0xe3, 0xa1, 0x1c,
+		0xc3, 0x87, 0x87, 0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
+		0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31, 0x7c, 0x78, 0x24, 0xc7, 0xb8, 0xe2,
+		0xb1, 0x1c, 0xe3, 0x89, 0xc7, 0x72, 0x8c, 0x5c, 0xc2, 0xc9, 0xf9, 0xb9, 0x7a, 0x68, 0x56, 0x3b,
+		0xf1, 0xc2, 0x2c, 0x0e, 0x00, 0x89, 0x04, 0x30, 0x46, 0xb1, 0x96, 0x54, 0x16, 0xa4, 0x16, 0xff,
+		0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x25, 0x00,
+		0xaa, 0x45, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x3b, 0x2f, 0xbf, 0x3c, 0x2f, 0x04, 0xa4, 0x32, 0x89,
+		0x0d, 0x6c, 0x96, 0x31, 0x60, 0x00, 0x8a, 0x1c, 0x64, 0x4e, 0xf6, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x03, 0xe3, 0x8d,
+		0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca, 0x31, 0xae, 0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3,
+		0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0xbe, 0x78, 0x24, 0xc7, 0xf0, 0xe1, 0x91, 0x1c,
+		0xe3, 0x8a, 0xc7, 0x72, 0x8c, 0x27, 0x1e, 0xcb, 0x31, 0x72, 0x09, 0x27, 0xe7, 0xe7, 0xea, 0xa1,
+		0x59, 0xee, 0xc4, 0x07, 0xb7, 0x3a, 0x00, 0x24, 0x14, 0xc0, 0x18, 0xc5, 0x5a, 0x52, 0x59, 0x90,
+		0x5a, 0xfc, 0x83, 0x91, 0x71, 0x11, 0x13, 0xb3, 0x7b, 0x80, 0xd3, 0x2a, 0x26, 0x39, 0x77, 0x88,
+		0x9e, 0x00, 0xa8, 0x1e, 0xbd, 0xf0, 0xd4, 0x9c, 0x1c, 0xef, 0xbc, 0xfc, 0xf2, 0xbc, 0x10, 0x90,
+		0xca, 0x24, 0x36, 0xb0, 0x61, 0xc6, 0x80, 0x01, 0x00, 0x0b, 0x23, 0x83, 0xdd, 0xfa, 0x00, 0x00,
+		0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) FrontendAdminAPIYARPCClient {
+			return NewFrontendAdminAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
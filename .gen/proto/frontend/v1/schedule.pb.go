@@ -0,0 +1,5801 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/frontend/v1/schedule.proto
+
+package frontendv1
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/uber/cadence-idl/go/proto/api/v1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduleBatchOperationType is the operation a BatchOperationAction applies to every workflow matched by its query.
+type ScheduleBatchOperationType int32
+
+const (
+	ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_INVALID ScheduleBatchOperationType = 0
+	// Terminate matched workflows.
+	ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_TERMINATE ScheduleBatchOperationType = 1
+	// Request cancellation of matched workflows.
+	ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_CANCEL ScheduleBatchOperationType = 2
+	// Signal matched workflows.
+	ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_SIGNAL ScheduleBatchOperationType = 3
+)
+
+var ScheduleBatchOperationType_name = map[int32]string{
+	0: "SCHEDULE_BATCH_OPERATION_TYPE_INVALID",
+	1: "SCHEDULE_BATCH_OPERATION_TYPE_TERMINATE",
+	2: "SCHEDULE_BATCH_OPERATION_TYPE_CANCEL",
+	3: "SCHEDULE_BATCH_OPERATION_TYPE_SIGNAL",
+}
+
+var ScheduleBatchOperationType_value = map[string]int32{
+	"SCHEDULE_BATCH_OPERATION_TYPE_INVALID":   0,
+	"SCHEDULE_BATCH_OPERATION_TYPE_TERMINATE": 1,
+	"SCHEDULE_BATCH_OPERATION_TYPE_CANCEL":    2,
+	"SCHEDULE_BATCH_OPERATION_TYPE_SIGNAL":    3,
+}
+
+func (x ScheduleBatchOperationType) String() string {
+	return proto.EnumName(ScheduleBatchOperationType_name, int32(x))
+}
+
+func (ScheduleBatchOperationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{0}
+}
+
+// ScheduleActionOutcome describes what happened to a single schedule fire.
+type ScheduleActionOutcome int32
+
+const (
+	ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_INVALID ScheduleActionOutcome = 0
+	// Target workflow was started.
+	ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_STARTED ScheduleActionOutcome = 1
+	// Fire was dropped by the overlap policy or buffer limit.
+	ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_SKIPPED ScheduleActionOutcome = 2
+	// Action could not be performed.
+	ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_FAILED ScheduleActionOutcome = 3
+)
+
+var ScheduleActionOutcome_name = map[int32]string{
+	0: "SCHEDULE_ACTION_OUTCOME_INVALID",
+	1: "SCHEDULE_ACTION_OUTCOME_STARTED",
+	2: "SCHEDULE_ACTION_OUTCOME_SKIPPED",
+	3: "SCHEDULE_ACTION_OUTCOME_FAILED",
+}
+
+var ScheduleActionOutcome_value = map[string]int32{
+	"SCHEDULE_ACTION_OUTCOME_INVALID": 0,
+	"SCHEDULE_ACTION_OUTCOME_STARTED": 1,
+	"SCHEDULE_ACTION_OUTCOME_SKIPPED": 2,
+	"SCHEDULE_ACTION_OUTCOME_FAILED":  3,
+}
+
+func (x ScheduleActionOutcome) String() string {
+	return proto.EnumName(ScheduleActionOutcome_name, int32(x))
+}
+
+func (ScheduleActionOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{1}
+}
+
+// ScheduleSpec defines when a schedule should trigger.
+// The schedule fires at the union of cron_expression, calendars and intervals, minus any time matched by
+// exclude_calendars. At least one of cron_expression, calendars or intervals must be set.
+type ScheduleSpec struct {
+	// Standard cron expression (e.g., "0 6 * * *").
+	CronExpression string `protobuf:"bytes,1,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// Earliest time the schedule should trigger. If not set, starts immediately.
+	StartTime *types.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Latest time the schedule should trigger. If not set, runs indefinitely.
+	EndTime *types.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Random jitter applied to each trigger time to spread load.
+	Jitter *types.Duration `protobuf:"bytes,4,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// Additional calendar based fire times. The schedule fires if any of them matches.
+	Calendars []*ScheduleCalendarSpec `protobuf:"bytes,5,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	// Fixed period fire times aligned to the Unix epoch.
+	Intervals []*ScheduleIntervalSpec `protobuf:"bytes,6,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	// Fire times matching any of these calendars are skipped, whichever spec produced them.
+	ExcludeCalendars []*ScheduleCalendarSpec `protobuf:"bytes,7,rep,name=exclude_calendars,json=excludeCalendars,proto3" json:"exclude_calendars,omitempty"`
+	// IANA location name (e.g. "America/New_York") used to evaluate cron_expression and calendars.
+	// Defaults to UTC. A CRON_TZ prefix on cron_expression takes precedence for that expression.
+	Timezone             string   `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleSpec) Reset()         { *m = ScheduleSpec{} }
+func (m *ScheduleSpec) String() string { return proto.CompactTextString(m) }
+func (*ScheduleSpec) ProtoMessage()    {}
+func (*ScheduleSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{0}
+}
+func (m *ScheduleSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleSpec.Merge(m, src)
+}
+func (m *ScheduleSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleSpec proto.InternalMessageInfo
+
+func (m *ScheduleSpec) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *ScheduleSpec) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetJitter() *types.Duration {
+	if m != nil {
+		return m.Jitter
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetCalendars() []*ScheduleCalendarSpec {
+	if m != nil {
+		return m.Calendars
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetIntervals() []*ScheduleIntervalSpec {
+	if m != nil {
+		return m.Intervals
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetExcludeCalendars() []*ScheduleCalendarSpec {
+	if m != nil {
+		return m.ExcludeCalendars
+	}
+	return nil
+}
+
+func (m *ScheduleSpec) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+// ScheduleCalendarSpec describes a set of calendar times. Every field accepts cron field syntax.
+// Unlike a cron expression, day_of_month and day_of_week are combined with AND.
+type ScheduleCalendarSpec struct {
+	Second     string `protobuf:"bytes,1,opt,name=second,proto3" json:"second,omitempty"`
+	Minute     string `protobuf:"bytes,2,opt,name=minute,proto3" json:"minute,omitempty"`
+	Hour       string `protobuf:"bytes,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	DayOfMonth string `protobuf:"bytes,4,opt,name=day_of_month,json=dayOfMonth,proto3" json:"day_of_month,omitempty"`
+	Month      string `protobuf:"bytes,5,opt,name=month,proto3" json:"month,omitempty"`
+	DayOfWeek  string `protobuf:"bytes,6,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	// Free-form description of the calendar.
+	Comment              string   `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleCalendarSpec) Reset()         { *m = ScheduleCalendarSpec{} }
+func (m *ScheduleCalendarSpec) String() string { return proto.CompactTextString(m) }
+func (*ScheduleCalendarSpec) ProtoMessage()    {}
+func (*ScheduleCalendarSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{1}
+}
+func (m *ScheduleCalendarSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleCalendarSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleCalendarSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleCalendarSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleCalendarSpec.Merge(m, src)
+}
+func (m *ScheduleCalendarSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleCalendarSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleCalendarSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleCalendarSpec proto.InternalMessageInfo
+
+func (m *ScheduleCalendarSpec) GetSecond() string {
+	if m != nil {
+		return m.Second
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetMinute() string {
+	if m != nil {
+		return m.Minute
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetHour() string {
+	if m != nil {
+		return m.Hour
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetDayOfMonth() string {
+	if m != nil {
+		return m.DayOfMonth
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetMonth() string {
+	if m != nil {
+		return m.Month
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetDayOfWeek() string {
+	if m != nil {
+		return m.DayOfWeek
+	}
+	return ""
+}
+
+func (m *ScheduleCalendarSpec) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+// ScheduleIntervalSpec fires every interval, at times t where (t - UnixEpoch - phase) is a multiple of interval.
+type ScheduleIntervalSpec struct {
+	Interval             *types.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	Phase                *types.Duration `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ScheduleIntervalSpec) Reset()         { *m = ScheduleIntervalSpec{} }
+func (m *ScheduleIntervalSpec) String() string { return proto.CompactTextString(m) }
+func (*ScheduleIntervalSpec) ProtoMessage()    {}
+func (*ScheduleIntervalSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{2}
+}
+func (m *ScheduleIntervalSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleIntervalSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleIntervalSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleIntervalSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleIntervalSpec.Merge(m, src)
+}
+func (m *ScheduleIntervalSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleIntervalSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleIntervalSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleIntervalSpec proto.InternalMessageInfo
+
+func (m *ScheduleIntervalSpec) GetInterval() *types.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *ScheduleIntervalSpec) GetPhase() *types.Duration {
+	if m != nil {
+		return m.Phase
+	}
+	return nil
+}
+
+// ScheduleAction defines what action to take when the schedule triggers.
+// Exactly one action field must be set.
+type ScheduleAction struct {
+	// Start a new workflow execution on each trigger.
+	StartWorkflow *v1.ScheduleAction_StartWorkflowAction `protobuf:"bytes,1,opt,name=start_workflow,json=startWorkflow,proto3" json:"start_workflow,omitempty"`
+	// Signal a workflow on each trigger.
+	SignalWorkflow *SignalWorkflowAction `protobuf:"bytes,2,opt,name=signal_workflow,json=signalWorkflow,proto3" json:"signal_workflow,omitempty"`
+	// Start a batch operation on each trigger.
+	BatchOperation       *BatchOperationAction `protobuf:"bytes,3,opt,name=batch_operation,json=batchOperation,proto3" json:"batch_operation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ScheduleAction) Reset()         { *m = ScheduleAction{} }
+func (m *ScheduleAction) String() string { return proto.CompactTextString(m) }
+func (*ScheduleAction) ProtoMessage()    {}
+func (*ScheduleAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{3}
+}
+func (m *ScheduleAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleAction.Merge(m, src)
+}
+func (m *ScheduleAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleAction proto.InternalMessageInfo
+
+func (m *ScheduleAction) GetStartWorkflow() *v1.ScheduleAction_StartWorkflowAction {
+	if m != nil {
+		return m.StartWorkflow
+	}
+	return nil
+}
+
+func (m *ScheduleAction) GetSignalWorkflow() *SignalWorkflowAction {
+	if m != nil {
+		return m.SignalWorkflow
+	}
+	return nil
+}
+
+func (m *ScheduleAction) GetBatchOperation() *BatchOperationAction {
+	if m != nil {
+		return m.BatchOperation
+	}
+	return nil
+}
+
+// SignalWorkflowAction signals a workflow in the schedule's domain when the schedule triggers.
+// When start_workflow is set the signal is sent with signal-with-start, starting the workflow with those options
+// if it is not running; start_workflow.workflow_id_prefix is ignored in that case.
+type SignalWorkflowAction struct {
+	WorkflowId           string                                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	SignalName           string                                 `protobuf:"bytes,2,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	SignalInput          *v1.Payload                            `protobuf:"bytes,3,opt,name=signal_input,json=signalInput,proto3" json:"signal_input,omitempty"`
+	StartWorkflow        *v1.ScheduleAction_StartWorkflowAction `protobuf:"bytes,4,opt,name=start_workflow,json=startWorkflow,proto3" json:"start_workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *SignalWorkflowAction) Reset()         { *m = SignalWorkflowAction{} }
+func (m *SignalWorkflowAction) String() string { return proto.CompactTextString(m) }
+func (*SignalWorkflowAction) ProtoMessage()    {}
+func (*SignalWorkflowAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{4}
+}
+func (m *SignalWorkflowAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalWorkflowAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalWorkflowAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalWorkflowAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalWorkflowAction.Merge(m, src)
+}
+func (m *SignalWorkflowAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalWorkflowAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalWorkflowAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalWorkflowAction proto.InternalMessageInfo
+
+func (m *SignalWorkflowAction) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *SignalWorkflowAction) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
+func (m *SignalWorkflowAction) GetSignalInput() *v1.Payload {
+	if m != nil {
+		return m.SignalInput
+	}
+	return nil
+}
+
+func (m *SignalWorkflowAction) GetStartWorkflow() *v1.ScheduleAction_StartWorkflowAction {
+	if m != nil {
+		return m.StartWorkflow
+	}
+	return nil
+}
+
+// BatchOperationAction starts a batch operation over the workflows in the schedule's domain that match query.
+// signal_name and signal_input are only used by the SIGNAL operation. rps and concurrency fall back to the batcher
+// defaults when zero.
+type BatchOperationAction struct {
+	OperationType        ScheduleBatchOperationType `protobuf:"varint,1,opt,name=operation_type,json=operationType,proto3,enum=uber.cadence.frontend.v1.ScheduleBatchOperationType" json:"operation_type,omitempty"`
+	Query                string                     `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Reason               string                     `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	SignalName           string                     `protobuf:"bytes,4,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	SignalInput          string                     `protobuf:"bytes,5,opt,name=signal_input,json=signalInput,proto3" json:"signal_input,omitempty"`
+	Rps                  int32                      `protobuf:"varint,6,opt,name=rps,proto3" json:"rps,omitempty"`
+	Concurrency          int32                      `protobuf:"varint,7,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *BatchOperationAction) Reset()         { *m = BatchOperationAction{} }
+func (m *BatchOperationAction) String() string { return proto.CompactTextString(m) }
+func (*BatchOperationAction) ProtoMessage()    {}
+func (*BatchOperationAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{5}
+}
+func (m *BatchOperationAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOperationAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOperationAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOperationAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOperationAction.Merge(m, src)
+}
+func (m *BatchOperationAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOperationAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOperationAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOperationAction proto.InternalMessageInfo
+
+func (m *BatchOperationAction) GetOperationType() ScheduleBatchOperationType {
+	if m != nil {
+		return m.OperationType
+	}
+	return ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_INVALID
+}
+
+func (m *BatchOperationAction) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *BatchOperationAction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BatchOperationAction) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
+func (m *BatchOperationAction) GetSignalInput() string {
+	if m != nil {
+		return m.SignalInput
+	}
+	return ""
+}
+
+func (m *BatchOperationAction) GetRps() int32 {
+	if m != nil {
+		return m.Rps
+	}
+	return 0
+}
+
+func (m *BatchOperationAction) GetConcurrency() int32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+// SchedulePolicies configures the schedule behavior.
+type SchedulePolicies struct {
+	OverlapPolicy    v1.ScheduleOverlapPolicy `protobuf:"varint,1,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=uber.cadence.api.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+	CatchUpPolicy    v1.ScheduleCatchUpPolicy `protobuf:"varint,2,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=uber.cadence.api.v1.ScheduleCatchUpPolicy" json:"catch_up_policy,omitempty"`
+	CatchUpWindow    *types.Duration          `protobuf:"bytes,3,opt,name=catch_up_window,json=catchUpWindow,proto3" json:"catch_up_window,omitempty"`
+	PauseOnFailure   bool                     `protobuf:"varint,4,opt,name=pause_on_failure,json=pauseOnFailure,proto3" json:"pause_on_failure,omitempty"`
+	BufferLimit      int32                    `protobuf:"varint,5,opt,name=buffer_limit,json=bufferLimit,proto3" json:"buffer_limit,omitempty"`
+	ConcurrencyLimit int32                    `protobuf:"varint,6,opt,name=concurrency_limit,json=concurrencyLimit,proto3" json:"concurrency_limit,omitempty"`
+	// When set, the schedule takes at most remaining_actions more actions and then completes.
+	LimitedActions   bool  `protobuf:"varint,7,opt,name=limited_actions,json=limitedActions,proto3" json:"limited_actions,omitempty"`
+	RemainingActions int64 `protobuf:"varint,8,opt,name=remaining_actions,json=remainingActions,proto3" json:"remaining_actions,omitempty"`
+	// Number of consecutive failed runs that pause the schedule, only used when pause_on_failure is set.
+	PauseOnFailureThreshold int32 `protobuf:"varint,9,opt,name=pause_on_failure_threshold,json=pauseOnFailureThreshold,proto3" json:"pause_on_failure_threshold,omitempty"`
+	// How long the schedule stays paused after a failure pause before it unpauses itself. Zero keeps it paused.
+	PauseOnFailureCooldown *types.Duration `protobuf:"bytes,10,opt,name=pause_on_failure_cooldown,json=pauseOnFailureCooldown,proto3" json:"pause_on_failure_cooldown,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_unrecognized       []byte          `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *SchedulePolicies) Reset()         { *m = SchedulePolicies{} }
+func (m *SchedulePolicies) String() string { return proto.CompactTextString(m) }
+func (*SchedulePolicies) ProtoMessage()    {}
+func (*SchedulePolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{6}
+}
+func (m *SchedulePolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePolicies.Merge(m, src)
+}
+func (m *SchedulePolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePolicies proto.InternalMessageInfo
+
+func (m *SchedulePolicies) GetOverlapPolicy() v1.ScheduleOverlapPolicy {
+	if m != nil {
+		return m.OverlapPolicy
+	}
+	return v1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_INVALID
+}
+
+func (m *SchedulePolicies) GetCatchUpPolicy() v1.ScheduleCatchUpPolicy {
+	if m != nil {
+		return m.CatchUpPolicy
+	}
+	return v1.ScheduleCatchUpPolicy_SCHEDULE_CATCH_UP_POLICY_INVALID
+}
+
+func (m *SchedulePolicies) GetCatchUpWindow() *types.Duration {
+	if m != nil {
+		return m.CatchUpWindow
+	}
+	return nil
+}
+
+func (m *SchedulePolicies) GetPauseOnFailure() bool {
+	if m != nil {
+		return m.PauseOnFailure
+	}
+	return false
+}
+
+func (m *SchedulePolicies) GetBufferLimit() int32 {
+	if m != nil {
+		return m.BufferLimit
+	}
+	return 0
+}
+
+func (m *SchedulePolicies) GetConcurrencyLimit() int32 {
+	if m != nil {
+		return m.ConcurrencyLimit
+	}
+	return 0
+}
+
+func (m *SchedulePolicies) GetLimitedActions() bool {
+	if m != nil {
+		return m.LimitedActions
+	}
+	return false
+}
+
+func (m *SchedulePolicies) GetRemainingActions() int64 {
+	if m != nil {
+		return m.RemainingActions
+	}
+	return 0
+}
+
+func (m *SchedulePolicies) GetPauseOnFailureThreshold() int32 {
+	if m != nil {
+		return m.PauseOnFailureThreshold
+	}
+	return 0
+}
+
+func (m *SchedulePolicies) GetPauseOnFailureCooldown() *types.Duration {
+	if m != nil {
+		return m.PauseOnFailureCooldown
+	}
+	return nil
+}
+
+// SchedulePauseInfo contains information about a paused schedule.
+type SchedulePauseInfo struct {
+	Reason   string           `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	PausedAt *types.Timestamp `protobuf:"bytes,2,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	PausedBy string           `protobuf:"bytes,3,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
+	// When the schedule unpauses itself, if it was paused on failure with a cooldown.
+	AutoUnpauseTime      *types.Timestamp `protobuf:"bytes,4,opt,name=auto_unpause_time,json=autoUnpauseTime,proto3" json:"auto_unpause_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SchedulePauseInfo) Reset()         { *m = SchedulePauseInfo{} }
+func (m *SchedulePauseInfo) String() string { return proto.CompactTextString(m) }
+func (*SchedulePauseInfo) ProtoMessage()    {}
+func (*SchedulePauseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{7}
+}
+func (m *SchedulePauseInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePauseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePauseInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePauseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePauseInfo.Merge(m, src)
+}
+func (m *SchedulePauseInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePauseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePauseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePauseInfo proto.InternalMessageInfo
+
+func (m *SchedulePauseInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SchedulePauseInfo) GetPausedAt() *types.Timestamp {
+	if m != nil {
+		return m.PausedAt
+	}
+	return nil
+}
+
+func (m *SchedulePauseInfo) GetPausedBy() string {
+	if m != nil {
+		return m.PausedBy
+	}
+	return ""
+}
+
+func (m *SchedulePauseInfo) GetAutoUnpauseTime() *types.Timestamp {
+	if m != nil {
+		return m.AutoUnpauseTime
+	}
+	return nil
+}
+
+// ScheduleState represents the current state of a schedule.
+type ScheduleState struct {
+	Paused    bool               `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	PauseInfo *SchedulePauseInfo `protobuf:"bytes,2,opt,name=pause_info,json=pauseInfo,proto3" json:"pause_info,omitempty"`
+	// Set once the schedule will take no more actions, e.g. after running out of its limited actions.
+	Completed            bool     `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleState) Reset()         { *m = ScheduleState{} }
+func (m *ScheduleState) String() string { return proto.CompactTextString(m) }
+func (*ScheduleState) ProtoMessage()    {}
+func (*ScheduleState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{8}
+}
+func (m *ScheduleState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleState.Merge(m, src)
+}
+func (m *ScheduleState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleState proto.InternalMessageInfo
+
+func (m *ScheduleState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ScheduleState) GetPauseInfo() *SchedulePauseInfo {
+	if m != nil {
+		return m.PauseInfo
+	}
+	return nil
+}
+
+func (m *ScheduleState) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+// ScheduleListEntry is a summary of a schedule returned by ListSchedules.
+type ScheduleListEntry struct {
+	ScheduleId           string           `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	WorkflowType         *v1.WorkflowType `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	State                *ScheduleState   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	CronExpression       string           `protobuf:"bytes,4,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ScheduleListEntry) Reset()         { *m = ScheduleListEntry{} }
+func (m *ScheduleListEntry) String() string { return proto.CompactTextString(m) }
+func (*ScheduleListEntry) ProtoMessage()    {}
+func (*ScheduleListEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{9}
+}
+func (m *ScheduleListEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleListEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleListEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleListEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleListEntry.Merge(m, src)
+}
+func (m *ScheduleListEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleListEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleListEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleListEntry proto.InternalMessageInfo
+
+func (m *ScheduleListEntry) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
+	}
+	return ""
+}
+
+func (m *ScheduleListEntry) GetWorkflowType() *v1.WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *ScheduleListEntry) GetState() *ScheduleState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ScheduleListEntry) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+// ScheduleActionResult records a single action taken by the schedule.
+type ScheduleActionResult struct {
+	ScheduledTime *types.Timestamp `protobuf:"bytes,1,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	ActualTime    *types.Timestamp `protobuf:"bytes,2,opt,name=actual_time,json=actualTime,proto3" json:"actual_time,omitempty"`
+	// Target run, only set when the outcome is STARTED.
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Outcome              ScheduleActionOutcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=uber.cadence.frontend.v1.ScheduleActionOutcome" json:"outcome,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ScheduleActionResult) Reset()         { *m = ScheduleActionResult{} }
+func (m *ScheduleActionResult) String() string { return proto.CompactTextString(m) }
+func (*ScheduleActionResult) ProtoMessage()    {}
+func (*ScheduleActionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{10}
+}
+func (m *ScheduleActionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleActionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleActionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleActionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleActionResult.Merge(m, src)
+}
+func (m *ScheduleActionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleActionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleActionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleActionResult proto.InternalMessageInfo
+
+func (m *ScheduleActionResult) GetScheduledTime() *types.Timestamp {
+	if m != nil {
+		return m.ScheduledTime
+	}
+	return nil
+}
+
+func (m *ScheduleActionResult) GetActualTime() *types.Timestamp {
+	if m != nil {
+		return m.ActualTime
+	}
+	return nil
+}
+
+func (m *ScheduleActionResult) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ScheduleActionResult) GetOutcome() ScheduleActionOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_INVALID
+}
+
+// ScheduleInfo provides runtime information about the schedule.
+type ScheduleInfo struct {
+	LastRunTime          *types.Timestamp   `protobuf:"bytes,1,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	NextRunTime          *types.Timestamp   `protobuf:"bytes,2,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	TotalRuns            int64              `protobuf:"varint,3,opt,name=total_runs,json=totalRuns,proto3" json:"total_runs,omitempty"`
+	CreateTime           *types.Timestamp   `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	LastUpdateTime       *types.Timestamp   `protobuf:"bytes,5,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+	OngoingBackfills     []*v1.BackfillInfo `protobuf:"bytes,6,rep,name=ongoing_backfills,json=ongoingBackfills,proto3" json:"ongoing_backfills,omitempty"`
+	MissedRuns           int64              `protobuf:"varint,7,opt,name=missed_runs,json=missedRuns,proto3" json:"missed_runs,omitempty"`
+	SkippedRuns          int64              `protobuf:"varint,8,opt,name=skipped_runs,json=skippedRuns,proto3" json:"skipped_runs,omitempty"`
+	BufferedFireCount    int64              `protobuf:"varint,9,opt,name=buffered_fire_count,json=bufferedFireCount,proto3" json:"buffered_fire_count,omitempty"`
+	RunningWorkflowCount int64              `protobuf:"varint,10,opt,name=running_workflow_count,json=runningWorkflowCount,proto3" json:"running_workflow_count,omitempty"`
+	// Most recent actions taken by the schedule, oldest first.
+	RecentActions []*ScheduleActionResult `protobuf:"bytes,11,rep,name=recent_actions,json=recentActions,proto3" json:"recent_actions,omitempty"`
+	// Current streak of failed runs, only tracked when pause_on_failure is set.
+	ConsecutiveFailures int32 `protobuf:"varint,12,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// Most recent failed run, only tracked when pause_on_failure is set.
+	LastFailure          *ScheduleFailureInfo `protobuf:"bytes,13,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ScheduleInfo) Reset()         { *m = ScheduleInfo{} }
+func (m *ScheduleInfo) String() string { return proto.CompactTextString(m) }
+func (*ScheduleInfo) ProtoMessage()    {}
+func (*ScheduleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{11}
+}
+func (m *ScheduleInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleInfo.Merge(m, src)
+}
+func (m *ScheduleInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleInfo proto.InternalMessageInfo
+
+func (m *ScheduleInfo) GetLastRunTime() *types.Timestamp {
+	if m != nil {
+		return m.LastRunTime
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetNextRunTime() *types.Timestamp {
+	if m != nil {
+		return m.NextRunTime
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetTotalRuns() int64 {
+	if m != nil {
+		return m.TotalRuns
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetCreateTime() *types.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetLastUpdateTime() *types.Timestamp {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetOngoingBackfills() []*v1.BackfillInfo {
+	if m != nil {
+		return m.OngoingBackfills
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetMissedRuns() int64 {
+	if m != nil {
+		return m.MissedRuns
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetSkippedRuns() int64 {
+	if m != nil {
+		return m.SkippedRuns
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetBufferedFireCount() int64 {
+	if m != nil {
+		return m.BufferedFireCount
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetRunningWorkflowCount() int64 {
+	if m != nil {
+		return m.RunningWorkflowCount
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetRecentActions() []*ScheduleActionResult {
+	if m != nil {
+		return m.RecentActions
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetConsecutiveFailures() int32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetLastFailure() *ScheduleFailureInfo {
+	if m != nil {
+		return m.LastFailure
+	}
+	return nil
+}
+
+// ScheduleFailureInfo describes a failed run observed by a schedule with pause_on_failure set.
+type ScheduleFailureInfo struct {
+	WorkflowExecution *v1.WorkflowExecution           `protobuf:"bytes,1,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	CloseStatus       v1.WorkflowExecutionCloseStatus `protobuf:"varint,2,opt,name=close_status,json=closeStatus,proto3,enum=uber.cadence.api.v1.WorkflowExecutionCloseStatus" json:"close_status,omitempty"`
+	FailureReason     string                          `protobuf:"bytes,3,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// When the scheduler noticed the failure.
+	ObservedTime *types.Timestamp `protobuf:"bytes,4,opt,name=observed_time,json=observedTime,proto3" json:"observed_time,omitempty"`
+	// Failure streak including this run.
+	ConsecutiveFailures  int32    `protobuf:"varint,5,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleFailureInfo) Reset()         { *m = ScheduleFailureInfo{} }
+func (m *ScheduleFailureInfo) String() string { return proto.CompactTextString(m) }
+func (*ScheduleFailureInfo) ProtoMessage()    {}
+func (*ScheduleFailureInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1937b58cf1f9e913, []int{12}
+}
+func (m *ScheduleFailureInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleFailureInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleFailureInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleFailureInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleFailureInfo.Merge(m, src)
+}
+func (m *ScheduleFailureInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleFailureInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleFailureInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleFailureInfo proto.InternalMessageInfo
+
+func (m *ScheduleFailureInfo) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ScheduleFailureInfo) GetCloseStatus() v1.WorkflowExecutionCloseStatus {
+	if m != nil {
+		return m.CloseStatus
+	}
+	return v1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+}
+
+func (m *ScheduleFailureInfo) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *ScheduleFailureInfo) GetObservedTime() *types.Timestamp {
+	if m != nil {
+		return m.ObservedTime
+	}
+	return nil
+}
+
+func (m *ScheduleFailureInfo) GetConsecutiveFailures() int32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("uber.cadence.frontend.v1.ScheduleBatchOperationType", ScheduleBatchOperationType_name, ScheduleBatchOperationType_value)
+	proto.RegisterEnum("uber.cadence.frontend.v1.ScheduleActionOutcome", ScheduleActionOutcome_name, ScheduleActionOutcome_value)
+	proto.RegisterType((*ScheduleSpec)(nil), "uber.cadence.frontend.v1.ScheduleSpec")
+	proto.RegisterType((*ScheduleCalendarSpec)(nil), "uber.cadence.frontend.v1.ScheduleCalendarSpec")
+	proto.RegisterType((*ScheduleIntervalSpec)(nil), "uber.cadence.frontend.v1.ScheduleIntervalSpec")
+	proto.RegisterType((*ScheduleAction)(nil), "uber.cadence.frontend.v1.ScheduleAction")
+	proto.RegisterType((*SignalWorkflowAction)(nil), "uber.cadence.frontend.v1.SignalWorkflowAction")
+	proto.RegisterType((*BatchOperationAction)(nil), "uber.cadence.frontend.v1.BatchOperationAction")
+	proto.RegisterType((*SchedulePolicies)(nil), "uber.cadence.frontend.v1.SchedulePolicies")
+	proto.RegisterType((*SchedulePauseInfo)(nil), "uber.cadence.frontend.v1.SchedulePauseInfo")
+	proto.RegisterType((*ScheduleState)(nil), "uber.cadence.frontend.v1.ScheduleState")
+	proto.RegisterType((*ScheduleListEntry)(nil), "uber.cadence.frontend.v1.ScheduleListEntry")
+	proto.RegisterType((*ScheduleActionResult)(nil), "uber.cadence.frontend.v1.ScheduleActionResult")
+	proto.RegisterType((*ScheduleInfo)(nil), "uber.cadence.frontend.v1.ScheduleInfo")
+	proto.RegisterType((*ScheduleFailureInfo)(nil), "uber.cadence.frontend.v1.ScheduleFailureInfo")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/frontend/v1/schedule.proto", fileDescriptor_1937b58cf1f9e913)
+}
+
+var fileDescriptor_1937b58cf1f9e913 = []byte{
+	// 1762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0x23, 0x49,
+	0x19, 0xa6, 0xe3, 0x38, 0x89, 0x5f, 0xc7, 0x8e, 0x53, 0x13, 0x06, 0x6f, 0x58, 0x32, 0x59, 0xc3,
+	0x92, 0x30, 0x23, 0x1c, 0x65, 0x58, 0xb4, 0x5a, 0x8d, 0xd8, 0x95, 0xe3, 0x38, 0xbb, 0x86, 0x4c,
+	0x1c, 0x2a, 0x0e, 0x23, 0x58, 0x89, 0x56, 0xb9, 0xbb, 0x9c, 0x34, 0x69, 0x57, 0x35, 0xdd, 0xd5,
+	0xc9, 0x98, 0x03, 0x47, 0xee, 0x1c, 0xb9, 0x72, 0xe4, 0x17, 0xf0, 0x13, 0x06, 0x89, 0x03, 0x7f,
+	0x00, 0x81, 0x46, 0xfc, 0x01, 0x8e, 0xdc, 0x50, 0x7d, 0x75, 0xda, 0x19, 0x27, 0xb6, 0x60, 0x6f,
+	0xae, 0xb7, 0x9e, 0xe7, 0x71, 0xbd, 0x9f, 0x55, 0x36, 0xec, 0xa4, 0x03, 0x1a, 0xef, 0x79, 0xc4,
+	0xa7, 0xcc, 0xa3, 0x7b, 0xc3, 0x98, 0x33, 0x41, 0x99, 0xbf, 0x77, 0xbd, 0xbf, 0x97, 0x78, 0x97,
+	0xd4, 0x4f, 0x43, 0xda, 0x8c, 0x62, 0x2e, 0x38, 0xaa, 0x4b, 0x60, 0xd3, 0x00, 0x9b, 0x16, 0xd8,
+	0xbc, 0xde, 0xdf, 0xdc, 0xba, 0xe0, 0xfc, 0x22, 0xa4, 0x7b, 0x0a, 0x37, 0x48, 0x87, 0x7b, 0x7e,
+	0x1a, 0x13, 0x11, 0x70, 0xa6, 0x99, 0x9b, 0x4f, 0xee, 0xee, 0x8b, 0x60, 0x44, 0x13, 0x41, 0x46,
+	0x91, 0x01, 0x6c, 0x4f, 0x9c, 0x81, 0x44, 0x81, 0xfc, 0x7a, 0x8f, 0x8f, 0x46, 0x99, 0x44, 0x63,
+	0x1a, 0x62, 0xf2, 0x80, 0xd3, 0x31, 0x37, 0x3c, 0xbe, 0x1a, 0x86, 0xfc, 0x46, 0x63, 0x1a, 0xff,
+	0x2e, 0xc0, 0xea, 0x99, 0xa1, 0x9d, 0x45, 0xd4, 0x43, 0x3b, 0xb0, 0xe6, 0xc5, 0x9c, 0xb9, 0xf4,
+	0x75, 0x14, 0xd3, 0x24, 0x09, 0x38, 0xab, 0x3b, 0xdb, 0xce, 0x6e, 0x09, 0x57, 0xa5, 0xb9, 0x93,
+	0x59, 0xd1, 0x27, 0x00, 0x89, 0x20, 0xb1, 0x70, 0xe5, 0xe1, 0xeb, 0x0b, 0xdb, 0xce, 0x6e, 0xf9,
+	0xf9, 0x66, 0x53, 0x7b, 0xd6, 0xb4, 0x9e, 0x35, 0xfb, 0xd6, 0x33, 0x5c, 0x52, 0x68, 0xb9, 0x46,
+	0x3f, 0x84, 0x15, 0xca, 0x7c, 0x4d, 0x2c, 0xcc, 0x24, 0x2e, 0x53, 0xe6, 0x2b, 0xda, 0x3e, 0x2c,
+	0xfd, 0x2a, 0x10, 0x82, 0xc6, 0xf5, 0x45, 0x45, 0x7a, 0xef, 0x1d, 0xd2, 0xa1, 0x89, 0x33, 0x36,
+	0x40, 0x74, 0x0c, 0x25, 0x8f, 0x84, 0x94, 0xf9, 0x24, 0x4e, 0xea, 0xc5, 0xed, 0xc2, 0x6e, 0xf9,
+	0x79, 0xb3, 0x79, 0x5f, 0xde, 0x9a, 0x36, 0x10, 0x6d, 0x43, 0x91, 0x01, 0xc1, 0xb7, 0x02, 0x52,
+	0x2d, 0x60, 0x82, 0xc6, 0xd7, 0x24, 0x4c, 0xea, 0x4b, 0xf3, 0xaa, 0x75, 0x0d, 0x45, 0xab, 0x65,
+	0x02, 0xe8, 0x4b, 0x58, 0xa7, 0xaf, 0xbd, 0x30, 0xf5, 0xa9, 0x7b, 0x7b, 0xc6, 0xe5, 0xff, 0xe9,
+	0x8c, 0x35, 0x23, 0xd4, 0xce, 0x8e, 0xba, 0x09, 0x2b, 0x32, 0xbc, 0xbf, 0xe1, 0x8c, 0xd6, 0x57,
+	0x54, 0xfe, 0xb2, 0x75, 0xe3, 0xaf, 0x0e, 0x6c, 0x4c, 0x93, 0x41, 0x8f, 0x61, 0x29, 0xa1, 0x1e,
+	0x67, 0xbe, 0x49, 0xb9, 0x59, 0x49, 0xfb, 0x28, 0x60, 0xa9, 0xd0, 0x69, 0x2e, 0x61, 0xb3, 0x42,
+	0x08, 0x16, 0x2f, 0x79, 0x1a, 0xab, 0x1c, 0x96, 0xb0, 0xfa, 0x8c, 0xb6, 0x61, 0xd5, 0x27, 0x63,
+	0x97, 0x0f, 0xdd, 0x11, 0x67, 0xe2, 0x52, 0xa5, 0xaa, 0x84, 0xc1, 0x27, 0xe3, 0xde, 0xf0, 0xa5,
+	0xb4, 0xa0, 0x0d, 0x28, 0xea, 0xad, 0xa2, 0xda, 0xd2, 0x0b, 0xb4, 0x05, 0x65, 0xc3, 0xbb, 0xa1,
+	0xf4, 0xaa, 0xbe, 0xa4, 0xf6, 0x4a, 0x8a, 0xf6, 0x8a, 0xd2, 0x2b, 0x54, 0x87, 0x65, 0xd9, 0x00,
+	0x94, 0x89, 0xfa, 0xb2, 0xda, 0xb3, 0xcb, 0xc6, 0x6f, 0x61, 0x63, 0x5a, 0xa8, 0x65, 0x95, 0xd9,
+	0x60, 0xd7, 0x9d, 0x59, 0x05, 0x93, 0x41, 0xd1, 0x1e, 0x14, 0xa3, 0x4b, 0x92, 0xd8, 0x92, 0x7e,
+	0x80, 0xa3, 0x71, 0x8d, 0x3f, 0x2e, 0x40, 0xd5, 0x1e, 0xa0, 0xe5, 0xc9, 0x1d, 0xf4, 0x4b, 0xa8,
+	0xea, 0xde, 0xb0, 0xdd, 0x66, 0x0e, 0xf0, 0xf1, 0x64, 0x5e, 0x49, 0x14, 0xe4, 0x53, 0xaa, 0xc9,
+	0xcd, 0x33, 0xc9, 0x7c, 0x65, 0x88, 0xda, 0x86, 0x2b, 0x49, 0xde, 0x88, 0x5e, 0xc1, 0x5a, 0x12,
+	0x5c, 0x30, 0x12, 0xde, 0x7e, 0x81, 0x3e, 0xed, 0x43, 0x85, 0xa3, 0x08, 0x77, 0x74, 0xab, 0xc9,
+	0x84, 0x55, 0x0a, 0x0f, 0x88, 0xf0, 0x2e, 0x5d, 0x1e, 0x51, 0xed, 0x65, 0xbd, 0x30, 0x4b, 0xf8,
+	0x40, 0x12, 0x7a, 0x16, 0x6f, 0x85, 0x07, 0x13, 0xd6, 0xc6, 0x7f, 0x64, 0xcd, 0x4d, 0x39, 0x01,
+	0x7a, 0x02, 0x65, 0xeb, 0x83, 0x1b, 0xd8, 0xc2, 0x03, 0x6b, 0xea, 0xfa, 0x12, 0x60, 0x7c, 0x65,
+	0x64, 0x64, 0x2b, 0x10, 0xb4, 0xe9, 0x84, 0x8c, 0x28, 0xfa, 0x0c, 0x56, 0x0d, 0x20, 0x60, 0x51,
+	0x2a, 0xcc, 0x81, 0xdf, 0x9f, 0x1a, 0xea, 0x53, 0x32, 0x0e, 0x39, 0xf1, 0xb1, 0x91, 0xec, 0x4a,
+	0xc2, 0x94, 0x6c, 0x2d, 0x7e, 0x95, 0xd9, 0x6a, 0xfc, 0x61, 0x01, 0x36, 0xa6, 0x05, 0x09, 0x7d,
+	0x09, 0xd5, 0x2c, 0xce, 0xae, 0x18, 0x47, 0x54, 0xb9, 0x5f, 0x7d, 0xfe, 0xd1, 0xec, 0xf6, 0x9f,
+	0xd4, 0xeb, 0x8f, 0x23, 0x8a, 0x2b, 0x3c, 0xbf, 0x94, 0x6d, 0xf6, 0xeb, 0x94, 0xc6, 0x63, 0x13,
+	0x31, 0xbd, 0x90, 0xad, 0x1c, 0x53, 0x92, 0x98, 0xbc, 0x96, 0xb0, 0x59, 0xdd, 0x8d, 0xf2, 0xe2,
+	0x3b, 0x51, 0xfe, 0xe0, 0x4e, 0x94, 0x75, 0xf3, 0x4e, 0xc4, 0xb1, 0x06, 0x85, 0x38, 0x4a, 0x54,
+	0xeb, 0x16, 0xb1, 0xfc, 0x88, 0xb6, 0xa1, 0xec, 0x71, 0xe6, 0xa5, 0x71, 0x4c, 0x99, 0x37, 0x56,
+	0x8d, 0x5b, 0xc4, 0x79, 0x53, 0xe3, 0x1f, 0x8b, 0x50, 0xb3, 0x3e, 0x9d, 0xf2, 0x30, 0xf0, 0x02,
+	0x9a, 0xa0, 0x9f, 0x42, 0x95, 0x5f, 0xd3, 0x38, 0x24, 0x91, 0x1b, 0x49, 0xdb, 0xd8, 0xc4, 0xe5,
+	0xe9, 0x83, 0x09, 0xe9, 0x69, 0x8a, 0x52, 0x19, 0xe3, 0x0a, 0xcf, 0x2f, 0x11, 0x86, 0x35, 0x4f,
+	0x15, 0x76, 0x9a, 0x69, 0x2e, 0xcc, 0xa1, 0xd9, 0x96, 0x9c, 0xf3, 0x4c, 0xd3, 0xcb, 0x2f, 0x51,
+	0x2b, 0xa7, 0x79, 0x13, 0x30, 0x9f, 0xdf, 0xd4, 0x0b, 0xb3, 0x66, 0x86, 0x95, 0x78, 0xa5, 0xf0,
+	0x68, 0x17, 0x6a, 0x11, 0x49, 0x13, 0xea, 0x72, 0xe6, 0x0e, 0x49, 0x10, 0xa6, 0xb1, 0x8e, 0xfd,
+	0x0a, 0xae, 0x2a, 0x7b, 0x8f, 0x1d, 0x69, 0xab, 0x8c, 0xff, 0x20, 0x1d, 0x0e, 0x69, 0xec, 0x86,
+	0xc1, 0x28, 0xd0, 0xf1, 0x2f, 0xe2, 0xb2, 0xb6, 0x1d, 0x4b, 0x13, 0x7a, 0x06, 0xeb, 0xb9, 0xd0,
+	0x1a, 0x9c, 0xce, 0x46, 0x2d, 0xb7, 0xa1, 0xc1, 0x3b, 0xb0, 0xa6, 0x00, 0xd4, 0x77, 0x89, 0xaa,
+	0xc6, 0x44, 0xa5, 0x67, 0x05, 0x57, 0x8d, 0x59, 0xd7, 0x68, 0x22, 0x55, 0x63, 0x3a, 0x22, 0x01,
+	0x0b, 0xd8, 0x45, 0x06, 0x95, 0x57, 0x4a, 0x01, 0xd7, 0xb2, 0x0d, 0x0b, 0x7e, 0x01, 0x9b, 0x77,
+	0xfd, 0x71, 0xc5, 0x65, 0x4c, 0x93, 0x4b, 0x1e, 0xfa, 0xf5, 0x92, 0x3a, 0xcb, 0x37, 0x26, 0x3d,
+	0xeb, 0xdb, 0x6d, 0xd4, 0x87, 0xf7, 0xde, 0x21, 0x7b, 0x9c, 0x87, 0x3e, 0xbf, 0x61, 0x75, 0x98,
+	0x15, 0xd9, 0xc7, 0x93, 0xb2, 0x6d, 0x43, 0x6c, 0xfc, 0xc5, 0x81, 0xf5, 0xac, 0xc2, 0x24, 0xa4,
+	0xcb, 0x86, 0x3c, 0xd7, 0x07, 0xce, 0x44, 0x1f, 0x7c, 0x0c, 0x25, 0xa5, 0xe3, 0xbb, 0x44, 0xcc,
+	0xf1, 0xa8, 0x59, 0xd1, 0xe0, 0x96, 0x40, 0xdf, 0xcc, 0x88, 0x83, 0xb1, 0xe9, 0x2d, 0xb3, 0x79,
+	0x30, 0x46, 0x47, 0xb0, 0x4e, 0x52, 0xc1, 0xdd, 0x94, 0x69, 0x07, 0xd5, 0xcb, 0x67, 0x71, 0xa6,
+	0xfa, 0x9a, 0x24, 0x9d, 0x6b, 0x8e, 0xb4, 0x36, 0x7e, 0xef, 0x40, 0x25, 0x7b, 0xad, 0x09, 0x22,
+	0xa8, 0xf4, 0x43, 0x7f, 0x8b, 0xf2, 0x63, 0x05, 0x9b, 0x15, 0xfa, 0x31, 0x80, 0xfe, 0xaa, 0x80,
+	0x0d, 0xb9, 0x71, 0xe4, 0xd9, 0xec, 0xb1, 0x92, 0x05, 0x08, 0x97, 0x22, 0xfb, 0x11, 0xbd, 0x0f,
+	0x25, 0x8f, 0x8f, 0xa2, 0x90, 0x0a, 0xea, 0x2b, 0xd7, 0x56, 0xf0, 0xad, 0xa1, 0xf1, 0xaf, 0x5c,
+	0x7c, 0x8f, 0x83, 0x44, 0x74, 0x98, 0x88, 0xc7, 0x6a, 0x9e, 0x18, 0x63, 0x6e, 0xac, 0x5b, 0x53,
+	0xd7, 0x47, 0x47, 0x50, 0xc9, 0xe6, 0xbe, 0x1a, 0x7d, 0xfa, 0x8c, 0x1f, 0x4c, 0x6d, 0x47, 0x3b,
+	0x4a, 0xd5, 0x9c, 0x5b, 0xbd, 0xc9, 0xad, 0xd0, 0x8f, 0xa0, 0x98, 0xc8, 0x48, 0x98, 0xd6, 0xdb,
+	0x99, 0xed, 0xa3, 0x0a, 0x1c, 0xd6, 0xac, 0x69, 0xcf, 0xdd, 0xc5, 0x69, 0xcf, 0xdd, 0xc6, 0x9f,
+	0x17, 0x6e, 0x9f, 0x19, 0x66, 0xcc, 0xd3, 0x24, 0x0d, 0x05, 0x6a, 0x41, 0xd5, 0xba, 0x65, 0x9e,
+	0xb4, 0xce, 0xcc, 0xc4, 0x56, 0x32, 0x86, 0xb4, 0xa1, 0x17, 0x50, 0x26, 0x9e, 0x48, 0x49, 0x38,
+	0xef, 0x5b, 0x1a, 0x34, 0x5c, 0x91, 0xcf, 0x01, 0x65, 0x81, 0xa4, 0xaf, 0xa9, 0x97, 0xe6, 0x6e,
+	0xed, 0xef, 0x3e, 0x18, 0xcd, 0x8e, 0x45, 0xe3, 0xf5, 0x9b, 0xbb, 0x26, 0xd4, 0x85, 0x65, 0x9e,
+	0x0a, 0x8f, 0x9b, 0x42, 0xad, 0x3e, 0xdf, 0x9b, 0x1d, 0x59, 0x1d, 0x97, 0x9e, 0xa6, 0x61, 0xcb,
+	0x6f, 0xfc, 0x6e, 0xe9, 0xf6, 0x37, 0x86, 0x2a, 0xa8, 0x4f, 0xa1, 0x12, 0x92, 0x44, 0xb8, 0x71,
+	0xca, 0xe6, 0x8d, 0x58, 0x59, 0x12, 0x70, 0xca, 0x94, 0xcb, 0x9f, 0x42, 0x85, 0xd1, 0xd7, 0x39,
+	0xfe, 0xec, 0x88, 0x95, 0x25, 0xc1, 0xf2, 0xbf, 0x05, 0x20, 0xb8, 0x20, 0xa1, 0x14, 0x48, 0x54,
+	0xa8, 0x0a, 0xb8, 0xa4, 0x2c, 0x38, 0x55, 0x43, 0xac, 0xec, 0xc5, 0x94, 0x88, 0xb9, 0xfb, 0x14,
+	0x34, 0x5c, 0x69, 0x1f, 0x42, 0x4d, 0xf9, 0x96, 0x46, 0x7e, 0xa6, 0x50, 0x9c, 0xa9, 0x50, 0x95,
+	0x9c, 0x73, 0x45, 0x51, 0x2a, 0x27, 0xb0, 0xce, 0xd9, 0x05, 0x97, 0x23, 0x77, 0x40, 0xbc, 0xab,
+	0x61, 0x10, 0x66, 0xbf, 0x38, 0xa6, 0x77, 0xc8, 0x81, 0x41, 0xa9, 0xde, 0xad, 0x19, 0xae, 0x35,
+	0x26, 0xb2, 0x1d, 0x47, 0x41, 0x22, 0xa7, 0x53, 0x9c, 0x9a, 0x49, 0x5f, 0xc0, 0xa0, 0x4d, 0xca,
+	0x67, 0x79, 0xbd, 0x5f, 0x05, 0x51, 0x64, 0x11, 0x7a, 0xc0, 0x97, 0x8d, 0x4d, 0x41, 0x9a, 0xf0,
+	0x48, 0xdf, 0x36, 0xd4, 0x77, 0x87, 0x81, 0x9a, 0xcd, 0x29, 0x13, 0x6a, 0xa8, 0x17, 0xf0, 0xba,
+	0xdd, 0x3a, 0x0a, 0xe4, 0xec, 0x4d, 0x99, 0x40, 0x1f, 0xc1, 0xe3, 0x38, 0x65, 0xea, 0xda, 0xc8,
+	0x0a, 0x54, 0x53, 0x40, 0x51, 0x36, 0xcc, 0xae, 0x2d, 0x47, 0xcd, 0x3a, 0x87, 0x6a, 0x4c, 0x3d,
+	0xca, 0x44, 0x76, 0xd7, 0x94, 0xe7, 0xfd, 0x49, 0x94, 0x6f, 0x4b, 0x5c, 0xd1, 0x2a, 0xf6, 0x62,
+	0xda, 0x87, 0x0d, 0x8f, 0xb3, 0x44, 0x55, 0xf7, 0x35, 0xb5, 0xd7, 0x4b, 0x52, 0x5f, 0x55, 0x57,
+	0xd2, 0xa3, 0xdc, 0x9e, 0xb9, 0x3f, 0x12, 0x74, 0x0a, 0xab, 0x2a, 0x93, 0xf6, 0x5e, 0xae, 0xa8,
+	0x2c, 0x7e, 0x7f, 0xf6, 0x39, 0x8c, 0x82, 0x4a, 0x85, 0xaa, 0x5b, 0x63, 0x68, 0xfc, 0x7d, 0x01,
+	0x1e, 0x4d, 0x01, 0xdd, 0xd3, 0xc2, 0xce, 0xff, 0xdb, 0xc2, 0x7d, 0x58, 0xf5, 0x42, 0x9e, 0x50,
+	0x57, 0x8e, 0xba, 0x34, 0x31, 0x0f, 0x9e, 0xfd, 0xf9, 0x04, 0xdb, 0x92, 0x79, 0xa6, 0x88, 0xb8,
+	0xec, 0xdd, 0x2e, 0xd0, 0x87, 0x50, 0xb5, 0x97, 0xf3, 0xc4, 0x4b, 0xb2, 0x62, 0xac, 0x58, 0x19,
+	0xd1, 0x67, 0x50, 0xe1, 0x83, 0x84, 0xc6, 0xd7, 0xd4, 0x9f, 0xb7, 0x8d, 0x56, 0x2d, 0xc1, 0xfc,
+	0xda, 0x9f, 0x9e, 0xb1, 0xe2, 0xbd, 0x19, 0x7b, 0xfa, 0xc6, 0x81, 0xcd, 0xfb, 0x1f, 0xc8, 0xe8,
+	0x7b, 0xf0, 0xe1, 0x59, 0xfb, 0x8b, 0xce, 0xe1, 0xf9, 0x71, 0xc7, 0x3d, 0x68, 0xf5, 0xdb, 0x5f,
+	0xb8, 0xbd, 0xd3, 0x0e, 0x6e, 0xf5, 0xbb, 0xbd, 0x13, 0xb7, 0xff, 0xf3, 0xd3, 0x8e, 0xdb, 0x3d,
+	0xf9, 0x59, 0xeb, 0xb8, 0x7b, 0x58, 0xfb, 0x1a, 0x7a, 0x06, 0x3b, 0x0f, 0x43, 0xfb, 0x1d, 0xfc,
+	0xb2, 0x7b, 0xd2, 0xea, 0x77, 0x6a, 0x0e, 0xda, 0x85, 0xef, 0x3c, 0x0c, 0x6e, 0xb7, 0x4e, 0xda,
+	0x9d, 0xe3, 0xda, 0xc2, 0x6c, 0xe4, 0x59, 0xf7, 0xf3, 0x93, 0xd6, 0x71, 0xad, 0xf0, 0xf4, 0x4f,
+	0x0e, 0x7c, 0x7d, 0xea, 0x58, 0x45, 0xdf, 0x86, 0x27, 0x99, 0x46, 0xab, 0xad, 0xa8, 0xbd, 0xf3,
+	0x7e, 0xbb, 0xf7, 0x32, 0x7f, 0xfe, 0x07, 0x40, 0x67, 0xfd, 0x16, 0xee, 0x77, 0x0e, 0x6b, 0xce,
+	0x83, 0xa0, 0x9f, 0x74, 0x4f, 0x4f, 0x3b, 0x87, 0xb5, 0x05, 0xd4, 0x80, 0xad, 0xfb, 0x40, 0x47,
+	0xad, 0xee, 0x71, 0xe7, 0xb0, 0x56, 0x38, 0xf8, 0xfc, 0xcd, 0xdb, 0x2d, 0xe7, 0x6f, 0x6f, 0xb7,
+	0x9c, 0x7f, 0xbe, 0xdd, 0x72, 0x7e, 0xf1, 0xc9, 0x45, 0x20, 0x2e, 0xd3, 0x41, 0xd3, 0xe3, 0xa3,
+	0xbd, 0x89, 0x7f, 0xa0, 0x9a, 0x17, 0x94, 0xe9, 0xff, 0xbc, 0xf2, 0x7f, 0xab, 0xbd, 0xb0, 0x9f,
+	0xaf, 0xf7, 0x07, 0x4b, 0x6a, 0xf7, 0x07, 0xff, 0x1d, 0x00, 0x79, 0x2b, 0xac, 0x82, 0x84, 0x13,
+	0x00, 0x00,
+}
+
+func (m *ScheduleSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Timezone) > 0 {
+		i -= len(m.Timezone)
+		copy(dAtA[i:], m.Timezone)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Timezone)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExcludeCalendars) > 0 {
+		for iNdEx := len(m.ExcludeCalendars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExcludeCalendars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Intervals) > 0 {
+		for iNdEx := len(m.Intervals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Intervals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Calendars) > 0 {
+		for iNdEx := len(m.Calendars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calendars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Jitter != nil {
+		{
+			size, err := m.Jitter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleCalendarSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleCalendarSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleCalendarSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DayOfWeek) > 0 {
+		i -= len(m.DayOfWeek)
+		copy(dAtA[i:], m.DayOfWeek)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.DayOfWeek)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Month) > 0 {
+		i -= len(m.Month)
+		copy(dAtA[i:], m.Month)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Month)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DayOfMonth) > 0 {
+		i -= len(m.DayOfMonth)
+		copy(dAtA[i:], m.DayOfMonth)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.DayOfMonth)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hour) > 0 {
+		i -= len(m.Hour)
+		copy(dAtA[i:], m.Hour)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Hour)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minute) > 0 {
+		i -= len(m.Minute)
+		copy(dAtA[i:], m.Minute)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Minute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Second) > 0 {
+		i -= len(m.Second)
+		copy(dAtA[i:], m.Second)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Second)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleIntervalSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleIntervalSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleIntervalSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Phase != nil {
+		{
+			size, err := m.Phase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BatchOperation != nil {
+		{
+			size, err := m.BatchOperation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SignalWorkflow != nil {
+		{
+			size, err := m.SignalWorkflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartWorkflow != nil {
+		{
+			size, err := m.StartWorkflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalWorkflowAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalWorkflowAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalWorkflowAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StartWorkflow != nil {
+		{
+			size, err := m.StartWorkflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SignalInput != nil {
+		{
+			size, err := m.SignalInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOperationAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOperationAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOperationAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Concurrency != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Concurrency))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Rps != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Rps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SignalInput) > 0 {
+		i -= len(m.SignalInput)
+		copy(dAtA[i:], m.SignalInput)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.SignalInput)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OperationType != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.OperationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PauseOnFailureCooldown != nil {
+		{
+			size, err := m.PauseOnFailureCooldown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PauseOnFailureThreshold != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.PauseOnFailureThreshold))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RemainingActions != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.RemainingActions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LimitedActions {
+		i--
+		if m.LimitedActions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ConcurrencyLimit != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ConcurrencyLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BufferLimit != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BufferLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PauseOnFailure {
+		i--
+		if m.PauseOnFailure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CatchUpWindow != nil {
+		{
+			size, err := m.CatchUpWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CatchUpPolicy != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.CatchUpPolicy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OverlapPolicy != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.OverlapPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePauseInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePauseInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePauseInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AutoUnpauseTime != nil {
+		{
+			size, err := m.AutoUnpauseTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PausedBy) > 0 {
+		i -= len(m.PausedBy)
+		copy(dAtA[i:], m.PausedBy)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.PausedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PausedAt != nil {
+		{
+			size, err := m.PausedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PauseInfo != nil {
+		{
+			size, err := m.PauseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleListEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleListEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleListEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleActionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleActionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleActionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActualTime != nil {
+		{
+			size, err := m.ActualTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduledTime != nil {
+		{
+			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastFailure != nil {
+		{
+			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.RecentActions) > 0 {
+		for iNdEx := len(m.RecentActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.RunningWorkflowCount != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.RunningWorkflowCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BufferedFireCount != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.BufferedFireCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SkippedRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.SkippedRuns))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MissedRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.MissedRuns))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.OngoingBackfills) > 0 {
+		for iNdEx := len(m.OngoingBackfills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OngoingBackfills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastUpdateTime != nil {
+		{
+			size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CreateTime != nil {
+		{
+			size, err := m.CreateTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalRuns != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.TotalRuns))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextRunTime != nil {
+		{
+			size, err := m.NextRunTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LastRunTime != nil {
+		{
+			size, err := m.LastRunTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleFailureInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleFailureInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleFailureInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ObservedTime != nil {
+		{
+			size, err := m.ObservedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CloseStatus != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.CloseStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduleSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Jitter != nil {
+		l = m.Jitter.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if len(m.Calendars) > 0 {
+		for _, e := range m.Calendars {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	if len(m.Intervals) > 0 {
+		for _, e := range m.Intervals {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	if len(m.ExcludeCalendars) > 0 {
+		for _, e := range m.ExcludeCalendars {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	l = len(m.Timezone)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleCalendarSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Second)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Minute)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Hour)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.DayOfMonth)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Month)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.DayOfWeek)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleIntervalSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Phase != nil {
+		l = m.Phase.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartWorkflow != nil {
+		l = m.StartWorkflow.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.SignalWorkflow != nil {
+		l = m.SignalWorkflow.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.BatchOperation != nil {
+		l = m.BatchOperation.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalWorkflowAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.SignalInput != nil {
+		l = m.SignalInput.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.StartWorkflow != nil {
+		l = m.StartWorkflow.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchOperationAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationType != 0 {
+		n += 1 + sovSchedule(uint64(m.OperationType))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.SignalInput)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Rps != 0 {
+		n += 1 + sovSchedule(uint64(m.Rps))
+	}
+	if m.Concurrency != 0 {
+		n += 1 + sovSchedule(uint64(m.Concurrency))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchedulePolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OverlapPolicy != 0 {
+		n += 1 + sovSchedule(uint64(m.OverlapPolicy))
+	}
+	if m.CatchUpPolicy != 0 {
+		n += 1 + sovSchedule(uint64(m.CatchUpPolicy))
+	}
+	if m.CatchUpWindow != nil {
+		l = m.CatchUpWindow.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.PauseOnFailure {
+		n += 2
+	}
+	if m.BufferLimit != 0 {
+		n += 1 + sovSchedule(uint64(m.BufferLimit))
+	}
+	if m.ConcurrencyLimit != 0 {
+		n += 1 + sovSchedule(uint64(m.ConcurrencyLimit))
+	}
+	if m.LimitedActions {
+		n += 2
+	}
+	if m.RemainingActions != 0 {
+		n += 1 + sovSchedule(uint64(m.RemainingActions))
+	}
+	if m.PauseOnFailureThreshold != 0 {
+		n += 1 + sovSchedule(uint64(m.PauseOnFailureThreshold))
+	}
+	if m.PauseOnFailureCooldown != nil {
+		l = m.PauseOnFailureCooldown.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchedulePauseInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.PausedAt != nil {
+		l = m.PausedAt.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.PausedBy)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.AutoUnpauseTime != nil {
+		l = m.AutoUnpauseTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if m.PauseInfo != nil {
+		l = m.PauseInfo.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleListEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleActionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.ActualTime != nil {
+		l = m.ActualTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovSchedule(uint64(m.Outcome))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRunTime != nil {
+		l = m.LastRunTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.NextRunTime != nil {
+		l = m.NextRunTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.TotalRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.TotalRuns))
+	}
+	if m.CreateTime != nil {
+		l = m.CreateTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.LastUpdateTime != nil {
+		l = m.LastUpdateTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if len(m.OngoingBackfills) > 0 {
+		for _, e := range m.OngoingBackfills {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	if m.MissedRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.MissedRuns))
+	}
+	if m.SkippedRuns != 0 {
+		n += 1 + sovSchedule(uint64(m.SkippedRuns))
+	}
+	if m.BufferedFireCount != 0 {
+		n += 1 + sovSchedule(uint64(m.BufferedFireCount))
+	}
+	if m.RunningWorkflowCount != 0 {
+		n += 1 + sovSchedule(uint64(m.RunningWorkflowCount))
+	}
+	if len(m.RecentActions) > 0 {
+		for _, e := range m.RecentActions {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovSchedule(uint64(m.ConsecutiveFailures))
+	}
+	if m.LastFailure != nil {
+		l = m.LastFailure.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleFailureInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.CloseStatus != 0 {
+		n += 1 + sovSchedule(uint64(m.CloseStatus))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.ObservedTime != nil {
+		l = m.ObservedTime.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovSchedule(uint64(m.ConsecutiveFailures))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduleSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Jitter == nil {
+				m.Jitter = &types.Duration{}
+			}
+			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calendars = append(m.Calendars, &ScheduleCalendarSpec{})
+			if err := m.Calendars[len(m.Calendars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intervals = append(m.Intervals, &ScheduleIntervalSpec{})
+			if err := m.Intervals[len(m.Intervals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeCalendars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeCalendars = append(m.ExcludeCalendars, &ScheduleCalendarSpec{})
+			if err := m.ExcludeCalendars[len(m.ExcludeCalendars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleCalendarSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleCalendarSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleCalendarSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Second = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hour = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayOfMonth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DayOfMonth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Month = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayOfWeek", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DayOfWeek = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleIntervalSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleIntervalSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleIntervalSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = &types.Duration{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Phase == nil {
+				m.Phase = &types.Duration{}
+			}
+			if err := m.Phase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartWorkflow == nil {
+				m.StartWorkflow = &v1.ScheduleAction_StartWorkflowAction{}
+			}
+			if err := m.StartWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalWorkflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignalWorkflow == nil {
+				m.SignalWorkflow = &SignalWorkflowAction{}
+			}
+			if err := m.SignalWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchOperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchOperation == nil {
+				m.BatchOperation = &BatchOperationAction{}
+			}
+			if err := m.BatchOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignalInput == nil {
+				m.SignalInput = &v1.Payload{}
+			}
+			if err := m.SignalInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartWorkflow == nil {
+				m.StartWorkflow = &v1.ScheduleAction_StartWorkflowAction{}
+			}
+			if err := m.StartWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOperationAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOperationAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOperationAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
+			}
+			m.OperationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationType |= ScheduleBatchOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalInput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalInput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rps", wireType)
+			}
+			m.Rps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rps |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapPolicy", wireType)
+			}
+			m.OverlapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapPolicy |= v1.ScheduleOverlapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpPolicy", wireType)
+			}
+			m.CatchUpPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpPolicy |= v1.ScheduleCatchUpPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CatchUpWindow == nil {
+				m.CatchUpWindow = &types.Duration{}
+			}
+			if err := m.CatchUpWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseOnFailure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseOnFailure = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferLimit", wireType)
+			}
+			m.BufferLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyLimit", wireType)
+			}
+			m.ConcurrencyLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcurrencyLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitedActions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LimitedActions = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingActions", wireType)
+			}
+			m.RemainingActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingActions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseOnFailureThreshold", wireType)
+			}
+			m.PauseOnFailureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseOnFailureThreshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseOnFailureCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseOnFailureCooldown == nil {
+				m.PauseOnFailureCooldown = &types.Duration{}
+			}
+			if err := m.PauseOnFailureCooldown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePauseInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePauseInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePauseInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PausedAt == nil {
+				m.PausedAt = &types.Timestamp{}
+			}
+			if err := m.PausedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnpauseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoUnpauseTime == nil {
+				m.AutoUnpauseTime = &types.Timestamp{}
+			}
+			if err := m.AutoUnpauseTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseInfo == nil {
+				m.PauseInfo = &SchedulePauseInfo{}
+			}
+			if err := m.PauseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleListEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleListEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleListEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &ScheduleState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleActionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleActionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleActionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualTime == nil {
+				m.ActualTime = &types.Timestamp{}
+			}
+			if err := m.ActualTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= ScheduleActionOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRunTime == nil {
+				m.LastRunTime = &types.Timestamp{}
+			}
+			if err := m.LastRunTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextRunTime == nil {
+				m.NextRunTime = &types.Timestamp{}
+			}
+			if err := m.NextRunTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRuns", wireType)
+			}
+			m.TotalRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRuns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateTime == nil {
+				m.CreateTime = &types.Timestamp{}
+			}
+			if err := m.CreateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = &types.Timestamp{}
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OngoingBackfills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OngoingBackfills = append(m.OngoingBackfills, &v1.BackfillInfo{})
+			if err := m.OngoingBackfills[len(m.OngoingBackfills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRuns", wireType)
+			}
+			m.MissedRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRuns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedRuns", wireType)
+			}
+			m.SkippedRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedRuns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedFireCount", wireType)
+			}
+			m.BufferedFireCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BufferedFireCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningWorkflowCount", wireType)
+			}
+			m.RunningWorkflowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunningWorkflowCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentActions = append(m.RecentActions, &ScheduleActionResult{})
+			if err := m.RecentActions[len(m.RecentActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFailure == nil {
+				m.LastFailure = &ScheduleFailureInfo{}
+			}
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleFailureInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleFailureInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleFailureInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseStatus", wireType)
+			}
+			m.CloseStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseStatus |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObservedTime == nil {
+				m.ObservedTime = &types.Timestamp{}
+			}
+			if err := m.ObservedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
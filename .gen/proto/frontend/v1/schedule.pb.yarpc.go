@@ -0,0 +1,591 @@
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/frontend/v1/schedule.proto
+
+package frontendv1
+
+var yarpcFileDescriptorClosure1937b58cf1f9e913 = [][]byte{
+	// uber/cadence/frontend/v1/schedule.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0x23, 0x49,
+		0x19, 0xc6, 0x71, 0x9c, 0xc4, 0xaf, 0x63, 0xc7, 0xa9, 0x09, 0x43, 0x6f, 0x58, 0x66, 0xb2, 0x86,
+		0x25, 0x61, 0x46, 0xd8, 0xca, 0xb0, 0x68, 0xb5, 0x1a, 0xb1, 0x2b, 0xc7, 0x71, 0x58, 0x43, 0x26,
+		0x0e, 0x15, 0x87, 0x11, 0x8c, 0x44, 0xab, 0xdc, 0x5d, 0x4e, 0x9a, 0xb4, 0xab, 0x9a, 0xee, 0xea,
+		0x24, 0xe6, 0xc0, 0x91, 0x3b, 0x47, 0xae, 0x1c, 0xf9, 0x05, 0xfc, 0x04, 0x90, 0xf8, 0x0b, 0x88,
+		0x0b, 0x7f, 0x80, 0x23, 0x37, 0x54, 0x5f, 0x9d, 0x76, 0xc6, 0x89, 0x2d, 0xd8, 0x9b, 0xeb, 0xad,
+		0xe7, 0x79, 0x5c, 0xef, 0x67, 0x95, 0x0d, 0xbb, 0xe9, 0x90, 0xc6, 0x2d, 0x8f, 0xf8, 0x94, 0x79,
+		0xb4, 0x35, 0x8a, 0x39, 0x13, 0x94, 0xf9, 0xad, 0xeb, 0xfd, 0x56, 0xe2, 0x5d, 0x52, 0x3f, 0x0d,
+		0x69, 0x33, 0x8a, 0xb9, 0xe0, 0xc8, 0x91, 0xc0, 0xa6, 0x01, 0x36, 0x2d, 0xb0, 0x79, 0xbd, 0xbf,
+		0xfd, 0xec, 0x82, 0xf3, 0x8b, 0x90, 0xb6, 0x14, 0x6e, 0x98, 0x8e, 0x5a, 0x7e, 0x1a, 0x13, 0x11,
+		0x70, 0xa6, 0x99, 0xdb, 0xcf, 0xef, 0xef, 0x8b, 0x60, 0x4c, 0x13, 0x41, 0xc6, 0x91, 0x01, 0xec,
+		0x4c, 0x9d, 0x81, 0x44, 0x81, 0xfc, 0x7a, 0x8f, 0x8f, 0xc7, 0x99, 0x44, 0x63, 0x16, 0x62, 0xfa,
+		0x80, 0xb3, 0x31, 0x37, 0x3c, 0xbe, 0x1a, 0x85, 0xfc, 0x46, 0x63, 0x1a, 0xff, 0x2e, 0xc2, 0xfa,
+		0x99, 0xa1, 0x9d, 0x45, 0xd4, 0x43, 0xbb, 0xb0, 0xe1, 0xc5, 0x9c, 0xb9, 0xf4, 0x36, 0x8a, 0x69,
+		0x92, 0x04, 0x9c, 0x39, 0x85, 0x9d, 0xc2, 0x5e, 0x19, 0xd7, 0xa4, 0xb9, 0x9b, 0x59, 0xd1, 0x67,
+		0x00, 0x89, 0x20, 0xb1, 0x70, 0xe5, 0xe1, 0x9d, 0xa5, 0x9d, 0xc2, 0x5e, 0xe5, 0xd5, 0x76, 0x53,
+		0x7b, 0xd6, 0xb4, 0x9e, 0x35, 0x07, 0xd6, 0x33, 0x5c, 0x56, 0x68, 0xb9, 0x46, 0x3f, 0x84, 0x35,
+		0xca, 0x7c, 0x4d, 0x2c, 0xce, 0x25, 0xae, 0x52, 0xe6, 0x2b, 0xda, 0x3e, 0xac, 0xfc, 0x3a, 0x10,
+		0x82, 0xc6, 0xce, 0xb2, 0x22, 0x7d, 0xf0, 0x1e, 0xe9, 0xd0, 0xc4, 0x19, 0x1b, 0x20, 0x3a, 0x86,
+		0xb2, 0x47, 0x42, 0xca, 0x7c, 0x12, 0x27, 0x4e, 0x69, 0xa7, 0xb8, 0x57, 0x79, 0xd5, 0x6c, 0x3e,
+		0x94, 0xb7, 0xa6, 0x0d, 0x44, 0xc7, 0x50, 0x64, 0x40, 0xf0, 0x9d, 0x80, 0x54, 0x0b, 0x98, 0xa0,
+		0xf1, 0x35, 0x09, 0x13, 0x67, 0x65, 0x51, 0xb5, 0x9e, 0xa1, 0x68, 0xb5, 0x4c, 0x00, 0xbd, 0x83,
+		0x4d, 0x7a, 0xeb, 0x85, 0xa9, 0x4f, 0xdd, 0xbb, 0x33, 0xae, 0xfe, 0x4f, 0x67, 0xac, 0x1b, 0xa1,
+		0x4e, 0x76, 0xd4, 0x6d, 0x58, 0x93, 0xe1, 0xfd, 0x2d, 0x67, 0xd4, 0x59, 0x53, 0xf9, 0xcb, 0xd6,
+		0x8d, 0xbf, 0x17, 0x60, 0x6b, 0x96, 0x0c, 0x7a, 0x0a, 0x2b, 0x09, 0xf5, 0x38, 0xf3, 0x4d, 0xca,
+		0xcd, 0x4a, 0xda, 0xc7, 0x01, 0x4b, 0x85, 0x4e, 0x73, 0x19, 0x9b, 0x15, 0x42, 0xb0, 0x7c, 0xc9,
+		0xd3, 0x58, 0xe5, 0xb0, 0x8c, 0xd5, 0x67, 0xb4, 0x03, 0xeb, 0x3e, 0x99, 0xb8, 0x7c, 0xe4, 0x8e,
+		0x39, 0x13, 0x97, 0x2a, 0x55, 0x65, 0x0c, 0x3e, 0x99, 0xf4, 0x47, 0x6f, 0xa4, 0x05, 0x6d, 0x41,
+		0x49, 0x6f, 0x95, 0xd4, 0x96, 0x5e, 0xa0, 0x67, 0x50, 0x31, 0xbc, 0x1b, 0x4a, 0xaf, 0x9c, 0x15,
+		0xb5, 0x57, 0x56, 0xb4, 0xb7, 0x94, 0x5e, 0x21, 0x07, 0x56, 0x65, 0x03, 0x50, 0x26, 0x9c, 0x55,
+		0xb5, 0x67, 0x97, 0x8d, 0xdf, 0xc1, 0xd6, 0xac, 0x50, 0xcb, 0x2a, 0xb3, 0xc1, 0x76, 0x0a, 0xf3,
+		0x0a, 0x26, 0x83, 0xa2, 0x16, 0x94, 0xa2, 0x4b, 0x92, 0xd8, 0x92, 0x7e, 0x84, 0xa3, 0x71, 0x8d,
+		0x3f, 0x2d, 0x41, 0xcd, 0x1e, 0xa0, 0xed, 0xc9, 0x1d, 0xf4, 0x2b, 0xa8, 0xe9, 0xde, 0xb0, 0xdd,
+		0x66, 0x0e, 0xf0, 0xe9, 0x74, 0x5e, 0x49, 0x14, 0xe4, 0x53, 0xaa, 0xc9, 0xcd, 0x33, 0xc9, 0x7c,
+		0x6b, 0x88, 0xda, 0x86, 0xab, 0x49, 0xde, 0x88, 0xde, 0xc2, 0x46, 0x12, 0x5c, 0x30, 0x12, 0xde,
+		0x7d, 0x81, 0x3e, 0xed, 0x63, 0x85, 0xa3, 0x08, 0xf7, 0x74, 0x6b, 0xc9, 0x94, 0x55, 0x0a, 0x0f,
+		0x89, 0xf0, 0x2e, 0x5d, 0x1e, 0x51, 0xed, 0xa5, 0x53, 0x9c, 0x27, 0x7c, 0x20, 0x09, 0x7d, 0x8b,
+		0xb7, 0xc2, 0xc3, 0x29, 0x6b, 0xe3, 0x3f, 0xb2, 0xe6, 0x66, 0x9c, 0x00, 0x3d, 0x87, 0x8a, 0xf5,
+		0xc1, 0x0d, 0x6c, 0xe1, 0x81, 0x35, 0xf5, 0x7c, 0x09, 0x30, 0xbe, 0x32, 0x32, 0xb6, 0x15, 0x08,
+		0xda, 0x74, 0x42, 0xc6, 0x14, 0x7d, 0x01, 0xeb, 0x06, 0x10, 0xb0, 0x28, 0x15, 0xe6, 0xc0, 0x1f,
+		0xce, 0x0c, 0xf5, 0x29, 0x99, 0x84, 0x9c, 0xf8, 0xd8, 0x48, 0xf6, 0x24, 0x61, 0x46, 0xb6, 0x96,
+		0xbf, 0xca, 0x6c, 0x35, 0xfe, 0xb8, 0x04, 0x5b, 0xb3, 0x82, 0x84, 0xde, 0x41, 0x2d, 0x8b, 0xb3,
+		0x2b, 0x26, 0x11, 0x55, 0xee, 0xd7, 0x5e, 0x7d, 0x32, 0xbf, 0xfd, 0xa7, 0xf5, 0x06, 0x93, 0x88,
+		0xe2, 0x2a, 0xcf, 0x2f, 0x65, 0x9b, 0xfd, 0x26, 0xa5, 0xf1, 0xc4, 0x44, 0x4c, 0x2f, 0x64, 0x2b,
+		0xc7, 0x94, 0x24, 0x26, 0xaf, 0x65, 0x6c, 0x56, 0xf7, 0xa3, 0xbc, 0xfc, 0x5e, 0x94, 0x3f, 0xba,
+		0x17, 0x65, 0xdd, 0xbc, 0x53, 0x71, 0xac, 0x43, 0x31, 0x8e, 0x12, 0xd5, 0xba, 0x25, 0x2c, 0x3f,
+		0xa2, 0x1d, 0xa8, 0x78, 0x9c, 0x79, 0x69, 0x1c, 0x53, 0xe6, 0x4d, 0x54, 0xe3, 0x96, 0x70, 0xde,
+		0xd4, 0xf8, 0xe7, 0x32, 0xd4, 0xad, 0x4f, 0xa7, 0x3c, 0x0c, 0xbc, 0x80, 0x26, 0xe8, 0x67, 0x50,
+		0xe3, 0xd7, 0x34, 0x0e, 0x49, 0xe4, 0x46, 0xd2, 0x36, 0x31, 0x71, 0x79, 0xf1, 0x68, 0x42, 0xfa,
+		0x9a, 0xa2, 0x54, 0x26, 0xb8, 0xca, 0xf3, 0x4b, 0x84, 0x61, 0xc3, 0x53, 0x85, 0x9d, 0x66, 0x9a,
+		0x4b, 0x0b, 0x68, 0x76, 0x24, 0xe7, 0x3c, 0xd3, 0xf4, 0xf2, 0x4b, 0xd4, 0xce, 0x69, 0xde, 0x04,
+		0xcc, 0xe7, 0x37, 0x4e, 0x71, 0xde, 0xcc, 0xb0, 0x12, 0x6f, 0x15, 0x1e, 0xed, 0x41, 0x3d, 0x22,
+		0x69, 0x42, 0x5d, 0xce, 0xdc, 0x11, 0x09, 0xc2, 0x34, 0xd6, 0xb1, 0x5f, 0xc3, 0x35, 0x65, 0xef,
+		0xb3, 0x23, 0x6d, 0x95, 0xf1, 0x1f, 0xa6, 0xa3, 0x11, 0x8d, 0xdd, 0x30, 0x18, 0x07, 0x3a, 0xfe,
+		0x25, 0x5c, 0xd1, 0xb6, 0x63, 0x69, 0x42, 0x2f, 0x61, 0x33, 0x17, 0x5a, 0x83, 0xd3, 0xd9, 0xa8,
+		0xe7, 0x36, 0x34, 0x78, 0x17, 0x36, 0x14, 0x80, 0xfa, 0x2e, 0x51, 0xd5, 0x98, 0xa8, 0xf4, 0xac,
+		0xe1, 0x9a, 0x31, 0xeb, 0x1a, 0x4d, 0xa4, 0x6a, 0x4c, 0xc7, 0x24, 0x60, 0x01, 0xbb, 0xc8, 0xa0,
+		0xf2, 0x4a, 0x29, 0xe2, 0x7a, 0xb6, 0x61, 0xc1, 0xaf, 0x61, 0xfb, 0xbe, 0x3f, 0xae, 0xb8, 0x8c,
+		0x69, 0x72, 0xc9, 0x43, 0xdf, 0x29, 0xab, 0xb3, 0x7c, 0x63, 0xda, 0xb3, 0x81, 0xdd, 0x46, 0x03,
+		0xf8, 0xe0, 0x3d, 0xb2, 0xc7, 0x79, 0xe8, 0xf3, 0x1b, 0xe6, 0xc0, 0xbc, 0xc8, 0x3e, 0x9d, 0x96,
+		0xed, 0x18, 0x62, 0xe3, 0x6f, 0x05, 0xd8, 0xcc, 0x2a, 0x4c, 0x42, 0x7a, 0x6c, 0xc4, 0x73, 0x7d,
+		0x50, 0x98, 0xea, 0x83, 0x4f, 0xa1, 0xac, 0x74, 0x7c, 0x97, 0x88, 0x05, 0x1e, 0x35, 0x6b, 0x1a,
+		0xdc, 0x16, 0xe8, 0x9b, 0x19, 0x71, 0x38, 0x31, 0xbd, 0x65, 0x36, 0x0f, 0x26, 0xe8, 0x08, 0x36,
+		0x49, 0x2a, 0xb8, 0x9b, 0x32, 0xed, 0xa0, 0x7a, 0xf9, 0x2c, 0xcf, 0x55, 0xdf, 0x90, 0xa4, 0x73,
+		0xcd, 0x91, 0xd6, 0xc6, 0x1f, 0x0a, 0x50, 0xcd, 0x5e, 0x6b, 0x82, 0x08, 0x2a, 0xfd, 0xd0, 0xdf,
+		0xa2, 0xfc, 0x58, 0xc3, 0x66, 0x85, 0x7e, 0x02, 0xa0, 0xbf, 0x2a, 0x60, 0x23, 0x6e, 0x1c, 0x79,
+		0x39, 0x7f, 0xac, 0x64, 0x01, 0xc2, 0xe5, 0xc8, 0x7e, 0x44, 0x1f, 0x42, 0xd9, 0xe3, 0xe3, 0x28,
+		0xa4, 0x82, 0xfa, 0xca, 0xb5, 0x35, 0x7c, 0x67, 0x68, 0xfc, 0x2b, 0x17, 0xdf, 0xe3, 0x20, 0x11,
+		0x5d, 0x26, 0xe2, 0x89, 0x9a, 0x27, 0xc6, 0x98, 0x1b, 0xeb, 0xd6, 0xd4, 0xf3, 0xd1, 0x11, 0x54,
+		0xb3, 0xb9, 0xaf, 0x46, 0x9f, 0x3e, 0xe3, 0x47, 0x33, 0xdb, 0xd1, 0x8e, 0x52, 0x35, 0xe7, 0xd6,
+		0x6f, 0x72, 0x2b, 0xf4, 0x23, 0x28, 0x25, 0x32, 0x12, 0xa6, 0xf5, 0x76, 0xe7, 0xfb, 0xa8, 0x02,
+		0x87, 0x35, 0x6b, 0xd6, 0x73, 0x77, 0x79, 0xd6, 0x73, 0xb7, 0xf1, 0x97, 0xa5, 0xbb, 0x67, 0x86,
+		0x19, 0xf3, 0x34, 0x49, 0x43, 0x81, 0xda, 0x50, 0xb3, 0x6e, 0x99, 0x27, 0x6d, 0x61, 0x6e, 0x62,
+		0xab, 0x19, 0x43, 0xda, 0xd0, 0x6b, 0xa8, 0x10, 0x4f, 0xa4, 0x24, 0x5c, 0xf4, 0x2d, 0x0d, 0x1a,
+		0xae, 0xc8, 0xe7, 0x80, 0xb2, 0x40, 0xd2, 0x5b, 0xea, 0xa5, 0xb9, 0x5b, 0xfb, 0xbb, 0x8f, 0x46,
+		0xb3, 0x6b, 0xd1, 0x78, 0xf3, 0xe6, 0xbe, 0x09, 0xf5, 0x60, 0x95, 0xa7, 0xc2, 0xe3, 0xa6, 0x50,
+		0x6b, 0xaf, 0x5a, 0xf3, 0x23, 0xab, 0xe3, 0xd2, 0xd7, 0x34, 0x6c, 0xf9, 0x8d, 0xdf, 0xaf, 0xdc,
+		0xfd, 0xc6, 0x50, 0x05, 0xf5, 0x39, 0x54, 0x43, 0x92, 0x08, 0x37, 0x4e, 0xd9, 0xa2, 0x11, 0xab,
+		0x48, 0x02, 0x4e, 0x99, 0x72, 0xf9, 0x73, 0xa8, 0x32, 0x7a, 0x9b, 0xe3, 0xcf, 0x8f, 0x58, 0x45,
+		0x12, 0x2c, 0xff, 0x5b, 0x00, 0x82, 0x0b, 0x12, 0x4a, 0x81, 0x44, 0x85, 0xaa, 0x88, 0xcb, 0xca,
+		0x82, 0x53, 0x35, 0xc4, 0x2a, 0x5e, 0x4c, 0x89, 0x58, 0xb8, 0x4f, 0x41, 0xc3, 0x95, 0xf6, 0x21,
+		0xd4, 0x95, 0x6f, 0x69, 0xe4, 0x67, 0x0a, 0xa5, 0xb9, 0x0a, 0x35, 0xc9, 0x39, 0x57, 0x14, 0xa5,
+		0x72, 0x02, 0x9b, 0x9c, 0x5d, 0x70, 0x39, 0x72, 0x87, 0xc4, 0xbb, 0x1a, 0x05, 0x61, 0xf6, 0x8b,
+		0x63, 0x76, 0x87, 0x1c, 0x18, 0x94, 0xea, 0xdd, 0xba, 0xe1, 0x5a, 0x63, 0x22, 0xdb, 0x71, 0x1c,
+		0x24, 0x72, 0x3a, 0xc5, 0xa9, 0x99, 0xf4, 0x45, 0x0c, 0xda, 0xa4, 0x7c, 0x96, 0xd7, 0xfb, 0x55,
+		0x10, 0x45, 0x16, 0xa1, 0x07, 0x7c, 0xc5, 0xd8, 0x14, 0xa4, 0x09, 0x4f, 0xf4, 0x6d, 0x43, 0x7d,
+		0x77, 0x14, 0xa8, 0xd9, 0x9c, 0x32, 0xa1, 0x86, 0x7a, 0x11, 0x6f, 0xda, 0xad, 0xa3, 0x40, 0xce,
+		0xde, 0x94, 0x09, 0xf4, 0x09, 0x3c, 0x8d, 0x53, 0xa6, 0xae, 0x8d, 0xac, 0x40, 0x35, 0x05, 0x14,
+		0x65, 0xcb, 0xec, 0xda, 0x72, 0xd4, 0xac, 0x73, 0xa8, 0xc5, 0xd4, 0xa3, 0x4c, 0x64, 0x77, 0x4d,
+		0x65, 0xd1, 0x9f, 0x44, 0xf9, 0xb6, 0xc4, 0x55, 0xad, 0x62, 0x2f, 0xa6, 0x7d, 0xd8, 0xf2, 0x38,
+		0x4b, 0x54, 0x75, 0x5f, 0x53, 0x7b, 0xbd, 0x24, 0xce, 0xba, 0xba, 0x92, 0x9e, 0xe4, 0xf6, 0xcc,
+		0xfd, 0x91, 0xa0, 0x53, 0x58, 0x57, 0x99, 0xb4, 0xf7, 0x72, 0x55, 0x65, 0xf1, 0xfb, 0xf3, 0xcf,
+		0x61, 0x14, 0x54, 0x2a, 0x54, 0xdd, 0x1a, 0x43, 0xe3, 0x1f, 0x4b, 0xf0, 0x64, 0x06, 0xe8, 0x81,
+		0x16, 0x2e, 0xfc, 0xbf, 0x2d, 0x3c, 0x80, 0x75, 0x2f, 0xe4, 0x09, 0x75, 0xe5, 0xa8, 0x4b, 0x13,
+		0xf3, 0xe0, 0xd9, 0x5f, 0x4c, 0xb0, 0x23, 0x99, 0x67, 0x8a, 0x88, 0x2b, 0xde, 0xdd, 0x02, 0x7d,
+		0x0c, 0x35, 0x7b, 0x39, 0x4f, 0xbd, 0x24, 0xab, 0xc6, 0x8a, 0x95, 0x11, 0x7d, 0x01, 0x55, 0x3e,
+		0x4c, 0x68, 0x7c, 0x4d, 0xfd, 0x45, 0xdb, 0x68, 0xdd, 0x12, 0xcc, 0xaf, 0xfd, 0xd9, 0x19, 0x2b,
+		0x3d, 0x98, 0xb1, 0x17, 0x7f, 0x2d, 0xc0, 0xf6, 0xc3, 0x0f, 0x64, 0xf4, 0x3d, 0xf8, 0xf8, 0xac,
+		0xf3, 0x65, 0xf7, 0xf0, 0xfc, 0xb8, 0xeb, 0x1e, 0xb4, 0x07, 0x9d, 0x2f, 0xdd, 0xfe, 0x69, 0x17,
+		0xb7, 0x07, 0xbd, 0xfe, 0x89, 0x3b, 0xf8, 0xc5, 0x69, 0xd7, 0xed, 0x9d, 0xfc, 0xbc, 0x7d, 0xdc,
+		0x3b, 0xac, 0x7f, 0x0d, 0xbd, 0x84, 0xdd, 0xc7, 0xa1, 0x83, 0x2e, 0x7e, 0xd3, 0x3b, 0x69, 0x0f,
+		0xba, 0xf5, 0x02, 0xda, 0x83, 0xef, 0x3c, 0x0e, 0xee, 0xb4, 0x4f, 0x3a, 0xdd, 0xe3, 0xfa, 0xd2,
+		0x7c, 0xe4, 0x59, 0xef, 0xc7, 0x27, 0xed, 0xe3, 0x7a, 0xf1, 0xc5, 0x9f, 0x0b, 0xf0, 0xf5, 0x99,
+		0x63, 0x15, 0x7d, 0x1b, 0x9e, 0x67, 0x1a, 0xed, 0x8e, 0xa2, 0xf6, 0xcf, 0x07, 0x9d, 0xfe, 0x9b,
+		0xfc, 0xf9, 0x1f, 0x01, 0x9d, 0x0d, 0xda, 0x78, 0xd0, 0x3d, 0xac, 0x17, 0x1e, 0x05, 0xfd, 0xb4,
+		0x77, 0x7a, 0xda, 0x3d, 0xac, 0x2f, 0xa1, 0x06, 0x3c, 0x7b, 0x08, 0x74, 0xd4, 0xee, 0x1d, 0x77,
+		0x0f, 0xeb, 0xc5, 0x83, 0xd7, 0xbf, 0xfc, 0xec, 0x22, 0x10, 0x97, 0xe9, 0xb0, 0xe9, 0xf1, 0x71,
+		0x6b, 0xea, 0x5f, 0xa7, 0xe6, 0x05, 0x65, 0xfa, 0x7f, 0xae, 0xfc, 0x5f, 0x69, 0xaf, 0xed, 0xe7,
+		0xeb, 0xfd, 0xe1, 0x8a, 0xda, 0xfd, 0xc1, 0x7f, 0x07, 0x00, 0x64, 0xa2, 0x2b, 0x43, 0x78, 0x13,
+		0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0x54, 0x7f, 0xe3, 0xa1, 0x1c,
+		0xc3, 0x87, 0x87, 0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
+		0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31, 0x7c, 0x78, 0x24, 0xc7, 0xb8, 0xe2,
+		0xb1, 0x1c, 0xe3, 0x89, 0xc7, 0x72, 0x8c, 0x5c, 0xc2, 0xc9, 0xf9, 0xb9, 0x7a, 0x68, 0x56, 0x3b,
+		0xf1, 0xc2, 0x2c, 0x0e, 0x00, 0x89, 0x04, 0x30, 0x46, 0xb1, 0x96, 0x54, 0x16, 0xa4, 0x16, 0xff,
+		0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x25, 0x00,
+		0xaa, 0x45, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x3b, 0x2f, 0xbf, 0x3c, 0x2f, 0x04, 0xa4, 0x32, 0x89,
+		0x0d, 0x6c, 0x96, 0x31, 0x60, 0x00, 0x8a, 0x1c, 0x64, 0x4e, 0xf6, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x03, 0xe3, 0x8d,
+		0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca, 0x31, 0xae, 0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3,
+		0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0xbe, 0x78, 0x24, 0xc7, 0xf0, 0xe1, 0x91, 0x1c,
+		0xe3, 0x8a, 0xc7, 0x72, 0x8c, 0x27, 0x1e, 0xcb, 0x31, 0x72, 0x09, 0x27, 0xe7, 0xe7, 0xea, 0xa1,
+		0x59, 0xee, 0xc4, 0x07, 0xb7, 0x3a, 0x00, 0x24, 0x14, 0xc0, 0x18, 0xc5, 0x5a, 0x52, 0x59, 0x90,
+		0x5a, 0xfc, 0x83, 0x91, 0x71, 0x11, 0x13, 0xb3, 0x7b, 0x80, 0xd3, 0x2a, 0x26, 0x39, 0x77, 0x88,
+		0x9e, 0x00, 0xa8, 0x1e, 0xbd, 0xf0, 0xd4, 0x9c, 0x1c, 0xef, 0xbc, 0xfc, 0xf2, 0xbc, 0x10, 0x90,
+		0xca, 0x24, 0x36, 0xb0, 0x61, 0xc6, 0x80, 0x01, 0x00, 0x0b, 0x23, 0x83, 0xdd, 0xfa, 0x00, 0x00,
+		0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5d, 0x73, 0xdb, 0x44,
+		0x17, 0x7e, 0x65, 0xd7, 0xf9, 0x38, 0x4e, 0x13, 0x65, 0xd3, 0x26, 0x4e, 0xda, 0xe4, 0x4d, 0xcd,
+		0x47, 0x43, 0x06, 0xec, 0x89, 0x7b, 0xd3, 0x01, 0x0a, 0xb8, 0xfe, 0x48, 0xd4, 0x1a, 0xdb, 0xac,
+		0xdd, 0x86, 0x72, 0x51, 0xcd, 0x5a, 0x5a, 0xbb, 0x4b, 0x64, 0xad, 0x90, 0x56, 0x6e, 0xdc, 0x0b,
+		0x86, 0x1b, 0xfe, 0x06, 0x17, 0x5c, 0xf3, 0x43, 0x98, 0xe1, 0x86, 0x19, 0xfe, 0x00, 0xd3, 0x5f,
+		0xc2, 0xac, 0xb4, 0x8a, 0xed, 0xc4, 0xa5, 0x5c, 0x30, 0xdc, 0x69, 0xcf, 0xf3, 0x9c, 0x73, 0x9e,
+		0x3d, 0xda, 0x73, 0x76, 0x61, 0x3f, 0xec, 0x51, 0xbf, 0x68, 0x11, 0x9b, 0xba, 0x16, 0x2d, 0x12,
+		0x8f, 0x15, 0x47, 0x47, 0x45, 0x8b, 0x0f, 0x87, 0xdc, 0x2d, 0x78, 0x3e, 0x17, 0x1c, 0x6d, 0x48,
+		0x46, 0x41, 0x31, 0x0a, 0xc4, 0x63, 0x85, 0xd1, 0xd1, 0xce, 0xde, 0x80, 0xf3, 0x81, 0x43, 0x8b,
+		0x11, 0xa5, 0x17, 0xf6, 0x8b, 0x76, 0xe8, 0x13, 0xc1, 0x12, 0xa7, 0xfc, 0x63, 0x58, 0x3f, 0xe5,
+		0xfe, 0x59, 0xdf, 0xe1, 0x2f, 0x6b, 0xe7, 0xd4, 0x0a, 0x25, 0x84, 0xfe, 0x0f, 0xd9, 0x97, 0xca,
+		0x68, 0x32, 0x3b, 0xa7, 0xed, 0x6b, 0x07, 0xcb, 0x18, 0x12, 0x93, 0x61, 0xa3, 0x9b, 0xb0, 0xe0,
+		0x87, 0xae, 0xc4, 0x52, 0x11, 0x96, 0xf1, 0x43, 0xd7, 0xb0, 0xf3, 0x79, 0x58, 0x49, 0x82, 0x75,
+		0xc7, 0x1e, 0x45, 0x08, 0xae, 0xb9, 0x64, 0x48, 0x55, 0x80, 0xe8, 0x5b, 0x72, 0xca, 0x96, 0x60,
+		0x23, 0x26, 0xc6, 0x6f, 0xe4, 0xec, 0xc2, 0x62, 0x9b, 0x8c, 0x1d, 0x4e, 0x6c, 0x09, 0xdb, 0x44,
+		0x90, 0x08, 0x5e, 0xc1, 0xd1, 0x77, 0xfe, 0x15, 0x2c, 0xd6, 0x09, 0x73, 0x42, 0x9f, 0xa2, 0x4d,
+		0x58, 0xf0, 0x29, 0x09, 0xb8, 0xab, 0xfc, 0xd5, 0x0a, 0xe5, 0x60, 0xd1, 0xa6, 0x82, 0x30, 0x27,
+		0x88, 0x14, 0xae, 0xe0, 0x64, 0x89, 0x1e, 0xc0, 0x22, 0xf7, 0xe4, 0x2e, 0x83, 0x5c, 0x7a, 0x5f,
+		0x3b, 0xc8, 0x96, 0xde, 0x29, 0xcc, 0xa9, 0x5b, 0x41, 0x25, 0x68, 0xc5, 0x54, 0x9c, 0xf8, 0xe4,
+		0x7f, 0xd1, 0x60, 0x75, 0x16, 0x43, 0x2d, 0xd0, 0xfb, 0xb1, 0xc5, 0xb4, 0x88, 0xa0, 0x03, 0xee,
+		0x8f, 0x23, 0x35, 0xab, 0xa5, 0x77, 0xff, 0x2e, 0x74, 0x45, 0x71, 0xf1, 0x5a, 0x7f, 0xd6, 0x80,
+		0x0c, 0xd8, 0x70, 0xe9, 0xb9, 0x30, 0x7d, 0x2a, 0xfc, 0xb1, 0xc9, 0x5c, 0x41, 0xfd, 0x11, 0x71,
+		0xa2, 0x8d, 0x64, 0x4b, 0xdb, 0x85, 0xf8, 0x8f, 0x16, 0x92, 0x3f, 0x5a, 0xa8, 0xaa, 0x3f, 0x8a,
+		0xd7, 0xa5, 0x17, 0x96, 0x4e, 0x86, 0xf2, 0xc9, 0xff, 0xa4, 0xc1, 0xb5, 0x2f, 0xe9, 0x90, 0xa3,
+		0x07, 0xb0, 0xd0, 0x67, 0xd4, 0xb1, 0x83, 0x9c, 0xb6, 0x9f, 0x3e, 0xc8, 0x96, 0xde, 0x9b, 0x2b,
+		0x4d, 0x52, 0x0b, 0xf5, 0x88, 0x57, 0x73, 0x85, 0x3f, 0xc6, 0xca, 0x69, 0xe7, 0x14, 0xb2, 0x53,
+		0x66, 0xa4, 0x43, 0xfa, 0x8c, 0x8e, 0x55, 0xcd, 0xe5, 0x27, 0x2a, 0x41, 0x66, 0x44, 0x9c, 0x90,
+		0x2a, 0x95, 0xb7, 0xe7, 0x86, 0x57, 0x3f, 0x15, 0xc7, 0xd4, 0x8f, 0x53, 0xf7, 0xb5, 0xfc, 0xcf,
+		0x1a, 0x2c, 0x9c, 0x50, 0x62, 0x53, 0x1f, 0x7d, 0x7e, 0x49, 0xe2, 0xdd, 0xb9, 0x31, 0x62, 0xf2,
+		0x7f, 0x2b, 0xf2, 0x0f, 0x0d, 0xf4, 0x0e, 0x25, 0xbe, 0xf5, 0xa2, 0x2c, 0x84, 0xcf, 0x7a, 0xa1,
+		0xa0, 0x01, 0x32, 0x61, 0x95, 0xb9, 0x36, 0x3d, 0xa7, 0xb6, 0x39, 0x23, 0xfb, 0xfe, 0xdc, 0xa8,
+		0x97, 0xdd, 0x0b, 0x46, 0xec, 0x3b, 0xbd, 0x8f, 0xeb, 0x6c, 0xda, 0xb6, 0xf3, 0x1c, 0xd0, 0x55,
+		0xd2, 0xbf, 0xb8, 0xab, 0x3e, 0x2c, 0x55, 0x89, 0x20, 0x0f, 0x1d, 0xde, 0x43, 0x75, 0xb8, 0x4e,
+		0x5d, 0x8b, 0xdb, 0xcc, 0x1d, 0x98, 0x62, 0xec, 0x51, 0x75, 0x80, 0xef, 0xcc, 0x8d, 0x55, 0x53,
+		0x4c, 0xd9, 0xbf, 0x78, 0x85, 0x4e, 0xad, 0x2e, 0xda, 0x35, 0x35, 0xd5, 0xae, 0xed, 0x78, 0xc4,
+		0x50, 0xff, 0x29, 0xf5, 0x03, 0xc6, 0x5d, 0xc3, 0xed, 0x73, 0x49, 0x64, 0x43, 0xcf, 0x49, 0xda,
+		0x5e, 0x7e, 0xa3, 0xbb, 0xb0, 0xd6, 0xa7, 0x44, 0xc8, 0x46, 0x1a, 0xc5, 0x54, 0x35, 0x5e, 0x56,
+		0x95, 0x59, 0x05, 0xc8, 0x3f, 0x86, 0xad, 0x4e, 0xe8, 0x79, 0xdc, 0x17, 0xd4, 0xae, 0x38, 0x8c,
+		0xba, 0x42, 0x21, 0x81, 0x9c, 0x4c, 0x03, 0x6e, 0x06, 0xf6, 0x99, 0x8a, 0x9c, 0x19, 0xf0, 0x8e,
+		0x7d, 0x86, 0xb6, 0x61, 0xe9, 0x5b, 0x32, 0x22, 0x11, 0x10, 0xc7, 0x5c, 0x94, 0xeb, 0x8e, 0x7d,
+		0x96, 0xff, 0x21, 0x0d, 0xd9, 0xa8, 0x69, 0xda, 0xdc, 0x61, 0xd6, 0x18, 0x55, 0x41, 0x67, 0x2e,
+		0x13, 0x8c, 0x38, 0x93, 0xd6, 0xd3, 0xde, 0xd6, 0x7a, 0x6b, 0xca, 0x25, 0x69, 0x3c, 0x54, 0x84,
+		0x8d, 0x1e, 0xb1, 0xce, 0x78, 0xbf, 0x6f, 0x5a, 0x9c, 0xf6, 0xfb, 0xcc, 0x92, 0x32, 0xa3, 0xdc,
+		0x1a, 0x46, 0x0a, 0xaa, 0x4c, 0x10, 0x99, 0x76, 0x48, 0xce, 0xd9, 0x30, 0x1c, 0x4e, 0xd2, 0xa6,
+		0xdf, 0x9a, 0x56, 0xb9, 0x5c, 0xa4, 0xfd, 0x60, 0x12, 0x85, 0x08, 0x41, 0x87, 0x9e, 0x08, 0x72,
+		0xd7, 0xf6, 0xb5, 0x83, 0xcc, 0x05, 0xb5, 0xac, 0xcc, 0xe8, 0x01, 0xdc, 0x72, 0xb9, 0x1b, 0x0f,
+		0x19, 0xd2, 0x73, 0xa8, 0x49, 0x7d, 0x9f, 0xfb, 0x66, 0x3c, 0x40, 0x83, 0x5c, 0x66, 0x3f, 0x7d,
+		0xb0, 0x8c, 0x73, 0x2e, 0x77, 0x71, 0xc2, 0xa8, 0x49, 0x02, 0x8e, 0x71, 0xf4, 0x08, 0x36, 0xe8,
+		0xb9, 0xc7, 0x62, 0x21, 0x13, 0xc9, 0x0b, 0x6f, 0x93, 0x8c, 0x26, 0x5e, 0x17, 0x53, 0x6a, 0x08,
+		0x5b, 0x46, 0xc0, 0x9d, 0xc8, 0x78, 0xec, 0xf3, 0xd0, 0x6b, 0x13, 0x5f, 0x30, 0xb9, 0x9a, 0x77,
+		0x3d, 0xa0, 0xcf, 0x20, 0x13, 0x08, 0x22, 0xe2, 0x03, 0xbf, 0x5a, 0x3a, 0x98, 0x7b, 0x48, 0x67,
+		0x03, 0x76, 0x24, 0x1f, 0xc7, 0x6e, 0xf9, 0x11, 0xdc, 0x9a, 0x45, 0x2b, 0xdc, 0xed, 0xb3, 0x81,
+		0x52, 0x88, 0x4e, 0x41, 0x67, 0x09, 0x6c, 0x0e, 0x24, 0x9e, 0xb4, 0xf6, 0x87, 0xff, 0x20, 0xd3,
+		0x85, 0x74, 0xbc, 0xc6, 0x66, 0x80, 0x20, 0xff, 0x9b, 0x06, 0x3b, 0xe5, 0x60, 0xec, 0x5a, 0xc9,
+		0x25, 0x39, 0x9b, 0x37, 0x07, 0x8b, 0xd4, 0x95, 0x75, 0x8e, 0x6f, 0xdc, 0x25, 0x9c, 0x2c, 0x51,
+		0x09, 0x6e, 0x7a, 0x3e, 0xb5, 0x69, 0x9f, 0xb9, 0xd4, 0x36, 0xbf, 0x0b, 0x69, 0x48, 0xcd, 0xa8,
+		0x2a, 0xf1, 0x51, 0xde, 0x98, 0x80, 0x5f, 0x49, 0xac, 0x29, 0x8b, 0xb4, 0x0b, 0x10, 0x13, 0xa3,
+		0x76, 0x4e, 0x47, 0xc4, 0xe5, 0xc8, 0x12, 0x35, 0xea, 0x17, 0xb0, 0x12, 0xc3, 0x56, 0xa4, 0x21,
+		0x3a, 0x24, 0xd9, 0xd2, 0xee, 0xdc, 0x0d, 0x26, 0x53, 0x02, 0x67, 0x23, 0x97, 0x58, 0x75, 0xde,
+		0x87, 0xdb, 0xd1, 0x45, 0x4e, 0x2b, 0x4e, 0x18, 0x08, 0xea, 0x77, 0xa8, 0x43, 0x2d, 0xb9, 0x11,
+		0xd5, 0x47, 0x18, 0xd6, 0xad, 0x18, 0x31, 0x49, 0x32, 0xf6, 0x54, 0x9a, 0xf9, 0x97, 0x8f, 0x8a,
+		0x73, 0x31, 0x23, 0xb1, 0x6e, 0x5d, 0xb2, 0xe4, 0x3f, 0x05, 0xfd, 0x32, 0x0b, 0xdd, 0x80, 0x4c,
+		0x60, 0x71, 0x2f, 0x39, 0x22, 0xf1, 0xe2, 0xe2, 0xdc, 0xa4, 0xa6, 0x9e, 0x15, 0x5f, 0xc3, 0x7a,
+		0x9b, 0x0c, 0x98, 0x1b, 0x95, 0x3b, 0xb9, 0xbd, 0x6f, 0xc1, 0xb2, 0x47, 0x06, 0xd4, 0x0c, 0xd8,
+		0xab, 0x38, 0x44, 0x06, 0x2f, 0x49, 0x43, 0x87, 0xbd, 0xa2, 0xe8, 0x7d, 0x58, 0x8b, 0x6e, 0xe2,
+		0x88, 0x21, 0xf8, 0x19, 0x75, 0xd5, 0x64, 0xbb, 0x2e, 0xcd, 0x6d, 0x32, 0xa0, 0x5d, 0x69, 0x3c,
+		0xfc, 0x51, 0x83, 0xb5, 0x4b, 0xd7, 0x3a, 0xba, 0x0d, 0xb9, 0x7a, 0xd9, 0x68, 0x3c, 0xc1, 0x35,
+		0xb3, 0x52, 0xee, 0xd6, 0x8e, 0x5b, 0xf8, 0x99, 0x69, 0x34, 0x9f, 0x96, 0x1b, 0x46, 0x55, 0xff,
+		0x1f, 0xda, 0x86, 0x9b, 0x57, 0xd0, 0x76, 0xab, 0xd1, 0xd0, 0x35, 0xb4, 0x0b, 0xdb, 0x57, 0xa0,
+		0x4e, 0xb7, 0xdc, 0xac, 0x96, 0x71, 0x55, 0x4f, 0xa1, 0x1d, 0xd8, 0xbc, 0x02, 0xd7, 0xcb, 0xdd,
+		0x72, 0x43, 0x4f, 0x1f, 0xbe, 0x84, 0x95, 0xe9, 0xe1, 0x2c, 0xb3, 0xd4, 0x9a, 0x95, 0x56, 0xd5,
+		0x68, 0x1e, 0x9b, 0xdd, 0x67, 0xed, 0xda, 0x94, 0x80, 0x1d, 0xd8, 0x9c, 0x85, 0xba, 0x27, 0xd8,
+		0xa8, 0x77, 0xf1, 0xa9, 0xae, 0xa1, 0x4d, 0x40, 0xb3, 0xd8, 0xa3, 0x4e, 0xab, 0xa9, 0xa7, 0x50,
+		0x0e, 0x6e, 0xcc, 0xda, 0xdb, 0xb8, 0xd5, 0x6d, 0xdd, 0xd3, 0xd3, 0x87, 0xdf, 0xc3, 0xc6, 0x9c,
+		0x86, 0x43, 0x77, 0x60, 0xd7, 0xe8, 0xb4, 0x1a, 0xe5, 0xae, 0xd1, 0x6a, 0x9a, 0xc7, 0xb8, 0xf5,
+		0xa4, 0x2d, 0x77, 0xd2, 0x9d, 0xd6, 0xf1, 0x46, 0xca, 0x49, 0xad, 0xdc, 0xe8, 0x9e, 0x3c, 0xd3,
+		0xb5, 0x37, 0x53, 0xaa, 0xb8, 0x6c, 0x34, 0x6b, 0x55, 0x3d, 0x75, 0xf8, 0x1c, 0x56, 0x64, 0xfd,
+		0xf9, 0x88, 0xfa, 0xc9, 0xc6, 0x65, 0x91, 0x5a, 0x4f, 0x6b, 0xf8, 0xf2, 0xc6, 0xb7, 0x60, 0x63,
+		0x16, 0xaa, 0xb7, 0x70, 0xa5, 0xa6, 0x6b, 0x49, 0x61, 0x27, 0xc0, 0x31, 0x2e, 0x57, 0x6a, 0xf5,
+		0x27, 0x0d, 0x3d, 0xf5, 0x70, 0xf0, 0xeb, 0xeb, 0x3d, 0xed, 0xf7, 0xd7, 0x7b, 0xda, 0x9f, 0xaf,
+		0xf7, 0x34, 0xd8, 0xb2, 0xf8, 0x70, 0xde, 0x11, 0x7e, 0x98, 0xad, 0x44, 0x0f, 0xf2, 0xb6, 0x9c,
+		0x7a, 0x6d, 0xed, 0x9b, 0xa3, 0x01, 0x13, 0x2f, 0xc2, 0x5e, 0xc1, 0xe2, 0xc3, 0xe2, 0xf4, 0xf3,
+		0xfd, 0x23, 0x66, 0x3b, 0xc5, 0x01, 0x8f, 0x1f, 0xe5, 0xea, 0x2d, 0xff, 0x09, 0xf1, 0xd8, 0xe8,
+		0xa8, 0xb7, 0x10, 0xd9, 0xee, 0xfd, 0x35, 0x00, 0x39, 0x8e, 0x5e, 0x65, 0xef, 0x0b, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/schedule.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+		0x14, 0x47, 0xfe, 0x93, 0xda, 0xcf, 0xb1, 0xab, 0x6c, 0x69, 0x6b, 0xd2, 0x26, 0x75, 0x0c, 0xfd,
+		0x33, 0x29, 0xb5, 0x27, 0x01, 0xa6, 0x40, 0x67, 0x3a, 0xe3, 0x38, 0xca, 0xd4, 0x83, 0x6b, 0x9b,
+		0x8d, 0xdd, 0x0c, 0x1c, 0xd0, 0xc8, 0xd2, 0x3a, 0x59, 0x22, 0x6b, 0x35, 0xd2, 0x2a, 0x89, 0x8f,
+		0x9c, 0xf9, 0x1a, 0x9c, 0xf8, 0x24, 0x1c, 0xf9, 0x00, 0xcc, 0xc0, 0xf4, 0xc2, 0x9d, 0x03, 0x57,
+		0x98, 0x5d, 0xfd, 0xa9, 0xdb, 0xca, 0x71, 0x87, 0x0b, 0x37, 0xed, 0x6f, 0x7f, 0xbf, 0xb7, 0xef,
+		0xbd, 0x7d, 0xbb, 0x6f, 0x05, 0xf5, 0x60, 0x4c, 0xbc, 0xa6, 0x69, 0x58, 0xc4, 0x31, 0x49, 0xd3,
+		0x70, 0x69, 0xf3, 0x6c, 0xa7, 0xe9, 0x9b, 0x27, 0xc4, 0x0a, 0x6c, 0xd2, 0x70, 0x3d, 0xc6, 0x19,
+		0xba, 0x26, 0x38, 0x8d, 0x88, 0xd3, 0x30, 0x5c, 0xda, 0x38, 0xdb, 0x59, 0xdf, 0x3c, 0x66, 0xec,
+		0xd8, 0x26, 0x4d, 0x49, 0x19, 0x07, 0x93, 0xa6, 0x15, 0x78, 0x06, 0xa7, 0xcc, 0x09, 0x45, 0xeb,
+		0x77, 0xde, 0x9c, 0xe7, 0x74, 0x4a, 0x7c, 0x6e, 0x4c, 0xdd, 0x88, 0x50, 0x4b, 0x5b, 0xd9, 0x64,
+		0xd3, 0x69, 0x62, 0x22, 0xd5, 0x37, 0x6e, 0xf8, 0xa7, 0x36, 0xf5, 0x79, 0xc8, 0xa9, 0xff, 0xa6,
+		0xc0, 0xea, 0x61, 0xe4, 0xee, 0xa1, 0x4b, 0x4c, 0x74, 0x1f, 0xae, 0x9a, 0x1e, 0x73, 0x74, 0x72,
+		0xe1, 0x7a, 0xc4, 0xf7, 0x29, 0x73, 0xaa, 0x4a, 0x4d, 0x79, 0x50, 0xc4, 0x15, 0x01, 0x6b, 0x09,
+		0x8a, 0xbe, 0x00, 0xf0, 0xb9, 0xe1, 0x71, 0x5d, 0x38, 0x56, 0xcd, 0xd4, 0x94, 0x07, 0xa5, 0xdd,
+		0xf5, 0x46, 0xe8, 0x75, 0x23, 0xf6, 0xba, 0x31, 0x8c, 0xbd, 0xc6, 0x45, 0xc9, 0x16, 0x63, 0xf4,
+		0x19, 0x14, 0x88, 0x63, 0x85, 0xc2, 0xec, 0x52, 0xe1, 0x15, 0xe2, 0x58, 0x52, 0xb6, 0x03, 0x2b,
+		0xdf, 0x53, 0xce, 0x89, 0x57, 0xcd, 0x49, 0xd1, 0x07, 0x6f, 0x89, 0xf6, 0xa3, 0x1c, 0xe2, 0x88,
+		0x58, 0xff, 0x2b, 0x0f, 0x95, 0x38, 0xbc, 0x96, 0x29, 0xa6, 0xd0, 0x77, 0x50, 0x09, 0xfd, 0x3e,
+		0x67, 0xde, 0xe9, 0xc4, 0x66, 0xe7, 0x32, 0xbe, 0xd2, 0xee, 0xe3, 0x46, 0xca, 0x36, 0x35, 0x5e,
+		0x17, 0x37, 0x0e, 0x85, 0xf2, 0x28, 0x12, 0x86, 0x18, 0x2e, 0xfb, 0xf3, 0xe0, 0xfa, 0x3f, 0x39,
+		0xb8, 0x96, 0x42, 0x43, 0x07, 0x50, 0x8e, 0x57, 0xd4, 0xf9, 0xcc, 0x25, 0xd1, 0xb2, 0x5b, 0xa9,
+		0xcb, 0xc6, 0xda, 0xe1, 0xcc, 0x25, 0x78, 0xf5, 0x7c, 0x6e, 0x84, 0xbe, 0x84, 0xa2, 0xd8, 0x43,
+		0x5d, 0x6c, 0x62, 0x94, 0xf6, 0x8d, 0x54, 0x1b, 0x43, 0xc3, 0x3f, 0xed, 0x52, 0x9f, 0xe3, 0x02,
+		0x8f, 0xbe, 0xd0, 0x2e, 0xe4, 0xa9, 0xe3, 0x06, 0x3c, 0xca, 0xfa, 0xed, 0x54, 0xdd, 0xc0, 0x98,
+		0xd9, 0xcc, 0xb0, 0x70, 0x48, 0x45, 0x1f, 0x03, 0x4a, 0xfc, 0xa6, 0x96, 0xee, 0x7a, 0x64, 0x42,
+		0x2f, 0xe4, 0x0e, 0x14, 0xb1, 0x1a, 0xcf, 0x74, 0xac, 0x81, 0xc4, 0x91, 0x01, 0x35, 0x72, 0x41,
+		0xcc, 0x40, 0x84, 0xac, 0x47, 0xf5, 0xc1, 0x74, 0xd3, 0x66, 0x3e, 0x91, 0xfb, 0xcd, 0x02, 0x5e,
+		0xcd, 0x2f, 0xdb, 0xbd, 0xdb, 0x89, 0x09, 0x99, 0xc8, 0x21, 0x6b, 0x0b, 0xfd, 0x30, 0x94, 0xa3,
+		0x23, 0xb8, 0x25, 0x13, 0xb0, 0xc0, 0xfa, 0xca, 0x32, 0xeb, 0x37, 0x85, 0x3a, 0xcd, 0x70, 0x1b,
+		0x56, 0x3d, 0xc2, 0xbd, 0x99, 0xee, 0x32, 0x9b, 0x9a, 0xb3, 0xea, 0x15, 0x69, 0xa9, 0x96, 0x9a,
+		0x24, 0x2c, 0x88, 0x03, 0xc9, 0xc3, 0x25, 0xef, 0xd5, 0x00, 0x3d, 0x82, 0xdc, 0x94, 0x4c, 0x59,
+		0xb5, 0x10, 0xb9, 0x91, 0x26, 0x7e, 0x4e, 0xa6, 0x0c, 0x4b, 0x1a, 0xc2, 0xb0, 0xe6, 0x13, 0xc3,
+		0x33, 0x4f, 0x74, 0x83, 0x73, 0x8f, 0x8e, 0x03, 0x4e, 0xfc, 0x6a, 0x51, 0x6a, 0xef, 0xa6, 0x17,
+		0xa4, 0x64, 0xb7, 0x12, 0x32, 0x56, 0xfd, 0x37, 0x90, 0xfa, 0xdf, 0x19, 0x50, 0xe3, 0xba, 0x95,
+		0x5e, 0x51, 0xe2, 0xa3, 0xaf, 0xa1, 0xc2, 0xce, 0x88, 0x67, 0x1b, 0x6e, 0x1c, 0x9e, 0xa8, 0xbf,
+		0xca, 0xee, 0xf6, 0xa5, 0x65, 0xdf, 0x0f, 0x25, 0x51, 0xa0, 0x65, 0x36, 0x3f, 0x44, 0x18, 0xae,
+		0x9a, 0x06, 0x37, 0x4f, 0xf4, 0x20, 0xb1, 0x99, 0x79, 0x07, 0x9b, 0x6d, 0xa1, 0x19, 0x25, 0x36,
+		0xcd, 0xf9, 0x21, 0x6a, 0xcd, 0xd9, 0x3c, 0xa7, 0x8e, 0xc5, 0xce, 0xab, 0xd9, 0x65, 0x1b, 0x1a,
+		0x9b, 0x38, 0x92, 0x7c, 0xf4, 0x00, 0x54, 0xd7, 0x08, 0x7c, 0xa2, 0x33, 0x47, 0x9f, 0x18, 0xd4,
+		0x0e, 0x3c, 0x22, 0xcb, 0xb5, 0x80, 0x2b, 0x12, 0xef, 0x3b, 0x07, 0x21, 0x8a, 0xb6, 0x60, 0x75,
+		0x1c, 0x4c, 0x26, 0xc4, 0xd3, 0x6d, 0x3a, 0xa5, 0x61, 0x61, 0xe6, 0x71, 0x29, 0xc4, 0xba, 0x02,
+		0x42, 0x0f, 0x61, 0xcd, 0x64, 0x8e, 0x19, 0x78, 0x1e, 0x71, 0xcc, 0x59, 0xc4, 0x5b, 0x91, 0x3c,
+		0x75, 0x6e, 0x42, 0x92, 0xeb, 0x3f, 0x28, 0xb0, 0x96, 0x24, 0x5e, 0x2c, 0xd5, 0x71, 0x26, 0x0c,
+		0xdd, 0x80, 0x15, 0x8f, 0x18, 0x7e, 0x72, 0x91, 0x46, 0x23, 0xf4, 0x18, 0x8a, 0xd2, 0x1f, 0x4b,
+		0x37, 0xf8, 0x3b, 0xdc, 0x9f, 0x85, 0x90, 0xdc, 0xe2, 0xe8, 0x56, 0x22, 0x1c, 0xcf, 0x64, 0x76,
+		0x8a, 0xf1, 0xe4, 0xde, 0xac, 0xee, 0x40, 0x39, 0xb9, 0xcf, 0xb9, 0xc1, 0x89, 0x58, 0x3e, 0x9c,
+		0x94, 0xcb, 0x17, 0x70, 0x34, 0x42, 0x1a, 0x80, 0xfc, 0xd2, 0xa9, 0x33, 0x61, 0xd1, 0xfa, 0xf7,
+		0x2e, 0xdd, 0xb8, 0x24, 0x24, 0x5c, 0x74, 0xe3, 0xcf, 0xfa, 0x9f, 0x0a, 0xac, 0xee, 0x19, 0xe6,
+		0xe9, 0x84, 0xda, 0xb6, 0x0c, 0xf7, 0x0e, 0x94, 0xc6, 0xd1, 0x58, 0xa7, 0x56, 0x14, 0x33, 0xc4,
+		0x50, 0xc7, 0xfa, 0x1f, 0x1a, 0xc7, 0x5d, 0xa8, 0x78, 0x81, 0xe3, 0xeb, 0x26, 0x9b, 0xba, 0x36,
+		0xe1, 0xc4, 0x92, 0xf5, 0x90, 0xc7, 0x65, 0x81, 0xb6, 0x63, 0x10, 0x6d, 0x00, 0x48, 0x1a, 0x67,
+		0xdc, 0xb0, 0xa3, 0x62, 0x28, 0x0a, 0x64, 0x28, 0x80, 0xfa, 0xcf, 0xb9, 0x57, 0xad, 0x52, 0x46,
+		0xfa, 0x14, 0xca, 0xb6, 0xe1, 0x73, 0xdd, 0x0b, 0x9c, 0xd0, 0x25, 0x65, 0xa9, 0x4b, 0x25, 0x21,
+		0xc0, 0x81, 0x23, 0xdd, 0x7a, 0x0a, 0x65, 0x87, 0x5c, 0xcc, 0xe9, 0x97, 0xe7, 0xa2, 0x24, 0x04,
+		0xb1, 0x7e, 0x03, 0x40, 0xba, 0x2a, 0x0c, 0xf8, 0x32, 0x1f, 0x59, 0x5c, 0x94, 0x08, 0x0e, 0x1c,
+		0x1f, 0x3d, 0x81, 0x92, 0xe9, 0x11, 0x83, 0x87, 0x57, 0x63, 0x35, 0xb7, 0xd4, 0x38, 0x84, 0x74,
+		0x69, 0x7b, 0x1f, 0x54, 0x19, 0x5b, 0xe0, 0x5a, 0x89, 0x85, 0xfc, 0x52, 0x0b, 0x15, 0xa1, 0x19,
+		0x49, 0x89, 0xb4, 0xd2, 0x83, 0x35, 0xe6, 0x1c, 0x33, 0xea, 0x1c, 0xeb, 0x71, 0x01, 0xf8, 0xd5,
+		0x95, 0x5a, 0x76, 0x61, 0xdf, 0x9b, 0xaf, 0x24, 0xac, 0x46, 0xda, 0x18, 0xf4, 0x45, 0x6d, 0x4d,
+		0xa9, 0x2f, 0x2a, 0x5f, 0x86, 0x7c, 0x45, 0x86, 0x0c, 0x21, 0x24, 0x63, 0xde, 0x82, 0x55, 0xff,
+		0x94, 0xba, 0x6e, 0xcc, 0x28, 0x48, 0x46, 0x29, 0xc2, 0x24, 0xa5, 0x01, 0xd7, 0xc2, 0x03, 0x4e,
+		0x2c, 0x7d, 0x42, 0x3d, 0xa2, 0x9b, 0x2c, 0x70, 0xb8, 0xbc, 0x73, 0xb3, 0x78, 0x2d, 0x9e, 0x3a,
+		0xa0, 0x1e, 0x69, 0x8b, 0x09, 0xf4, 0x29, 0xdc, 0xf0, 0x02, 0xc7, 0x11, 0x31, 0x24, 0x7d, 0x30,
+		0x94, 0x80, 0x94, 0xbc, 0x1f, 0xcd, 0xc6, 0x2d, 0x5b, 0xaa, 0xea, 0xbf, 0xcf, 0x5d, 0x05, 0xa2,
+		0xf5, 0x6a, 0x0e, 0xf7, 0x66, 0xc2, 0xff, 0xf8, 0x6d, 0x38, 0x77, 0x36, 0x62, 0xa8, 0x63, 0xbd,
+		0xfd, 0x48, 0xc8, 0xfc, 0xb7, 0x47, 0xc2, 0xe7, 0x90, 0xf7, 0xc5, 0xe9, 0x8f, 0x4e, 0x49, 0xfd,
+		0xd2, 0x73, 0x2d, 0xef, 0x09, 0x1c, 0x0a, 0xd2, 0xde, 0x7f, 0xb9, 0xb4, 0xf7, 0xdf, 0xf6, 0x8f,
+		0x19, 0xb8, 0x9e, 0xda, 0x26, 0xd0, 0x87, 0x70, 0xe7, 0xb0, 0xfd, 0x4c, 0xdb, 0x1f, 0x75, 0x35,
+		0xbd, 0xff, 0x42, 0xc3, 0xdd, 0xd6, 0x40, 0x1f, 0xf4, 0xbb, 0x9d, 0xf6, 0x37, 0x7a, 0xa7, 0xf7,
+		0xa2, 0xd5, 0xed, 0xec, 0xab, 0xef, 0xa1, 0x8f, 0xa0, 0xb6, 0x88, 0x74, 0xf8, 0x55, 0x67, 0xa0,
+		0xf7, 0xb4, 0x23, 0x55, 0x41, 0x75, 0xd8, 0x5c, 0xc4, 0xda, 0x1b, 0x1d, 0x1c, 0x68, 0x58, 0xcd,
+		0xa0, 0x7b, 0x50, 0x5f, 0xc4, 0x69, 0xf7, 0x7b, 0xed, 0x11, 0xc6, 0x5a, 0x6f, 0xa8, 0x66, 0xd1,
+		0x43, 0xb8, 0xbf, 0x90, 0xd7, 0xea, 0xb5, 0xb5, 0xae, 0x3e, 0xc0, 0xda, 0x8b, 0x4e, 0x7f, 0x74,
+		0xa8, 0xe6, 0x50, 0x03, 0xb6, 0x17, 0x91, 0x87, 0x1a, 0x7e, 0xde, 0xe9, 0xb5, 0x86, 0xda, 0x2b,
+		0x7e, 0x7e, 0xfb, 0x27, 0x05, 0xae, 0xa7, 0x36, 0xb8, 0xd7, 0x02, 0x6d, 0xb7, 0x86, 0xed, 0x67,
+		0xfa, 0x28, 0x25, 0x1d, 0x5b, 0xb0, 0xb1, 0x90, 0x25, 0xf2, 0xa1, 0x2a, 0xa8, 0x06, 0xb7, 0x17,
+		0x52, 0xfa, 0x3d, 0x4d, 0xcd, 0x5c, 0xca, 0x68, 0x75, 0xbb, 0x6a, 0x76, 0x8f, 0xfe, 0xf2, 0x72,
+		0x53, 0xf9, 0xf5, 0xe5, 0xa6, 0xf2, 0xc7, 0xcb, 0x4d, 0x05, 0x6e, 0x9a, 0x6c, 0x9a, 0x56, 0x19,
+		0x7b, 0x49, 0x0b, 0x19, 0x88, 0x33, 0x3e, 0x50, 0xbe, 0xdd, 0x39, 0xa6, 0xfc, 0x24, 0x18, 0x37,
+		0x4c, 0x36, 0x6d, 0xce, 0xff, 0x55, 0x3c, 0xa2, 0x96, 0xdd, 0x3c, 0x66, 0xe1, 0x5f, 0x4a, 0xf4,
+		0x8b, 0xf1, 0xc4, 0x70, 0xe9, 0xd9, 0xce, 0x78, 0x45, 0x62, 0x9f, 0xfc, 0x3b, 0x00, 0x85, 0x9b,
+		0xb8, 0x5d, 0x22, 0x0d, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+		0x14, 0x2d, 0xf5, 0x48, 0xe4, 0xab, 0xd8, 0x66, 0x26, 0x76, 0x2c, 0x29, 0x89, 0xab, 0x68, 0x11,
+		0xb8, 0x41, 0x4b, 0xc1, 0x6e, 0x0b, 0x14, 0x6d, 0x91, 0x46, 0xb6, 0x84, 0x84, 0xb0, 0xec, 0x08,
+		0x14, 0x9b, 0x22, 0xdd, 0x10, 0x23, 0x72, 0x22, 0x4f, 0x45, 0x72, 0x08, 0xce, 0x50, 0x8a, 0x36,
+		0xfd, 0x8c, 0xee, 0xfa, 0x23, 0xfd, 0x82, 0x6e, 0x0a, 0xf4, 0x13, 0x8a, 0x7c, 0x49, 0x31, 0x43,
+		0xca, 0xd1, 0x83, 0x09, 0x9a, 0x45, 0x77, 0x9a, 0x7b, 0xe6, 0xdc, 0x73, 0x9f, 0x23, 0x42, 0x2b,
+		0x19, 0x91, 0xb8, 0xed, 0x62, 0x8f, 0x84, 0x2e, 0x69, 0xe3, 0x88, 0xb6, 0xa7, 0xc7, 0x6d, 0x81,
+		0xf9, 0xc4, 0xa7, 0x5c, 0x18, 0x51, 0xcc, 0x04, 0x43, 0x77, 0xe4, 0x1d, 0x23, 0xbb, 0x63, 0xe0,
+		0x88, 0x1a, 0xd3, 0xe3, 0xc6, 0xe1, 0x98, 0xb1, 0xb1, 0x4f, 0xda, 0xea, 0xca, 0x28, 0x79, 0xdd,
+		0xf6, 0x92, 0x18, 0x0b, 0xca, 0xc2, 0x94, 0xd4, 0xf8, 0x74, 0x1d, 0x17, 0x34, 0x20, 0x5c, 0xe0,
+		0x20, 0xca, 0x2e, 0x6c, 0x38, 0x98, 0xc5, 0x38, 0x8a, 0x48, 0xcc, 0x53, 0xbc, 0x15, 0x43, 0xc5,
+		0xc6, 0x7c, 0xd2, 0xa7, 0x5c, 0x20, 0x04, 0xa5, 0x10, 0x07, 0xa4, 0xa6, 0x35, 0xb5, 0xa3, 0x2d,
+		0x4b, 0xfd, 0x46, 0x5f, 0x43, 0x69, 0x42, 0x43, 0xaf, 0x56, 0x68, 0x6a, 0x47, 0x3b, 0x27, 0x0f,
+		0x8d, 0x9c, 0x20, 0x8d, 0x85, 0x83, 0x73, 0x1a, 0x7a, 0x96, 0xba, 0x8e, 0xee, 0xc1, 0xd6, 0x08,
+		0x73, 0xe2, 0x28, 0x7f, 0x45, 0xe5, 0xaf, 0x22, 0x0d, 0x97, 0x38, 0x20, 0x2d, 0x0c, 0xfa, 0x82,
+		0x72, 0x41, 0x04, 0xf6, 0xb0, 0xc0, 0xe8, 0x02, 0xf6, 0x02, 0xfc, 0xc6, 0x91, 0x35, 0xe1, 0x4e,
+		0x44, 0x62, 0x87, 0x13, 0x97, 0x85, 0x9e, 0x8a, 0xa5, 0x7a, 0x72, 0xdf, 0x48, 0xd3, 0x30, 0x16,
+		0x69, 0x18, 0x5d, 0x96, 0x8c, 0x7c, 0xf2, 0x12, 0xfb, 0x09, 0xb1, 0x6e, 0x07, 0xf8, 0x8d, 0x74,
+		0xc8, 0x07, 0x24, 0x1e, 0x2a, 0x5a, 0xeb, 0x47, 0xa8, 0x2f, 0x24, 0x06, 0x38, 0x16, 0x54, 0x96,
+		0xec, 0x5a, 0x4b, 0x87, 0xe2, 0x84, 0xcc, 0xb3, 0x34, 0xe5, 0x4f, 0xf4, 0x08, 0x76, 0xd9, 0x2c,
+		0x24, 0xb1, 0x73, 0xc5, 0xb8, 0x48, 0x83, 0x2e, 0x28, 0x74, 0x5b, 0x99, 0x9f, 0x33, 0x2e, 0x54,
+		0xe4, 0x13, 0xd8, 0x37, 0x39, 0xf3, 0x55, 0x07, 0x9e, 0xc5, 0x2c, 0x89, 0x2e, 0x88, 0x88, 0xa9,
+		0xcb, 0x51, 0x1b, 0xf6, 0x42, 0x32, 0xcb, 0x0f, 0x5f, 0xb3, 0x6e, 0x87, 0x64, 0xb6, 0x1a, 0x20,
+		0x7a, 0x08, 0xb7, 0x22, 0xe6, 0xfb, 0x24, 0x76, 0x5c, 0x96, 0x84, 0x42, 0xc9, 0x15, 0xad, 0x6a,
+		0x6a, 0x3b, 0x93, 0xa6, 0xd6, 0xef, 0x25, 0xd8, 0x59, 0x24, 0x31, 0x14, 0x58, 0x24, 0x1c, 0x7d,
+		0x0e, 0x68, 0x84, 0xdd, 0x89, 0xcf, 0xc6, 0x29, 0xcd, 0xb9, 0xa2, 0xa1, 0x50, 0x22, 0x45, 0x4b,
+		0xcf, 0x10, 0x45, 0x7e, 0x4e, 0x43, 0x81, 0x1e, 0x00, 0xc4, 0x04, 0x7b, 0x8e, 0x4f, 0xa6, 0xc4,
+		0xcf, 0x14, 0xb6, 0xa4, 0xa5, 0x2f, 0x0d, 0xb2, 0x47, 0xd8, 0x9d, 0x64, 0x68, 0x51, 0xa1, 0x15,
+		0xec, 0x4e, 0x52, 0xf0, 0x11, 0xec, 0xc6, 0x58, 0x90, 0xe5, 0x5c, 0x4a, 0x2a, 0x97, 0x6d, 0x69,
+		0x7e, 0x97, 0x47, 0x17, 0xb6, 0x65, 0xd2, 0x0e, 0xf5, 0x9c, 0x91, 0xcf, 0xdc, 0x49, 0xad, 0xac,
+		0x1a, 0xd6, 0x7c, 0xef, 0xa0, 0x98, 0xdd, 0x53, 0x79, 0xcf, 0xaa, 0x4a, 0x9a, 0xe9, 0xa9, 0x03,
+		0x9a, 0xc2, 0x01, 0x5d, 0xd4, 0xd5, 0x19, 0xcb, 0xc2, 0x3a, 0x41, 0x5a, 0xd9, 0xda, 0x8d, 0x66,
+		0xf1, 0xa8, 0x7a, 0xf2, 0xe4, 0x83, 0x83, 0x97, 0x56, 0xc7, 0xc8, 0x6d, 0x4d, 0x2f, 0x14, 0xf1,
+		0xdc, 0xda, 0xa7, 0x1f, 0xd5, 0xb6, 0x9b, 0xef, 0x6b, 0xdb, 0x1e, 0x94, 0x49, 0x10, 0x89, 0x79,
+		0xad, 0xd2, 0xd4, 0x8e, 0x2a, 0x56, 0x7a, 0x68, 0x08, 0x68, 0xbc, 0x5f, 0x3b, 0x67, 0xdc, 0x9e,
+		0x42, 0x79, 0x2a, 0x27, 0x57, 0xf5, 0xa4, 0x7a, 0xf2, 0x38, 0x37, 0xb9, 0x5c, 0x8f, 0x56, 0x4a,
+		0xfc, 0xb6, 0xf0, 0x8d, 0xd6, 0xfa, 0x01, 0xaa, 0x4b, 0x05, 0x45, 0x75, 0xa8, 0x70, 0x81, 0x63,
+		0xe1, 0x50, 0x2f, 0x9b, 0x88, 0x9b, 0xea, 0x6c, 0x7a, 0x68, 0x1f, 0x6e, 0x90, 0xd0, 0x93, 0x40,
+		0x3a, 0x04, 0x65, 0x12, 0x7a, 0xa6, 0xd7, 0xfa, 0x4d, 0x03, 0x18, 0xa8, 0x81, 0x33, 0xc3, 0xd7,
+		0x0c, 0x75, 0x41, 0xf7, 0x31, 0x17, 0x0e, 0x76, 0x5d, 0xc2, 0xb9, 0x23, 0x5f, 0x92, 0x6c, 0xfd,
+		0x1a, 0x1b, 0xeb, 0x67, 0x2f, 0x9e, 0x19, 0x6b, 0x47, 0x72, 0x3a, 0x8a, 0x22, 0x8d, 0xa8, 0x01,
+		0x15, 0xea, 0x91, 0x50, 0x50, 0x31, 0xcf, 0x76, 0xe8, 0xfa, 0x9c, 0x37, 0x54, 0xc5, 0x9c, 0xa1,
+		0x6a, 0xfd, 0xa1, 0x41, 0x7d, 0x28, 0xa8, 0x3b, 0x99, 0xf7, 0xde, 0x10, 0x37, 0x91, 0x45, 0xe8,
+		0x08, 0x11, 0xd3, 0x51, 0x22, 0x08, 0x47, 0xcf, 0x40, 0x9f, 0xb1, 0x78, 0x42, 0x62, 0xd5, 0x37,
+		0x47, 0x3e, 0xa1, 0x59, 0x9c, 0x0f, 0x3e, 0x38, 0x25, 0xd6, 0x4e, 0x4a, 0x5b, 0x9c, 0x91, 0x0d,
+		0x75, 0xee, 0x5e, 0x11, 0x2f, 0xf1, 0x89, 0x23, 0x98, 0x93, 0x56, 0x4f, 0xa6, 0xcd, 0x12, 0x91,
+		0xb5, 0xa6, 0xbe, 0xf9, 0xf0, 0x64, 0x0f, 0xb0, 0x75, 0x77, 0xc1, 0xb5, 0xd9, 0x50, 0x32, 0xed,
+		0x94, 0xd8, 0x7a, 0x02, 0xb7, 0x37, 0x9e, 0x1e, 0xf4, 0x19, 0xe8, 0x6b, 0x03, 0xce, 0x6b, 0x5a,
+		0xb3, 0x78, 0xb4, 0x65, 0xed, 0xae, 0x4e, 0x26, 0x6f, 0xfd, 0x55, 0x82, 0x83, 0x0d, 0x07, 0x67,
+		0x2c, 0x7c, 0x4d, 0xc7, 0xa8, 0x06, 0x37, 0xa7, 0x24, 0xe6, 0x94, 0x85, 0x8b, 0x16, 0x67, 0x47,
+		0x74, 0x02, 0x77, 0xc2, 0x24, 0x70, 0xd4, 0xbe, 0x47, 0x0b, 0x16, 0x57, 0x59, 0x94, 0x4f, 0x0b,
+		0x35, 0x39, 0xcc, 0x49, 0x60, 0x11, 0xec, 0x5d, 0xbb, 0xe4, 0xe8, 0x2b, 0xd8, 0x93, 0x9c, 0x59,
+		0x4c, 0x65, 0x4f, 0xde, 0x91, 0x8a, 0xd7, 0x24, 0x14, 0x26, 0xc1, 0x4f, 0x12, 0x5e, 0x62, 0x51,
+		0xd8, 0x5d, 0x57, 0x29, 0xa9, 0x1d, 0x7d, 0xfa, 0xc1, 0xea, 0xaf, 0xa5, 0x62, 0xac, 0xc6, 0x92,
+		0x6e, 0xe9, 0x4e, 0xbc, 0x1a, 0xa0, 0x0f, 0xfa, 0x46, 0x70, 0x65, 0xa5, 0xd5, 0xf9, 0x28, 0xad,
+		0xb5, 0x14, 0x52, 0xb1, 0xdd, 0xd9, 0xaa, 0xb5, 0x41, 0xe1, 0x4e, 0x4e, 0x50, 0xcb, 0xeb, 0x5b,
+		0x4e, 0xd7, 0xf7, 0xfb, 0xd5, 0xf5, 0x7d, 0xf4, 0xdf, 0x62, 0x59, 0x5a, 0xdd, 0xc6, 0x2f, 0xb0,
+		0x97, 0x17, 0xd3, 0xff, 0xa1, 0xf5, 0xf8, 0x57, 0xb8, 0xb5, 0xfc, 0x07, 0x8d, 0x1a, 0x70, 0xd7,
+		0xee, 0x0c, 0xcf, 0x9d, 0xbe, 0x39, 0xb4, 0x9d, 0x73, 0xf3, 0xb2, 0xeb, 0x98, 0x97, 0x2f, 0x3b,
+		0x7d, 0xb3, 0xab, 0x7f, 0x82, 0xea, 0xb0, 0xbf, 0x86, 0x5d, 0xbe, 0xb0, 0x2e, 0x3a, 0x7d, 0x5d,
+		0xcb, 0x81, 0x86, 0xb6, 0x79, 0x76, 0xfe, 0x4a, 0x2f, 0xa0, 0xfb, 0x50, 0x5b, 0x83, 0x7a, 0x83,
+		0xe7, 0xbd, 0x8b, 0x9e, 0xd5, 0xe9, 0xeb, 0xc5, 0xc7, 0xde, 0x3b, 0x7d, 0x7b, 0x1e, 0x91, 0x55,
+		0x7d, 0xfb, 0xd5, 0xa0, 0xb7, 0xa4, 0x7f, 0x0f, 0x0e, 0xd6, 0xb0, 0x6e, 0xef, 0xcc, 0x1c, 0x9a,
+		0x2f, 0x2e, 0x75, 0x2d, 0x07, 0xec, 0x9c, 0xd9, 0xe6, 0x4b, 0xd3, 0x7e, 0xa5, 0x17, 0x4e, 0xe9,
+		0x9f, 0x6f, 0x0f, 0xb5, 0xbf, 0xdf, 0x1e, 0x6a, 0xff, 0xbc, 0x3d, 0xd4, 0xe0, 0xc0, 0x65, 0x41,
+		0x5e, 0xa5, 0x4e, 0xb7, 0xaf, 0x4b, 0x25, 0xf7, 0x79, 0xa0, 0xfd, 0x7c, 0x3c, 0xa6, 0xe2, 0x2a,
+		0x19, 0x19, 0x2e, 0x0b, 0xda, 0xcb, 0x1f, 0x69, 0x5f, 0x50, 0xcf, 0x6f, 0x8f, 0x59, 0xfa, 0xdd,
+		0x94, 0x7d, 0xb1, 0x7d, 0x87, 0x23, 0x3a, 0x3d, 0x1e, 0xdd, 0x50, 0xb6, 0x2f, 0xff, 0x1d, 0x00,
+		0xc2, 0xc8, 0xe1, 0x36, 0xd5, 0x09, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x3b,
+		0xe3, 0x8d, 0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca, 0x31, 0xfe, 0x78, 0x28, 0xc7, 0xd8, 0xf0, 0x48,
+		0x8e, 0x71, 0xc5, 0x23, 0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
+		0x48, 0x8e, 0xf1, 0xc5, 0x23, 0x39, 0x86, 0x0f, 0x20, 0xf1, 0xc7, 0x72, 0x8c, 0x27, 0x1e, 0xcb,
+		0x31, 0x72, 0x09, 0x27, 0xe7, 0xe7, 0xea, 0xa1, 0x45, 0x87, 0x13, 0x6f, 0x38, 0x34, 0xbe, 0x02,
+		0x40, 0x22, 0x01, 0x8c, 0x51, 0xac, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x3f, 0x18, 0x19, 0x17, 0x31,
+		0x31, 0xbb, 0x07, 0x38, 0xad, 0x62, 0x92, 0x73, 0x87, 0x68, 0x09, 0x80, 0x6a, 0xd1, 0x0b, 0x4f,
+		0xcd, 0xc9, 0xf1, 0xce, 0xcb, 0x2f, 0xcf, 0x0b, 0x01, 0xa9, 0x4c, 0x62, 0x03, 0x9b, 0x65, 0x0c,
+		0x18, 0x00, 0x31, 0x55, 0x64, 0x90, 0x0a, 0x02, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
+		0x15, 0x2f, 0x25, 0xdb, 0xb1, 0x9f, 0x64, 0x9b, 0x1e, 0xdb, 0xb1, 0xf2, 0xb1, 0x8e, 0xe3, 0xdd,
+		0x64, 0x1d, 0x75, 0x63, 0xaf, 0xb3, 0xdf, 0x9b, 0x6e, 0x53, 0x9a, 0xa2, 0x13, 0x26, 0x0a, 0xa5,
+		0x8e, 0xa8, 0x78, 0xbd, 0x28, 0x4a, 0xd0, 0xd2, 0xd8, 0x66, 0x23, 0x91, 0x02, 0x49, 0x25, 0xf1,
+		0xbd, 0x40, 0x7b, 0x6d, 0x4f, 0x8b, 0x9e, 0xfa, 0x07, 0x14, 0x28, 0x8a, 0x1e, 0x7a, 0x2a, 0x7a,
+		0xed, 0xa5, 0x40, 0x4f, 0x3d, 0x17, 0xb9, 0x14, 0xfd, 0x2f, 0x8a, 0x19, 0x0e, 0x25, 0x52, 0xa2,
+		0x48, 0xb9, 0x05, 0xb6, 0x37, 0xf3, 0xcd, 0xef, 0xf7, 0xe6, 0xcd, 0xfb, 0x9a, 0x47, 0x5a, 0xb0,
+		0xdd, 0x3f, 0x21, 0xee, 0x5e, 0xcb, 0x6c, 0x13, 0xbb, 0x45, 0xf6, 0xcc, 0x9e, 0xb5, 0xf7, 0x6a,
+		0x7f, 0xef, 0xb5, 0xe3, 0xbe, 0x3c, 0xed, 0x38, 0xaf, 0x77, 0x7b, 0xae, 0xe3, 0x3b, 0x68, 0x95,
+		0x62, 0x76, 0x39, 0x66, 0xd7, 0xec, 0x59, 0xbb, 0xaf, 0xf6, 0xaf, 0x6f, 0x9e, 0x39, 0xce, 0x59,
+		0x87, 0xec, 0x31, 0xc8, 0x49, 0xff, 0x74, 0xaf, 0xdd, 0x77, 0x4d, 0xdf, 0x72, 0xec, 0x80, 0x74,
+		0xfd, 0xd6, 0xe8, 0xba, 0x6f, 0x75, 0x89, 0xe7, 0x9b, 0xdd, 0x1e, 0x07, 0x6c, 0x25, 0xed, 0xdc,
+		0x72, 0xba, 0xdd, 0x81, 0x8a, 0x44, 0xdb, 0x7c, 0xd3, 0x7b, 0xd9, 0xb1, 0x3c, 0x3f, 0xc0, 0x6c,
+		0x7f, 0x5b, 0x84, 0xf5, 0x23, 0x6e, 0xae, 0xf2, 0x86, 0xb4, 0xfa, 0xd4, 0x04, 0xd5, 0x3e, 0x75,
+		0x50, 0x13, 0x50, 0x78, 0x0e, 0x83, 0x84, 0x2b, 0x25, 0x61, 0x4b, 0xd8, 0x29, 0x3c, 0xb8, 0xbb,
+		0x9b, 0x70, 0xa4, 0xdd, 0x31, 0x3d, 0x78, 0xe5, 0xf5, 0xa8, 0x08, 0x7d, 0x02, 0x33, 0xfe, 0x45,
+		0x8f, 0x94, 0x72, 0x4c, 0xd1, 0xed, 0x54, 0x45, 0xfa, 0x45, 0x8f, 0x60, 0x06, 0x47, 0x5f, 0x00,
+		0x78, 0xbe, 0xe9, 0xfa, 0x06, 0x75, 0x43, 0x29, 0xcf, 0xc8, 0xd7, 0x77, 0x03, 0x1f, 0xed, 0x86,
+		0x3e, 0xda, 0xd5, 0x43, 0x1f, 0xe1, 0x05, 0x86, 0xa6, 0xcf, 0x94, 0xda, 0xea, 0x38, 0x1e, 0x09,
+		0xa8, 0x33, 0xd9, 0x54, 0x86, 0x66, 0x54, 0x1d, 0x8a, 0x01, 0xd5, 0xf3, 0x4d, 0xbf, 0xef, 0x95,
+		0x66, 0xb7, 0x84, 0x9d, 0xa5, 0x07, 0xfb, 0xd3, 0x9d, 0x5e, 0xa6, 0xcc, 0x06, 0x23, 0xe2, 0x42,
+		0x6b, 0xf8, 0x80, 0xee, 0xc0, 0xd2, 0xb9, 0xe5, 0xf9, 0x8e, 0x7b, 0x61, 0x74, 0x88, 0x7d, 0xe6,
+		0x9f, 0x97, 0xe6, 0xb6, 0x84, 0x9d, 0x3c, 0x5e, 0xe4, 0xd2, 0x2a, 0x13, 0xa2, 0x9f, 0xc0, 0x7a,
+		0xcf, 0x74, 0x89, 0xed, 0x0f, 0xdd, 0x6f, 0x58, 0xf6, 0xa9, 0x53, 0xba, 0xc2, 0x8e, 0xb0, 0x93,
+		0x68, 0x45, 0x9d, 0x31, 0x62, 0x91, 0xc4, 0xab, 0xbd, 0x71, 0x21, 0x92, 0x60, 0x69, 0xa8, 0x96,
+		0x79, 0x66, 0x3e, 0xd3, 0x33, 0x8b, 0x03, 0x06, 0xf3, 0xce, 0x7d, 0x98, 0xe9, 0x92, 0xae, 0x53,
+		0x5a, 0x60, 0xc4, 0x6b, 0x89, 0xf6, 0x3c, 0x27, 0x5d, 0x07, 0x33, 0x18, 0xc2, 0xb0, 0xe2, 0x11,
+		0xd3, 0x6d, 0x9d, 0x1b, 0xa6, 0xef, 0xbb, 0xd6, 0x49, 0xdf, 0x27, 0x5e, 0x09, 0x18, 0xf7, 0x4e,
+		0x22, 0xb7, 0xc1, 0xd0, 0xd2, 0x00, 0x8c, 0x45, 0x6f, 0x44, 0x82, 0xaa, 0xb0, 0x62, 0xf6, 0x7d,
+		0xc7, 0x70, 0x89, 0x47, 0x7c, 0xa3, 0xe7, 0x58, 0xb6, 0xef, 0x95, 0x0a, 0x4c, 0xe7, 0x56, 0xa2,
+		0x4e, 0x4c, 0x81, 0x75, 0x86, 0xc3, 0xcb, 0x94, 0x1a, 0x11, 0xa0, 0x1b, 0xb0, 0x40, 0xcb, 0xc3,
+		0xa0, 0xf5, 0x51, 0x2a, 0x6e, 0x09, 0x3b, 0x0b, 0x78, 0x9e, 0x0a, 0xaa, 0x96, 0xe7, 0x23, 0x19,
+		0x96, 0x06, 0x8b, 0x41, 0x1c, 0x56, 0xd8, 0x3e, 0xef, 0x24, 0xee, 0xa3, 0x73, 0x1a, 0x2e, 0x86,
+		0x0a, 0x98, 0xd7, 0x37, 0xe0, 0x8a, 0xe5, 0x19, 0x2d, 0xd7, 0xb1, 0x4b, 0x8b, 0x5b, 0xc2, 0xce,
+		0x3c, 0x9e, 0xb3, 0x3c, 0xd9, 0x75, 0x6c, 0xf4, 0x10, 0x0a, 0xfd, 0x5e, 0xdb, 0xf4, 0x79, 0x96,
+		0x2e, 0x65, 0xc6, 0x02, 0x02, 0x38, 0x0b, 0xc4, 0xcf, 0x40, 0xec, 0x99, 0xae, 0x6f, 0xb1, 0x58,
+		0xb6, 0x1c, 0xfb, 0xd4, 0x3a, 0x2b, 0x2d, 0x6f, 0xe5, 0x77, 0x0a, 0x0f, 0x1e, 0x4d, 0x97, 0xaa,
+		0xd4, 0xb6, 0xdd, 0x7a, 0xa8, 0x42, 0x66, 0x1a, 0x14, 0xdb, 0x77, 0x2f, 0xf0, 0x72, 0x2f, 0x2e,
+		0x45, 0x2f, 0x60, 0x95, 0x9a, 0x6f, 0x38, 0xaf, 0x88, 0xdb, 0x31, 0x7b, 0x46, 0xcf, 0xe9, 0x58,
+		0xad, 0x8b, 0x92, 0xc8, 0x2a, 0x23, 0xb9, 0x2f, 0xd0, 0x03, 0xd6, 0x02, 0x78, 0x9d, 0xa1, 0xf1,
+		0x4a, 0x6b, 0x54, 0x84, 0xde, 0xc0, 0x2d, 0xb3, 0xe5, 0x5b, 0xaf, 0x88, 0xd1, 0xea, 0xf4, 0x3d,
+		0x9f, 0xb8, 0x86, 0x47, 0x3a, 0xa4, 0xc5, 0x8e, 0xc4, 0xf7, 0x40, 0xcc, 0x29, 0xc9, 0xd5, 0x27,
+		0x31, 0xae, 0x1c, 0x50, 0x1b, 0x21, 0x93, 0x6f, 0x77, 0xd3, 0x4c, 0x59, 0x45, 0xef, 0xc2, 0x22,
+		0x3b, 0x91, 0xd7, 0x3a, 0x27, 0xed, 0x7e, 0x87, 0x94, 0x56, 0x59, 0xe4, 0x8b, 0x54, 0xd8, 0xe0,
+		0x32, 0x74, 0x04, 0xe2, 0xb0, 0x5c, 0x78, 0x37, 0x58, 0x63, 0x67, 0xfe, 0x60, 0x3a, 0x17, 0xf3,
+		0x46, 0xb0, 0x4c, 0xe2, 0x02, 0xa4, 0x43, 0x29, 0xdc, 0xb8, 0x6d, 0x8c, 0x54, 0xe4, 0x7a, 0x66,
+		0x16, 0x5c, 0x1d, 0x70, 0x95, 0x68, 0x69, 0x5e, 0x3f, 0x80, 0xb5, 0xa4, 0x70, 0x22, 0x11, 0xf2,
+		0x2f, 0xc9, 0x05, 0xeb, 0xe2, 0x0b, 0x98, 0xfe, 0x89, 0xd6, 0x60, 0xf6, 0x95, 0xd9, 0xe9, 0x07,
+		0x0d, 0x79, 0x01, 0x07, 0x0f, 0x5f, 0xe6, 0x3e, 0x17, 0xb6, 0xbf, 0xcd, 0xc1, 0xe6, 0x78, 0x53,
+		0x63, 0xca, 0xf8, 0x55, 0x85, 0xbe, 0x8c, 0x16, 0x8c, 0x30, 0x4d, 0x39, 0x0c, 0xeb, 0xc9, 0x84,
+		0xad, 0x98, 0x47, 0x69, 0x6f, 0x77, 0x8c, 0x61, 0xa7, 0x76, 0xfa, 0x3e, 0xbf, 0x24, 0xae, 0x8d,
+		0x39, 0xa0, 0xc2, 0x0d, 0xc0, 0x37, 0xa3, 0xee, 0x74, 0x7d, 0xdd, 0x91, 0xc3, 0xde, 0xed, 0xf4,
+		0x7d, 0x74, 0x04, 0x37, 0x98, 0x79, 0x13, 0xb4, 0xe7, 0xb3, 0xb4, 0x6f, 0x50, 0x76, 0x82, 0xe2,
+		0xed, 0xbf, 0x09, 0xb0, 0x9a, 0xd0, 0x69, 0x69, 0x03, 0x69, 0x3b, 0x5d, 0xd3, 0xb2, 0x0d, 0xab,
+		0xcd, 0x9d, 0x3c, 0x1f, 0x08, 0xd4, 0x36, 0xba, 0x05, 0x05, 0xbe, 0x68, 0x9b, 0xdd, 0xd0, 0xdf,
+		0x10, 0x88, 0x34, 0xb3, 0x4b, 0x26, 0xdc, 0xb8, 0xf9, 0xff, 0xf5, 0xc6, 0xbd, 0x0d, 0x45, 0xcb,
+		0xb6, 0x7c, 0xcb, 0xf4, 0x49, 0x9b, 0xda, 0x35, 0xc3, 0x2e, 0x9b, 0xc2, 0x40, 0xa6, 0xb6, 0xb7,
+		0x7f, 0x25, 0xc0, 0xba, 0xf2, 0xc6, 0x27, 0xae, 0x6d, 0x76, 0xbe, 0x93, 0x29, 0x60, 0xd4, 0xa6,
+		0xdc, 0xb8, 0x4d, 0x7f, 0x9a, 0x83, 0xd5, 0x3a, 0xb1, 0xdb, 0x96, 0x7d, 0xc6, 0x8a, 0xdb, 0xf2,
+		0x2f, 0x98, 0x45, 0xb7, 0xa0, 0x60, 0xf2, 0xe7, 0xa1, 0x97, 0x21, 0x14, 0xa9, 0x6d, 0x74, 0x08,
+		0x8b, 0x03, 0x40, 0xe6, 0xa8, 0x11, 0xaa, 0x66, 0xa3, 0x46, 0xd1, 0x8c, 0x3c, 0xa1, 0x47, 0x30,
+		0x4b, 0x0b, 0x3d, 0x98, 0x36, 0x96, 0x1e, 0xdc, 0x4b, 0xbe, 0x6f, 0xe3, 0x16, 0xd2, 0xa2, 0x26,
+		0x38, 0xe0, 0x21, 0x15, 0x56, 0xce, 0x89, 0xe9, 0xfa, 0x27, 0xc4, 0xf4, 0x8d, 0x36, 0xf1, 0x4d,
+		0xab, 0xe3, 0xf1, 0xf9, 0xe3, 0xe6, 0x84, 0xcb, 0xfb, 0xa2, 0xe3, 0x98, 0x6d, 0x2c, 0x0e, 0x68,
+		0x95, 0x80, 0x85, 0x9e, 0xc2, 0x6a, 0xc7, 0xf4, 0x7c, 0x63, 0xa8, 0x8f, 0x35, 0x88, 0xd9, 0xcc,
+		0x06, 0xb1, 0x42, 0x69, 0x4f, 0x42, 0x16, 0x95, 0xa3, 0x43, 0x60, 0xc2, 0xa0, 0x2a, 0x48, 0x3b,
+		0xd0, 0x34, 0x97, 0xa9, 0x69, 0x99, 0x92, 0x1a, 0x01, 0x87, 0xe9, 0x29, 0xc1, 0x15, 0xd3, 0xf7,
+		0x49, 0xb7, 0xe7, 0xb3, 0x89, 0x64, 0x16, 0x87, 0x8f, 0xe8, 0x1e, 0x88, 0x5d, 0xf3, 0x8d, 0xd5,
+		0xed, 0x77, 0x0d, 0x2e, 0xf2, 0xd8, 0x74, 0x31, 0x8b, 0x97, 0xb9, 0x5c, 0xe2, 0x62, 0x3a, 0x86,
+		0x0c, 0xdb, 0x1f, 0xb3, 0x64, 0x21, 0x7b, 0x0c, 0x19, 0x30, 0x98, 0x1d, 0x32, 0x2c, 0x93, 0x37,
+		0x3d, 0x2b, 0xa8, 0xd9, 0x40, 0x07, 0x64, 0xea, 0x58, 0x1a, 0x52, 0x98, 0x92, 0x47, 0x50, 0x64,
+		0x4e, 0x39, 0x35, 0xad, 0x4e, 0xdf, 0x25, 0xa5, 0x42, 0x4a, 0x98, 0x0e, 0x03, 0x0c, 0x2e, 0x50,
+		0x06, 0x7f, 0x40, 0x1f, 0xc2, 0x1a, 0x53, 0x40, 0x73, 0x9d, 0xb8, 0x86, 0xd5, 0x26, 0xb6, 0x6f,
+		0xf9, 0x17, 0x7c, 0x8c, 0x40, 0x74, 0xed, 0x88, 0x2d, 0xa9, 0x7c, 0x05, 0x7d, 0x0a, 0x1b, 0x61,
+		0x08, 0x46, 0x49, 0x8b, 0x8c, 0xb4, 0xce, 0x97, 0x47, 0x78, 0xb7, 0xa0, 0x10, 0x3a, 0x80, 0x16,
+		0xc0, 0x12, 0x2b, 0x1d, 0x08, 0x45, 0x6a, 0x7b, 0xfb, 0x8f, 0x39, 0xb8, 0xc6, 0xf3, 0x52, 0x3e,
+		0xb7, 0x3a, 0xed, 0xef, 0xa4, 0xa2, 0x3f, 0x88, 0xa8, 0xa5, 0x55, 0x17, 0x6d, 0x72, 0xe2, 0xeb,
+		0xc8, 0x40, 0xcf, 0x5a, 0xdd, 0x68, 0xfd, 0xe7, 0xc7, 0xea, 0x9f, 0x0e, 0x1a, 0x7c, 0xfc, 0x0d,
+		0xba, 0x36, 0x1f, 0x02, 0x66, 0x52, 0x06, 0x8d, 0xa0, 0x25, 0xb3, 0x4e, 0x1d, 0x0e, 0x1a, 0xbd,
+		0x51, 0x11, 0xba, 0x0a, 0x73, 0x41, 0xcf, 0x65, 0xd5, 0xb3, 0x80, 0xf9, 0xd3, 0xf6, 0xbf, 0x72,
+		0x83, 0x7e, 0x53, 0x21, 0x2d, 0xcb, 0x0b, 0xfd, 0x35, 0x68, 0x03, 0x42, 0x76, 0x1b, 0x08, 0x89,
+		0xb1, 0x36, 0x30, 0x9e, 0xe2, 0xb9, 0xcb, 0xa6, 0xf8, 0x57, 0x50, 0x8c, 0x55, 0x6b, 0xf6, 0xfb,
+		0x4f, 0xc1, 0x4b, 0xae, 0xd4, 0x99, 0x78, 0xa5, 0x62, 0xd8, 0x70, 0x5c, 0xeb, 0xcc, 0xb2, 0xcd,
+		0x8e, 0x31, 0x62, 0x64, 0x76, 0x6f, 0x59, 0x0f, 0xa9, 0x8d, 0x98, 0xb1, 0x23, 0xf9, 0x39, 0x37,
+		0x96, 0x9f, 0x7f, 0xce, 0xc1, 0xb5, 0xb0, 0x61, 0x56, 0x9d, 0x96, 0xd9, 0xa9, 0x58, 0x5e, 0xcf,
+		0xf4, 0x5b, 0xe7, 0xd3, 0xf5, 0xf7, 0xff, 0xbf, 0x3f, 0x7f, 0x0a, 0x9b, 0x71, 0x0b, 0x0c, 0xe7,
+		0xd4, 0xf0, 0xcf, 0x2d, 0xcf, 0x88, 0xba, 0x39, 0x5d, 0xe1, 0xf5, 0x98, 0x45, 0xb5, 0x53, 0xfd,
+		0xdc, 0xf2, 0x78, 0x57, 0x44, 0xef, 0x00, 0xb0, 0xb9, 0xc5, 0x77, 0x5e, 0x92, 0x20, 0x4d, 0x8b,
+		0x98, 0x0d, 0x5a, 0x3a, 0x15, 0x6c, 0x3f, 0x85, 0x42, 0xf4, 0xad, 0xe5, 0x21, 0xcc, 0xf1, 0x17,
+		0x1f, 0x81, 0xcd, 0xfc, 0xef, 0x66, 0xbc, 0xf8, 0xb0, 0x77, 0x42, 0x4e, 0xd9, 0xfe, 0x7d, 0x0e,
+		0x96, 0xe2, 0x4b, 0xe8, 0x7d, 0x58, 0x3e, 0xb1, 0x6c, 0xd3, 0xbd, 0x30, 0x5a, 0xe7, 0xa4, 0xf5,
+		0xd2, 0xeb, 0x77, 0x79, 0x10, 0x96, 0x02, 0xb1, 0xcc, 0xa5, 0x68, 0x1d, 0xe6, 0xdc, 0xbe, 0x1d,
+		0x5e, 0xdf, 0x0b, 0x78, 0xd6, 0xed, 0xd3, 0x39, 0xe7, 0x2b, 0xb8, 0x71, 0x6a, 0xb9, 0x1e, 0xbd,
+		0xf2, 0x82, 0x6a, 0x30, 0x5a, 0x4e, 0xb7, 0xd7, 0x21, 0xb1, 0x52, 0x2f, 0x31, 0x48, 0x58, 0x2f,
+		0x72, 0x08, 0x60, 0xf4, 0x62, 0xcb, 0x25, 0xe6, 0x20, 0x36, 0xd9, 0xae, 0x2c, 0x70, 0x3c, 0x6f,
+		0xe4, 0x8b, 0xac, 0xb5, 0x5b, 0xf6, 0xd9, 0xb4, 0x79, 0x5c, 0x0c, 0x09, 0x4c, 0xc1, 0x26, 0x00,
+		0x7b, 0x9b, 0xf4, 0xcd, 0x93, 0x4e, 0x70, 0x2f, 0xce, 0xe3, 0x88, 0xa4, 0xfc, 0x07, 0x01, 0xd6,
+		0x92, 0x6e, 0x7d, 0xb4, 0x0d, 0x9b, 0x75, 0x45, 0xab, 0xa8, 0xda, 0x63, 0x43, 0x92, 0x75, 0xf5,
+		0x85, 0xaa, 0x1f, 0x1b, 0x0d, 0x5d, 0xd2, 0x15, 0x43, 0xd5, 0x5e, 0x48, 0x55, 0xb5, 0x22, 0x7e,
+		0x0f, 0xbd, 0x07, 0x5b, 0x13, 0x30, 0x0d, 0xf9, 0x89, 0x52, 0x69, 0x56, 0x95, 0x8a, 0x28, 0xa4,
+		0x68, 0x6a, 0xe8, 0x12, 0xd6, 0x95, 0x8a, 0x98, 0x43, 0xdf, 0x87, 0xf7, 0x27, 0x60, 0x64, 0x49,
+		0x93, 0x95, 0xaa, 0x81, 0x95, 0x1f, 0x37, 0x95, 0x06, 0x05, 0xe7, 0xcb, 0x3f, 0x1f, 0xda, 0x1c,
+		0x6b, 0x51, 0xd1, 0x9d, 0x2a, 0x8a, 0xac, 0x36, 0xd4, 0x9a, 0x96, 0x66, 0xf3, 0x08, 0x66, 0x82,
+		0xcd, 0xa3, 0xa8, 0xd0, 0xe6, 0xf2, 0x2f, 0x72, 0xc3, 0x8f, 0x4d, 0x6a, 0x1b, 0x93, 0xfe, 0xa0,
+		0x29, 0xbf, 0x07, 0x5b, 0x47, 0x35, 0xfc, 0xec, 0xb0, 0x5a, 0x3b, 0x32, 0xd4, 0x8a, 0x81, 0x95,
+		0x66, 0x43, 0x31, 0xea, 0xb5, 0xaa, 0x2a, 0x1f, 0x47, 0x2c, 0xf9, 0x1c, 0x3e, 0x9e, 0x88, 0x92,
+		0xaa, 0x54, 0x5a, 0x69, 0xd6, 0xab, 0xaa, 0x4c, 0x77, 0x3d, 0x94, 0xd4, 0xaa, 0x52, 0x31, 0x6a,
+		0x5a, 0xf5, 0x58, 0x14, 0xd0, 0x07, 0xb0, 0x33, 0x2d, 0x53, 0xcc, 0xa1, 0xfb, 0x70, 0x6f, 0x22,
+		0x1a, 0x2b, 0x4f, 0x15, 0x59, 0x8f, 0xc0, 0xf3, 0x68, 0x1f, 0xee, 0x4f, 0x84, 0xeb, 0x0a, 0x7e,
+		0xae, 0x6a, 0xcc, 0xa1, 0x87, 0x06, 0x6e, 0x6a, 0x9a, 0xaa, 0x3d, 0x16, 0x67, 0xca, 0x17, 0xb0,
+		0x32, 0xf6, 0x56, 0x8c, 0x6e, 0xc1, 0x0d, 0x19, 0xd7, 0x34, 0xa3, 0xf6, 0x42, 0xc1, 0x55, 0xa9,
+		0x3e, 0x7e, 0xfe, 0x09, 0x80, 0xc6, 0x33, 0xb5, 0x5e, 0x0f, 0x83, 0x90, 0x04, 0x38, 0x68, 0x1e,
+		0x1e, 0x2a, 0xd8, 0xa8, 0x69, 0x8a, 0x98, 0x2b, 0xff, 0x56, 0x80, 0x95, 0xb1, 0x8b, 0x92, 0xaa,
+		0xae, 0x4b, 0x58, 0xd1, 0x74, 0x43, 0xae, 0xd6, 0x92, 0x7c, 0x3f, 0x01, 0x20, 0x1d, 0x48, 0x5a,
+		0xa5, 0xa6, 0x89, 0x02, 0xba, 0x0b, 0xdb, 0x49, 0x00, 0x9e, 0x86, 0x3c, 0x2b, 0xc5, 0x1c, 0xba,
+		0x0d, 0xef, 0x24, 0xe1, 0x06, 0x8e, 0x12, 0xf3, 0xe5, 0x7f, 0xe7, 0xe0, 0x66, 0xda, 0xe7, 0x34,
+		0x9a, 0xfc, 0x03, 0x8f, 0x2b, 0x5f, 0x2b, 0x72, 0x53, 0xa7, 0xe9, 0x16, 0xe8, 0xa3, 0x49, 0xd7,
+		0x6c, 0x44, 0x2c, 0x8f, 0x46, 0x73, 0x02, 0x58, 0xae, 0x3d, 0xaf, 0x57, 0x15, 0x9d, 0xf9, 0xb0,
+		0x0c, 0x77, 0xb3, 0xe0, 0x41, 0x6e, 0x89, 0xb9, 0x58, 0x5a, 0x4d, 0x52, 0xcd, 0xce, 0x4d, 0xab,
+		0x10, 0xed, 0x42, 0x39, 0x0b, 0x3d, 0xf0, 0x42, 0x45, 0x9c, 0x41, 0x1f, 0xc3, 0x87, 0xd9, 0x86,
+		0x6b, 0xba, 0xaa, 0x35, 0x95, 0x8a, 0x21, 0x35, 0x0c, 0x4d, 0x39, 0x12, 0x67, 0xa7, 0x39, 0xae,
+		0xae, 0x3e, 0xa7, 0xa5, 0xd1, 0xd4, 0xc5, 0xb9, 0xf2, 0x2f, 0xf3, 0xb0, 0x31, 0xe1, 0x63, 0x05,
+		0xba, 0x03, 0xb7, 0x13, 0x54, 0x8d, 0x39, 0x38, 0x15, 0xc6, 0x9b, 0x82, 0x28, 0xa4, 0xc3, 0x86,
+		0x8d, 0xed, 0x7d, 0x78, 0x77, 0x32, 0x6c, 0x18, 0xa8, 0x7c, 0xac, 0x67, 0x8c, 0x01, 0x79, 0x88,
+		0x66, 0x68, 0x5a, 0xa6, 0xa8, 0x0b, 0x83, 0x33, 0x8b, 0x76, 0xe0, 0xbd, 0xc9, 0xb8, 0x48, 0x58,
+		0xe6, 0x26, 0x84, 0x71, 0x52, 0x40, 0xae, 0xa4, 0x1f, 0x68, 0x18, 0x8a, 0xf9, 0xf2, 0x5f, 0x04,
+		0xb8, 0x2a, 0x3b, 0xb6, 0x6f, 0xd9, 0x7d, 0x22, 0x79, 0x1a, 0x79, 0xad, 0x06, 0xe3, 0xb0, 0xe3,
+		0x52, 0xdf, 0x85, 0x9a, 0xb9, 0x62, 0x43, 0xd5, 0x54, 0x5d, 0x95, 0xf4, 0x1a, 0x8e, 0x47, 0x62,
+		0x32, 0x8c, 0xb6, 0xe5, 0x8a, 0x82, 0x83, 0x14, 0x9f, 0x0c, 0xc3, 0x8a, 0x8e, 0x8f, 0x79, 0x55,
+		0x06, 0xf7, 0xcc, 0x64, 0x2c, 0x6b, 0x36, 0xe1, 0x2d, 0x20, 0xe6, 0xcb, 0xbf, 0x13, 0xa0, 0xc0,
+		0xbf, 0x91, 0xb0, 0x57, 0xe8, 0x12, 0xac, 0xd1, 0x03, 0xd6, 0x9a, 0xba, 0xa1, 0x1f, 0xd7, 0x95,
+		0x78, 0x3b, 0x89, 0xad, 0xb0, 0xf8, 0x1b, 0x7a, 0x2d, 0x48, 0xd4, 0xa0, 0x95, 0xc5, 0x01, 0x7c,
+		0x17, 0x8a, 0x61, 0x60, 0x31, 0x97, 0x8a, 0x09, 0xf4, 0xe4, 0xd1, 0x75, 0xb8, 0x1a, 0xc3, 0x3c,
+		0x51, 0x24, 0xac, 0x1f, 0x28, 0x92, 0x2e, 0xce, 0x94, 0x7f, 0x23, 0xc0, 0xb5, 0xf0, 0x3e, 0xa4,
+		0x5f, 0xa8, 0xa8, 0xe9, 0xed, 0x5a, 0xdf, 0x97, 0xcd, 0xbe, 0x47, 0xd0, 0x3d, 0xb8, 0x33, 0xb8,
+		0xc9, 0x74, 0xa9, 0xf1, 0x6c, 0x18, 0x2b, 0x43, 0x96, 0x9a, 0x8d, 0xe8, 0x69, 0x32, 0xa1, 0xdc,
+		0x04, 0x51, 0xa0, 0xd9, 0x90, 0x0e, 0xc5, 0x4a, 0x43, 0xd1, 0xc5, 0x5c, 0xf9, 0x1f, 0x05, 0xd8,
+		0x88, 0x1a, 0x47, 0x5f, 0x34, 0x49, 0x3b, 0x30, 0xed, 0x2e, 0x6c, 0xc7, 0x95, 0xf0, 0xdb, 0x6e,
+		0xd4, 0xae, 0x7d, 0xb8, 0x9f, 0x82, 0x6b, 0x6a, 0x4f, 0x24, 0xad, 0x42, 0x9f, 0x43, 0x90, 0x28,
+		0xa0, 0x47, 0xf0, 0x30, 0x85, 0x72, 0x20, 0x55, 0x86, 0x5e, 0x1e, 0xcc, 0x1d, 0x92, 0xae, 0x63,
+		0xf5, 0xa0, 0xa9, 0x2b, 0x0d, 0x31, 0x87, 0x14, 0x90, 0x32, 0x14, 0xc4, 0xaf, 0x84, 0x44, 0x35,
+		0x79, 0xf4, 0x05, 0x7c, 0x92, 0x65, 0x47, 0x90, 0x32, 0xea, 0x73, 0x05, 0x47, 0xa9, 0x33, 0xe8,
+		0x4b, 0xf8, 0x34, 0x83, 0xca, 0x77, 0x1e, 0xe3, 0xce, 0xa2, 0x87, 0xf0, 0x59, 0xa6, 0xf5, 0x72,
+		0x0d, 0x57, 0x8c, 0xe7, 0x12, 0x7e, 0x16, 0x27, 0xcf, 0x21, 0x15, 0x94, 0xac, 0x8d, 0x79, 0xff,
+		0x32, 0x12, 0x3a, 0x42, 0x44, 0xd5, 0x95, 0x29, 0xbc, 0x48, 0x05, 0x19, 0x6a, 0xe6, 0xd1, 0x63,
+		0x90, 0xa7, 0x73, 0x45, 0xba, 0xa2, 0x05, 0xf4, 0x35, 0xe8, 0x97, 0x8b, 0xaa, 0xf2, 0xb5, 0xae,
+		0x60, 0x4d, 0xca, 0xd2, 0x0c, 0xe8, 0x2b, 0xf8, 0x22, 0xd3, 0x69, 0xf1, 0xfe, 0x13, 0xa1, 0x17,
+		0xd0, 0x67, 0xf0, 0x51, 0x0a, 0x3d, 0x9a, 0x23, 0xc3, 0xd9, 0x50, 0xad, 0x88, 0x45, 0xf4, 0x09,
+		0xec, 0xa7, 0x10, 0x59, 0x15, 0x1a, 0x0d, 0x5d, 0x95, 0x9f, 0x1d, 0x07, 0xcb, 0x55, 0xb5, 0xa1,
+		0x8b, 0x8b, 0xe8, 0x47, 0xf0, 0x83, 0x14, 0xda, 0xe0, 0xb0, 0xf4, 0x0f, 0x05, 0x47, 0x4a, 0x8c,
+		0xc2, 0x9a, 0x58, 0x11, 0x97, 0xa6, 0x88, 0x49, 0x43, 0x7d, 0x9c, 0xed, 0xb9, 0x65, 0x24, 0xc3,
+		0xa3, 0xa9, 0x4a, 0x44, 0x7e, 0xa2, 0x56, 0x2b, 0xc9, 0x4a, 0x44, 0xf4, 0x11, 0xec, 0xa5, 0x28,
+		0x39, 0xac, 0x61, 0x59, 0xe1, 0xc3, 0xc3, 0xa0, 0x49, 0xac, 0xa0, 0x4f, 0xe1, 0x41, 0x1a, 0x49,
+		0x52, 0xab, 0x74, 0x02, 0x1d, 0xe5, 0x21, 0x3a, 0xd1, 0x4c, 0x77, 0x74, 0x55, 0xab, 0x37, 0x75,
+		0xa3, 0xa1, 0x7e, 0xa3, 0x88, 0xab, 0x74, 0xa2, 0xc9, 0x8c, 0x54, 0xe8, 0x2b, 0x71, 0x6d, 0xbc,
+		0x19, 0x8f, 0x6d, 0x72, 0xa0, 0x6a, 0x12, 0x3e, 0x16, 0xd7, 0x33, 0x72, 0x6f, 0xbc, 0xd1, 0xc5,
+		0x52, 0xe8, 0xea, 0x34, 0xc7, 0x51, 0x24, 0x2c, 0x3f, 0x89, 0x7a, 0x7c, 0x83, 0xde, 0x3a, 0xb7,
+		0xd9, 0x77, 0xb9, 0xb1, 0xb1, 0x2b, 0xda, 0xe2, 0xf7, 0xe1, 0x7e, 0x10, 0xb7, 0x84, 0x2c, 0x98,
+		0xd0, 0xed, 0x0f, 0xe0, 0x87, 0xd3, 0x51, 0x06, 0xeb, 0x52, 0x15, 0x2b, 0x52, 0xe5, 0x78, 0xf0,
+		0x62, 0x22, 0x94, 0x7f, 0x9d, 0x83, 0xb2, 0x6c, 0xda, 0x2d, 0xd2, 0x09, 0xff, 0x1f, 0x90, 0x6a,
+		0xe5, 0x43, 0xf8, 0x6c, 0x8a, 0x7a, 0x9f, 0x60, 0xef, 0x11, 0x34, 0x2e, 0x4b, 0x6e, 0x6a, 0xcf,
+		0xb4, 0xda, 0x91, 0x96, 0x46, 0x10, 0x05, 0xa4, 0xc1, 0xd3, 0xcb, 0x2a, 0x1e, 0x73, 0xc9, 0x70,
+		0xd2, 0xcc, 0x31, 0xa7, 0x34, 0xac, 0x33, 0xdb, 0x9c, 0xda, 0x29, 0x3c, 0x8d, 0xff, 0x3b, 0xa7,
+		0x5c, 0x96, 0x3c, 0xb5, 0x53, 0x2e, 0xab, 0x38, 0xcd, 0x29, 0x07, 0xd6, 0x5f, 0xdf, 0x6e, 0x0a,
+		0x7f, 0x7f, 0xbb, 0x29, 0xfc, 0xf3, 0xed, 0xa6, 0x00, 0x1b, 0x2d, 0xa7, 0x9b, 0xf4, 0xdd, 0xe9,
+		0x60, 0x31, 0x74, 0x55, 0x9d, 0x7e, 0x78, 0xa9, 0x0b, 0xdf, 0xec, 0x9f, 0x59, 0xfe, 0x79, 0xff,
+		0x64, 0xb7, 0xe5, 0x74, 0xf7, 0xa2, 0x3f, 0x50, 0xb9, 0x6f, 0xb5, 0x3b, 0x7b, 0x67, 0x4e, 0xf0,
+		0x83, 0x17, 0xfe, 0x6b, 0x95, 0x87, 0x66, 0xcf, 0x7a, 0xb5, 0x7f, 0x32, 0xc7, 0x64, 0x1f, 0xfd,
+		0x67, 0x00, 0x28, 0x17, 0xf3, 0x03, 0x6d, 0x23, 0x00, 0x00,
+	},
+}
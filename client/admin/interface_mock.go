@@ -301,6 +301,26 @@ func (mr *MockClientMockRecorder) GetDomainReplicationMessages(arg0, arg1 any, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationMessages", reflect.TypeOf((*MockClient)(nil).GetDomainReplicationMessages), varargs...)
 }
 
+// GetDomainReplicationStatus mocks base method.
+func (m *MockClient) GetDomainReplicationStatus(arg0 context.Context, arg1 *types.GetDomainReplicationStatusRequest, arg2 ...yarpc.CallOption) (*types.GetDomainReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*types.GetDomainReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainReplicationStatus indicates an expected call of GetDomainReplicationStatus.
+func (mr *MockClientMockRecorder) GetDomainReplicationStatus(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationStatus", reflect.TypeOf((*MockClient)(nil).GetDomainReplicationStatus), varargs...)
+}
+
 // GetDynamicConfig mocks base method.
 func (m *MockClient) GetDynamicConfig(arg0 context.Context, arg1 *types.GetDynamicConfigRequest, arg2 ...yarpc.CallOption) (*types.GetDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -673,6 +693,26 @@ func (mr *MockClientMockRecorder) UpdateDomainAsyncWorkflowConfiguraton(ctx, req
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainAsyncWorkflowConfiguraton", reflect.TypeOf((*MockClient)(nil).UpdateDomainAsyncWorkflowConfiguraton), varargs...)
 }
 
+// UpdateDomainIsolation mocks base method.
+func (m *MockClient) UpdateDomainIsolation(arg0 context.Context, arg1 *types.UpdateDomainIsolationRequest, arg2 ...yarpc.CallOption) (*types.UpdateDomainIsolationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDomainIsolation", varargs...)
+	ret0, _ := ret[0].(*types.UpdateDomainIsolationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDomainIsolation indicates an expected call of UpdateDomainIsolation.
+func (mr *MockClientMockRecorder) UpdateDomainIsolation(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainIsolation", reflect.TypeOf((*MockClient)(nil).UpdateDomainIsolation), varargs...)
+}
+
 // UpdateDomainIsolationGroups mocks base method.
 func (m *MockClient) UpdateDomainIsolationGroups(ctx context.Context, request *types.UpdateDomainIsolationGroupsRequest, opts ...yarpc.CallOption) (*types.UpdateDomainIsolationGroupsResponse, error) {
 	m.ctrl.T.Helper()
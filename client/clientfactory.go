@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
 	"github.com/uber/cadence/.gen/go/matching/matchingserviceclient"
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	matchingv1 "github.com/uber/cadence/.gen/proto/matching/v1"
 	"github.com/uber/cadence/client/admin"
@@ -196,7 +197,7 @@ func (cf *rpcClientFactory) NewAdminClientWithTimeoutAndConfig(
 ) (admin.Client, error) {
 	var client admin.Client
 	if rpc.IsGRPCOutbound(config) {
-		client = grpc.NewAdminClient(adminv1.NewAdminAPIYARPCClient(config))
+		client = grpc.NewAdminClient(adminv1.NewAdminAPIYARPCClient(config), frontendv1.NewFrontendAdminAPIYARPCClient(config))
 	} else {
 		client = thrift.NewAdminClient(adminserviceclient.New(config))
 	}
@@ -224,6 +225,8 @@ func (cf *rpcClientFactory) NewFrontendClientWithTimeoutAndConfig(
 			apiv1.NewWorkerAPIYARPCClient(config),
 			apiv1.NewVisibilityAPIYARPCClient(config),
 			apiv1.NewScheduleAPIYARPCClient(config),
+			frontendv1.NewScheduleAPIYARPCClient(config),
+			frontendv1.NewFrontendAPIYARPCClient(config),
 		)
 	} else {
 		client = thrift.NewFrontendClient(workflowserviceclient.New(config))
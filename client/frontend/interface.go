@@ -32,7 +32,7 @@ import (
 //go:generate gowrap gen -g -p . -i Client -t ../templates/retry.tmpl -o ../wrappers/retryable/frontend_generated.go -v client=Frontend
 //go:generate gowrap gen -g -p . -i Client -t ../templates/metered.tmpl -o ../wrappers/metered/frontend_generated.go -v client=Frontend
 //go:generate gowrap gen -g -p . -i Client -t ../templates/errorinjectors.tmpl -o ../wrappers/errorinjectors/frontend_generated.go -v client=Frontend
-//go:generate gowrap gen -g -p . -i Client -t ../templates/grpc.tmpl -o ../wrappers/grpc/frontend_generated.go -v client=Frontend -v package=apiv1 -v path=github.com/uber/cadence-idl/go/proto/api/v1 -v prefix=
+//go:generate gowrap gen -g -p . -i Client -t ../templates/grpc.tmpl -o ../wrappers/grpc/frontend_generated.go -v client=Frontend -v package=apiv1 -v path=github.com/uber/cadence-idl/go/proto/api/v1 -v prefix= -v localPrefix=Frontend
 //go:generate gowrap gen -g -p . -i Client -t ../templates/thrift.tmpl -o ../wrappers/thrift/frontend_generated.go -v client=Frontend -v prefix=
 //go:generate gowrap gen -g -p . -i Client -t ../templates/timeout.tmpl -o ../wrappers/timeout/frontend_generated.go -v client=Frontend
 
@@ -93,5 +93,13 @@ type Client interface {
 	PauseSchedule(context.Context, *types.PauseScheduleRequest, ...yarpc.CallOption) (*types.PauseScheduleResponse, error)
 	UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest, ...yarpc.CallOption) (*types.UnpauseScheduleResponse, error)
 	BackfillSchedule(context.Context, *types.BackfillScheduleRequest, ...yarpc.CallOption) (*types.BackfillScheduleResponse, error)
+	TriggerSchedule(context.Context, *types.TriggerScheduleRequest, ...yarpc.CallOption) (*types.TriggerScheduleResponse, error)
+	PauseActivity(context.Context, *types.PauseActivityRequest, ...yarpc.CallOption) (*types.PauseActivityResponse, error)
+	UnpauseActivity(context.Context, *types.UnpauseActivityRequest, ...yarpc.CallOption) (*types.UnpauseActivityResponse, error)
+	ResetActivity(context.Context, *types.ResetActivityRequest, ...yarpc.CallOption) (*types.ResetActivityResponse, error)
+	UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest, ...yarpc.CallOption) (*types.UpdateActivityOptionsResponse, error)
+	PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest, ...yarpc.CallOption) (*types.PauseWorkflowExecutionResponse, error)
+	ResumeWorkflowExecution(context.Context, *types.ResumeWorkflowExecutionRequest, ...yarpc.CallOption) (*types.ResumeWorkflowExecutionResponse, error)
+	ListScheduleMatchingTimes(context.Context, *types.ListScheduleMatchingTimesRequest, ...yarpc.CallOption) (*types.ListScheduleMatchingTimesResponse, error)
 	ListSchedules(context.Context, *types.ListSchedulesRequest, ...yarpc.CallOption) (*types.ListSchedulesResponse, error)
 }
@@ -599,6 +599,138 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.PauseWorkflowExecutionResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.PauseWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*types.ResumeWorkflowExecutionResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.ResumeWorkflowExecutionResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.ResumeWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (*types.PauseActivityResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.PauseActivityResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.PauseActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (*types.UnpauseActivityResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.UnpauseActivityResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.UnpauseActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ResetActivity(
+	ctx context.Context,
+	request *types.HistoryResetActivityRequest,
+	opts ...yarpc.CallOption,
+) (*types.ResetActivityResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.ResetActivityResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.ResetActivity(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.HistoryUpdateActivityOptionsRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateActivityOptionsResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetRequest().GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return nil, err
+	}
+	var response *types.UpdateActivityOptionsResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		response, err = c.client.UpdateActivityOptions(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	request *types.HistoryResetWorkflowExecutionRequest,
@@ -955,6 +1087,143 @@ func (c *clientImpl) CountDLQMessages(
 	return &types.HistoryCountDLQMessagesResponse{Entries: entries}, err
 }
 
+func (c *clientImpl) GetDomainReplicationStatus(
+	ctx context.Context,
+	request *types.HistoryGetDomainReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryGetDomainReplicationStatusResponse, error) {
+
+	peers, err := c.peerResolver.GetAllPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	responses := make([]*types.HistoryGetDomainReplicationStatusResponse, 0, len(peers))
+	failedPeers := map[string]struct{}{}
+	var firstErr error
+
+	g := &errgroup.Group{}
+	for _, peer := range peers {
+		peer := peer
+		g.Go(func() (e error) {
+			defer func() { log.CapturePanic(recover(), c.logger, &e) }()
+
+			response, err := c.client.GetDomainReplicationStatus(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				c.logger.Warn("Failed to get domain replication status from history host", tag.Address(peer), tag.Error(err))
+				failedPeers[peer] = struct{}{}
+				if firstErr == nil {
+					firstErr = err
+				}
+				return nil
+			}
+			responses = append(responses, response)
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	if len(responses) == 0 && firstErr != nil {
+		return nil, firstErr
+	}
+
+	// the status of the hosts that did not respond is reported as failed for the shards they own
+	failedShards, err := c.getShardsOwnedByPeers(failedPeers)
+	if err != nil {
+		return nil, err
+	}
+	result := &types.HistoryGetDomainReplicationStatusResponse{
+		Entries:      map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{},
+		FailedShards: failedShards,
+	}
+	for _, response := range responses {
+		for key, status := range response.Entries {
+			result.Entries[key] = status
+		}
+		result.FailedShards = append(result.FailedShards, response.FailedShards...)
+	}
+	return result, nil
+}
+
+func (c *clientImpl) UpdateDomainIsolation(
+	ctx context.Context,
+	request *types.HistoryUpdateDomainIsolationRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryUpdateDomainIsolationResponse, error) {
+
+	peers, err := c.peerResolver.GetAllPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	var failedShards []int32
+	failedPeers := map[string]struct{}{}
+	var firstErr error
+
+	g := &errgroup.Group{}
+	for _, peer := range peers {
+		peer := peer
+		g.Go(func() (e error) {
+			defer func() { log.CapturePanic(recover(), c.logger, &e) }()
+
+			response, err := c.client.UpdateDomainIsolation(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				c.logger.Warn("Failed to update domain isolation on history host", tag.Address(peer), tag.Error(err))
+				failedPeers[peer] = struct{}{}
+				if firstErr == nil {
+					firstErr = err
+				}
+				return nil
+			}
+			failedShards = append(failedShards, response.GetFailedShards()...)
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	if len(failedPeers) == len(peers) && firstErr != nil {
+		return nil, firstErr
+	}
+
+	// the change is not applied to the shards of the hosts that did not respond, they are reported as failed
+	failedPeerShards, err := c.getShardsOwnedByPeers(failedPeers)
+	if err != nil {
+		return nil, err
+	}
+	return &types.HistoryUpdateDomainIsolationResponse{
+		FailedShards: append(failedPeerShards, failedShards...),
+	}, nil
+}
+
+func (c *clientImpl) getShardsOwnedByPeers(peers map[string]struct{}) ([]int32, error) {
+	if len(peers) == 0 {
+		return nil, nil
+	}
+	var shardIDs []int32
+	for shardID := 0; shardID < c.numberOfShards; shardID++ {
+		peer, err := c.peerResolver.FromShardID(shardID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := peers[peer]; ok {
+			shardIDs = append(shardIDs, int32(shardID))
+		}
+	}
+	return shardIDs, nil
+}
+
 func (c *clientImpl) ReadDLQMessages(
 	ctx context.Context,
 	request *types.ReadDLQMessagesRequest,
@@ -376,6 +376,107 @@ func TestClient_withResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "GetDomainReplicationStatus",
+			op: func(c Client) (any, error) {
+				return c.GetDomainReplicationStatus(context.Background(), &types.HistoryGetDomainReplicationStatusRequest{DomainUUID: "test-domain-id"})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer-0", "test-peer-1"}, nil).Times(1)
+				c.EXPECT().GetDomainReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.HistoryGetDomainReplicationStatusResponse{
+						Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{
+							{ShardID: 1, ClusterName: "standby"}: {ClusterName: "standby", PendingTaskCount: 1},
+						},
+					}, nil).Times(1)
+				c.EXPECT().GetDomainReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(&types.HistoryGetDomainReplicationStatusResponse{
+						Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{
+							{ShardID: 2, ClusterName: "standby"}: {ClusterName: "standby", PendingTaskCount: 2},
+						},
+					}, nil).Times(1)
+			},
+			want: &types.HistoryGetDomainReplicationStatusResponse{
+				Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{
+					{ShardID: 1, ClusterName: "standby"}: {ClusterName: "standby", PendingTaskCount: 1},
+					{ShardID: 2, ClusterName: "standby"}: {ClusterName: "standby", PendingTaskCount: 2},
+				},
+			},
+		},
+		{
+			name: "GetDomainReplicationStatus with failed host",
+			op: func(c Client) (any, error) {
+				return c.GetDomainReplicationStatus(context.Background(), &types.HistoryGetDomainReplicationStatusRequest{DomainUUID: "test-domain-id"})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer-0", "test-peer-1"}, nil).Times(1)
+				p.EXPECT().FromShardID(gomock.Any()).DoAndReturn(func(shardID int) (string, error) {
+					return fmt.Sprintf("test-peer-%d", shardID%2), nil
+				}).Times(10)
+				c.EXPECT().GetDomainReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.HistoryGetDomainReplicationStatusResponse{
+						Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{
+							{ShardID: 0, ClusterName: "standby"}: {ClusterName: "standby", PendingTaskCount: 1},
+						},
+						FailedShards: []int32{2},
+					}, nil).Times(1)
+				c.EXPECT().GetDomainReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(nil, fmt.Errorf("GetDomainReplicationStatus failed")).Times(1)
+			},
+			want: &types.HistoryGetDomainReplicationStatusResponse{
+				Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{
+					{ShardID: 0, ClusterName: "standby"}: {ClusterName: "standby", PendingTaskCount: 1},
+				},
+				FailedShards: []int32{1, 3, 5, 7, 9, 2},
+			},
+		},
+		{
+			name: "UpdateDomainIsolation",
+			op: func(c Client) (any, error) {
+				return c.UpdateDomainIsolation(context.Background(), &types.HistoryUpdateDomainIsolationRequest{DomainUUID: "test-domain-id", Isolated: true})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer-0", "test-peer-1"}, nil).Times(1)
+				c.EXPECT().UpdateDomainIsolation(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.HistoryUpdateDomainIsolationResponse{}, nil).Times(1)
+				c.EXPECT().UpdateDomainIsolation(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(&types.HistoryUpdateDomainIsolationResponse{FailedShards: []int32{3}}, nil).Times(1)
+			},
+			want: &types.HistoryUpdateDomainIsolationResponse{
+				FailedShards: []int32{3},
+			},
+		},
+		{
+			name: "UpdateDomainIsolation with failed host",
+			op: func(c Client) (any, error) {
+				return c.UpdateDomainIsolation(context.Background(), &types.HistoryUpdateDomainIsolationRequest{DomainUUID: "test-domain-id", Isolated: true})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer-0", "test-peer-1"}, nil).Times(1)
+				p.EXPECT().FromShardID(gomock.Any()).DoAndReturn(func(shardID int) (string, error) {
+					return fmt.Sprintf("test-peer-%d", shardID%2), nil
+				}).Times(10)
+				c.EXPECT().UpdateDomainIsolation(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.HistoryUpdateDomainIsolationResponse{FailedShards: []int32{2}}, nil).Times(1)
+				c.EXPECT().UpdateDomainIsolation(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(nil, fmt.Errorf("UpdateDomainIsolation failed")).Times(1)
+			},
+			want: &types.HistoryUpdateDomainIsolationResponse{
+				FailedShards: []int32{1, 3, 5, 7, 9, 2},
+			},
+		},
+		{
+			name: "UpdateDomainIsolation with all hosts failed",
+			op: func(c Client) (any, error) {
+				return c.UpdateDomainIsolation(context.Background(), &types.HistoryUpdateDomainIsolationRequest{DomainUUID: "test-domain-id", Isolated: true})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer-0"}, nil).Times(1)
+				c.EXPECT().UpdateDomainIsolation(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(nil, fmt.Errorf("UpdateDomainIsolation failed")).Times(1)
+			},
+			wantError: true,
+		},
 		{
 			name: "QueryWorkflow",
 			op: func(c Client) (any, error) {
@@ -839,6 +940,18 @@ func TestClient_withResponse(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "GetDomainReplicationStatus fail",
+			op: func(c Client) (any, error) {
+				return c.GetDomainReplicationStatus(context.Background(), &types.HistoryGetDomainReplicationStatusRequest{DomainUUID: "test-domain-id"})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().GetAllPeers().Return([]string{"test-peer"}, nil).Times(1)
+				c.EXPECT().GetDomainReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil, fmt.Errorf("GetDomainReplicationStatus failed")).Times(1)
+			},
+			wantError: true,
+		},
 		{
 			name: "QueryWorkflow fail",
 			op: func(c Client) (any, error) {
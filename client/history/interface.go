@@ -34,7 +34,7 @@ import (
 //go:generate gowrap gen -g -p . -i Client -t ../templates/errorinjectors.tmpl -o ../wrappers/errorinjectors/history_generated.go -v client=History
 //go:generate gowrap gen -g -p . -i Client -t ../templates/grpc.tmpl -o ../wrappers/grpc/history_generated.go -v client=History -v package=historyv1 -v path=github.com/uber/cadence/.gen/proto/history/v1 -v prefix=History
 //go:generate gowrap gen -g -p . -i Client -t ../templates/thrift.tmpl -o ../wrappers/thrift/history_generated.go -v client=History -v prefix=History
-//go:generate gowrap gen -g -p . -i Client -t ../templates/timeout.tmpl -o ../wrappers/timeout/history_generated.go -v client=History -v exclude=GetReplicationMessages|GetDLQReplicationMessages|CountDLQMessages|ReadDLQMessages|PurgeDLQMessages|MergeDLQMessages|GetCrossClusterTasks|GetFailoverInfo
+//go:generate gowrap gen -g -p . -i Client -t ../templates/timeout.tmpl -o ../wrappers/timeout/history_generated.go -v client=History -v exclude=GetReplicationMessages|GetDLQReplicationMessages|CountDLQMessages|GetDomainReplicationStatus|ReadDLQMessages|PurgeDLQMessages|MergeDLQMessages|GetCrossClusterTasks|GetFailoverInfo
 
 // Client is the interface exposed by history service client
 type Client interface {
@@ -46,6 +46,8 @@ type Client interface {
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest, ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error)
 	CountDLQMessages(context.Context, *types.CountDLQMessagesRequest, ...yarpc.CallOption) (*types.HistoryCountDLQMessagesResponse, error)
+	GetDomainReplicationStatus(context.Context, *types.HistoryGetDomainReplicationStatusRequest, ...yarpc.CallOption) (*types.HistoryGetDomainReplicationStatusResponse, error)
+	UpdateDomainIsolation(context.Context, *types.HistoryUpdateDomainIsolationRequest, ...yarpc.CallOption) (*types.HistoryUpdateDomainIsolationResponse, error)
 	GetMutableState(context.Context, *types.GetMutableStateRequest, ...yarpc.CallOption) (*types.GetMutableStateResponse, error)
 	GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetReplicationMessagesResponse, error)
 	MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest, ...yarpc.CallOption) (*types.MergeDLQMessagesResponse, error)
@@ -80,6 +82,12 @@ type Client interface {
 	SyncActivity(context.Context, *types.SyncActivityRequest, ...yarpc.CallOption) error
 	SyncShardStatus(context.Context, *types.SyncShardStatusRequest, ...yarpc.CallOption) error
 	TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest, ...yarpc.CallOption) error
+	PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest, ...yarpc.CallOption) (*types.PauseWorkflowExecutionResponse, error)
+	ResumeWorkflowExecution(context.Context, *types.HistoryResumeWorkflowExecutionRequest, ...yarpc.CallOption) (*types.ResumeWorkflowExecutionResponse, error)
+	PauseActivity(context.Context, *types.HistoryPauseActivityRequest, ...yarpc.CallOption) (*types.PauseActivityResponse, error)
+	UnpauseActivity(context.Context, *types.HistoryUnpauseActivityRequest, ...yarpc.CallOption) (*types.UnpauseActivityResponse, error)
+	ResetActivity(context.Context, *types.HistoryResetActivityRequest, ...yarpc.CallOption) (*types.ResetActivityResponse, error)
+	UpdateActivityOptions(context.Context, *types.HistoryUpdateActivityOptionsRequest, ...yarpc.CallOption) (*types.UpdateActivityOptionsResponse, error)
 	GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest, ...yarpc.CallOption) (*types.GetFailoverInfoResponse, error)
 
 	// RatelimitUpdate pushes usage info for the passed ratelimit keys, and requests updated weight info from aggregating hosts.
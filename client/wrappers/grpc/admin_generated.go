@@ -79,6 +79,11 @@ func (g adminClient) GetDomainReplicationMessages(ctx context.Context, gp1 *type
 	return proto.ToAdminGetDomainReplicationMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) GetDomainReplicationStatus(ctx context.Context, gp1 *types.GetDomainReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDomainReplicationStatusResponse, err error) {
+	response, err := g.local.GetDomainReplicationStatus(ctx, proto.FromFrontendGetDomainReplicationStatusRequest(gp1), p1...)
+	return proto.ToFrontendGetDomainReplicationStatusResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDynamicConfigResponse, err error) {
 	response, err := g.c.GetDynamicConfig(ctx, proto.FromAdminGetDynamicConfigRequest(gp1), p1...)
 	return proto.ToAdminGetDynamicConfigResponse(response), proto.ToError(err)
@@ -174,6 +179,11 @@ func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context,
 	return proto.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) UpdateDomainIsolation(ctx context.Context, up1 *types.UpdateDomainIsolationRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainIsolationResponse, err error) {
+	response, err := g.local.UpdateDomainIsolation(ctx, proto.FromFrontendUpdateDomainIsolationRequest(up1), p1...)
+	return proto.ToFrontendUpdateDomainIsolationResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) UpdateDomainIsolationGroups(ctx context.Context, request *types.UpdateDomainIsolationGroupsRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainIsolationGroupsResponse, err error) {
 	response, err := g.c.UpdateDomainIsolationGroups(ctx, proto.FromAdminUpdateDomainIsolationGroupsRequest(request), opts...)
 	return proto.ToAdminUpdateDomainIsolationGroupsResponse(response), proto.ToError(err)
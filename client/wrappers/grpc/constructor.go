@@ -26,6 +26,7 @@ import (
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	matchingv1 "github.com/uber/cadence/.gen/proto/matching/v1"
 	"github.com/uber/cadence/client/admin"
@@ -36,7 +37,8 @@ import (
 
 type (
 	adminClient struct {
-		c adminv1.AdminAPIYARPCClient
+		c     adminv1.AdminAPIYARPCClient
+		local frontendv1.FrontendAdminAPIYARPCClient
 	}
 
 	frontendGRPCClientWrapper struct {
@@ -46,8 +48,13 @@ type (
 		apiv1.VisibilityAPIYARPCClient
 		apiv1.ScheduleAPIYARPCClient
 	}
+	frontendLocalGRPCClientWrapper struct {
+		frontendv1.ScheduleAPIYARPCClient
+		frontendv1.FrontendAPIYARPCClient
+	}
 	frontendClient struct {
-		c *frontendGRPCClientWrapper
+		c     *frontendGRPCClientWrapper
+		local *frontendLocalGRPCClientWrapper
 	}
 	historyClient struct {
 		c historyv1.HistoryAPIYARPCClient
@@ -57,8 +64,8 @@ type (
 	}
 )
 
-func NewAdminClient(c adminv1.AdminAPIYARPCClient) admin.Client {
-	return adminClient{c}
+func NewAdminClient(c adminv1.AdminAPIYARPCClient, local frontendv1.FrontendAdminAPIYARPCClient) admin.Client {
+	return adminClient{c, local}
 }
 
 func NewFrontendClient(
@@ -67,8 +74,13 @@ func NewFrontendClient(
 	worker apiv1.WorkerAPIYARPCClient,
 	visibility apiv1.VisibilityAPIYARPCClient,
 	schedule apiv1.ScheduleAPIYARPCClient,
+	localSchedule frontendv1.ScheduleAPIYARPCClient,
+	local frontendv1.FrontendAPIYARPCClient,
 ) frontend.Client {
-	return frontendClient{&frontendGRPCClientWrapper{domain, workflow, worker, visibility, schedule}}
+	return frontendClient{
+		&frontendGRPCClientWrapper{domain, workflow, worker, visibility, schedule},
+		&frontendLocalGRPCClientWrapper{localSchedule, local},
+	}
 }
 
 func NewHistoryClient(c historyv1.HistoryAPIYARPCClient) history.Client {
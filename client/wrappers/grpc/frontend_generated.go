@@ -25,8 +25,8 @@ func (g frontendClient) CountWorkflowExecutions(ctx context.Context, cp1 *types.
 }
 
 func (g frontendClient) CreateSchedule(ctx context.Context, cp1 *types.CreateScheduleRequest, p1 ...yarpc.CallOption) (cp2 *types.CreateScheduleResponse, err error) {
-	response, err := g.c.CreateSchedule(ctx, proto.FromCreateScheduleRequest(cp1), p1...)
-	return proto.ToCreateScheduleResponse(response), proto.ToError(err)
+	response, err := g.local.CreateSchedule(ctx, proto.FromFrontendCreateScheduleRequest(cp1), p1...)
+	return proto.ToFrontendCreateScheduleResponse(response), proto.ToError(err)
 }
 
 func (g frontendClient) DeleteDomain(ctx context.Context, dp1 *types.DeleteDomainRequest, p1 ...yarpc.CallOption) (err error) {
@@ -50,8 +50,8 @@ func (g frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeD
 }
 
 func (g frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeScheduleResponse, err error) {
-	response, err := g.c.DescribeSchedule(ctx, proto.FromDescribeScheduleRequest(dp1), p1...)
-	return proto.ToDescribeScheduleResponse(response), proto.ToError(err)
+	response, err := g.local.DescribeSchedule(ctx, proto.FromFrontendDescribeScheduleRequest(dp1), p1...)
+	return proto.ToFrontendDescribeScheduleResponse(response), proto.ToError(err)
 }
 
 func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
@@ -119,9 +119,14 @@ func (g frontendClient) ListOpenWorkflowExecutions(ctx context.Context, lp1 *typ
 	return proto.ToListOpenWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ListScheduleMatchingTimes(ctx context.Context, lp1 *types.ListScheduleMatchingTimesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListScheduleMatchingTimesResponse, err error) {
+	response, err := g.local.ListScheduleMatchingTimes(ctx, proto.FromFrontendListScheduleMatchingTimesRequest(lp1), p1...)
+	return proto.ToFrontendListScheduleMatchingTimesResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSchedulesResponse, err error) {
-	response, err := g.c.ListSchedules(ctx, proto.FromListSchedulesRequest(lp1), p1...)
-	return proto.ToListSchedulesResponse(response), proto.ToError(err)
+	response, err := g.local.ListSchedules(ctx, proto.FromFrontendListSchedulesRequest(lp1), p1...)
+	return proto.ToFrontendListSchedulesResponse(response), proto.ToError(err)
 }
 
 func (g frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.ListTaskListPartitionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListTaskListPartitionsResponse, err error) {
@@ -134,11 +139,21 @@ func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.L
 	return proto.ToListWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseActivityResponse, err error) {
+	response, err := g.local.PauseActivity(ctx, proto.FromFrontendPauseActivityRequest(pp1), p1...)
+	return proto.ToFrontendPauseActivityResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseScheduleResponse, err error) {
 	response, err := g.c.PauseSchedule(ctx, proto.FromPauseScheduleRequest(pp1), p1...)
 	return proto.ToPauseScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseWorkflowExecutionResponse, err error) {
+	response, err := g.local.PauseWorkflowExecution(ctx, proto.FromFrontendPauseWorkflowExecutionRequest(pp1), p1...)
+	return proto.ToFrontendPauseWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, proto.FromPollForActivityTaskRequest(pp1), p1...)
 	return proto.ToPollForActivityTaskResponse(response), proto.ToError(err)
@@ -179,6 +194,11 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return proto.ToError(err)
 }
 
+func (g frontendClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetActivityResponse, err error) {
+	response, err := g.local.ResetActivity(ctx, proto.FromFrontendResetActivityRequest(rp1), p1...)
+	return proto.ToFrontendResetActivityResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, proto.FromResetStickyTaskListRequest(rp1), p1...)
 	return proto.ToResetStickyTaskListResponse(response), proto.ToError(err)
@@ -239,6 +259,11 @@ func (g frontendClient) RestartWorkflowExecution(ctx context.Context, rp1 *types
 	return proto.ToRestartWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ResumeWorkflowExecution(ctx context.Context, rp1 *types.ResumeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (rp2 *types.ResumeWorkflowExecutionResponse, err error) {
+	response, err := g.local.ResumeWorkflowExecution(ctx, proto.FromFrontendResumeWorkflowExecutionRequest(rp1), p1...)
+	return proto.ToFrontendResumeWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.c.ScanWorkflowExecutions(ctx, proto.FromScanWorkflowExecutionsRequest(lp1), p1...)
 	return proto.ToScanWorkflowExecutionsResponse(response), proto.ToError(err)
@@ -274,17 +299,32 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return proto.ToError(err)
 }
 
+func (g frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (tp2 *types.TriggerScheduleResponse, err error) {
+	response, err := g.local.TriggerSchedule(ctx, proto.FromFrontendTriggerScheduleRequest(tp1), p1...)
+	return proto.ToFrontendTriggerScheduleResponse(response), proto.ToError(err)
+}
+
+func (g frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseActivityResponse, err error) {
+	response, err := g.local.UnpauseActivity(ctx, proto.FromFrontendUnpauseActivityRequest(up1), p1...)
+	return proto.ToFrontendUnpauseActivityResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseScheduleResponse, err error) {
 	response, err := g.c.UnpauseSchedule(ctx, proto.FromUnpauseScheduleRequest(up1), p1...)
 	return proto.ToUnpauseScheduleResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	response, err := g.local.UpdateActivityOptions(ctx, proto.FromFrontendUpdateActivityOptionsRequest(up1), p1...)
+	return proto.ToFrontendUpdateActivityOptionsResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, proto.FromUpdateDomainRequest(up1), p1...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
 }
 
 func (g frontendClient) UpdateSchedule(ctx context.Context, up1 *types.UpdateScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateScheduleResponse, err error) {
-	response, err := g.c.UpdateSchedule(ctx, proto.FromUpdateScheduleRequest(up1), p1...)
-	return proto.ToUpdateScheduleResponse(response), proto.ToError(err)
+	response, err := g.local.UpdateSchedule(ctx, proto.FromFrontendUpdateScheduleRequest(up1), p1...)
+	return proto.ToFrontendUpdateScheduleResponse(response), proto.ToError(err)
 }
@@ -53,6 +53,11 @@ func (g historyClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types
 	return proto.ToHistoryGetDLQReplicationMessagesResponse(response), proto.ToError(err)
 }
 
+func (g historyClient) GetDomainReplicationStatus(ctx context.Context, hp1 *types.HistoryGetDomainReplicationStatusRequest, p1 ...yarpc.CallOption) (hp2 *types.HistoryGetDomainReplicationStatusResponse, err error) {
+	response, err := g.c.GetDomainReplicationStatus(ctx, proto.FromHistoryGetDomainReplicationStatusRequest(hp1), p1...)
+	return proto.ToHistoryGetDomainReplicationStatusResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) GetFailoverInfo(ctx context.Context, gp1 *types.GetFailoverInfoRequest, p1 ...yarpc.CallOption) (gp2 *types.GetFailoverInfoResponse, err error) {
 	response, err := g.c.GetFailoverInfo(ctx, proto.FromHistoryGetFailoverInfoRequest(gp1), p1...)
 	return proto.ToHistoryGetFailoverInfoResponse(response), proto.ToError(err)
@@ -78,6 +83,16 @@ func (g historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.Not
 	return proto.ToError(err)
 }
 
+func (g historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (pp1 *types.PauseActivityResponse, err error) {
+	response, err := g.c.PauseActivity(ctx, proto.FromHistoryPauseActivityRequest(hp1), p1...)
+	return proto.ToHistoryPauseActivityResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (pp1 *types.PauseWorkflowExecutionResponse, err error) {
+	response, err := g.c.PauseWorkflowExecution(ctx, proto.FromHistoryPauseWorkflowExecutionRequest(hp1), p1...)
+	return proto.ToHistoryPauseWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	response, err := g.c.PollMutableState(ctx, proto.FromHistoryPollMutableStateRequest(pp1), p1...)
 	return proto.ToHistoryPollMutableStateResponse(response), proto.ToError(err)
@@ -153,6 +168,11 @@ func (g historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *
 	return proto.ToError(err)
 }
 
+func (g historyClient) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest, p1 ...yarpc.CallOption) (rp1 *types.ResetActivityResponse, err error) {
+	response, err := g.c.ResetActivity(ctx, proto.FromHistoryResetActivityRequest(hp1), p1...)
+	return proto.ToHistoryResetActivityResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ResetQueue(ctx, proto.FromHistoryResetQueueRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -198,6 +218,11 @@ func (g historyClient) RespondDecisionTaskFailed(ctx context.Context, hp1 *types
 	return proto.ToError(err)
 }
 
+func (g historyClient) ResumeWorkflowExecution(ctx context.Context, hp1 *types.HistoryResumeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (rp1 *types.ResumeWorkflowExecutionResponse, err error) {
+	response, err := g.c.ResumeWorkflowExecution(ctx, proto.FromHistoryResumeWorkflowExecutionRequest(hp1), p1...)
+	return proto.ToHistoryResumeWorkflowExecutionResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.ScheduleDecisionTaskRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ScheduleDecisionTask(ctx, proto.FromHistoryScheduleDecisionTaskRequest(sp1), p1...)
 	return proto.ToError(err)
@@ -232,3 +257,18 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	_, err = g.c.TerminateWorkflowExecution(ctx, proto.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToError(err)
 }
+
+func (g historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (up1 *types.UnpauseActivityResponse, err error) {
+	response, err := g.c.UnpauseActivity(ctx, proto.FromHistoryUnpauseActivityRequest(hp1), p1...)
+	return proto.ToHistoryUnpauseActivityResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	response, err := g.c.UpdateActivityOptions(ctx, proto.FromHistoryUpdateActivityOptionsRequest(hp1), p1...)
+	return proto.ToHistoryUpdateActivityOptionsResponse(response), proto.ToError(err)
+}
+
+func (g historyClient) UpdateDomainIsolation(ctx context.Context, hp1 *types.HistoryUpdateDomainIsolationRequest, p1 ...yarpc.CallOption) (hp2 *types.HistoryUpdateDomainIsolationResponse, err error) {
+	response, err := g.c.UpdateDomainIsolation(ctx, proto.FromHistoryUpdateDomainIsolationRequest(hp1), p1...)
+	return proto.ToHistoryUpdateDomainIsolationResponse(response), proto.ToError(err)
+}
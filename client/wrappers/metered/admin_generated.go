@@ -341,6 +341,30 @@ func (c *adminClient) GetDomainReplicationMessages(ctx context.Context, gp1 *typ
 	return gp2, err
 }
 
+func (c *adminClient) GetDomainReplicationStatus(ctx context.Context, gp1 *types.GetDomainReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDomainReplicationStatusResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientGetDomainReplicationStatusScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientGetDomainReplicationStatusScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp2, err = c.client.GetDomainReplicationStatus(ctx, gp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
 func (c *adminClient) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDynamicConfigResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -797,6 +821,30 @@ func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context,
 	return up1, err
 }
 
+func (c *adminClient) UpdateDomainIsolation(ctx context.Context, up1 *types.UpdateDomainIsolationRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainIsolationResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientUpdateDomainIsolationScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientUpdateDomainIsolationScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	up2, err = c.client.UpdateDomainIsolation(ctx, up1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return up2, err
+}
+
 func (c *adminClient) UpdateDomainIsolationGroups(ctx context.Context, request *types.UpdateDomainIsolationGroupsRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainIsolationGroupsResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -166,6 +166,17 @@ func (c *adminClient) GetDomainReplicationMessages(ctx context.Context, gp1 *typ
 	return resp, err
 }
 
+func (c *adminClient) GetDomainReplicationStatus(ctx context.Context, gp1 *types.GetDomainReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDomainReplicationStatusResponse, err error) {
+	var resp *types.GetDomainReplicationStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDomainReplicationStatus(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDynamicConfigResponse, err error) {
 	var resp *types.GetDynamicConfigResponse
 	op := func(ctx context.Context) error {
@@ -343,6 +354,17 @@ func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context,
 	return resp, err
 }
 
+func (c *adminClient) UpdateDomainIsolation(ctx context.Context, up1 *types.UpdateDomainIsolationRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainIsolationResponse, err error) {
+	var resp *types.UpdateDomainIsolationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateDomainIsolation(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) UpdateDomainIsolationGroups(ctx context.Context, request *types.UpdateDomainIsolationGroupsRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainIsolationGroupsResponse, err error) {
 	var resp *types.UpdateDomainIsolationGroupsResponse
 	op := func(ctx context.Context) error {
@@ -254,6 +254,17 @@ func (c *frontendClient) ListOpenWorkflowExecutions(ctx context.Context, lp1 *ty
 	return resp, err
 }
 
+func (c *frontendClient) ListScheduleMatchingTimes(ctx context.Context, lp1 *types.ListScheduleMatchingTimesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListScheduleMatchingTimesResponse, err error) {
+	var resp *types.ListScheduleMatchingTimesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListScheduleMatchingTimes(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListSchedulesResponse, err error) {
 	var resp *types.ListSchedulesResponse
 	op := func(ctx context.Context) error {
@@ -287,6 +298,17 @@ func (c *frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.
 	return resp, err
 }
 
+func (c *frontendClient) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseActivityResponse, err error) {
+	var resp *types.PauseActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseActivity(ctx, pp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseScheduleResponse, err error) {
 	var resp *types.PauseScheduleResponse
 	op := func(ctx context.Context) error {
@@ -298,6 +320,17 @@ func (c *frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseSche
 	return resp, err
 }
 
+func (c *frontendClient) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (pp2 *types.PauseWorkflowExecutionResponse, err error) {
+	var resp *types.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, pp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
 	var resp *types.PollForActivityTaskResponse
 	op := func(ctx context.Context) error {
@@ -374,6 +407,17 @@ func (c *frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetActivityResponse, err error) {
+	var resp *types.ResetActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetActivity(ctx, rp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	var resp *types.ResetStickyTaskListResponse
 	op := func(ctx context.Context) error {
@@ -474,6 +518,17 @@ func (c *frontendClient) RestartWorkflowExecution(ctx context.Context, rp1 *type
 	return resp, err
 }
 
+func (c *frontendClient) ResumeWorkflowExecution(ctx context.Context, rp1 *types.ResumeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (rp2 *types.ResumeWorkflowExecutionResponse, err error) {
+	var resp *types.ResumeWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResumeWorkflowExecution(ctx, rp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	var resp *types.ListWorkflowExecutionsResponse
 	op := func(ctx context.Context) error {
@@ -543,6 +598,28 @@ func (c *frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *ty
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest, p1 ...yarpc.CallOption) (tp2 *types.TriggerScheduleResponse, err error) {
+	var resp *types.TriggerScheduleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.TriggerSchedule(ctx, tp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *frontendClient) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseActivityResponse, err error) {
+	var resp *types.UnpauseActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseActivity(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest, p1 ...yarpc.CallOption) (up2 *types.UnpauseScheduleResponse, err error) {
 	var resp *types.UnpauseScheduleResponse
 	op := func(ctx context.Context) error {
@@ -554,6 +631,17 @@ func (c *frontendClient) UnpauseSchedule(ctx context.Context, up1 *types.Unpause
 	return resp, err
 }
 
+func (c *frontendClient) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	var resp *types.UpdateActivityOptionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	var resp *types.UpdateDomainResponse
 	op := func(ctx context.Context) error {
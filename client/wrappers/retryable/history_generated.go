@@ -115,6 +115,17 @@ func (c *historyClient) GetDLQReplicationMessages(ctx context.Context, gp1 *type
 	return resp, err
 }
 
+func (c *historyClient) GetDomainReplicationStatus(ctx context.Context, hp1 *types.HistoryGetDomainReplicationStatusRequest, p1 ...yarpc.CallOption) (hp2 *types.HistoryGetDomainReplicationStatusResponse, err error) {
+	var resp *types.HistoryGetDomainReplicationStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDomainReplicationStatus(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) GetFailoverInfo(ctx context.Context, gp1 *types.GetFailoverInfoRequest, p1 ...yarpc.CallOption) (gp2 *types.GetFailoverInfoResponse, err error) {
 	var resp *types.GetFailoverInfoResponse
 	op := func(ctx context.Context) error {
@@ -166,6 +177,28 @@ func (c *historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.No
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (pp1 *types.PauseActivityResponse, err error) {
+	var resp *types.PauseActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseActivity(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (pp1 *types.PauseWorkflowExecutionResponse, err error) {
+	var resp *types.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	var resp *types.PollMutableStateResponse
 	op := func(ctx context.Context) error {
@@ -299,6 +332,17 @@ func (c *historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest, p1 ...yarpc.CallOption) (rp1 *types.ResetActivityResponse, err error) {
+	var resp *types.ResetActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetActivity(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.ResetQueue(ctx, rp1, p1...)
@@ -378,6 +422,17 @@ func (c *historyClient) RespondDecisionTaskFailed(ctx context.Context, hp1 *type
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) ResumeWorkflowExecution(ctx context.Context, hp1 *types.HistoryResumeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (rp1 *types.ResumeWorkflowExecutionResponse, err error) {
+	var resp *types.ResumeWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResumeWorkflowExecution(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.ScheduleDecisionTaskRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.ScheduleDecisionTask(ctx, sp1, p1...)
@@ -434,3 +489,36 @@ func (c *historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *typ
 	}
 	return c.throttleRetry.Do(ctx, op)
 }
+
+func (c *historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (up1 *types.UnpauseActivityResponse, err error) {
+	var resp *types.UnpauseActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseActivity(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	var resp *types.UpdateActivityOptionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *historyClient) UpdateDomainIsolation(ctx context.Context, hp1 *types.HistoryUpdateDomainIsolationRequest, p1 ...yarpc.CallOption) (hp2 *types.HistoryUpdateDomainIsolationResponse, err error) {
+	var resp *types.HistoryUpdateDomainIsolationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateDomainIsolation(ctx, hp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
@@ -77,6 +77,10 @@ func (g adminClient) GetDomainReplicationMessages(ctx context.Context, gp1 *type
 	return thrift.ToAdminGetDomainReplicationMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) GetDomainReplicationStatus(ctx context.Context, gp1 *types.GetDomainReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDomainReplicationStatusResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g adminClient) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDynamicConfigResponse, err error) {
 	response, err := g.c.GetDynamicConfig(ctx, thrift.FromAdminGetDynamicConfigRequest(gp1), p1...)
 	return thrift.ToAdminGetDynamicConfigResponse(response), thrift.ToError(err)
@@ -172,6 +176,10 @@ func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context,
 	return thrift.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) UpdateDomainIsolation(ctx context.Context, up1 *types.UpdateDomainIsolationRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainIsolationResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g adminClient) UpdateDomainIsolationGroups(ctx context.Context, request *types.UpdateDomainIsolationGroupsRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainIsolationGroupsResponse, err error) {
 	response, err := g.c.UpdateDomainIsolationGroups(ctx, thrift.FromAdminUpdateDomainIsolationGroupsRequest(request), opts...)
 	return thrift.ToAdminUpdateDomainIsolationGroupsResponse(response), thrift.ToError(err)
@@ -52,6 +52,10 @@ func (g historyClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types
 	return thrift.ToHistoryGetDLQReplicationMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g historyClient) GetDomainReplicationStatus(ctx context.Context, hp1 *types.HistoryGetDomainReplicationStatusRequest, p1 ...yarpc.CallOption) (hp2 *types.HistoryGetDomainReplicationStatusResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g historyClient) GetFailoverInfo(ctx context.Context, gp1 *types.GetFailoverInfoRequest, p1 ...yarpc.CallOption) (gp2 *types.GetFailoverInfoResponse, err error) {
 	response, err := g.c.GetFailoverInfo(ctx, thrift.FromHistoryGetFailoverInfoRequest(gp1), p1...)
 	return thrift.ToHistoryGetFailoverInfoResponse(response), thrift.ToError(err)
@@ -77,6 +81,14 @@ func (g historyClient) NotifyFailoverMarkers(ctx context.Context, np1 *types.Not
 	return thrift.ToError(err)
 }
 
+func (g historyClient) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest, p1 ...yarpc.CallOption) (pp1 *types.PauseActivityResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
+func (g historyClient) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (pp1 *types.PauseWorkflowExecutionResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g historyClient) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest, p1 ...yarpc.CallOption) (pp2 *types.PollMutableStateResponse, err error) {
 	response, err := g.c.PollMutableState(ctx, thrift.FromHistoryPollMutableStateRequest(pp1), p1...)
 	return thrift.ToHistoryPollMutableStateResponse(response), thrift.ToError(err)
@@ -152,6 +164,10 @@ func (g historyClient) RequestCancelWorkflowExecution(ctx context.Context, hp1 *
 	return thrift.ToError(err)
 }
 
+func (g historyClient) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest, p1 ...yarpc.CallOption) (rp1 *types.ResetActivityResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g historyClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ResetQueue(ctx, thrift.FromHistoryResetQueueRequest(rp1), p1...)
 	return thrift.ToError(err)
@@ -197,6 +213,10 @@ func (g historyClient) RespondDecisionTaskFailed(ctx context.Context, hp1 *types
 	return thrift.ToError(err)
 }
 
+func (g historyClient) ResumeWorkflowExecution(ctx context.Context, hp1 *types.HistoryResumeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (rp1 *types.ResumeWorkflowExecutionResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g historyClient) ScheduleDecisionTask(ctx context.Context, sp1 *types.ScheduleDecisionTaskRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ScheduleDecisionTask(ctx, thrift.FromHistoryScheduleDecisionTaskRequest(sp1), p1...)
 	return thrift.ToError(err)
@@ -231,3 +251,15 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	err = g.c.TerminateWorkflowExecution(ctx, thrift.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return thrift.ToError(err)
 }
+
+func (g historyClient) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest, p1 ...yarpc.CallOption) (up1 *types.UnpauseActivityResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
+func (g historyClient) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest, p1 ...yarpc.CallOption) (up1 *types.UpdateActivityOptionsResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
+func (g historyClient) UpdateDomainIsolation(ctx context.Context, hp1 *types.HistoryUpdateDomainIsolationRequest, p1 ...yarpc.CallOption) (hp2 *types.HistoryUpdateDomainIsolationResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
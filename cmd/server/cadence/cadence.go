@@ -148,6 +148,40 @@ func BuildCLI(releaseVersion string, gitRevision string) *cli.App {
 				)
 			},
 		},
+		{
+			Name:  "start-dev",
+			Usage: "start all cadence services in a single process backed by SQLite, for local development only",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "data-dir",
+					Value: "cadence-dev",
+					Usage: "directory of the SQLite databases, archives and dynamic config file, created if it doesn't exist",
+				},
+				&cli.IntFlag{
+					Name:  "port",
+					Value: devServerDefaultPort,
+					Usage: "frontend gRPC port P, the only gRPC port of the dev server. The services talk to each other over localhost " +
+						"TChannel ports derived from it: frontend, history, matching and worker on P+100, P+101, P+102 and P+106",
+				},
+				&cli.StringFlag{
+					Name:  "domain",
+					Value: devServerDefaultDomain,
+					Usage: "domain registered on startup, set to empty to skip the registration",
+				},
+				&cli.StringFlag{
+					Name:  "dynamic-config-file",
+					Usage: "file based dynamic config, defaults to dynamicconfig.yaml in the data directory",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return startDevServer(devServerOptions{
+					DataDir:           c.String("data-dir"),
+					Port:              c.Int("port"),
+					Domain:            strings.TrimSpace(c.String("domain")),
+					DynamicConfigFile: c.String("dynamic-config-file"),
+				})
+			},
+		},
 	}
 
 	return app
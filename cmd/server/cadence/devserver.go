@@ -0,0 +1,471 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	stdLog "log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
+
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
+	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/clock/clockfx"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/config/yaml"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicconfigfx"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/logfx"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/metrics/metricsfx"
+	ringpopconfig "github.com/uber/cadence/common/peerprovider/ringpopprovider/config"
+	sqliteplugin "github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/sqlite"
+	"github.com/uber/cadence/tools/common/schema"
+	"github.com/uber/cadence/tools/sql"
+)
+
+const (
+	devServerDefaultPort   = 7833
+	devServerDefaultDomain = "default"
+	devServerClusterName   = "cluster0"
+	devServerNumShards     = 4
+	devServerRetentionDays = 1
+
+	devServerCallerName = "cadence-dev-server"
+
+	devServerDomainRegistrationTimeout = 2 * time.Minute
+	devServerRegisterDomainCallTimeout = 10 * time.Second
+)
+
+// devServerTChannelPortOffsets are the offsets from the frontend gRPC port of the TChannel ports of every service.
+// With the default port, they are the same as the ones of config/development.yaml.
+// The frontend gRPC port is the only gRPC port of the dev server: each service joins the ringpop ring with its
+// own TChannel address, and history and matching are called over TChannel, see devServerDynamicConfigClient.
+// Keep the usage of the --port flag and CONTRIBUTING.md in sync when changing them.
+var devServerTChannelPortOffsets = map[string]int{
+	service.ShortName(service.Frontend): 100,
+	service.ShortName(service.History):  101,
+	service.ShortName(service.Matching): 102,
+	service.ShortName(service.Worker):   106,
+}
+
+// devServerOptions are the options of the start-dev command
+type devServerOptions struct {
+	// DataDir holds the SQLite databases, the archives and the dynamic config file
+	DataDir string
+	// Port is the frontend gRPC port, the TChannel ports of the services are derived from it
+	Port int
+	// Domain is registered once the services are started, nothing is registered when empty
+	Domain string
+	// DynamicConfigFile is created in DataDir when empty
+	DynamicConfigFile string
+}
+
+// startDevServer runs all the services in this process, backed by SQLite databases in the data directory.
+// The databases are created and upgraded to the latest schema versions before the services are started.
+func startDevServer(opts devServerOptions) error {
+	cfg, err := newDevServerConfig(opts)
+	if err != nil {
+		return err
+	}
+	if err := setupDevServerSchemas(&cfg.Persistence); err != nil {
+		return err
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("get hostname: %w", err)
+	}
+	appCtx := appContext{
+		CfgContext: config.Context{
+			Environment: "development",
+		},
+		RootDir:  opts.DataDir,
+		HostName: host,
+	}
+
+	stdLog.Printf("starting cadence dev server, listening on localhost ports: %v", describeDevServerPorts(cfg))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if opts.Domain != "" {
+		go func() {
+			if err := registerDevServerDomain(ctx, cfg.PublicClient.HostPort, opts.Domain); err != nil {
+				stdLog.Printf("failed to register domain %v: %v", opts.Domain, err)
+				return
+			}
+			stdLog.Printf("domain %v is registered, cadence is ready at %v", opts.Domain, cfg.PublicClient.HostPort)
+		}()
+	}
+
+	return runServices(
+		defaultServices,
+		func(serviceName string) fxAppInterface {
+			return fx.New(
+				fx.Module(serviceName,
+					devServerModule(cfg),
+					fx.Provide(
+						func() appContext {
+							return appCtx
+						},
+					),
+					Module(serviceName),
+				),
+			)
+		},
+	)
+}
+
+// devServerModule replaces _commonModule, with a config built in memory instead of loaded from the config directory
+func devServerModule(cfg config.Config) fx.Option {
+	return fx.Options(
+		fx.Provide(func(p devServerConfigParams) (config.Result, error) {
+			svcCfg, err := cfg.GetServiceConfig(p.Service)
+			if err != nil {
+				return config.Result{}, fmt.Errorf("get service config: %w", err)
+			}
+			return config.Result{
+				Config:        cfg,
+				ServiceConfig: svcCfg,
+				MigrationCfg:  metrics.MigrationConfig{},
+			}, nil
+		}),
+		dynamicconfigfx.Module,
+		fx.Decorate(func(client dynamicconfig.Client) dynamicconfig.Client {
+			return devServerDynamicConfigClient{Client: client}
+		}),
+		logfx.Module,
+		metricsfx.Module,
+		clockfx.Module,
+	)
+}
+
+type devServerConfigParams struct {
+	fx.In
+
+	Service string `name:"service"`
+}
+
+// devServerDynamicConfigClient disables the gRPC outbounds to history and matching, so that they are called
+// over TChannel and the frontend gRPC port is the only one clients need to reach
+type devServerDynamicConfigClient struct {
+	dynamicconfig.Client
+}
+
+func (c devServerDynamicConfigClient) GetBoolValue(name dynamicproperties.BoolKey, filters map[dynamicproperties.Filter]interface{}) (bool, error) {
+	if name == dynamicproperties.EnableGRPCOutbound {
+		return false, nil
+	}
+	return c.Client.GetBoolValue(name, filters)
+}
+
+// newDevServerConfig builds the config of the dev server and creates the directories and files it refers to
+func newDevServerConfig(opts devServerOptions) (config.Config, error) {
+	dataDir, err := filepath.Abs(opts.DataDir)
+	if err != nil {
+		return config.Config{}, fmt.Errorf("resolve data directory: %w", err)
+	}
+	if opts.Port <= 0 || opts.Port+devServerTChannelPortOffsets[service.ShortName(service.Worker)] > 65535 {
+		return config.Config{}, fmt.Errorf("invalid port %v", opts.Port)
+	}
+	historyArchivalDir := filepath.Join(dataDir, "archival", "history")
+	visibilityArchivalDir := filepath.Join(dataDir, "archival", "visibility")
+	blobstoreDir := filepath.Join(dataDir, "blobstore")
+	for _, dir := range []string{dataDir, historyArchivalDir, visibilityArchivalDir, blobstoreDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return config.Config{}, fmt.Errorf("create directory %v: %w", dir, err)
+		}
+	}
+
+	dynamicConfigFile := opts.DynamicConfigFile
+	if dynamicConfigFile == "" {
+		dynamicConfigFile = filepath.Join(dataDir, "dynamicconfig.yaml")
+		if err := createFileIfNotExists(dynamicConfigFile, "# dynamic config of the dev server, see config/dynamicconfig/README.md\n"); err != nil {
+			return config.Config{}, err
+		}
+	}
+	dynamicConfigFile, err = filepath.Abs(dynamicConfigFile)
+	if err != nil {
+		return config.Config{}, fmt.Errorf("resolve dynamic config file: %w", err)
+	}
+
+	archiverConfig, err := yaml.ToNode(&config.FilestoreArchiver{
+		FileMode: "0666",
+		DirMode:  "0766",
+	})
+	if err != nil {
+		return config.Config{}, fmt.Errorf("convert filestore archiver config: %w", err)
+	}
+
+	services := make(map[string]config.Service, len(devServerTChannelPortOffsets))
+	var bootstrapHosts []string
+	for name, offset := range devServerTChannelPortOffsets {
+		rpc := config.RPC{
+			Port:            uint16(opts.Port + offset),
+			BindOnLocalHost: true,
+		}
+		if name == service.ShortName(service.Frontend) {
+			rpc.GRPCPort = uint16(opts.Port)
+		}
+		services[name] = config.Service{RPC: rpc}
+		bootstrapHosts = append(bootstrapHosts, "127.0.0.1:"+strconv.Itoa(int(rpc.Port)))
+	}
+
+	cfg := config.Config{
+		Ringpop: ringpopconfig.Config{
+			Name:            "cadence",
+			BootstrapMode:   ringpopconfig.BootstrapModeHosts,
+			BootstrapHosts:  bootstrapHosts,
+			MaxJoinDuration: 30 * time.Second,
+		},
+		Persistence: config.Persistence{
+			DefaultStore:     "sqlite-default",
+			VisibilityStore:  "sqlite-visibility",
+			NumHistoryShards: devServerNumShards,
+			DataStores: map[string]config.DataStore{
+				"sqlite-default": {
+					SQL: newDevServerSQLConfig(filepath.Join(dataDir, "cadence.db")),
+				},
+				"sqlite-visibility": {
+					SQL: newDevServerSQLConfig(filepath.Join(dataDir, "cadence_visibility.db")),
+				},
+			},
+		},
+		Log: config.Logger{
+			Stdout: true,
+			Level:  "info",
+		},
+		ClusterGroupMetadata: &config.ClusterGroupMetadata{
+			FailoverVersionIncrement: 10,
+			PrimaryClusterName:       devServerClusterName,
+			CurrentClusterName:       devServerClusterName,
+			ClusterGroup: map[string]config.ClusterInformation{
+				devServerClusterName: {
+					Enabled:                true,
+					InitialFailoverVersion: 0,
+					RPCAddress:             "127.0.0.1:" + strconv.Itoa(opts.Port),
+					RPCTransport:           "grpc",
+				},
+			},
+		},
+		Services: services,
+		Archival: config.Archival{
+			History: config.HistoryArchival{
+				Status:     "enabled",
+				EnableRead: true,
+				Provider:   config.HistoryArchiverProvider{config.FilestoreConfig: archiverConfig},
+			},
+			Visibility: config.VisibilityArchival{
+				Status:     "enabled",
+				EnableRead: true,
+				Provider:   config.VisibilityArchiverProvider{config.FilestoreConfig: archiverConfig},
+			},
+		},
+		DomainDefaults: config.DomainDefaults{
+			Archival: config.ArchivalDomainDefaults{
+				History: config.HistoryArchivalDomainDefaults{
+					Status: "enabled",
+					URI:    "file://" + historyArchivalDir,
+				},
+				Visibility: config.VisibilityArchivalDomainDefaults{
+					Status: "enabled",
+					URI:    "file://" + visibilityArchivalDir,
+				},
+			},
+		},
+		DynamicConfig: config.DynamicConfig{
+			Client: dynamicconfig.FileBasedClient,
+			FileBased: dynamicconfig.FileBasedClientConfig{
+				Filepath:     dynamicConfigFile,
+				PollInterval: 10 * time.Second,
+			},
+		},
+		Blobstore: config.Blobstore{
+			Filestore: &config.FileBlobstore{
+				OutputDirectory: blobstoreDir,
+			},
+		},
+	}
+	if err := cfg.ValidateAndFillDefaults(); err != nil {
+		return config.Config{}, fmt.Errorf("validate config: %w", err)
+	}
+	return cfg, nil
+}
+
+// describeDevServerPorts lists the ports of every service, e.g. "frontend grpc 7833, frontend tchannel 7933, ..."
+func describeDevServerPorts(cfg config.Config) string {
+	var ports []string
+	for _, name := range []string{service.Frontend, service.History, service.Matching, service.Worker} {
+		rpc := cfg.Services[service.ShortName(name)].RPC
+		if rpc.GRPCPort != 0 {
+			ports = append(ports, fmt.Sprintf("%v grpc %v", service.ShortName(name), rpc.GRPCPort))
+		}
+		ports = append(ports, fmt.Sprintf("%v tchannel %v", service.ShortName(name), rpc.Port))
+	}
+	return strings.Join(ports, ", ")
+}
+
+func newDevServerSQLConfig(databaseName string) *config.SQL {
+	return &config.SQL{
+		PluginName:      sqliteplugin.PluginName,
+		DatabaseName:    databaseName,
+		MaxConns:        1,
+		MaxIdleConns:    1,
+		MaxConnLifetime: 128 * time.Hour,
+	}
+}
+
+func createFileIfNotExists(path string, content string) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("check file %v: %w", path, err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("create file %v: %w", path, err)
+	}
+	return nil
+}
+
+// setupDevServerSchemas creates the schemas of the default and visibility stores if needed,
+// and upgrades them to the latest versions embedded in this binary
+func setupDevServerSchemas(cfg *config.Persistence) error {
+	if err := setupDevServerSchema(cfg.DataStores[cfg.DefaultStore].SQL, "cadence/versioned", sqlite.Version); err != nil {
+		return fmt.Errorf("setup default store schema: %w", err)
+	}
+	if err := setupDevServerSchema(cfg.DataStores[cfg.VisibilityStore].SQL, "visibility/versioned", sqlite.VisibilityVersion); err != nil {
+		return fmt.Errorf("setup visibility store schema: %w", err)
+	}
+	return nil
+}
+
+func setupDevServerSchema(cfg *config.SQL, schemaDir string, version string) error {
+	conn, err := sql.NewConnection(cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	tables, err := conn.ListTables()
+	if err != nil {
+		return err
+	}
+	if !containsString(tables, "schema_version") {
+		if err := schema.SetupFromConfig(&schema.SetupConfig{InitialVersion: "0.0"}, conn); err != nil {
+			return err
+		}
+	}
+
+	// the update task rejects a target version which is not above the current one, i.e. a restart
+	currentVersion, err := conn.ReadSchemaVersion()
+	if err != nil {
+		return err
+	}
+	if schema.CompareVersion(currentVersion, version) >= 0 {
+		return nil
+	}
+
+	schemaFS, err := fs.Sub(sqlite.SchemaFS, schemaDir)
+	if err != nil {
+		return err
+	}
+	return schema.UpdateFromConfig(&schema.UpdateConfig{
+		DBName:        cfg.DatabaseName,
+		TargetVersion: version,
+		SchemaFS:      schemaFS,
+	}, conn)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// registerDevServerDomain registers the domain through the frontend, retrying until the services are ready
+func registerDevServerDomain(ctx context.Context, hostPort string, domain string) error {
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: devServerCallerName,
+		Outbounds: yarpc.Outbounds{
+			service.Frontend: transport.Outbounds{Unary: grpc.NewTransport().NewSingleOutbound(hostPort)},
+		},
+	})
+	if err := dispatcher.Start(); err != nil {
+		return fmt.Errorf("start dispatcher: %w", err)
+	}
+	defer dispatcher.Stop()
+
+	clientConfig := dispatcher.ClientConfig(service.Frontend)
+	client := grpcClient.NewFrontendClient(
+		apiv1.NewDomainAPIYARPCClient(clientConfig),
+		apiv1.NewWorkflowAPIYARPCClient(clientConfig),
+		apiv1.NewWorkerAPIYARPCClient(clientConfig),
+		apiv1.NewVisibilityAPIYARPCClient(clientConfig),
+		apiv1.NewScheduleAPIYARPCClient(clientConfig),
+		frontendv1.NewScheduleAPIYARPCClient(clientConfig),
+		frontendv1.NewFrontendAPIYARPCClient(clientConfig),
+	)
+
+	policy := backoff.NewExponentialRetryPolicy(time.Second)
+	policy.SetMaximumInterval(5 * time.Second)
+	policy.SetExpirationInterval(devServerDomainRegistrationTimeout)
+	throttleRetry := backoff.NewThrottleRetry(
+		backoff.WithRetryPolicy(policy),
+		backoff.WithRetryableError(func(err error) bool {
+			return ctx.Err() == nil
+		}),
+	)
+	return throttleRetry.Do(ctx, func(ctx context.Context) error {
+		// the outbound rejects calls without a deadline
+		ctx, cancel := context.WithTimeout(ctx, devServerRegisterDomainCallTimeout)
+		defer cancel()
+		err := client.RegisterDomain(ctx, &types.RegisterDomainRequest{
+			Name:                                   domain,
+			Description:                            "Domain registered by the dev server",
+			WorkflowExecutionRetentionPeriodInDays: devServerRetentionDays,
+		})
+		var alreadyExists *types.DomainAlreadyExistsError
+		if errors.As(err, &alreadyExists) {
+			return nil
+		}
+		return err
+	})
+}
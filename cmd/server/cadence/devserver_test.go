@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/tools/sql"
+)
+
+func TestNewDevServerConfig(t *testing.T) {
+	dataDir := filepath.Join(t.TempDir(), "data")
+	cfg, err := newDevServerConfig(devServerOptions{
+		DataDir: dataDir,
+		Port:    devServerDefaultPort,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(dataDir, "cadence.db"), cfg.Persistence.DataStores[cfg.Persistence.DefaultStore].SQL.DatabaseName)
+	assert.Equal(t, filepath.Join(dataDir, "cadence_visibility.db"), cfg.Persistence.DataStores[cfg.Persistence.VisibilityStore].SQL.DatabaseName)
+	assert.Equal(t, "127.0.0.1:7833", cfg.PublicClient.HostPort)
+	assert.Equal(t, filepath.Join(dataDir, "dynamicconfig.yaml"), cfg.DynamicConfig.FileBased.Filepath)
+	assert.Equal(t, "file://"+filepath.Join(dataDir, "archival", "history"), cfg.DomainDefaults.Archival.History.URI)
+	assert.Equal(t, "file://"+filepath.Join(dataDir, "archival", "visibility"), cfg.DomainDefaults.Archival.Visibility.URI)
+
+	// same TChannel ports as config/development.yaml, only the frontend has a gRPC port
+	wantPorts := map[string][2]uint16{
+		"frontend": {7933, 7833},
+		"history":  {7934, 0},
+		"matching": {7935, 0},
+		"worker":   {7939, 0},
+	}
+	require.Len(t, cfg.Services, len(wantPorts))
+	for name, ports := range wantPorts {
+		svcCfg, err := cfg.GetServiceConfig(name)
+		require.NoError(t, err)
+		assert.Equal(t, ports[0], svcCfg.RPC.Port, name)
+		assert.Equal(t, ports[1], svcCfg.RPC.GRPCPort, name)
+		assert.True(t, svcCfg.RPC.BindOnLocalHost, name)
+	}
+	assert.ElementsMatch(t, []string{"127.0.0.1:7933", "127.0.0.1:7934", "127.0.0.1:7935", "127.0.0.1:7939"}, cfg.Ringpop.BootstrapHosts)
+
+	for _, path := range []string{
+		cfg.DynamicConfig.FileBased.Filepath,
+		filepath.Join(dataDir, "archival", "history"),
+		filepath.Join(dataDir, "archival", "visibility"),
+		cfg.Blobstore.Filestore.OutputDirectory,
+	} {
+		_, err := os.Stat(path)
+		assert.NoError(t, err, path)
+	}
+}
+
+func TestDescribeDevServerPorts(t *testing.T) {
+	cfg, err := newDevServerConfig(devServerOptions{
+		DataDir: t.TempDir(),
+		Port:    devServerDefaultPort,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "frontend grpc 7833, frontend tchannel 7933, history tchannel 7934, "+
+		"matching tchannel 7935, worker tchannel 7939", describeDevServerPorts(cfg))
+}
+
+func TestNewDevServerConfig_KeepsDynamicConfigFile(t *testing.T) {
+	dataDir := t.TempDir()
+	dynamicConfigFile := filepath.Join(dataDir, "dynamicconfig.yaml")
+	require.NoError(t, os.WriteFile(dynamicConfigFile, []byte("system.enableGracefulFailover:\n- value: true\n"), 0o644))
+
+	_, err := newDevServerConfig(devServerOptions{
+		DataDir: dataDir,
+		Port:    devServerDefaultPort,
+	})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(dynamicConfigFile)
+	require.NoError(t, err)
+	assert.Equal(t, "system.enableGracefulFailover:\n- value: true\n", string(content))
+}
+
+func TestNewDevServerConfig_Port(t *testing.T) {
+	cfg, err := newDevServerConfig(devServerOptions{
+		DataDir: t.TempDir(),
+		Port:    9000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:9000", cfg.PublicClient.HostPort)
+	assert.Equal(t, uint16(9101), cfg.Services["history"].RPC.Port)
+
+	_, err = newDevServerConfig(devServerOptions{
+		DataDir: t.TempDir(),
+		Port:    65500,
+	})
+	assert.ErrorContains(t, err, "invalid port")
+}
+
+func TestDevServerDynamicConfigClient(t *testing.T) {
+	inMemoryClient := dynamicconfig.NewInMemoryClient()
+	require.NoError(t, inMemoryClient.UpdateValue(dynamicproperties.EnableGRPCOutbound, true))
+	require.NoError(t, inMemoryClient.UpdateValue(dynamicproperties.EnableGracefulFailover, true))
+	client := devServerDynamicConfigClient{Client: inMemoryClient}
+
+	enableGRPCOutbound, err := client.GetBoolValue(dynamicproperties.EnableGRPCOutbound, nil)
+	require.NoError(t, err)
+	assert.False(t, enableGRPCOutbound)
+
+	enableGracefulFailover, err := client.GetBoolValue(dynamicproperties.EnableGracefulFailover, nil)
+	require.NoError(t, err)
+	assert.True(t, enableGracefulFailover)
+}
+
+func TestSetupDevServerSchemas(t *testing.T) {
+	cfg, err := newDevServerConfig(devServerOptions{
+		DataDir: t.TempDir(),
+		Port:    devServerDefaultPort,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, setupDevServerSchemas(&cfg.Persistence))
+	require.NoError(t, sql.VerifyCompatibleVersion(cfg.Persistence))
+
+	// restarting the dev server reuses the existing databases
+	require.NoError(t, setupDevServerSchemas(&cfg.Persistence))
+	require.NoError(t, sql.VerifyCompatibleVersion(cfg.Persistence))
+}
+
+func TestDevServerFxDependencies(t *testing.T) {
+	cfg, err := newDevServerConfig(devServerOptions{
+		DataDir: t.TempDir(),
+		Port:    devServerDefaultPort,
+	})
+	require.NoError(t, err)
+
+	err = fx.ValidateApp(devServerModule(cfg),
+		fx.Supply(appContext{
+			CfgContext: config.Context{
+				Environment: "development",
+			},
+		}),
+		Module("frontend"))
+	require.NoError(t, err)
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/tools/cassandra"
+	"github.com/uber/cadence/tools/dynamodb"
 	"github.com/uber/cadence/tools/sql"
 )
 
@@ -132,6 +133,10 @@ func (a *App) verifySchema(ctx context.Context) error {
 	if err := sql.VerifyCompatibleVersion(a.cfg.Persistence); err != nil {
 		return fmt.Errorf("sql schema version compatibility check failed: %w", err)
 	}
+	// dynamodb schema version validation
+	if err := dynamodb.VerifyCompatibleVersion(a.cfg.Persistence); err != nil {
+		return fmt.Errorf("dynamodb schema version compatibility check failed: %w", err)
+	}
 	return nil
 }
 
@@ -33,6 +33,7 @@ type (
 		Get(context.Context, *GetRequest) (*GetResponse, error)
 		Exists(context.Context, *ExistsRequest) (*ExistsResponse, error)
 		Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+		ListByPrefix(context.Context, *ListByPrefixRequest) (*ListByPrefixResponse, error)
 		IsRetryableError(error) bool
 	}
 
@@ -73,6 +74,17 @@ type (
 	// DeleteResponse is the response from Delete
 	DeleteResponse struct{}
 
+	// ListByPrefixRequest is the request to ListByPrefix
+	ListByPrefixRequest struct {
+		Prefix string
+	}
+
+	// ListByPrefixResponse is the response from ListByPrefix
+	ListByPrefixResponse struct {
+		// Keys are the keys of the blobs starting with the prefix, in lexicographic order
+		Keys []string
+	}
+
 	// Blob defines a blob which can be stored and fetched from blobstore
 	Blob struct {
 		Tags map[string]string
@@ -99,6 +99,21 @@ func (mr *MockClientMockRecorder) IsRetryableError(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRetryableError", reflect.TypeOf((*MockClient)(nil).IsRetryableError), arg0)
 }
 
+// ListByPrefix mocks base method.
+func (m *MockClient) ListByPrefix(arg0 context.Context, arg1 *ListByPrefixRequest) (*ListByPrefixResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPrefix", arg0, arg1)
+	ret0, _ := ret[0].(*ListByPrefixResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPrefix indicates an expected call of ListByPrefix.
+func (mr *MockClientMockRecorder) ListByPrefix(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPrefix", reflect.TypeOf((*MockClient)(nil).ListByPrefix), arg0, arg1)
+}
+
 // Put mocks base method.
 func (m *MockClient) Put(arg0 context.Context, arg1 *PutRequest) (*PutResponse, error) {
 	m.ctrl.T.Helper()
@@ -28,6 +28,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/config"
@@ -128,6 +129,26 @@ func (c *client) Delete(_ context.Context, request *blobstore.DeleteRequest) (*b
 	return &blobstore.DeleteResponse{}, nil
 }
 
+// ListByPrefix lists the keys of the blobs starting with a prefix
+func (c *client) ListByPrefix(_ context.Context, request *blobstore.ListByPrefixRequest) (*blobstore.ListByPrefixResponse, error) {
+	entries, err := os.ReadDir(c.outputDirectory)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, entry := range entries {
+		name := entry.Name()
+		// the tags of the blobs are stored in hidden files
+		if entry.IsDir() || strings.HasPrefix(name, ".") || !strings.HasPrefix(name, request.Prefix) {
+			continue
+		}
+		keys = append(keys, name)
+	}
+	return &blobstore.ListByPrefixResponse{
+		Keys: keys,
+	}, nil
+}
+
 // IsRetryableError returns true if the error is retryable false otherwise
 func (c *client) IsRetryableError(err error) bool {
 	return false
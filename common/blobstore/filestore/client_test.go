@@ -151,3 +151,25 @@ func (s *ClientSuite) TestCrudOperations() {
 	s.Error(err)
 	s.Nil(get1)
 }
+
+func (s *ClientSuite) TestListByPrefix() {
+	c, err := NewFilestoreClient(&config.FileBlobstore{OutputDirectory: s.T().TempDir()})
+	s.NoError(err)
+	ctx := context.Background()
+
+	for _, key := range []string{"a_2", "b_1", "a_1"} {
+		_, err = c.Put(ctx, &blobstore.PutRequest{
+			Key:  key,
+			Blob: blobstore.Blob{Tags: map[string]string{"key": key}, Body: []byte{1}},
+		})
+		s.NoError(err)
+	}
+
+	resp, err := c.ListByPrefix(ctx, &blobstore.ListByPrefixRequest{Prefix: "a_"})
+	s.NoError(err)
+	s.Equal([]string{"a_1", "a_2"}, resp.Keys)
+
+	resp, err = c.ListByPrefix(ctx, &blobstore.ListByPrefixRequest{Prefix: "c_"})
+	s.NoError(err)
+	s.Empty(resp.Keys)
+}
@@ -102,6 +102,20 @@ func (c *retryableClient) Delete(ctx context.Context, req *DeleteRequest) (*Dele
 	return resp, nil
 }
 
+func (c *retryableClient) ListByPrefix(ctx context.Context, req *ListByPrefixRequest) (*ListByPrefixResponse, error) {
+	var resp *ListByPrefixResponse
+	var err error
+	op := func(ctx context.Context) error {
+		resp, err = c.client.ListByPrefix(ctx, req)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *retryableClient) IsRetryableError(err error) bool {
 	return c.client.IsRetryableError(err)
 }
@@ -138,6 +138,23 @@ func TestRetryableClient(t *testing.T) {
 				assert.Equal(t, resp.(*DeleteResponse), result)
 			},
 		},
+		{
+			name:           "ListByPrefix",
+			retryPolicy:    backoff.NewExponentialRetryPolicy(0),
+			retryableError: false,
+			req:            &ListByPrefixRequest{Prefix: "prefix"},
+			resp:           &ListByPrefixResponse{Keys: []string{"prefix_1"}},
+			expectFn: func(m *MockClient, req, resp any) {
+				m.EXPECT().ListByPrefix(gomock.Any(), req.(*ListByPrefixRequest)).Return(resp.(*ListByPrefixResponse), nil).Times(1)
+			},
+			callFn: func(c Client, ctx context.Context, req any) (any, error) {
+				return c.ListByPrefix(ctx, req.(*ListByPrefixRequest))
+			},
+			assertFn: func(t *testing.T, req any, resp any, result any, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, resp.(*ListByPrefixResponse), result)
+			},
+		},
 		{
 			name:           "RetryOnError",
 			retryPolicy:    backoff.NewExponentialRetryPolicy(1),
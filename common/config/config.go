@@ -201,6 +201,10 @@ type (
 		// AdvancedVisibilityStore is the name of the datastore to be used for visibility records
 		// Must provide one of VisibilityStore and AdvancedVisibilityStore
 		AdvancedVisibilityStore string `yaml:"advancedVisibilityStore"`
+		// MigrationStore is the name of the datastore the default store is being migrated to.
+		// When set, shard, execution, history, task and domain persistence calls are routed between
+		// the two stores according to the system.persistenceMigrationMode dynamic config
+		MigrationStore string `yaml:"migrationStore"`
 		// HistoryMaxConns is the desired number of conns to history store. Value specified
 		// here overrides the MaxConns config specified as part of datastore
 		// Deprecated: This value is not used
@@ -246,9 +250,9 @@ type (
 
 	// NoSQL contains configuration to connect to NoSQL Database cluster
 	NoSQL struct {
-		// PluginName is the name of NoSQL plugin, default is "cassandra". Supported values: cassandra
+		// PluginName is the name of NoSQL plugin, default is "cassandra". Supported values: cassandra, dynamodb, mongodb
 		PluginName string `yaml:"pluginName"`
-		// Hosts is a csv of cassandra endpoints
+		// Hosts is a csv of cassandra endpoints, or the endpoint of dynamodb
 		Hosts string `yaml:"hosts" validate:"nonzero"`
 		// Port is the cassandra port used for connection by gocql client
 		Port int `yaml:"port"`
@@ -260,7 +264,7 @@ type (
 		AllowedAuthenticators []string `yaml:"allowedAuthenticators"`
 		// Keyspace is the cassandra keyspace
 		Keyspace string `yaml:"keyspace"`
-		// Region is the region filter arg for cassandra
+		// Region is the region filter arg for cassandra, or the AWS region for dynamodb
 		Region string `yaml:"region"`
 		// Datacenter is the data center filter arg for cassandra
 		Datacenter string `yaml:"datacenter"`
@@ -282,6 +286,7 @@ type (
 		// Use it ONLY when a configure is too specific to a particular NoSQL database that should not be in the common struct
 		// Otherwise please add new fields to the struct for better documentation
 		// If being used in any database, update this comment here to make it clear
+		// MongoDB: the attributes are passed as the options of the connection string, e.g. replicaSet
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 		// HostSelectionPolicy sets gocql policy for selecting host for a query
 		// Available selections are: "tokenaware,roundrobin", "hostpool-epsilon-greedy", "roundrobin"
@@ -440,6 +445,9 @@ type (
 		// 5. TerminateWorkflowExecution
 		// 6. QueryWorkflow
 		// 7. ResetWorkflow
+		// 9. PauseActivity, UnpauseActivity and ResetActivity
+		// 10. UpdateActivityOptions
+		// 11. PauseWorkflowExecution and ResumeWorkflowExecution
 		//
 		// 4) "selected-apis-forwarding-v2" will forward all of "selected-apis-forwarding", and also activity responses
 		// and heartbeats, but not other worker APIs.
@@ -308,6 +308,36 @@ func TestValidShardedNoSQLConfig(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestValidMigrationStoreConfig(t *testing.T) {
+	cfg := getValidShardedNoSQLConfig()
+	cfg.Persistence.MigrationStore = "postgres"
+	cfg.Persistence.DataStores["postgres"] = DataStore{
+		SQL: &SQL{
+			PluginName:   "postgres",
+			DatabaseName: "cadence",
+			ConnectAddr:  "127.0.0.1:5432",
+		},
+	}
+	err := cfg.ValidateAndFillDefaults()
+	require.NoError(t, err)
+}
+
+func TestInvalidMigrationStoreConfig_MissingDataStore(t *testing.T) {
+	cfg := getValidShardedNoSQLConfig()
+	cfg.Persistence.MigrationStore = "postgres"
+
+	err := cfg.ValidateAndFillDefaults()
+	require.EqualError(t, err, "persistence config: missing config for datastore postgres")
+}
+
+func TestInvalidMigrationStoreConfig_SameAsDefaultStore(t *testing.T) {
+	cfg := getValidShardedNoSQLConfig()
+	cfg.Persistence.MigrationStore = cfg.Persistence.DefaultStore
+
+	err := cfg.ValidateAndFillDefaults()
+	require.EqualError(t, err, "persistence config: migrationStore must be different from defaultStore")
+}
+
 func TestInvalidShardedNoSQLConfig_MultipleConfigTypes(t *testing.T) {
 	cfg := getValidShardedNoSQLConfig()
 	store := cfg.Persistence.DataStores["default"]
@@ -84,6 +84,12 @@ func (c *Persistence) Validate() error {
 		}
 		useAdvancedVisibilityOnly = true
 	}
+	if c.MigrationStore != "" {
+		if c.MigrationStore == c.DefaultStore {
+			return fmt.Errorf("persistence config: migrationStore must be different from defaultStore")
+		}
+		dbStoreKeys = append(dbStoreKeys, c.MigrationStore)
+	}
 
 	for _, st := range dbStoreKeys {
 		ds, ok := c.DataStores[st]
@@ -327,5 +327,15 @@ func GetTaskPriority(
 	return class | subClass
 }
 
+// GetTaskPriorityClass returns the priority class of a task priority
+func GetTaskPriorityClass(priority int) int {
+	return priority &^ (1<<numBitsPerLevel - 1)
+}
+
+// GetTaskPrioritySubclass returns the priority subclass of a task priority
+func GetTaskPrioritySubclass(priority int) int {
+	return priority & (1<<numBitsPerLevel - 1)
+}
+
 // GRPCConnectionClosingError is the error message returned when a gRPC client connection is closing
 const GRPCConnectionClosingError = "grpc: the client connection is closing"
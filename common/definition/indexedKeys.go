@@ -62,6 +62,9 @@ const (
 	CadenceScheduleCron         = "CadenceScheduleCron"
 	CadenceScheduleWorkflowType = "CadenceScheduleWorkflowType"
 
+	// CadenceWorkflowPaused is set by the history service while a workflow is paused.
+	CadenceWorkflowPaused = "CadenceWorkflowPaused"
+
 	CustomStringField    = "CustomStringField"
 	CustomKeywordField   = "CustomKeywordField"
 	CustomIntField       = "CustomIntField"
@@ -104,6 +107,7 @@ func createDefaultIndexedKeys() map[string]interface{} {
 		CadenceScheduleCron:         types.IndexedValueTypeKeyword,
 		CadenceScheduleWorkflowType: types.IndexedValueTypeKeyword,
 		CadenceScheduleBackfillID:   types.IndexedValueTypeKeyword,
+		CadenceWorkflowPaused:       types.IndexedValueTypeBool,
 	}
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
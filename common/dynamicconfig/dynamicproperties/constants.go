@@ -1348,6 +1348,18 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	MutableStateChecksumVerifyProbability
+	// PersistenceWriteAuditMaxQPS is the max number of execution mutations of a domain recorded per second by each shard in the persistence write audit trail
+	// KeyName: history.persistenceWriteAuditMaxQPS
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	PersistenceWriteAuditMaxQPS
+	// PersistenceWriteAuditMaxRecords is the max number of records kept in the persistence write audit trail of a workflow run, older records are dropped
+	// KeyName: history.persistenceWriteAuditMaxRecords
+	// Value type: Int
+	// Default value: 200
+	// Allowed filters: N/A
+	PersistenceWriteAuditMaxRecords
 	// TaskSchedulerMigrationRatio is the ratio of task that is migrated to new scheduler
 	// KeyName: history.taskSchedulerMigrationRatio
 	// Value type: Int
@@ -1553,6 +1565,30 @@ const (
 	// Default value: 3
 	// Allowed filters: N/A
 	TimersScannerPeriodEnd
+	// HistoryBranchesScannerConcurrency is the concurrency of history branches scanner
+	// KeyName: worker.historyBranchesScannerConcurrency
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: N/A
+	HistoryBranchesScannerConcurrency
+	// HistoryBranchesScannerPersistencePageSize is the page size of execution persistence fetches in history branches scanner
+	// KeyName: worker.historyBranchesScannerPersistencePageSize
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: N/A
+	HistoryBranchesScannerPersistencePageSize
+	// HistoryBranchesScannerBlobstoreFlushThreshold is threshold to flush blob store
+	// KeyName: worker.historyBranchesScannerBlobstoreFlushThreshold
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	HistoryBranchesScannerBlobstoreFlushThreshold
+	// HistoryBranchesScannerActivityBatchSize is the number of shards scanned by an activity of history branches scanner
+	// KeyName: worker.historyBranchesScannerActivityBatchSize
+	// Value type: Int
+	// Default value: 25
+	// Allowed filters: N/A
+	HistoryBranchesScannerActivityBatchSize
 	// ESAnalyzerMaxNumDomains defines how many domains to check
 	// KeyName: worker.ESAnalyzerMaxNumDomains
 	// Value type: int
@@ -1636,6 +1672,36 @@ const (
 	// Default value: 2
 	// Allowed filters: N/A
 	QueueMaxVirtualQueueCount
+	// QueueCriticalVirtualSliceCount is the critical number of virtual slices of the queue, above which the slices are merged. 0 disables the alert
+	// KeyName: history.queueCriticalVirtualSliceCount
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalVirtualSliceCount
+	// QueueCriticalTaskAttempt is the critical attempt count of a pending task of the queue, above which the pending tasks of these domains are moved out of their virtual queue and these domains are throttled. 0 disables the alert
+	// KeyName: history.queueCriticalTaskAttempt
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalTaskAttempt
+	// QueueCriticalReadLevelLag is the critical number of task IDs between the read level of the root virtual queue and the max task ID, above which the other virtual queues are paused. Only used by immediate queues, 0 disables the alert
+	// KeyName: history.queueCriticalReadLevelLag
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalReadLevelLag
+	// QueueThrottledDomainRPS is the rate at which the tasks of a domain throttled by the queue alert mitigations are submitted, per shard and queue. 0 disables the throttling
+	// KeyName: history.queueThrottledDomainRPS
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	QueueThrottledDomainRPS
+	// TimerBurstSmoothingThreshold is the number of timer tasks of a shard scheduled within the same second, above which the dispatch of the remaining tasks is spread over the burst smoothing window. 0 disables burst smoothing
+	// KeyName: history.timerBurstSmoothingThreshold
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: N/A
+	TimerBurstSmoothingThreshold
 
 	// HistoryTaskListNiceValue is the nice value for task processing priority per domain and task list.
 	// KeyName: history.taskListNiceValue
@@ -1977,6 +2043,12 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	EnableHistoryCorruptionCheck
+	// EnablePersistenceWriteAudit enables the sampled audit trail of the execution mutations of a domain, which is written to the blobstore
+	// KeyName: history.enablePersistenceWriteAudit
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnablePersistenceWriteAudit
 	// EnableActivityLocalDispatchByDomain is allows worker to dispatch activity tasks through local tunnel after decisions are made. This is an performance optimization to skip activity scheduling efforts
 	// KeyName: history.enableActivityLocalDispatchByDomain
 	// Value type: Bool
@@ -2173,6 +2245,24 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	TimersFixerDomainAllow
+	// HistoryBranchesScannerEnabled is if history branches scanner, reporting the history branches not referenced by their execution, should be started as part of worker.Scanner
+	// KeyName: worker.historyBranchesScannerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	HistoryBranchesScannerEnabled
+	// HistoryBranchesFixerEnabled is if history branches fixer, deleting the history branches reported by history branches scanner, should be started as part of worker.Scanner
+	// KeyName: worker.historyBranchesFixerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	HistoryBranchesFixerEnabled
+	// HistoryBranchesFixerDomainAllow is which domains are allowed to be fixed by history branches fixer workflow
+	// KeyName: worker.historyBranchesFixerDomainAllow
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	HistoryBranchesFixerDomainAllow
 	// ConcreteExecutionFixerEnabled is if concrete execution fixer workflow is enabled
 	// KeyName: worker.concreteExecutionFixerEnabled
 	// Value type: Bool
@@ -2408,6 +2498,14 @@ const (
 	// Allowed filters: DomainName
 	EnableTaskListAwareTaskSchedulerByDomain
 
+	// EnableTaskPriorityByTaskType is to assign the priority subclass of history tasks by task type, so that
+	// background tasks like retention deletion and visibility updates are scheduled with lower weights than user facing tasks
+	// KeyName: history.enableTaskPriorityByTaskType
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableTaskPriorityByTaskType
+
 	// HistoryTaskDLQProcessorEnabled enables processing HistoryTaskDLQ messages.
 	// When enabled the HistoryTaskDLQProcessor will be started alongside the lifecycle of the history engine.
 	// KeyName: history.historyTaskDLQProcessorEnabled
@@ -2415,6 +2513,14 @@ const (
 	// Default value: false
 	HistoryTaskDLQProcessorEnabled
 
+	// EnablePersistenceFaultInjection enables the persistence fault injection driven by PersistenceFaultInjectionProfiles.
+	// It is checked on every persistence call.
+	// KeyName: system.enablePersistenceFaultInjection
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnablePersistenceFaultInjection
+
 	// LastBoolKey must be the last one in this const group
 	LastBoolKey
 )
@@ -2740,6 +2846,20 @@ const (
 	// Allowed filters: ShardID
 	TimerProcessorCachedQueueReaderMode
 
+	// PersistenceMigrationMode controls how persistence calls are routed between the default store and the
+	// migration store while a cluster is moved to another database. It only takes effect when
+	// persistence.migrationStore is configured.
+	// "disabled" (default): only the default store is used.
+	// "dual-write": writes go to the default store then the migration store, reads are served by the default store.
+	// "shadow-read": same as dual-write, reads are also sent to the migration store and the results are compared.
+	// "cutover": writes go to the migration store then the default store, reads are served by the migration store.
+	// "secondary": only the migration store is used.
+	// KeyName: system.persistenceMigrationMode
+	// Value type: string enum: "disabled", "dual-write", "shadow-read", "cutover" or "secondary"
+	// Default value: "disabled"
+	// Allowed filters: ShardID
+	PersistenceMigrationMode
+
 	// LastStringKey must be the last one in this const group
 	LastStringKey
 )
@@ -2986,6 +3106,18 @@ const (
 	// Default value: 5m
 	// Allowed filters: N/A
 	VirtualSliceForceAppendInterval
+	// QueueCriticalAckLevelStuckDuration is the critical duration the ack level of the queue can stay the same while it has pending tasks, above which the pending tasks of the domains blocking it are moved out of their virtual queue and these domains are throttled. 0 disables the alert
+	// KeyName: history.queueCriticalAckLevelStuckDuration
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	QueueCriticalAckLevelStuckDuration
+	// QueueDomainThrottleDuration is how long a domain stays throttled after it was last throttled by the queue alert mitigations
+	// KeyName: history.queueDomainThrottleDuration
+	// Value type: Duration
+	// Default value: 5m
+	// Allowed filters: N/A
+	QueueDomainThrottleDuration
 	// TimerProcessorUpdateAckInterval is update interval for timer processor
 	// KeyName: history.timerProcessorUpdateAckInterval
 	// Value type: Duration
@@ -3032,6 +3164,12 @@ const (
 	// Default value: 1s (1*time.Second)
 	// Allowed filters: N/A
 	TimerProcessorMaxTimeShift
+	// TimerBurstSmoothingWindow is the max delay added to the dispatch of a timer task scheduled in a dense second, see TimerBurstSmoothingThreshold. It is capped at 10 minutes, 0 disables burst smoothing for the domain
+	// KeyName: history.timerBurstSmoothingWindow
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName
+	TimerBurstSmoothingWindow
 	// TimerProcessorCachePrefetchTriggerWindow triggers prefetch when this close to upperBound
 	// KeyName: history.timerProcessorCachePrefetchTriggerWindow
 	// Value type: Duration
@@ -3236,6 +3374,13 @@ const (
 	// Default value: 10m (time.Minute*10)
 	// Allowed filters: N/A
 	WorkerReplicationTaskMaxRetryDuration
+	// HistoryBranchesScannerMinBranchAge is the age a history branch must have to be reported by history branches scanner,
+	// so that the branches just forked and not yet referenced by their execution are left alone
+	// KeyName: worker.historyBranchesScannerMinBranchAge
+	// Value type: Duration
+	// Default value: 1h (time.Hour)
+	// Allowed filters: N/A
+	HistoryBranchesScannerMinBranchAge
 	// ESAnalyzerTimeWindow defines the time window ElasticSearch Analyzer will consider while taking workflow averages
 	// KeyName: worker.ESAnalyzerTimeWindow
 	// Value type: Duration
@@ -3415,6 +3560,14 @@ const (
 	// Default value: see common.ConvertIntMapToDynamicConfigMapProperty(DefaultStuckTaskSplitThreshold) in code base
 	// Allowed filters: N/A
 	QueueProcessorStuckTaskSplitThreshold
+	// QueueIsolatedDomainSettings is the settings of the history queue v2 virtual queues of the domains isolated
+	// with the admin UpdateDomainIsolation API, keyed by domain name.
+	// Each value is a map with optional maxPollRPS and maxPendingTaskCount settings of the isolated virtual queue
+	// KeyName: history.queueIsolatedDomainSettings
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: N/A
+	QueueIsolatedDomainSettings
 
 	// PinotOptimizedQueryColumns is the list of search attributes that can be used in pinot optimized query
 	// KeyName: frontend.pinotOptimizedQueryColumns
@@ -3462,6 +3615,16 @@ const (
 	// Default value: forward all headers.  (this is a problematic value, and it will be changing as we reduce to a list of known values)
 	HeaderForwardingRules
 
+	// PersistenceFaultInjectionProfiles defines the faults injected in persistence calls when EnablePersistenceFaultInjection is set.
+	// Each profile selects operations (e.g. "ExecutionManager.*") and domains, and injects errors (timeout, unhandled, service-busy,
+	// internal-service, db-unavailable, condition-failed, shard-ownership-lost) and latency (fixed, uniform or exponential),
+	// optionally within a startTime/endTime window. See errorinjectors.Profile for the format.
+	// KeyName: system.persistenceFaultInjectionProfiles
+	// Value type: []errorinjectors.Profile or an []interface{} containing map[string]interface{} values
+	// Default value: empty list
+	// Allowed filters: N/A
+	PersistenceFaultInjectionProfiles
+
 	LastListKey
 )
 
@@ -4326,6 +4489,17 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MutableStateChecksumVerifyProbability is the probability [0-100] that checksum will be verified for mutable state",
 		DefaultValue: 0,
 	},
+	PersistenceWriteAuditMaxQPS: {
+		KeyName:      "history.persistenceWriteAuditMaxQPS",
+		Filters:      []Filter{DomainName},
+		Description:  "PersistenceWriteAuditMaxQPS is the max number of execution mutations of a domain recorded per second by each shard in the persistence write audit trail",
+		DefaultValue: 10,
+	},
+	PersistenceWriteAuditMaxRecords: {
+		KeyName:      "history.persistenceWriteAuditMaxRecords",
+		Description:  "PersistenceWriteAuditMaxRecords is the max number of records kept in the persistence write audit trail of a workflow run, older records are dropped",
+		DefaultValue: 200,
+	},
 	TaskSchedulerMigrationRatio: {
 		KeyName:      "history.taskSchedulerMigrationRatio",
 		Description:  "DEPRECATED: TaskSchedulerMigrationRatio is the ratio of task that is migrated to new scheduler",
@@ -4494,6 +4668,26 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TimersScannerPeriodEnd is interval end for fetching scheduled timers",
 		DefaultValue: 3,
 	},
+	HistoryBranchesScannerConcurrency: {
+		KeyName:      "worker.historyBranchesScannerConcurrency",
+		Description:  "HistoryBranchesScannerConcurrency is the concurrency of history branches scanner",
+		DefaultValue: 5,
+	},
+	HistoryBranchesScannerPersistencePageSize: {
+		KeyName:      "worker.historyBranchesScannerPersistencePageSize",
+		Description:  "HistoryBranchesScannerPersistencePageSize is the page size of execution persistence fetches in history branches scanner",
+		DefaultValue: 1000,
+	},
+	HistoryBranchesScannerBlobstoreFlushThreshold: {
+		KeyName:      "worker.historyBranchesScannerBlobstoreFlushThreshold",
+		Description:  "HistoryBranchesScannerBlobstoreFlushThreshold is threshold to flush blob store",
+		DefaultValue: 100,
+	},
+	HistoryBranchesScannerActivityBatchSize: {
+		KeyName:      "worker.historyBranchesScannerActivityBatchSize",
+		Description:  "HistoryBranchesScannerActivityBatchSize is the number of shards scanned by an activity of history branches scanner",
+		DefaultValue: 25,
+	},
 	ESAnalyzerMaxNumDomains: {
 		KeyName:      "worker.ESAnalyzerMaxNumDomains",
 		Description:  "ESAnalyzerMaxNumDomains defines how many domains to check",
@@ -4564,6 +4758,31 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "QueueMaxVirtualQueueCount is the max number of virtual queues",
 		DefaultValue: 2,
 	},
+	QueueCriticalVirtualSliceCount: {
+		KeyName:      "history.queueCriticalVirtualSliceCount",
+		Description:  "QueueCriticalVirtualSliceCount is the critical number of virtual slices of the queue, above which the slices are merged. 0 disables the alert",
+		DefaultValue: 0,
+	},
+	QueueCriticalTaskAttempt: {
+		KeyName:      "history.queueCriticalTaskAttempt",
+		Description:  "QueueCriticalTaskAttempt is the critical attempt count of a pending task of the queue, above which the pending tasks of these domains are moved out of their virtual queue and these domains are throttled. 0 disables the alert",
+		DefaultValue: 0,
+	},
+	QueueCriticalReadLevelLag: {
+		KeyName:      "history.queueCriticalReadLevelLag",
+		Description:  "QueueCriticalReadLevelLag is the critical number of task IDs between the read level of the root virtual queue and the max task ID, above which the other virtual queues are paused. Only used by immediate queues, 0 disables the alert",
+		DefaultValue: 0,
+	},
+	QueueThrottledDomainRPS: {
+		KeyName:      "history.queueThrottledDomainRPS",
+		Description:  "QueueThrottledDomainRPS is the rate at which the tasks of a domain throttled by the queue alert mitigations are submitted, per shard and queue. 0 disables the throttling",
+		DefaultValue: 10,
+	},
+	TimerBurstSmoothingThreshold: {
+		KeyName:      "history.timerBurstSmoothingThreshold",
+		Description:  "TimerBurstSmoothingThreshold is the number of timer tasks of a domain in a shard scheduled within the same second, above which the dispatch of the remaining tasks is spread over the burst smoothing window. 0 disables burst smoothing",
+		DefaultValue: 0,
+	},
 	HistoryTaskListNiceValue: {
 		KeyName:      "history.taskListNiceValue",
 		Description:  "HistoryTaskListNiceValue is the nice value for task processing priority per domain and task list",
@@ -4885,6 +5104,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableHistoryCorruptionCheck enables additional sanity check for corrupted history. This allows early catches of DB corruptions but potiantally increased latency.",
 		DefaultValue: false,
 	},
+	EnablePersistenceWriteAudit: {
+		KeyName:      "history.enablePersistenceWriteAudit",
+		Filters:      []Filter{DomainName},
+		Description:  "EnablePersistenceWriteAudit enables the sampled audit trail of the execution mutations of a domain, which is written to the blobstore",
+		DefaultValue: false,
+	},
 	EnableActivityLocalDispatchByDomain: {
 		KeyName:      "history.enableActivityLocalDispatchByDomain",
 		Filters:      []Filter{DomainName},
@@ -5043,6 +5268,22 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "TimersFixerDomainAllow is which domains are allowed to be fixed by timer fixer workflow",
 		DefaultValue: false,
 	},
+	HistoryBranchesScannerEnabled: {
+		KeyName:      "worker.historyBranchesScannerEnabled",
+		Description:  "HistoryBranchesScannerEnabled is if history branches scanner, reporting the history branches not referenced by their execution, should be started as part of worker.Scanner",
+		DefaultValue: false,
+	},
+	HistoryBranchesFixerEnabled: {
+		KeyName:      "worker.historyBranchesFixerEnabled",
+		Description:  "HistoryBranchesFixerEnabled is if history branches fixer, deleting the history branches reported by history branches scanner, should be started as part of worker.Scanner",
+		DefaultValue: false,
+	},
+	HistoryBranchesFixerDomainAllow: {
+		KeyName:      "worker.historyBranchesFixerDomainAllow",
+		Filters:      []Filter{DomainName},
+		Description:  "HistoryBranchesFixerDomainAllow is which domains are allowed to be fixed by history branches fixer workflow",
+		DefaultValue: false,
+	},
 	ConcreteExecutionFixerEnabled: {
 		KeyName:      "worker.concreteExecutionFixerEnabled",
 		Description:  "ConcreteExecutionFixerEnabled is if concrete execution fixer workflow is enabled",
@@ -5237,11 +5478,22 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Filters:      []Filter{DomainName},
 		DefaultValue: false,
 	},
+	EnableTaskPriorityByTaskType: {
+		KeyName:      "history.enableTaskPriorityByTaskType",
+		Description:  "EnableTaskPriorityByTaskType is to assign the priority subclass of history tasks by task type, so that background tasks like retention deletion and visibility updates are scheduled with lower weights than user facing tasks",
+		Filters:      []Filter{DomainName},
+		DefaultValue: false,
+	},
 	HistoryTaskDLQProcessorEnabled: {
 		KeyName:      "history.historyTaskDLQProcessorEnabled",
 		Description:  "HistoryTaskDLQProcessorEnabled enables processing HistoryTaskDLQ messages",
 		DefaultValue: false,
 	},
+	EnablePersistenceFaultInjection: {
+		KeyName:      "system.enablePersistenceFaultInjection",
+		Description:  "EnablePersistenceFaultInjection enables the persistence fault injection driven by PersistenceFaultInjectionProfiles. It is checked on every persistence call.",
+		DefaultValue: false,
+	},
 }
 
 var FloatKeys = map[FloatKey]DynamicFloat{
@@ -5504,6 +5756,12 @@ var StringKeys = map[StringKey]DynamicString{
 		DefaultValue: "disabled",
 		Filters:      []Filter{ShardID},
 	},
+	PersistenceMigrationMode: {
+		KeyName:      "system.persistenceMigrationMode",
+		Description:  "PersistenceMigrationMode controls how persistence calls are routed between the default store and the migration store: disabled/dual-write/shadow-read/cutover/secondary",
+		DefaultValue: "disabled",
+		Filters:      []Filter{ShardID},
+	},
 }
 
 var DurationKeys = map[DurationKey]DynamicDuration{
@@ -5739,6 +5997,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "VirtualSliceForceAppendInterval is the duration forcing a new virtual slice to be appended to the root virtual queue instead of being merged. It has 2 benefits: First, virtual slices won't grow infinitely, task loading for that slice can complete and its scope can be shrinked. Second, when we need to unload a virtual slice to free memory, we won't unload too many tasks.",
 		DefaultValue: time.Minute * 5,
 	},
+	QueueCriticalAckLevelStuckDuration: {
+		KeyName:      "history.queueCriticalAckLevelStuckDuration",
+		Description:  "QueueCriticalAckLevelStuckDuration is the critical duration the ack level of the queue can stay the same while it has pending tasks, above which the pending tasks of the domains blocking it are moved out of their virtual queue and these domains are throttled. 0 disables the alert",
+		DefaultValue: 0,
+	},
+	QueueDomainThrottleDuration: {
+		KeyName:      "history.queueDomainThrottleDuration",
+		Description:  "QueueDomainThrottleDuration is how long a domain stays throttled after it was last throttled by the queue alert mitigations",
+		DefaultValue: time.Minute * 5,
+	},
 	TimerProcessorUpdateAckInterval: {
 		KeyName:      "history.timerProcessorUpdateAckInterval",
 		Description:  "TimerProcessorUpdateAckInterval is update interval for timer processor",
@@ -5774,6 +6042,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "TimerProcessorMaxTimeShift is the max shift timer processor can have",
 		DefaultValue: time.Second,
 	},
+	TimerBurstSmoothingWindow: {
+		KeyName:      "history.timerBurstSmoothingWindow",
+		Filters:      []Filter{DomainName},
+		Description:  "TimerBurstSmoothingWindow is the max delay added to the dispatch of a timer task scheduled in a dense second, see TimerBurstSmoothingThreshold. It is capped at 10 minutes, 0 disables burst smoothing for the domain",
+		DefaultValue: 0,
+	},
 	TimerProcessorCachePrefetchTriggerWindow: {
 		KeyName:      "history.timerProcessorCachePrefetchTriggerWindow",
 		Description:  "TimerProcessorCachePrefetchTriggerWindow triggers prefetch when this close to upperBound",
@@ -5950,6 +6224,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "WorkerReplicationTaskMaxRetryDuration is the max retry duration for any task",
 		DefaultValue: time.Minute * 10,
 	},
+	HistoryBranchesScannerMinBranchAge: {
+		KeyName:      "worker.historyBranchesScannerMinBranchAge",
+		Description:  "HistoryBranchesScannerMinBranchAge is the age a history branch must have to be reported by history branches scanner, so that the branches just forked and not yet referenced by their execution are left alone",
+		DefaultValue: time.Hour,
+	},
 	ESAnalyzerTimeWindow: {
 		KeyName:      "worker.ESAnalyzerTimeWindow",
 		Description:  "ESAnalyzerTimeWindow defines the time window ElasticSearch Analyzer will consider while taking workflow averages",
@@ -6099,6 +6378,11 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "QueueProcessorStuckTaskSplitThreshold is the threshold for the number of attempts of a task",
 		DefaultValue: ConvertIntMapToDynamicConfigMapProperty(map[int]int{0: 100, 1: 10000}),
 	},
+	QueueIsolatedDomainSettings: {
+		KeyName:      "history.queueIsolatedDomainSettings",
+		Description:  "QueueIsolatedDomainSettings is the settings of the history queue v2 virtual queues of the domains isolated with the admin UpdateDomainIsolation API, keyed by domain name. Each value is a map with optional maxPollRPS and maxPendingTaskCount settings of the isolated virtual queue",
+		DefaultValue: map[string]interface{}{},
+	},
 	PinotOptimizedQueryColumns: {
 		KeyName:      "frontend.pinotOptimizedQueryColumns",
 		Description:  "PinotOptimizedQueryColumns is the list of search attributes that can be used in pinot optimized query",
@@ -6140,6 +6424,11 @@ var ListKeys = map[ListKey]DynamicList{
 			},
 		},
 	},
+	PersistenceFaultInjectionProfiles: {
+		KeyName:      "system.persistenceFaultInjectionProfiles",
+		Description:  "PersistenceFaultInjectionProfiles defines the errors and latency injected in persistence calls per operation and domain when EnablePersistenceFaultInjection is set",
+		DefaultValue: []interface{}{},
+	},
 }
 
 var _keyNames map[string]Key
@@ -6186,8 +6475,14 @@ func init() {
 
 var (
 	DefaultTaskSchedulerRoundRobinWeights = map[int]int{
+		constants.GetTaskPriority(constants.HighPriorityClass, constants.HighPrioritySubclass):       500,
 		constants.GetTaskPriority(constants.HighPriorityClass, constants.DefaultPrioritySubclass):    500,
+		constants.GetTaskPriority(constants.HighPriorityClass, constants.LowPrioritySubclass):        50,
+		constants.GetTaskPriority(constants.DefaultPriorityClass, constants.HighPrioritySubclass):    20,
 		constants.GetTaskPriority(constants.DefaultPriorityClass, constants.DefaultPrioritySubclass): 20,
+		constants.GetTaskPriority(constants.DefaultPriorityClass, constants.LowPrioritySubclass):     5,
+		constants.GetTaskPriority(constants.LowPriorityClass, constants.HighPrioritySubclass):        5,
 		constants.GetTaskPriority(constants.LowPriorityClass, constants.DefaultPrioritySubclass):     5,
+		constants.GetTaskPriority(constants.LowPriorityClass, constants.LowPrioritySubclass):         1,
 	}
 )
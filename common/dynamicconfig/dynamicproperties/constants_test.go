@@ -317,9 +317,15 @@ func (s *constantSuite) TestMapKey() {
 			KeyName:     "history.taskSchedulerRoundRobinWeight",
 			Description: "TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler",
 			DefaultValue: ConvertIntMapToDynamicConfigMapProperty(map[int]int{
+				constants.GetTaskPriority(constants.HighPriorityClass, constants.HighPrioritySubclass):       500,
 				constants.GetTaskPriority(constants.HighPriorityClass, constants.DefaultPrioritySubclass):    500,
+				constants.GetTaskPriority(constants.HighPriorityClass, constants.LowPrioritySubclass):        50,
+				constants.GetTaskPriority(constants.DefaultPriorityClass, constants.HighPrioritySubclass):    20,
 				constants.GetTaskPriority(constants.DefaultPriorityClass, constants.DefaultPrioritySubclass): 20,
+				constants.GetTaskPriority(constants.DefaultPriorityClass, constants.LowPrioritySubclass):     5,
+				constants.GetTaskPriority(constants.LowPriorityClass, constants.HighPrioritySubclass):        5,
 				constants.GetTaskPriority(constants.LowPriorityClass, constants.DefaultPrioritySubclass):     5,
+				constants.GetTaskPriority(constants.LowPriorityClass, constants.LowPrioritySubclass):         1,
 			}),
 		},
 		"QueueProcessorStuckTaskSplitThreshold": {
@@ -30,6 +30,8 @@ var (
 	WorkflowActionWorkflowTimeout       = workflowAction("add-workflow-timeout-event")
 	WorkflowActionWorkflowTerminated    = workflowAction("add-workflow-terminated-event")
 	WorkflowActionWorkflowContinueAsNew = workflowAction("add-workflow-continue-as-new-event")
+	WorkflowActionWorkflowPause         = workflowAction("workflow-pause")
+	WorkflowActionWorkflowResume        = workflowAction("workflow-resume")
 
 	// workflow cancellation / sign
 	WorkflowActionWorkflowCancelRequested        = workflowAction("add-workflow-cancel-requested-event")
@@ -58,6 +60,10 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskPause           = workflowAction("activitytask-pause")
+	WorkflowActionActivityTaskUnpause         = workflowAction("activitytask-unpause")
+	WorkflowActionActivityTaskReset           = workflowAction("activitytask-reset")
+	WorkflowActionActivityTaskOptionsUpdate   = workflowAction("activitytask-options-update")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -128,6 +134,7 @@ var (
 	ComponentESVisibilityManager              = component("es-visibility-manager")
 	ComponentArchiver                         = component("archiver")
 	ComponentBatcher                          = component("batcher")
+	ComponentPersistenceMigration             = component("persistence-migration")
 	ComponentScheduler                        = component("scheduler")
 	ComponentWorker                           = component("worker")
 	ComponentServiceResolver                  = component("service-resolver")
@@ -324,6 +331,8 @@ var (
 	AdminClientOperationGetDLQReplicationMessages             = clientOperation("admin-get-dlq-replication-messsages")
 	AdminClientOperationReapplyEvents                         = clientOperation("admin-reapply-events")
 	AdminClientOperationCountDLQMessages                      = clientOperation("admin-count-dlq-messsages")
+	AdminClientOperationGetDomainReplicationStatus            = clientOperation("admin-get-domain-replication-status")
+	AdminClientOperationUpdateDomainIsolation                 = clientOperation("admin-update-domain-isolation")
 	AdminClientOperationDeleteWorkflow                        = clientOperation("admin-delete-workflow")
 	AdminClientOperationReadDLQMessages                       = clientOperation("admin-read-dlq-messsages")
 	AdminClientOperationPurgeDLQMessages                      = clientOperation("admin-purge-dlq-messsages")
@@ -405,6 +414,14 @@ var (
 	FrontendClientOperationPauseSchedule                         = clientOperation("frontend-pause-schedule")
 	FrontendClientOperationUnpauseSchedule                       = clientOperation("frontend-unpause-schedule")
 	FrontendClientOperationBackfillSchedule                      = clientOperation("frontend-backfill-schedule")
+	FrontendClientOperationTriggerSchedule                       = clientOperation("frontend-trigger-schedule")
+	FrontendClientOperationPauseActivity                         = clientOperation("frontend-pause-activity")
+	FrontendClientOperationUnpauseActivity                       = clientOperation("frontend-unpause-activity")
+	FrontendClientOperationResetActivity                         = clientOperation("frontend-reset-activity")
+	FrontendClientOperationUpdateActivityOptions                 = clientOperation("frontend-update-activity-options")
+	FrontendClientOperationPauseWorkflowExecution                = clientOperation("frontend-pause-wf-execution")
+	FrontendClientOperationResumeWorkflowExecution               = clientOperation("frontend-resume-wf-execution")
+	FrontendClientOperationListScheduleMatchingTimes             = clientOperation("frontend-list-schedule-matching-times")
 	FrontendClientOperationListSchedules                         = clientOperation("frontend-list-schedules")
 
 	HistoryClientOperationStartWorkflowExecution            = clientOperation("history-start-wf-execution")
@@ -440,8 +457,16 @@ var (
 	HistoryClientOperationGetReplicationMessages            = clientOperation("history-get-replication-messages")
 	HistoryClientOperationGetDLQReplicationMessages         = clientOperation("history-get-dlq-replication-messages")
 	HistoryClientOperationQueryWorkflow                     = clientOperation("history-query-wf")
+	HistoryClientOperationPauseWorkflowExecution            = clientOperation("history-pause-wf")
+	HistoryClientOperationResumeWorkflowExecution           = clientOperation("history-resume-wf")
+	HistoryClientOperationPauseActivity                     = clientOperation("history-pause-activity")
+	HistoryClientOperationUnpauseActivity                   = clientOperation("history-unpause-activity")
+	HistoryClientOperationResetActivity                     = clientOperation("history-reset-activity")
+	HistoryClientOperationUpdateActivityOptions             = clientOperation("history-update-activity-options")
 	HistoryClientOperationReapplyEvents                     = clientOperation("history-reapply-events")
 	HistoryClientOperationCountDLQMessages                  = clientOperation("history-count-dlq-messages")
+	HistoryClientOperationGetDomainReplicationStatus        = clientOperation("history-get-domain-replication-status")
+	HistoryClientOperationUpdateDomainIsolation             = clientOperation("history-update-domain-isolation")
 	HistoryClientOperationReadDLQMessages                   = clientOperation("history-read-dlq-messages")
 	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
 	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
@@ -410,10 +410,26 @@ const (
 	HistoryClientGetDLQReplicationTasksScope
 	// HistoryClientQueryWorkflowScope tracks RPC calls to history service
 	HistoryClientQueryWorkflowScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientResumeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResumeWorkflowExecutionScope
+	// HistoryClientPauseActivityScope tracks RPC calls to history service
+	HistoryClientPauseActivityScope
+	// HistoryClientUnpauseActivityScope tracks RPC calls to history service
+	HistoryClientUnpauseActivityScope
+	// HistoryClientResetActivityScope tracks RPC calls to history service
+	HistoryClientResetActivityScope
+	// HistoryClientUpdateActivityOptionsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityOptionsScope
 	// HistoryClientReapplyEventsScope tracks RPC calls to history service
 	HistoryClientReapplyEventsScope
 	// HistoryClientCountDLQMessagesScope tracks RPC calls to history service
 	HistoryClientCountDLQMessagesScope
+	// HistoryClientGetDomainReplicationStatusScope tracks RPC calls to history service
+	HistoryClientGetDomainReplicationStatusScope
+	// HistoryClientUpdateDomainIsolationScope tracks RPC calls to history service
+	HistoryClientUpdateDomainIsolationScope
 	// HistoryClientReadDLQMessagesScope tracks RPC calls to history service
 	HistoryClientReadDLQMessagesScope
 	// HistoryClientPurgeDLQMessagesScope tracks RPC calls to history service
@@ -562,6 +578,22 @@ const (
 	FrontendClientUnpauseScheduleScope
 	// FrontendClientBackfillScheduleScope tracks RPC calls to frontend service
 	FrontendClientBackfillScheduleScope
+	// FrontendClientTriggerScheduleScope tracks RPC calls to frontend service
+	FrontendClientTriggerScheduleScope
+	// FrontendClientPauseActivityScope tracks RPC calls to frontend service
+	FrontendClientPauseActivityScope
+	// FrontendClientUnpauseActivityScope tracks RPC calls to frontend service
+	FrontendClientUnpauseActivityScope
+	// FrontendClientResetActivityScope tracks RPC calls to frontend service
+	FrontendClientResetActivityScope
+	// FrontendClientUpdateActivityOptionsScope tracks RPC calls to frontend service
+	FrontendClientUpdateActivityOptionsScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientResumeWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientResumeWorkflowExecutionScope
+	// FrontendClientListScheduleMatchingTimesScope tracks RPC calls to frontend service
+	FrontendClientListScheduleMatchingTimesScope
 	// FrontendClientListSchedulesScope tracks RPC calls to frontend service
 	FrontendClientListSchedulesScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -609,6 +641,10 @@ const (
 	AdminClientDescribeClusterScope
 	// AdminClientCountDLQMessagesScope tracks RPC calls to admin service
 	AdminClientCountDLQMessagesScope
+	// AdminClientGetDomainReplicationStatusScope tracks RPC calls to admin service
+	AdminClientGetDomainReplicationStatusScope
+	// AdminClientUpdateDomainIsolationScope tracks RPC calls to admin service
+	AdminClientUpdateDomainIsolationScope
 	// AdminClientReadDLQMessagesScope tracks RPC calls to admin service
 	AdminClientReadDLQMessagesScope
 	// AdminClientPurgeDLQMessagesScope tracks RPC calls to admin service
@@ -778,6 +814,22 @@ const (
 	DCRedirectionUnpauseScheduleScope
 	// DCRedirectionBackfillScheduleScope tracks RPC calls for dc redirection
 	DCRedirectionBackfillScheduleScope
+	// DCRedirectionTriggerScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionTriggerScheduleScope
+	// DCRedirectionPauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionPauseActivityScope
+	// DCRedirectionUnpauseActivityScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseActivityScope
+	// DCRedirectionResetActivityScope tracks RPC calls for dc redirection
+	DCRedirectionResetActivityScope
+	// DCRedirectionUpdateActivityOptionsScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateActivityOptionsScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionResumeWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionResumeWorkflowExecutionScope
+	// DCRedirectionListScheduleMatchingTimesScope tracks RPC calls for dc redirection
+	DCRedirectionListScheduleMatchingTimesScope
 	// DCRedirectionListSchedulesScope tracks RPC calls for dc redirection
 	DCRedirectionListSchedulesScope
 	// DCRedirectionForwardingPolicyScope tracks cluster redirection decisions
@@ -1010,6 +1062,10 @@ const (
 	AdminDescribeQueueScope
 	// AdminCountDLQMessagesScope is the metric scope for admin.AdminCountDLQMessagesScope
 	AdminCountDLQMessagesScope
+	// AdminGetDomainReplicationStatusScope is the metric scope for admin.GetDomainReplicationStatus
+	AdminGetDomainReplicationStatusScope
+	// AdminUpdateDomainIsolationScope is the metric scope for admin.UpdateDomainIsolation
+	AdminUpdateDomainIsolationScope
 	// AdminReadDLQMessagesScope is the metric scope for admin.AdminReadDLQMessagesScope
 	AdminReadDLQMessagesScope
 	// AdminPurgeDLQMessagesScope is the metric scope for admin.AdminPurgeDLQMessagesScope
@@ -1175,6 +1231,22 @@ const (
 	FrontendUnpauseScheduleScope
 	// FrontendBackfillScheduleScope is the metric scope for frontend.BackfillSchedule
 	FrontendBackfillScheduleScope
+	// FrontendTriggerScheduleScope is the metric scope for frontend.TriggerSchedule
+	FrontendTriggerScheduleScope
+	// FrontendPauseActivityScope is the metric scope for frontend.PauseActivity
+	FrontendPauseActivityScope
+	// FrontendUnpauseActivityScope is the metric scope for frontend.UnpauseActivity
+	FrontendUnpauseActivityScope
+	// FrontendResetActivityScope is the metric scope for frontend.ResetActivity
+	FrontendResetActivityScope
+	// FrontendUpdateActivityOptionsScope is the metric scope for frontend.UpdateActivityOptions
+	FrontendUpdateActivityOptionsScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendResumeWorkflowExecutionScope is the metric scope for frontend.ResumeWorkflowExecution
+	FrontendResumeWorkflowExecutionScope
+	// FrontendListScheduleMatchingTimesScope is the metric scope for frontend.ListScheduleMatchingTimes
+	FrontendListScheduleMatchingTimesScope
 	// FrontendListSchedulesScope is the metric scope for frontend.ListSchedules
 	FrontendListSchedulesScope
 
@@ -1247,6 +1319,10 @@ const (
 	HistoryGetDLQReplicationMessagesScope
 	// HistoryCountDLQMessagesScope tracks CountDLQMessages API calls received by service
 	HistoryCountDLQMessagesScope
+	// HistoryGetDomainReplicationStatusScope tracks GetDomainReplicationStatus API calls received by service
+	HistoryGetDomainReplicationStatusScope
+	// HistoryUpdateDomainIsolationScope tracks UpdateDomainIsolation API calls received by service
+	HistoryUpdateDomainIsolationScope
 	// HistoryReadDLQMessagesScope tracks ReadDLQMessages API calls received by service
 	HistoryReadDLQMessagesScope
 	// HistoryPurgeDLQMessagesScope tracks PurgeDLQMessages API calls received by service
@@ -1433,6 +1509,18 @@ const (
 	HistoryResetWorkflowExecutionScope
 	// HistoryQueryWorkflowScope tracks QueryWorkflow API calls received by service
 	HistoryQueryWorkflowScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryResumeWorkflowExecutionScope tracks ResumeWorkflowExecution API calls received by service
+	HistoryResumeWorkflowExecutionScope
+	// HistoryPauseActivityScope tracks PauseActivity API calls received by service
+	HistoryPauseActivityScope
+	// HistoryUnpauseActivityScope tracks UnpauseActivity API calls received by service
+	HistoryUnpauseActivityScope
+	// HistoryResetActivityScope tracks ResetActivity API calls received by service
+	HistoryResetActivityScope
+	// HistoryUpdateActivityOptionsScope tracks UpdateActivityOptions API calls received by service
+	HistoryUpdateActivityOptionsScope
 	// HistoryProcessDeleteHistoryEventScope tracks ProcessDeleteHistoryEvent processing calls
 	HistoryProcessDeleteHistoryEventScope
 	// WorkflowCompletionStatsScope tracks workflow completion updates
@@ -1699,8 +1787,16 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryClientGetReplicationTasksScope:               {operation: "HistoryClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetDLQReplicationTasksScope:            {operation: "HistoryClientGetDLQReplicationTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientQueryWorkflowScope:                     {operation: "HistoryClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:            {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResumeWorkflowExecutionScope:           {operation: "HistoryClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientPauseActivityScope:                     {operation: "HistoryClientPauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUnpauseActivityScope:                   {operation: "HistoryClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientResetActivityScope:                     {operation: "HistoryClientResetActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateActivityOptionsScope:             {operation: "HistoryClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientReapplyEventsScope:                     {operation: "HistoryClientReapplyEvents", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientCountDLQMessagesScope:                  {operation: "HistoryClientCountDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetDomainReplicationStatusScope:        {operation: "HistoryClientGetDomainReplicationStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateDomainIsolationScope:             {operation: "HistoryClientUpdateDomainIsolation", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientReadDLQMessagesScope:                   {operation: "HistoryClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientPurgeDLQMessagesScope:                  {operation: "HistoryClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                  {operation: "HistoryClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1787,6 +1883,14 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendClientPauseScheduleScope:                         {operation: "FrontendClientPauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUnpauseScheduleScope:                       {operation: "FrontendClientUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientBackfillScheduleScope:                      {operation: "FrontendClientBackfillSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientTriggerScheduleScope:                       {operation: "FrontendClientTriggerSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseActivityScope:                         {operation: "FrontendClientPauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUnpauseActivityScope:                       {operation: "FrontendClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientResetActivityScope:                         {operation: "FrontendClientResetActivity", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateActivityOptionsScope:                 {operation: "FrontendClientUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:                {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientResumeWorkflowExecutionScope:               {operation: "FrontendClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientListScheduleMatchingTimesScope:             {operation: "FrontendClientListScheduleMatchingTimes", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListSchedulesScope:                         {operation: "FrontendClientListSchedules", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 
 		AdminClientGetReplicationTasksScope:                   {operation: "AdminClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1803,6 +1907,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminClientResetQueueScope:                            {operation: "AdminClientResetQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeQueueScope:                         {operation: "AdminClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientCountDLQMessagesScope:                      {operation: "AdminClientCountDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetDomainReplicationStatusScope:            {operation: "AdminClientGetDomainReplicationStatus", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUpdateDomainIsolationScope:                 {operation: "AdminClientUpdateDomainIsolation", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientReadDLQMessagesScope:                       {operation: "AdminClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientMergeDLQMessagesScope:                      {operation: "AdminClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1885,6 +1991,14 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		DCRedirectionPauseScheduleScope:                         {operation: "DCRedirectionPauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUnpauseScheduleScope:                       {operation: "DCRedirectionUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionBackfillScheduleScope:                      {operation: "DCRedirectionBackfillSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionTriggerScheduleScope:                       {operation: "DCRedirectionTriggerSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseActivityScope:                         {operation: "DCRedirectionPauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseActivityScope:                       {operation: "DCRedirectionUnpauseActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResetActivityScope:                         {operation: "DCRedirectionResetActivity", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateActivityOptionsScope:                 {operation: "DCRedirectionUpdateActivityOptions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:                {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResumeWorkflowExecutionScope:               {operation: "DCRedirectionResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListScheduleMatchingTimesScope:             {operation: "DCRedirectionListScheduleMatchingTimes", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListSchedulesScope:                         {operation: "DCRedirectionListSchedules", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionForwardingPolicyScope:                      {operation: "DCRedirectionForwardingPolicy", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 
@@ -1985,6 +2099,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminResetQueueScope:                        {operation: "AdminResetQueue"},
 		AdminDescribeQueueScope:                     {operation: "AdminDescribeQueue"},
 		AdminCountDLQMessagesScope:                  {operation: "AdminCountDLQMessages"},
+		AdminGetDomainReplicationStatusScope:        {operation: "AdminGetDomainReplicationStatus"},
+		AdminUpdateDomainIsolationScope:             {operation: "AdminUpdateDomainIsolation"},
 		AdminReadDLQMessagesScope:                   {operation: "AdminReadDLQMessages"},
 		AdminPurgeDLQMessagesScope:                  {operation: "AdminPurgeDLQMessages"},
 		AdminMergeDLQMessagesScope:                  {operation: "AdminMergeDLQMessages"},
@@ -2075,6 +2191,14 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendPauseScheduleScope:                         {operation: "PauseSchedule"},
 		FrontendUnpauseScheduleScope:                       {operation: "UnpauseSchedule"},
 		FrontendBackfillScheduleScope:                      {operation: "BackfillSchedule"},
+		FrontendTriggerScheduleScope:                       {operation: "TriggerSchedule"},
+		FrontendPauseActivityScope:                         {operation: "PauseActivity"},
+		FrontendUnpauseActivityScope:                       {operation: "UnpauseActivity"},
+		FrontendResetActivityScope:                         {operation: "ResetActivity"},
+		FrontendUpdateActivityOptionsScope:                 {operation: "UpdateActivityOptions"},
+		FrontendPauseWorkflowExecutionScope:                {operation: "PauseWorkflowExecution"},
+		FrontendResumeWorkflowExecutionScope:               {operation: "ResumeWorkflowExecution"},
+		FrontendListScheduleMatchingTimesScope:             {operation: "ListScheduleMatchingTimes"},
 		FrontendListSchedulesScope:                         {operation: "ListSchedules"},
 		FrontendGetSearchAttributesScope:                   {operation: "GetSearchAttributes"},
 		FrontendGetClusterInfoScope:                        {operation: "GetClusterInfo"},
@@ -2103,6 +2227,12 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryTerminateWorkflowExecutionScope:                          {operation: "TerminateWorkflowExecution"},
 		HistoryResetWorkflowExecutionScope:                              {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                                       {operation: "QueryWorkflow"},
+		HistoryPauseWorkflowExecutionScope:                              {operation: "PauseWorkflowExecution"},
+		HistoryResumeWorkflowExecutionScope:                             {operation: "ResumeWorkflowExecution"},
+		HistoryPauseActivityScope:                                       {operation: "PauseActivity"},
+		HistoryUnpauseActivityScope:                                     {operation: "UnpauseActivity"},
+		HistoryResetActivityScope:                                       {operation: "ResetActivity"},
+		HistoryUpdateActivityOptionsScope:                               {operation: "UpdateActivityOptions"},
 		HistoryProcessDeleteHistoryEventScope:                           {operation: "ProcessDeleteHistoryEvent"},
 		HistoryScheduleDecisionTaskScope:                                {operation: "ScheduleDecisionTask"},
 		HistoryRecordChildExecutionCompletedScope:                       {operation: "RecordChildExecutionCompleted"},
@@ -2116,6 +2246,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryGetReplicationMessagesScope:                              {operation: "GetReplicationMessages"},
 		HistoryGetDLQReplicationMessagesScope:                           {operation: "GetDLQReplicationMessages"},
 		HistoryCountDLQMessagesScope:                                    {operation: "CountDLQMessages"},
+		HistoryGetDomainReplicationStatusScope:                          {operation: "GetDomainReplicationStatus"},
+		HistoryUpdateDomainIsolationScope:                               {operation: "UpdateDomainIsolation"},
 		HistoryReadDLQMessagesScope:                                     {operation: "ReadDLQMessages"},
 		HistoryPurgeDLQMessagesScope:                                    {operation: "PurgeDLQMessages"},
 		HistoryMergeDLQMessagesScope:                                    {operation: "MergeDLQMessages"},
@@ -2359,6 +2491,12 @@ const (
 	NoSQLShardStoreReadFromOriginalColumnCounter
 	NoSQLShardStoreReadFromDataBlobCounter
 
+	PersistenceMigrationFollowerWriteFailures
+	PersistenceMigrationShadowReadFailures
+	PersistenceMigrationShadowReadMismatches
+	PersistenceMigrationInvalidMode
+	PersistenceMigrationCutoverBlocked
+
 	CadenceClientRequests
 	CadenceClientFailures
 	CadenceClientLatency
@@ -2986,6 +3124,13 @@ const (
 	VirtualQueueCountGauge
 	VirtualQueuePausedGauge
 	VirtualQueueRunningGauge
+	VirtualSliceCountGauge
+	QueueReadLevelLagGauge
+	QueueMaxTaskAttemptGauge
+	QueueAlertCounter
+	IsolatedDomainCountGauge
+	TimerBurstSmoothedTaskCounter
+	TimerBurstSmoothingDelay
 	CachedQueueHitsCounter
 	CachedQueueMissesCounter
 	CachedQueueSizeHistogram
@@ -3234,6 +3379,8 @@ const (
 	SchedulerOverlapCancelCountPerDomain
 	// SchedulerOverlapTerminateCountPerDomain measures confirmed terminates under TerminatePrevious policy; excludes workflows already gone.
 	SchedulerOverlapTerminateCountPerDomain
+	// SchedulerPausedOnFailureCountPerDomain measures fires not started because PauseOnFailure paused the schedule after consecutive failed runs.
+	SchedulerPausedOnFailureCountPerDomain
 
 	NumWorkerMetrics
 )
@@ -3323,6 +3470,11 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		PersistenceEmptyResponseCounterPerDomain:                     {metricName: "persistence_empty_response_per_domain", metricRollupName: "persistence_empty_response", metricType: Counter},
 		NoSQLShardStoreReadFromOriginalColumnCounter:                 {metricName: "nosql_shard_store_read_from_original_column", metricType: Counter},
 		NoSQLShardStoreReadFromDataBlobCounter:                       {metricName: "nosql_shard_store_read_from_data_blob", metricType: Counter},
+		PersistenceMigrationFollowerWriteFailures:                    {metricName: "persistence_migration_follower_write_failures", metricType: Counter},
+		PersistenceMigrationShadowReadFailures:                       {metricName: "persistence_migration_shadow_read_failures", metricType: Counter},
+		PersistenceMigrationShadowReadMismatches:                     {metricName: "persistence_migration_shadow_read_mismatches", metricType: Counter},
+		PersistenceMigrationInvalidMode:                              {metricName: "persistence_migration_invalid_mode", metricType: Counter},
+		PersistenceMigrationCutoverBlocked:                           {metricName: "persistence_migration_cutover_blocked", metricType: Counter},
 		CadenceClientRequests:                                        {metricName: "cadence_client_requests", metricType: Counter},
 		CadenceClientFailures:                                        {metricName: "cadence_client_errors", metricType: Counter},
 		CadenceClientLatency:                                         {metricName: "cadence_client_latency", metricType: Timer},
@@ -3968,6 +4120,13 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		VirtualQueueCountGauge:                                        {metricName: "virtual_queue_count", metricType: Gauge},
 		VirtualQueuePausedGauge:                                       {metricName: "virtual_queue_paused", metricType: Gauge},
 		VirtualQueueRunningGauge:                                      {metricName: "virtual_queue_running", metricType: Gauge},
+		VirtualSliceCountGauge:                                        {metricName: "virtual_slice_count", metricType: Gauge},
+		QueueReadLevelLagGauge:                                        {metricName: "queue_read_level_lag", metricType: Gauge},
+		QueueMaxTaskAttemptGauge:                                      {metricName: "queue_max_task_attempt", metricType: Gauge},
+		QueueAlertCounter:                                             {metricName: "queue_alert", metricType: Counter},
+		IsolatedDomainCountGauge:                                      {metricName: "isolated_domain_count", metricType: Gauge},
+		TimerBurstSmoothedTaskCounter:                                 {metricName: "timer_burst_smoothed_tasks", metricType: Counter},
+		TimerBurstSmoothingDelay:                                      {metricName: "timer_burst_smoothing_delay", metricType: Histogram, buckets: HistoryTaskLatencyBuckets},
 		CachedQueueHitsCounter:                                        {metricName: "cached_queue_hits", metricType: Counter},
 		CachedQueueMissesCounter:                                      {metricName: "cached_queue_misses", metricType: Counter},
 		CachedQueueSizeHistogram:                                      {metricName: "cached_queue_size", metricType: Histogram, buckets: TaskCountBuckets},
@@ -4176,6 +4335,7 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		SchedulerFireLatencyPerDomainHistogram:          {metricName: "scheduler_fire_latency_per_domain_ns", metricType: Histogram, exponentialBuckets: Default1ms100s},
 		SchedulerOverlapCancelCountPerDomain:            {metricName: "scheduler_overlap_cancel_per_domain", metricType: Counter},
 		SchedulerOverlapTerminateCountPerDomain:         {metricName: "scheduler_overlap_terminate_per_domain", metricType: Counter},
+		SchedulerPausedOnFailureCountPerDomain:          {metricName: "scheduler_paused_on_failure_per_domain", metricType: Counter},
 	},
 }
 
@@ -382,6 +382,11 @@ func TaskCategoryTag(category string) Tag {
 	return metricWithUnknown("task_category", category)
 }
 
+// AlertTypeTag returns a new alert_type tag.
+func AlertTypeTag(alertType string) Tag {
+	return metricWithUnknown("alert_type", alertType)
+}
+
 // ReasonTag returns a new reason tag
 func ReasonTag(reason string) Tag {
 	return metricWithUnknown("reason", reason)
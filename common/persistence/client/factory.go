@@ -43,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/persistence/wrappers/errorinjectors"
 	"github.com/uber/cadence/common/persistence/wrappers/metered"
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
 	"github.com/uber/cadence/common/persistence/wrappers/ratelimited"
 	"github.com/uber/cadence/common/persistence/wrappers/sampled"
 	pnt "github.com/uber/cadence/common/pinot"
@@ -117,8 +118,14 @@ type (
 		metricsClient metrics.Client
 		logger        log.Logger
 		datastores    map[storeType]Datastore
-		clusterName   string
-		dc            *p.DynamicConfiguration
+		// migrationDatastore is the store data is being migrated to, nil when no migration is configured
+		migrationDatastore *Datastore
+		// migrationState is shared by the migration wrappers of the managers
+		migrationState *migration.State
+		// faultInjectionProfiles are the fault injection profiles of the managers, nil when fault injection is disabled
+		faultInjectionProfiles *errorinjectors.Profiles
+		clusterName            string
+		dc                     *p.DynamicConfiguration
 	}
 
 	storeType int
@@ -181,8 +188,15 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 		return nil, err
 	}
 	result := p.NewTaskManager(store)
-	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewTaskManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 || f.faultInjectionProfiles != nil {
+		result = errorinjectors.NewTaskManager(result, errorRate, f.faultInjectionProfiles, f.logger, time.Now())
+	}
+	if f.migrationDatastore != nil {
+		secondaryStore, err := f.migrationDatastore.factory.NewTaskStore()
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewTaskManager(result, p.NewTaskManager(secondaryStore), f.dc.PersistenceMigrationMode, f.migrationState, f.getMigrationMetricsClient(), f.logger)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewTaskManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -201,8 +215,15 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 		return nil, err
 	}
 	result := p.NewShardManager(store, f.dc)
-	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewShardManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 || f.faultInjectionProfiles != nil {
+		result = errorinjectors.NewShardManager(result, errorRate, f.faultInjectionProfiles, f.logger, time.Now())
+	}
+	if f.migrationDatastore != nil {
+		secondaryStore, err := f.migrationDatastore.factory.NewShardStore()
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewShardManager(result, p.NewShardManager(secondaryStore, f.dc), f.dc.PersistenceMigrationMode, f.migrationState, f.getMigrationMetricsClient(), f.logger)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewShardManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -221,8 +242,15 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 		return nil, err
 	}
 	result := p.NewHistoryV2ManagerImpl(store, f.logger, p.NewPayloadSerializer(), codec.NewThriftRWEncoder(), f.config.TransactionSizeLimit)
-	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewHistoryManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 || f.faultInjectionProfiles != nil {
+		result = errorinjectors.NewHistoryManager(result, errorRate, f.faultInjectionProfiles, f.logger, time.Now())
+	}
+	if f.migrationDatastore != nil {
+		secondaryStore, err := f.migrationDatastore.factory.NewHistoryStore()
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewHistoryManager(result, p.NewHistoryV2ManagerImpl(secondaryStore, f.logger, p.NewPayloadSerializer(), codec.NewThriftRWEncoder(), f.config.TransactionSizeLimit), f.dc.PersistenceMigrationMode, f.migrationState, f.getMigrationMetricsClient(), f.logger)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewHistoryManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -243,8 +271,15 @@ func (f *factoryImpl) NewDomainManager() (p.DomainManager, error) {
 		return nil, err
 	}
 	result := p.NewDomainManagerImpl(store, f.logger, p.NewPayloadSerializer(), f.dc)
-	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewDomainManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 || f.faultInjectionProfiles != nil {
+		result = errorinjectors.NewDomainManager(result, errorRate, f.faultInjectionProfiles, f.logger, time.Now())
+	}
+	if f.migrationDatastore != nil {
+		secondaryStore, err := f.migrationDatastore.factory.NewDomainStore()
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewDomainManager(result, p.NewDomainManagerImpl(secondaryStore, f.logger, p.NewPayloadSerializer(), f.dc), f.dc.PersistenceMigrationMode, f.migrationState, f.getMigrationMetricsClient(), f.logger)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewDomainManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -299,8 +334,15 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 		return nil, err
 	}
 	result := p.NewExecutionManagerImpl(store, f.logger, p.NewPayloadSerializer(), f.dc)
-	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewExecutionManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 || f.faultInjectionProfiles != nil {
+		result = errorinjectors.NewExecutionManager(result, errorRate, f.faultInjectionProfiles, f.logger, time.Now())
+	}
+	if f.migrationDatastore != nil {
+		secondaryStore, err := f.migrationDatastore.factory.NewExecutionStore(shardID)
+		if err != nil {
+			return nil, err
+		}
+		result = migration.NewExecutionManager(result, p.NewExecutionManagerImpl(secondaryStore, f.logger, p.NewPayloadSerializer(), f.dc), f.dc.PersistenceMigrationMode, f.migrationState, f.getMigrationMetricsClient(), f.logger)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewExecutionManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -494,8 +536,8 @@ func (f *factoryImpl) newDBVisibilityManager(
 		return nil, err
 	}
 	result := p.NewVisibilityManagerImpl(store, f.logger, f.dc)
-	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewVisibilityManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 || f.faultInjectionProfiles != nil {
+		result = errorinjectors.NewVisibilityManager(result, errorRate, f.faultInjectionProfiles, f.logger, time.Now())
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewVisibilityManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -527,8 +569,8 @@ func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error)
 		return nil, err
 	}
 	result := p.NewQueueManager(store)
-	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewQueueManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 || f.faultInjectionProfiles != nil {
+		result = errorinjectors.NewQueueManager(result, errorRate, f.faultInjectionProfiles, f.logger, time.Now())
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewQueueManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -547,8 +589,8 @@ func (f *factoryImpl) NewConfigStoreManager() (p.ConfigStoreManager, error) {
 		return nil, err
 	}
 	result := p.NewConfigStoreManagerImpl(store, f.logger)
-	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewConfigStoreManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 || f.faultInjectionProfiles != nil {
+		result = errorinjectors.NewConfigStoreManager(result, errorRate, f.faultInjectionProfiles, f.logger, time.Now())
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewConfigStoreManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -564,6 +606,9 @@ func (f *factoryImpl) NewConfigStoreManager() (p.ConfigStoreManager, error) {
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
 	ds.factory.Close()
+	if f.migrationDatastore != nil {
+		f.migrationDatastore.factory.Close()
+	}
 }
 
 func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limiter) {
@@ -572,29 +617,9 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	if defaultCfg.Cassandra != nil {
 		f.logger.Warn("Cassandra config is deprecated, please use NoSQL with pluginName of cassandra.")
 	}
-	defaultDataStore := Datastore{ratelimit: limiters[f.config.DefaultStore]}
-	switch {
-	case defaultCfg.NoSQL != nil:
-		parser := f.getParser()
-		taskSerializer := serialization.NewTaskSerializer(parser)
-		shardedNoSQLConfig := defaultCfg.NoSQL.ConvertToShardedNoSQLConfig()
-		defaultDataStore.factory = nosql.NewFactory(*shardedNoSQLConfig, clusterName, f.logger, f.metricsClient, taskSerializer, parser, f.dc)
-	case defaultCfg.ShardedNoSQL != nil:
-		parser := f.getParser()
-		taskSerializer := serialization.NewTaskSerializer(parser)
-		defaultDataStore.factory = nosql.NewFactory(*defaultCfg.ShardedNoSQL, clusterName, f.logger, f.metricsClient, taskSerializer, parser, f.dc)
-	case defaultCfg.SQL != nil:
-		if defaultCfg.SQL.EncodingType == "" {
-			defaultCfg.SQL.EncodingType = string(constants.EncodingTypeThriftRW)
-		}
-		if len(defaultCfg.SQL.DecodingTypes) == 0 {
-			defaultCfg.SQL.DecodingTypes = []string{
-				string(constants.EncodingTypeThriftRW),
-			}
-		}
-		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, clusterName, f.logger, f.getParser(), f.dc)
-	default:
-		f.logger.Fatal("invalid config: one of nosql or sql params must be specified for defaultDataStore")
+	defaultDataStore := Datastore{
+		factory:   f.newDataStoreFactory(defaultCfg, clusterName, "defaultDataStore"),
+		ratelimit: limiters[f.config.DefaultStore],
 	}
 
 	for _, st := range storeTypes {
@@ -603,6 +628,27 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 		}
 	}
 
+	// the fault injection wrapper is always installed when it's configurable, it checks whether it's enabled on every call
+	// so that fault injection can be turned on and off without restarting the services
+	if f.dc != nil && f.dc.EnablePersistenceFaultInjection != nil && f.dc.PersistenceFaultInjectionProfiles != nil {
+		f.faultInjectionProfiles = errorinjectors.NewProfiles(
+			f.dc.EnablePersistenceFaultInjection,
+			f.dc.PersistenceFaultInjectionProfiles,
+			f.logger,
+		)
+	}
+
+	if f.config.MigrationStore != "" {
+		migrationCfg := f.config.DataStores[f.config.MigrationStore]
+		if migrationCfg.Cassandra != nil {
+			f.logger.Warn("Cassandra config is deprecated, please use NoSQL with pluginName of cassandra.")
+		}
+		f.migrationDatastore = &Datastore{
+			factory: f.newDataStoreFactory(migrationCfg, clusterName, "migrationStore"),
+		}
+		f.migrationState = migration.NewState(f.NewConfigStoreManager)
+	}
+
 	visibilityCfg, ok := f.config.DataStores[f.config.VisibilityStore]
 	if !ok {
 		f.logger.Info("no visibilityStore is configured, will use advancedVisibilityStore")
@@ -629,6 +675,41 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	f.datastores[storeTypeVisibility] = visibilityDataStore
 }
 
+func (f *factoryImpl) newDataStoreFactory(cfg config.DataStore, clusterName string, storeName string) DataStoreFactory {
+	switch {
+	case cfg.NoSQL != nil:
+		parser := f.getParser()
+		taskSerializer := serialization.NewTaskSerializer(parser)
+		shardedNoSQLConfig := cfg.NoSQL.ConvertToShardedNoSQLConfig()
+		return nosql.NewFactory(*shardedNoSQLConfig, clusterName, f.logger, f.metricsClient, taskSerializer, parser, f.dc)
+	case cfg.ShardedNoSQL != nil:
+		parser := f.getParser()
+		taskSerializer := serialization.NewTaskSerializer(parser)
+		return nosql.NewFactory(*cfg.ShardedNoSQL, clusterName, f.logger, f.metricsClient, taskSerializer, parser, f.dc)
+	case cfg.SQL != nil:
+		if cfg.SQL.EncodingType == "" {
+			cfg.SQL.EncodingType = string(constants.EncodingTypeThriftRW)
+		}
+		if len(cfg.SQL.DecodingTypes) == 0 {
+			cfg.SQL.DecodingTypes = []string{
+				string(constants.EncodingTypeThriftRW),
+			}
+		}
+		return sql.NewFactory(*cfg.SQL, clusterName, f.logger, f.getParser(), f.dc)
+	default:
+		f.logger.Fatal("invalid config: one of nosql or sql params must be specified for " + storeName)
+	}
+	return nil
+}
+
+// getMigrationMetricsClient returns the metrics client of the migration wrappers, which always emit their metrics
+func (f *factoryImpl) getMigrationMetricsClient() metrics.Client {
+	if f.metricsClient == nil {
+		return metrics.NewNoopMetricsClient()
+	}
+	return f.metricsClient
+}
+
 func (f *factoryImpl) getParser() serialization.Parser {
 	parser, err := serialization.NewParser(f.dc)
 	if err != nil {
@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/messaging/kafka"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
 	"github.com/uber/cadence/common/service"
 )
 
@@ -267,7 +268,9 @@ func makeFactoryWithMetrics(t *testing.T, withMetrics bool) Factory {
 		met = metrics.NewClient(tally.NewTestScope("", nil), service.GetMetricsServiceIdx(service.Frontend, logger), metrics.MigrationConfig{})
 	}
 	ctrl := gomock.NewController(t)
-	dc := dynamicconfig.NewCollection(dynamicconfig.NewMockClient(ctrl), logger)
+	client := dynamicconfig.NewMockClient(ctrl)
+	client.EXPECT().GetBoolValue(dynamicproperties.EnablePersistenceFaultInjection, gomock.Any()).Return(false, nil).AnyTimes()
+	dc := dynamicconfig.NewCollection(client, logger)
 	pdc := persistence.NewDynamicConfiguration(dc)
 
 	cfg := &config.Persistence{
@@ -309,6 +312,55 @@ func mockDatastore(t *testing.T, fact Factory, store storeType) *MockDataStoreFa
 	return mock
 }
 
+func TestFaultInjectionProfiles(t *testing.T) {
+	logger := testlogger.New(t)
+	// the wrapper is installed while fault injection is disabled, so that it can be enabled later
+	pdc := &persistence.DynamicConfiguration{
+		EnablePersistenceFaultInjection:   func(...dynamicproperties.FilterOption) bool { return false },
+		PersistenceFaultInjectionProfiles: func(...dynamicproperties.FilterOption) []interface{} { return nil },
+	}
+	cfg := &config.Persistence{
+		DefaultStore:     "fake",
+		NumHistoryShards: 1024,
+		DataStores: map[string]config.DataStore{
+			"fake": {NoSQL: &config.NoSQL{}},
+		},
+		ErrorInjectionRate: func(...dynamicproperties.FilterOption) float64 { return 0 },
+	}
+	fact := NewFactory(cfg, func() float64 { return 1000 }, "test cluster", nil, logger, pdc)
+	defer fact.Close()
+	assert.NotNil(t, fact.(*factoryImpl).faultInjectionProfiles)
+
+	ds := mockDatastore(t, fact, storeTypeShard)
+	ds.EXPECT().NewShardStore().Return(nil, nil).Times(1)
+	check(t, fact.NewShardManager)
+}
+
+func TestMigrationStore(t *testing.T) {
+	fact := makeFactory(t)
+	impl := fact.(*factoryImpl)
+	// the factory of a configured migration store, with no real connection behind it
+	migrationDS := NewMockDataStoreFactory(gomock.NewController(t))
+	impl.migrationDatastore = &Datastore{factory: migrationDS}
+	impl.migrationState = migration.NewState(nil)
+	ds := mockDatastore(t, fact, storeTypeShard)
+
+	ds.EXPECT().NewShardStore().Return(nil, nil).Times(1)
+	migrationDS.EXPECT().NewShardStore().Return(nil, nil).Times(1)
+	sm, err := fact.NewShardManager()
+	assert.NoError(t, err)
+	assert.NotNil(t, sm)
+
+	migrationDS.EXPECT().NewShardStore().Return(nil, errors.New("no connection")).Times(1)
+	ds.EXPECT().NewShardStore().Return(nil, nil).Times(1)
+	_, err = fact.NewShardManager()
+	assert.EqualError(t, err, "no connection")
+
+	mockDatastore(t, fact, storeTypeExecution).EXPECT().Close().Times(1)
+	migrationDS.EXPECT().Close().Times(1)
+	fact.Close()
+}
+
 func TestVisibilityManagers(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -40,6 +40,10 @@ type (
 		DomainAuditLogTTL                        dynamicproperties.DurationPropertyFnWithDomainIDFilter
 		HistoryNodeDeleteBatchSize               dynamicproperties.IntPropertyFn
 		RateLimiterBypassCallerTypes             dynamicproperties.ListPropertyFn
+		ValidSearchAttributes                    dynamicproperties.MapPropertyFn
+		PersistenceMigrationMode                 dynamicproperties.StringPropertyFn
+		EnablePersistenceFaultInjection          dynamicproperties.BoolPropertyFn
+		PersistenceFaultInjectionProfiles        dynamicproperties.ListPropertyFn
 	}
 )
 
@@ -58,5 +62,9 @@ func NewDynamicConfiguration(dc *dynamicconfig.Collection) *DynamicConfiguration
 		DomainAuditLogTTL:                        dc.GetDurationPropertyFilteredByDomainID(dynamicproperties.DomainAuditLogTTL),
 		HistoryNodeDeleteBatchSize:               dc.GetIntProperty(dynamicproperties.HistoryNodeDeleteBatchSize),
 		RateLimiterBypassCallerTypes:             dc.GetListProperty(dynamicproperties.RateLimiterBypassCallerTypes),
+		ValidSearchAttributes:                    dc.GetMapProperty(dynamicproperties.ValidSearchAttributes),
+		PersistenceMigrationMode:                 dc.GetStringProperty(dynamicproperties.PersistenceMigrationMode),
+		EnablePersistenceFaultInjection:          dc.GetBoolProperty(dynamicproperties.EnablePersistenceFaultInjection),
+		PersistenceFaultInjectionProfiles:        dc.GetListProperty(dynamicproperties.PersistenceFaultInjectionProfiles),
 	}
 }
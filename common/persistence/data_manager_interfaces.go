@@ -47,6 +47,13 @@
 //go:generate gowrap gen -g -p . -i HistoryTaskDLQManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/historytaskdlq_generated.go
 //go:generate gowrap gen -g -p . -i QueueManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/queue_generated.go
 
+// Generate migration wrappers.
+//go:generate gowrap gen -g -p . -i ShardManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/shard_generated.go
+//go:generate gowrap gen -g -p . -i ExecutionManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/execution_generated.go
+//go:generate gowrap gen -g -p . -i TaskManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/task_generated.go
+//go:generate gowrap gen -g -p . -i HistoryManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/history_generated.go
+//go:generate gowrap gen -g -p . -i DomainManager -t ./wrappers/templates/migration.tmpl -o wrappers/migration/domain_generated.go
+
 // execution metered wrapper is special
 //go:generate gowrap gen -g -p . -i ExecutionManager -t ./wrappers/templates/metered_execution.tmpl -o wrappers/metered/execution_generated.go
 
@@ -364,6 +371,7 @@ const (
 	DynamicConfig ConfigType = iota
 	GlobalIsolationGroupConfig
 	OperationalDynamicConfig
+	PersistenceMigrationConfig
 )
 
 type (
@@ -453,6 +461,10 @@ type (
 		CronOverlapPolicy types.CronOverlapPolicy
 		ExpirationSeconds int32 // TODO: is this field useful?
 		CronSchedule      string
+		// Paused stops new decision and activity tasks of the workflow from being dispatched
+		Paused bool
+		// DecisionDispatchSuppressed is set when a task of the pending decision was dropped while the workflow was paused
+		DecisionDispatchSuppressed bool
 
 		ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
 	}
@@ -674,6 +686,10 @@ type (
 		LastFailureDetails       []byte
 		LastFailureCategory      types.FailureCategory
 		LastRetryIntervalSeconds int32
+		// Paused stops new attempts of the activity from being dispatched
+		Paused bool
+		// DispatchSuppressed is set when a task of the activity was dropped while its workflow was paused
+		DispatchSuppressed bool
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -1565,6 +1581,8 @@ type (
 		ShardID *int
 		// DomainName to create metrics for Domain Cost Attribution
 		DomainName string
+		// NewBranchID is the ID of the new branch, a random one is generated when it's empty
+		NewBranchID string
 	}
 
 	// ForkHistoryBranchResponse is the response to ForkHistoryBranchRequest
@@ -1617,6 +1635,8 @@ type (
 	GetHistoryTreeResponse struct {
 		// all branches of a tree
 		Branches []*workflow.HistoryBranch
+		// fork time and info of the branches, in the same order as Branches
+		BranchDetails []HistoryBranchDetail
 	}
 
 	// GetAllHistoryTreeBranchesRequest is a request of GetAllHistoryTreeBranches
@@ -434,6 +434,9 @@ type (
 		Memo               map[string][]byte
 		SearchAttributes   map[string][]byte
 		PartitionConfig    map[string]string
+		Paused             bool
+		// DecisionDispatchSuppressed is set when a task of the pending decision was dropped while the workflow was paused
+		DecisionDispatchSuppressed bool
 
 		ActiveClusterSelectionPolicy *DataBlob
 
@@ -501,6 +504,8 @@ type (
 		LastFailureDetails       []byte
 		LastFailureCategory      types.FailureCategory
 		LastRetryIntervalSeconds int32
+		Paused                   bool
+		DispatchSuppressed       bool
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -762,6 +767,8 @@ type (
 	InternalGetHistoryTreeResponse struct {
 		// all branches of a tree
 		Branches []*types.HistoryBranch
+		// fork time and info of the branches, in the same order as Branches
+		BranchDetails []HistoryBranchDetail
 	}
 
 	// InternalVisibilityWorkflowExecutionInfo is visibility info for internal response
@@ -208,6 +208,8 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		BranchToken:                        info.BranchToken,
 		CronSchedule:                       info.CronSchedule,
 		CronOverlapPolicy:                  info.CronOverlapPolicy,
+		Paused:                             info.Paused,
+		DecisionDispatchSuppressed:         info.DecisionDispatchSuppressed,
 		ExpirationSeconds:                  int32(info.ExpirationInterval.Seconds()),
 		AutoResetPoints:                    autoResetPoints,
 		SearchAttributes:                   info.SearchAttributes,
@@ -335,6 +337,8 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			LastFailureDetails:                      v.LastFailureDetails,
 			LastFailureCategory:                     v.LastFailureCategory,
 			LastRetryIntervalSeconds:                v.LastRetryIntervalSeconds,
+			Paused:                                  v.Paused,
+			DispatchSuppressed:                      v.DispatchSuppressed,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -468,6 +472,8 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			LastFailureDetails:                      v.LastFailureDetails,
 			LastFailureCategory:                     v.LastFailureCategory,
 			LastRetryIntervalSeconds:                v.LastRetryIntervalSeconds,
+			Paused:                                  v.Paused,
+			DispatchSuppressed:                      v.DispatchSuppressed,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -558,6 +564,8 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		SearchAttributes:                   info.SearchAttributes,
 		PartitionConfig:                    info.PartitionConfig,
 		CronOverlapPolicy:                  info.CronOverlapPolicy,
+		Paused:                             info.Paused,
+		DecisionDispatchSuppressed:         info.DecisionDispatchSuppressed,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
 
 		// attributes which are not related to mutable state
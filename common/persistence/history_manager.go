@@ -132,10 +132,14 @@ func (m *historyV2ManagerImpl) ForkHistoryBranch(
 	if err != nil {
 		return nil, err
 	}
+	newBranchID := request.NewBranchID
+	if newBranchID == "" {
+		newBranchID = uuid.New()
+	}
 	req := &InternalForkHistoryBranchRequest{
 		ForkBranchInfo:   *thrift.ToHistoryBranch(&forkBranch),
 		ForkNodeID:       request.ForkNodeID,
-		NewBranchID:      uuid.New(),
+		NewBranchID:      newBranchID,
 		Info:             request.Info,
 		ShardID:          shardID,
 		CurrentTimeStamp: m.timeSrc.Now(),
@@ -209,7 +213,8 @@ func (m *historyV2ManagerImpl) GetHistoryTree(
 		branches = append(branches, thrift.FromHistoryBranch(b))
 	}
 	return &GetHistoryTreeResponse{
-		Branches: branches,
+		Branches:      branches,
+		BranchDetails: resp.BranchDetails,
 	}, nil
 }
 
@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -104,6 +105,45 @@ func TestForkHistoryBranch(t *testing.T) {
 				NewBranchToken: []byte("new-branch-token"),
 			},
 		},
+		{
+			name: "success with new branch ID",
+			setupMock: func(mockStore *MockHistoryStore, mockEncoder *codec.MockBinaryEncoder) {
+				mockEncoder.EXPECT().
+					Decode([]byte("fork-branch"), &workflow.HistoryBranch{}).DoAndReturn(func(data []byte, value *workflow.HistoryBranch) error {
+					value.TreeID = common.Ptr("tree-id")
+					value.BranchID = common.Ptr("branch-id")
+					return nil
+				}).Times(1)
+				mockStore.EXPECT().
+					ForkHistoryBranch(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *InternalForkHistoryBranchRequest) (*InternalForkHistoryBranchResponse, error) {
+						assert.Equal(t, "new-branch-id", request.NewBranchID)
+						return &InternalForkHistoryBranchResponse{
+							NewBranchInfo: types.HistoryBranch{
+								TreeID:   "tree-id",
+								BranchID: request.NewBranchID,
+							},
+						}, nil
+					}).Times(1)
+				mockEncoder.EXPECT().
+					Encode(&workflow.HistoryBranch{
+						TreeID:   common.StringPtr("tree-id"),
+						BranchID: common.StringPtr("new-branch-id"),
+					}).
+					Return([]byte("new-branch-token"), nil).Times(1)
+			},
+			request: &ForkHistoryBranchRequest{
+				ForkBranchToken: []byte("fork-branch"),
+				ForkNodeID:      2,
+				Info:            "fork info",
+				ShardID:         common.Ptr(10),
+				NewBranchID:     "new-branch-id",
+			},
+			expectError: false,
+			expected: &ForkHistoryBranchResponse{
+				NewBranchToken: []byte("new-branch-token"),
+			},
+		},
 		{
 			name: "nil Shard ID",
 			setupMock: func(mockStore *MockHistoryStore, mockEncoder *codec.MockBinaryEncoder) {
@@ -350,6 +390,14 @@ func TestGetHistoryTree(t *testing.T) {
 								BranchID: "branch-id",
 							},
 						},
+						BranchDetails: []HistoryBranchDetail{
+							{
+								TreeID:   "tree-id",
+								BranchID: "branch-id",
+								ForkTime: time.Unix(1700000000, 0),
+								Info:     "info",
+							},
+						},
 					}, nil).Times(1)
 			},
 			request: &GetHistoryTreeRequest{
@@ -364,6 +412,14 @@ func TestGetHistoryTree(t *testing.T) {
 						BranchID: common.Ptr("branch-id"),
 					},
 				},
+				BranchDetails: []HistoryBranchDetail{
+					{
+						TreeID:   "tree-id",
+						BranchID: "branch-id",
+						ForkTime: time.Unix(1700000000, 0),
+						Info:     "info",
+					},
+				},
 			},
 		},
 		{
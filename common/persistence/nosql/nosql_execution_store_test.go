@@ -245,6 +245,16 @@ func TestUpdateWorkflowExecution(t *testing.T) {
 				RunID:       "test-run-id",
 			},
 		},
+		{
+			name: "Transaction size limit error",
+			setupMock: func(mockDB *nosqlplugin.MockDB, shardID int) {
+				mockDB.EXPECT().
+					UpdateWorkflowExecutionWithTasks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), nil, gomock.Any(), gomock.Any()).
+					Return(&persistence.TransactionSizeLimitError{Msg: "too many tasks"})
+			},
+			request:       newUpdateWorkflowExecutionRequest,
+			expectedError: &persistence.TransactionSizeLimitError{Msg: "too many tasks"},
+		},
 		{
 			name: "UpdateWorkflowModeBypassCurrent - assertNotCurrentExecution failure",
 			setupMock: func(mockDB *nosqlplugin.MockDB, shardID int) {
@@ -534,6 +534,7 @@ func (h *nosqlHistoryStore) GetHistoryTree(
 	}
 
 	branches := make([]*types.HistoryBranch, 0)
+	branchDetails := make([]persistence.HistoryBranchDetail, 0, len(dbBranches))
 	for _, dbBr := range dbBranches {
 		br := &types.HistoryBranch{
 			TreeID:    treeID,
@@ -541,8 +542,15 @@ func (h *nosqlHistoryStore) GetHistoryTree(
 			Ancestors: dbBr.Ancestors,
 		}
 		branches = append(branches, br)
+		branchDetails = append(branchDetails, persistence.HistoryBranchDetail{
+			TreeID:   treeID,
+			BranchID: dbBr.BranchID,
+			ForkTime: dbBr.CreateTimestamp,
+			Info:     dbBr.Info,
+		})
 	}
 	return &persistence.InternalGetHistoryTreeResponse{
-		Branches: branches,
+		Branches:      branches,
+		BranchDetails: branchDetails,
 	}, nil
 }
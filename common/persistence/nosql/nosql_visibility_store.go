@@ -21,12 +21,17 @@
 package nosql
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -37,6 +42,8 @@ import (
 const (
 	defaultCloseTTLSeconds = 86400
 	openExecutionTTLBuffer = int64(86400) // setting it to a day to account for shard going down
+
+	defaultVisibilityQueryPageSize = 1000
 )
 
 type nosqlVisibilityStore struct {
@@ -85,6 +92,7 @@ func (v *nosqlVisibilityStore) RecordWorkflowExecutionStarted(
 			ExecutionStatus:        request.ExecutionStatus,
 			CronSchedule:           request.CronSchedule,
 			ScheduledExecutionTime: request.ScheduledExecutionTime,
+			SearchAttributes:       v.decodeSearchAttributes(request.SearchAttributes),
 		},
 	})
 	if err != nil {
@@ -125,6 +133,7 @@ func (v *nosqlVisibilityStore) RecordWorkflowExecutionClosed(
 			CronSchedule:           request.CronSchedule,
 			ExecutionStatus:        request.ExecutionStatus,
 			ScheduledExecutionTime: request.ScheduledExecutionTime,
+			SearchAttributes:       v.decodeSearchAttributes(request.SearchAttributes),
 		},
 	})
 
@@ -149,7 +158,32 @@ func (v *nosqlVisibilityStore) UpsertWorkflowExecution(
 	if persistence.IsNopUpsertWorkflowRequest(request) {
 		return nil
 	}
-	return persistence.ErrVisibilityOperationNotSupported
+	ttl := int64(request.WorkflowTimeout.Seconds()) + openExecutionTTLBuffer
+
+	err := v.db.UpsertVisibility(ctx, ttl, &nosqlplugin.VisibilityRowForInsert{
+		DomainID: request.DomainUUID,
+		VisibilityRow: nosqlplugin.VisibilityRow{
+			WorkflowID:             request.WorkflowID,
+			RunID:                  request.RunID,
+			TypeName:               request.WorkflowTypeName,
+			StartTime:              request.StartTimestamp,
+			ExecutionTime:          request.ExecutionTimestamp,
+			Memo:                   request.Memo,
+			TaskList:               request.TaskList,
+			IsCron:                 request.IsCron,
+			NumClusters:            request.NumClusters,
+			UpdateTime:             request.UpdateTimestamp,
+			ShardID:                int16(request.ShardID),
+			ExecutionStatus:        request.ExecutionStatus,
+			CronSchedule:           request.CronSchedule,
+			ScheduledExecutionTime: time.Unix(0, request.ScheduledExecutionTimestamp),
+			SearchAttributes:       v.decodeSearchAttributes(request.SearchAttributes),
+		},
+	})
+	if err != nil {
+		return convertVisibilityQueryErrors(v.db, "UpsertWorkflowExecution", err)
+	}
+	return nil
 }
 
 func (v *nosqlVisibilityStore) ListOpenWorkflowExecutions(
@@ -374,21 +408,103 @@ func (v *nosqlVisibilityStore) DeleteUninitializedWorkflowExecution(
 }
 
 func (v *nosqlVisibilityStore) ListWorkflowExecutions(
-	_ context.Context,
-	_ *persistence.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *persistence.ListWorkflowExecutionsByQueryRequest,
 ) (*persistence.InternalListWorkflowExecutionsResponse, error) {
-	return nil, persistence.ErrVisibilityOperationNotSupported
+	return v.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", request)
 }
 
 func (v *nosqlVisibilityStore) ScanWorkflowExecutions(
-	_ context.Context,
-	_ *persistence.ListWorkflowExecutionsByQueryRequest) (*persistence.InternalListWorkflowExecutionsResponse, error) {
-	return nil, persistence.ErrVisibilityOperationNotSupported
+	ctx context.Context,
+	request *persistence.ListWorkflowExecutionsByQueryRequest,
+) (*persistence.InternalListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", request)
 }
 
 func (v *nosqlVisibilityStore) CountWorkflowExecutions(
-	_ context.Context,
-	_ *persistence.CountWorkflowExecutionsRequest,
+	ctx context.Context,
+	request *persistence.CountWorkflowExecutionsRequest,
 ) (*persistence.CountWorkflowExecutionsResponse, error) {
-	return nil, persistence.ErrVisibilityOperationNotSupported
+	query, err := nosqlplugin.ParseVisibilityQuery(request.Query, v.getSearchAttributeTypes())
+	if err != nil {
+		return nil, err
+	}
+	count, err := v.db.CountVisibilityByQuery(ctx, &nosqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+	})
+	if err != nil {
+		return nil, convertVisibilityQueryErrors(v.db, "CountWorkflowExecutions", err)
+	}
+	return &persistence.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (v *nosqlVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	request *persistence.ListWorkflowExecutionsByQueryRequest,
+) (*persistence.InternalListWorkflowExecutionsResponse, error) {
+	query, err := nosqlplugin.ParseVisibilityQuery(request.Query, v.getSearchAttributeTypes())
+	if err != nil {
+		return nil, err
+	}
+	filter := &nosqlplugin.VisibilityQueryFilter{
+		DomainID:      request.DomainUUID,
+		Query:         query,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = defaultVisibilityQueryPageSize
+	}
+
+	resp, err := v.db.SelectVisibilityByQuery(ctx, filter)
+	if err != nil {
+		return nil, convertVisibilityQueryErrors(v.db, opName, err)
+	}
+	return &persistence.InternalListWorkflowExecutionsResponse{
+		Executions:    resp.Executions,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// decodeSearchAttributes decodes the JSON encoded search attributes, so plugins can index them by value
+func (v *nosqlVisibilityStore) decodeSearchAttributes(attributes map[string][]byte) map[string]interface{} {
+	if len(attributes) == 0 {
+		return nil
+	}
+	values := make(map[string]interface{}, len(attributes))
+	for key, data := range attributes {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			v.logger.Error("failed to decode search attribute", tag.Key(key), tag.Error(err))
+			continue
+		}
+		values[key] = value
+	}
+	return values
+}
+
+func (v *nosqlVisibilityStore) getSearchAttributeTypes() map[string]types.IndexedValueType {
+	if v.dc == nil || v.dc.ValidSearchAttributes == nil {
+		return nil
+	}
+	validSearchAttributes := v.dc.ValidSearchAttributes()
+	attributeTypes := make(map[string]types.IndexedValueType, len(validSearchAttributes))
+	for key, valueType := range validSearchAttributes {
+		attributeTypes[key] = common.ConvertIndexedValueTypeToInternalType(valueType, v.logger)
+	}
+	return attributeTypes
+}
+
+// convertVisibilityQueryErrors keeps the BadRequestError of the plugins which don't support
+// the query based APIs, i.e. persistence.ErrVisibilityOperationNotSupported
+func convertVisibilityQueryErrors(errChecker nosqlplugin.ClientErrorChecker, operation string, err error) error {
+	var badRequestErr *types.BadRequestError
+	if errors.As(err, &badRequestErr) {
+		return err
+	}
+	return convertCommonErrors(errChecker, operation, err)
 }
@@ -24,6 +24,7 @@ package nosql
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -177,8 +178,45 @@ func TestUpsertWorkflowExecution(t *testing.T) {
 	})
 
 	assert.NoError(t, err)
+}
+
+func TestUpsertWorkflowExecution_Success(t *testing.T) {
+	visibilityStore, db := setupNoSQLVisibilityStoreMocks(t)
 
-	err = visibilityStore.UpsertWorkflowExecution(context.Background(), &persistence.InternalUpsertWorkflowExecutionRequest{})
+	db.EXPECT().UpsertVisibility(gomock.Any(), int64(60)+openExecutionTTLBuffer, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int64, row *nosqlplugin.VisibilityRowForInsert) error {
+			assert.Equal(t, testDomainID, row.DomainID)
+			assert.Equal(t, testWorkflowID, row.WorkflowID)
+			assert.Equal(t, int16(2), row.ShardID)
+			assert.Equal(t, map[string]interface{}{
+				"CustomKeywordField": "keyword",
+				"CustomIntField":     json.Number("1"),
+			}, row.SearchAttributes)
+			return nil
+		})
+
+	err := visibilityStore.UpsertWorkflowExecution(context.Background(), &persistence.InternalUpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainID,
+		WorkflowID:       testWorkflowID,
+		RunID:            testRunID,
+		WorkflowTypeName: testWorkflowTypeName,
+		WorkflowTimeout:  time.Minute,
+		ShardID:          2,
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`"keyword"`),
+			"CustomIntField":     []byte(`1`),
+		},
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestUpsertWorkflowExecution_NotSupported(t *testing.T) {
+	visibilityStore, db := setupNoSQLVisibilityStoreMocks(t)
+
+	db.EXPECT().UpsertVisibility(gomock.Any(), gomock.Any(), gomock.Any()).Return(persistence.ErrVisibilityOperationNotSupported)
+
+	err := visibilityStore.UpsertWorkflowExecution(context.Background(), &persistence.InternalUpsertWorkflowExecutionRequest{})
 
 	assert.Error(t, err)
 	assert.Equal(t, persistence.ErrVisibilityOperationNotSupported, err)
@@ -564,23 +602,96 @@ func TestDeleteUninitializedWorkflowExecution(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestListWorkflowExecutions(t *testing.T) {
+func TestListWorkflowExecutions_Success(t *testing.T) {
+	visibilityStore, db := setupNoSQLVisibilityStoreMocks(t)
+
+	db.EXPECT().SelectVisibilityByQuery(gomock.Any(), &nosqlplugin.VisibilityQueryFilter{
+		DomainID: testDomainID,
+		Query: &nosqlplugin.VisibilityQuery{
+			Conditions: []nosqlplugin.VisibilityQueryCondition{{
+				Attribute: definition.WorkflowType,
+				Operator:  nosqlplugin.VisibilityQueryEqual,
+				Value:     testWorkflowTypeName,
+			}},
+		},
+		PageSize:      defaultVisibilityQueryPageSize,
+		NextPageToken: []byte("token"),
+	}).Return(&nosqlplugin.SelectVisibilityResponse{
+		Executions: []*nosqlplugin.VisibilityRow{{
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+			TypeName:   testWorkflowTypeName,
+		}},
+		NextPageToken: []byte("next-token"),
+	}, nil)
+
+	resp, err := visibilityStore.ListWorkflowExecutions(context.Background(), &persistence.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID:    testDomainID,
+		NextPageToken: []byte("token"),
+		Query:         fmt.Sprintf("WorkflowType = '%v'", testWorkflowTypeName),
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Executions, 1)
+	assert.Equal(t, []byte("next-token"), resp.NextPageToken)
+}
+
+func TestListWorkflowExecutions_InvalidQuery(t *testing.T) {
 	visibilityStore, _ := setupNoSQLVisibilityStoreMocks(t)
+
+	_, err := visibilityStore.ListWorkflowExecutions(context.Background(), &persistence.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainID,
+		Query:      "WorkflowType = 'a' OR WorkflowType = 'b'",
+	})
+
+	var badRequestErr *types.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+}
+
+func TestListWorkflowExecutions_NotSupported(t *testing.T) {
+	visibilityStore, db := setupNoSQLVisibilityStoreMocks(t)
+
+	db.EXPECT().SelectVisibilityByQuery(gomock.Any(), gomock.Any()).Return(nil, persistence.ErrVisibilityOperationNotSupported)
+
 	_, err := visibilityStore.ListWorkflowExecutions(context.Background(), &persistence.ListWorkflowExecutionsByQueryRequest{})
+
 	assert.Error(t, err)
 	assert.Equal(t, persistence.ErrVisibilityOperationNotSupported, err)
 }
 
 func TestScanWorkflowExecutions(t *testing.T) {
-	visibilityStore, _ := setupNoSQLVisibilityStoreMocks(t)
-	_, err := visibilityStore.ScanWorkflowExecutions(context.Background(), &persistence.ListWorkflowExecutionsByQueryRequest{})
-	assert.Error(t, err)
-	assert.Equal(t, persistence.ErrVisibilityOperationNotSupported, err)
+	visibilityStore, db := setupNoSQLVisibilityStoreMocks(t)
+
+	db.EXPECT().SelectVisibilityByQuery(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+	// The error _is_ a NotFoundError
+	db.EXPECT().IsNotFoundError(assert.AnError).Return(true)
+
+	_, err := visibilityStore.ScanWorkflowExecutions(context.Background(), &persistence.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainID,
+		PageSize:   10,
+	})
+
+	assert.ErrorContains(t, err, "ScanWorkflowExecutions failed. Error:")
 }
 
 func TestCountWorkflowExecutions(t *testing.T) {
-	visibilityStore, _ := setupNoSQLVisibilityStoreMocks(t)
-	_, err := visibilityStore.CountWorkflowExecutions(context.Background(), &persistence.CountWorkflowExecutionsRequest{})
-	assert.Error(t, err)
-	assert.Equal(t, persistence.ErrVisibilityOperationNotSupported, err)
+	visibilityStore, db := setupNoSQLVisibilityStoreMocks(t)
+
+	db.EXPECT().CountVisibilityByQuery(gomock.Any(), &nosqlplugin.VisibilityQueryFilter{
+		DomainID: testDomainID,
+		Query: &nosqlplugin.VisibilityQuery{
+			Conditions: []nosqlplugin.VisibilityQueryCondition{{
+				Attribute: definition.CloseStatus,
+				Operator:  nosqlplugin.VisibilityQueryMissing,
+			}},
+		},
+	}).Return(int64(3), nil)
+
+	resp, err := visibilityStore.CountWorkflowExecutions(context.Background(), &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Query:      "CloseStatus = missing",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), resp.Count)
 }
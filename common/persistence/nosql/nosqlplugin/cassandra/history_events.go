@@ -163,9 +163,11 @@ func (db *CDB) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.Hi
 		for iter.Scan(&branchUUID, &ancsResult, &createTime, &info) {
 			ancs := parseBranchAncestors(ancsResult)
 			row := &nosqlplugin.HistoryTreeRow{
-				TreeID:    filter.TreeID,
-				BranchID:  branchUUID,
-				Ancestors: ancs,
+				TreeID:          filter.TreeID,
+				BranchID:        branchUUID,
+				Ancestors:       ancs,
+				CreateTimestamp: createTime,
+				Info:            info,
 			}
 			rows = append(rows, row)
 
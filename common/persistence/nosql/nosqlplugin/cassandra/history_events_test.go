@@ -390,11 +390,11 @@ func TestSelectFromHistoryTree(t *testing.T) {
 				mockQuery.EXPECT().PageState(gomock.Any()).Return(mockQuery).AnyTimes()
 
 				iter1 := newFakeIter([][]interface{}{
-					{"branchUUID1", []map[string]interface{}{{"branch_id": uuid.Parse(permanentRunID), "end_node_id": int64(10)}}, time.Now(), "Info1"},
+					{"branchUUID1", []map[string]interface{}{{"branch_id": uuid.Parse(permanentRunID), "end_node_id": int64(10)}}, time.Unix(1700000000, 0), "Info1"},
 				}, []byte("nextPageToken"))
 
 				iter2 := newFakeIter([][]interface{}{
-					{"branchUUID2", []map[string]interface{}{{"branch_id": uuid.Parse(permanentRunID), "end_node_id": int64(20)}}, time.Now(), "Info2"},
+					{"branchUUID2", []map[string]interface{}{{"branch_id": uuid.Parse(permanentRunID), "end_node_id": int64(20)}}, time.Unix(1700000001, 0), "Info2"},
 				}, nil) // No more pages
 
 				gomock.InOrder(
@@ -405,8 +405,8 @@ func TestSelectFromHistoryTree(t *testing.T) {
 				session.query = mockQuery
 			},
 			expectedRows: []*nosqlplugin.HistoryTreeRow{
-				{TreeID: "treeID", BranchID: "branchUUID1", Ancestors: []*types.HistoryBranchRange{{BranchID: permanentRunID, EndNodeID: 10, BeginNodeID: 1}}, Info: ""},
-				{TreeID: "treeID", BranchID: "branchUUID2", Ancestors: []*types.HistoryBranchRange{{BranchID: permanentRunID, EndNodeID: 20, BeginNodeID: 1}}, Info: ""},
+				{TreeID: "treeID", BranchID: "branchUUID1", Ancestors: []*types.HistoryBranchRange{{BranchID: permanentRunID, EndNodeID: 10, BeginNodeID: 1}}, CreateTimestamp: time.Unix(1700000000, 0), Info: "Info1"},
+				{TreeID: "treeID", BranchID: "branchUUID2", Ancestors: []*types.HistoryBranchRange{{BranchID: permanentRunID, EndNodeID: 20, BeginNodeID: 1}}, CreateTimestamp: time.Unix(1700000001, 0), Info: "Info2"},
 			},
 			expectError: false,
 		},
@@ -27,6 +27,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
@@ -39,11 +40,15 @@ const (
 )
 
 // InsertVisibility creates a new visibility record, return error is there is any.
-// TODO: Cassandra implementation ignores search attributes
 func (db *CDB) InsertVisibility(ctx context.Context, ttlSeconds int64, row *nosqlplugin.VisibilityRowForInsert) error {
-	var query gocql.Query
+	searchAttributes, err := encodeVisibilitySearchAttributes(row.SearchAttributes)
+	if err != nil {
+		return err
+	}
+
+	batch := db.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 	if ttlSeconds > maxCassandraTTL {
-		query = db.session.Query(templateCreateWorkflowExecutionStarted,
+		batch.Query(templateCreateWorkflowExecutionStarted,
 			row.DomainID,
 			domainPartition,
 			row.WorkflowID,
@@ -61,9 +66,12 @@ func (db *CDB) InsertVisibility(ctx context.Context, ttlSeconds int64, row *nosq
 			row.ExecutionStatus,
 			row.CronSchedule,
 			persistence.UnixNanoToDBTimestamp(row.ScheduledExecutionTime.UnixNano()),
-		).WithContext(ctx)
+		)
+		batch.Query(templateUpsertOpenExecutionVisibility,
+			openExecutionVisibilityArgs(row.DomainID, &row.VisibilityRow, searchAttributes)...,
+		)
 	} else {
-		query = db.session.Query(templateCreateWorkflowExecutionStartedWithTTL,
+		batch.Query(templateCreateWorkflowExecutionStartedWithTTL,
 			row.DomainID,
 			domainPartition,
 			row.WorkflowID,
@@ -82,13 +90,43 @@ func (db *CDB) InsertVisibility(ctx context.Context, ttlSeconds int64, row *nosq
 			row.CronSchedule,
 			persistence.UnixNanoToDBTimestamp(row.ScheduledExecutionTime.UnixNano()),
 			ttlSeconds,
+		)
+		batch.Query(templateUpsertOpenExecutionVisibilityWithTTL,
+			append(openExecutionVisibilityArgs(row.DomainID, &row.VisibilityRow, searchAttributes), ttlSeconds)...,
+		)
+	}
+	batch = batch.WithTimestamp(persistence.UnixNanoToDBTimestamp(row.StartTime.UnixNano()))
+	return db.session.ExecuteBatch(batch)
+}
+
+// UpsertVisibility overrides the record of an open workflow in executions_visibility.
+// The write uses the update time as its timestamp, so it doesn't override the record written when the workflow is closed.
+func (db *CDB) UpsertVisibility(ctx context.Context, ttlSeconds int64, row *nosqlplugin.VisibilityRowForInsert) error {
+	searchAttributes, err := encodeVisibilitySearchAttributes(row.SearchAttributes)
+	if err != nil {
+		return err
+	}
+
+	var query gocql.Query
+	if ttlSeconds > maxCassandraTTL {
+		query = db.session.Query(templateUpsertOpenExecutionVisibility,
+			openExecutionVisibilityArgs(row.DomainID, &row.VisibilityRow, searchAttributes)...,
+		).WithContext(ctx)
+	} else {
+		query = db.session.Query(templateUpsertOpenExecutionVisibilityWithTTL,
+			append(openExecutionVisibilityArgs(row.DomainID, &row.VisibilityRow, searchAttributes), ttlSeconds)...,
 		).WithContext(ctx)
 	}
-	query = query.WithTimestamp(persistence.UnixNanoToDBTimestamp(row.StartTime.UnixNano()))
+	query = query.WithTimestamp(persistence.UnixNanoToDBTimestamp(row.UpdateTime.UnixNano()))
 	return query.Exec()
 }
 
 func (db *CDB) UpdateVisibility(ctx context.Context, ttlSeconds int64, row *nosqlplugin.VisibilityRowForUpdate) error {
+	searchAttributes, err := encodeVisibilitySearchAttributes(row.SearchAttributes)
+	if err != nil {
+		return err
+	}
+
 	batch := db.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 
 	if row.UpdateCloseToOpen {
@@ -153,6 +191,9 @@ func (db *CDB) UpdateVisibility(ctx context.Context, ttlSeconds int64, row *nosq
 			row.CronSchedule,
 			persistence.UnixNanoToDBTimestamp(row.ScheduledExecutionTime.UnixNano()),
 		)
+		batch.Query(templateUpsertClosedExecutionVisibility,
+			closedExecutionVisibilityArgs(row.DomainID, &row.VisibilityRow, searchAttributes)...,
+		)
 	} else {
 		batch.Query(templateCreateWorkflowExecutionClosedWithTTL,
 			row.DomainID,
@@ -201,6 +242,9 @@ func (db *CDB) UpdateVisibility(ctx context.Context, ttlSeconds int64, row *nosq
 			persistence.UnixNanoToDBTimestamp(row.ScheduledExecutionTime.UnixNano()),
 			ttlSeconds,
 		)
+		batch.Query(templateUpsertClosedExecutionVisibilityWithTTL,
+			append(closedExecutionVisibilityArgs(row.DomainID, &row.VisibilityRow, searchAttributes), ttlSeconds)...,
+		)
 	}
 
 	// RecordWorkflowExecutionStarted is using StartTimestamp as
@@ -273,6 +317,16 @@ func (db *CDB) DeleteVisibility(ctx context.Context, domainID, workflowID, runID
 			record.StartTime,
 			runID,
 		).WithContext(ctx)
+		if err := db.executeWithConsistencyAll(query); err != nil {
+			return err
+		}
+
+		query = db.session.Query(templateDeleteExecutionVisibility,
+			domainID,
+			domainPartition,
+			record.StartTime,
+			runID,
+		).WithContext(ctx)
 		return db.executeWithConsistencyAll(query)
 	}
 	return nil
@@ -333,6 +387,36 @@ func (db *CDB) SelectVisibility(ctx context.Context, filter *nosqlplugin.Visibil
 	}
 }
 
+func (db *CDB) SelectVisibilityByQuery(ctx context.Context, filter *nosqlplugin.VisibilityQueryFilter) (*nosqlplugin.SelectVisibilityResponse, error) {
+	conditions, args, err := buildVisibilityQueryConditions(filter)
+	if err != nil {
+		return nil, err
+	}
+	query := db.session.Query(templateGetExecutionsVisibilityByQuery+conditions,
+		args...,
+	).Consistency(cassandraLowConslevel).WithContext(ctx)
+	request := &persistence.InternalListWorkflowExecutionsRequest{
+		PageSize:      filter.PageSize,
+		NextPageToken: filter.NextPageToken,
+	}
+	return processQuery(query, request, db.readExecutionVisibilityRecord)
+}
+
+func (db *CDB) CountVisibilityByQuery(ctx context.Context, filter *nosqlplugin.VisibilityQueryFilter) (int64, error) {
+	conditions, args, err := buildVisibilityQueryConditions(filter)
+	if err != nil {
+		return 0, err
+	}
+	query := db.session.Query(templateCountExecutionsVisibilityByQuery+conditions,
+		args...,
+	).Consistency(cassandraLowConslevel).WithContext(ctx)
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		return 0, err
+	}
+	return result["count"].(int64), nil
+}
+
 func (db *CDB) openFilteredByWorkflowTypeSortedByStartTime(
 	ctx context.Context,
 	request *persistence.InternalListWorkflowExecutionsRequest,
@@ -637,3 +721,60 @@ func readClosedWorkflowExecutionRecord(
 	}
 	return nil, false
 }
+
+func (db *CDB) readExecutionVisibilityRecord(
+	iter gocql.Iter,
+) (*persistence.InternalVisibilityWorkflowExecutionInfo, bool) {
+	var workflowID string
+	var runID string
+	var typeName string
+	var startTime time.Time
+	var executionTime time.Time
+	var closeTime time.Time
+	var closeStatus int32
+	var historyLength int64
+	var memo []byte
+	var encoding string
+	var taskList string
+	var isCron bool
+	var numClusters int16
+	var updateTime time.Time
+	var shardID int16
+	var executionStatus int32
+	var cronSchedule string
+	var scheduledExecutionTime time.Time
+	var searchAttributes map[string]string
+	if iter.Scan(&workflowID, &runID, &startTime, &executionTime, &closeTime, &typeName, &closeStatus, &historyLength, &memo, &encoding, &taskList, &isCron, &numClusters, &updateTime, &shardID, &executionStatus, &cronSchedule, &scheduledExecutionTime, &searchAttributes) {
+		record := &persistence.InternalVisibilityWorkflowExecutionInfo{
+			WorkflowID:             workflowID,
+			RunID:                  runID,
+			TypeName:               typeName,
+			StartTime:              startTime,
+			ExecutionTime:          executionTime,
+			Memo:                   persistence.NewDataBlob(memo, constants.EncodingType(encoding)),
+			TaskList:               taskList,
+			IsCron:                 isCron,
+			NumClusters:            numClusters,
+			UpdateTime:             updateTime,
+			ShardID:                shardID,
+			ExecutionStatus:        types.WorkflowExecutionStatus(executionStatus),
+			CronSchedule:           cronSchedule,
+			ScheduledExecutionTime: scheduledExecutionTime,
+		}
+		if closeStatus != openExecutionCloseStatus {
+			record.CloseTime = closeTime
+			record.Status = types.WorkflowExecutionCloseStatus(closeStatus).Ptr()
+			record.HistoryLength = historyLength
+		}
+		attributes, err := decodeVisibilitySearchAttributes(searchAttributes)
+		if err != nil {
+			db.logger.Error("failed to decode search attributes",
+				tag.WorkflowID(workflowID),
+				tag.WorkflowRunID(runID),
+				tag.Error(err))
+		}
+		record.SearchAttributes = attributes
+		return record, true
+	}
+	return nil, false
+}
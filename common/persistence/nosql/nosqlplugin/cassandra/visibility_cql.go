@@ -167,4 +167,41 @@ const (
 		`AND close_time >= ? ` +
 		`AND close_time <= ? ` +
 		`AND status = ? `
+
+	// /////////////// Executions Visibility /////////////////
+	// executions_visibility holds both open and closed executions to serve the query based APIs.
+	// close_status is openExecutionCloseStatus until the execution is closed.
+	executionsVisibilityOpenColumns = " workflow_id, run_id, start_time, execution_time, workflow_type_name, close_status, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes "
+
+	executionsVisibilityColumnsForSelect = " workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, close_status, history_length, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes "
+
+	templateUpsertOpenExecutionVisibilityWithTTL = `INSERT INTO executions_visibility (domain_id, domain_partition, ` + executionsVisibilityOpenColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
+
+	templateUpsertOpenExecutionVisibility = `INSERT INTO executions_visibility (domain_id, domain_partition, ` + executionsVisibilityOpenColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpsertClosedExecutionVisibilityWithTTL = `INSERT INTO executions_visibility (domain_id, domain_partition, ` + executionsVisibilityColumnsForSelect + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
+
+	templateUpsertClosedExecutionVisibility = `INSERT INTO executions_visibility (domain_id, domain_partition, ` + executionsVisibilityColumnsForSelect + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	// Intended for admin operations only
+	templateDeleteExecutionVisibility = `DELETE FROM executions_visibility ` +
+		`WHERE domain_id = ? ` +
+		`and domain_partition = ? ` +
+		`and start_time = ? ` +
+		`and run_id = ? `
+
+	// the conditions of the query are appended to the templates, followed by ALLOW FILTERING
+	templateGetExecutionsVisibilityByQuery = `SELECT ` + executionsVisibilityColumnsForSelect +
+		`FROM executions_visibility ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? `
+
+	templateCountExecutionsVisibilityByQuery = `SELECT COUNT(1) AS count ` +
+		`FROM executions_visibility ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? `
 )
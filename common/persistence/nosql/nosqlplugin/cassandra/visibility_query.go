@@ -0,0 +1,215 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+// openExecutionCloseStatus is the close_status of open executions in executions_visibility.
+// Cassandra can't filter on null values, so open executions need a close status of their own.
+const openExecutionCloseStatus int32 = -1
+
+var visibilityQueryColumns = map[string]string{
+	definition.WorkflowID:      "workflow_id",
+	definition.RunID:           "run_id",
+	definition.WorkflowType:    "workflow_type_name",
+	definition.CloseStatus:     "close_status",
+	definition.ExecutionStatus: "execution_status",
+	definition.IsCron:          "is_cron",
+	definition.StartTime:       "start_time",
+	definition.CloseTime:       "close_time",
+	definition.HistoryLength:   "history_length",
+}
+
+var visibilityQueryOperators = map[nosqlplugin.VisibilityQueryOperator]string{
+	nosqlplugin.VisibilityQueryEqual:        "=",
+	nosqlplugin.VisibilityQueryGreaterThan:  ">",
+	nosqlplugin.VisibilityQueryGreaterEqual: ">=",
+	nosqlplugin.VisibilityQueryLessThan:     "<",
+	nosqlplugin.VisibilityQueryLessEqual:    "<=",
+}
+
+// buildVisibilityQueryConditions returns the conditions to append to the executions_visibility query templates,
+// and the arguments of the whole statement. All the records of a domain are in the same partition, so the
+// conditions are evaluated with ALLOW FILTERING within that partition, with the secondary indexes when possible.
+// To bound the records read by ALLOW FILTERING, the query must either restrict the StartTime clustering column
+// from below, or match a WorkflowID through its secondary index.
+func buildVisibilityQueryConditions(filter *nosqlplugin.VisibilityQueryFilter) (string, []interface{}, error) {
+	if !isVisibilityQueryBounded(filter.Query) {
+		return "", nil, &types.BadRequestError{Message: fmt.Sprintf(
+			"Cassandra visibility queries must have a lower bound on %v, e.g. %v >= \"2006-01-02T15:04:05Z\", or a condition %v = \"<workflow ID>\", "+
+				"otherwise they would filter all the records of the domain",
+			definition.StartTime, definition.StartTime, definition.WorkflowID)}
+	}
+
+	var sb strings.Builder
+	args := []interface{}{filter.DomainID, domainPartition}
+	if filter.Query != nil {
+		for _, condition := range filter.Query.Conditions {
+			if condition.Operator == nosqlplugin.VisibilityQueryMissing {
+				// CloseStatus and CloseTime are only missing for open executions
+				sb.WriteString("AND close_status = ? ")
+				args = append(args, openExecutionCloseStatus)
+				continue
+			}
+
+			operator, ok := visibilityQueryOperators[condition.Operator]
+			if !ok {
+				return "", nil, fmt.Errorf("unknown visibility query operator %v", condition.Operator)
+			}
+			if condition.IsCustom {
+				value, err := json.Marshal(condition.Value)
+				if err != nil {
+					return "", nil, err
+				}
+				// the key is validated by the query parser, so it is safe to inline it
+				sb.WriteString(fmt.Sprintf("AND search_attributes['%s'] %s ? ", condition.Attribute, operator))
+				args = append(args, string(value))
+				continue
+			}
+
+			column, ok := visibilityQueryColumns[condition.Attribute]
+			if !ok {
+				return "", nil, fmt.Errorf("search attribute %v is not supported by Cassandra visibility", condition.Attribute)
+			}
+			value := condition.Value
+			if t, ok := value.(time.Time); ok {
+				value = persistence.UnixNanoToDBTimestamp(t.UnixNano())
+			}
+			sb.WriteString("AND " + column + " " + operator + " ? ")
+			args = append(args, value)
+		}
+	}
+	sb.WriteString("ALLOW FILTERING")
+	return sb.String(), args, nil
+}
+
+// isVisibilityQueryBounded returns true if the query has a lower bound on StartTime or an equality on WorkflowID
+func isVisibilityQueryBounded(query *nosqlplugin.VisibilityQuery) bool {
+	if query == nil {
+		return false
+	}
+	for _, condition := range query.Conditions {
+		if condition.IsCustom {
+			continue
+		}
+		switch {
+		case condition.Attribute == definition.StartTime &&
+			(condition.Operator == nosqlplugin.VisibilityQueryGreaterThan || condition.Operator == nosqlplugin.VisibilityQueryGreaterEqual):
+			return true
+		case condition.Attribute == definition.WorkflowID && condition.Operator == nosqlplugin.VisibilityQueryEqual:
+			return true
+		}
+	}
+	return false
+}
+
+func openExecutionVisibilityArgs(domainID string, row *nosqlplugin.VisibilityRow, searchAttributes map[string]string) []interface{} {
+	return []interface{}{
+		domainID,
+		domainPartition,
+		row.WorkflowID,
+		row.RunID,
+		persistence.UnixNanoToDBTimestamp(row.StartTime.UnixNano()),
+		persistence.UnixNanoToDBTimestamp(row.ExecutionTime.UnixNano()),
+		row.TypeName,
+		openExecutionCloseStatus,
+		row.Memo.Data,
+		row.Memo.GetEncoding(),
+		row.TaskList,
+		row.IsCron,
+		row.NumClusters,
+		row.UpdateTime,
+		row.ShardID,
+		row.ExecutionStatus,
+		row.CronSchedule,
+		persistence.UnixNanoToDBTimestamp(row.ScheduledExecutionTime.UnixNano()),
+		searchAttributes,
+	}
+}
+
+func closedExecutionVisibilityArgs(domainID string, row *nosqlplugin.VisibilityRow, searchAttributes map[string]string) []interface{} {
+	return []interface{}{
+		domainID,
+		domainPartition,
+		row.WorkflowID,
+		row.RunID,
+		persistence.UnixNanoToDBTimestamp(row.StartTime.UnixNano()),
+		persistence.UnixNanoToDBTimestamp(row.ExecutionTime.UnixNano()),
+		persistence.UnixNanoToDBTimestamp(row.CloseTime.UnixNano()),
+		row.TypeName,
+		row.Status,
+		row.HistoryLength,
+		row.Memo.Data,
+		row.Memo.GetEncoding(),
+		row.TaskList,
+		row.IsCron,
+		row.NumClusters,
+		row.UpdateTime,
+		row.ShardID,
+		row.ExecutionStatus,
+		row.CronSchedule,
+		persistence.UnixNanoToDBTimestamp(row.ScheduledExecutionTime.UnixNano()),
+		searchAttributes,
+	}
+}
+
+// encodeVisibilitySearchAttributes encodes each value as JSON, the same way the values of the query conditions are encoded
+func encodeVisibilitySearchAttributes(attributes map[string]interface{}) (map[string]string, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	encoded := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode search attribute %v: %w", key, err)
+		}
+		encoded[key] = string(data)
+	}
+	return encoded, nil
+}
+
+func decodeVisibilitySearchAttributes(attributes map[string]string) (map[string]interface{}, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	decoded := make(map[string]interface{}, len(attributes))
+	for key, data := range attributes {
+		decoder := json.NewDecoder(strings.NewReader(data))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("failed to decode search attribute %v: %w", key, err)
+		}
+		decoded[key] = value
+	}
+	return decoded, nil
+}
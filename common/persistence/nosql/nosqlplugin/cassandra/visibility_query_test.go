@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestBuildVisibilityQueryConditions(t *testing.T) {
+	closeTime := time.Unix(1700000000, 0)
+	tests := []struct {
+		desc           string
+		query          *nosqlplugin.VisibilityQuery
+		wantConditions string
+		wantArgs       []interface{}
+		wantErr        bool
+	}{
+		{
+			desc:    "no query",
+			wantErr: true,
+		},
+		{
+			desc: "no lower bound on start time",
+			query: &nosqlplugin.VisibilityQuery{
+				Conditions: []nosqlplugin.VisibilityQueryCondition{
+					{Attribute: definition.StartTime, ValueType: types.IndexedValueTypeDatetime, Operator: nosqlplugin.VisibilityQueryLessThan, Value: closeTime},
+					{Attribute: definition.WorkflowType, ValueType: types.IndexedValueTypeKeyword, Operator: nosqlplugin.VisibilityQueryEqual, Value: "type"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			desc: "start time range",
+			query: &nosqlplugin.VisibilityQuery{
+				Conditions: []nosqlplugin.VisibilityQueryCondition{
+					{Attribute: definition.StartTime, ValueType: types.IndexedValueTypeDatetime, Operator: nosqlplugin.VisibilityQueryGreaterEqual, Value: closeTime},
+					{Attribute: definition.WorkflowType, ValueType: types.IndexedValueTypeKeyword, Operator: nosqlplugin.VisibilityQueryEqual, Value: "type"},
+				},
+			},
+			wantConditions: "AND start_time >= ? AND workflow_type_name = ? ALLOW FILTERING",
+			wantArgs:       []interface{}{"domain-id", domainPartition, int64(1700000000000), "type"},
+		},
+		{
+			desc: "system and custom attributes",
+			query: &nosqlplugin.VisibilityQuery{
+				Conditions: []nosqlplugin.VisibilityQueryCondition{
+					{Attribute: definition.WorkflowID, ValueType: types.IndexedValueTypeKeyword, Operator: nosqlplugin.VisibilityQueryEqual, Value: "wid"},
+					{Attribute: definition.CloseTime, ValueType: types.IndexedValueTypeDatetime, Operator: nosqlplugin.VisibilityQueryLessThan, Value: closeTime},
+					{Attribute: definition.HistoryLength, ValueType: types.IndexedValueTypeInt, Operator: nosqlplugin.VisibilityQueryGreaterThan, Value: int64(10)},
+					{Attribute: "CustomIntField", IsCustom: true, ValueType: types.IndexedValueTypeInt, Operator: nosqlplugin.VisibilityQueryEqual, Value: int64(5)},
+					{Attribute: "CustomBoolField", IsCustom: true, ValueType: types.IndexedValueTypeBool, Operator: nosqlplugin.VisibilityQueryEqual, Value: true},
+				},
+			},
+			wantConditions: "AND workflow_id = ? AND close_time < ? AND history_length > ? " +
+				"AND search_attributes['CustomIntField'] = ? AND search_attributes['CustomBoolField'] = ? ALLOW FILTERING",
+			wantArgs: []interface{}{"domain-id", domainPartition, "wid", int64(1700000000000), int64(10), "5", "true"},
+		},
+		{
+			desc: "missing close status",
+			query: &nosqlplugin.VisibilityQuery{
+				Conditions: []nosqlplugin.VisibilityQueryCondition{
+					{Attribute: definition.StartTime, ValueType: types.IndexedValueTypeDatetime, Operator: nosqlplugin.VisibilityQueryGreaterThan, Value: closeTime},
+					{Attribute: definition.CloseStatus, ValueType: types.IndexedValueTypeInt, Operator: nosqlplugin.VisibilityQueryMissing},
+				},
+			},
+			wantConditions: "AND start_time > ? AND close_status = ? ALLOW FILTERING",
+			wantArgs:       []interface{}{"domain-id", domainPartition, int64(1700000000000), openExecutionCloseStatus},
+		},
+		{
+			desc: "unknown attribute",
+			query: &nosqlplugin.VisibilityQuery{
+				Conditions: []nosqlplugin.VisibilityQueryCondition{
+					{Attribute: "Unknown", ValueType: types.IndexedValueTypeKeyword, Operator: nosqlplugin.VisibilityQueryEqual, Value: "value"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			conditions, args, err := buildVisibilityQueryConditions(&nosqlplugin.VisibilityQueryFilter{
+				DomainID: "domain-id",
+				Query:    test.query,
+			})
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantConditions, conditions)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+}
+
+func TestVisibilitySearchAttributesEncoding(t *testing.T) {
+	encoded, err := encodeVisibilitySearchAttributes(map[string]interface{}{
+		"CustomKeywordField": "keyword",
+		"CustomIntField":     int64(1),
+		"CustomBoolField":    true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"CustomKeywordField": `"keyword"`,
+		"CustomIntField":     "1",
+		"CustomBoolField":    "true",
+	}, encoded)
+
+	decoded, err := decodeVisibilitySearchAttributes(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"CustomKeywordField": "keyword",
+		"CustomIntField":     json.Number("1"),
+		"CustomBoolField":    true,
+	}, decoded)
+
+	encoded, err = encodeVisibilitySearchAttributes(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, encoded)
+
+	_, err = decodeVisibilitySearchAttributes(map[string]string{"CustomKeywordField": "{"})
+	assert.Error(t, err)
+}
@@ -24,20 +24,88 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/testdata"
+	"github.com/uber/cadence/common/types"
 )
 
 func TestInsertVisibility(t *testing.T) {
+	tests := []struct {
+		desc            string
+		row             *nosqlplugin.VisibilityRowForInsert
+		ttlSeconds      int64
+		executeBatchErr error
+		wantQueries     []string
+		wantErr         bool
+	}{
+		{
+			desc:       "Query with ttl less than maxCassandraTTL",
+			row:        testdata.NewVisibilityRowForInsert(),
+			ttlSeconds: int64(1000),
+			wantQueries: []string{
+				`INSERT INTO open_executions (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time )VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871) using TTL 1000`,
+				`INSERT INTO executions_visibility (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, close_status, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes ) VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, -1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871, map[]) using TTL 1000`,
+			},
+			wantErr: false,
+		},
+		{
+			desc:       "Query With ttl greater than maxCassandraTTL",
+			row:        testdata.NewVisibilityRowForInsert(),
+			ttlSeconds: maxCassandraTTL + 1,
+			wantQueries: []string{
+				`INSERT INTO open_executions(domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time )VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871)`,
+				`INSERT INTO executions_visibility (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, close_status, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes ) VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, -1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871, map[])`,
+			},
+			wantErr: false,
+		},
+		{
+			desc:            "batch execution fails",
+			row:             testdata.NewVisibilityRowForInsert(),
+			ttlSeconds:      int64(1000),
+			executeBatchErr: errors.New("batch failed"),
+			wantQueries: []string{
+				`INSERT INTO open_executions (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time )VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871) using TTL 1000`,
+				`INSERT INTO executions_visibility (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, close_status, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes ) VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, -1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871, map[]) using TTL 1000`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			session := &fakeSession{
+				query:           gocql.NewMockQuery(ctrl),
+				executeBatchErr: test.executeBatchErr,
+			}
+			client := gocql.NewMockClient(ctrl)
+			cfg := &config.NoSQL{}
+			logger := testlogger.New(t)
+			dc := &persistence.DynamicConfiguration{}
+			db := NewCassandraDBFromSession(cfg, session, logger, dc, DbWithClient(client))
+
+			err := db.InsertVisibility(context.Background(), test.ttlSeconds, test.row)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.wantQueries, session.batches[0].queries)
+		})
+	}
+}
+
+func TestUpsertVisibility(t *testing.T) {
 	tests := []struct {
 		desc          string
 		row           *nosqlplugin.VisibilityRowForInsert
@@ -56,23 +124,23 @@ func TestInsertVisibility(t *testing.T) {
 				query.EXPECT().Exec().Return(nil)
 			},
 			wantQueries: []string{
-				`INSERT INTO open_executions (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time )VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871) using TTL 1000`,
+				`INSERT INTO executions_visibility (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, close_status, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes ) VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, -1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871, map[]) using TTL 1000`,
 			},
 			wantErr: false,
 		},
 		{
-			desc:       "Query With ttl greater than maxCassandraTTL",
+			desc:       "Query with ttl greater than maxCassandraTTL",
 			row:        testdata.NewVisibilityRowForInsert(),
 			ttlSeconds: maxCassandraTTL + 1,
 			queryMockFunc: func(query *gocql.MockQuery) {
 				query.EXPECT().WithContext(gomock.Any()).Return(query)
 				query.EXPECT().WithTimestamp(gomock.Any()).Return(query)
-				query.EXPECT().Exec().Return(nil)
+				query.EXPECT().Exec().Return(errors.New("exec failed"))
 			},
 			wantQueries: []string{
-				`INSERT INTO open_executions(domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time )VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871)`,
+				`INSERT INTO executions_visibility (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, workflow_type_name, close_status, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes ) VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, test-type-name, -1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871, map[])`,
 			},
-			wantErr: false,
+			wantErr: true,
 		},
 	}
 	for _, test := range tests {
@@ -91,7 +159,7 @@ func TestInsertVisibility(t *testing.T) {
 			dc := &persistence.DynamicConfiguration{}
 			db := NewCassandraDBFromSession(cfg, session, logger, dc, DbWithClient(client))
 
-			err := db.InsertVisibility(context.Background(), test.ttlSeconds, test.row)
+			err := db.UpsertVisibility(context.Background(), test.ttlSeconds, test.row)
 			if test.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -119,6 +187,7 @@ func TestUpdateVisibility(t *testing.T) {
 				`DELETE FROM open_executions WHERE domain_id = test-domain-id AND domain_partition = 0 AND start_time = 1712009321000 AND run_id = test-run-id`,
 				`INSERT INTO closed_executions (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time )VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, 1712009261000, test-type-name, COMPLETED, 1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871) using TTL 100`,
 				`INSERT INTO closed_executions_v2 (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time )VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, 1712009261000, test-type-name, COMPLETED, 1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871) using TTL 100`,
+				`INSERT INTO executions_visibility (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, close_status, history_length, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes ) VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, 1712009261000, test-type-name, COMPLETED, 1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871, map[]) using TTL 100`,
 			},
 			wantErr:   false,
 			wantPanic: false,
@@ -131,6 +200,7 @@ func TestUpdateVisibility(t *testing.T) {
 				`DELETE FROM open_executions WHERE domain_id = test-domain-id AND domain_partition = 0 AND start_time = 1712009321000 AND run_id = test-run-id`,
 				`INSERT INTO closed_executions (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time )VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, 1712009261000, test-type-name, COMPLETED, 1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871)`,
 				`INSERT INTO closed_executions_v2 (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time )VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, 1712009261000, test-type-name, COMPLETED, 1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871)`,
+				`INSERT INTO executions_visibility (domain_id, domain_partition,  workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, close_status, history_length, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes ) VALUES (test-domain-id, 0, test-workflow-id, test-run-id, 1712009321000, 1712009321000, 1712009261000, test-type-name, COMPLETED, 1, [], json, test-task-list, false, 1, 2024-04-01T22:08:41Z, 1, PENDING, , -6795364578871, map[])`,
 			},
 			wantErr:   false,
 			wantPanic: false,
@@ -368,8 +438,8 @@ func TestDeleteVisibility(t *testing.T) {
 				itr.EXPECT().Close().Return(nil)
 			},
 			queryMockFunc: func(query *gocql.MockQuery) {
-				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(2)
-				query.EXPECT().Exec().Return(nil)
+				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(3)
+				query.EXPECT().Exec().Return(nil).Times(2)
 			},
 			context:        context.WithValue(context.Background(), persistence.VisibilityAdminDeletionKey("visibilityAdminDelete"), true),
 			dc:             nil,
@@ -377,6 +447,7 @@ func TestDeleteVisibility(t *testing.T) {
 			wantQueries: []string{
 				`SELECT  workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time FROM open_executions WHERE domain_id = test-domain-id AND domain_partition = 0 AND run_id = test-run-id ALLOW FILTERING`,
 				`DELETE FROM open_executions WHERE domain_id = test-domain-id and domain_partition = 0 and start_time = 0001-01-01T00:00:00Z and run_id = test-run-id `,
+				`DELETE FROM executions_visibility WHERE domain_id = test-domain-id and domain_partition = 0 and start_time = 0001-01-01T00:00:00Z and run_id = test-run-id `,
 			},
 			wantError: false,
 		},
@@ -391,9 +462,9 @@ func TestDeleteVisibility(t *testing.T) {
 				itr.EXPECT().Close().Return(nil)
 			},
 			queryMockFunc: func(query *gocql.MockQuery) {
-				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(2)
-				query.EXPECT().Consistency(gomock.Any()).Return(query)
-				query.EXPECT().Exec().Return(nil)
+				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(3)
+				query.EXPECT().Consistency(gomock.Any()).Return(query).Times(2)
+				query.EXPECT().Exec().Return(nil).Times(2)
 			},
 			context: context.WithValue(context.Background(), persistence.VisibilityAdminDeletionKey("visibilityAdminDelete"), true),
 			dc: &persistence.DynamicConfiguration{EnableCassandraAllConsistencyLevelDelete: func(opts ...dynamicproperties.FilterOption) bool {
@@ -403,6 +474,7 @@ func TestDeleteVisibility(t *testing.T) {
 			wantQueries: []string{
 				`SELECT  workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time FROM open_executions WHERE domain_id = test-domain-id AND domain_partition = 0 AND run_id = test-run-id ALLOW FILTERING`,
 				`DELETE FROM open_executions WHERE domain_id = test-domain-id and domain_partition = 0 and start_time = 0001-01-01T00:00:00Z and run_id = test-run-id `,
+				`DELETE FROM executions_visibility WHERE domain_id = test-domain-id and domain_partition = 0 and start_time = 0001-01-01T00:00:00Z and run_id = test-run-id `,
 			},
 			wantError: false,
 		},
@@ -413,11 +485,11 @@ func TestDeleteVisibility(t *testing.T) {
 			runID:      testdata.RunID,
 			context:    context.WithValue(context.Background(), persistence.VisibilityAdminDeletionKey("visibilityAdminDelete"), true),
 			queryMockFunc: func(query *gocql.MockQuery) {
-				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(2)
+				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(3)
 				query.EXPECT().Consistency(gomock.Any()).Return(query)
 				query.EXPECT().Exec().Return(errors.New("all consistency level fail"))
-				query.EXPECT().Consistency(gomock.Any()).Return(query)
-				query.EXPECT().Exec().Return(nil)
+				query.EXPECT().Consistency(gomock.Any()).Return(query).Times(2)
+				query.EXPECT().Exec().Return(nil).Times(2)
 			},
 			mockItr: true,
 			itrMockFunc: func(itr *gocql.MockIter) {
@@ -433,6 +505,7 @@ func TestDeleteVisibility(t *testing.T) {
 			wantQueries: []string{
 				`SELECT  workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time FROM open_executions WHERE domain_id = test-domain-id AND domain_partition = 0 AND run_id = test-run-id ALLOW FILTERING`,
 				`DELETE FROM open_executions WHERE domain_id = test-domain-id and domain_partition = 0 and start_time = 0001-01-01T00:00:00Z and run_id = test-run-id `,
+				`DELETE FROM executions_visibility WHERE domain_id = test-domain-id and domain_partition = 0 and start_time = 0001-01-01T00:00:00Z and run_id = test-run-id `,
 			},
 			wantError: false,
 		},
@@ -901,6 +974,186 @@ func TestSelectVisibility(t *testing.T) {
 	}
 }
 
+func TestSelectVisibilityByQuery(t *testing.T) {
+	ts, err := time.Parse(time.RFC3339, "2024-04-01T22:08:41Z")
+	if err != nil {
+		t.Fatalf("Failed to parse time: %v", err)
+	}
+	filter := &nosqlplugin.VisibilityQueryFilter{
+		DomainID: testdata.DomainID,
+		Query: &nosqlplugin.VisibilityQuery{
+			Conditions: []nosqlplugin.VisibilityQueryCondition{
+				{Attribute: definition.WorkflowType, ValueType: types.IndexedValueTypeKeyword, Operator: nosqlplugin.VisibilityQueryEqual, Value: testdata.TypeName},
+				{Attribute: definition.StartTime, ValueType: types.IndexedValueTypeDatetime, Operator: nosqlplugin.VisibilityQueryGreaterEqual, Value: ts},
+				{Attribute: "CustomKeywordField", IsCustom: true, ValueType: types.IndexedValueTypeKeyword, Operator: nosqlplugin.VisibilityQueryEqual, Value: "keyword"},
+			},
+		},
+		PageSize: 10,
+	}
+	wantQuery := `SELECT  workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, close_status, history_length, memo, encoding, task_list, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes FROM executions_visibility WHERE domain_id = test-domain-id AND domain_partition = 0 AND workflow_type_name = test-type-name AND start_time >= 1712009321000 AND search_attributes['CustomKeywordField'] = "keyword" ALLOW FILTERING`
+
+	tests := []struct {
+		desc        string
+		itrMockFunc func(itr *gocql.MockIter)
+		wantQueries []string
+		wantResult  *nosqlplugin.SelectVisibilityResponse
+		wantError   bool
+	}{
+		{
+			desc:        "return error if iterator is nil",
+			wantQueries: []string{wantQuery},
+			wantError:   true,
+		},
+		{
+			desc: "return error if closing iterator fails",
+			itrMockFunc: func(itr *gocql.MockIter) {
+				itr.EXPECT().Scan(generateMockParams(19)...).Return(false)
+				itr.EXPECT().PageState().Return([]byte("test"))
+				itr.EXPECT().Close().Return(errors.New("close error"))
+			},
+			wantQueries: []string{wantQuery},
+			wantError:   true,
+		},
+		{
+			desc: "success",
+			itrMockFunc: func(itr *gocql.MockIter) {
+				itr.EXPECT().Scan(generateMockParams(19)...).DoAndReturn(func(args ...interface{}) bool {
+					*args[0].(*string) = testdata.WorkflowID
+					*args[1].(*string) = testdata.RunID
+					*args[6].(*int32) = openExecutionCloseStatus
+					*args[18].(*map[string]string) = map[string]string{"CustomKeywordField": `"keyword"`}
+					return true
+				})
+				itr.EXPECT().Scan(generateMockParams(19)...).DoAndReturn(func(args ...interface{}) bool {
+					*args[0].(*string) = testdata.WorkflowID
+					*args[1].(*string) = "closed-run-id"
+					*args[4].(*time.Time) = ts
+					*args[6].(*int32) = int32(types.WorkflowExecutionCloseStatusCompleted)
+					*args[7].(*int64) = 10
+					return true
+				})
+				itr.EXPECT().Scan(generateMockParams(19)...).Return(false)
+				itr.EXPECT().PageState().Return([]byte("test"))
+				itr.EXPECT().Close().Return(nil)
+			},
+			wantQueries: []string{wantQuery},
+			wantResult: &nosqlplugin.SelectVisibilityResponse{
+				Executions: []*persistence.InternalVisibilityWorkflowExecutionInfo{
+					{
+						WorkflowID:       testdata.WorkflowID,
+						RunID:            testdata.RunID,
+						SearchAttributes: map[string]interface{}{"CustomKeywordField": "keyword"},
+					},
+					{
+						WorkflowID:    testdata.WorkflowID,
+						RunID:         "closed-run-id",
+						CloseTime:     ts,
+						Status:        types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+						HistoryLength: 10,
+					},
+				},
+				NextPageToken: []byte("test"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			query := gocql.NewMockQuery(ctrl)
+			query.EXPECT().Consistency(gomock.Any()).Return(query)
+			query.EXPECT().WithContext(gomock.Any()).Return(query)
+			query.EXPECT().PageSize(10).Return(query)
+			query.EXPECT().PageState(gomock.Any()).Return(query)
+			if test.itrMockFunc != nil {
+				itr := gocql.NewMockIter(ctrl)
+				test.itrMockFunc(itr)
+				query.EXPECT().Iter().Return(itr)
+			} else {
+				query.EXPECT().Iter().Return(nil)
+			}
+			session := &fakeSession{
+				query: query,
+			}
+			client := gocql.NewMockClient(ctrl)
+			cfg := &config.NoSQL{}
+			logger := testlogger.New(t)
+			dc := &persistence.DynamicConfiguration{}
+			db := NewCassandraDBFromSession(cfg, session, logger, dc, DbWithClient(client))
+			result, err := db.SelectVisibilityByQuery(context.Background(), filter)
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.wantResult, result)
+			assert.Equal(t, test.wantQueries, session.queries)
+		})
+	}
+}
+
+func TestCountVisibilityByQuery(t *testing.T) {
+	filter := &nosqlplugin.VisibilityQueryFilter{
+		DomainID: testdata.DomainID,
+		Query: &nosqlplugin.VisibilityQuery{
+			Conditions: []nosqlplugin.VisibilityQueryCondition{
+				{Attribute: definition.WorkflowID, ValueType: types.IndexedValueTypeKeyword, Operator: nosqlplugin.VisibilityQueryEqual, Value: testdata.WorkflowID},
+				{Attribute: definition.CloseStatus, ValueType: types.IndexedValueTypeInt, Operator: nosqlplugin.VisibilityQueryMissing},
+			},
+		},
+	}
+	wantQuery := `SELECT COUNT(1) AS count FROM executions_visibility WHERE domain_id = test-domain-id AND domain_partition = 0 AND workflow_id = test-workflow-id AND close_status = -1 ALLOW FILTERING`
+
+	tests := []struct {
+		desc          string
+		queryMockFunc func(query *gocql.MockQuery)
+		wantCount     int64
+		wantError     bool
+	}{
+		{
+			desc: "success",
+			queryMockFunc: func(query *gocql.MockQuery) {
+				query.EXPECT().MapScan(gomock.Any()).DoAndReturn(func(m map[string]interface{}) error {
+					m["count"] = int64(3)
+					return nil
+				})
+			},
+			wantCount: 3,
+		},
+		{
+			desc: "return error if scan fails",
+			queryMockFunc: func(query *gocql.MockQuery) {
+				query.EXPECT().MapScan(gomock.Any()).Return(errors.New("scan error"))
+			},
+			wantError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			query := gocql.NewMockQuery(ctrl)
+			query.EXPECT().Consistency(gomock.Any()).Return(query)
+			query.EXPECT().WithContext(gomock.Any()).Return(query)
+			test.queryMockFunc(query)
+			session := &fakeSession{
+				query: query,
+			}
+			client := gocql.NewMockClient(ctrl)
+			cfg := &config.NoSQL{}
+			logger := testlogger.New(t)
+			dc := &persistence.DynamicConfiguration{}
+			db := NewCassandraDBFromSession(cfg, session, logger, dc, DbWithClient(client))
+			count, err := db.CountVisibilityByQuery(context.Background(), filter)
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.wantCount, count)
+			assert.Equal(t, []string{wantQuery}, session.queries)
+		})
+	}
+}
+
 func generateMockParams(count int) []interface{} {
 	params := []interface{}{}
 	for i := 0; i < count; i++ {
@@ -86,7 +86,9 @@ const (
 		`memo: ?, ` +
 		`partition_config: ?, ` +
 		`active_cluster_selection_policy: ?, ` +
-		`active_cluster_selection_policy_encoding: ?` +
+		`active_cluster_selection_policy_encoding: ?, ` +
+		`paused: ?, ` +
+		`decision_dispatch_suppressed: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -178,6 +180,8 @@ const (
 		`last_failure_details: ?, ` +
 		`last_failure_category: ?, ` +
 		`last_retry_interval_seconds: ?, ` +
+		`paused: ?, ` +
+		`dispatch_suppressed: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
@@ -189,6 +189,10 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) (*persistence.Int
 			activeClusterSelectionPolicyEncoding = constants.EncodingType(v.(string))
 		case "cron_overlap_policy":
 			info.CronOverlapPolicy = types.CronOverlapPolicy(int32(v.(int)))
+		case "paused":
+			info.Paused = v.(bool)
+		case "decision_dispatch_suppressed":
+			info.DecisionDispatchSuppressed = v.(bool)
 		}
 	}
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
@@ -331,6 +335,10 @@ func parseActivityInfo(
 			info.LastFailureCategory = types.FailureCategory(v.(int))
 		case "last_retry_interval_seconds":
 			info.LastRetryIntervalSeconds = int32(v.(int))
+		case "paused":
+			info.Paused = v.(bool)
+		case "dispatch_suppressed":
+			info.DispatchSuppressed = v.(bool)
 		case "event_data_encoding":
 			sharedEncoding = constants.EncodingType(v.(string))
 		}
@@ -125,6 +125,8 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 					"auto_reset_points_encoding":               "Proto3",
 					"active_cluster_selection_policy":          activeClusterSelectionPolicyData,
 					"active_cluster_selection_policy_encoding": "Proto3",
+					"paused":                                   true,
+					"decision_dispatch_suppressed":             true,
 				},
 				"next_event_id": int64(5),
 			},
@@ -180,6 +182,8 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				Memo:                               memo,
 				PartitionConfig:                    partitionConfig,
 				ActiveClusterSelectionPolicy:       persistence.NewDataBlob(activeClusterSelectionPolicyData, "Proto3"),
+				Paused:                             true,
+				DecisionDispatchSuppressed:         true,
 			},
 		},
 		{
@@ -423,6 +427,8 @@ func Test_parseActivityInfo(t *testing.T) {
 		"last_failure_details":        []byte("last_failure_details"),
 		"last_failure_category":       2,
 		"last_retry_interval_seconds": 14,
+		"paused":                      true,
+		"dispatch_suppressed":         true,
 		"event_data_encoding":         "Proto3",
 	}
 
@@ -462,6 +468,8 @@ func Test_parseActivityInfo(t *testing.T) {
 		LastFailureDetails:       []byte("last_failure_details"),
 		LastFailureCategory:      types.FailureCategoryFatal,
 		LastRetryIntervalSeconds: int32(14),
+		Paused:                   true,
+		DispatchSuppressed:       true,
 		DomainID:                 "domain_id",
 	}
 
@@ -1103,6 +1103,8 @@ func resetActivityInfoMap(activityInfos map[int64]*persistence.InternalActivityI
 		aInfo["last_failure_details"] = a.LastFailureDetails
 		aInfo["last_failure_category"] = int32(a.LastFailureCategory)
 		aInfo["last_retry_interval_seconds"] = a.LastRetryIntervalSeconds
+		aInfo["paused"] = a.Paused
+		aInfo["dispatch_suppressed"] = a.DispatchSuppressed
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -1158,6 +1160,8 @@ func updateActivityInfos(
 			a.LastFailureDetails,
 			int32(a.LastFailureCategory),
 			a.LastRetryIntervalSeconds,
+			a.Paused,
+			a.DispatchSuppressed,
 			a.ScheduledEvent.GetEncodingString(),
 			timeStamp,
 			shardID,
@@ -1473,6 +1477,8 @@ func updateWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.Paused,
+		execution.DecisionDispatchSuppressed,
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1575,6 +1581,8 @@ func createWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.Paused,
+		execution.DecisionDispatchSuppressed,
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
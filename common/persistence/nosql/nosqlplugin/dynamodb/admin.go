@@ -21,14 +21,145 @@
 
 package dynamodb
 
-import "github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
 
 var _ nosqlplugin.AdminDB = (*ddb)(nil)
 
+const (
+	testSchemaDir = "schema/dynamodb/"
+
+	// tableStatusPollInterval is how often the status of a table is read while waiting for it to be active
+	tableStatusPollInterval = time.Second
+)
+
+// TableSchema is a single entry of a schema JSON file, either a table to create or a table to update
+type TableSchema struct {
+	CreateTable *dynamodb.CreateTableInput
+	UpdateTable *dynamodb.UpdateTableInput
+	TimeToLive  *dynamodb.TimeToLiveSpecification
+}
+
 func (db *ddb) SetupTestDatabase(schemaBaseDir string, replicas int) error {
-	panic("TODO")
+	if schemaBaseDir == "" {
+		var err error
+		schemaBaseDir, err = nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	schemaFile := schemaBaseDir + "cadence/schema.json"
+	byteValues, err := ioutil.ReadFile(schemaFile)
+	if err != nil {
+		return err
+	}
+
+	// TODO SetupTestDatabase doesn't pass in context.Context so we are using background for now
+	return ApplySchema(context.Background(), db.client, db.tablePrefix, byteValues)
+}
+
+// ApplySchema creates and updates the tables of a schema JSON file, the names of the tables are prefixed
+// with tablePrefix. It returns once the tables and their indexes are active.
+func ApplySchema(ctx context.Context, client dynamodbiface.DynamoDBAPI, tablePrefix string, data []byte) error {
+	var tables []TableSchema
+	if err := json.Unmarshal(data, &tables); err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		var tableName *string
+		switch {
+		case table.CreateTable != nil:
+			input := *table.CreateTable
+			input.TableName = aws.String(tablePrefix + aws.StringValue(input.TableName))
+			tableName = input.TableName
+			if _, err := client.CreateTableWithContext(ctx, &input); err != nil {
+				return err
+			}
+		case table.UpdateTable != nil:
+			input := *table.UpdateTable
+			input.TableName = aws.String(tablePrefix + aws.StringValue(input.TableName))
+			tableName = input.TableName
+			if _, err := client.UpdateTableWithContext(ctx, &input); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("schema entry has neither CreateTable nor UpdateTable")
+		}
+		if err := waitUntilTableActive(ctx, client, tableName); err != nil {
+			return err
+		}
+		if table.TimeToLive == nil {
+			continue
+		}
+		if _, err := client.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName:               tableName,
+			TimeToLiveSpecification: table.TimeToLive,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitUntilTableActive waits for the table and its global secondary indexes to be active, an index added
+// by a table update is created in the background while the table itself is active
+func waitUntilTableActive(ctx context.Context, client dynamodbiface.DynamoDBAPI, tableName *string) error {
+	for {
+		resp, err := client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{TableName: tableName})
+		if err != nil {
+			return err
+		}
+		active := aws.StringValue(resp.Table.TableStatus) == dynamodb.TableStatusActive
+		for _, index := range resp.Table.GlobalSecondaryIndexes {
+			active = active && aws.StringValue(index.IndexStatus) == dynamodb.IndexStatusActive
+		}
+		if active {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(tableStatusPollInterval):
+		}
+	}
 }
 
 func (db *ddb) TeardownTestDatabase() error {
-	panic("TODO")
+	ctx := context.Background()
+	var tableNames []*string
+	err := db.client.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{}, func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+		for _, name := range page.TableNames {
+			if strings.HasPrefix(aws.StringValue(name), db.tablePrefix) {
+				tableNames = append(tableNames, name)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range tableNames {
+		if _, err := db.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{TableName: name}); err != nil {
+			return err
+		}
+		if err := db.client.WaitUntilTableNotExistsWithContext(ctx, &dynamodb.DescribeTableInput{TableName: name}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -22,15 +22,71 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	configAttrVersion   = "version"
+	configAttrTimestamp = "timestamp"
+	configAttrEncoding  = "data_encoding"
 )
 
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	data, encoding := persistence.FromDataBlob(row.Values)
+	item := itemKey(strconv.Itoa(row.RowType), encodeInt64(row.Version))
+	item[configAttrVersion] = numberAttr(row.Version)
+	item[configAttrTimestamp] = numberAttr(unixNano(row.Timestamp))
+	item[attrData] = binaryAttr(data)
+	item[configAttrEncoding] = stringAttr(encoding)
+
+	builder := newExpressionBuilder()
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableClusterConfig),
+		Item:                     item,
+		ConditionExpression:      aws.String(fmt.Sprintf("attribute_not_exists(%v)", builder.name(attrPK))),
+		ExpressionAttributeNames: builder.attributeNames(),
+	})
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	builder := newExpressionBuilder()
+	input := &dynamodb.QueryInput{
+		TableName: db.tableName(tableClusterConfig),
+		KeyConditionExpression: aws.String(fmt.Sprintf("%v = %v",
+			builder.name(attrPK), builder.value(stringAttr(strconv.Itoa(rowType))))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+		ScanIndexForward:          aws.Bool(false),
+	}
+	items, _, err := db.queryPage(ctx, input, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errNotFound
+	}
+
+	item := items[0]
+	return &persistence.InternalConfigStoreEntry{
+		RowType:   rowType,
+		Version:   getInt64(item, configAttrVersion),
+		Timestamp: time.Unix(0, getInt64(item, configAttrTimestamp)),
+		Values: &persistence.DataBlob{
+			Data:     getBytes(item, attrData),
+			Encoding: constants.EncodingType(getString(item, configAttrEncoding)),
+		},
+	}, nil
 }
@@ -21,8 +21,19 @@
 package dynamodb
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
@@ -30,27 +41,97 @@ import (
 )
 
 const (
-	// PluginName is the name of the plugin
-	PluginName = "dynamodb"
+	defaultRegion = "us-east-1"
 )
 
 var (
 	errConditionFailed = errors.New("internal condition fail error")
+	errNotFound        = errors.New("dynamodb item not found")
 )
 
 // ddb represents a logical connection to DynamoDB database
 type ddb struct {
+	client      dynamodbiface.DynamoDBAPI
+	cfg         *config.NoSQL
+	logger      log.Logger
+	tablePrefix string
 }
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
 // NewDynamoDB return a new DB
 func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
+	return newDDB(&cfg, logger)
+}
+
+func newDDB(cfg *config.NoSQL, logger log.Logger) (*ddb, error) {
+	if cfg.Keyspace == "" {
+		return nil, fmt.Errorf("keyspace(table prefix) cannot be empty")
+	}
+	client, err := NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &ddb{
+		client:      client,
+		cfg:         cfg,
+		logger:      logger,
+		tablePrefix: TablePrefix(cfg.Keyspace),
+	}, nil
+}
+
+// NewClient creates a DynamoDB client for the endpoint, region and credentials of the config
+func NewClient(cfg *config.NoSQL) (dynamodbiface.DynamoDBAPI, error) {
+	region := cfg.Region
+	if region == "" {
+		region = defaultRegion
+	}
+	awsConfig := &aws.Config{
+		Region: aws.String(region),
+	}
+	if endpoint := buildEndpoint(cfg); endpoint != "" {
+		awsConfig.Endpoint = aws.String(endpoint)
+	}
+	if cfg.User != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(cfg.User, cfg.Password, "")
+	}
+	if cfg.Timeout > 0 {
+		awsConfig.HTTPClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return dynamodb.New(sess), nil
+}
+
+// TablePrefix returns the prefix of the names of the tables of a keyspace
+func TablePrefix(keyspace string) string {
+	return keyspace + "_"
+}
+
+// buildEndpoint returns the endpoint from the first configured host, or empty to use the default AWS endpoint
+func buildEndpoint(cfg *config.NoSQL) string {
+	host := strings.TrimSpace(strings.Split(cfg.Hosts, ",")[0])
+	if host == "" {
+		return ""
+	}
+	if strings.Contains(host, "://") {
+		return host
+	}
+	scheme := "http"
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		scheme = "https"
+	}
+	if cfg.Port > 0 {
+		return fmt.Sprintf("%v://%v:%v", scheme, host, cfg.Port)
+	}
+	return fmt.Sprintf("%v://%v", scheme, host)
 }
 
 func (db *ddb) Close() {
-	panic("TODO")
+	// the underlying http client doesn't hold any resources that need to be released
 }
 
 func (db *ddb) PluginName() string {
@@ -58,21 +139,58 @@ func (db *ddb) PluginName() string {
 }
 
 func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
+	if err == errNotFound {
+		return true
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == dynamodb.ErrCodeResourceNotFoundException
+	}
+	return false
 }
 
 func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
+	if err == context.DeadlineExceeded {
+		return true
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		if aerr.Code() == request.CanceledErrorCode {
+			return true
+		}
+		return errors.Is(aerr.OrigErr(), context.DeadlineExceeded)
+	}
+	return false
 }
 
 func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case dynamodb.ErrCodeProvisionedThroughputExceededException,
+			dynamodb.ErrCodeRequestLimitExceeded:
+			return true
+		}
+		return request.IsErrorThrottle(aerr)
+	}
+	return false
 }
 
 func (db *ddb) IsDBUnavailableError(err error) bool {
-	panic("TODO")
+	if aerr, ok := err.(awserr.Error); ok {
+		if aerr.Code() == dynamodb.ErrCodeInternalServerError {
+			return true
+		}
+		if rerr, ok := err.(awserr.RequestFailure); ok {
+			return rerr.StatusCode() == http.StatusServiceUnavailable
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsConditionFailedError(err error) bool {
-	return err == errConditionFailed
+	if err == errConditionFailed {
+		return true
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+	}
+	return false
 }
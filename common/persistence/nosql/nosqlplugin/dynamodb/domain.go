@@ -22,17 +22,93 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+// Domains are stored in a single partition, ordered by name, together with the domain metadata record.
+// A separate item per domainID is used to look up the name and to guarantee the uniqueness of domainID.
+const (
+	domainPartitionKey      = "domains"
+	domainNameSortKeyPrefix = "name#"
+	domainMetadataSortKey   = "metadata"
+	domainIDPartitionPrefix = "id#"
+	domainIDSortKey         = "domain"
+
+	domainAttrName                = "name"
+	domainAttrID                  = "domain_id"
+	domainAttrNotificationVersion = "notification_version"
 )
 
+func domainNameKey(name string) attributeMap {
+	return itemKey(domainPartitionKey, domainNameSortKeyPrefix+name)
+}
+
+func domainIDKey(domainID string) attributeMap {
+	return itemKey(domainIDPartitionPrefix+domainID, domainIDSortKey)
+}
+
+func domainMetadataKey() attributeMap {
+	return itemKey(domainPartitionKey, domainMetadataSortKey)
+}
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	domain := *row
+	domain.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	domain.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	domain.NotificationVersion = metadataNotificationVersion
+	nameItem, err := newDomainNameItem(&domain)
+	if err != nil {
+		return err
+	}
+
+	idItem := domainIDKey(row.Info.ID)
+	idItem[domainAttrName] = stringAttr(row.Info.Name)
+	idBuilder := newExpressionBuilder()
+	nameBuilder := newExpressionBuilder()
+	items := []*dynamodb.TransactWriteItem{
+		db.newTransactPut(tableDomain, idItem, fmt.Sprintf("attribute_not_exists(%v)", idBuilder.name(attrPK)), idBuilder),
+		db.newTransactPut(tableDomain, nameItem, fmt.Sprintf("attribute_not_exists(%v)", nameBuilder.name(attrPK)), nameBuilder),
+		db.newDomainMetadataUpdate(metadataNotificationVersion),
+	}
+
+	reasons, err := db.transactWrite(ctx, items)
+	if err != nil {
+		return err
+	}
+	if reasons == nil {
+		return nil
+	}
+	if isConditionalCheckFailed(reasons[0]) {
+		return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+	}
+	if isConditionalCheckFailed(reasons[1]) {
+		db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+		return &types.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+		}
+	}
+	db.logger.Warn("Create domain operation failed because of condition update failure on domain metadata record")
+	return nosqlplugin.NewConditionFailure("domain")
 }
 
 // Update domain
@@ -40,7 +116,23 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	nameItem, err := newDomainNameItem(row)
+	if err != nil {
+		return err
+	}
+	items := []*dynamodb.TransactWriteItem{
+		db.newTransactPut(tableDomain, nameItem, "", nil),
+		db.newDomainMetadataUpdate(row.NotificationVersion),
+	}
+
+	reasons, err := db.transactWrite(ctx, items)
+	if err != nil {
+		return err
+	}
+	if reasons != nil {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +141,25 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	if domainID != nil {
+		name, err := db.selectDomainName(ctx, *domainID)
+		if err != nil {
+			return nil, err
+		}
+		domainName = &name
+	}
+
+	item, err := db.getItem(ctx, tableDomain, domainNameKey(*domainName))
+	if err != nil {
+		return nil, err
+	}
+	return parseDomainNameItem(item)
 }
 
 // Get all domain data
@@ -58,7 +168,30 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	input := &dynamodb.QueryInput{
+		TableName: db.tableName(tableDomain),
+		KeyConditionExpression: aws.String(fmt.Sprintf("%v = %v AND begins_with(%v, %v)",
+			builder.name(attrPK), builder.value(stringAttr(domainPartitionKey)),
+			builder.name(attrSK), builder.value(stringAttr(domainNameSortKeyPrefix)),
+		)),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+	items, nextPageToken, err := db.queryPage(ctx, input, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(items))
+	for _, item := range items {
+		row, err := parseDomainNameItem(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +200,104 @@ func (db *ddb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	if domainName == nil {
+		name, err := db.selectDomainName(ctx, *domainID)
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		domainName = &name
+	} else {
+		item, err := db.getItem(ctx, tableDomain, domainNameKey(*domainName))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		id := getString(item, domainAttrID)
+		domainID = &id
+	}
+
+	if err := db.deleteItem(ctx, tableDomain, domainNameKey(*domainName)); err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, tableDomain, domainIDKey(*domainID))
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableDomain, domainMetadataKey())
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata record doesn't exist until the first domain is created
+			return 0, nil
+		}
+		return -1, err
+	}
+	return getInt64(item, domainAttrNotificationVersion), nil
+}
+
+func (db *ddb) selectDomainName(ctx context.Context, domainID string) (string, error) {
+	item, err := db.getItem(ctx, tableDomain, domainIDKey(domainID))
+	if err != nil {
+		return "", err
+	}
+	return getString(item, domainAttrName), nil
+}
+
+// newDomainMetadataUpdate bumps the notification version of the domain metadata record,
+// on the condition that the current version is still notificationVersion
+func (db *ddb) newDomainMetadataUpdate(notificationVersion int64) *dynamodb.TransactWriteItem {
+	builder := newExpressionBuilder()
+	versionName := builder.name(domainAttrNotificationVersion)
+	var nextVersion int64 = 1
+	condition := fmt.Sprintf("attribute_not_exists(%v)", versionName)
+	if notificationVersion > 0 {
+		nextVersion = notificationVersion + 1
+		condition = fmt.Sprintf("%v = %v", versionName, builder.value(numberAttr(notificationVersion)))
+	}
+	update := fmt.Sprintf("SET %v = %v", versionName, builder.value(numberAttr(nextVersion)))
+	return db.newTransactUpdate(tableDomain, domainMetadataKey(), update, condition, builder)
+}
+
+func newDomainNameItem(row *nosqlplugin.DomainRow) (attributeMap, error) {
+	domain := *row
+	domain.CurrentTimeStamp = time.Time{}
+	data, err := jsonAttr(&domain)
+	if err != nil {
+		return nil, err
+	}
+	item := domainNameKey(row.Info.Name)
+	item[domainAttrID] = stringAttr(row.Info.ID)
+	item[attrData] = data
+	return item, nil
+}
+
+func parseDomainNameItem(item attributeMap) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := getJSON(item, attrData, row); err != nil {
+		return nil, err
+	}
+	if row.Info == nil {
+		row.Info = &persistence.DomainInfo{}
+	}
+	if row.Config == nil {
+		row.Config = &persistence.InternalDomainConfig{}
+	}
+	if row.ReplicationConfig == nil {
+		row.ReplicationConfig = &persistence.InternalDomainReplicationConfig{}
+	}
+	row.Config.BadBinaries = normalizeDataBlob(row.Config.BadBinaries)
+	row.Config.IsolationGroups = normalizeDataBlob(row.Config.IsolationGroups)
+	row.Config.AsyncWorkflowsConfig = normalizeDataBlob(row.Config.AsyncWorkflowsConfig)
+	row.ReplicationConfig.ActiveClustersConfig = normalizeDataBlob(row.ReplicationConfig.ActiveClustersConfig)
+	return row, nil
 }
@@ -22,16 +22,89 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
+// domainAuditLogPartitionKey returns the partition key of the audit logs of a domain and operation type.
+// Entries within a partition are sorted by the negated created time, so that the newest entries come first.
+func domainAuditLogPartitionKey(domainID string, operationType persistence.DomainAuditOperationType) string {
+	return fmt.Sprintf("%v#%v", domainID, operationType)
+}
+
+func domainAuditLogSortKeyPrefix(createdTime time.Time) string {
+	return encodeInt64(-unixNano(createdTime))
+}
+
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *ddb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	panic("TODO: InsertDomainAuditLog not implemented")
+	entry := *row
+	entry.TTLSeconds = 0
+	data, err := jsonAttr(&entry)
+	if err != nil {
+		return err
+	}
+	item := itemKey(
+		domainAuditLogPartitionKey(row.DomainID, row.OperationType),
+		domainAuditLogSortKeyPrefix(row.CreatedTime)+"#"+row.EventID,
+	)
+	item[attrData] = data
+	if row.TTLSeconds > 0 {
+		item[attrTTL] = ttlAttr(time.Now(), row.TTLSeconds)
+	}
+
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableDomainAuditLog),
+		Item:      item,
+	})
+	return err
 }
 
 // SelectDomainAuditLogs returns audit log entries for a domain and operation type
 func (db *ddb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	panic("TODO: SelectDomainAuditLogs not implemented")
+	if filter.MinCreatedTime == nil || filter.MaxCreatedTime == nil {
+		return nil, nil, &types.InternalServiceError{
+			Message: "SelectDomainAuditLogs requires non-nil MinCreatedTime and MaxCreatedTime",
+		}
+	}
+	if !filter.MinCreatedTime.Before(*filter.MaxCreatedTime) {
+		return nil, nil, nil
+	}
+
+	// created time is within [MinCreatedTime, MaxCreatedTime), which is (-MaxCreatedTime, -MinCreatedTime] once negated
+	builder := newExpressionBuilder()
+	keyCondition := fmt.Sprintf("%v = %v AND %v BETWEEN %v AND %v",
+		builder.name(attrPK), builder.value(stringAttr(domainAuditLogPartitionKey(filter.DomainID, filter.OperationType))),
+		builder.name(attrSK),
+		builder.value(stringAttr(encodeInt64(-unixNano(*filter.MaxCreatedTime)+1))),
+		// "$" sorts right after the "#" separator
+		builder.value(stringAttr(domainAuditLogSortKeyPrefix(*filter.MinCreatedTime)+"$")),
+	)
+	input := &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableDomainAuditLog),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+	items, nextPageToken, err := db.queryPage(ctx, input, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(items))
+	for _, item := range items {
+		row := &nosqlplugin.DomainAuditLogRow{}
+		if err := getJSON(item, attrData, row); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
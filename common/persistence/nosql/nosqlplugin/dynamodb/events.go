@@ -22,31 +22,292 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	historyAttrAncestors    = "ancestors"
+	historyAttrInfo         = "info"
+	historyAttrCreatedTime  = "created_time"
+	historyAttrTreeID       = "tree_id"
+	historyAttrBranchID     = "branch_id"
+	historyAttrNodeID       = "node_id"
+	historyAttrTxnID        = "txn_id"
+	historyAttrDataEncoding = "data_encoding"
+	historyAttrChunks       = "chunks"
+
+	// historyNodeChunkSize is the size of the data of a history node kept in one item, leaving room for the other
+	// attributes within maxItemSize. The data of a larger node is split into chunk items.
+	historyNodeChunkSize = 384 * 1024
 )
 
+// history_tree is partitioned by treeID and sorted by branchID.
+// history_node is partitioned by (treeID, branchID) and sorted by (nodeID ASC, txnID DESC).
+// The data of a node larger than historyNodeChunkSize is split, the node item keeps the first chunk and the number of
+// chunks, and the other chunks are kept in a separate partition of the branch sorted by (nodeID, txnID, chunk index),
+// so that the queries of nodes are not affected by the chunks.
+
+func historyNodePartitionKey(treeID, branchID string) string {
+	return treeID + "#" + branchID
+}
+
+func historyNodeChunkPartitionKey(treeID, branchID string) string {
+	return historyNodePartitionKey(treeID, branchID) + "#chunks"
+}
+
+func historyNodeSortKey(nodeID int64, txnID int64) string {
+	return encodeInt64(nodeID) + "#" + encodeInt64(-txnID)
+}
+
+func historyNodeChunkSortKey(nodeID int64, txnID int64, index int) string {
+	return historyNodeSortKey(nodeID, txnID) + "#" + encodeInt64(int64(index))
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *ddb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var items []*dynamodb.TransactWriteItem
+	if treeRow != nil {
+		ancestors, err := jsonAttr(treeRow.Ancestors)
+		if err != nil {
+			return err
+		}
+		item := itemKey(treeRow.TreeID, treeRow.BranchID)
+		item[historyAttrTreeID] = stringAttr(treeRow.TreeID)
+		item[historyAttrBranchID] = stringAttr(treeRow.BranchID)
+		item[historyAttrAncestors] = ancestors
+		item[historyAttrInfo] = stringAttr(treeRow.Info)
+		item[historyAttrCreatedTime] = numberAttr(unixNano(treeRow.CreateTimestamp))
+		items = append(items, db.newTransactPut(tableHistoryTree, item, "", nil))
+	}
+	if nodeRow != nil {
+		txnID := getTxnID(nodeRow)
+		chunks := splitHistoryNodeData(nodeRow.Data)
+		item := itemKey(historyNodePartitionKey(nodeRow.TreeID, nodeRow.BranchID), historyNodeSortKey(nodeRow.NodeID, txnID))
+		item[historyAttrNodeID] = numberAttr(nodeRow.NodeID)
+		item[historyAttrTxnID] = numberAttr(txnID)
+		item[attrData] = binaryAttr(chunks[0])
+		item[historyAttrDataEncoding] = stringAttr(nodeRow.DataEncoding)
+		item[historyAttrCreatedTime] = numberAttr(unixNano(nodeRow.CreateTimestamp))
+		if len(chunks) > 1 {
+			item[historyAttrChunks] = numberAttr(int64(len(chunks)))
+			// the chunks are written ahead of the node item, a node is only read once its item is written,
+			// and the chunks of a failed write are deleted along with the nodes of the branch
+			if err := db.insertHistoryNodeChunks(ctx, nodeRow, txnID, chunks[1:]); err != nil {
+				return err
+			}
+		}
+		items = append(items, db.newTransactPut(tableHistoryNode, item, "", nil))
+	}
+
+	if len(items) == 1 {
+		// a single item doesn't need a transaction
+		put := items[0].Put
+		_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+			TableName: put.TableName,
+			Item:      put.Item,
+		})
+		return err
+	}
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: items,
+	})
+	return err
+}
+
+// insertHistoryNodeChunks writes the chunks of the data of a node following the first one
+func (db *ddb) insertHistoryNodeChunks(ctx context.Context, nodeRow *nosqlplugin.HistoryNodeRow, txnID int64, chunks [][]byte) error {
+	items := make([]attributeMap, 0, len(chunks))
+	for i, chunk := range chunks {
+		item := itemKey(historyNodeChunkPartitionKey(nodeRow.TreeID, nodeRow.BranchID), historyNodeChunkSortKey(nodeRow.NodeID, txnID, i+1))
+		item[attrData] = binaryAttr(chunk)
+		items = append(items, item)
+	}
+	return db.batchPut(ctx, tableHistoryNode, items)
+}
+
+// selectHistoryNodeChunks reads the data of a node split into the given number of chunks, following the first one
+func (db *ddb) selectHistoryNodeChunks(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter, nodeID, txnID int64, chunks int64) ([]byte, error) {
+	builder := newExpressionBuilder()
+	input := &dynamodb.QueryInput{
+		TableName: db.tableName(tableHistoryNode),
+		KeyConditionExpression: aws.String(fmt.Sprintf("%v = %v AND begins_with(%v, %v)",
+			builder.name(attrPK), builder.value(stringAttr(historyNodeChunkPartitionKey(filter.TreeID, filter.BranchID))),
+			builder.name(attrSK), builder.value(stringAttr(historyNodeSortKey(nodeID, txnID)+"#")),
+		)),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+	items, _, err := db.queryPage(ctx, input, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(items)) != chunks-1 {
+		return nil, fmt.Errorf("history node %v of branch %v has %v chunks, expected %v", nodeID, filter.BranchID, len(items)+1, chunks)
+	}
+	var data []byte
+	for _, item := range items {
+		data = append(data, getBytes(item, attrData)...)
+	}
+	return data, nil
+}
+
+// splitHistoryNodeData splits the data of a node into chunks of historyNodeChunkSize, there is always at least one chunk
+func splitHistoryNodeData(data []byte) [][]byte {
+	chunks := [][]byte{data}
+	for len(chunks[len(chunks)-1]) > historyNodeChunkSize {
+		last := chunks[len(chunks)-1]
+		chunks[len(chunks)-1] = last[:historyNodeChunkSize]
+		chunks = append(chunks, last[historyNodeChunkSize:])
+	}
+	return chunks
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *ddb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	if filter.MinNodeID >= filter.MaxNodeID {
+		return nil, nil, nil
+	}
+	input := db.newHistoryNodeQuery(historyNodePartitionKey(filter.TreeID, filter.BranchID), filter, filter.MaxNodeID-1)
+	items, nextPageToken, err := db.queryPage(ctx, input, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(items))
+	for _, item := range items {
+		nodeID := getInt64(item, historyAttrNodeID)
+		txnID := getInt64(item, historyAttrTxnID)
+		data := getBytes(item, attrData)
+		if chunks := getInt64(item, historyAttrChunks); chunks > 1 {
+			rest, err := db.selectHistoryNodeChunks(ctx, filter, nodeID, txnID, chunks)
+			if err != nil {
+				return nil, nil, err
+			}
+			data = append(data, rest...)
+		}
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			ShardID:      filter.ShardID,
+			TreeID:       filter.TreeID,
+			BranchID:     filter.BranchID,
+			NodeID:       nodeID,
+			TxnID:        &txnID,
+			Data:         data,
+			DataEncoding: getString(item, historyAttrDataEncoding),
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// delete the nodes first, so that a failure can be retried as long as the branch record still exists
+	for _, nodeFilter := range nodeFilters {
+		for _, pk := range []string{
+			historyNodePartitionKey(nodeFilter.TreeID, nodeFilter.BranchID),
+			historyNodeChunkPartitionKey(nodeFilter.TreeID, nodeFilter.BranchID),
+		} {
+			input := db.newHistoryNodeQuery(pk, nodeFilter, math.MaxInt64)
+			if err := db.rangeDelete(ctx, tableHistoryNode, input); err != nil {
+				return err
+			}
+		}
+	}
+	var branchID string
+	if treeFilter.BranchID != nil {
+		branchID = *treeFilter.BranchID
+	}
+	return db.deleteItem(ctx, tableHistoryTree, itemKey(treeFilter.TreeID, branchID))
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	items, pagingToken, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName: db.tableName(tableHistoryTree),
+	}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:          getString(item, historyAttrTreeID),
+			BranchID:        getString(item, historyAttrBranchID),
+			CreateTimestamp: time.Unix(0, getInt64(item, historyAttrCreatedTime)),
+			Info:            getString(item, historyAttrInfo),
+		})
+	}
+	return rows, pagingToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *ddb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	input := &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableHistoryTree),
+		KeyConditionExpression:    aws.String(fmt.Sprintf("%v = %v", builder.name(attrPK), builder.value(stringAttr(filter.TreeID)))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+	items, _, err := db.queryPage(ctx, input, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, item := range items {
+		var ancestors []*types.HistoryBranchRange
+		if err := getJSON(item, historyAttrAncestors, &ancestors); err != nil {
+			return nil, err
+		}
+		if ancestors == nil {
+			ancestors = []*types.HistoryBranchRange{}
+		}
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:          filter.TreeID,
+			BranchID:        getString(item, historyAttrBranchID),
+			Ancestors:       ancestors,
+			CreateTimestamp: time.Unix(0, getInt64(item, historyAttrCreatedTime)),
+			Info:            getString(item, historyAttrInfo),
+		})
+	}
+	return rows, nil
+}
+
+// newHistoryNodeQuery returns a query of the items of the given partition of a branch, either the nodes or their chunks,
+// with nodeID in [filter.MinNodeID, inclusiveMaxNodeID]
+func (db *ddb) newHistoryNodeQuery(pk string, filter *nosqlplugin.HistoryNodeFilter, inclusiveMaxNodeID int64) *dynamodb.QueryInput {
+	builder := newExpressionBuilder()
+	keyCondition := fmt.Sprintf("%v = %v AND %v BETWEEN %v AND %v",
+		builder.name(attrPK), builder.value(stringAttr(pk)),
+		builder.name(attrSK),
+		builder.value(stringAttr(encodeInt64(filter.MinNodeID))),
+		// "$" sorts right after the "#" separator
+		builder.value(stringAttr(encodeInt64(inclusiveMaxNodeID)+"$")),
+	)
+	return &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableHistoryNode),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+}
+
+func getTxnID(row *nosqlplugin.HistoryNodeRow) int64 {
+	if row.TxnID == nil {
+		return 0
+	}
+	return *row.TxnID
 }
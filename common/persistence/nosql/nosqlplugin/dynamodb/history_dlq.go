@@ -23,31 +23,196 @@ package dynamodb
 import (
 	"context"
 	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// History DLQ tasks are partitioned by (shard, domain, cluster attribute, task category) and sorted by (visibility_ts, task_id).
+// Ack levels of a shard are stored in a single partition, sorted by (domain, cluster attribute, task category).
+
+func historyDLQTaskPartitionKey(shardID int, domainID, scope, name string, taskCategory int) string {
+	return fmt.Sprintf("%v#%v#%v#%v#%v", shardID, domainID, scope, name, taskCategory)
+}
+
+func historyDLQTaskSortKey(visibilityTS time.Time, taskID int64) string {
+	return encodeTime(visibilityTS) + "#" + encodeInt64(taskID)
+}
+
+func historyDLQAckLevelSortKey(domainID, scope, name string, taskCategory int) string {
+	return fmt.Sprintf("%v#%v#%v#%v", domainID, scope, name, taskCategory)
+}
+
+// historyDLQTaskSortKeyBefore returns the largest sort key that is smaller than the (visibilityTS, taskID) tuple
+func historyDLQTaskSortKeyBefore(visibilityTS time.Time, taskID int64) string {
+	if taskID == math.MinInt64 {
+		return encodeInt64(unixNano(visibilityTS)-1) + "#" + encodeInt64(math.MaxInt64)
+	}
+	return historyDLQTaskSortKey(visibilityTS, taskID-1)
+}
+
 // InsertHistoryDLQTaskRow writes a task to the history DLQ.
 func (db *ddb) InsertHistoryDLQTaskRow(ctx context.Context, task *nosqlplugin.HistoryDLQTaskRow) error {
-	return fmt.Errorf("InsertHistoryDLQTaskRow not implemented for DynamoDB")
+	data, err := jsonAttr(task)
+	if err != nil {
+		return err
+	}
+	item := itemKey(
+		historyDLQTaskPartitionKey(task.ShardID, task.DomainID, task.ClusterAttributeScope, task.ClusterAttributeName, task.TaskCategory),
+		historyDLQTaskSortKey(task.VisibilityTimestamp, task.TaskID),
+	)
+	item[attrData] = data
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableHistoryTaskDLQ),
+		Item:      item,
+	})
+	return err
 }
 
+// SelectHistoryDLQTaskRows reads paginated tasks from the history DLQ within the given bounds.
 func (db *ddb) SelectHistoryDLQTaskRows(ctx context.Context, filter nosqlplugin.HistoryDLQTaskFilter) ([]*nosqlplugin.HistoryDLQTaskRow, []byte, error) {
-	return nil, nil, fmt.Errorf("SelectHistoryDLQTaskRows not implemented for DynamoDB")
+	minSortKey := historyDLQTaskSortKey(filter.InclusiveMinVisibilityTS, filter.InclusiveMinTaskID)
+	maxSortKey := historyDLQTaskSortKeyBefore(filter.ExclusiveMaxVisibilityTS, filter.ExclusiveMaxTaskID)
+	if minSortKey > maxSortKey {
+		return nil, nil, nil
+	}
+
+	input := db.newHistoryDLQTasksQuery(
+		historyDLQTaskPartitionKey(filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName, filter.TaskCategory),
+		minSortKey,
+		maxSortKey,
+	)
+	items, nextPageToken, err := db.queryPage(ctx, input, filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryDLQTaskRow, 0, len(items))
+	for _, item := range items {
+		row := &nosqlplugin.HistoryDLQTaskRow{}
+		if err := getJSON(item, attrData, row); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
+// RangeDeleteHistoryDLQTaskRows deletes all tasks before the given ack-level bounds.
 func (db *ddb) RangeDeleteHistoryDLQTaskRows(ctx context.Context, filter nosqlplugin.HistoryDLQTaskRangeDeleteFilter) error {
-	return fmt.Errorf("RangeDeleteHistoryDLQTaskRows not implemented for DynamoDB")
+	minSortKey := historyDLQTaskSortKey(time.Unix(0, math.MinInt64), math.MinInt64)
+	maxSortKey := historyDLQTaskSortKeyBefore(filter.ExclusiveMaxVisibilityTS, filter.ExclusiveMaxTaskID)
+	if minSortKey > maxSortKey {
+		return nil
+	}
+
+	input := db.newHistoryDLQTasksQuery(
+		historyDLQTaskPartitionKey(filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName, filter.TaskCategory),
+		minSortKey,
+		maxSortKey,
+	)
+	return db.rangeDelete(ctx, tableHistoryTaskDLQ, input)
 }
 
+// SelectHistoryDLQAckLevelRows reads ack-level rows for a shard.
+// The result is narrowed down by the domain, and then by the cluster attribute if they are specified.
 func (db *ddb) SelectHistoryDLQAckLevelRows(ctx context.Context, filter nosqlplugin.HistoryDLQAckLevelFilter) ([]*nosqlplugin.HistoryDLQAckLevelRow, error) {
-	return nil, fmt.Errorf("SelectHistoryDLQAckLevelRows not implemented for DynamoDB")
+	builder := newExpressionBuilder()
+	keyCondition := fmt.Sprintf("%v = %v", builder.name(attrPK), builder.value(stringAttr(strconv.Itoa(filter.ShardID))))
+	var prefix string
+	switch {
+	case filter.DomainID != "" && filter.ClusterAttributeScope != "" && filter.ClusterAttributeName != "":
+		prefix = fmt.Sprintf("%v#%v#%v#", filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName)
+	case filter.DomainID != "":
+		prefix = filter.DomainID + "#"
+	}
+	if prefix != "" {
+		keyCondition += fmt.Sprintf(" AND begins_with(%v, %v)", builder.name(attrSK), builder.value(stringAttr(prefix)))
+	}
+
+	items, _, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableHistoryTaskDLQAckLevel),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryDLQAckLevelRow, 0, len(items))
+	for _, item := range items {
+		row := &nosqlplugin.HistoryDLQAckLevelRow{}
+		if err := getJSON(item, attrData, row); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
 }
 
+// InsertOrUpdateHistoryDLQAckLevelRow upserts a single ack-level row.
 func (db *ddb) InsertOrUpdateHistoryDLQAckLevelRow(ctx context.Context, row *nosqlplugin.HistoryDLQAckLevelRow) error {
-	return fmt.Errorf("InsertOrUpdateHistoryDLQAckLevelRow not implemented for DynamoDB")
+	item, err := newHistoryDLQAckLevelItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableHistoryTaskDLQAckLevel),
+		Item:      item,
+	})
+	return err
 }
 
+// InsertHistoryDLQAckLevelIfNotExistsRow inserts a sentinel ack-level row if it does not already exist
+// for this (shard, domain, scope, name, task_category) key.
+// Returns success if the row is written or if it already exists.
 func (db *ddb) InsertHistoryDLQAckLevelIfNotExistsRow(ctx context.Context, row *nosqlplugin.HistoryDLQAckLevelRow) error {
-	return fmt.Errorf("InsertHistoryDLQAckLevelIfNotExistsRow not implemented for DynamoDB")
+	item, err := newHistoryDLQAckLevelItem(row)
+	if err != nil {
+		return err
+	}
+	builder := newExpressionBuilder()
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableHistoryTaskDLQAckLevel),
+		Item:                     item,
+		ConditionExpression:      aws.String(fmt.Sprintf("attribute_not_exists(%v)", builder.name(attrPK))),
+		ExpressionAttributeNames: builder.attributeNames(),
+	})
+	if err != nil && !db.IsConditionFailedError(err) {
+		return err
+	}
+	return nil
+}
+
+func (db *ddb) newHistoryDLQTasksQuery(partitionKey, inclusiveMinSortKey, inclusiveMaxSortKey string) *dynamodb.QueryInput {
+	builder := newExpressionBuilder()
+	keyCondition := fmt.Sprintf("%v = %v AND %v BETWEEN %v AND %v",
+		builder.name(attrPK), builder.value(stringAttr(partitionKey)),
+		builder.name(attrSK), builder.value(stringAttr(inclusiveMinSortKey)), builder.value(stringAttr(inclusiveMaxSortKey)),
+	)
+	return &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableHistoryTaskDLQ),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+}
+
+func newHistoryDLQAckLevelItem(row *nosqlplugin.HistoryDLQAckLevelRow) (attributeMap, error) {
+	data, err := jsonAttr(row)
+	if err != nil {
+		return nil, err
+	}
+	item := itemKey(
+		strconv.Itoa(row.ShardID),
+		historyDLQAckLevelSortKey(row.DomainID, row.ClusterAttributeScope, row.ClusterAttributeName, row.TaskCategory),
+	)
+	item[attrData] = data
+	return item, nil
 }
@@ -22,18 +22,59 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	queueMessageSortKeyPrefix = "msg#"
+	queueMetadataSortKey      = "metadata"
+
+	queueAttrMessageID       = "message_id"
+	queueAttrPayload         = "message_payload"
+	queueAttrCreatedTime     = "created_time"
+	queueAttrClusterAckLevel = "cluster_ack_level"
+	queueAttrVersion         = "version"
+	queueAttrLastUpdatedTime = "last_updated_time"
+)
+
+func queuePartitionKey(queueType persistence.QueueType) string {
+	return strconv.Itoa(int(queueType))
+}
+
+func queueMessageSortKey(messageID int64) string {
+	return queueMessageSortKeyPrefix + encodeInt64(messageID)
+}
+
 // Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	item := itemKey(queuePartitionKey(row.QueueType), queueMessageSortKey(row.ID))
+	item[queueAttrMessageID] = numberAttr(row.ID)
+	item[queueAttrPayload] = binaryAttr(row.Payload)
+	item[queueAttrCreatedTime] = numberAttr(unixNano(row.CurrentTimeStamp))
+
+	builder := newExpressionBuilder()
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableQueue),
+		Item:                     item,
+		ConditionExpression:      aws.String(fmt.Sprintf("attribute_not_exists(%v)", builder.name(attrPK))),
+		ExpressionAttributeNames: builder.attributeNames(),
+	})
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +82,16 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	input := db.newQueueMessagesQuery(queueType, math.MinInt64, math.MaxInt64)
+	input.ScanIndexForward = aws.Bool(false)
+	items, _, err := db.queryPage(ctx, input, 1, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, errNotFound
+	}
+	return getInt64(items[0], queueAttrMessageID), nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +101,24 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	if exclusiveBeginMessageID == math.MaxInt64 {
+		return nil, nil
+	}
+	input := db.newQueueMessagesQuery(queueType, exclusiveBeginMessageID+1, math.MaxInt64)
+	items, _, err := db.queryPage(ctx, input, maxRows, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*nosqlplugin.QueueMessageRow
+	for _, item := range items {
+		result = append(result, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        getInt64(item, queueAttrMessageID),
+			Payload:   getBytes(item, queueAttrPayload),
+		})
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +126,27 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	if request.ExclusiveBeginMessageID >= request.InclusiveEndMessageID {
+		return &nosqlplugin.SelectMessagesBetweenResponse{}, nil
+	}
+	input := db.newQueueMessagesQuery(request.QueueType, request.ExclusiveBeginMessageID+1, request.InclusiveEndMessageID)
+	items, nextPageToken, err := db.queryPage(ctx, input, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []nosqlplugin.QueueMessageRow
+	for _, item := range items {
+		rows = append(rows, nosqlplugin.QueueMessageRow{
+			QueueType: request.QueueType,
+			ID:        getInt64(item, queueAttrMessageID),
+			Payload:   getBytes(item, queueAttrPayload),
+		})
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +155,11 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID == math.MinInt64 {
+		return nil
+	}
+	input := db.newQueueMessagesQuery(queueType, math.MinInt64, exclusiveBeginMessageID-1)
+	return db.rangeDelete(ctx, tableQueue, input)
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +169,11 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID >= inclusiveEndMessageID {
+		return nil
+	}
+	input := db.newQueueMessagesQuery(queueType, exclusiveBeginMessageID+1, inclusiveEndMessageID)
+	return db.rangeDelete(ctx, tableQueue, input)
 }
 
 // Delete one message
@@ -87,12 +182,26 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableQueue, itemKey(queuePartitionKey(queueType), queueMessageSortKey(messageID)))
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *ddb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	panic("TODO")
+	item := newQueueMetadataItem(row.QueueType, map[string]int64{}, row.Version)
+	item[queueAttrCreatedTime] = numberAttr(unixNano(row.CurrentTimeStamp))
+
+	builder := newExpressionBuilder()
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableQueue),
+		Item:                     item,
+		ConditionExpression:      aws.String(fmt.Sprintf("attribute_not_exists(%v)", builder.name(attrPK))),
+		ExpressionAttributeNames: builder.attributeNames(),
+	})
+	// it's ok if the item is not written, which means that the record exists already.
+	if err != nil && !db.IsConditionFailedError(err) {
+		return err
+	}
+	return nil
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -102,7 +211,25 @@ func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	versionName := builder.name(queueAttrVersion)
+	update := fmt.Sprintf("SET %v = %v, %v = %v, %v = %v",
+		builder.name(queueAttrClusterAckLevel), builder.value(clusterAckLevelAttr(row.ClusterAckLevels)),
+		versionName, builder.value(numberAttr(row.Version)),
+		builder.name(queueAttrLastUpdatedTime), builder.value(numberAttr(unixNano(row.CurrentTimeStamp))),
+	)
+	_, err := db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 db.tableName(tableQueue),
+		Key:                       itemKey(queuePartitionKey(row.QueueType), queueMetadataSortKey),
+		UpdateExpression:          aws.String(update),
+		ConditionExpression:       aws.String(fmt.Sprintf("%v = %v", versionName, builder.value(numberAttr(row.Version-1)))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	})
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -110,12 +237,62 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableQueue, itemKey(queuePartitionKey(queueType), queueMetadataSortKey))
+	if err != nil {
+		return nil, err
+	}
+
+	// if record exist but ackLevels is empty, we initialize the map
+	ackLevels := make(map[string]int64)
+	for cluster, av := range getMap(item, queueAttrClusterAckLevel) {
+		ackLevels[cluster] = numberValue(av)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          getInt64(item, queueAttrVersion),
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.queryCount(ctx, db.newQueueMessagesQuery(queueType, math.MinInt64, math.MaxInt64))
+}
+
+// newQueueMessagesQuery returns a query of messages with ID in [inclusiveMinMessageID, inclusiveMaxMessageID]
+func (db *ddb) newQueueMessagesQuery(
+	queueType persistence.QueueType,
+	inclusiveMinMessageID int64,
+	inclusiveMaxMessageID int64,
+) *dynamodb.QueryInput {
+	builder := newExpressionBuilder()
+	keyCondition := fmt.Sprintf("%v = %v AND %v BETWEEN %v AND %v",
+		builder.name(attrPK), builder.value(stringAttr(queuePartitionKey(queueType))),
+		builder.name(attrSK),
+		builder.value(stringAttr(queueMessageSortKey(inclusiveMinMessageID))),
+		builder.value(stringAttr(queueMessageSortKey(inclusiveMaxMessageID))),
+	)
+	return &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableQueue),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+}
+
+func newQueueMetadataItem(queueType persistence.QueueType, clusterAckLevels map[string]int64, version int64) attributeMap {
+	item := itemKey(queuePartitionKey(queueType), queueMetadataSortKey)
+	item[queueAttrClusterAckLevel] = clusterAckLevelAttr(clusterAckLevels)
+	item[queueAttrVersion] = numberAttr(version)
+	return item
+}
+
+func clusterAckLevelAttr(clusterAckLevels map[string]int64) *dynamodb.AttributeValue {
+	m := make(attributeMap, len(clusterAckLevels))
+	for cluster, ackLevel := range clusterAckLevels {
+		m[cluster] = numberAttr(ackLevel)
+	}
+	return mapAttr(m)
 }
@@ -22,29 +22,155 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	shardSortKey = "shard"
+
+	shardAttrRangeID      = "range_id"
+	shardAttrInfo         = "info"
+	shardAttrDataEncoding = "data_encoding"
+)
+
+func shardKey(shardID int) attributeMap {
+	return itemKey(strconv.Itoa(shardID), shardSortKey)
+}
+
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	item, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	builder := newExpressionBuilder()
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableShard),
+		Item:                     item,
+		ConditionExpression:      aws.String(fmt.Sprintf("attribute_not_exists(%v)", builder.name(attrPK))),
+		ExpressionAttributeNames: builder.attributeNames(),
+	})
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedShardError(ctx, row.ShardID)
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableShard, shardKey(shardID))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	info := &persistence.InternalShardInfo{}
+	if err := getJSON(item, shardAttrInfo, info); err != nil {
+		return 0, nil, err
+	}
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	info.PendingFailoverMarkers = normalizeDataBlob(info.PendingFailoverMarkers)
+	info.TransferProcessingQueueStates = normalizeDataBlob(info.TransferProcessingQueueStates)
+	info.TimerProcessingQueueStates = normalizeDataBlob(info.TimerProcessingQueueStates)
+
+	return getInt64(item, shardAttrRangeID), &nosqlplugin.ShardRow{
+		InternalShardInfo: info,
+		Data:              getBytes(item, attrData),
+		DataEncoding:      getString(item, shardAttrDataEncoding),
+	}, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	rangeIDName := builder.name(shardAttrRangeID)
+	_, err := db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 db.tableName(tableShard),
+		Key:                       shardKey(shardID),
+		UpdateExpression:          aws.String(fmt.Sprintf("SET %v = %v", rangeIDName, builder.value(numberAttr(rangeID)))),
+		ConditionExpression:       aws.String(fmt.Sprintf("%v = %v", rangeIDName, builder.value(numberAttr(previousRangeID)))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	})
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedShardError(ctx, shardID)
+	}
+	return err
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	item, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	builder := newExpressionBuilder()
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 db.tableName(tableShard),
+		Item:                      item,
+		ConditionExpression:       aws.String(fmt.Sprintf("%v = %v", builder.name(shardAttrRangeID), builder.value(numberAttr(previousRangeID)))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	})
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedShardError(ctx, row.ShardID)
+	}
+	return err
+}
+
+func newShardItem(row *nosqlplugin.ShardRow) (attributeMap, error) {
+	info, err := jsonAttr(row.InternalShardInfo)
+	if err != nil {
+		return nil, err
+	}
+	item := shardKey(row.ShardID)
+	item[shardAttrRangeID] = numberAttr(row.RangeID)
+	item[shardAttrInfo] = info
+	item[attrData] = binaryAttr(row.Data)
+	item[shardAttrDataEncoding] = stringAttr(row.DataEncoding)
+	return item, nil
+}
+
+// newConflictedShardError reads the current shard record as DynamoDB doesn't return it when a conditional write fails
+func (db *ddb) newConflictedShardError(ctx context.Context, shardID int) error {
+	item, err := db.getItem(ctx, tableShard, shardKey(shardID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return &nosqlplugin.ShardOperationConditionFailure{
+				RangeID: -1,
+				Details: fmt.Sprintf("shard %v doesn't exist", shardID),
+			}
+		}
+		return err
+	}
+	rangeID := getInt64(item, shardAttrRangeID)
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: rangeID,
+		Details: fmt.Sprintf("range_id=%v", rangeID),
+	}
 }
@@ -22,20 +22,86 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// A tasklist and its tasks share the same partition, the tasklist item uses a constant sort key
+// and the tasks are sorted by taskID.
+const (
+	taskListSortKey       = "tasklist"
+	taskSortKeyPrefix     = "task#"
+	initialRangeID        = 1 // Id of the first range of a new task list
+	maxTasksInTransaction = maxTransactionItems - 1
+
+	taskAttrDomainID                = "domain_id"
+	taskAttrTaskListName            = "task_list_name"
+	taskAttrTaskListType            = "task_list_type"
+	taskAttrRangeID                 = "range_id"
+	taskAttrAckLevel                = "ack_level"
+	taskAttrKind                    = "kind"
+	taskAttrLastUpdated             = "last_updated"
+	taskAttrAdaptivePartitionConfig = "adaptive_partition_config"
+	taskAttrTaskID                  = "task_id"
+	taskAttrWorkflowID              = "workflow_id"
+	taskAttrRunID                   = "run_id"
+	taskAttrScheduleID              = "schedule_id"
+	taskAttrCreatedTime             = "created_time"
+	taskAttrPartitionConfig         = "partition_config"
+)
+
+func taskListPartitionKey(domainID, taskListName string, taskListType int) string {
+	return fmt.Sprintf("%v#%v#%v", domainID, taskListName, taskListType)
+}
+
+func taskListKey(filter *nosqlplugin.TaskListFilter) attributeMap {
+	return itemKey(taskListPartitionKey(filter.DomainID, filter.TaskListName, filter.TaskListType), taskListSortKey)
+}
+
+func taskSortKey(taskID int64) string {
+	return taskSortKeyPrefix + encodeInt64(taskID)
+}
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *ddb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableTasks, taskListKey(filter))
+	if err != nil {
+		return nil, err
+	}
+	return parseTaskListItem(item)
 }
 
 // InsertTaskList insert a single tasklist row
 // Return IsConditionFailedError if the row already exists, and also the existing row
 func (db *ddb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	tasklist := *row
+	tasklist.RangeID = initialRangeID
+	tasklist.AckLevel = 0
+	item, err := newTaskListItem(&tasklist, tasklist.LastUpdatedTime)
+	if err != nil {
+		return err
+	}
+
+	builder := newExpressionBuilder()
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableTasks),
+		Item:                     item,
+		ConditionExpression:      aws.String(fmt.Sprintf("attribute_not_exists(%v)", builder.name(attrPK))),
+		ExpressionAttributeNames: builder.attributeNames(),
+	})
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedTaskListError(ctx, row.DomainID, row.TaskListName, row.TaskListType)
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +111,11 @@ func (db *ddb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	item, err := newTaskListItem(row, row.LastUpdatedTime)
+	if err != nil {
+		return err
+	}
+	return db.putTaskListWithRangeIDCondition(ctx, row, item, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,39 +128,144 @@ func (db *ddb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	item, err := newTaskListItem(row, row.CurrentTimeStamp)
+	if err != nil {
+		return err
+	}
+	item[attrTTL] = ttlAttr(row.CurrentTimeStamp, ttlSeconds)
+	return db.putTaskListWithRangeIDCondition(ctx, row, item, previousRangeID)
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *ddb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	items, token, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName:                 db.tableName(tableTasks),
+		FilterExpression:          aws.String(fmt.Sprintf("%v = %v", builder.name(attrSK), builder.value(stringAttr(taskListSortKey)))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &nosqlplugin.ListTaskListResult{
+		NextPageToken: token,
+	}
+	for _, item := range items {
+		row, err := parseTaskListItem(item)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *ddb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 db.tableName(tableTasks),
+		Key:                       taskListKey(filter),
+		ConditionExpression:       aws.String(fmt.Sprintf("%v = %v", builder.name(taskAttrRangeID), builder.value(numberAttr(previousRangeID)))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	})
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedTaskListError(ctx, filter.DomainID, filter.TaskListName, filter.TaskListType)
+	}
+	return err
 }
 
 // InsertTasks inserts a batch of tasks
 // Return TaskOperationConditionFailure if the condition doesn't meet
+// A transaction is limited to 100 items, so a large batch is split into multiple transactions,
+// each of them checks the rangeID of the tasklist
 func (db *ddb) InsertTasks(
 	ctx context.Context,
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	partitionKey := taskListPartitionKey(tasklistCondition.DomainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType)
+	timeStamp := tasklistCondition.CurrentTimeStamp
+
+	for start := 0; start < len(tasksToInsert); start += maxTasksInTransaction {
+		end := start + maxTasksInTransaction
+		if end > len(tasksToInsert) {
+			end = len(tasksToInsert)
+		}
+
+		// The following condition check is used to ensure that range_id didn't change
+		builder := newExpressionBuilder()
+		items := []*dynamodb.TransactWriteItem{
+			db.newTransactConditionCheck(
+				tableTasks,
+				itemKey(partitionKey, taskListSortKey),
+				fmt.Sprintf("%v = %v", builder.name(taskAttrRangeID), builder.value(numberAttr(tasklistCondition.RangeID))),
+				builder,
+			),
+		}
+		for _, task := range tasksToInsert[start:end] {
+			item, err := newTaskItem(partitionKey, task, timeStamp)
+			if err != nil {
+				return err
+			}
+			items = append(items, db.newTransactPut(tableTasks, item, "", nil))
+		}
+
+		reasons, err := db.transactWrite(ctx, items)
+		if err != nil {
+			return err
+		}
+		if reasons != nil {
+			return db.newConflictedTaskListError(ctx, tasklistCondition.DomainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType)
+		}
+	}
+	return nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return nil, nil
+	}
+	input := db.newTasksQuery(&filter.TaskListFilter, filter.MinTaskID+1, filter.MaxTaskID)
+	items, _, err := db.queryPage(ctx, input, filter.BatchSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []*nosqlplugin.TaskRow
+	for _, item := range items {
+		var partitionConfig map[string]string
+		if err := getJSON(item, taskAttrPartitionConfig, &partitionConfig); err != nil {
+			return nil, err
+		}
+		response = append(response, &nosqlplugin.TaskRow{
+			DomainID:        filter.DomainID,
+			TaskListName:    filter.TaskListName,
+			TaskListType:    filter.TaskListType,
+			TaskID:          getInt64(item, taskAttrTaskID),
+			WorkflowID:      getString(item, taskAttrWorkflowID),
+			RunID:           getString(item, taskAttrRunID),
+			ScheduledID:     getInt64(item, taskAttrScheduleID),
+			Expiry:          getExpiry(item),
+			CreatedTime:     time.Unix(0, getInt64(item, taskAttrCreatedTime)),
+			PartitionConfig: partitionConfig,
+		})
+	}
+	return response, nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	if filter.MinTaskID == math.MaxInt64 {
+		return 0, nil
+	}
+	return db.queryCount(ctx, db.newTasksQuery(&filter.TaskListFilter, filter.MinTaskID+1, math.MaxInt64))
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
@@ -98,5 +273,123 @@ func (db *ddb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilte
 // NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
 // be returned to the caller, because rowsDeleted is not supported by Cassandra
 func (db *ddb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return persistence.UnknownNumRowsAffected, nil
+	}
+	input := db.newTasksQuery(&filter.TaskListFilter, filter.MinTaskID+1, filter.MaxTaskID)
+	if err := db.rangeDelete(ctx, tableTasks, input); err != nil {
+		return 0, err
+	}
+	return persistence.UnknownNumRowsAffected, nil
+}
+
+// newTasksQuery returns a query of tasks with taskID in [inclusiveMinTaskID, inclusiveMaxTaskID]
+func (db *ddb) newTasksQuery(filter *nosqlplugin.TaskListFilter, inclusiveMinTaskID, inclusiveMaxTaskID int64) *dynamodb.QueryInput {
+	builder := newExpressionBuilder()
+	keyCondition := fmt.Sprintf("%v = %v AND %v BETWEEN %v AND %v",
+		builder.name(attrPK), builder.value(stringAttr(taskListPartitionKey(filter.DomainID, filter.TaskListName, filter.TaskListType))),
+		builder.name(attrSK),
+		builder.value(stringAttr(taskSortKey(inclusiveMinTaskID))),
+		builder.value(stringAttr(taskSortKey(inclusiveMaxTaskID))),
+	)
+	return &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableTasks),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+}
+
+func (db *ddb) putTaskListWithRangeIDCondition(ctx context.Context, row *nosqlplugin.TaskListRow, item attributeMap, previousRangeID int64) error {
+	builder := newExpressionBuilder()
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 db.tableName(tableTasks),
+		Item:                      item,
+		ConditionExpression:       aws.String(fmt.Sprintf("%v = %v", builder.name(taskAttrRangeID), builder.value(numberAttr(previousRangeID)))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	})
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedTaskListError(ctx, row.DomainID, row.TaskListName, row.TaskListType)
+	}
+	return err
+}
+
+// newConflictedTaskListError reads the current tasklist record as DynamoDB doesn't return it when a conditional write fails
+func (db *ddb) newConflictedTaskListError(ctx context.Context, domainID string, taskListName string, taskListType int) error {
+	item, err := db.getItem(ctx, tableTasks, taskListKey(&nosqlplugin.TaskListFilter{
+		DomainID:     domainID,
+		TaskListName: taskListName,
+		TaskListType: taskListType,
+	}))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return &nosqlplugin.TaskOperationConditionFailure{
+				RangeID: -1,
+				Details: "tasklist doesn't exist",
+			}
+		}
+		return err
+	}
+	rangeID := getInt64(item, taskAttrRangeID)
+	columns := []string{
+		fmt.Sprintf("%s=%v", taskAttrRangeID, rangeID),
+		fmt.Sprintf("%s=%v", taskAttrAckLevel, getInt64(item, taskAttrAckLevel)),
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: rangeID,
+		Details: strings.Join(columns, ","),
+	}
+}
+
+func newTaskListItem(row *nosqlplugin.TaskListRow, lastUpdated time.Time) (attributeMap, error) {
+	partitionConfig, err := jsonAttr(row.AdaptivePartitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	item := itemKey(taskListPartitionKey(row.DomainID, row.TaskListName, row.TaskListType), taskListSortKey)
+	item[taskAttrDomainID] = stringAttr(row.DomainID)
+	item[taskAttrTaskListName] = stringAttr(row.TaskListName)
+	item[taskAttrTaskListType] = numberAttr(int64(row.TaskListType))
+	item[taskAttrRangeID] = numberAttr(row.RangeID)
+	item[taskAttrAckLevel] = numberAttr(row.AckLevel)
+	item[taskAttrKind] = numberAttr(int64(row.TaskListKind))
+	item[taskAttrLastUpdated] = numberAttr(unixNano(lastUpdated))
+	item[taskAttrAdaptivePartitionConfig] = partitionConfig
+	return item, nil
+}
+
+func parseTaskListItem(item attributeMap) (*nosqlplugin.TaskListRow, error) {
+	var partitionConfig *persistence.TaskListPartitionConfig
+	if err := getJSON(item, taskAttrAdaptivePartitionConfig, &partitionConfig); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.TaskListRow{
+		DomainID:                getString(item, taskAttrDomainID),
+		TaskListName:            getString(item, taskAttrTaskListName),
+		TaskListType:            int(getInt64(item, taskAttrTaskListType)),
+		RangeID:                 getInt64(item, taskAttrRangeID),
+		TaskListKind:            int(getInt64(item, taskAttrKind)),
+		AckLevel:                getInt64(item, taskAttrAckLevel),
+		LastUpdatedTime:         time.Unix(0, getInt64(item, taskAttrLastUpdated)),
+		AdaptivePartitionConfig: partitionConfig,
+	}, nil
+}
+
+func newTaskItem(partitionKey string, task *nosqlplugin.TaskRowForInsert, timeStamp time.Time) (attributeMap, error) {
+	partitionConfig, err := jsonAttr(task.PartitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	item := itemKey(partitionKey, taskSortKey(task.TaskID))
+	item[taskAttrTaskID] = numberAttr(task.TaskID)
+	item[taskAttrWorkflowID] = stringAttr(task.WorkflowID)
+	item[taskAttrRunID] = stringAttr(task.RunID)
+	item[taskAttrScheduleID] = numberAttr(task.ScheduledID)
+	item[taskAttrCreatedTime] = numberAttr(unixNano(task.CreatedTime))
+	item[taskAttrPartitionConfig] = partitionConfig
+	if task.TTLSeconds > 0 {
+		item[attrTTL] = ttlAttr(timeStamp, int64(task.TTLSeconds))
+	}
+	return item, nil
 }
@@ -0,0 +1,669 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Every table uses a string partition key(pk) and a string sort key(sk).
+// Numeric sort keys are encoded with encodeInt64 so that the lexical order matches the numeric order.
+const (
+	attrPK   = "pk"
+	attrSK   = "sk"
+	attrTTL  = "ttl"
+	attrData = "data"
+
+	// maxTransactionItems is the limit of items in one TransactWriteItems call
+	maxTransactionItems = 100
+	// maxBatchWriteItems is the limit of items in one BatchWriteItem call
+	maxBatchWriteItems = 25
+
+	// maxItemSize is the limit of the size of an item, including the names of its attributes
+	maxItemSize = 400 * 1024
+
+	cancellationReasonConditionalCheckFailed = "ConditionalCheckFailed"
+	cancellationReasonValidationError        = "ValidationError"
+	errCodeValidationException               = "ValidationException"
+	// itemSizeLimitErrorMessage is part of the message of a write rejected because of maxItemSize
+	itemSizeLimitErrorMessage = "exceeded the maximum allowed size"
+)
+
+// table names, without the keyspace prefix
+const (
+	tableShard                  = "shard"
+	tableExecution              = "execution"
+	tableHistoryTask            = "history_task"
+	tableHistoryTree            = "history_tree"
+	tableHistoryNode            = "history_node"
+	tableTasks                  = "tasks"
+	tableDomain                 = "domain"
+	tableQueue                  = "queue"
+	tableClusterConfig          = "cluster_config"
+	tableDomainAuditLog         = "domain_audit_log"
+	tableHistoryTaskDLQ         = "history_task_dlq"
+	tableHistoryTaskDLQAckLevel = "history_task_dlq_ack_level"
+	tableVisibility             = "visibility"
+)
+
+type (
+	attributeMap = map[string]*dynamodb.AttributeValue
+
+	// expressionBuilder allocates placeholders for attribute names and values used in an expression,
+	// so that reserved words and arbitrary map keys can be safely referenced
+	expressionBuilder struct {
+		names  map[string]*string
+		values attributeMap
+	}
+
+	// updateExpression collects the SET and REMOVE actions of an update expression
+	updateExpression struct {
+		builder *expressionBuilder
+		sets    []string
+		removes []string
+	}
+)
+
+func newExpressionBuilder() *expressionBuilder {
+	return &expressionBuilder{
+		names:  make(map[string]*string),
+		values: make(attributeMap),
+	}
+}
+
+// name returns the placeholder of an attribute name
+func (b *expressionBuilder) name(name string) string {
+	for placeholder, n := range b.names {
+		if *n == name {
+			return placeholder
+		}
+	}
+	placeholder := "#n" + strconv.Itoa(len(b.names))
+	b.names[placeholder] = aws.String(name)
+	return placeholder
+}
+
+// path returns the placeholder of a nested map entry, e.g. activity_map.<key>
+func (b *expressionBuilder) path(mapName, key string) string {
+	return b.name(mapName) + "." + b.name(key)
+}
+
+// value returns the placeholder of an attribute value
+func (b *expressionBuilder) value(value *dynamodb.AttributeValue) string {
+	placeholder := ":v" + strconv.Itoa(len(b.values))
+	b.values[placeholder] = value
+	return placeholder
+}
+
+func (b *expressionBuilder) attributeNames() map[string]*string {
+	if len(b.names) == 0 {
+		return nil
+	}
+	return b.names
+}
+
+func (b *expressionBuilder) attributeValues() attributeMap {
+	if len(b.values) == 0 {
+		return nil
+	}
+	return b.values
+}
+
+func newUpdateExpression(builder *expressionBuilder) *updateExpression {
+	return &updateExpression{
+		builder: builder,
+	}
+}
+
+// set assigns the value to the attribute
+func (u *updateExpression) set(name string, value *dynamodb.AttributeValue) {
+	u.sets = append(u.sets, fmt.Sprintf("%v = %v", u.builder.name(name), u.builder.value(value)))
+}
+
+// setEntry assigns the value to an entry of a map attribute, the map attribute must exist
+func (u *updateExpression) setEntry(mapName, key string, value *dynamodb.AttributeValue) {
+	u.sets = append(u.sets, fmt.Sprintf("%v = %v", u.builder.path(mapName, key), u.builder.value(value)))
+}
+
+// appendList appends the values to a list attribute, the list attribute must exist
+func (u *updateExpression) appendList(name string, values ...*dynamodb.AttributeValue) {
+	placeholder := u.builder.name(name)
+	u.sets = append(u.sets, fmt.Sprintf("%v = list_append(%v, %v)", placeholder, placeholder, u.builder.value(listAttr(values))))
+}
+
+// removeEntry removes an entry from a map attribute
+func (u *updateExpression) removeEntry(mapName, key string) {
+	u.removes = append(u.removes, u.builder.path(mapName, key))
+}
+
+func (u *updateExpression) isEmpty() bool {
+	return len(u.sets) == 0 && len(u.removes) == 0
+}
+
+func (u *updateExpression) String() string {
+	var clauses []string
+	if len(u.sets) > 0 {
+		clauses = append(clauses, "SET "+strings.Join(u.sets, ", "))
+	}
+	if len(u.removes) > 0 {
+		clauses = append(clauses, "REMOVE "+strings.Join(u.removes, ", "))
+	}
+	return strings.Join(clauses, " ")
+}
+
+func (db *ddb) tableName(table string) *string {
+	return aws.String(db.tablePrefix + table)
+}
+
+func itemKey(pk, sk string) attributeMap {
+	return attributeMap{
+		attrPK: stringAttr(pk),
+		attrSK: stringAttr(sk),
+	}
+}
+
+func stringAttr(v string) *dynamodb.AttributeValue {
+	if v == "" {
+		return &dynamodb.AttributeValue{NULL: aws.Bool(true)}
+	}
+	return &dynamodb.AttributeValue{S: aws.String(v)}
+}
+
+func numberAttr(v int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(v, 10))}
+}
+
+func binaryAttr(v []byte) *dynamodb.AttributeValue {
+	if len(v) == 0 {
+		return &dynamodb.AttributeValue{NULL: aws.Bool(true)}
+	}
+	return &dynamodb.AttributeValue{B: v}
+}
+
+func boolAttr(v bool) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{BOOL: aws.Bool(v)}
+}
+
+func mapAttr(v attributeMap) *dynamodb.AttributeValue {
+	if v == nil {
+		v = attributeMap{}
+	}
+	return &dynamodb.AttributeValue{M: v}
+}
+
+func listAttr(v []*dynamodb.AttributeValue) *dynamodb.AttributeValue {
+	if v == nil {
+		v = []*dynamodb.AttributeValue{}
+	}
+	return &dynamodb.AttributeValue{L: v}
+}
+
+// jsonAttr encodes the value as a JSON binary attribute
+func jsonAttr(v interface{}) (*dynamodb.AttributeValue, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return binaryAttr(data), nil
+}
+
+func ttlAttr(now time.Time, ttlSeconds int64) *dynamodb.AttributeValue {
+	return numberAttr(now.Unix() + ttlSeconds)
+}
+
+func getString(item attributeMap, name string) string {
+	if av, ok := item[name]; ok && av.S != nil {
+		return *av.S
+	}
+	return ""
+}
+
+func getInt64(item attributeMap, name string) int64 {
+	return numberValue(item[name])
+}
+
+func numberValue(av *dynamodb.AttributeValue) int64 {
+	if av == nil || av.N == nil {
+		return 0
+	}
+	v, _ := strconv.ParseInt(*av.N, 10, 64)
+	return v
+}
+
+func getBytes(item attributeMap, name string) []byte {
+	if av, ok := item[name]; ok {
+		return av.B
+	}
+	return nil
+}
+
+func getBool(item attributeMap, name string) bool {
+	if av, ok := item[name]; ok && av.BOOL != nil {
+		return *av.BOOL
+	}
+	return false
+}
+
+func getMap(item attributeMap, name string) attributeMap {
+	if av, ok := item[name]; ok {
+		return av.M
+	}
+	return nil
+}
+
+func getList(item attributeMap, name string) []*dynamodb.AttributeValue {
+	if av, ok := item[name]; ok {
+		return av.L
+	}
+	return nil
+}
+
+// getJSON decodes a JSON binary attribute into v, it's a noop if the attribute doesn't exist
+func getJSON(item attributeMap, name string, v interface{}) error {
+	data := getBytes(item, name)
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// getExpiry returns the expiry time from the ttl attribute, or zero time if there is no ttl
+func getExpiry(item attributeMap) time.Time {
+	ttl := getInt64(item, attrTTL)
+	if ttl == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ttl, 0).UTC()
+}
+
+// encodeInt64 encodes an int64 into a fixed width string which keeps the numeric order in lexical order
+func encodeInt64(v int64) string {
+	return fmt.Sprintf("%020d", uint64(v)^(1<<63))
+}
+
+// decodeInt64 is the reverse of encodeInt64
+func decodeInt64(s string) (int64, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(v ^ (1 << 63)), nil
+}
+
+// unixNano returns the nanoseconds of the time, zero time is treated as the unix epoch
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// encodeTime encodes a time into a sort key component
+func encodeTime(t time.Time) string {
+	return encodeInt64(unixNano(t))
+}
+
+// toDataBlob normalizes the empty blob to nil to be consistent with persistence.NewDataBlob
+func toDataBlob(data []byte, encoding string) *persistence.DataBlob {
+	if len(data) == 0 {
+		return nil
+	}
+	return &persistence.DataBlob{
+		Data:     data,
+		Encoding: constants.EncodingType(encoding),
+	}
+}
+
+func normalizeDataBlob(blob *persistence.DataBlob) *persistence.DataBlob {
+	if blob == nil || len(blob.Data) == 0 {
+		return nil
+	}
+	return blob
+}
+
+func serializePageToken(lastEvaluatedKey attributeMap) ([]byte, error) {
+	if len(lastEvaluatedKey) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(lastEvaluatedKey)
+}
+
+func deserializePageToken(pageToken []byte) (attributeMap, error) {
+	if len(pageToken) == 0 {
+		return nil, nil
+	}
+	var key attributeMap
+	if err := json.Unmarshal(pageToken, &key); err != nil {
+		return nil, fmt.Errorf("invalid page token: %v", err)
+	}
+	return key, nil
+}
+
+// getItem reads an item with strong consistency, return errNotFound if the item doesn't exist
+func (db *ddb) getItem(ctx context.Context, table string, key attributeMap) (attributeMap, error) {
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      db.tableName(table),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Item) == 0 {
+		return nil, errNotFound
+	}
+	return output.Item, nil
+}
+
+// transactGet reads the items within one transaction, the result is in the same order as the keys and
+// a missing item is returned as nil
+func (db *ddb) transactGet(ctx context.Context, table string, keys []attributeMap) ([]attributeMap, error) {
+	gets := make([]*dynamodb.TransactGetItem, 0, len(keys))
+	for _, key := range keys {
+		gets = append(gets, &dynamodb.TransactGetItem{
+			Get: &dynamodb.Get{
+				TableName: db.tableName(table),
+				Key:       key,
+			},
+		})
+	}
+	output, err := db.client.TransactGetItemsWithContext(ctx, &dynamodb.TransactGetItemsInput{
+		TransactItems: gets,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]attributeMap, len(keys))
+	for i, response := range output.Responses {
+		if i < len(items) && response != nil {
+			items[i] = response.Item
+		}
+	}
+	return items, nil
+}
+
+func (db *ddb) deleteItem(ctx context.Context, table string, key attributeMap) error {
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName: db.tableName(table),
+		Key:       key,
+	})
+	return err
+}
+
+// queryPage runs the query until pageSize items are collected or there is no more item.
+// A pageSize of zero or less reads all the items.
+func (db *ddb) queryPage(ctx context.Context, input *dynamodb.QueryInput, pageSize int, pageToken []byte) ([]attributeMap, []byte, error) {
+	startKey, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+	if input.IndexName == nil {
+		input.ConsistentRead = aws.Bool(true)
+	}
+
+	var items []attributeMap
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		output, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, output.Items...)
+		if len(output.LastEvaluatedKey) == 0 {
+			return items, nil, nil
+		}
+		if pageSize > 0 && len(items) >= pageSize {
+			token, err := serializePageToken(output.LastEvaluatedKey)
+			return items, token, err
+		}
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+// scanPage is the same as queryPage, but for a full table scan
+func (db *ddb) scanPage(ctx context.Context, input *dynamodb.ScanInput, pageSize int, pageToken []byte) ([]attributeMap, []byte, error) {
+	startKey, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ExclusiveStartKey = startKey
+	if input.IndexName == nil {
+		input.ConsistentRead = aws.Bool(true)
+	}
+
+	var items []attributeMap
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		output, err := db.client.ScanWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, output.Items...)
+		if len(output.LastEvaluatedKey) == 0 {
+			return items, nil, nil
+		}
+		if pageSize > 0 && len(items) >= pageSize {
+			token, err := serializePageToken(output.LastEvaluatedKey)
+			return items, token, err
+		}
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+// queryCount returns the number of items matching the query
+func (db *ddb) queryCount(ctx context.Context, input *dynamodb.QueryInput) (int64, error) {
+	input.Select = aws.String(dynamodb.SelectCount)
+	if input.IndexName == nil {
+		input.ConsistentRead = aws.Bool(true)
+	}
+	var count int64
+	for {
+		output, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return 0, err
+		}
+		count += aws.Int64Value(output.Count)
+		if len(output.LastEvaluatedKey) == 0 {
+			return count, nil
+		}
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+// rangeDelete deletes all the items matching the query
+func (db *ddb) rangeDelete(ctx context.Context, table string, input *dynamodb.QueryInput) error {
+	names := map[string]*string{
+		"#pk": aws.String(attrPK),
+		"#sk": aws.String(attrSK),
+	}
+	for k, v := range input.ExpressionAttributeNames {
+		names[k] = v
+	}
+	input.ProjectionExpression = aws.String("#pk, #sk")
+	input.ExpressionAttributeNames = names
+
+	items, _, err := db.queryPage(ctx, input, 0, nil)
+	if err != nil {
+		return err
+	}
+	keys := make([]attributeMap, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, itemKey(getString(item, attrPK), getString(item, attrSK)))
+	}
+	return db.batchDelete(ctx, table, keys)
+}
+
+// batchDelete deletes the items in chunks, retrying the unprocessed items
+func (db *ddb) batchDelete(ctx context.Context, table string, keys []attributeMap) error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(keys))
+	for _, key := range keys {
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: key},
+		})
+	}
+	return db.batchWrite(ctx, table, requests)
+}
+
+// batchPut writes the items in chunks without any condition, retrying the unprocessed items
+func (db *ddb) batchPut(ctx context.Context, table string, items []attributeMap) error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(items))
+	for _, item := range items {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{Item: item},
+		})
+	}
+	return db.batchWrite(ctx, table, requests)
+}
+
+func (db *ddb) batchWrite(ctx context.Context, table string, requests []*dynamodb.WriteRequest) error {
+	for start := 0; start < len(requests); start += maxBatchWriteItems {
+		end := start + maxBatchWriteItems
+		if end > len(requests) {
+			end = len(requests)
+		}
+		pending := map[string][]*dynamodb.WriteRequest{
+			*db.tableName(table): requests[start:end],
+		}
+		for len(pending) > 0 {
+			output, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: pending,
+			})
+			if err != nil {
+				return err
+			}
+			pending = output.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+// transactWrite executes the items within one transaction.
+// If the transaction is canceled because of any condition check failure, it returns the cancellation reasons
+// in the same order as the items, with a nil error.
+func (db *ddb) transactWrite(ctx context.Context, items []*dynamodb.TransactWriteItem) ([]*dynamodb.CancellationReason, error) {
+	if len(items) > maxTransactionItems {
+		return nil, fmt.Errorf("transaction contains %v items, exceeding the limit of %v", len(items), maxTransactionItems)
+	}
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: items,
+	})
+	if err == nil {
+		return nil, nil
+	}
+	reasons := cancellationReasons(err)
+	for _, reason := range reasons {
+		if isConditionalCheckFailed(reason) {
+			return reasons, nil
+		}
+	}
+	if isItemSizeLimitError(err) {
+		return nil, &persistence.TransactionSizeLimitError{
+			Msg: fmt.Sprintf("an item of the transaction exceeds the limit of %v bytes of a DynamoDB item: %v", maxItemSize, err),
+		}
+	}
+	return nil, err
+}
+
+func (db *ddb) newTransactPut(table string, item attributeMap, condition string, builder *expressionBuilder) *dynamodb.TransactWriteItem {
+	put := &dynamodb.Put{
+		TableName:                           db.tableName(table),
+		Item:                                item,
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	}
+	if condition != "" {
+		put.ConditionExpression = aws.String(condition)
+		put.ExpressionAttributeNames = builder.attributeNames()
+		put.ExpressionAttributeValues = builder.attributeValues()
+	}
+	return &dynamodb.TransactWriteItem{Put: put}
+}
+
+func (db *ddb) newTransactUpdate(table string, key attributeMap, update, condition string, builder *expressionBuilder) *dynamodb.TransactWriteItem {
+	u := &dynamodb.Update{
+		TableName:                           db.tableName(table),
+		Key:                                 key,
+		UpdateExpression:                    aws.String(update),
+		ExpressionAttributeNames:            builder.attributeNames(),
+		ExpressionAttributeValues:           builder.attributeValues(),
+		ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+	}
+	if condition != "" {
+		u.ConditionExpression = aws.String(condition)
+	}
+	return &dynamodb.TransactWriteItem{Update: u}
+}
+
+func (db *ddb) newTransactConditionCheck(table string, key attributeMap, condition string, builder *expressionBuilder) *dynamodb.TransactWriteItem {
+	return &dynamodb.TransactWriteItem{
+		ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:                           db.tableName(table),
+			Key:                                 key,
+			ConditionExpression:                 aws.String(condition),
+			ExpressionAttributeNames:            builder.attributeNames(),
+			ExpressionAttributeValues:           builder.attributeValues(),
+			ReturnValuesOnConditionCheckFailure: aws.String(dynamodb.ReturnValuesOnConditionCheckFailureAllOld),
+		},
+	}
+}
+
+// cancellationReasons returns the reasons of a canceled transaction, or nil if the error is not a transaction cancellation
+func cancellationReasons(err error) []*dynamodb.CancellationReason {
+	if tce, ok := err.(*dynamodb.TransactionCanceledException); ok {
+		return tce.CancellationReasons
+	}
+	return nil
+}
+
+func isConditionalCheckFailed(reason *dynamodb.CancellationReason) bool {
+	return reason != nil && aws.StringValue(reason.Code) == cancellationReasonConditionalCheckFailed
+}
+
+// isItemSizeLimitError returns whether a write is rejected because an item would exceed maxItemSize,
+// either as the error of a single item write or as the cancellation reason of an item of a transaction
+func isItemSizeLimitError(err error) bool {
+	for _, reason := range cancellationReasons(err) {
+		if reason != nil && aws.StringValue(reason.Code) == cancellationReasonValidationError &&
+			strings.Contains(aws.StringValue(reason.Message), itemSizeLimitErrorMessage) {
+			return true
+		}
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == errCodeValidationException && strings.Contains(aerr.Message(), itemSizeLimitErrorMessage)
+	}
+	return false
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+func TestEncodeInt64(t *testing.T) {
+	values := []int64{math.MinInt64, -1000, -1, 0, 1, 1000, math.MaxInt64}
+	encoded := make([]string, 0, len(values))
+	for _, v := range values {
+		s := encodeInt64(v)
+		assert.Len(t, s, 20)
+		decoded, err := decodeInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, v, decoded)
+		encoded = append(encoded, s)
+	}
+	assert.True(t, sort.StringsAreSorted(encoded), "encoded values must keep the numeric order")
+}
+
+func TestUpdateExpression(t *testing.T) {
+	builder := newExpressionBuilder()
+	update := newUpdateExpression(builder)
+	update.set("next_event_id", numberAttr(10))
+	update.setEntry("activity_map", "5", binaryAttr([]byte("a")))
+	update.appendList("buffered_events", stringAttr("e"))
+	update.removeEntry("activity_map", "6")
+
+	assert.Equal(t, "SET #n0 = :v0, #n1.#n2 = :v1, #n3 = list_append(#n3, :v2) REMOVE #n1.#n4", update.String())
+	assert.Len(t, builder.attributeNames(), 5)
+	assert.Len(t, builder.attributeValues(), 3)
+	assert.Nil(t, newExpressionBuilder().attributeNames())
+	assert.Nil(t, newExpressionBuilder().attributeValues())
+}
+
+func TestPageToken(t *testing.T) {
+	token, err := serializePageToken(nil)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	key := itemKey("pk", "sk")
+	token, err = serializePageToken(key)
+	require.NoError(t, err)
+	decoded, err := deserializePageToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, key, decoded)
+
+	_, err = deserializePageToken([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestActivityInfoMap(t *testing.T) {
+	entries, err := newJSONMap(map[int64]*persistence.InternalActivityInfo{
+		5: {ScheduleID: 5, ActivityID: "a", Paused: true},
+	})
+	require.NoError(t, err)
+	infos, err := parseJSONMap[int64, persistence.InternalActivityInfo](attributeMap{
+		execAttrActivityMap: mapAttr(entries),
+	}, execAttrActivityMap, parseInt64Key)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]*persistence.InternalActivityInfo{
+		5: {ScheduleID: 5, ActivityID: "a", Paused: true},
+	}, infos)
+}
+
+func TestWorkflowExecutionInfo(t *testing.T) {
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:   "domain",
+			WorkflowID: "workflow",
+			RunID:      "run",
+			Paused:     true,
+		},
+	}
+	item, err := newWorkflowExecutionAttributes(execution, time.Now())
+	require.NoError(t, err)
+	info, err := parseWorkflowExecutionInfo(item)
+	require.NoError(t, err)
+	assert.Equal(t, &execution.InternalWorkflowExecutionInfo, info)
+}
+
+func TestWorkflowExecutionParts(t *testing.T) {
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:   "domain",
+			WorkflowID: "workflow",
+			RunID:      "run",
+		},
+		ActivityInfos: map[int64]*persistence.InternalActivityInfo{
+			5: {ScheduleID: 5, ActivityID: "a"},
+		},
+		SignalRequestedIDs: []string{"signal"},
+		MapsWriteMode:      nosqlplugin.WorkflowExecutionMapsWriteModeCreate,
+	}
+	db := &ddb{tablePrefix: "test_"}
+	tx := &workflowTransaction{}
+	require.NoError(t, db.createWorkflowExecutionWithMergeMaps(tx, 1, execution, time.Now()))
+	require.Len(t, tx.items, 1+len(workflowExecutionParts), "one item for the execution info and one per part")
+
+	keys := workflowExecutionKeys(1, "domain", "workflow", "run")
+	items := make([]attributeMap, 0, len(tx.items))
+	for i, item := range tx.items {
+		assert.Equal(t, keys[i][attrSK], item.Put.Item[attrSK])
+		items = append(items, item.Put.Item)
+	}
+	state, err := parseWorkflowExecution(mergeWorkflowExecutionItems(items))
+	require.NoError(t, err)
+	assert.Equal(t, execution.ActivityInfos, state.ActivityInfos)
+	assert.Equal(t, map[string]struct{}{"signal": {}}, state.SignalRequestedIDs)
+	assert.Empty(t, state.BufferedEvents)
+
+	assert.Nil(t, mergeWorkflowExecutionItems(make([]attributeMap, len(keys))), "missing execution info item")
+}
+
+func TestUpdateWorkflowExecutionParts(t *testing.T) {
+	previousNextEventID := int64(3)
+	execution := &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:   "domain",
+			WorkflowID: "workflow",
+			RunID:      "run",
+		},
+		TimerInfoKeysToDelete:        []string{"timer"},
+		MapsWriteMode:                nosqlplugin.WorkflowExecutionMapsWriteModeUpdate,
+		EventBufferWriteMode:         nosqlplugin.EventBufferWriteModeNone,
+		PreviousNextEventIDCondition: &previousNextEventID,
+	}
+	db := &ddb{tablePrefix: "test_"}
+	tx := &workflowTransaction{}
+	require.NoError(t, db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(tx, 1, execution, time.Now()))
+	require.Len(t, tx.items, 2, "only the execution info and the changed parts are updated")
+	assert.Equal(t, workflowExecutionKey(1, "domain", "workflow", "run"), tx.items[0].Update.Key)
+	assert.Equal(t, workflowExecutionPartKey(1, "domain", "workflow", "run", execAttrTimerMap), tx.items[1].Update.Key)
+	assert.Nil(t, tx.items[1].Update.ConditionExpression)
+}
+
+func TestCreateTasksByCategory(t *testing.T) {
+	newTasks := func(count int) map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask {
+		tasks := make([]*nosqlplugin.HistoryMigrationTask, 0, count)
+		for i := 0; i < count; i++ {
+			tasks = append(tasks, &nosqlplugin.HistoryMigrationTask{
+				Transfer: &nosqlplugin.TransferTask{TaskID: int64(i)},
+				Task:     &persistence.DataBlob{},
+			})
+		}
+		return map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask{
+			persistence.HistoryTaskCategoryTransfer: tasks,
+		}
+	}
+	shardCondition := &nosqlplugin.ShardCondition{ShardID: 1, RangeID: 2}
+
+	t.Run("tasks fit into the transaction", func(t *testing.T) {
+		db := &ddb{tablePrefix: "test_"}
+		tx := &workflowTransaction{}
+		db.assertShardRangeID(tx, shardCondition)
+		require.NoError(t, db.createTasksByCategory(tx, 1, newTasks(maxTransactionItems-1), time.Now()))
+		assert.Len(t, tx.items, maxTransactionItems)
+	})
+
+	t.Run("tasks exceeding the transaction limit are rejected", func(t *testing.T) {
+		db := &ddb{tablePrefix: "test_"}
+		tx := &workflowTransaction{}
+		db.assertShardRangeID(tx, shardCondition)
+		err := db.createTasksByCategory(tx, 1, newTasks(maxTransactionItems), time.Now())
+		var sizeErr *persistence.TransactionSizeLimitError
+		assert.ErrorAs(t, err, &sizeErr)
+		assert.Len(t, tx.items, 1, "no task is added to the transaction")
+	})
+}
+
+func TestSplitHistoryNodeData(t *testing.T) {
+	tests := map[string]struct {
+		size           int
+		expectedChunks []int
+	}{
+		"empty":             {size: 0, expectedChunks: []int{0}},
+		"within one chunk":  {size: historyNodeChunkSize, expectedChunks: []int{historyNodeChunkSize}},
+		"split into chunks": {size: 2*historyNodeChunkSize + 1, expectedChunks: []int{historyNodeChunkSize, historyNodeChunkSize, 1}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := make([]byte, tc.size)
+			for i := range data {
+				data[i] = byte(i)
+			}
+			chunks := splitHistoryNodeData(data)
+			var joined []byte
+			for i, chunk := range chunks {
+				assert.Len(t, chunk, tc.expectedChunks[i])
+				joined = append(joined, chunk...)
+			}
+			assert.Len(t, chunks, len(tc.expectedChunks))
+			assert.Equal(t, len(data), len(joined))
+			assert.True(t, bytes.Equal(data, joined), "the chunks must join into the data")
+		})
+	}
+	assert.Less(t, historyNodeChunkSize, maxItemSize)
+	assert.True(t, historyNodeChunkSortKey(1, 2, 1) > historyNodeSortKey(1, 2) && historyNodeChunkSortKey(1, 2, 1) < historyNodeSortKey(2, 0),
+		"the chunks of a node must be within the sort key range of the node")
+}
+
+func TestIsItemSizeLimitError(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"single item write": {
+			err:      awserr.New(errCodeValidationException, "Item size has exceeded the maximum allowed size", nil),
+			expected: true,
+		},
+		"transaction item": {
+			err: &dynamodb.TransactionCanceledException{CancellationReasons: []*dynamodb.CancellationReason{
+				{Code: aws.String("None")},
+				{Code: aws.String(cancellationReasonValidationError), Message: aws.String("Item size to update has exceeded the maximum allowed size")},
+			}},
+			expected: true,
+		},
+		"other validation error": {
+			err:      awserr.New(errCodeValidationException, "One or more parameter values were invalid", nil),
+			expected: false,
+		},
+		"condition failure": {
+			err: &dynamodb.TransactionCanceledException{CancellationReasons: []*dynamodb.CancellationReason{
+				{Code: aws.String(cancellationReasonConditionalCheckFailed)},
+			}},
+			expected: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isItemSizeLimitError(tc.err))
+		})
+	}
+}
@@ -22,16 +22,70 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// A visibility record is a single item keyed by (domainID, workflowID#runID).
+// Unlike Cassandra which writes into different tables, the open and closed records are served by sparse
+// global secondary indexes: the index attributes of the open index are removed when the workflow is closed.
+const (
+	visibilityOpenByStartTimeIndex   = "open_by_start_time"
+	visibilityClosedByStartTimeIndex = "closed_by_start_time"
+	visibilityClosedByCloseTimeIndex = "closed_by_close_time"
+
+	visibilityAttrOpenPK           = "open_pk"
+	visibilityAttrOpenSK           = "open_sk"
+	visibilityAttrClosedPK         = "closed_pk"
+	visibilityAttrClosedStartSK    = "closed_start_sk"
+	visibilityAttrClosedCloseSK    = "closed_close_sk"
+	visibilityAttrWorkflowID       = "workflow_id"
+	visibilityAttrWorkflowType     = "workflow_type"
+	visibilityAttrCloseStatus      = "close_status"
+	visibilitySortKeySeparator     = "#"
+	visibilitySortKeyUpperBoundary = "$"
+)
+
+func visibilityKey(domainID, workflowID, runID string) attributeMap {
+	return itemKey(domainID, workflowID+visibilitySortKeySeparator+runID)
+}
+
+// visibilityIndexSortKey sorts the records by time(in milliseconds, same as Cassandra) and then by runID
+func visibilityIndexSortKey(t time.Time, runID string) string {
+	return encodeInt64(toDBTimestamp(t)) + visibilitySortKeySeparator + runID
+}
+
 func (db *ddb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	item, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	item[visibilityAttrOpenPK] = stringAttr(row.DomainID)
+	item[visibilityAttrOpenSK] = stringAttr(visibilityIndexSortKey(row.StartTime, row.RunID))
+
+	// the close event may be recorded before the start event, never override a closed record
+	builder := newExpressionBuilder()
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                db.tableName(tableVisibility),
+		Item:                     item,
+		ConditionExpression:      aws.String(fmt.Sprintf("attribute_not_exists(%v)", builder.name(visibilityAttrClosedPK))),
+		ExpressionAttributeNames: builder.attributeNames(),
+	})
+	if db.IsConditionFailedError(err) {
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) UpdateVisibility(
@@ -39,26 +93,174 @@ func (db *ddb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	if row.UpdateCloseToOpen {
+		// TODO implement it when where is a need
+		panic("not supported operation")
+	}
+
+	// replacing the whole item removes it from the open index
+	item, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	item[visibilityAttrClosedPK] = stringAttr(row.DomainID)
+	item[visibilityAttrClosedStartSK] = stringAttr(visibilityIndexSortKey(row.StartTime, row.RunID))
+	item[visibilityAttrClosedCloseSK] = stringAttr(visibilityIndexSortKey(row.CloseTime, row.RunID))
+	if row.Status != nil {
+		item[visibilityAttrCloseStatus] = numberAttr(int64(*row.Status))
+	}
+
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableVisibility),
+		Item:      item,
+	})
+	return err
 }
 
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	var indexName, partitionKeyName, sortKeyName string
+	var filters []string
+
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		indexName, partitionKeyName, sortKeyName = visibilityOpenByStartTimeIndex, visibilityAttrOpenPK, visibilityAttrOpenSK
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		switch filter.SortType {
+		case nosqlplugin.SortByStartTime:
+			indexName, partitionKeyName, sortKeyName = visibilityClosedByStartTimeIndex, visibilityAttrClosedPK, visibilityAttrClosedStartSK
+		case nosqlplugin.SortByClosedTime:
+			indexName, partitionKeyName, sortKeyName = visibilityClosedByCloseTimeIndex, visibilityAttrClosedPK, visibilityAttrClosedCloseSK
+		default:
+			panic("not supported sorting type")
+		}
+	default:
+		panic("no supported filter type")
+	}
+
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		filters = append(filters, fmt.Sprintf("%v = %v", builder.name(visibilityAttrWorkflowType), builder.value(stringAttr(filter.WorkflowType))))
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		filters = append(filters, fmt.Sprintf("%v = %v", builder.name(visibilityAttrWorkflowID), builder.value(stringAttr(filter.WorkflowID))))
+	case nosqlplugin.ClosedByClosedStatus:
+		filters = append(filters, fmt.Sprintf("%v = %v", builder.name(visibilityAttrCloseStatus), builder.value(numberAttr(int64(filter.CloseStatus)))))
+	}
+
+	request := &filter.ListRequest
+	keyCondition := fmt.Sprintf("%v = %v AND %v BETWEEN %v AND %v",
+		builder.name(partitionKeyName), builder.value(stringAttr(request.DomainUUID)),
+		builder.name(sortKeyName),
+		builder.value(stringAttr(encodeInt64(toDBTimestamp(request.EarliestTime)))),
+		builder.value(stringAttr(encodeInt64(toDBTimestamp(request.LatestTime))+visibilitySortKeyUpperBoundary)),
+	)
+	input := &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableVisibility),
+		IndexName:                 aws.String(indexName),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ScanIndexForward:          aws.Bool(false),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+	if len(filters) > 0 {
+		input.FilterExpression = aws.String(strings.Join(filters, " AND "))
+	}
+
+	items, nextPageToken, err := db.queryPage(ctx, input, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions:    make([]*nosqlplugin.VisibilityRow, 0, len(items)),
+		NextPageToken: nextPageToken,
+	}
+	for _, item := range items {
+		row, err := parseVisibilityItem(item)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	return response, nil
 }
 
+// UpsertVisibility is not supported, the query based visibility APIs are only implemented by the Cassandra plugin
+func (db *ddb) UpsertVisibility(
+	ctx context.Context,
+	ttlSeconds int64,
+	row *nosqlplugin.VisibilityRowForInsert,
+) error {
+	return persistence.ErrVisibilityOperationNotSupported
+}
+
+func (db *ddb) SelectVisibilityByQuery(
+	ctx context.Context,
+	filter *nosqlplugin.VisibilityQueryFilter,
+) (*nosqlplugin.SelectVisibilityResponse, error) {
+	return nil, persistence.ErrVisibilityOperationNotSupported
+}
+
+func (db *ddb) CountVisibilityByQuery(
+	ctx context.Context,
+	filter *nosqlplugin.VisibilityQueryFilter,
+) (int64, error) {
+	return 0, persistence.ErrVisibilityOperationNotSupported
+}
+
+// DeleteVisibility deletes the record, though the records are also deleted by TTL
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableVisibility, visibilityKey(domainID, workflowID, runID))
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableVisibility, visibilityKey(domainID, workflowID, runID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if _, ok := item[visibilityAttrClosedPK]; !ok {
+		return nil, nil
+	}
+	return parseVisibilityItem(item)
+}
+
+func newVisibilityItem(domainID string, row *nosqlplugin.VisibilityRow, ttlSeconds int64) (attributeMap, error) {
+	record := *row
+	record.DomainID = domainID
+	// search attributes can't be queried with this plugin, so they are not stored
+	record.SearchAttributes = nil
+	data, err := jsonAttr(&record)
+	if err != nil {
+		return nil, err
+	}
+
+	item := visibilityKey(domainID, row.WorkflowID, row.RunID)
+	item[attrData] = data
+	item[visibilityAttrWorkflowID] = stringAttr(row.WorkflowID)
+	item[visibilityAttrWorkflowType] = stringAttr(row.TypeName)
+	if ttlSeconds > 0 {
+		item[attrTTL] = ttlAttr(time.Now(), ttlSeconds)
+	}
+	return item, nil
+}
+
+func parseVisibilityItem(item attributeMap) (*nosqlplugin.VisibilityRow, error) {
+	row := &persistence.InternalVisibilityWorkflowExecutionInfo{}
+	if err := getJSON(item, attrData, row); err != nil {
+		return nil, err
+	}
+	row.Memo = normalizeDataBlob(row.Memo)
+	return row, nil
 }
@@ -23,8 +23,14 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -40,7 +46,53 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+	timeStamp := execution.CurrentTimeStamp
+
+	t := &workflowTransaction{}
+	db.insertWorkflowActiveClusterSelectionPolicyRow(t, activeClusterSelectionPolicyRow, timeStamp)
+	err := db.insertOrUpsertWorkflowRequestRow(t, requests, timeStamp)
+	if err != nil {
+		return err
+	}
+	err = db.createOrUpdateCurrentWorkflow(t, shardID, domainID, workflowID, currentWorkflowRequest, timeStamp)
+	if err != nil {
+		return err
+	}
+	err = db.createWorkflowExecutionWithMergeMaps(t, shardID, execution, timeStamp)
+	if err != nil {
+		return err
+	}
+	db.assertShardRangeID(t, shardCondition)
+	err = db.createTasksByCategory(t, shardID, tasksByCategory, timeStamp)
+	if err != nil {
+		return err
+	}
+
+	reasons, err := db.transactWrite(ctx, t.items)
+	if err != nil || reasons == nil {
+		return err
+	}
+	return db.newCreateWorkflowConditionFailure(reasons, t.ops, currentWorkflowRequest, execution, shardCondition)
+}
+
+func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
+	item, err := db.getItem(ctx, tableExecution, currentWorkflowKey(shardID, domainID, workflowID))
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            getString(item, execAttrCurrentRunID),
+		CreateRequestID:  getString(item, execAttrCreateRequestID),
+		State:            int(getInt64(item, execAttrState)),
+		CloseStatus:      int(getInt64(item, execAttrCloseStatus)),
+		LastWriteVersion: getInt64(item, execAttrLastWriteVersion),
+	}, nil
 }
 
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
@@ -54,113 +106,467 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var previousNextEventIDCondition int64
+	var timeStamp time.Time
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		previousNextEventIDCondition = *mutatedExecution.PreviousNextEventIDCondition
+		timeStamp = mutatedExecution.CurrentTimeStamp
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		previousNextEventIDCondition = *resetExecution.PreviousNextEventIDCondition
+		timeStamp = resetExecution.CurrentTimeStamp
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	t := &workflowTransaction{}
+	err := db.insertOrUpsertWorkflowRequestRow(t, requests, timeStamp)
+	if err != nil {
+		return err
+	}
+	err = db.createOrUpdateCurrentWorkflow(t, shardID, domainID, workflowID, currentWorkflowRequest, timeStamp)
+	if err != nil {
+		return err
+	}
+
+	if mutatedExecution != nil {
+		err = db.updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(t, shardID, mutatedExecution, timeStamp)
+		if err != nil {
+			return err
+		}
+	}
+
+	if insertedExecution != nil {
+		err = db.createWorkflowExecutionWithMergeMaps(t, shardID, insertedExecution, timeStamp)
+		if err != nil {
+			return err
+		}
+		db.insertWorkflowActiveClusterSelectionPolicyRow(t, activeClusterSelectionPolicyRow, timeStamp)
+	}
+
+	if resetExecution != nil {
+		err = db.resetWorkflowExecutionAndMapsAndEventBuffer(t, shardID, resetExecution, timeStamp)
+		if err != nil {
+			return err
+		}
+	}
+
+	db.assertShardRangeID(t, shardCondition)
+	err = db.createTasksByCategory(t, shardID, tasksByCategory, timeStamp)
+	if err != nil {
+		return err
+	}
+
+	reasons, err := db.transactWrite(ctx, t.items)
+	if err != nil || reasons == nil {
+		return err
+	}
+	return db.newUpdateWorkflowConditionFailure(reasons, t.ops, currentWorkflowRequest, previousNextEventIDCondition, shardCondition)
 }
 
-func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+// SelectWorkflowExecution reads all the items of a run within one transaction, so that the parts are consistent
+// with the execution info.
+func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
+	items, err := db.transactGet(ctx, tableExecution, workflowExecutionKeys(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	item := mergeWorkflowExecutionItems(items)
+	if item == nil {
+		return nil, errNotFound
+	}
+	return parseWorkflowExecution(item)
 }
 
-func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+// SelectWorkflowTimerTasks reads the workflow_timer_tasks map from the execution info item.
+func (db *ddb) SelectWorkflowTimerTasks(ctx context.Context, shardID int, domainID, workflowID, runID string) ([]persistence.HistoryTaskKey, error) {
+	builder := newExpressionBuilder()
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:                db.tableName(tableExecution),
+		Key:                      workflowExecutionKey(shardID, domainID, workflowID, runID),
+		ConsistentRead:           aws.Bool(true),
+		ProjectionExpression:     aws.String(builder.name(execAttrWorkflowTimerTasks)),
+		ExpressionAttributeNames: builder.attributeNames(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseWorkflowTimerTasks(output.Item)
 }
 
 func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 db.tableName(tableExecution),
+		Key:                       currentWorkflowKey(shardID, domainID, workflowID),
+		ConditionExpression:       aws.String(fmt.Sprintf("%v = %v", builder.name(execAttrCurrentRunID), builder.value(stringAttr(currentRunIDCondition)))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	})
+	// same as Cassandra, it's not an error if the current workflow has moved on to another run
+	if db.IsConditionFailedError(err) {
+		return nil
+	}
+	return err
 }
 
 func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
-}
-
-func (db *ddb) SelectWorkflowTimerTasks(ctx context.Context, shardID int, domainID, workflowID, runID string) ([]persistence.HistoryTaskKey, error) {
-	panic("TODO")
+	return db.batchDelete(ctx, tableExecution, workflowExecutionKeys(shardID, domainID, workflowID, runID))
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, db.newShardIndexQuery(shardID, shardIndexCurrent), pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var executions []*persistence.CurrentWorkflowExecution
+	for _, item := range items {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     getString(item, execAttrDomainID),
+			WorkflowID:   getString(item, execAttrWorkflowID),
+			RunID:        permanentRunID,
+			State:        int(getInt64(item, execAttrState)),
+			CurrentRunID: getString(item, execAttrCurrentRunID),
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, db.newShardIndexQuery(shardID, shardIndexRun), pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var executions []*persistence.InternalListConcreteExecutionsEntity
+	for _, item := range items {
+		executionInfo, err := parseWorkflowExecutionInfo(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    executionInfo,
+			VersionHistories: toDataBlob(getBytes(item, execAttrVersionHistories), getString(item, execAttrVersionHistoriesEncoding)),
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:                db.tableName(tableExecution),
+		Key:                      workflowExecutionKey(shardID, domainID, workflowID, runID),
+		ConsistentRead:           aws.Bool(true),
+		ProjectionExpression:     aws.String(builder.name(attrPK)),
+		ExpressionAttributeNames: builder.attributeNames(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(output.Item) > 0, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	input := db.newHistoryTasksQuery(historyTaskPartitionKey(shardID, historyTaskTypeTransfer),
+		encodeInt64(inclusiveMinTaskID), encodeInt64(exclusiveMaxTaskID-1))
+	items, nextPageToken, err := db.queryPage(ctx, input, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := parseHistoryTasks(items, parseTransferTask)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	pk := historyTaskPartitionKey(shardID, historyTaskTypeTransfer)
+	itemKeys := make([]attributeMap, 0, len(keys))
+	for _, key := range keys {
+		itemKeys = append(itemKeys, itemKey(pk, encodeInt64(key.GetTaskID())))
+	}
+	return db.batchDelete(ctx, tableHistoryTask, itemKeys)
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if inclusiveBeginTaskID >= exclusiveEndTaskID {
+		return nil
+	}
+	input := db.newHistoryTasksQuery(historyTaskPartitionKey(shardID, historyTaskTypeTransfer),
+		encodeInt64(inclusiveBeginTaskID), encodeInt64(exclusiveEndTaskID-1))
+	return db.rangeDelete(ctx, tableHistoryTask, input)
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	input, ok := db.newTimerTasksQuery(shardID, inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil, nil, nil
+	}
+	items, nextPageToken, err := db.queryPage(ctx, input, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	timers, err := parseHistoryTasks(items, parseTimerTask)
+	if err != nil {
+		return nil, nil, err
+	}
+	return timers, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	pk := historyTaskPartitionKey(shardID, historyTaskTypeTimer)
+	itemKeys := make([]attributeMap, 0, len(keys))
+	for _, key := range keys {
+		itemKeys = append(itemKeys, itemKey(pk, timerTaskSortKey(toDBTimestamp(key.GetScheduledTime()), key.GetTaskID())))
+	}
+	return db.batchDelete(ctx, tableHistoryTask, itemKeys)
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	input, ok := db.newTimerTasksQuery(shardID, inclusiveMinTime, exclusiveMaxTime)
+	if !ok {
+		return nil
+	}
+	return db.rangeDelete(ctx, tableHistoryTask, input)
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectReplicationTasks(ctx, historyTaskPartitionKey(shardID, historyTaskTypeReplication), pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	pk := historyTaskPartitionKey(shardID, historyTaskTypeReplication)
+	itemKeys := make([]attributeMap, 0, len(keys))
+	for _, key := range keys {
+		itemKeys = append(itemKeys, itemKey(pk, encodeInt64(key.GetTaskID())))
+	}
+	return db.batchDelete(ctx, tableHistoryTask, itemKeys)
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if exclusiveEndTaskID == math.MinInt64 {
+		return nil
+	}
+	input := db.newHistoryTasksQuery(historyTaskPartitionKey(shardID, historyTaskTypeReplication),
+		encodeInt64(math.MinInt64), encodeInt64(exclusiveEndTaskID-1))
+	return db.rangeDelete(ctx, tableHistoryTask, input)
 }
 
+// InsertReplicationTask writes the tasks together with the shard condition check, the tasks are split into
+// multiple transactions if there are too many of them
 func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	if len(tasks) == 0 {
+		return nil
+	}
+	return db.InsertHistoryTasks(ctx, map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask{
+		persistence.HistoryTaskCategoryReplication: tasks,
+	}, tasks[0].Replication.CurrentTimeStamp, condition)
 }
 
 func (db *ddb) InsertHistoryTasks(ctx context.Context, tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask, currentTimeStamp time.Time, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	items, err := newHistoryTaskItems(condition.ShardID, tasksByCategory, currentTimeStamp)
+	if err != nil {
+		return err
+	}
+	return db.insertHistoryTaskItems(ctx, items, condition)
 }
 
+// insertHistoryTaskItems writes the task items in as many transactions as needed, each guarded by the shard range ID
+func (db *ddb) insertHistoryTaskItems(ctx context.Context, items []attributeMap, condition nosqlplugin.ShardCondition) error {
+	for start := 0; start < len(items); start += maxHistoryTasksInTransaction {
+		end := start + maxHistoryTasksInTransaction
+		if end > len(items) {
+			end = len(items)
+		}
+		t := &workflowTransaction{}
+		for _, item := range items[start:end] {
+			t.add(workflowTransactionOp{}, db.newTransactPut(tableHistoryTask, item, "", nil))
+		}
+		db.assertShardRangeID(t, &condition)
+
+		reasons, err := db.transactWrite(ctx, t.items)
+		if err != nil {
+			return err
+		}
+		if reasons != nil {
+			return newShardOperationConditionFailure(reasons, t.ops, condition)
+		}
+	}
+	return nil
+}
+
+// DeleteCrossClusterTask is a noop, cross cluster tasks are deprecated and never written by this plugin
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return nil
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	// Use source cluster name as part of the partition key for replication dlq
+	item, err := newHistoryTaskItem(replicationDLQPartitionKey(shardID, sourceCluster), encodeInt64(task.Replication.TaskID),
+		task, task.Replication, 0, task.Replication.CurrentTimeStamp)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: db.tableName(tableHistoryTask),
+		Item:      item,
+	})
+	return err
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	return db.selectReplicationTasks(ctx, replicationDLQPartitionKey(shardID, sourceCluster), pageSize, pageToken, inclusiveMinTaskID, exclusiveMaxTaskID)
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	builder := newExpressionBuilder()
+	count, err := db.queryCount(ctx, &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableHistoryTask),
+		KeyConditionExpression:    aws.String(fmt.Sprintf("%v = %v", builder.name(attrPK), builder.value(stringAttr(replicationDLQPartitionKey(shardID, sourceCluster))))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	})
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableHistoryTask, itemKey(replicationDLQPartitionKey(shardID, sourceCluster), encodeInt64(taskID)))
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	if inclusiveBeginTaskID >= exclusiveEndTaskID {
+		return nil
+	}
+	input := db.newHistoryTasksQuery(replicationDLQPartitionKey(shardID, sourceCluster),
+		encodeInt64(inclusiveBeginTaskID), encodeInt64(exclusiveEndTaskID-1))
+	return db.rangeDelete(ctx, tableHistoryTask, input)
 }
 
 func (db *ddb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableExecution, activeClusterSelectionPolicyKey(shardID, domainID, wfID, rID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy: &persistence.DataBlob{
+			Data:     getBytes(item, attrData),
+			Encoding: constants.EncodingType(getString(item, execAttrDataEncoding)),
+		},
+	}, nil
 }
 
 func (db *ddb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecution, activeClusterSelectionPolicyKey(shardID, domainID, wfID, rID))
+}
+
+// createTasksByCategory adds the tasks to the workflow transaction, so that they are written with the execution.
+// A transaction is limited to maxTransactionItems items, so a write with more tasks than fit is rejected: writing
+// them in separate transactions would leave orphaned tasks if the execution write then failed its condition.
+func (db *ddb) createTasksByCategory(
+	t *workflowTransaction,
+	shardID int,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+	timeStamp time.Time,
+) error {
+	items, err := newHistoryTaskItems(shardID, tasksByCategory, timeStamp)
+	if err != nil {
+		return err
+	}
+	if available := maxTransactionItems - len(t.items); len(items) > available {
+		return &persistence.TransactionSizeLimitError{
+			Msg: fmt.Sprintf("workflow write has %v history tasks and %v other items, exceeding the limit of %v items of a DynamoDB transaction",
+				len(items), len(t.items), maxTransactionItems),
+		}
+	}
+	for _, item := range items {
+		t.add(workflowTransactionOp{}, db.newTransactPut(tableHistoryTask, item, "", nil))
+	}
+	return nil
+}
+
+func (db *ddb) selectReplicationTasks(ctx context.Context, pk string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	if inclusiveMinTaskID >= exclusiveMaxTaskID {
+		return nil, nil, nil
+	}
+	input := db.newHistoryTasksQuery(pk, encodeInt64(inclusiveMinTaskID), encodeInt64(exclusiveMaxTaskID-1))
+	items, nextPageToken, err := db.queryPage(ctx, input, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := parseHistoryTasks(items, parseReplicationTask)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
+}
+
+// newHistoryTasksQuery returns a query of the tasks with sort key in [inclusiveMinSortKey, inclusiveMaxSortKey]
+func (db *ddb) newHistoryTasksQuery(pk, inclusiveMinSortKey, inclusiveMaxSortKey string) *dynamodb.QueryInput {
+	builder := newExpressionBuilder()
+	keyCondition := fmt.Sprintf("%v = %v AND %v BETWEEN %v AND %v",
+		builder.name(attrPK), builder.value(stringAttr(pk)),
+		builder.name(attrSK), builder.value(stringAttr(inclusiveMinSortKey)), builder.value(stringAttr(inclusiveMaxSortKey)),
+	)
+	return &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableHistoryTask),
+		KeyConditionExpression:    aws.String(keyCondition),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+}
+
+// newTimerTasksQuery returns a query of the timer tasks with visibility timestamp in [inclusiveMinTime, exclusiveMaxTime),
+// or false if the range is empty
+func (db *ddb) newTimerTasksQuery(shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) (*dynamodb.QueryInput, bool) {
+	minTimestamp := toDBTimestamp(inclusiveMinTime)
+	maxTimestamp := toDBTimestamp(exclusiveMaxTime)
+	if minTimestamp >= maxTimestamp {
+		return nil, false
+	}
+	// "$" sorts right after "#", so that all the tasks of the last timestamp are included
+	return db.newHistoryTasksQuery(historyTaskPartitionKey(shardID, historyTaskTypeTimer),
+		encodeInt64(minTimestamp), encodeInt64(maxTimestamp-1)+"$"), true
+}
+
+func (db *ddb) newShardIndexQuery(shardID int, recordType string) *dynamodb.QueryInput {
+	builder := newExpressionBuilder()
+	return &dynamodb.QueryInput{
+		TableName:                 db.tableName(tableExecution),
+		IndexName:                 aws.String(shardIndexName),
+		KeyConditionExpression:    aws.String(fmt.Sprintf("%v = %v", builder.name(attrShardIndexPK), builder.value(stringAttr(shardIndexPartitionKey(shardID, recordType))))),
+		ExpressionAttributeNames:  builder.attributeNames(),
+		ExpressionAttributeValues: builder.attributeValues(),
+	}
+}
+
+func parseHistoryTasks(items []attributeMap, parse func(attributeMap) (*nosqlplugin.HistoryMigrationTask, error)) ([]*nosqlplugin.HistoryMigrationTask, error) {
+	var tasks []*nosqlplugin.HistoryMigrationTask
+	for _, item := range items {
+		task, err := parse(item)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
 }
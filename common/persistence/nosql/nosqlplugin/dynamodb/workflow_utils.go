@@ -0,0 +1,1160 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// The execution table stores everything of a workflow within one partition(shardID, domainID, workflowID):
+// the current workflow record, the records of each run, the workflow requests for deduplication and
+// the active cluster selection policies.
+// A run is stored in one record for the execution info plus one record per part, i.e. each of the five maps
+// and the buffered events, so that the mutable state of a run is not bounded by the 400KB limit of a single item.
+const (
+	executionCurrentSortKey                   = "current"
+	executionRunSortKeyPrefix                 = "run#"
+	executionRunPartSortKeySeparator          = "#"
+	workflowRequestSortKeyPrefix              = "request#"
+	activeClusterSelectionPolicySortKeyPrefix = "policy#"
+
+	// shard_index is a global secondary index of the execution table for paging through
+	// the current records or the runs within a shard
+	shardIndexName    = "shard_index"
+	shardIndexCurrent = "current"
+	shardIndexRun     = "run"
+	attrShardIndexPK  = "shard_pk"
+	attrShardIndexSK  = "shard_sk"
+
+	// Same as the Cassandra plugin
+	workflowRequestTTLInSeconds = 10800
+	permanentRunID              = "30000000-0000-f000-f000-000000000001"
+
+	execAttrDomainID                 = "domain_id"
+	execAttrWorkflowID               = "workflow_id"
+	execAttrRunID                    = "run_id"
+	execAttrCurrentRunID             = "current_run_id"
+	execAttrCreateRequestID          = "create_request_id"
+	execAttrState                    = "state"
+	execAttrCloseStatus              = "close_status"
+	execAttrLastWriteVersion         = "last_write_version"
+	execAttrNextEventID              = "next_event_id"
+	execAttrInfo                     = "info"
+	execAttrVersionHistories         = "version_histories"
+	execAttrVersionHistoriesEncoding = "version_histories_encoding"
+	execAttrChecksum                 = "checksum"
+	execAttrActivityMap              = "activity_map"
+	execAttrTimerMap                 = "timer_map"
+	execAttrChildExecutionMap        = "child_executions_map"
+	execAttrRequestCancelMap         = "request_cancel_map"
+	execAttrSignalMap                = "signal_map"
+	execAttrSignalRequested          = "signal_requested"
+	execAttrWorkflowTimerTasks       = "workflow_timer_tasks"
+	execAttrBufferedEvents           = "buffered_events"
+	execAttrVersion                  = "version"
+	execAttrDataEncoding             = "data_encoding"
+	execAttrLastUpdated              = "last_updated"
+)
+
+// The history_task table stores the transfer, timer and replication tasks of a shard, and the replication DLQ tasks.
+// Transfer and replication tasks are sorted by taskID, timer tasks are sorted by (visibility timestamp, taskID).
+const (
+	historyTaskTypeTransfer       = "transfer"
+	historyTaskTypeTimer          = "timer"
+	historyTaskTypeReplication    = "replication"
+	historyTaskTypeReplicationDLQ = "replication_dlq"
+
+	historyTaskAttrTaskID       = "task_id"
+	historyTaskAttrVisibilityTS = "visibility_ts"
+	historyTaskAttrInfo         = "info"
+	historyTaskAttrDataEncoding = "data_encoding"
+	historyTaskAttrCreatedTime  = "created_time"
+
+	// maxHistoryTasksInTransaction leaves one item of the transaction for the shard condition check
+	maxHistoryTasksInTransaction = maxTransactionItems - 1
+)
+
+// workflowExecutionParts are the attributes of a run stored in their own items, see workflowExecutionPartKey
+var workflowExecutionParts = []string{
+	execAttrActivityMap,
+	execAttrTimerMap,
+	execAttrChildExecutionMap,
+	execAttrRequestCancelMap,
+	execAttrSignalMap,
+	execAttrBufferedEvents,
+}
+
+type (
+	// workflowTransaction is the DynamoDB version of the Cassandra LoggedBatch, each item is associated with an operation
+	// so that the cancellation reasons can be translated into the condition failures
+	workflowTransaction struct {
+		items []*dynamodb.TransactWriteItem
+		ops   []workflowTransactionOp
+	}
+
+	workflowTransactionOp struct {
+		kind        workflowTransactionOpKind
+		runID       string
+		requestType persistence.WorkflowRequestType
+	}
+
+	workflowTransactionOpKind int
+)
+
+const (
+	workflowTransactionOpOther workflowTransactionOpKind = iota
+	workflowTransactionOpShard
+	workflowTransactionOpRequest
+	workflowTransactionOpCurrentWorkflow
+	workflowTransactionOpCreateExecution
+	workflowTransactionOpUpdateExecution
+)
+
+func executionPartitionKey(shardID int, domainID, workflowID string) string {
+	return fmt.Sprintf("%v#%v#%v", shardID, domainID, workflowID)
+}
+
+func currentWorkflowKey(shardID int, domainID, workflowID string) attributeMap {
+	return itemKey(executionPartitionKey(shardID, domainID, workflowID), executionCurrentSortKey)
+}
+
+func workflowExecutionKey(shardID int, domainID, workflowID, runID string) attributeMap {
+	return itemKey(executionPartitionKey(shardID, domainID, workflowID), executionRunSortKeyPrefix+runID)
+}
+
+// workflowExecutionPartKey returns the key of the item storing one part of a run, the part name is the name of
+// the only attribute of the item besides the key
+func workflowExecutionPartKey(shardID int, domainID, workflowID, runID, part string) attributeMap {
+	return itemKey(executionPartitionKey(shardID, domainID, workflowID), executionRunSortKeyPrefix+runID+executionRunPartSortKeySeparator+part)
+}
+
+// workflowExecutionKeys returns the keys of all the items of a run, starting with the execution info item
+func workflowExecutionKeys(shardID int, domainID, workflowID, runID string) []attributeMap {
+	keys := []attributeMap{workflowExecutionKey(shardID, domainID, workflowID, runID)}
+	for _, part := range workflowExecutionParts {
+		keys = append(keys, workflowExecutionPartKey(shardID, domainID, workflowID, runID, part))
+	}
+	return keys
+}
+
+func workflowRequestKey(row *nosqlplugin.WorkflowRequestRow) attributeMap {
+	sortKey := fmt.Sprintf("%v%v#%v", workflowRequestSortKeyPrefix, int(row.RequestType), row.RequestID)
+	return itemKey(executionPartitionKey(row.ShardID, row.DomainID, row.WorkflowID), sortKey)
+}
+
+func activeClusterSelectionPolicyKey(shardID int, domainID, workflowID, runID string) attributeMap {
+	return itemKey(executionPartitionKey(shardID, domainID, workflowID), activeClusterSelectionPolicySortKeyPrefix+runID)
+}
+
+func shardIndexPartitionKey(shardID int, recordType string) string {
+	return fmt.Sprintf("%v#%v", shardID, recordType)
+}
+
+func historyTaskPartitionKey(shardID int, taskType string) string {
+	return fmt.Sprintf("%v#%v", shardID, taskType)
+}
+
+func replicationDLQPartitionKey(shardID int, sourceCluster string) string {
+	return fmt.Sprintf("%v#%v#%v", shardID, historyTaskTypeReplicationDLQ, sourceCluster)
+}
+
+func timerTaskSortKey(visibilityTimestamp int64, taskID int64) string {
+	return encodeInt64(visibilityTimestamp) + "#" + encodeInt64(taskID)
+}
+
+// toDBTimestamp converts the time into the millisecond precision used by timer tasks, same as Cassandra
+func toDBTimestamp(t time.Time) int64 {
+	return persistence.UnixNanoToDBTimestamp(t.UnixNano())
+}
+
+func fromDBTimestamp(ts int64) time.Time {
+	return time.Unix(0, persistence.DBTimestampToUnixNano(ts)).UTC()
+}
+
+func (t *workflowTransaction) add(op workflowTransactionOp, item *dynamodb.TransactWriteItem) {
+	t.items = append(t.items, item)
+	t.ops = append(t.ops, op)
+}
+
+func (db *ddb) assertShardRangeID(t *workflowTransaction, shardCondition *nosqlplugin.ShardCondition) {
+	builder := newExpressionBuilder()
+	t.add(workflowTransactionOp{kind: workflowTransactionOpShard}, db.newTransactConditionCheck(
+		tableShard,
+		shardKey(shardCondition.ShardID),
+		fmt.Sprintf("%v = %v", builder.name(shardAttrRangeID), builder.value(numberAttr(shardCondition.RangeID))),
+		builder,
+	))
+}
+
+func (db *ddb) insertWorkflowActiveClusterSelectionPolicyRow(
+	t *workflowTransaction,
+	row *nosqlplugin.ActiveClusterSelectionPolicyRow,
+	timeStamp time.Time,
+) {
+	if row == nil || row.Policy == nil {
+		return
+	}
+	item := activeClusterSelectionPolicyKey(row.ShardID, row.DomainID, row.WorkflowID, row.RunID)
+	item[attrData] = binaryAttr(row.Policy.Data)
+	item[execAttrDataEncoding] = stringAttr(row.Policy.GetEncodingString())
+	item[execAttrLastUpdated] = numberAttr(unixNano(timeStamp))
+	t.add(workflowTransactionOp{}, db.newTransactPut(tableExecution, item, "", nil))
+}
+
+func (db *ddb) insertOrUpsertWorkflowRequestRow(
+	t *workflowTransaction,
+	requests *nosqlplugin.WorkflowRequestsWriteRequest,
+	timeStamp time.Time,
+) error {
+	if requests == nil {
+		return nil
+	}
+	switch requests.WriteMode {
+	case nosqlplugin.WorkflowRequestWriteModeInsert, nosqlplugin.WorkflowRequestWriteModeUpsert:
+	default:
+		return fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+	}
+
+	for _, row := range requests.Rows {
+		item := workflowRequestKey(row)
+		item[execAttrRunID] = stringAttr(row.RunID)
+		item[execAttrVersion] = numberAttr(row.Version)
+		item[execAttrLastUpdated] = numberAttr(unixNano(timeStamp))
+		item[attrTTL] = ttlAttr(timeStamp, workflowRequestTTLInSeconds)
+
+		op := workflowTransactionOp{kind: workflowTransactionOpRequest, requestType: row.RequestType}
+		if requests.WriteMode == nosqlplugin.WorkflowRequestWriteModeUpsert {
+			t.add(op, db.newTransactPut(tableExecution, item, "", nil))
+			continue
+		}
+		// expired items are not deleted immediately, so they are treated as non-existing
+		builder := newExpressionBuilder()
+		condition := fmt.Sprintf("attribute_not_exists(%v) OR %v < %v",
+			builder.name(attrPK), builder.name(attrTTL), builder.value(numberAttr(timeStamp.Unix())))
+		t.add(op, db.newTransactPut(tableExecution, item, condition, builder))
+	}
+	return nil
+}
+
+func (db *ddb) createOrUpdateCurrentWorkflow(
+	t *workflowTransaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+	timeStamp time.Time,
+) error {
+	item := currentWorkflowKey(shardID, domainID, workflowID)
+	item[attrShardIndexPK] = stringAttr(shardIndexPartitionKey(shardID, shardIndexCurrent))
+	item[attrShardIndexSK] = stringAttr(executionPartitionKey(shardID, domainID, workflowID))
+	item[execAttrDomainID] = stringAttr(domainID)
+	item[execAttrWorkflowID] = stringAttr(workflowID)
+	item[execAttrCurrentRunID] = stringAttr(request.Row.RunID)
+	item[execAttrCreateRequestID] = stringAttr(request.Row.CreateRequestID)
+	item[execAttrState] = numberAttr(int64(request.Row.State))
+	item[execAttrCloseStatus] = numberAttr(int64(request.Row.CloseStatus))
+	item[execAttrLastWriteVersion] = numberAttr(request.Row.LastWriteVersion)
+	item[execAttrLastUpdated] = numberAttr(unixNano(timeStamp))
+
+	op := workflowTransactionOp{kind: workflowTransactionOpCurrentWorkflow}
+	builder := newExpressionBuilder()
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeNoop:
+		return nil
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		condition := fmt.Sprintf("attribute_not_exists(%v)", builder.name(attrPK))
+		t.add(op, db.newTransactPut(tableExecution, item, condition, builder))
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		conditions := []string{
+			fmt.Sprintf("%v = %v", builder.name(execAttrCurrentRunID), builder.value(stringAttr(*request.Condition.CurrentRunID))),
+		}
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			conditions = append(conditions,
+				fmt.Sprintf("%v = %v", builder.name(execAttrLastWriteVersion), builder.value(numberAttr(*request.Condition.LastWriteVersion))),
+				fmt.Sprintf("%v = %v", builder.name(execAttrState), builder.value(numberAttr(int64(*request.Condition.State)))),
+			)
+		}
+		t.add(op, db.newTransactPut(tableExecution, item, strings.Join(conditions, " AND "), builder))
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+	return nil
+}
+
+func (db *ddb) createWorkflowExecutionWithMergeMaps(
+	t *workflowTransaction,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	timeStamp time.Time,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	item := workflowExecutionKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	attributes, err := newWorkflowExecutionAttributes(execution, timeStamp)
+	if err != nil {
+		return err
+	}
+	for name, value := range attributes {
+		item[name] = value
+	}
+	parts, err := newWorkflowExecutionParts(execution)
+	if err != nil {
+		return err
+	}
+	item[execAttrSignalRequested] = mapAttr(newSignalRequestedEntries(execution.SignalRequestedIDs))
+	item[execAttrWorkflowTimerTasks] = mapAttr(newWorkflowTimerTaskEntries(execution.WorkflowTimerTasks))
+	item[attrShardIndexPK] = stringAttr(shardIndexPartitionKey(shardID, shardIndexRun))
+	item[attrShardIndexSK] = stringAttr(fmt.Sprintf("%v#%v", executionPartitionKey(shardID, execution.DomainID, execution.WorkflowID), execution.RunID))
+
+	builder := newExpressionBuilder()
+	condition := fmt.Sprintf("attribute_not_exists(%v)", builder.name(attrPK))
+	t.add(workflowTransactionOp{kind: workflowTransactionOpCreateExecution, runID: execution.RunID},
+		db.newTransactPut(tableExecution, item, condition, builder))
+	db.putWorkflowExecutionParts(t, shardID, execution, parts, timeStamp)
+	return nil
+}
+
+func (db *ddb) updateWorkflowExecutionAndEventBufferWithMergeAndDeleteMaps(
+	t *workflowTransaction,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	timeStamp time.Time,
+) error {
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	builder := newExpressionBuilder()
+	update := newUpdateExpression(builder)
+	if err := setWorkflowExecutionAttributes(update, execution, timeStamp); err != nil {
+		return err
+	}
+
+	updateSignalsRequested(update, execution.SignalRequestedIDs, execution.SignalRequestedIDsKeysToDelete)
+	appendWorkflowTimerTasks(update, execution.WorkflowTimerTasks)
+	db.addUpdateWorkflowExecution(t, shardID, execution, update, builder)
+
+	partUpdates := map[string]func(*updateExpression) error{
+		execAttrActivityMap: func(u *updateExpression) error {
+			return updateJSONMap(u, execAttrActivityMap, execution.ActivityInfos, execution.ActivityInfoKeysToDelete)
+		},
+		execAttrTimerMap: func(u *updateExpression) error {
+			return updateJSONMap(u, execAttrTimerMap, execution.TimerInfos, execution.TimerInfoKeysToDelete)
+		},
+		execAttrChildExecutionMap: func(u *updateExpression) error {
+			return updateJSONMap(u, execAttrChildExecutionMap, execution.ChildWorkflowInfos, execution.ChildWorkflowInfoKeysToDelete)
+		},
+		execAttrRequestCancelMap: func(u *updateExpression) error {
+			return updateJSONMap(u, execAttrRequestCancelMap, execution.RequestCancelInfos, execution.RequestCancelInfoKeysToDelete)
+		},
+		execAttrSignalMap: func(u *updateExpression) error {
+			return updateJSONMap(u, execAttrSignalMap, execution.SignalInfos, execution.SignalInfoKeysToDelete)
+		},
+		execAttrBufferedEvents: func(u *updateExpression) error {
+			switch execution.EventBufferWriteMode {
+			case nosqlplugin.EventBufferWriteModeClear:
+				u.set(execAttrBufferedEvents, listAttr(nil))
+			case nosqlplugin.EventBufferWriteModeAppend:
+				u.appendList(execAttrBufferedEvents, newBufferedEventsEntry(execution.NewBufferedEventBatch))
+			}
+			return nil
+		},
+	}
+	for _, part := range workflowExecutionParts {
+		if err := db.updateWorkflowExecutionPart(t, shardID, execution, part, partUpdates[part]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *ddb) resetWorkflowExecutionAndMapsAndEventBuffer(
+	t *workflowTransaction,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	timeStamp time.Time,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeReset")
+	}
+
+	builder := newExpressionBuilder()
+	update := newUpdateExpression(builder)
+	if err := setWorkflowExecutionAttributes(update, execution, timeStamp); err != nil {
+		return err
+	}
+	update.set(execAttrSignalRequested, mapAttr(newSignalRequestedEntries(execution.SignalRequestedIDs)))
+	appendWorkflowTimerTasks(update, execution.WorkflowTimerTasks)
+	db.addUpdateWorkflowExecution(t, shardID, execution, update, builder)
+
+	// the parts are replaced as a whole, which also clears the buffered events
+	parts, err := newWorkflowExecutionParts(execution)
+	if err != nil {
+		return err
+	}
+	db.putWorkflowExecutionParts(t, shardID, execution, parts, timeStamp)
+	return nil
+}
+
+// putWorkflowExecutionParts adds the puts of the part items of a run, the parts are only written together with
+// the execution info item, which carries the condition of the run
+func (db *ddb) putWorkflowExecutionParts(
+	t *workflowTransaction,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	parts attributeMap,
+	timeStamp time.Time,
+) {
+	for _, part := range workflowExecutionParts {
+		item := workflowExecutionPartKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID, part)
+		item[part] = parts[part]
+		item[execAttrLastUpdated] = numberAttr(unixNano(timeStamp))
+		t.add(workflowTransactionOp{}, db.newTransactPut(tableExecution, item, "", nil))
+	}
+}
+
+// updateWorkflowExecutionPart adds the update of a part item of a run, the part is skipped if it's not changed
+func (db *ddb) updateWorkflowExecutionPart(
+	t *workflowTransaction,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	part string,
+	updatePart func(*updateExpression) error,
+) error {
+	builder := newExpressionBuilder()
+	update := newUpdateExpression(builder)
+	if err := updatePart(update); err != nil {
+		return err
+	}
+	if update.isEmpty() {
+		return nil
+	}
+	update.set(execAttrLastUpdated, numberAttr(unixNano(execution.CurrentTimeStamp)))
+	t.add(workflowTransactionOp{}, db.newTransactUpdate(
+		tableExecution,
+		workflowExecutionPartKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID, part),
+		update.String(),
+		"",
+		builder,
+	))
+	return nil
+}
+
+// addUpdateWorkflowExecution adds the update of an existing run, conditioned on the previous next event ID
+func (db *ddb) addUpdateWorkflowExecution(
+	t *workflowTransaction,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	update *updateExpression,
+	builder *expressionBuilder,
+) {
+	var previousNextEventID int64
+	if execution.PreviousNextEventIDCondition != nil {
+		previousNextEventID = *execution.PreviousNextEventIDCondition
+	}
+	condition := fmt.Sprintf("%v = %v", builder.name(execAttrNextEventID), builder.value(numberAttr(previousNextEventID)))
+	t.add(workflowTransactionOp{kind: workflowTransactionOpUpdateExecution, runID: execution.RunID}, db.newTransactUpdate(
+		tableExecution,
+		workflowExecutionKey(shardID, execution.DomainID, execution.WorkflowID, execution.RunID),
+		update.String(),
+		condition,
+		builder,
+	))
+}
+
+// newWorkflowExecutionAttributes returns the attributes of a run except the maps and buffered events
+func newWorkflowExecutionAttributes(execution *nosqlplugin.WorkflowExecutionRequest, timeStamp time.Time) (attributeMap, error) {
+	info, err := jsonAttr(&execution.InternalWorkflowExecutionInfo)
+	if err != nil {
+		return nil, err
+	}
+	checksumAttr, err := jsonAttr(execution.Checksums)
+	if err != nil {
+		return nil, err
+	}
+	attributes := attributeMap{
+		execAttrDomainID:         stringAttr(execution.DomainID),
+		execAttrWorkflowID:       stringAttr(execution.WorkflowID),
+		execAttrRunID:            stringAttr(execution.RunID),
+		execAttrNextEventID:      numberAttr(execution.NextEventID),
+		execAttrLastWriteVersion: numberAttr(execution.LastWriteVersion),
+		execAttrInfo:             info,
+		execAttrChecksum:         checksumAttr,
+		execAttrLastUpdated:      numberAttr(unixNano(timeStamp)),
+	}
+	if execution.VersionHistories != nil {
+		attributes[execAttrVersionHistories] = binaryAttr(execution.VersionHistories.Data)
+		attributes[execAttrVersionHistoriesEncoding] = stringAttr(execution.VersionHistories.GetEncodingString())
+	} else {
+		attributes[execAttrVersionHistories] = binaryAttr(nil)
+		attributes[execAttrVersionHistoriesEncoding] = stringAttr("")
+	}
+	return attributes, nil
+}
+
+func setWorkflowExecutionAttributes(update *updateExpression, execution *nosqlplugin.WorkflowExecutionRequest, timeStamp time.Time) error {
+	attributes, err := newWorkflowExecutionAttributes(execution, timeStamp)
+	if err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(attributes) {
+		update.set(name, attributes[name])
+	}
+	return nil
+}
+
+// newWorkflowExecutionParts returns the five maps and the empty buffered events as a whole
+func newWorkflowExecutionParts(execution *nosqlplugin.WorkflowExecutionRequest) (attributeMap, error) {
+	activityMap, err := newJSONMap(execution.ActivityInfos)
+	if err != nil {
+		return nil, err
+	}
+	timerMap, err := newJSONMap(execution.TimerInfos)
+	if err != nil {
+		return nil, err
+	}
+	childExecutionMap, err := newJSONMap(execution.ChildWorkflowInfos)
+	if err != nil {
+		return nil, err
+	}
+	requestCancelMap, err := newJSONMap(execution.RequestCancelInfos)
+	if err != nil {
+		return nil, err
+	}
+	signalMap, err := newJSONMap(execution.SignalInfos)
+	if err != nil {
+		return nil, err
+	}
+	return attributeMap{
+		execAttrActivityMap:       mapAttr(activityMap),
+		execAttrTimerMap:          mapAttr(timerMap),
+		execAttrChildExecutionMap: mapAttr(childExecutionMap),
+		execAttrRequestCancelMap:  mapAttr(requestCancelMap),
+		execAttrSignalMap:         mapAttr(signalMap),
+		execAttrBufferedEvents:    listAttr(nil),
+	}, nil
+}
+
+func newSignalRequestedEntries(signalRequestedIDs []string) attributeMap {
+	entries := make(attributeMap, len(signalRequestedIDs))
+	for _, id := range signalRequestedIDs {
+		entries[id] = boolAttr(true)
+	}
+	return entries
+}
+
+func newJSONMap[K comparable, V any](values map[K]V) (attributeMap, error) {
+	result := make(attributeMap, len(values))
+	for key, value := range values {
+		av, err := jsonAttr(value)
+		if err != nil {
+			return nil, err
+		}
+		result[fmt.Sprint(key)] = av
+	}
+	return result, nil
+}
+
+func updateJSONMap[K comparable, V any](update *updateExpression, mapName string, values map[K]V, keysToDelete []K) error {
+	entries, err := newJSONMap(values)
+	if err != nil {
+		return err
+	}
+	for _, key := range sortedKeys(entries) {
+		update.setEntry(mapName, key, entries[key])
+	}
+	for _, key := range keysToDelete {
+		// the same entry can't be both updated and removed within one update expression
+		if _, ok := values[key]; ok {
+			continue
+		}
+		update.removeEntry(mapName, fmt.Sprint(key))
+	}
+	return nil
+}
+
+func parseJSONMap[K comparable, V any](item attributeMap, mapName string, parseKey func(string) (K, error)) (map[K]*V, error) {
+	result := make(map[K]*V)
+	for key, av := range getMap(item, mapName) {
+		k, err := parseKey(key)
+		if err != nil {
+			return nil, err
+		}
+		v := new(V)
+		if len(av.B) > 0 {
+			if err := json.Unmarshal(av.B, v); err != nil {
+				return nil, err
+			}
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+func parseInt64Key(key string) (int64, error) {
+	return strconv.ParseInt(key, 10, 64)
+}
+
+func parseStringKey(key string) (string, error) {
+	return key, nil
+}
+
+func updateSignalsRequested(update *updateExpression, signalRequestedIDs []string, signalRequestedIDsToDelete []string) {
+	added := make(map[string]struct{}, len(signalRequestedIDs))
+	for _, id := range signalRequestedIDs {
+		if _, ok := added[id]; ok {
+			continue
+		}
+		added[id] = struct{}{}
+		update.setEntry(execAttrSignalRequested, id, boolAttr(true))
+	}
+	for _, id := range signalRequestedIDsToDelete {
+		if _, ok := added[id]; ok {
+			continue
+		}
+		update.removeEntry(execAttrSignalRequested, id)
+	}
+}
+
+// workflow timer tasks are stored as the keys of a map, which dedupes the identical keys like the Cassandra set
+func workflowTimerTaskEntryKey(key persistence.HistoryTaskKey) string {
+	return fmt.Sprintf("%v_%v", toDBTimestamp(key.GetScheduledTime()), key.GetTaskID())
+}
+
+func newWorkflowTimerTaskEntries(timerTasks []persistence.HistoryTaskKey) attributeMap {
+	entries := make(attributeMap, len(timerTasks))
+	for _, key := range timerTasks {
+		entries[workflowTimerTaskEntryKey(key)] = boolAttr(true)
+	}
+	return entries
+}
+
+func appendWorkflowTimerTasks(update *updateExpression, timerTasks []persistence.HistoryTaskKey) {
+	entries := newWorkflowTimerTaskEntries(timerTasks)
+	for _, key := range sortedKeys(entries) {
+		update.setEntry(execAttrWorkflowTimerTasks, key, entries[key])
+	}
+}
+
+func parseWorkflowTimerTasks(item attributeMap) ([]persistence.HistoryTaskKey, error) {
+	entries := getMap(item, execAttrWorkflowTimerTasks)
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	keys := make([]persistence.HistoryTaskKey, 0, len(entries))
+	for entry := range entries {
+		parts := strings.SplitN(entry, "_", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("corrupted workflow timer task entry %v", entry)
+		}
+		ts, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		taskID, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, persistence.NewHistoryTaskKey(fromDBTimestamp(ts), taskID))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Less(keys[j])
+	})
+	return keys, nil
+}
+
+func newBufferedEventsEntry(blob *persistence.DataBlob) *dynamodb.AttributeValue {
+	return mapAttr(attributeMap{
+		attrData:             binaryAttr(blob.Data),
+		execAttrDataEncoding: stringAttr(blob.GetEncodingString()),
+	})
+}
+
+func parseWorkflowExecutionInfo(item attributeMap) (*persistence.InternalWorkflowExecutionInfo, error) {
+	info := &persistence.InternalWorkflowExecutionInfo{}
+	if err := getJSON(item, execAttrInfo, info); err != nil {
+		return nil, err
+	}
+	info.CompletionEvent = normalizeDataBlob(info.CompletionEvent)
+	info.AutoResetPoints = normalizeDataBlob(info.AutoResetPoints)
+	info.ActiveClusterSelectionPolicy = normalizeDataBlob(info.ActiveClusterSelectionPolicy)
+	return info, nil
+}
+
+// mergeWorkflowExecutionItems merges the part items of a run into its execution info item
+func mergeWorkflowExecutionItems(items []attributeMap) attributeMap {
+	if len(items) == 0 || len(items[0]) == 0 {
+		return nil
+	}
+	merged := make(attributeMap, len(items[0])+len(workflowExecutionParts))
+	for name, value := range items[0] {
+		merged[name] = value
+	}
+	for _, item := range items[1:] {
+		for _, part := range workflowExecutionParts {
+			if value, ok := item[part]; ok {
+				merged[part] = value
+			}
+		}
+	}
+	return merged
+}
+
+func parseWorkflowExecution(item attributeMap) (*nosqlplugin.WorkflowExecution, error) {
+	info, err := parseWorkflowExecutionInfo(item)
+	if err != nil {
+		return nil, err
+	}
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:    info,
+		VersionHistories: toDataBlob(getBytes(item, execAttrVersionHistories), getString(item, execAttrVersionHistoriesEncoding)),
+	}
+
+	if state.ActivityInfos, err = parseJSONMap[int64, persistence.InternalActivityInfo](item, execAttrActivityMap, parseInt64Key); err != nil {
+		return nil, err
+	}
+	if state.TimerInfos, err = parseJSONMap[string, persistence.TimerInfo](item, execAttrTimerMap, parseStringKey); err != nil {
+		return nil, err
+	}
+	if state.ChildExecutionInfos, err = parseJSONMap[int64, persistence.InternalChildExecutionInfo](item, execAttrChildExecutionMap, parseInt64Key); err != nil {
+		return nil, err
+	}
+	if state.RequestCancelInfos, err = parseJSONMap[int64, persistence.RequestCancelInfo](item, execAttrRequestCancelMap, parseInt64Key); err != nil {
+		return nil, err
+	}
+	if state.SignalInfos, err = parseJSONMap[int64, persistence.SignalInfo](item, execAttrSignalMap, parseInt64Key); err != nil {
+		return nil, err
+	}
+
+	state.SignalRequestedIDs = make(map[string]struct{})
+	for id := range getMap(item, execAttrSignalRequested) {
+		state.SignalRequestedIDs[id] = struct{}{}
+	}
+
+	events := getList(item, execAttrBufferedEvents)
+	state.BufferedEvents = make([]*persistence.DataBlob, 0, len(events))
+	for _, event := range events {
+		state.BufferedEvents = append(state.BufferedEvents, &persistence.DataBlob{
+			Data:     getBytes(event.M, attrData),
+			Encoding: constants.EncodingType(getString(event.M, execAttrDataEncoding)),
+		})
+	}
+
+	var cs *checksum.Checksum
+	if err := getJSON(item, execAttrChecksum, &cs); err != nil {
+		return nil, err
+	}
+	if cs != nil {
+		state.Checksum = *cs
+	}
+	return state, nil
+}
+
+// newHistoryTaskItem returns the item of a history task, the legacy task info is kept as JSON
+func newHistoryTaskItem(pk, sk string, task *nosqlplugin.HistoryMigrationTask, legacyInfo interface{}, visibilityTimestamp int64, timeStamp time.Time) (attributeMap, error) {
+	info, err := jsonAttr(legacyInfo)
+	if err != nil {
+		return nil, err
+	}
+	item := itemKey(pk, sk)
+	item[historyTaskAttrTaskID] = numberAttr(task.TaskID)
+	item[historyTaskAttrVisibilityTS] = numberAttr(visibilityTimestamp)
+	item[historyTaskAttrInfo] = info
+	item[historyTaskAttrCreatedTime] = numberAttr(unixNano(timeStamp))
+	if task.Task != nil && len(task.Task.Data) > 0 {
+		item[attrData] = binaryAttr(task.Task.Data)
+		item[historyTaskAttrDataEncoding] = stringAttr(task.Task.GetEncodingString())
+	}
+	return item, nil
+}
+
+// newHistoryTaskItems returns the items of the history tasks, the tasks of the categories other than
+// transfer, timer and replication are not supported yet, same as the Cassandra plugin
+func newHistoryTaskItems(
+	shardID int,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+	timeStamp time.Time,
+) ([]attributeMap, error) {
+	var items []attributeMap
+	for c, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			var item attributeMap
+			var err error
+			switch c.ID() {
+			case persistence.HistoryTaskCategoryIDTransfer:
+				item, err = newHistoryTaskItem(historyTaskPartitionKey(shardID, historyTaskTypeTransfer), encodeInt64(task.Transfer.TaskID),
+					task, task.Transfer, 0, timeStamp)
+			case persistence.HistoryTaskCategoryIDTimer:
+				ts := toDBTimestamp(task.Timer.VisibilityTimestamp)
+				item, err = newHistoryTaskItem(historyTaskPartitionKey(shardID, historyTaskTypeTimer), timerTaskSortKey(ts, task.Timer.TaskID),
+					task, task.Timer, ts, timeStamp)
+			case persistence.HistoryTaskCategoryIDReplication:
+				item, err = newHistoryTaskItem(historyTaskPartitionKey(shardID, historyTaskTypeReplication), encodeInt64(task.Replication.TaskID),
+					task, task.Replication, 0, timeStamp)
+			default:
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func parseTransferTask(item attributeMap) (*nosqlplugin.HistoryMigrationTask, error) {
+	var info *nosqlplugin.TransferTask
+	if err := getJSON(item, historyTaskAttrInfo, &info); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryMigrationTask{
+		Transfer: info,
+		Task:     toDataBlob(getBytes(item, attrData), getString(item, historyTaskAttrDataEncoding)),
+		TaskID:   getInt64(item, historyTaskAttrTaskID),
+	}, nil
+}
+
+func parseTimerTask(item attributeMap) (*nosqlplugin.HistoryMigrationTask, error) {
+	var info *nosqlplugin.TimerTask
+	if err := getJSON(item, historyTaskAttrInfo, &info); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryMigrationTask{
+		Timer:         info,
+		Task:          toDataBlob(getBytes(item, attrData), getString(item, historyTaskAttrDataEncoding)),
+		TaskID:        getInt64(item, historyTaskAttrTaskID),
+		ScheduledTime: fromDBTimestamp(getInt64(item, historyTaskAttrVisibilityTS)),
+	}, nil
+}
+
+func parseReplicationTask(item attributeMap) (*nosqlplugin.HistoryMigrationTask, error) {
+	var info *nosqlplugin.ReplicationTask
+	if err := getJSON(item, historyTaskAttrInfo, &info); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryMigrationTask{
+		Replication: info,
+		Task:        toDataBlob(getBytes(item, attrData), getString(item, historyTaskAttrDataEncoding)),
+		TaskID:      getInt64(item, historyTaskAttrTaskID),
+	}, nil
+}
+
+func (db *ddb) newCreateWorkflowConditionFailure(
+	reasons []*dynamodb.CancellationReason,
+	ops []workflowTransactionOp,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	requestConditionalRunID := ""
+	if currentWorkflowRequest.Condition != nil {
+		requestConditionalRunID = currentWorkflowRequest.Condition.GetCurrentRunID()
+	}
+	// Unlike Cassandra, DynamoDB tells exactly which items failed the condition check,
+	// and returns the existing items with ReturnValuesOnConditionCheckFailure
+	rangeIDMismatch := false
+	actualRangeID := int64(0)
+	var actualCurrentWorkflow attributeMap
+	runIDMismatch := false
+	actualCurrRunID := ""
+	lastWriteVersionMismatch := false
+	actualLastWriteVersion := int64(constants.EmptyVersion)
+	stateMismatch := false
+	actualState := 0
+	concreteExecutionAlreadyExists := false
+	var duplicateRequest *nosqlplugin.DuplicateRequest
+	var columns []string
+
+	for i, reason := range reasons {
+		if !isConditionalCheckFailed(reason) {
+			continue
+		}
+		previous := reason.Item
+		switch ops[i].kind {
+		case workflowTransactionOpShard:
+			if actualRangeID = getInt64(previous, shardAttrRangeID); actualRangeID != shardCondition.RangeID {
+				rangeIDMismatch = true
+			}
+		case workflowTransactionOpRequest:
+			duplicateRequest = &nosqlplugin.DuplicateRequest{
+				RequestType: ops[i].requestType,
+				RunID:       getString(previous, execAttrRunID),
+			}
+		case workflowTransactionOpCurrentWorkflow:
+			if currentWorkflowRequest.WriteMode == nosqlplugin.CurrentWorkflowWriteModeInsert {
+				actualCurrentWorkflow = previous
+				break
+			}
+			if actualCurrRunID = getString(previous, execAttrCurrentRunID); requestConditionalRunID != "" && actualCurrRunID != requestConditionalRunID {
+				runIDMismatch = true
+			}
+			if currentWorkflowRequest.Condition != nil && currentWorkflowRequest.Condition.LastWriteVersion != nil {
+				if actualLastWriteVersion = getInt64(previous, execAttrLastWriteVersion); *currentWorkflowRequest.Condition.LastWriteVersion != actualLastWriteVersion {
+					lastWriteVersionMismatch = true
+				}
+			}
+			if currentWorkflowRequest.Condition != nil && currentWorkflowRequest.Condition.State != nil {
+				if actualState = int(getInt64(previous, execAttrState)); *currentWorkflowRequest.Condition.State != actualState {
+					stateMismatch = true
+				}
+			}
+		case workflowTransactionOpCreateExecution:
+			if ops[i].runID == execution.RunID {
+				concreteExecutionAlreadyExists = true
+				actualLastWriteVersion = getInt64(previous, execAttrLastWriteVersion)
+			}
+		}
+		columns = append(columns, describeItem(i, previous)...)
+	}
+
+	if rangeIDMismatch {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(actualRangeID),
+		}
+	}
+	if duplicateRequest != nil {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			DuplicateRequest: duplicateRequest,
+		}
+	}
+	// CreateWorkflowExecution failed because there is already a current execution record for this workflow
+	if actualCurrentWorkflow != nil {
+		runID := getString(actualCurrentWorkflow, execAttrCurrentRunID)
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", currentWorkflowRequest.Row.WorkflowID, runID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  getString(actualCurrentWorkflow, execAttrCreateRequestID),
+				RunID:            runID,
+				State:            int(getInt64(actualCurrentWorkflow, execAttrState)),
+				CloseStatus:      int(getInt64(actualCurrentWorkflow, execAttrCloseStatus)),
+				LastWriteVersion: getInt64(actualCurrentWorkflow, execAttrLastWriteVersion),
+			},
+		}
+	}
+	if runIDMismatch {
+		msg := fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+			currentWorkflowRequest.Row.WorkflowID, currentWorkflowRequest.Condition.GetCurrentRunID(), actualCurrRunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+	if lastWriteVersionMismatch {
+		msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+			currentWorkflowRequest.Row.WorkflowID, *currentWorkflowRequest.Condition.LastWriteVersion, actualLastWriteVersion)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+	if stateMismatch {
+		msg := fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+			currentWorkflowRequest.Row.WorkflowID, *currentWorkflowRequest.Condition.State, actualState)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+	if concreteExecutionAlreadyExists {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", execution.WorkflowID, execution.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: actualLastWriteVersion,
+			},
+		}
+	}
+	return newUnknownConditionFailureReason(shardCondition.RangeID, columns)
+}
+
+func (db *ddb) newUpdateWorkflowConditionFailure(
+	reasons []*dynamodb.CancellationReason,
+	ops []workflowTransactionOp,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	previousNextEventIDCondition int64,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	requestRunID := currentWorkflowRequest.Row.RunID
+	requestConditionalRunID := ""
+	if currentWorkflowRequest.Condition != nil {
+		requestConditionalRunID = currentWorkflowRequest.Condition.GetCurrentRunID()
+	}
+
+	rangeIDMismatch := false
+	actualRangeID := int64(0)
+	nextEventIDMismatch := false
+	actualNextEventID := int64(0)
+	runIDMismatch := false
+	actualCurrRunID := ""
+	var duplicateRequest *nosqlplugin.DuplicateRequest
+	var columns []string
+
+	for i, reason := range reasons {
+		if !isConditionalCheckFailed(reason) {
+			continue
+		}
+		previous := reason.Item
+		switch ops[i].kind {
+		case workflowTransactionOpShard:
+			if actualRangeID = getInt64(previous, shardAttrRangeID); actualRangeID != shardCondition.RangeID {
+				rangeIDMismatch = true
+			}
+		case workflowTransactionOpRequest:
+			duplicateRequest = &nosqlplugin.DuplicateRequest{
+				RequestType: ops[i].requestType,
+				RunID:       getString(previous, execAttrRunID),
+			}
+		case workflowTransactionOpUpdateExecution:
+			if ops[i].runID == requestRunID {
+				if actualNextEventID = getInt64(previous, execAttrNextEventID); actualNextEventID != previousNextEventIDCondition {
+					nextEventIDMismatch = true
+				}
+			}
+		case workflowTransactionOpCurrentWorkflow:
+			if actualCurrRunID = getString(previous, execAttrCurrentRunID); requestConditionalRunID != "" && actualCurrRunID != requestConditionalRunID {
+				runIDMismatch = true
+			}
+		}
+		columns = append(columns, describeItem(i, previous)...)
+	}
+
+	if rangeIDMismatch {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(actualRangeID),
+		}
+	}
+	if duplicateRequest != nil {
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			DuplicateRequest: duplicateRequest,
+		}
+	}
+	if runIDMismatch {
+		msg := fmt.Sprintf("Failed to update mutable state. requestConditionalRunID: %v, Actual Value: %v",
+			requestConditionalRunID, actualCurrRunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+	if nextEventIDMismatch {
+		msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+			previousNextEventIDCondition, actualNextEventID, requestRunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+
+	msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RangeID: %v, previousNextEventIDCondition: %v, requestConditionalRunID: %v, columns: (%v)",
+		shardCondition.ShardID, shardCondition.RangeID, previousNextEventIDCondition, requestConditionalRunID, strings.Join(columns, ","))
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+func newUnknownConditionFailureReason(
+	rangeID int64,
+	columns []string,
+) *nosqlplugin.WorkflowOperationConditionFailure {
+	msg := fmt.Sprintf("Failed to operate on workflow execution.  Request RangeID: %v, columns: (%v)",
+		rangeID, strings.Join(columns, ","))
+
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+// newShardOperationConditionFailure translates the cancellation reasons of a transaction guarded by assertShardRangeID
+func newShardOperationConditionFailure(reasons []*dynamodb.CancellationReason, ops []workflowTransactionOp, shardCondition nosqlplugin.ShardCondition) error {
+	var columns []string
+	for i, reason := range reasons {
+		if !isConditionalCheckFailed(reason) {
+			continue
+		}
+		if ops[i].kind == workflowTransactionOpShard {
+			if actualRangeID, ok := reason.Item[shardAttrRangeID]; ok && numberValue(actualRangeID) != shardCondition.RangeID {
+				return &nosqlplugin.ShardOperationConditionFailure{
+					RangeID: numberValue(actualRangeID),
+				}
+			}
+		}
+		columns = append(columns, describeItem(i, reason.Item)...)
+	}
+
+	// At this point we only know that the write was not applied.
+	// It's much safer to return ShardOperationConditionFailure(which will become ShardOwnershipLostError later) as the default to force the application to reload
+	// shard to recover from such errors
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: -1,
+		Details: strings.Join(columns, ","),
+	}
+}
+
+// describeItem returns the scalar attributes of an item for logging
+func describeItem(index int, item attributeMap) []string {
+	var columns []string
+	for _, name := range sortedKeys(item) {
+		av := item[name]
+		switch {
+		case av.S != nil:
+			columns = append(columns, fmt.Sprintf("%v: %s=%v", index, name, *av.S))
+		case av.N != nil:
+			columns = append(columns, fmt.Sprintf("%v: %s=%v", index, name, *av.N))
+		case av.BOOL != nil:
+			columns = append(columns, fmt.Sprintf("%v: %s=%v", index, name, *av.BOOL))
+		}
+	}
+	return columns
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
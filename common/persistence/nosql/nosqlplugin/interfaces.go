@@ -246,11 +246,18 @@ type (
 	*
 	* NOTE 2: TTL(time to live records) is for auto-deleting expired records in visibility. For databases that don't support TTL,
 	* please implement DeleteVisibility method. If TTL is supported, then DeleteVisibility can be a noop.
+	*
+	* NOTE 3: UpsertVisibility, SelectVisibilityByQuery and CountVisibilityByQuery serve the query based APIs with a subset of
+	* the query language, see VisibilityQuery. Plugins that can't support them should return persistence.ErrVisibilityOperationNotSupported.
 	 */
 	VisibilityCRUD interface {
 		InsertVisibility(ctx context.Context, ttlSeconds int64, row *VisibilityRowForInsert) error
 		UpdateVisibility(ctx context.Context, ttlSeconds int64, row *VisibilityRowForUpdate) error
+		// UpsertVisibility updates the record of an open workflow, e.g. when its search attributes are changed
+		UpsertVisibility(ctx context.Context, ttlSeconds int64, row *VisibilityRowForInsert) error
 		SelectVisibility(ctx context.Context, filter *VisibilityFilter) (*SelectVisibilityResponse, error)
+		SelectVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (*SelectVisibilityResponse, error)
+		CountVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
 		DeleteVisibility(ctx context.Context, domainID, workflowID, runID string) error
 		// TODO deprecated this in the future in favor of SelectVisibility
 		// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
@@ -291,6 +298,14 @@ type (
 		CloseStatus  int32
 	}
 
+	// VisibilityQueryFilter selects the records of a domain matching a parsed visibility query
+	VisibilityQueryFilter struct {
+		DomainID      string
+		Query         *VisibilityQuery
+		PageSize      int
+		NextPageToken []byte
+	}
+
 	VisibilityFilterType int
 	VisibilitySortType   int
 
@@ -163,6 +163,21 @@ func (mr *MockDBMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDB)(nil).Close))
 }
 
+// CountVisibilityByQuery mocks base method.
+func (m *MockDB) CountVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountVisibilityByQuery indicates an expected call of CountVisibilityByQuery.
+func (mr *MockDBMockRecorder) CountVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).CountVisibilityByQuery), ctx, filter)
+}
+
 // DeleteActiveClusterSelectionPolicy mocks base method.
 func (m *MockDB) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
 	m.ctrl.T.Helper()
@@ -1258,6 +1273,21 @@ func (mr *MockDBMockRecorder) SelectVisibility(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectVisibility", reflect.TypeOf((*MockDB)(nil).SelectVisibility), ctx, filter)
 }
 
+// SelectVisibilityByQuery mocks base method.
+func (m *MockDB) SelectVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (*SelectVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(*SelectVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectVisibilityByQuery indicates an expected call of SelectVisibilityByQuery.
+func (mr *MockDBMockRecorder) SelectVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).SelectVisibilityByQuery), ctx, filter)
+}
+
 // SelectWorkflowExecution mocks base method.
 func (m *MockDB) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*WorkflowExecution, error) {
 	m.ctrl.T.Helper()
@@ -1400,6 +1430,20 @@ func (mr *MockDBMockRecorder) UpdateWorkflowExecutionWithTasks(ctx, requests, cu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionWithTasks", reflect.TypeOf((*MockDB)(nil).UpdateWorkflowExecutionWithTasks), ctx, requests, currentWorkflowRequest, mutatedExecution, insertedExecution, activeClusterSelectionPolicyRow, resetExecution, tasksByCategory, shardCondition)
 }
 
+// UpsertVisibility mocks base method.
+func (m *MockDB) UpsertVisibility(ctx context.Context, ttlSeconds int64, row *VisibilityRowForInsert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertVisibility", ctx, ttlSeconds, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertVisibility indicates an expected call of UpsertVisibility.
+func (mr *MockDBMockRecorder) UpsertVisibility(ctx, ttlSeconds, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertVisibility", reflect.TypeOf((*MockDB)(nil).UpsertVisibility), ctx, ttlSeconds, row)
+}
+
 // MocktableCRUD is a mock of tableCRUD interface.
 type MocktableCRUD struct {
 	ctrl     *gomock.Controller
@@ -1424,6 +1468,21 @@ func (m *MocktableCRUD) EXPECT() *MocktableCRUDMockRecorder {
 	return m.recorder
 }
 
+// CountVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) CountVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountVisibilityByQuery indicates an expected call of CountVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) CountVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).CountVisibilityByQuery), ctx, filter)
+}
+
 // DeleteActiveClusterSelectionPolicy mocks base method.
 func (m *MocktableCRUD) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
 	m.ctrl.T.Helper()
@@ -2449,6 +2508,21 @@ func (mr *MocktableCRUDMockRecorder) SelectVisibility(ctx, filter any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectVisibility", reflect.TypeOf((*MocktableCRUD)(nil).SelectVisibility), ctx, filter)
 }
 
+// SelectVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) SelectVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (*SelectVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(*SelectVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectVisibilityByQuery indicates an expected call of SelectVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) SelectVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).SelectVisibilityByQuery), ctx, filter)
+}
+
 // SelectWorkflowExecution mocks base method.
 func (m *MocktableCRUD) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*WorkflowExecution, error) {
 	m.ctrl.T.Helper()
@@ -2591,6 +2665,20 @@ func (mr *MocktableCRUDMockRecorder) UpdateWorkflowExecutionWithTasks(ctx, reque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionWithTasks", reflect.TypeOf((*MocktableCRUD)(nil).UpdateWorkflowExecutionWithTasks), ctx, requests, currentWorkflowRequest, mutatedExecution, insertedExecution, activeClusterSelectionPolicyRow, resetExecution, tasksByCategory, shardCondition)
 }
 
+// UpsertVisibility mocks base method.
+func (m *MocktableCRUD) UpsertVisibility(ctx context.Context, ttlSeconds int64, row *VisibilityRowForInsert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertVisibility", ctx, ttlSeconds, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertVisibility indicates an expected call of UpsertVisibility.
+func (mr *MocktableCRUDMockRecorder) UpsertVisibility(ctx, ttlSeconds, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertVisibility", reflect.TypeOf((*MocktableCRUD)(nil).UpsertVisibility), ctx, ttlSeconds, row)
+}
+
 // MockClientErrorChecker is a mock of ClientErrorChecker interface.
 type MockClientErrorChecker struct {
 	ctrl     *gomock.Controller
@@ -3171,6 +3259,21 @@ func (m *MockVisibilityCRUD) EXPECT() *MockVisibilityCRUDMockRecorder {
 	return m.recorder
 }
 
+// CountVisibilityByQuery mocks base method.
+func (m *MockVisibilityCRUD) CountVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountVisibilityByQuery indicates an expected call of CountVisibilityByQuery.
+func (mr *MockVisibilityCRUDMockRecorder) CountVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountVisibilityByQuery", reflect.TypeOf((*MockVisibilityCRUD)(nil).CountVisibilityByQuery), ctx, filter)
+}
+
 // DeleteVisibility mocks base method.
 func (m *MockVisibilityCRUD) DeleteVisibility(ctx context.Context, domainID, workflowID, runID string) error {
 	m.ctrl.T.Helper()
@@ -3229,6 +3332,21 @@ func (mr *MockVisibilityCRUDMockRecorder) SelectVisibility(ctx, filter any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectVisibility", reflect.TypeOf((*MockVisibilityCRUD)(nil).SelectVisibility), ctx, filter)
 }
 
+// SelectVisibilityByQuery mocks base method.
+func (m *MockVisibilityCRUD) SelectVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (*SelectVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(*SelectVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectVisibilityByQuery indicates an expected call of SelectVisibilityByQuery.
+func (mr *MockVisibilityCRUDMockRecorder) SelectVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectVisibilityByQuery", reflect.TypeOf((*MockVisibilityCRUD)(nil).SelectVisibilityByQuery), ctx, filter)
+}
+
 // UpdateVisibility mocks base method.
 func (m *MockVisibilityCRUD) UpdateVisibility(ctx context.Context, ttlSeconds int64, row *VisibilityRowForUpdate) error {
 	m.ctrl.T.Helper()
@@ -3243,6 +3361,20 @@ func (mr *MockVisibilityCRUDMockRecorder) UpdateVisibility(ctx, ttlSeconds, row
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockVisibilityCRUD)(nil).UpdateVisibility), ctx, ttlSeconds, row)
 }
 
+// UpsertVisibility mocks base method.
+func (m *MockVisibilityCRUD) UpsertVisibility(ctx context.Context, ttlSeconds int64, row *VisibilityRowForInsert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertVisibility", ctx, ttlSeconds, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertVisibility indicates an expected call of UpsertVisibility.
+func (mr *MockVisibilityCRUDMockRecorder) UpsertVisibility(ctx, ttlSeconds, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertVisibility", reflect.TypeOf((*MockVisibilityCRUD)(nil).UpsertVisibility), ctx, ttlSeconds, row)
+}
+
 // MockTaskCRUD is a mock of TaskCRUD interface.
 type MockTaskCRUD struct {
 	ctrl     *gomock.Controller
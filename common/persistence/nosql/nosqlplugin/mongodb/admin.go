@@ -65,7 +65,7 @@ func (db *mdb) SetupTestDatabase(schemaBaseDir string, replicas int) error {
 }
 
 func (db *mdb) TeardownTestDatabase() error {
-	result := db.dbConn.RunCommand(context.Background(), bson.D{{"dropDatabase", 1}})
+	result := db.dbConn.RunCommand(context.Background(), bson.D{{Key: "dropDatabase", Value: 1}})
 	err := result.Err()
 	return err
 }
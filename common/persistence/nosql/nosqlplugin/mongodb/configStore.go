@@ -51,9 +51,9 @@ func (db *mdb) InsertConfig(ctx context.Context, row *persistence.InternalConfig
 }
 
 func (db *mdb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	filter := bson.D{{"rowtype", rowType}}
+	filter := bson.D{{Key: "rowtype", Value: rowType}}
 	queryOptions := options.FindOneOptions{}
-	queryOptions.SetSort(bson.D{{"version", -1}})
+	queryOptions.SetSort(bson.D{{Key: "version", Value: -1}})
 
 	collection := db.dbConn.Collection(cadence.ClusterConfigCollectionName)
 	var result cadence.ClusterConfigCollectionEntry
@@ -22,17 +22,68 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+// domainMetadataID is the _id of the single document of the domain metadata collection
+const domainMetadataID = "metadata"
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	domain := *row
+	domain.FailoverNotificationVersion = persistence.InitialFailoverNotificationVersion
+	domain.PreviousFailoverVersion = constants.InitialPreviousFailoverVersion
+	domain.NotificationVersion = metadataNotificationVersion
+	doc, err := newDomainEntry(&domain)
+	if err != nil {
+		return err
+	}
+
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		collection := db.dbConn.Collection(cadence.DomainCollectionName)
+		var existing cadence.DomainCollectionEntry
+		found, err := findOne(sc, collection, bson.D{{Key: "domainid", Value: row.Info.ID}}, &existing)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+		}
+		found, err = findOne(sc, collection, bson.D{{Key: "name", Value: row.Info.Name}}, &existing)
+		if err != nil {
+			return err
+		}
+		if found {
+			db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+			return &types.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+			}
+		}
+		if _, err := collection.InsertOne(sc, doc); err != nil {
+			return err
+		}
+		return db.updateDomainMetadata(sc, metadataNotificationVersion)
+	})
 }
 
 // Update domain
@@ -40,7 +91,17 @@ func (db *mdb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	doc, err := newDomainEntry(row)
+	if err != nil {
+		return err
+	}
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		_, err := db.dbConn.Collection(cadence.DomainCollectionName).ReplaceOne(sc, bson.D{{Key: "name", Value: row.Info.Name}}, doc)
+		if err != nil {
+			return err
+		}
+		return db.updateDomainMetadata(sc, row.NotificationVersion)
+	})
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +110,22 @@ func (db *mdb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	var filter bson.D
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID != nil {
+		filter = bson.D{{Key: "domainid", Value: *domainID}}
+	} else if domainName != nil {
+		filter = bson.D{{Key: "name", Value: *domainName}}
+	} else {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	var doc cadence.DomainCollectionEntry
+	if err := db.dbConn.Collection(cadence.DomainCollectionName).FindOne(ctx, filter).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return parseDomainEntry(&doc)
 }
 
 // Get all domain data
@@ -58,7 +134,27 @@ func (db *mdb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage[cadence.DomainCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.DomainCollectionName),
+		bson.D{},
+		bson.D{{Key: "name", Value: 1}},
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(docs))
+	for _, doc := range docs {
+		row, err := parseDomainEntry(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
 // Delete a domain, either by domainID or domainName
@@ -67,11 +163,95 @@ func (db *mdb) DeleteDomain(
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	var filter bson.D
+	if domainName != nil {
+		filter = bson.D{{Key: "name", Value: *domainName}}
+	} else if domainID != nil {
+		filter = bson.D{{Key: "domainid", Value: *domainID}}
+	} else {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+	_, err := db.dbConn.Collection(cadence.DomainCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 func (db *mdb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	var doc cadence.DomainMetadataCollectionEntry
+	found, err := findOne(ctx, db.dbConn.Collection(cadence.DomainMetadataCollectionName), bson.D{{Key: "_id", Value: domainMetadataID}}, &doc)
+	if err != nil {
+		return -1, err
+	}
+	if !found {
+		// the metadata document doesn't exist until the first domain is created
+		return 0, nil
+	}
+	return doc.NotificationVersion, nil
+}
+
+// updateDomainMetadata bumps the notification version of the domain metadata document within a transaction,
+// on the condition that the current version is still notificationVersion
+func (db *mdb) updateDomainMetadata(sc mongo.SessionContext, notificationVersion int64) error {
+	collection := db.dbConn.Collection(cadence.DomainMetadataCollectionName)
+	if notificationVersion == 0 {
+		_, err := collection.InsertOne(sc, &cadence.DomainMetadataCollectionEntry{
+			ID:                  domainMetadataID,
+			NotificationVersion: 1,
+		})
+		if mongo.IsDuplicateKeyError(err) {
+			db.logger.Warn("Domain operation failed because of condition update failure on domain metadata record")
+			return nosqlplugin.NewConditionFailure("domain")
+		}
+		return err
+	}
+
+	result, err := collection.UpdateOne(
+		sc,
+		bson.D{{Key: "_id", Value: domainMetadataID}, {Key: "notificationversion", Value: notificationVersion}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "notificationversion", Value: notificationVersion + 1}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		db.logger.Warn("Domain operation failed because of condition update failure on domain metadata record")
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
+}
+
+func newDomainEntry(row *nosqlplugin.DomainRow) (*cadence.DomainCollectionEntry, error) {
+	domain := *row
+	domain.CurrentTimeStamp = time.Time{}
+	data, err := toJSON(&domain)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.DomainCollectionEntry{
+		DomainID: row.Info.ID,
+		Name:     row.Info.Name,
+		Data:     data,
+	}, nil
+}
+
+func parseDomainEntry(doc *cadence.DomainCollectionEntry) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := fromJSON(doc.Data, row); err != nil {
+		return nil, err
+	}
+	if row.Info == nil {
+		row.Info = &persistence.DomainInfo{}
+	}
+	if row.Config == nil {
+		row.Config = &persistence.InternalDomainConfig{}
+	}
+	if row.ReplicationConfig == nil {
+		row.ReplicationConfig = &persistence.InternalDomainReplicationConfig{}
+	}
+	row.Config.BadBinaries = normalizeDataBlob(row.Config.BadBinaries)
+	row.Config.IsolationGroups = normalizeDataBlob(row.Config.IsolationGroups)
+	row.Config.AsyncWorkflowsConfig = normalizeDataBlob(row.Config.AsyncWorkflowsConfig)
+	row.ReplicationConfig.ActiveClustersConfig = normalizeDataBlob(row.ReplicationConfig.ActiveClustersConfig)
+	return row, nil
 }
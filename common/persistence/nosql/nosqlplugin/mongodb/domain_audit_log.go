@@ -22,17 +22,65 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // InsertDomainAuditLog inserts a new audit log entry for a domain operation
 func (db *mdb) InsertDomainAuditLog(ctx context.Context, row *nosqlplugin.DomainAuditLogRow) error {
-	return fmt.Errorf("InsertDomainAuditLog not implemented")
+	entry := *row
+	entry.TTLSeconds = 0
+	data, err := toJSON(&entry)
+	if err != nil {
+		return err
+	}
+	_, err = db.dbConn.Collection(cadence.DomainAuditLogCollectionName).InsertOne(ctx, &cadence.DomainAuditLogCollectionEntry{
+		DomainID:      row.DomainID,
+		OperationType: int(row.OperationType),
+		CreatedTime:   unixNano(row.CreatedTime),
+		EventID:       row.EventID,
+		Data:          data,
+		Expiry:        expiryTime(time.Now(), row.TTLSeconds),
+	})
+	return err
 }
 
-// SelectDomainAuditLogs returns audit log entries for a domain and operation type
+// SelectDomainAuditLogs returns audit log entries for a domain and operation type, the newest entries come first
 func (db *mdb) SelectDomainAuditLogs(ctx context.Context, filter *nosqlplugin.DomainAuditLogFilter) ([]*nosqlplugin.DomainAuditLogRow, []byte, error) {
-	return nil, nil, fmt.Errorf("SelectDomainAuditLogs not implemented")
+	if filter.MinCreatedTime == nil || filter.MaxCreatedTime == nil {
+		return nil, nil, &types.InternalServiceError{
+			Message: "SelectDomainAuditLogs requires non-nil MinCreatedTime and MaxCreatedTime",
+		}
+	}
+
+	docs, nextPageToken, err := findPage[cadence.DomainAuditLogCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.DomainAuditLogCollectionName),
+		bson.D{
+			{Key: "domainid", Value: filter.DomainID},
+			{Key: "operationtype", Value: int(filter.OperationType)},
+			{Key: "createdtime", Value: bson.D{{Key: "$gte", Value: unixNano(*filter.MinCreatedTime)}, {Key: "$lt", Value: unixNano(*filter.MaxCreatedTime)}}},
+		},
+		bson.D{{Key: "createdtime", Value: -1}, {Key: "eventid", Value: 1}},
+		filter.PageSize,
+		filter.NextPageToken,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainAuditLogRow, 0, len(docs))
+	for _, doc := range docs {
+		row := &nosqlplugin.DomainAuditLogRow{}
+		if err := fromJSON(doc.Data, row); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
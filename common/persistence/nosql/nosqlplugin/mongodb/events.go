@@ -22,31 +22,213 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *mdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	var treeDoc *cadence.HistoryTreeCollectionEntry
+	if treeRow != nil {
+		ancestors, err := toJSON(treeRow.Ancestors)
+		if err != nil {
+			return err
+		}
+		treeDoc = &cadence.HistoryTreeCollectionEntry{
+			TreeID:      treeRow.TreeID,
+			BranchID:    treeRow.BranchID,
+			Ancestors:   ancestors,
+			Info:        treeRow.Info,
+			CreatedTime: unixNano(treeRow.CreateTimestamp),
+		}
+	}
+	var nodeDoc *cadence.HistoryNodeCollectionEntry
+	if nodeRow != nil {
+		nodeDoc = &cadence.HistoryNodeCollectionEntry{
+			TreeID:       nodeRow.TreeID,
+			BranchID:     nodeRow.BranchID,
+			NodeID:       nodeRow.NodeID,
+			TxnID:        getTxnID(nodeRow),
+			Data:         nodeRow.Data,
+			DataEncoding: nodeRow.DataEncoding,
+			CreatedTime:  unixNano(nodeRow.CreateTimestamp),
+		}
+	}
+
+	// same as Cassandra, the rows are overwritten if they exist already
+	write := func(ctx context.Context) error {
+		if treeDoc != nil {
+			_, err := db.dbConn.Collection(cadence.HistoryTreeCollectionName).ReplaceOne(
+				ctx,
+				bson.D{{Key: "treeid", Value: treeDoc.TreeID}, {Key: "branchid", Value: treeDoc.BranchID}},
+				treeDoc,
+				options.Replace().SetUpsert(true),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		if nodeDoc != nil {
+			_, err := db.dbConn.Collection(cadence.HistoryNodeCollectionName).ReplaceOne(
+				ctx,
+				bson.D{{Key: "treeid", Value: nodeDoc.TreeID}, {Key: "branchid", Value: nodeDoc.BranchID}, {Key: "nodeid", Value: nodeDoc.NodeID}, {Key: "txnid", Value: nodeDoc.TxnID}},
+				nodeDoc,
+				options.Replace().SetUpsert(true),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if treeDoc == nil || nodeDoc == nil {
+		// a single document doesn't need a transaction
+		return write(ctx)
+	}
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		return write(sc)
+	})
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *mdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	if filter.MinNodeID >= filter.MaxNodeID {
+		return nil, nil, nil
+	}
+	docs, nextPageToken, err := findPage[cadence.HistoryNodeCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.HistoryNodeCollectionName),
+		historyNodeFilter(filter.TreeID, filter.BranchID, bson.D{{Key: "$gte", Value: filter.MinNodeID}, {Key: "$lt", Value: filter.MaxNodeID}}),
+		bson.D{{Key: "nodeid", Value: 1}, {Key: "txnid", Value: -1}},
+		filter.PageSize,
+		filter.NextPageToken,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(docs))
+	for _, doc := range docs {
+		txnID := doc.TxnID
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			ShardID:      filter.ShardID,
+			TreeID:       filter.TreeID,
+			BranchID:     filter.BranchID,
+			NodeID:       doc.NodeID,
+			TxnID:        &txnID,
+			Data:         doc.Data,
+			DataEncoding: doc.DataEncoding,
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *mdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	var branchID string
+	if treeFilter.BranchID != nil {
+		branchID = *treeFilter.BranchID
+	}
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		_, err := db.dbConn.Collection(cadence.HistoryTreeCollectionName).DeleteOne(
+			sc,
+			bson.D{{Key: "treeid", Value: treeFilter.TreeID}, {Key: "branchid", Value: branchID}},
+		)
+		if err != nil {
+			return err
+		}
+		for _, nodeFilter := range nodeFilters {
+			_, err := db.dbConn.Collection(cadence.HistoryNodeCollectionName).DeleteMany(
+				sc,
+				historyNodeFilter(nodeFilter.TreeID, nodeFilter.BranchID, bson.D{{Key: "$gte", Value: nodeFilter.MinNodeID}}),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *mdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	docs, pagingToken, err := findPage[cadence.HistoryTreeCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.HistoryTreeCollectionName),
+		bson.D{},
+		bson.D{{Key: "treeid", Value: 1}, {Key: "branchid", Value: 1}},
+		pageSize,
+		nextPageToken,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(docs))
+	for _, doc := range docs {
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:          doc.TreeID,
+			BranchID:        doc.BranchID,
+			CreateTimestamp: time.Unix(0, doc.CreatedTime),
+			Info:            doc.Info,
+		})
+	}
+	return rows, pagingToken, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *mdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	docs, _, err := findPage[cadence.HistoryTreeCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.HistoryTreeCollectionName),
+		bson.D{{Key: "treeid", Value: filter.TreeID}},
+		bson.D{{Key: "branchid", Value: 1}},
+		0,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(docs))
+	for _, doc := range docs {
+		var ancestors []*types.HistoryBranchRange
+		if err := fromJSON(doc.Ancestors, &ancestors); err != nil {
+			return nil, err
+		}
+		if ancestors == nil {
+			ancestors = []*types.HistoryBranchRange{}
+		}
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:          filter.TreeID,
+			BranchID:        doc.BranchID,
+			Ancestors:       ancestors,
+			CreateTimestamp: time.Unix(0, doc.CreatedTime),
+			Info:            doc.Info,
+		})
+	}
+	return rows, nil
+}
+
+func historyNodeFilter(treeID, branchID string, nodeIDCondition bson.D) bson.D {
+	return bson.D{{Key: "treeid", Value: treeID}, {Key: "branchid", Value: branchID}, {Key: "nodeid", Value: nodeIDCondition}}
+}
+
+func getTxnID(row *nosqlplugin.HistoryNodeRow) int64 {
+	if row.TxnID == nil {
+		return 0
+	}
+	return *row.TxnID
 }
@@ -22,32 +22,186 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+// History DLQ tasks are sorted by (visibilityts, taskid) within (shard, domain, cluster attribute, task category).
+
 // InsertHistoryDLQTaskRow writes a task to the history DLQ.
 func (db *mdb) InsertHistoryDLQTaskRow(ctx context.Context, task *nosqlplugin.HistoryDLQTaskRow) error {
-	return fmt.Errorf("InsertHistoryDLQTaskRow not implemented for MongoDB")
+	data, err := toJSON(task)
+	if err != nil {
+		return err
+	}
+	doc := &cadence.HistoryTaskDLQCollectionEntry{
+		ShardID:               task.ShardID,
+		DomainID:              task.DomainID,
+		ClusterAttributeScope: task.ClusterAttributeScope,
+		ClusterAttributeName:  task.ClusterAttributeName,
+		TaskCategory:          task.TaskCategory,
+		VisibilityTS:          unixNano(task.VisibilityTimestamp),
+		TaskID:                task.TaskID,
+		Data:                  data,
+	}
+	filter := append(
+		historyDLQFilter(task.ShardID, task.DomainID, task.ClusterAttributeScope, task.ClusterAttributeName, task.TaskCategory),
+		bson.E{Key: "visibilityts", Value: doc.VisibilityTS},
+		bson.E{Key: "taskid", Value: doc.TaskID},
+	)
+	_, err = db.dbConn.Collection(cadence.HistoryTaskDLQCollectionName).ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true))
+	return err
 }
 
+// SelectHistoryDLQTaskRows reads paginated tasks from the history DLQ within the given bounds.
 func (db *mdb) SelectHistoryDLQTaskRows(ctx context.Context, filter nosqlplugin.HistoryDLQTaskFilter) ([]*nosqlplugin.HistoryDLQTaskRow, []byte, error) {
-	return nil, nil, fmt.Errorf("SelectHistoryDLQTaskRows not implemented for MongoDB")
+	minVisibilityTS := unixNano(filter.InclusiveMinVisibilityTS)
+	maxVisibilityTS := unixNano(filter.ExclusiveMaxVisibilityTS)
+	query := append(
+		historyDLQFilter(filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName, filter.TaskCategory),
+		bson.E{Key: "$and", Value: bson.A{
+			// (visibilityts, taskid) >= (minVisibilityTS, InclusiveMinTaskID)
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "visibilityts", Value: bson.D{{Key: "$gt", Value: minVisibilityTS}}}},
+				bson.D{{Key: "visibilityts", Value: minVisibilityTS}, {Key: "taskid", Value: bson.D{{Key: "$gte", Value: filter.InclusiveMinTaskID}}}},
+			}}},
+			// (visibilityts, taskid) < (maxVisibilityTS, ExclusiveMaxTaskID)
+			historyDLQBefore(maxVisibilityTS, filter.ExclusiveMaxTaskID),
+		}},
+	)
+	docs, nextPageToken, err := findPage[cadence.HistoryTaskDLQCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.HistoryTaskDLQCollectionName),
+		query,
+		bson.D{{Key: "visibilityts", Value: 1}, {Key: "taskid", Value: 1}},
+		filter.PageSize,
+		filter.NextPageToken,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryDLQTaskRow, 0, len(docs))
+	for _, doc := range docs {
+		row := &nosqlplugin.HistoryDLQTaskRow{}
+		if err := fromJSON(doc.Data, row); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
+// RangeDeleteHistoryDLQTaskRows deletes all tasks before the given ack-level bounds.
 func (db *mdb) RangeDeleteHistoryDLQTaskRows(ctx context.Context, filter nosqlplugin.HistoryDLQTaskRangeDeleteFilter) error {
-	return fmt.Errorf("RangeDeleteHistoryDLQTaskRows not implemented for MongoDB")
+	query := append(
+		historyDLQFilter(filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName, filter.TaskCategory),
+		historyDLQBefore(unixNano(filter.ExclusiveMaxVisibilityTS), filter.ExclusiveMaxTaskID)...,
+	)
+	_, err := db.dbConn.Collection(cadence.HistoryTaskDLQCollectionName).DeleteMany(ctx, query)
+	return err
 }
 
+// SelectHistoryDLQAckLevelRows reads ack-level rows for a shard.
+// The result is narrowed down by the domain, and then by the cluster attribute if they are specified.
 func (db *mdb) SelectHistoryDLQAckLevelRows(ctx context.Context, filter nosqlplugin.HistoryDLQAckLevelFilter) ([]*nosqlplugin.HistoryDLQAckLevelRow, error) {
-	return nil, fmt.Errorf("SelectHistoryDLQAckLevelRows not implemented for MongoDB")
+	query := bson.D{{Key: "shardid", Value: filter.ShardID}}
+	if filter.DomainID != "" {
+		query = append(query, bson.E{Key: "domainid", Value: filter.DomainID})
+		if filter.ClusterAttributeScope != "" && filter.ClusterAttributeName != "" {
+			query = append(query,
+				bson.E{Key: "clusterattributescope", Value: filter.ClusterAttributeScope},
+				bson.E{Key: "clusterattributename", Value: filter.ClusterAttributeName},
+			)
+		}
+	}
+	docs, _, err := findPage[cadence.HistoryTaskDLQAckLevelCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.HistoryTaskDLQAckLevelCollectionName),
+		query,
+		bson.D{{Key: "domainid", Value: 1}, {Key: "clusterattributescope", Value: 1}, {Key: "clusterattributename", Value: 1}, {Key: "taskcategory", Value: 1}},
+		0,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryDLQAckLevelRow, 0, len(docs))
+	for _, doc := range docs {
+		row := &nosqlplugin.HistoryDLQAckLevelRow{}
+		if err := fromJSON(doc.Data, row); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
 }
 
+// InsertOrUpdateHistoryDLQAckLevelRow upserts a single ack-level row.
 func (db *mdb) InsertOrUpdateHistoryDLQAckLevelRow(ctx context.Context, row *nosqlplugin.HistoryDLQAckLevelRow) error {
-	return fmt.Errorf("InsertOrUpdateHistoryDLQAckLevelRow not implemented for MongoDB")
+	doc, err := newHistoryDLQAckLevelEntry(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.dbConn.Collection(cadence.HistoryTaskDLQAckLevelCollectionName).ReplaceOne(
+		ctx,
+		historyDLQFilter(row.ShardID, row.DomainID, row.ClusterAttributeScope, row.ClusterAttributeName, row.TaskCategory),
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	return err
 }
 
+// InsertHistoryDLQAckLevelIfNotExistsRow inserts a sentinel ack-level row if it does not already exist
+// for this (shard, domain, scope, name, task_category) key.
+// Returns success if the row is written or if it already exists.
 func (db *mdb) InsertHistoryDLQAckLevelIfNotExistsRow(ctx context.Context, row *nosqlplugin.HistoryDLQAckLevelRow) error {
-	return fmt.Errorf("InsertHistoryDLQAckLevelIfNotExistsRow not implemented for MongoDB")
+	doc, err := newHistoryDLQAckLevelEntry(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.dbConn.Collection(cadence.HistoryTaskDLQAckLevelCollectionName).InsertOne(ctx, doc)
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return nil
+}
+
+func historyDLQFilter(shardID int, domainID, scope, name string, taskCategory int) bson.D {
+	return bson.D{
+		{Key: "shardid", Value: shardID},
+		{Key: "domainid", Value: domainID},
+		{Key: "clusterattributescope", Value: scope},
+		{Key: "clusterattributename", Value: name},
+		{Key: "taskcategory", Value: taskCategory},
+	}
+}
+
+// historyDLQBefore returns the filter of the tasks before the (visibilityTS, taskID) tuple
+func historyDLQBefore(visibilityTS int64, taskID int64) bson.D {
+	return bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "visibilityts", Value: bson.D{{Key: "$lt", Value: visibilityTS}}}},
+		bson.D{{Key: "visibilityts", Value: visibilityTS}, {Key: "taskid", Value: bson.D{{Key: "$lt", Value: taskID}}}},
+	}}}
+}
+
+func newHistoryDLQAckLevelEntry(row *nosqlplugin.HistoryDLQAckLevelRow) (*cadence.HistoryTaskDLQAckLevelCollectionEntry, error) {
+	data, err := toJSON(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.HistoryTaskDLQAckLevelCollectionEntry{
+		ShardID:               row.ShardID,
+		DomainID:              row.DomainID,
+		ClusterAttributeScope: row.ClusterAttributeScope,
+		ClusterAttributeName:  row.ClusterAttributeName,
+		TaskCategory:          row.TaskCategory,
+		Data:                  data,
+	}, nil
 }
@@ -23,6 +23,8 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -58,7 +60,7 @@ func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger, dc *persist
 }
 
 func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*mdb, error) {
-	uri := fmt.Sprintf("mongodb://%v:%v@%v:%v/", cfg.User, cfg.Password, cfg.Hosts, cfg.Port)
+	uri := BuildConnectionURI(cfg)
 	// TODO CreateDB/CreateAdminDB don't pass in context.Context so we are using background for now
 	// It's okay because this is being called during server startup or CLI.
 	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
@@ -76,3 +78,37 @@ func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*mdb, error)
 		logger: logger,
 	}, err
 }
+
+// BuildConnectionURI returns the connection string of the replica set. Transactions require MongoDB to run as
+// a replica set or a sharded cluster, the replica set name and other options can be given by ConnectAttributes
+// e.g. replicaSet: rs0
+func BuildConnectionURI(cfg *config.NoSQL) string {
+	var hosts []string
+	for _, host := range strings.Split(cfg.Hosts, ",") {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			continue
+		}
+		if cfg.Port != 0 && !strings.Contains(host, ":") {
+			host = fmt.Sprintf("%v:%v", host, cfg.Port)
+		}
+		hosts = append(hosts, host)
+	}
+
+	u := url.URL{
+		Scheme: "mongodb",
+		Host:   strings.Join(hosts, ","),
+		Path:   "/",
+	}
+	if cfg.User != "" {
+		u.User = url.UserPassword(cfg.User, cfg.Password)
+	}
+	if len(cfg.ConnectAttributes) > 0 {
+		query := url.Values{}
+		for k, v := range cfg.ConnectAttributes {
+			query.Set(k, v)
+		}
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
@@ -22,10 +22,14 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // Insert message into queue, return error if failed or already exists
@@ -34,7 +38,16 @@ func (db *mdb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.QueueMessageCollectionName).InsertOne(ctx, &cadence.QueueMessageCollectionEntry{
+		QueueType:   int(row.QueueType),
+		MessageID:   row.ID,
+		Payload:     row.Payload,
+		CreatedTime: unixNano(row.CurrentTimeStamp),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -42,7 +55,16 @@ func (db *mdb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	var doc cadence.QueueMessageCollectionEntry
+	err := db.dbConn.Collection(cadence.QueueMessageCollectionName).FindOne(
+		ctx,
+		bson.D{{Key: "queuetype", Value: int(queueType)}},
+		options.FindOne().SetSort(bson.D{{Key: "messageid", Value: -1}}),
+	).Decode(&doc)
+	if err != nil {
+		return 0, err
+	}
+	return doc.MessageID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -52,7 +74,27 @@ func (db *mdb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	docs, _, err := findPage[cadence.QueueMessageCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.QueueMessageCollectionName),
+		queueMessagesFilter(queueType, bson.D{{Key: "$gt", Value: exclusiveBeginMessageID}}),
+		bson.D{{Key: "messageid", Value: 1}},
+		maxRows,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*nosqlplugin.QueueMessageRow
+	for _, doc := range docs {
+		result = append(result, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        doc.MessageID,
+			Payload:   doc.Payload,
+		})
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -60,7 +102,30 @@ func (db *mdb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage[cadence.QueueMessageCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.QueueMessageCollectionName),
+		queueMessagesFilter(request.QueueType, bson.D{{Key: "$gt", Value: request.ExclusiveBeginMessageID}, {Key: "$lte", Value: request.InclusiveEndMessageID}}),
+		bson.D{{Key: "messageid", Value: 1}},
+		request.PageSize,
+		request.NextPageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []nosqlplugin.QueueMessageRow
+	for _, doc := range docs {
+		rows = append(rows, nosqlplugin.QueueMessageRow{
+			QueueType: request.QueueType,
+			ID:        doc.MessageID,
+			Payload:   doc.Payload,
+		})
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -69,7 +134,11 @@ func (db *mdb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.QueueMessageCollectionName).DeleteMany(
+		ctx,
+		queueMessagesFilter(queueType, bson.D{{Key: "$lt", Value: exclusiveBeginMessageID}}),
+	)
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -79,7 +148,11 @@ func (db *mdb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.QueueMessageCollectionName).DeleteMany(
+		ctx,
+		queueMessagesFilter(queueType, bson.D{{Key: "$gt", Value: exclusiveBeginMessageID}, {Key: "$lte", Value: inclusiveEndMessageID}}),
+	)
+	return err
 }
 
 // Delete one message
@@ -88,12 +161,29 @@ func (db *mdb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.QueueMessageCollectionName).DeleteOne(
+		ctx,
+		bson.D{{Key: "queuetype", Value: int(queueType)}, {Key: "messageid", Value: messageID}},
+	)
+	return err
 }
 
 // Insert an empty metadata row, starting from a version
 func (db *mdb) InsertQueueMetadata(ctx context.Context, row nosqlplugin.QueueMetadataRow) error {
-	fmt.Println("not implemented, ignore the eror for testing")
+	ackLevels, err := toJSON(map[string]int64{})
+	if err != nil {
+		return err
+	}
+	_, err = db.dbConn.Collection(cadence.QueueMetadataCollectionName).InsertOne(ctx, &cadence.QueueMetadataCollectionEntry{
+		QueueType:        int(row.QueueType),
+		ClusterAckLevels: ackLevels,
+		Version:          row.Version,
+		CreatedTime:      unixNano(row.CurrentTimeStamp),
+	})
+	// it's ok if the document is not written, which means that the record exists already.
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
 	return nil
 }
 
@@ -104,7 +194,26 @@ func (db *mdb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	ackLevels, err := toJSON(row.ClusterAckLevels)
+	if err != nil {
+		return err
+	}
+	result, err := db.dbConn.Collection(cadence.QueueMetadataCollectionName).UpdateOne(
+		ctx,
+		bson.D{{Key: "queuetype", Value: int(row.QueueType)}, {Key: "version", Value: row.Version - 1}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "clusteracklevels", Value: ackLevels},
+			{Key: "version", Value: row.Version},
+			{Key: "lastupdated", Value: unixNano(row.CurrentTimeStamp)},
+		}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return nil
 }
 
 // Read a QueueMetadata
@@ -112,13 +221,34 @@ func (db *mdb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil, nil
+	var doc cadence.QueueMetadataCollectionEntry
+	err := db.dbConn.Collection(cadence.QueueMetadataCollectionName).FindOne(ctx, bson.D{{Key: "queuetype", Value: int(queueType)}}).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var ackLevels map[string]int64
+	if err := fromJSON(doc.ClusterAckLevels, &ackLevels); err != nil {
+		return nil, err
+	}
+	// if record exist but ackLevels is empty, we initialize the map
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          doc.Version,
+	}, nil
 }
 
 func (db *mdb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.dbConn.Collection(cadence.QueueMessageCollectionName).CountDocuments(ctx, bson.D{{Key: "queuetype", Value: int(queueType)}})
+}
+
+func queueMessagesFilter(queueType persistence.QueueType, messageIDCondition bson.D) bson.D {
+	return bson.D{{Key: "queuetype", Value: int(queueType)}, {Key: "messageid", Value: messageIDCondition}}
 }
@@ -22,31 +22,168 @@ package mongodb
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	log.Println("not implemented...ignore the error for testing...")
-	return nil
+	doc, err := newShardEntry(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.dbConn.Collection(cadence.ShardCollectionName).InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.newConflictedShardError(ctx, row.ShardID)
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *mdb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	var doc cadence.ShardCollectionEntry
+	err := db.dbConn.Collection(cadence.ShardCollectionName).FindOne(ctx, shardFilter(shardID)).Decode(&doc)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	info := &persistence.InternalShardInfo{}
+	if err := fromJSON(doc.Info, info); err != nil {
+		return 0, nil, err
+	}
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	info.PendingFailoverMarkers = normalizeDataBlob(info.PendingFailoverMarkers)
+	info.TransferProcessingQueueStates = normalizeDataBlob(info.TransferProcessingQueueStates)
+	info.TimerProcessingQueueStates = normalizeDataBlob(info.TimerProcessingQueueStates)
+
+	return doc.RangeID, &nosqlplugin.ShardRow{
+		InternalShardInfo: info,
+		Data:              doc.Data,
+		DataEncoding:      doc.DataEncoding,
+	}, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	filter := append(shardFilter(shardID), bson.E{Key: "rangeid", Value: previousRangeID})
+	result, err := db.dbConn.Collection(cadence.ShardCollectionName).UpdateOne(ctx, filter, bson.D{
+		{Key: "$set", Value: bson.D{{Key: "rangeid", Value: rangeID}}},
+		{Key: "$inc", Value: bson.D{{Key: "txnversion", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.newConflictedShardError(ctx, shardID)
+	}
+	return nil
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	doc, err := newShardEntry(row)
+	if err != nil {
+		return err
+	}
+	filter := append(shardFilter(row.ShardID), bson.E{Key: "rangeid", Value: previousRangeID})
+	result, err := db.dbConn.Collection(cadence.ShardCollectionName).UpdateOne(ctx, filter, bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "rangeid", Value: doc.RangeID},
+			{Key: "info", Value: doc.Info},
+			{Key: "data", Value: doc.Data},
+			{Key: "dataencoding", Value: doc.DataEncoding},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "txnversion", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.newConflictedShardError(ctx, row.ShardID)
+	}
+	return nil
+}
+
+// assertShardRangeID checks the rangeID of the shard within a transaction.
+// The shard document is always written so that the concurrent transactions of the same shard conflict with each other,
+// otherwise a transaction could still commit after the shard is stolen by another host.
+// It returns the actual rangeID and whether it matches the condition, the actual rangeID is -1 if the shard doesn't exist.
+func (db *mdb) assertShardRangeID(sc mongo.SessionContext, shardCondition *nosqlplugin.ShardCondition) (int64, bool, error) {
+	var doc cadence.ShardCollectionEntry
+	err := db.dbConn.Collection(cadence.ShardCollectionName).FindOneAndUpdate(
+		sc,
+		shardFilter(shardCondition.ShardID),
+		bson.D{{Key: "$inc", Value: bson.D{{Key: "txnversion", Value: 1}}}},
+		options.FindOneAndUpdate().SetProjection(bson.D{{Key: "rangeid", Value: 1}}),
+	).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return -1, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return doc.RangeID, doc.RangeID == shardCondition.RangeID, nil
+}
+
+func shardFilter(shardID int) bson.D {
+	return bson.D{{Key: "shardid", Value: shardID}}
+}
+
+func newShardEntry(row *nosqlplugin.ShardRow) (*cadence.ShardCollectionEntry, error) {
+	info, err := toJSON(row.InternalShardInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.ShardCollectionEntry{
+		ShardID:      row.ShardID,
+		RangeID:      row.RangeID,
+		Info:         info,
+		Data:         row.Data,
+		DataEncoding: row.DataEncoding,
+	}, nil
+}
+
+// newConflictedShardError reads the current shard document to tell the actual rangeID
+func (db *mdb) newConflictedShardError(ctx context.Context, shardID int) error {
+	var doc cadence.ShardCollectionEntry
+	found, err := findOne(ctx, db.dbConn.Collection(cadence.ShardCollectionName), shardFilter(shardID), &doc)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: -1,
+			Details: fmt.Sprintf("shard %v doesn't exist", shardID),
+		}
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: doc.RangeID,
+		Details: fmt.Sprintf("rangeid=%v", doc.RangeID),
+	}
 }
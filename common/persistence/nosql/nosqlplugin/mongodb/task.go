@@ -22,20 +22,52 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
 )
 
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	var doc cadence.TaskListCollectionEntry
+	if err := db.dbConn.Collection(cadence.TaskListCollectionName).FindOne(ctx, taskListFilter(filter)).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return parseTaskListEntry(&doc)
 }
 
 // InsertTaskList insert a single tasklist row
 // Return IsConditionFailedError if the row already exists, and also the existing row
 func (db *mdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	tasklist := *row
+	tasklist.RangeID = initialRangeID
+	tasklist.AckLevel = 0
+	doc, err := newTaskListEntry(&tasklist, tasklist.LastUpdatedTime)
+	if err != nil {
+		return err
+	}
+	_, err = db.dbConn.Collection(cadence.TaskListCollectionName).InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.newConflictedTaskListError(ctx, &nosqlplugin.TaskListFilter{
+			DomainID:     row.DomainID,
+			TaskListName: row.TaskListName,
+			TaskListType: row.TaskListType,
+		})
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +77,11 @@ func (db *mdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	doc, err := newTaskListEntry(row, row.LastUpdatedTime)
+	if err != nil {
+		return err
+	}
+	return db.updateTaskListWithRangeIDCondition(ctx, row, doc, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,38 +94,152 @@ func (db *mdb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	doc, err := newTaskListEntry(row, row.CurrentTimeStamp)
+	if err != nil {
+		return err
+	}
+	doc.Expiry = expiryTime(row.CurrentTimeStamp, ttlSeconds)
+	return db.updateTaskListWithRangeIDCondition(ctx, row, doc, previousRangeID)
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *mdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	docs, token, err := findPage[cadence.TaskListCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.TaskListCollectionName),
+		bson.D{},
+		bson.D{{Key: "domainid", Value: 1}, {Key: "tasklistname", Value: 1}, {Key: "tasklisttype", Value: 1}},
+		pageSize,
+		nextPageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &nosqlplugin.ListTaskListResult{
+		NextPageToken: token,
+	}
+	for _, doc := range docs {
+		row, err := parseTaskListEntry(doc)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *mdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.dbConn.Collection(cadence.TaskListCollectionName).DeleteOne(
+		ctx,
+		append(taskListFilter(filter), bson.E{Key: "rangeid", Value: previousRangeID}),
+	)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return db.newConflictedTaskListError(ctx, filter)
+	}
+	return nil
 }
 
 // InsertTasks inserts a batch of tasks
 // Return TaskOperationConditionFailure if the condition doesn't meet
+// The tasks are inserted within a transaction which also writes the tasklist document,
+// so that the transaction conflicts with any concurrent update of the rangeID
 func (db *mdb) InsertTasks(
 	ctx context.Context,
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	docs := make([]interface{}, 0, len(tasksToInsert))
+	for _, task := range tasksToInsert {
+		doc, err := newTaskEntry(tasklistCondition, task, tasklistCondition.CurrentTimeStamp)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     tasklistCondition.DomainID,
+		TaskListName: tasklistCondition.TaskListName,
+		TaskListType: tasklistCondition.TaskListType,
+	}
+
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		var tasklist cadence.TaskListCollectionEntry
+		err := db.dbConn.Collection(cadence.TaskListCollectionName).FindOneAndUpdate(
+			sc,
+			taskListFilter(filter),
+			bson.D{{Key: "$inc", Value: bson.D{{Key: "txnversion", Value: 1}}}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After),
+		).Decode(&tasklist)
+		if err == mongo.ErrNoDocuments {
+			return newTaskListConditionFailure(nil)
+		}
+		if err != nil {
+			return err
+		}
+		if tasklist.RangeID != tasklistCondition.RangeID {
+			return newTaskListConditionFailure(&tasklist)
+		}
+		if len(docs) == 0 {
+			return nil
+		}
+		_, err = db.dbConn.Collection(cadence.TaskCollectionName).InsertMany(sc, docs)
+		return err
+	})
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *mdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	docs, _, err := findPage[cadence.TaskCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.TaskCollectionName),
+		tasksFilter(&filter.TaskListFilter, bson.D{{Key: "$gt", Value: filter.MinTaskID}, {Key: "$lte", Value: filter.MaxTaskID}}),
+		bson.D{{Key: "taskid", Value: 1}},
+		filter.BatchSize,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []*nosqlplugin.TaskRow
+	for _, doc := range docs {
+		var partitionConfig map[string]string
+		if err := fromJSON(doc.PartitionConfig, &partitionConfig); err != nil {
+			return nil, err
+		}
+		var expiry time.Time
+		if doc.Expiry != nil {
+			expiry = doc.Expiry.UTC()
+		}
+		response = append(response, &nosqlplugin.TaskRow{
+			DomainID:        filter.DomainID,
+			TaskListName:    filter.TaskListName,
+			TaskListType:    filter.TaskListType,
+			TaskID:          doc.TaskID,
+			WorkflowID:      doc.WorkflowID,
+			RunID:           doc.RunID,
+			ScheduledID:     doc.ScheduleID,
+			Expiry:          expiry,
+			CreatedTime:     time.Unix(0, doc.CreatedTime),
+			PartitionConfig: partitionConfig,
+		})
+	}
+	return response, nil
 }
 
+// SelectTasks return tasks that associated to a tasklist
 func (db *mdb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilter) (int64, error) {
-	panic("TODO")
+	return db.dbConn.Collection(cadence.TaskCollectionName).CountDocuments(
+		ctx,
+		tasksFilter(&filter.TaskListFilter, bson.D{{Key: "$gt", Value: filter.MinTaskID}}),
+	)
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
@@ -97,5 +247,146 @@ func (db *mdb) GetTasksCount(ctx context.Context, filter *nosqlplugin.TasksFilte
 // NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
 // be returned to the caller, because rowsDeleted is not supported by Cassandra
 func (db *mdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	_, err = db.dbConn.Collection(cadence.TaskCollectionName).DeleteMany(
+		ctx,
+		tasksFilter(&filter.TaskListFilter, bson.D{{Key: "$gt", Value: filter.MinTaskID}, {Key: "$lte", Value: filter.MaxTaskID}}),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return persistence.UnknownNumRowsAffected, nil
+}
+
+func (db *mdb) updateTaskListWithRangeIDCondition(
+	ctx context.Context,
+	row *nosqlplugin.TaskListRow,
+	doc *cadence.TaskListCollectionEntry,
+	previousRangeID int64,
+) error {
+	filter := &nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}
+	set := bson.D{
+		{Key: "rangeid", Value: doc.RangeID},
+		{Key: "acklevel", Value: doc.AckLevel},
+		{Key: "kind", Value: doc.Kind},
+		{Key: "lastupdated", Value: doc.LastUpdated},
+		{Key: "adaptivepartitionconfig", Value: doc.AdaptivePartitionConfig},
+	}
+	update := bson.D{{Key: "$inc", Value: bson.D{{Key: "txnversion", Value: 1}}}}
+	if doc.Expiry != nil {
+		set = append(set, bson.E{Key: "expiry", Value: *doc.Expiry})
+	} else {
+		update = append(update, bson.E{Key: "$unset", Value: bson.D{{Key: "expiry", Value: ""}}})
+	}
+	update = append(update, bson.E{Key: "$set", Value: set})
+	result, err := db.dbConn.Collection(cadence.TaskListCollectionName).UpdateOne(
+		ctx,
+		append(taskListFilter(filter), bson.E{Key: "rangeid", Value: previousRangeID}),
+		update,
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.newConflictedTaskListError(ctx, filter)
+	}
+	return nil
+}
+
+// newConflictedTaskListError reads the current tasklist document to tell the actual rangeID
+func (db *mdb) newConflictedTaskListError(ctx context.Context, filter *nosqlplugin.TaskListFilter) error {
+	var doc cadence.TaskListCollectionEntry
+	found, err := findOne(ctx, db.dbConn.Collection(cadence.TaskListCollectionName), taskListFilter(filter), &doc)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return newTaskListConditionFailure(nil)
+	}
+	return newTaskListConditionFailure(&doc)
+}
+
+func newTaskListConditionFailure(doc *cadence.TaskListCollectionEntry) error {
+	if doc == nil {
+		return &nosqlplugin.TaskOperationConditionFailure{
+			RangeID: -1,
+			Details: "tasklist doesn't exist",
+		}
+	}
+	columns := []string{
+		fmt.Sprintf("rangeid=%v", doc.RangeID),
+		fmt.Sprintf("acklevel=%v", doc.AckLevel),
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: doc.RangeID,
+		Details: strings.Join(columns, ","),
+	}
+}
+
+func taskListFilter(filter *nosqlplugin.TaskListFilter) bson.D {
+	return bson.D{
+		{Key: "domainid", Value: filter.DomainID},
+		{Key: "tasklistname", Value: filter.TaskListName},
+		{Key: "tasklisttype", Value: filter.TaskListType},
+	}
+}
+
+func tasksFilter(filter *nosqlplugin.TaskListFilter, taskIDCondition bson.D) bson.D {
+	return append(taskListFilter(filter), bson.E{Key: "taskid", Value: taskIDCondition})
+}
+
+func newTaskListEntry(row *nosqlplugin.TaskListRow, lastUpdated time.Time) (*cadence.TaskListCollectionEntry, error) {
+	partitionConfig, err := toJSON(row.AdaptivePartitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.TaskListCollectionEntry{
+		DomainID:                row.DomainID,
+		TaskListName:            row.TaskListName,
+		TaskListType:            row.TaskListType,
+		RangeID:                 row.RangeID,
+		AckLevel:                row.AckLevel,
+		Kind:                    row.TaskListKind,
+		LastUpdated:             unixNano(lastUpdated),
+		AdaptivePartitionConfig: partitionConfig,
+	}, nil
+}
+
+func parseTaskListEntry(doc *cadence.TaskListCollectionEntry) (*nosqlplugin.TaskListRow, error) {
+	var partitionConfig *persistence.TaskListPartitionConfig
+	if err := fromJSON(doc.AdaptivePartitionConfig, &partitionConfig); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.TaskListRow{
+		DomainID:                doc.DomainID,
+		TaskListName:            doc.TaskListName,
+		TaskListType:            doc.TaskListType,
+		RangeID:                 doc.RangeID,
+		TaskListKind:            doc.Kind,
+		AckLevel:                doc.AckLevel,
+		LastUpdatedTime:         time.Unix(0, doc.LastUpdated),
+		AdaptivePartitionConfig: partitionConfig,
+	}, nil
+}
+
+func newTaskEntry(tasklist *nosqlplugin.TaskListRow, task *nosqlplugin.TaskRowForInsert, timeStamp time.Time) (*cadence.TaskCollectionEntry, error) {
+	partitionConfig, err := toJSON(task.PartitionConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.TaskCollectionEntry{
+		DomainID:        tasklist.DomainID,
+		TaskListName:    tasklist.TaskListName,
+		TaskListType:    tasklist.TaskListType,
+		TaskID:          task.TaskID,
+		WorkflowID:      task.WorkflowID,
+		RunID:           task.RunID,
+		ScheduleID:      task.ScheduledID,
+		CreatedTime:     unixNano(task.CreatedTime),
+		PartitionConfig: partitionConfig,
+		Expiry:          expiryTime(timeStamp, int64(task.TTLSeconds)),
+	}, nil
 }
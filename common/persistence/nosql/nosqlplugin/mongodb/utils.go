@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// pageToken holds the sort values of the last document of a page, the next page starts right after it
+type pageToken struct {
+	Values []bson.RawValue `bson:"values"`
+}
+
+// withTransaction runs fn within a multi-document transaction. Returning an error from fn aborts the transaction.
+// fn may be called more than once as the driver retries the transient errors like write conflicts.
+func (db *mdb) withTransaction(ctx context.Context, fn func(sc mongo.SessionContext) error) error {
+	session, err := db.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	txnOptions := options.Transaction().
+		SetReadConcern(readconcern.Snapshot()).
+		SetWriteConcern(writeconcern.New(writeconcern.WMajority()))
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	}, txnOptions)
+	return err
+}
+
+// findOne decodes the first document matching the filter into v, returns false if there is no such document
+func findOne(ctx context.Context, collection *mongo.Collection, filter bson.D, v interface{}) (bool, error) {
+	err := collection.FindOne(ctx, filter).Decode(v)
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// findPage reads the documents matching the filter in the order of the sort fields, until pageSize documents are read.
+// A pageSize of zero or less reads all the documents.
+// The sort fields must identify a document uniquely, as the page token only keeps their values.
+func findPage[T any](
+	ctx context.Context,
+	collection *mongo.Collection,
+	filter bson.D,
+	sort bson.D,
+	pageSize int,
+	token []byte,
+) ([]*T, []byte, error) {
+	if len(token) > 0 {
+		var last pageToken
+		if err := bson.Unmarshal(token, &last); err != nil {
+			return nil, nil, fmt.Errorf("invalid page token: %v", err)
+		}
+		if len(last.Values) != len(sort) {
+			return nil, nil, fmt.Errorf("invalid page token: expect %v values but got %v", len(sort), len(last.Values))
+		}
+		filter = bson.D{{Key: "$and", Value: bson.A{filter, afterSortValues(sort, last.Values)}}}
+	}
+
+	findOptions := options.Find().SetSort(sort)
+	if pageSize > 0 {
+		findOptions.SetLimit(int64(pageSize))
+	}
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []*T
+	var last bson.Raw
+	for cursor.Next(ctx) {
+		doc := new(T)
+		if err := cursor.Decode(doc); err != nil {
+			return nil, nil, err
+		}
+		results = append(results, doc)
+		// cursor.Current is only valid until the next call of Next
+		last = append(last[:0], cursor.Current...)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, nil, err
+	}
+	if pageSize <= 0 || len(results) < pageSize {
+		return results, nil, nil
+	}
+
+	next := pageToken{}
+	for _, field := range sort {
+		value, err := last.LookupErr(field.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		next.Values = append(next.Values, value)
+	}
+	nextToken, err := bson.Marshal(next)
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, nextToken, nil
+}
+
+// afterSortValues returns the filter of the documents that come after the sort values, e.g. for the sort of (a ASC, b DESC):
+// {$or: [{a: {$gt: va}}, {a: va, b: {$lt: vb}}]}
+func afterSortValues(sort bson.D, values []bson.RawValue) bson.D {
+	conditions := make(bson.A, 0, len(sort))
+	for i, field := range sort {
+		condition := bson.D{}
+		for j := 0; j < i; j++ {
+			condition = append(condition, bson.E{Key: sort[j].Key, Value: values[j]})
+		}
+		operator := "$gt"
+		if direction, ok := field.Value.(int); ok && direction < 0 {
+			operator = "$lt"
+		}
+		condition = append(condition, bson.E{Key: field.Key, Value: bson.D{{Key: operator, Value: values[i]}}})
+		conditions = append(conditions, condition)
+	}
+	return bson.D{{Key: "$or", Value: conditions}}
+}
+
+// toJSON encodes the value as JSON, the complex structs are kept as JSON to be consistent with the other NoSQL plugins
+func toJSON(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// fromJSON decodes the JSON into v, it's a noop if the data is empty
+func fromJSON(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// newJSONMap encodes a map as a JSON object, the integer keys are encoded as strings
+func newJSONMap[K comparable, V any](values map[K]V) ([]byte, error) {
+	if values == nil {
+		values = map[K]V{}
+	}
+	return json.Marshal(values)
+}
+
+// mergeJSONMap deletes the keys from the JSON object and then sets the values
+func mergeJSONMap[K comparable, V any](data []byte, values map[K]V, keysToDelete []K) ([]byte, error) {
+	entries := make(map[string]json.RawMessage)
+	if err := fromJSON(data, &entries); err != nil {
+		return nil, err
+	}
+	for _, key := range keysToDelete {
+		delete(entries, fmt.Sprint(key))
+	}
+	for key, value := range values {
+		entry, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		entries[fmt.Sprint(key)] = entry
+	}
+	return json.Marshal(entries)
+}
+
+func parseJSONMap[K comparable, V any](data []byte) (map[K]*V, error) {
+	result := make(map[K]*V)
+	if err := fromJSON(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// unixNano returns the nanoseconds of the time, zero time is treated as the unix epoch
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// expiryTime returns the time when a document with the TTL expires, or nil if there is no TTL
+func expiryTime(now time.Time, ttlSeconds int64) *time.Time {
+	if ttlSeconds <= 0 {
+		return nil
+	}
+	expiry := now.Add(time.Duration(ttlSeconds) * time.Second).UTC()
+	return &expiry
+}
+
+// toDBTimestamp converts the time into the millisecond precision used by timer tasks and visibility, same as Cassandra
+func toDBTimestamp(t time.Time) int64 {
+	return persistence.UnixNanoToDBTimestamp(t.UnixNano())
+}
+
+func fromDBTimestamp(ts int64) time.Time {
+	return time.Unix(0, persistence.DBTimestampToUnixNano(ts)).UTC()
+}
+
+// toDataBlob normalizes the empty blob to nil to be consistent with persistence.NewDataBlob
+func toDataBlob(data []byte, encoding string) *persistence.DataBlob {
+	if len(data) == 0 {
+		return nil
+	}
+	return &persistence.DataBlob{
+		Data:     data,
+		Encoding: constants.EncodingType(encoding),
+	}
+}
+
+func normalizeDataBlob(blob *persistence.DataBlob) *persistence.DataBlob {
+	if blob == nil || len(blob.Data) == 0 {
+		return nil
+	}
+	return blob
+}
@@ -22,16 +22,41 @@ package mongodb
 
 import (
 	"context"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+// A visibility record is a single document of a workflow run. Unlike Cassandra which writes into different tables,
+// the open and closed records are told apart by the closed field, which is the prefix of the indexes.
+
 func (db *mdb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	doc, err := newVisibilityEntry(row.DomainID, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	// the close event may be recorded before the start event, never override a closed record:
+	// the upsert fails with duplicate key if the closed record exists
+	_, err = db.dbConn.Collection(cadence.VisibilityCollectionName).ReplaceOne(
+		ctx,
+		append(visibilityFilter(row.DomainID, row.WorkflowID, row.RunID), bson.E{Key: "closed", Value: false}),
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	return err
 }
 
 func (db *mdb) UpdateVisibility(
@@ -39,26 +64,178 @@ func (db *mdb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	if row.UpdateCloseToOpen {
+		// TODO implement it when where is a need
+		panic("not supported operation")
+	}
+
+	doc, err := newVisibilityEntry(row.DomainID, &row.VisibilityRow, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	doc.Closed = true
+	doc.CloseTime = toDBTimestamp(row.CloseTime)
+	if row.Status != nil {
+		doc.CloseStatus = int(*row.Status)
+	}
+	_, err = db.dbConn.Collection(cadence.VisibilityCollectionName).ReplaceOne(
+		ctx,
+		visibilityFilter(row.DomainID, row.WorkflowID, row.RunID),
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	return err
 }
 
 func (db *mdb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := &filter.ListRequest
+	timeRange := bson.D{
+		{Key: "$gte", Value: toDBTimestamp(request.EarliestTime)},
+		{Key: "$lte", Value: toDBTimestamp(request.LatestTime)},
+	}
+
+	var closed bool
+	var timeField string
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		closed, timeField = false, "starttime"
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		switch filter.SortType {
+		case nosqlplugin.SortByStartTime:
+			closed, timeField = true, "starttime"
+		case nosqlplugin.SortByClosedTime:
+			closed, timeField = true, "closetime"
+		default:
+			panic("not supported sorting type")
+		}
+	default:
+		panic("no supported filter type")
+	}
+
+	query := bson.D{
+		{Key: "domainid", Value: request.DomainUUID},
+		{Key: "closed", Value: closed},
+		{Key: timeField, Value: timeRange},
+	}
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		query = append(query, bson.E{Key: "workflowtype", Value: filter.WorkflowType})
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		query = append(query, bson.E{Key: "workflowid", Value: filter.WorkflowID})
+	case nosqlplugin.ClosedByClosedStatus:
+		query = append(query, bson.E{Key: "closestatus", Value: int(filter.CloseStatus)})
+	}
+
+	docs, nextPageToken, err := findPage[cadence.VisibilityCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.VisibilityCollectionName),
+		query,
+		bson.D{{Key: timeField, Value: -1}, {Key: "runid", Value: -1}},
+		request.PageSize,
+		request.NextPageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+	response := &nosqlplugin.SelectVisibilityResponse{
+		Executions:    make([]*nosqlplugin.VisibilityRow, 0, len(docs)),
+		NextPageToken: nextPageToken,
+	}
+	for _, doc := range docs {
+		row, err := parseVisibilityEntry(doc)
+		if err != nil {
+			return nil, err
+		}
+		response.Executions = append(response.Executions, row)
+	}
+	return response, nil
 }
 
+// UpsertVisibility is not supported, the query based visibility APIs are only implemented by the Cassandra plugin
+func (db *mdb) UpsertVisibility(
+	ctx context.Context,
+	ttlSeconds int64,
+	row *nosqlplugin.VisibilityRowForInsert,
+) error {
+	return persistence.ErrVisibilityOperationNotSupported
+}
+
+func (db *mdb) SelectVisibilityByQuery(
+	ctx context.Context,
+	filter *nosqlplugin.VisibilityQueryFilter,
+) (*nosqlplugin.SelectVisibilityResponse, error) {
+	return nil, persistence.ErrVisibilityOperationNotSupported
+}
+
+func (db *mdb) CountVisibilityByQuery(
+	ctx context.Context,
+	filter *nosqlplugin.VisibilityQueryFilter,
+) (int64, error) {
+	return 0, persistence.ErrVisibilityOperationNotSupported
+}
+
+// DeleteVisibility deletes the record, though the records are also deleted by TTL
 func (db *mdb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.VisibilityCollectionName).DeleteOne(ctx, visibilityFilter(domainID, workflowID, runID))
+	return err
 }
 
 func (db *mdb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	var doc cadence.VisibilityCollectionEntry
+	found, err := findOne(
+		ctx,
+		db.dbConn.Collection(cadence.VisibilityCollectionName),
+		append(visibilityFilter(domainID, workflowID, runID), bson.E{Key: "closed", Value: true}),
+		&doc,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		// Special case: return nil,nil if not found(since we will deprecate it, it's not worth refactor to be consistent)
+		return nil, nil
+	}
+	return parseVisibilityEntry(&doc)
+}
+
+func visibilityFilter(domainID, workflowID, runID string) bson.D {
+	return bson.D{{Key: "domainid", Value: domainID}, {Key: "workflowid", Value: workflowID}, {Key: "runid", Value: runID}}
+}
+
+func newVisibilityEntry(domainID string, row *nosqlplugin.VisibilityRow, ttlSeconds int64) (*cadence.VisibilityCollectionEntry, error) {
+	record := *row
+	record.DomainID = domainID
+	// search attributes can't be queried with this plugin, so they are not stored
+	record.SearchAttributes = nil
+	data, err := toJSON(&record)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.VisibilityCollectionEntry{
+		DomainID:     domainID,
+		WorkflowID:   row.WorkflowID,
+		RunID:        row.RunID,
+		WorkflowType: row.TypeName,
+		StartTime:    toDBTimestamp(row.StartTime),
+		Data:         data,
+		Expiry:       expiryTime(time.Now(), ttlSeconds),
+	}, nil
+}
+
+func parseVisibilityEntry(doc *cadence.VisibilityCollectionEntry) (*nosqlplugin.VisibilityRow, error) {
+	row := &persistence.InternalVisibilityWorkflowExecutionInfo{}
+	if err := fromJSON(doc.Data, row); err != nil {
+		return nil, err
+	}
+	row.Memo = normalizeDataBlob(row.Memo)
+	return row, nil
 }
@@ -23,14 +23,25 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*mdb)(nil)
 
+// InsertWorkflowExecutionWithTasks checks the conditions in the same order as the Cassandra plugin reports them:
+// shard range ID, duplicate requests, the current workflow record and then the concrete execution.
+// Any failure aborts the transaction so nothing is written.
 func (db *mdb) InsertWorkflowExecutionWithTasks(
 	ctx context.Context,
 	requests *nosqlplugin.WorkflowRequestsWriteRequest,
@@ -40,7 +51,63 @@ func (db *mdb) InsertWorkflowExecutionWithTasks(
 	activeClusterSelectionPolicyRow *nosqlplugin.ActiveClusterSelectionPolicyRow,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+	timeStamp := execution.CurrentTimeStamp
+
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		actualRangeID, ok, err := db.assertShardRangeID(sc, shardCondition)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				ShardRangeIDNotMatch: common.Int64Ptr(actualRangeID),
+			}
+		}
+
+		duplicateRequest, err := db.insertOrUpsertWorkflowRequestRow(sc, requests, timeStamp)
+		if err != nil {
+			return err
+		}
+		if duplicateRequest != nil {
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				DuplicateRequest: duplicateRequest,
+			}
+		}
+
+		mismatch, err := db.createOrUpdateCurrentWorkflow(sc, shardID, domainID, workflowID, currentWorkflowRequest, timeStamp)
+		if err != nil {
+			return err
+		}
+		if mismatch != nil {
+			return newCreateWorkflowCurrentConditionFailure(mismatch, currentWorkflowRequest)
+		}
+
+		existing, err := db.createWorkflowExecutionWithMergeMaps(sc, shardID, execution, timeStamp)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", execution.WorkflowID, execution.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  execution.CreateRequestID,
+					RunID:            execution.RunID,
+					State:            execution.State,
+					CloseStatus:      execution.CloseStatus,
+					LastWriteVersion: existing.LastWriteVersion,
+				},
+			}
+		}
+
+		if err := db.insertWorkflowActiveClusterSelectionPolicyRow(sc, activeClusterSelectionPolicyRow, timeStamp); err != nil {
+			return err
+		}
+		return db.createTasksByCategory(sc, shardID, tasksByCategory, timeStamp)
+	})
 }
 
 func (db *mdb) UpdateWorkflowExecutionWithTasks(
@@ -54,113 +121,454 @@ func (db *mdb) UpdateWorkflowExecutionWithTasks(
 	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	var previousNextEventIDCondition int64
+	var timeStamp time.Time
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+		previousNextEventIDCondition = *mutatedExecution.PreviousNextEventIDCondition
+		timeStamp = mutatedExecution.CurrentTimeStamp
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+		previousNextEventIDCondition = *resetExecution.PreviousNextEventIDCondition
+		timeStamp = resetExecution.CurrentTimeStamp
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		actualRangeID, ok, err := db.assertShardRangeID(sc, shardCondition)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				ShardRangeIDNotMatch: common.Int64Ptr(actualRangeID),
+			}
+		}
+
+		duplicateRequest, err := db.insertOrUpsertWorkflowRequestRow(sc, requests, timeStamp)
+		if err != nil {
+			return err
+		}
+		if duplicateRequest != nil {
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				DuplicateRequest: duplicateRequest,
+			}
+		}
+
+		mismatch, err := db.createOrUpdateCurrentWorkflow(sc, shardID, domainID, workflowID, currentWorkflowRequest, timeStamp)
+		if err != nil {
+			return err
+		}
+		if mismatch != nil {
+			if mismatch.runIDMismatch {
+				msg := fmt.Sprintf("Failed to update mutable state. requestConditionalRunID: %v, Actual Value: %v",
+					currentWorkflowRequest.Condition.GetCurrentRunID(), mismatch.actualRunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					CurrentWorkflowConditionFailInfo: &msg,
+				}
+			}
+			return newUpdateWorkflowUnknownConditionFailure(shardCondition, previousNextEventIDCondition, currentWorkflowRequest,
+				fmt.Sprintf("last_write_version=%v", mismatch.actualLastWriteVersion), fmt.Sprintf("state=%v", mismatch.actualState))
+		}
+
+		for _, execution := range []*nosqlplugin.WorkflowExecutionRequest{mutatedExecution, resetExecution} {
+			if execution == nil {
+				continue
+			}
+			actualNextEventID, ok, err := db.updateWorkflowExecution(sc, shardID, execution, timeStamp)
+			if err != nil {
+				return err
+			}
+			if ok {
+				continue
+			}
+			if execution.RunID == currentWorkflowRequest.Row.RunID {
+				msg := fmt.Sprintf("Failed to update mutable state. previousNextEventIDCondition: %v, actualNextEventID: %v, Request Current RunID: %v",
+					previousNextEventIDCondition, actualNextEventID, currentWorkflowRequest.Row.RunID)
+				return &nosqlplugin.WorkflowOperationConditionFailure{
+					UnknownConditionFailureDetails: &msg,
+				}
+			}
+			return newUpdateWorkflowUnknownConditionFailure(shardCondition, previousNextEventIDCondition, currentWorkflowRequest,
+				fmt.Sprintf("run_id=%v", execution.RunID), fmt.Sprintf("next_event_id=%v", actualNextEventID))
+		}
+
+		if insertedExecution != nil {
+			existing, err := db.createWorkflowExecutionWithMergeMaps(sc, shardID, insertedExecution, timeStamp)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return newUpdateWorkflowUnknownConditionFailure(shardCondition, previousNextEventIDCondition, currentWorkflowRequest,
+					fmt.Sprintf("run_id=%v", existing.RunID), fmt.Sprintf("next_event_id=%v", existing.NextEventID))
+			}
+			if err := db.insertWorkflowActiveClusterSelectionPolicyRow(sc, activeClusterSelectionPolicyRow, timeStamp); err != nil {
+				return err
+			}
+		}
+
+		return db.createTasksByCategory(sc, shardID, tasksByCategory, timeStamp)
+	})
 }
 
+// Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	var doc cadence.CurrentWorkflowCollectionEntry
+	err := db.dbConn.Collection(cadence.CurrentWorkflowCollectionName).FindOne(ctx, currentWorkflowFilter(shardID, domainID, workflowID)).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            doc.RunID,
+		CreateRequestID:  doc.CreateRequestID,
+		State:            doc.State,
+		CloseStatus:      doc.CloseStatus,
+		LastWriteVersion: doc.LastWriteVersion,
+	}, nil
 }
 
+// Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	var doc cadence.WorkflowExecutionCollectionEntry
+	err := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName).FindOne(ctx, workflowExecutionFilter(shardID, domainID, workflowID, runID)).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return parseWorkflowExecution(&doc)
 }
 
 func (db *mdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	// a mismatched run ID means the current workflow has moved on, which is not an error same as Cassandra
+	filter := append(currentWorkflowFilter(shardID, domainID, workflowID), bson.E{Key: "runid", Value: currentRunIDCondition})
+	_, err := db.dbConn.Collection(cadence.CurrentWorkflowCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 func (db *mdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName).DeleteOne(ctx, workflowExecutionFilter(shardID, domainID, workflowID, runID))
+	return err
 }
 
 func (db *mdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage[cadence.CurrentWorkflowCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.CurrentWorkflowCollectionName),
+		shardFilter(shardID),
+		bson.D{{Key: "domainid", Value: 1}, {Key: "workflowid", Value: 1}},
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	var executions []*persistence.CurrentWorkflowExecution
+	for _, doc := range docs {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     doc.DomainID,
+			WorkflowID:   doc.WorkflowID,
+			RunID:        permanentRunID,
+			State:        doc.State,
+			CurrentRunID: doc.RunID,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	docs, nextPageToken, err := findPage[cadence.WorkflowExecutionCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.WorkflowExecutionCollectionName),
+		shardFilter(shardID),
+		bson.D{{Key: "domainid", Value: 1}, {Key: "workflowid", Value: 1}, {Key: "runid", Value: 1}},
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	var executions []*persistence.InternalListConcreteExecutionsEntity
+	for _, doc := range docs {
+		executionInfo, err := parseWorkflowExecutionInfo(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    executionInfo,
+			VersionHistories: toDataBlob(doc.VersionHistories, doc.VersionHistoriesEncoding),
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *mdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	count, err := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName).CountDocuments(
+		ctx,
+		workflowExecutionFilter(shardID, domainID, workflowID, runID),
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
 
 func (db *mdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	filter := append(
+		historyTaskFilter(shardID, persistence.HistoryTaskCategoryIDTransfer),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveMinTaskID}, {Key: "$lt", Value: exclusiveMaxTaskID}}},
+	)
+	return db.selectHistoryTasks(ctx, filter, bson.D{{Key: "taskid", Value: 1}}, pageSize, pageToken, parseTransferTask)
 }
 
 func (db *mdb) DeleteTransferTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	return db.deleteHistoryTasks(ctx, shardID, persistence.HistoryTaskCategoryIDTransfer, keys, false)
 }
 
 func (db *mdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	filter := append(
+		historyTaskFilter(shardID, persistence.HistoryTaskCategoryIDTransfer),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveBeginTaskID}, {Key: "$lt", Value: exclusiveEndTaskID}}},
+	)
+	_, err := db.dbConn.Collection(cadence.HistoryTaskCollectionName).DeleteMany(ctx, filter)
+	return err
 }
 
 func (db *mdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	filter := append(
+		historyTaskFilter(shardID, persistence.HistoryTaskCategoryIDTimer),
+		bson.E{Key: "visibilityts", Value: bson.D{{Key: "$gte", Value: toDBTimestamp(inclusiveMinTime)}, {Key: "$lt", Value: toDBTimestamp(exclusiveMaxTime)}}},
+	)
+	return db.selectHistoryTasks(ctx, filter, bson.D{{Key: "visibilityts", Value: 1}, {Key: "taskid", Value: 1}}, pageSize, pageToken, parseTimerTask)
 }
 
 func (db *mdb) DeleteTimerTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	return db.deleteHistoryTasks(ctx, shardID, persistence.HistoryTaskCategoryIDTimer, keys, true)
 }
 
 func (db *mdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	filter := append(
+		historyTaskFilter(shardID, persistence.HistoryTaskCategoryIDTimer),
+		bson.E{Key: "visibilityts", Value: bson.D{{Key: "$gte", Value: toDBTimestamp(inclusiveMinTime)}, {Key: "$lt", Value: toDBTimestamp(exclusiveMaxTime)}}},
+	)
+	_, err := db.dbConn.Collection(cadence.HistoryTaskCollectionName).DeleteMany(ctx, filter)
+	return err
 }
 
 func (db *mdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	filter := append(
+		historyTaskFilter(shardID, persistence.HistoryTaskCategoryIDReplication),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveMinTaskID}, {Key: "$lt", Value: exclusiveMaxTaskID}}},
+	)
+	return db.selectHistoryTasks(ctx, filter, bson.D{{Key: "taskid", Value: 1}}, pageSize, pageToken, parseReplicationTask)
 }
 
 func (db *mdb) DeleteReplicationTask(ctx context.Context, shardID int, keys []persistence.HistoryTaskKey) error {
-	panic("TODO")
+	return db.deleteHistoryTasks(ctx, shardID, persistence.HistoryTaskCategoryIDReplication, keys, false)
 }
 
 func (db *mdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	filter := append(
+		historyTaskFilter(shardID, persistence.HistoryTaskCategoryIDReplication),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$lt", Value: exclusiveEndTaskID}}},
+	)
+	_, err := db.dbConn.Collection(cadence.HistoryTaskCollectionName).DeleteMany(ctx, filter)
+	return err
 }
 
 func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.HistoryMigrationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	if len(tasks) == 0 {
+		return nil
+	}
+	return db.InsertHistoryTasks(ctx, map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask{
+		persistence.HistoryTaskCategoryReplication: tasks,
+	}, tasks[0].Replication.CurrentTimeStamp, condition)
 }
 
 func (db *mdb) InsertHistoryTasks(ctx context.Context, tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask, currentTimeStamp time.Time, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	return db.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		actualRangeID, ok, err := db.assertShardRangeID(sc, &condition)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			failure := &nosqlplugin.ShardOperationConditionFailure{
+				RangeID: actualRangeID,
+			}
+			if actualRangeID == -1 {
+				failure.Details = "shard doesn't exist"
+			}
+			return failure
+		}
+		return db.createTasksByCategory(sc, condition.ShardID, tasksByCategory, currentTimeStamp)
+	})
 }
 
 func (db *mdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	// cross cluster tasks are deprecated and never written by this plugin
+	return nil
 }
 
 func (db *mdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task *nosqlplugin.HistoryMigrationTask) error {
-	panic("TODO")
+	info, err := toJSON(task.Replication)
+	if err != nil {
+		return err
+	}
+	doc := &cadence.ReplicationDLQTaskCollectionEntry{
+		ShardID:       shardID,
+		SourceCluster: sourceCluster,
+		TaskID:        task.Replication.TaskID,
+		Info:          info,
+		CreatedTime:   unixNano(task.Replication.CurrentTimeStamp),
+	}
+	if task.Task != nil && len(task.Task.Data) > 0 {
+		doc.Data = task.Task.Data
+		doc.DataEncoding = task.Task.GetEncodingString()
+	}
+	filter := append(replicationDLQTaskFilter(shardID, sourceCluster), bson.E{Key: "taskid", Value: doc.TaskID})
+	_, err = db.dbConn.Collection(cadence.ReplicationDLQTaskCollectionName).ReplaceOne(ctx, filter, doc, options.Replace().SetUpsert(true))
+	return err
 }
 
 func (db *mdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, inclusiveMinTaskID, exclusiveMaxTaskID int64) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
-	panic("TODO")
+	filter := append(
+		replicationDLQTaskFilter(shardID, sourceCluster),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveMinTaskID}, {Key: "$lt", Value: exclusiveMaxTaskID}}},
+	)
+	docs, nextPageToken, err := findPage[cadence.ReplicationDLQTaskCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.ReplicationDLQTaskCollectionName),
+		filter,
+		bson.D{{Key: "taskid", Value: 1}},
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	var tasks []*nosqlplugin.HistoryMigrationTask
+	for _, doc := range docs {
+		task, err := parseReplicationTask(&cadence.HistoryTaskCollectionEntry{
+			TaskID:       doc.TaskID,
+			Info:         doc.Info,
+			Data:         doc.Data,
+			DataEncoding: doc.DataEncoding,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *mdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	count, err := db.dbConn.Collection(cadence.ReplicationDLQTaskCollectionName).CountDocuments(ctx, replicationDLQTaskFilter(shardID, sourceCluster))
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
 }
 
 func (db *mdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	filter := append(replicationDLQTaskFilter(shardID, sourceCluster), bson.E{Key: "taskid", Value: taskID})
+	_, err := db.dbConn.Collection(cadence.ReplicationDLQTaskCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 func (db *mdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, inclusiveBeginTaskID, exclusiveEndTaskID int64) error {
-	panic("TODO")
+	filter := append(
+		replicationDLQTaskFilter(shardID, sourceCluster),
+		bson.E{Key: "taskid", Value: bson.D{{Key: "$gte", Value: inclusiveBeginTaskID}, {Key: "$lt", Value: exclusiveEndTaskID}}},
+	)
+	_, err := db.dbConn.Collection(cadence.ReplicationDLQTaskCollectionName).DeleteMany(ctx, filter)
+	return err
 }
 
 func (db *mdb) SelectActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) (*nosqlplugin.ActiveClusterSelectionPolicyRow, error) {
-	panic("TODO")
+	var doc cadence.ActiveClusterSelectionPolicyCollectionEntry
+	found, err := findOne(ctx, db.dbConn.Collection(cadence.ActiveClusterSelectionPolicyCollectionName), workflowExecutionFilter(shardID, domainID, wfID, rID), &doc)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &nosqlplugin.ActiveClusterSelectionPolicyRow{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: wfID,
+		RunID:      rID,
+		Policy: &persistence.DataBlob{
+			Data:     doc.Data,
+			Encoding: constants.EncodingType(doc.DataEncoding),
+		},
+	}, nil
 }
 
 func (db *mdb) DeleteActiveClusterSelectionPolicy(ctx context.Context, shardID int, domainID, wfID, rID string) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.ActiveClusterSelectionPolicyCollectionName).DeleteOne(ctx, workflowExecutionFilter(shardID, domainID, wfID, rID))
+	return err
 }
 
+// SelectWorkflowTimerTasks reads the timer task keys kept in the execution document, ordered by the keys
 func (db *mdb) SelectWorkflowTimerTasks(ctx context.Context, shardID int, domainID, workflowID, runID string) ([]persistence.HistoryTaskKey, error) {
-	panic("TODO")
+	var doc cadence.WorkflowExecutionCollectionEntry
+	err := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName).FindOne(
+		ctx,
+		workflowExecutionFilter(shardID, domainID, workflowID, runID),
+		options.FindOne().SetProjection(bson.D{{Key: "workflowtimertasks", Value: 1}}),
+	).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return parseWorkflowTimerTasks(doc.WorkflowTimerTasks), nil
+}
+
+func (db *mdb) selectHistoryTasks(
+	ctx context.Context,
+	filter bson.D,
+	sort bson.D,
+	pageSize int,
+	pageToken []byte,
+	parse func(*cadence.HistoryTaskCollectionEntry) (*nosqlplugin.HistoryMigrationTask, error),
+) ([]*nosqlplugin.HistoryMigrationTask, []byte, error) {
+	docs, nextPageToken, err := findPage[cadence.HistoryTaskCollectionEntry](
+		ctx,
+		db.dbConn.Collection(cadence.HistoryTaskCollectionName),
+		filter,
+		sort,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks, err := parseHistoryTasks(docs, parse)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextPageToken, nil
+}
+
+// deleteHistoryTasks deletes the tasks by keys, the scheduled time is part of the key only for the timer tasks
+func (db *mdb) deleteHistoryTasks(ctx context.Context, shardID int, taskType int, keys []persistence.HistoryTaskKey, scheduled bool) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	conditions := make(bson.A, 0, len(keys))
+	for _, key := range keys {
+		condition := bson.D{{Key: "taskid", Value: key.GetTaskID()}}
+		if scheduled {
+			condition = append(condition, bson.E{Key: "visibilityts", Value: toDBTimestamp(key.GetScheduledTime())})
+		}
+		conditions = append(conditions, condition)
+	}
+	filter := append(historyTaskFilter(shardID, taskType), bson.E{Key: "$or", Value: conditions})
+	_, err := db.dbConn.Collection(cadence.HistoryTaskCollectionName).DeleteMany(ctx, filter)
+	return err
 }
@@ -0,0 +1,703 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	// Same as the Cassandra plugin
+	workflowRequestTTLInSeconds = 10800
+	permanentRunID              = "30000000-0000-f000-f000-000000000001"
+)
+
+// currentWorkflowMismatch tells why the write of the current workflow record is rejected
+type currentWorkflowMismatch struct {
+	// existing is the current workflow record that prevents the insert
+	existing *cadence.CurrentWorkflowCollectionEntry
+
+	runIDMismatch            bool
+	actualRunID              string
+	lastWriteVersionMismatch bool
+	actualLastWriteVersion   int64
+	stateMismatch            bool
+	actualState              int
+}
+
+func currentWorkflowFilter(shardID int, domainID, workflowID string) bson.D {
+	return bson.D{{Key: "shardid", Value: shardID}, {Key: "domainid", Value: domainID}, {Key: "workflowid", Value: workflowID}}
+}
+
+func workflowExecutionFilter(shardID int, domainID, workflowID, runID string) bson.D {
+	return append(currentWorkflowFilter(shardID, domainID, workflowID), bson.E{Key: "runid", Value: runID})
+}
+
+func historyTaskFilter(shardID int, taskType int) bson.D {
+	return bson.D{{Key: "shardid", Value: shardID}, {Key: "tasktype", Value: taskType}}
+}
+
+func replicationDLQTaskFilter(shardID int, sourceCluster string) bson.D {
+	return bson.D{{Key: "shardid", Value: shardID}, {Key: "sourcecluster", Value: sourceCluster}}
+}
+
+func (db *mdb) insertWorkflowActiveClusterSelectionPolicyRow(
+	sc mongo.SessionContext,
+	row *nosqlplugin.ActiveClusterSelectionPolicyRow,
+	timeStamp time.Time,
+) error {
+	if row == nil || row.Policy == nil {
+		return nil
+	}
+	_, err := db.dbConn.Collection(cadence.ActiveClusterSelectionPolicyCollectionName).ReplaceOne(
+		sc,
+		workflowExecutionFilter(row.ShardID, row.DomainID, row.WorkflowID, row.RunID),
+		&cadence.ActiveClusterSelectionPolicyCollectionEntry{
+			ShardID:      row.ShardID,
+			DomainID:     row.DomainID,
+			WorkflowID:   row.WorkflowID,
+			RunID:        row.RunID,
+			Data:         row.Policy.Data,
+			DataEncoding: row.Policy.GetEncodingString(),
+			LastUpdated:  unixNano(timeStamp),
+		},
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+// insertOrUpsertWorkflowRequestRow writes the workflow requests, returns the duplicate request if any of the requests
+// has been applied in the insert mode
+func (db *mdb) insertOrUpsertWorkflowRequestRow(
+	sc mongo.SessionContext,
+	requests *nosqlplugin.WorkflowRequestsWriteRequest,
+	timeStamp time.Time,
+) (*nosqlplugin.DuplicateRequest, error) {
+	if requests == nil {
+		return nil, nil
+	}
+	switch requests.WriteMode {
+	case nosqlplugin.WorkflowRequestWriteModeInsert, nosqlplugin.WorkflowRequestWriteModeUpsert:
+	default:
+		return nil, fmt.Errorf("unknown workflow request write mode %v", requests.WriteMode)
+	}
+
+	collection := db.dbConn.Collection(cadence.WorkflowRequestCollectionName)
+	for _, row := range requests.Rows {
+		filter := append(
+			currentWorkflowFilter(row.ShardID, row.DomainID, row.WorkflowID),
+			bson.E{Key: "requesttype", Value: int(row.RequestType)},
+			bson.E{Key: "requestid", Value: row.RequestID},
+		)
+		if requests.WriteMode == nosqlplugin.WorkflowRequestWriteModeInsert {
+			var existing cadence.WorkflowRequestCollectionEntry
+			found, err := findOne(sc, collection, filter, &existing)
+			if err != nil {
+				return nil, err
+			}
+			// expired documents are not deleted immediately, so they are treated as non-existing
+			if found && existing.Expiry.After(timeStamp) {
+				return &nosqlplugin.DuplicateRequest{
+					RequestType: row.RequestType,
+					RunID:       existing.RunID,
+				}, nil
+			}
+		}
+
+		_, err := collection.ReplaceOne(sc, filter, &cadence.WorkflowRequestCollectionEntry{
+			ShardID:     row.ShardID,
+			DomainID:    row.DomainID,
+			WorkflowID:  row.WorkflowID,
+			RequestType: int(row.RequestType),
+			RequestID:   row.RequestID,
+			RunID:       row.RunID,
+			Version:     row.Version,
+			LastUpdated: unixNano(timeStamp),
+			Expiry:      *expiryTime(timeStamp, workflowRequestTTLInSeconds),
+		}, options.Replace().SetUpsert(true))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// createOrUpdateCurrentWorkflow writes the current workflow record, returns the mismatch if the condition doesn't meet
+func (db *mdb) createOrUpdateCurrentWorkflow(
+	sc mongo.SessionContext,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+	timeStamp time.Time,
+) (*currentWorkflowMismatch, error) {
+	if request.WriteMode == nosqlplugin.CurrentWorkflowWriteModeNoop {
+		return nil, nil
+	}
+
+	collection := db.dbConn.Collection(cadence.CurrentWorkflowCollectionName)
+	filter := currentWorkflowFilter(shardID, domainID, workflowID)
+	var existing cadence.CurrentWorkflowCollectionEntry
+	found, err := findOne(sc, collection, filter, &existing)
+	if err != nil {
+		return nil, err
+	}
+
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		if found {
+			return &currentWorkflowMismatch{existing: &existing}, nil
+		}
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return nil, fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		mismatch := &currentWorkflowMismatch{
+			actualRunID:            existing.RunID,
+			actualLastWriteVersion: constants.EmptyVersion,
+		}
+		if !found || existing.RunID != *request.Condition.CurrentRunID {
+			mismatch.runIDMismatch = true
+		}
+		if found && request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			mismatch.actualLastWriteVersion = existing.LastWriteVersion
+			mismatch.actualState = existing.State
+			mismatch.lastWriteVersionMismatch = existing.LastWriteVersion != *request.Condition.LastWriteVersion
+			mismatch.stateMismatch = existing.State != *request.Condition.State
+		}
+		if mismatch.runIDMismatch || mismatch.lastWriteVersionMismatch || mismatch.stateMismatch {
+			return mismatch, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+
+	_, err = collection.ReplaceOne(sc, filter, &cadence.CurrentWorkflowCollectionEntry{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            request.Row.RunID,
+		CreateRequestID:  request.Row.CreateRequestID,
+		State:            request.Row.State,
+		CloseStatus:      request.Row.CloseStatus,
+		LastWriteVersion: request.Row.LastWriteVersion,
+		LastUpdated:      unixNano(timeStamp),
+	}, options.Replace().SetUpsert(true))
+	return nil, err
+}
+
+// createWorkflowExecutionWithMergeMaps inserts a new run, returns the existing run if there is one
+func (db *mdb) createWorkflowExecutionWithMergeMaps(
+	sc mongo.SessionContext,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	timeStamp time.Time,
+) (*cadence.WorkflowExecutionCollectionEntry, error) {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return nil, fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return nil, fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	collection := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName)
+	var existing cadence.WorkflowExecutionCollectionEntry
+	found, err := findOne(sc, collection, workflowExecutionFilter(shardID, execution.DomainID, execution.WorkflowID, execution.RunID), &existing)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return &existing, nil
+	}
+
+	doc := &cadence.WorkflowExecutionCollectionEntry{
+		ShardID:            shardID,
+		DomainID:           execution.DomainID,
+		WorkflowID:         execution.WorkflowID,
+		RunID:              execution.RunID,
+		SignalRequested:    []string{},
+		WorkflowTimerTasks: []cadence.WorkflowTimerTaskEntry{},
+		BufferedEvents:     []cadence.WorkflowBufferedEventsEntry{},
+	}
+	if err := setWorkflowExecutionAttributes(doc, execution, timeStamp); err != nil {
+		return nil, err
+	}
+	if err := setWorkflowExecutionMaps(doc, execution); err != nil {
+		return nil, err
+	}
+	appendWorkflowTimerTasks(doc, execution.WorkflowTimerTasks)
+
+	_, err = collection.InsertOne(sc, doc)
+	return nil, err
+}
+
+// updateWorkflowExecution updates an existing run on the condition of the previous next event ID.
+// The maps are merged for WorkflowExecutionMapsWriteModeUpdate and replaced for WorkflowExecutionMapsWriteModeReset.
+// It returns the actual next event ID and false if the condition doesn't meet.
+func (db *mdb) updateWorkflowExecution(
+	sc mongo.SessionContext,
+	shardID int,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	timeStamp time.Time,
+) (int64, bool, error) {
+	switch execution.MapsWriteMode {
+	case nosqlplugin.WorkflowExecutionMapsWriteModeUpdate:
+	case nosqlplugin.WorkflowExecutionMapsWriteModeReset:
+		if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+			return 0, false, fmt.Errorf("should only support EventBufferWriteModeClear")
+		}
+	default:
+		return 0, false, fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate or WorkflowExecutionMapsWriteModeReset")
+	}
+
+	collection := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName)
+	filter := workflowExecutionFilter(shardID, execution.DomainID, execution.WorkflowID, execution.RunID)
+	var doc cadence.WorkflowExecutionCollectionEntry
+	found, err := findOne(sc, collection, filter, &doc)
+	if err != nil {
+		return 0, false, err
+	}
+	var previousNextEventID int64
+	if execution.PreviousNextEventIDCondition != nil {
+		previousNextEventID = *execution.PreviousNextEventIDCondition
+	}
+	if !found || doc.NextEventID != previousNextEventID {
+		return doc.NextEventID, false, nil
+	}
+
+	if err := setWorkflowExecutionAttributes(&doc, execution, timeStamp); err != nil {
+		return 0, false, err
+	}
+	switch execution.EventBufferWriteMode {
+	case nosqlplugin.EventBufferWriteModeClear:
+		doc.BufferedEvents = []cadence.WorkflowBufferedEventsEntry{}
+	case nosqlplugin.EventBufferWriteModeAppend:
+		doc.BufferedEvents = append(doc.BufferedEvents, cadence.WorkflowBufferedEventsEntry{
+			Data:     execution.NewBufferedEventBatch.Data,
+			Encoding: execution.NewBufferedEventBatch.GetEncodingString(),
+		})
+	}
+	if execution.MapsWriteMode == nosqlplugin.WorkflowExecutionMapsWriteModeReset {
+		err = setWorkflowExecutionMaps(&doc, execution)
+	} else {
+		err = mergeWorkflowExecutionMaps(&doc, execution)
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	appendWorkflowTimerTasks(&doc, execution.WorkflowTimerTasks)
+
+	_, err = collection.ReplaceOne(sc, filter, &doc)
+	return 0, true, err
+}
+
+func (db *mdb) createTasksByCategory(
+	sc mongo.SessionContext,
+	shardID int,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+	timeStamp time.Time,
+) error {
+	docs, err := newHistoryTaskEntries(shardID, tasksByCategory, timeStamp)
+	if err != nil || len(docs) == 0 {
+		return err
+	}
+	// same as Cassandra, the tasks are overwritten if they exist already
+	collection := db.dbConn.Collection(cadence.HistoryTaskCollectionName)
+	models := make([]mongo.WriteModel, 0, len(docs))
+	for _, doc := range docs {
+		filter := append(
+			historyTaskFilter(doc.ShardID, doc.TaskType),
+			bson.E{Key: "visibilityts", Value: doc.VisibilityTS},
+			bson.E{Key: "taskid", Value: doc.TaskID},
+		)
+		models = append(models, mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(doc).SetUpsert(true))
+	}
+	_, err = collection.BulkWrite(sc, models)
+	return err
+}
+
+// setWorkflowExecutionAttributes sets the attributes of a run except the maps and buffered events
+func setWorkflowExecutionAttributes(doc *cadence.WorkflowExecutionCollectionEntry, execution *nosqlplugin.WorkflowExecutionRequest, timeStamp time.Time) error {
+	info, err := toJSON(&execution.InternalWorkflowExecutionInfo)
+	if err != nil {
+		return err
+	}
+	checksumData, err := toJSON(execution.Checksums)
+	if err != nil {
+		return err
+	}
+	doc.NextEventID = execution.NextEventID
+	doc.LastWriteVersion = execution.LastWriteVersion
+	doc.Info = info
+	doc.Checksum = checksumData
+	doc.LastUpdated = unixNano(timeStamp)
+	doc.VersionHistories = nil
+	doc.VersionHistoriesEncoding = ""
+	if execution.VersionHistories != nil {
+		doc.VersionHistories = execution.VersionHistories.Data
+		doc.VersionHistoriesEncoding = execution.VersionHistories.GetEncodingString()
+	}
+	return nil
+}
+
+// setWorkflowExecutionMaps replaces the five maps and the signal requested IDs as a whole
+func setWorkflowExecutionMaps(doc *cadence.WorkflowExecutionCollectionEntry, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	var err error
+	if doc.ActivityMap, err = newJSONMap(execution.ActivityInfos); err != nil {
+		return err
+	}
+	if doc.TimerMap, err = newJSONMap(execution.TimerInfos); err != nil {
+		return err
+	}
+	if doc.ChildExecutionMap, err = newJSONMap(execution.ChildWorkflowInfos); err != nil {
+		return err
+	}
+	if doc.RequestCancelMap, err = newJSONMap(execution.RequestCancelInfos); err != nil {
+		return err
+	}
+	if doc.SignalMap, err = newJSONMap(execution.SignalInfos); err != nil {
+		return err
+	}
+	doc.SignalRequested = mergeSignalsRequested(nil, execution.SignalRequestedIDs, nil)
+	return nil
+}
+
+// mergeWorkflowExecutionMaps deletes and then sets the entries of the five maps and the signal requested IDs
+func mergeWorkflowExecutionMaps(doc *cadence.WorkflowExecutionCollectionEntry, execution *nosqlplugin.WorkflowExecutionRequest) error {
+	var err error
+	if doc.ActivityMap, err = mergeJSONMap(doc.ActivityMap, execution.ActivityInfos, execution.ActivityInfoKeysToDelete); err != nil {
+		return err
+	}
+	if doc.TimerMap, err = mergeJSONMap(doc.TimerMap, execution.TimerInfos, execution.TimerInfoKeysToDelete); err != nil {
+		return err
+	}
+	if doc.ChildExecutionMap, err = mergeJSONMap(doc.ChildExecutionMap, execution.ChildWorkflowInfos, execution.ChildWorkflowInfoKeysToDelete); err != nil {
+		return err
+	}
+	if doc.RequestCancelMap, err = mergeJSONMap(doc.RequestCancelMap, execution.RequestCancelInfos, execution.RequestCancelInfoKeysToDelete); err != nil {
+		return err
+	}
+	if doc.SignalMap, err = mergeJSONMap(doc.SignalMap, execution.SignalInfos, execution.SignalInfoKeysToDelete); err != nil {
+		return err
+	}
+	doc.SignalRequested = mergeSignalsRequested(doc.SignalRequested, execution.SignalRequestedIDs, execution.SignalRequestedIDsKeysToDelete)
+	return nil
+}
+
+// mergeSignalsRequested removes and then adds the IDs, the result has no duplicates like the Cassandra set
+func mergeSignalsRequested(existing []string, signalRequestedIDs []string, signalRequestedIDsToDelete []string) []string {
+	deleted := make(map[string]struct{}, len(signalRequestedIDsToDelete))
+	for _, id := range signalRequestedIDsToDelete {
+		deleted[id] = struct{}{}
+	}
+	result := make([]string, 0, len(existing)+len(signalRequestedIDs))
+	seen := make(map[string]struct{}, len(existing)+len(signalRequestedIDs))
+	for _, id := range existing {
+		if _, ok := deleted[id]; ok {
+			continue
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			result = append(result, id)
+		}
+	}
+	for _, id := range signalRequestedIDs {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// appendWorkflowTimerTasks adds the timer task keys, the identical keys are deduped like the Cassandra set
+func appendWorkflowTimerTasks(doc *cadence.WorkflowExecutionCollectionEntry, timerTasks []persistence.HistoryTaskKey) {
+	seen := make(map[cadence.WorkflowTimerTaskEntry]struct{}, len(doc.WorkflowTimerTasks))
+	for _, entry := range doc.WorkflowTimerTasks {
+		seen[entry] = struct{}{}
+	}
+	for _, key := range timerTasks {
+		entry := cadence.WorkflowTimerTaskEntry{
+			VisibilityTS: toDBTimestamp(key.GetScheduledTime()),
+			TaskID:       key.GetTaskID(),
+		}
+		if _, ok := seen[entry]; ok {
+			continue
+		}
+		seen[entry] = struct{}{}
+		doc.WorkflowTimerTasks = append(doc.WorkflowTimerTasks, entry)
+	}
+}
+
+func parseWorkflowTimerTasks(entries []cadence.WorkflowTimerTaskEntry) []persistence.HistoryTaskKey {
+	if len(entries) == 0 {
+		return nil
+	}
+	keys := make([]persistence.HistoryTaskKey, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, persistence.NewHistoryTaskKey(fromDBTimestamp(entry.VisibilityTS), entry.TaskID))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Less(keys[j])
+	})
+	return keys
+}
+
+func parseWorkflowExecutionInfo(doc *cadence.WorkflowExecutionCollectionEntry) (*persistence.InternalWorkflowExecutionInfo, error) {
+	info := &persistence.InternalWorkflowExecutionInfo{}
+	if err := fromJSON(doc.Info, info); err != nil {
+		return nil, err
+	}
+	info.CompletionEvent = normalizeDataBlob(info.CompletionEvent)
+	info.AutoResetPoints = normalizeDataBlob(info.AutoResetPoints)
+	info.ActiveClusterSelectionPolicy = normalizeDataBlob(info.ActiveClusterSelectionPolicy)
+	return info, nil
+}
+
+func parseWorkflowExecution(doc *cadence.WorkflowExecutionCollectionEntry) (*nosqlplugin.WorkflowExecution, error) {
+	info, err := parseWorkflowExecutionInfo(doc)
+	if err != nil {
+		return nil, err
+	}
+	state := &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:    info,
+		VersionHistories: toDataBlob(doc.VersionHistories, doc.VersionHistoriesEncoding),
+	}
+
+	if state.ActivityInfos, err = parseJSONMap[int64, persistence.InternalActivityInfo](doc.ActivityMap); err != nil {
+		return nil, err
+	}
+	if state.TimerInfos, err = parseJSONMap[string, persistence.TimerInfo](doc.TimerMap); err != nil {
+		return nil, err
+	}
+	if state.ChildExecutionInfos, err = parseJSONMap[int64, persistence.InternalChildExecutionInfo](doc.ChildExecutionMap); err != nil {
+		return nil, err
+	}
+	if state.RequestCancelInfos, err = parseJSONMap[int64, persistence.RequestCancelInfo](doc.RequestCancelMap); err != nil {
+		return nil, err
+	}
+	if state.SignalInfos, err = parseJSONMap[int64, persistence.SignalInfo](doc.SignalMap); err != nil {
+		return nil, err
+	}
+
+	state.SignalRequestedIDs = make(map[string]struct{}, len(doc.SignalRequested))
+	for _, id := range doc.SignalRequested {
+		state.SignalRequestedIDs[id] = struct{}{}
+	}
+
+	state.BufferedEvents = make([]*persistence.DataBlob, 0, len(doc.BufferedEvents))
+	for _, event := range doc.BufferedEvents {
+		state.BufferedEvents = append(state.BufferedEvents, &persistence.DataBlob{
+			Data:     event.Data,
+			Encoding: constants.EncodingType(event.Encoding),
+		})
+	}
+
+	var cs *checksum.Checksum
+	if err := fromJSON(doc.Checksum, &cs); err != nil {
+		return nil, err
+	}
+	if cs != nil {
+		state.Checksum = *cs
+	}
+	return state, nil
+}
+
+// newHistoryTaskEntry returns the document of a history task, the legacy task info is kept as JSON
+func newHistoryTaskEntry(
+	shardID int,
+	taskType int,
+	task *nosqlplugin.HistoryMigrationTask,
+	legacyInfo interface{},
+	taskID int64,
+	visibilityTimestamp int64,
+	timeStamp time.Time,
+) (*cadence.HistoryTaskCollectionEntry, error) {
+	info, err := toJSON(legacyInfo)
+	if err != nil {
+		return nil, err
+	}
+	doc := &cadence.HistoryTaskCollectionEntry{
+		ShardID:      shardID,
+		TaskType:     taskType,
+		VisibilityTS: visibilityTimestamp,
+		TaskID:       taskID,
+		Info:         info,
+		CreatedTime:  unixNano(timeStamp),
+	}
+	if task.Task != nil && len(task.Task.Data) > 0 {
+		doc.Data = task.Task.Data
+		doc.DataEncoding = task.Task.GetEncodingString()
+	}
+	return doc, nil
+}
+
+// newHistoryTaskEntries returns the documents of the history tasks, the tasks of the categories other than
+// transfer, timer and replication are not supported yet, same as the Cassandra plugin
+func newHistoryTaskEntries(
+	shardID int,
+	tasksByCategory map[persistence.HistoryTaskCategory][]*nosqlplugin.HistoryMigrationTask,
+	timeStamp time.Time,
+) ([]*cadence.HistoryTaskCollectionEntry, error) {
+	var docs []*cadence.HistoryTaskCollectionEntry
+	for c, tasks := range tasksByCategory {
+		for _, task := range tasks {
+			var doc *cadence.HistoryTaskCollectionEntry
+			var err error
+			switch c.ID() {
+			case persistence.HistoryTaskCategoryIDTransfer:
+				doc, err = newHistoryTaskEntry(shardID, persistence.HistoryTaskCategoryIDTransfer, task, task.Transfer,
+					task.Transfer.TaskID, 0, timeStamp)
+			case persistence.HistoryTaskCategoryIDTimer:
+				doc, err = newHistoryTaskEntry(shardID, persistence.HistoryTaskCategoryIDTimer, task, task.Timer,
+					task.Timer.TaskID, toDBTimestamp(task.Timer.VisibilityTimestamp), timeStamp)
+			case persistence.HistoryTaskCategoryIDReplication:
+				doc, err = newHistoryTaskEntry(shardID, persistence.HistoryTaskCategoryIDReplication, task, task.Replication,
+					task.Replication.TaskID, 0, timeStamp)
+			default:
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, doc)
+		}
+	}
+	return docs, nil
+}
+
+func parseTransferTask(doc *cadence.HistoryTaskCollectionEntry) (*nosqlplugin.HistoryMigrationTask, error) {
+	var info *nosqlplugin.TransferTask
+	if err := fromJSON(doc.Info, &info); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryMigrationTask{
+		Transfer: info,
+		Task:     toDataBlob(doc.Data, doc.DataEncoding),
+		TaskID:   doc.TaskID,
+	}, nil
+}
+
+func parseTimerTask(doc *cadence.HistoryTaskCollectionEntry) (*nosqlplugin.HistoryMigrationTask, error) {
+	var info *nosqlplugin.TimerTask
+	if err := fromJSON(doc.Info, &info); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryMigrationTask{
+		Timer:         info,
+		Task:          toDataBlob(doc.Data, doc.DataEncoding),
+		TaskID:        doc.TaskID,
+		ScheduledTime: fromDBTimestamp(doc.VisibilityTS),
+	}, nil
+}
+
+func parseReplicationTask(doc *cadence.HistoryTaskCollectionEntry) (*nosqlplugin.HistoryMigrationTask, error) {
+	var info *nosqlplugin.ReplicationTask
+	if err := fromJSON(doc.Info, &info); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.HistoryMigrationTask{
+		Replication: info,
+		Task:        toDataBlob(doc.Data, doc.DataEncoding),
+		TaskID:      doc.TaskID,
+	}, nil
+}
+
+func parseHistoryTasks(
+	docs []*cadence.HistoryTaskCollectionEntry,
+	parse func(*cadence.HistoryTaskCollectionEntry) (*nosqlplugin.HistoryMigrationTask, error),
+) ([]*nosqlplugin.HistoryMigrationTask, error) {
+	var tasks []*nosqlplugin.HistoryMigrationTask
+	for _, doc := range docs {
+		task, err := parse(doc)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func newCreateWorkflowCurrentConditionFailure(
+	mismatch *currentWorkflowMismatch,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+) *nosqlplugin.WorkflowOperationConditionFailure {
+	// CreateWorkflowExecution failed because there is already a current execution record for this workflow
+	if existing := mismatch.existing; existing != nil {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v", currentWorkflowRequest.Row.WorkflowID, existing.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  existing.CreateRequestID,
+				RunID:            existing.RunID,
+				State:            existing.State,
+				CloseStatus:      existing.CloseStatus,
+				LastWriteVersion: existing.LastWriteVersion,
+			},
+		}
+	}
+
+	var msg string
+	switch {
+	case mismatch.runIDMismatch:
+		msg = fmt.Sprintf("Workflow execution creation condition failed by mismatch runID. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+			currentWorkflowRequest.Row.WorkflowID, currentWorkflowRequest.Condition.GetCurrentRunID(), mismatch.actualRunID)
+	case mismatch.lastWriteVersionMismatch:
+		msg = fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected Version: %v, Actual Version: %v",
+			currentWorkflowRequest.Row.WorkflowID, *currentWorkflowRequest.Condition.LastWriteVersion, mismatch.actualLastWriteVersion)
+	default:
+		msg = fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, Expected State: %v, Actual State: %v",
+			currentWorkflowRequest.Row.WorkflowID, *currentWorkflowRequest.Condition.State, mismatch.actualState)
+	}
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		CurrentWorkflowConditionFailInfo: &msg,
+	}
+}
+
+func newUpdateWorkflowUnknownConditionFailure(
+	shardCondition *nosqlplugin.ShardCondition,
+	previousNextEventIDCondition int64,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	columns ...string,
+) *nosqlplugin.WorkflowOperationConditionFailure {
+	requestConditionalRunID := ""
+	if currentWorkflowRequest.Condition != nil {
+		requestConditionalRunID = currentWorkflowRequest.Condition.GetCurrentRunID()
+	}
+	msg := fmt.Sprintf("Failed to update mutable state. ShardID: %v, RangeID: %v, previousNextEventIDCondition: %v, requestConditionalRunID: %v, columns: (%v)",
+		shardCondition.ShardID, shardCondition.RangeID, previousNextEventIDCondition, requestConditionalRunID, strings.Join(columns, ","))
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
@@ -0,0 +1,388 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nosqlplugin
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+// enums of VisibilityQueryOperator
+const (
+	VisibilityQueryEqual VisibilityQueryOperator = iota
+	VisibilityQueryGreaterThan
+	VisibilityQueryGreaterEqual
+	VisibilityQueryLessThan
+	VisibilityQueryLessEqual
+	// VisibilityQueryMissing matches the records without a value, i.e. open workflows for CloseStatus and CloseTime
+	VisibilityQueryMissing
+)
+
+const visibilityMissingValue = "missing"
+
+var searchAttributeKeyRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+type (
+	// VisibilityQuery is a parsed visibility query.
+	// NoSQL databases can't serve arbitrary predicates efficiently, so only a conjunction of
+	// conditions is supported, and records are always ordered by StartTime descending.
+	VisibilityQuery struct {
+		Conditions []VisibilityQueryCondition
+	}
+
+	// VisibilityQueryCondition compares a search attribute with a value
+	VisibilityQueryCondition struct {
+		// Attribute is the name of a system search attribute, or the key of a custom search attribute
+		Attribute string
+		IsCustom  bool
+		// ValueType is only set for custom search attributes
+		ValueType types.IndexedValueType
+		Operator  VisibilityQueryOperator
+		// Value is a string, int64, int32 (CloseStatus and ExecutionStatus), bool or time.Time.
+		// It is nil for VisibilityQueryMissing.
+		Value interface{}
+	}
+
+	VisibilityQueryOperator int
+
+	visibilityQueryAttribute struct {
+		parse func(sqlparser.Expr) (interface{}, error)
+		// supportsRange is true if the attribute can be compared with <, <=, >, >= and BETWEEN
+		supportsRange bool
+		// supportsMissing is true if the attribute can be compared with the missing value
+		supportsMissing bool
+	}
+)
+
+var visibilityQueryAttributes = map[string]visibilityQueryAttribute{
+	definition.WorkflowID:      {parse: parseVisibilityQueryString},
+	definition.RunID:           {parse: parseVisibilityQueryString},
+	definition.WorkflowType:    {parse: parseVisibilityQueryString},
+	definition.CloseStatus:     {parse: parseVisibilityQueryCloseStatus, supportsMissing: true},
+	definition.ExecutionStatus: {parse: parseVisibilityQueryExecutionStatus},
+	definition.IsCron:          {parse: parseVisibilityQueryBool},
+	definition.StartTime:       {parse: parseVisibilityQueryTime, supportsRange: true},
+	definition.CloseTime:       {parse: parseVisibilityQueryTime, supportsRange: true, supportsMissing: true},
+	definition.HistoryLength:   {parse: parseVisibilityQueryInt, supportsRange: true},
+}
+
+// ParseVisibilityQuery parses a visibility query into a VisibilityQuery. searchAttributeTypes is
+// used to compare custom search attributes with the right type; when a key is missing from it,
+// the type is inferred from the literal the attribute is compared with.
+// Any query NoSQL visibility can't serve is rejected with a BadRequestError explaining why.
+func ParseVisibilityQuery(query string, searchAttributeTypes map[string]types.IndexedValueType) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return &VisibilityQuery{}, nil
+	}
+
+	// #nosec
+	placeholderQuery := fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: "Invalid query."}
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, &types.BadRequestError{Message: "Invalid select query."}
+	}
+
+	result := &VisibilityQuery{}
+	if sel.Where != nil {
+		if err := result.parseConditions(sel.Where.Expr, searchAttributeTypes); err != nil {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+	}
+	if err := validateVisibilityQueryOrderBy(sel.OrderBy); err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	return result, nil
+}
+
+func (q *VisibilityQuery) parseConditions(expr sqlparser.Expr, searchAttributeTypes map[string]types.IndexedValueType) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		if err := q.parseConditions(expr.Left, searchAttributeTypes); err != nil {
+			return err
+		}
+		return q.parseConditions(expr.Right, searchAttributeTypes)
+	case *sqlparser.ParenExpr:
+		return q.parseConditions(expr.Expr, searchAttributeTypes)
+	case *sqlparser.ComparisonExpr:
+		condition, err := parseVisibilityQueryComparison(expr, searchAttributeTypes)
+		if err != nil {
+			return err
+		}
+		q.Conditions = append(q.Conditions, condition)
+		return nil
+	case *sqlparser.RangeCond:
+		if expr.Operator != sqlparser.BetweenStr {
+			return fmt.Errorf("operator %q is not supported by NoSQL visibility", expr.Operator)
+		}
+		from, err := parseVisibilityQueryRangeBound(expr.Left, VisibilityQueryGreaterEqual, expr.From, searchAttributeTypes)
+		if err != nil {
+			return err
+		}
+		to, err := parseVisibilityQueryRangeBound(expr.Left, VisibilityQueryLessEqual, expr.To, searchAttributeTypes)
+		if err != nil {
+			return err
+		}
+		q.Conditions = append(q.Conditions, from, to)
+		return nil
+	case *sqlparser.OrExpr:
+		return fmt.Errorf("operator OR is not supported by NoSQL visibility, conditions can only be combined with AND")
+	case *sqlparser.NotExpr:
+		return fmt.Errorf("operator NOT is not supported by NoSQL visibility")
+	default:
+		return fmt.Errorf("invalid where clause")
+	}
+}
+
+func parseVisibilityQueryComparison(
+	expr *sqlparser.ComparisonExpr,
+	searchAttributeTypes map[string]types.IndexedValueType,
+) (VisibilityQueryCondition, error) {
+	if colName, ok := expr.Right.(*sqlparser.ColName); ok && strings.ToLower(colName.Name.String()) == visibilityMissingValue {
+		condition, attribute, err := getVisibilityQueryAttribute(expr.Left, searchAttributeTypes, nil)
+		if err != nil {
+			return VisibilityQueryCondition{}, err
+		}
+		if expr.Operator != sqlparser.EqualStr || !attribute.supportsMissing {
+			return VisibilityQueryCondition{}, fmt.Errorf(
+				"NoSQL visibility only supports %v = missing and %v = missing", definition.CloseStatus, definition.CloseTime)
+		}
+		condition.Operator = VisibilityQueryMissing
+		return condition, nil
+	}
+
+	var operator VisibilityQueryOperator
+	switch expr.Operator {
+	case sqlparser.EqualStr:
+		operator = VisibilityQueryEqual
+	case sqlparser.GreaterThanStr:
+		operator = VisibilityQueryGreaterThan
+	case sqlparser.GreaterEqualStr:
+		operator = VisibilityQueryGreaterEqual
+	case sqlparser.LessThanStr:
+		operator = VisibilityQueryLessThan
+	case sqlparser.LessEqualStr:
+		operator = VisibilityQueryLessEqual
+	default:
+		return VisibilityQueryCondition{}, fmt.Errorf("operator %q is not supported by NoSQL visibility", expr.Operator)
+	}
+	return parseVisibilityQueryRangeBound(expr.Left, operator, expr.Right, searchAttributeTypes)
+}
+
+func parseVisibilityQueryRangeBound(
+	left sqlparser.Expr,
+	operator VisibilityQueryOperator,
+	right sqlparser.Expr,
+	searchAttributeTypes map[string]types.IndexedValueType,
+) (VisibilityQueryCondition, error) {
+	condition, attribute, err := getVisibilityQueryAttribute(left, searchAttributeTypes, right)
+	if err != nil {
+		return VisibilityQueryCondition{}, err
+	}
+	if operator != VisibilityQueryEqual && !attribute.supportsRange {
+		return VisibilityQueryCondition{}, fmt.Errorf(
+			"NoSQL visibility only supports range conditions on %v, %v and %v",
+			definition.StartTime, definition.CloseTime, definition.HistoryLength)
+	}
+	condition.Operator = operator
+	condition.Value, err = attribute.parse(right)
+	if err != nil {
+		return VisibilityQueryCondition{}, err
+	}
+	return condition, nil
+}
+
+// getVisibilityQueryAttribute resolves the search attribute expr refers to. value is used to infer the type of
+// custom search attributes which are not in searchAttributeTypes, and may be nil.
+func getVisibilityQueryAttribute(
+	expr sqlparser.Expr,
+	searchAttributeTypes map[string]types.IndexedValueType,
+	value sqlparser.Expr,
+) (VisibilityQueryCondition, visibilityQueryAttribute, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return VisibilityQueryCondition{}, visibilityQueryAttribute{}, fmt.Errorf("invalid search attribute expression")
+	}
+	name := colName.Name.String()
+	isCustom := false
+	if strings.HasPrefix(name, definition.Attr+".") {
+		name = strings.TrimPrefix(name, definition.Attr+".")
+		isCustom = true
+	} else if colName.Qualifier.Name.String() == definition.Attr {
+		isCustom = true
+	}
+
+	if !isCustom {
+		attribute, ok := visibilityQueryAttributes[name]
+		if !ok {
+			return VisibilityQueryCondition{}, visibilityQueryAttribute{}, fmt.Errorf("search attribute %q is not supported by NoSQL visibility", name)
+		}
+		return VisibilityQueryCondition{Attribute: name}, attribute, nil
+	}
+
+	if !searchAttributeKeyRegex.MatchString(name) {
+		return VisibilityQueryCondition{}, visibilityQueryAttribute{}, fmt.Errorf("invalid search attribute %q", name)
+	}
+	valueType, ok := searchAttributeTypes[name]
+	if !ok {
+		valueType = inferVisibilityQueryValueType(value)
+	}
+	condition := VisibilityQueryCondition{Attribute: name, IsCustom: true, ValueType: valueType}
+	switch valueType {
+	case types.IndexedValueTypeKeyword:
+		return condition, visibilityQueryAttribute{parse: parseVisibilityQueryString}, nil
+	case types.IndexedValueTypeInt:
+		return condition, visibilityQueryAttribute{parse: parseVisibilityQueryInt}, nil
+	case types.IndexedValueTypeBool:
+		return condition, visibilityQueryAttribute{parse: parseVisibilityQueryBool}, nil
+	default:
+		return VisibilityQueryCondition{}, visibilityQueryAttribute{}, fmt.Errorf(
+			"search attribute %q of type %v is not supported by NoSQL visibility, only Keyword, Int and Bool search attributes can be queried",
+			name, valueType)
+	}
+}
+
+func validateVisibilityQueryOrderBy(orderBy sqlparser.OrderBy) error {
+	if len(orderBy) == 0 {
+		return nil
+	}
+	if len(orderBy) == 1 && orderBy[0].Direction == sqlparser.DescScr {
+		if colName, ok := orderBy[0].Expr.(*sqlparser.ColName); ok && colName.Name.String() == definition.StartTime && colName.Qualifier.IsEmpty() {
+			return nil
+		}
+	}
+	return fmt.Errorf("NoSQL visibility only supports ORDER BY %v DESC", definition.StartTime)
+}
+
+func inferVisibilityQueryValueType(value sqlparser.Expr) types.IndexedValueType {
+	switch value := value.(type) {
+	case sqlparser.BoolVal:
+		return types.IndexedValueTypeBool
+	case *sqlparser.SQLVal:
+		switch value.Type {
+		case sqlparser.IntVal:
+			return types.IndexedValueTypeInt
+		case sqlparser.FloatVal:
+			return types.IndexedValueTypeDouble
+		}
+	}
+	return types.IndexedValueTypeKeyword
+}
+
+func getVisibilityQueryLiteral(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		return string(expr.Val), nil
+	case sqlparser.BoolVal:
+		return strconv.FormatBool(bool(expr)), nil
+	default:
+		return "", fmt.Errorf("invalid value %v", sqlparser.String(expr))
+	}
+}
+
+func parseVisibilityQueryString(expr sqlparser.Expr) (interface{}, error) {
+	return getVisibilityQueryLiteral(expr)
+}
+
+func parseVisibilityQueryInt(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityQueryLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid int value %q", str)
+	}
+	return value, nil
+}
+
+func parseVisibilityQueryBool(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityQueryLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseBool(str)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bool value %q", str)
+	}
+	return value, nil
+}
+
+func parseVisibilityQueryTime(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityQueryLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	if nanos, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return time.Unix(0, nanos).UTC(), nil
+	}
+	value, err := time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time value %q", str)
+	}
+	return value.UTC(), nil
+}
+
+func parseVisibilityQueryCloseStatus(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityQueryLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	if value, err := strconv.ParseInt(str, 10, 32); err == nil {
+		return int32(value), nil
+	}
+	var status types.WorkflowExecutionCloseStatus
+	if err := status.UnmarshalText([]byte(str)); err != nil {
+		return nil, fmt.Errorf("invalid close status %q", str)
+	}
+	return int32(status), nil
+}
+
+func parseVisibilityQueryExecutionStatus(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityQueryLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	if value, err := strconv.ParseInt(str, 10, 32); err == nil {
+		return int32(value), nil
+	}
+	var status types.WorkflowExecutionStatus
+	if err := status.UnmarshalText([]byte(str)); err != nil {
+		return nil, fmt.Errorf("invalid execution status %q", str)
+	}
+	return int32(status), nil
+}
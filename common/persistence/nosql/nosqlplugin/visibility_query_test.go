@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nosqlplugin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestParseVisibilityQuery(t *testing.T) {
+	searchAttributeTypes := map[string]types.IndexedValueType{
+		definition.CustomKeywordField:  types.IndexedValueTypeKeyword,
+		definition.CustomIntField:      types.IndexedValueTypeInt,
+		definition.CustomBoolField:     types.IndexedValueTypeBool,
+		definition.CustomDatetimeField: types.IndexedValueTypeDatetime,
+		definition.CustomStringField:   types.IndexedValueTypeString,
+	}
+	startTime := time.Unix(0, 1700000000000000000).UTC()
+	closeTime := time.Unix(0, 1700000001000000000).UTC()
+
+	tests := map[string]struct {
+		query              string
+		expectedConditions []VisibilityQueryCondition
+		expectedError      string
+	}{
+		"empty query": {
+			query: "",
+		},
+		"order by start time only": {
+			query: "order by StartTime desc",
+		},
+		"system attributes": {
+			query: "WorkflowType = 'type' and CloseStatus = 'FAILED' and StartTime > 1700000000000000000 order by StartTime desc",
+			expectedConditions: []VisibilityQueryCondition{
+				{Attribute: definition.WorkflowType, Operator: VisibilityQueryEqual, Value: "type"},
+				{Attribute: definition.CloseStatus, Operator: VisibilityQueryEqual, Value: int32(1)},
+				{Attribute: definition.StartTime, Operator: VisibilityQueryGreaterThan, Value: startTime},
+			},
+		},
+		"ranges": {
+			query: "(CloseTime between 1700000000000000000 and '2023-11-14T22:13:21Z') and HistoryLength <= 10",
+			expectedConditions: []VisibilityQueryCondition{
+				{Attribute: definition.CloseTime, Operator: VisibilityQueryGreaterEqual, Value: startTime},
+				{Attribute: definition.CloseTime, Operator: VisibilityQueryLessEqual, Value: closeTime},
+				{Attribute: definition.HistoryLength, Operator: VisibilityQueryLessEqual, Value: int64(10)},
+			},
+		},
+		"custom attributes": {
+			query: "`Attr.CustomKeywordField` = 'keyword' and `Attr.CustomIntField` = 1 and `Attr.CustomBoolField` = true and `Attr.UnknownField` = 2",
+			expectedConditions: []VisibilityQueryCondition{
+				{Attribute: definition.CustomKeywordField, IsCustom: true, ValueType: types.IndexedValueTypeKeyword, Operator: VisibilityQueryEqual, Value: "keyword"},
+				{Attribute: definition.CustomIntField, IsCustom: true, ValueType: types.IndexedValueTypeInt, Operator: VisibilityQueryEqual, Value: int64(1)},
+				{Attribute: definition.CustomBoolField, IsCustom: true, ValueType: types.IndexedValueTypeBool, Operator: VisibilityQueryEqual, Value: true},
+				{Attribute: "UnknownField", IsCustom: true, ValueType: types.IndexedValueTypeInt, Operator: VisibilityQueryEqual, Value: int64(2)},
+			},
+		},
+		"missing values": {
+			query: "CloseStatus = missing and CloseTime = missing",
+			expectedConditions: []VisibilityQueryCondition{
+				{Attribute: definition.CloseStatus, Operator: VisibilityQueryMissing},
+				{Attribute: definition.CloseTime, Operator: VisibilityQueryMissing},
+			},
+		},
+		"or is not supported": {
+			query:         "WorkflowType = 'a' or WorkflowType = 'b'",
+			expectedError: "operator OR is not supported by NoSQL visibility, conditions can only be combined with AND",
+		},
+		"in is not supported": {
+			query:         "WorkflowType in ('a', 'b')",
+			expectedError: `operator "in" is not supported by NoSQL visibility`,
+		},
+		"like is not supported": {
+			query:         "WorkflowID like 'a%'",
+			expectedError: `operator "like" is not supported by NoSQL visibility`,
+		},
+		"range on workflow type is not supported": {
+			query:         "WorkflowType > 'a'",
+			expectedError: "NoSQL visibility only supports range conditions on StartTime, CloseTime and HistoryLength",
+		},
+		"range on custom attribute is not supported": {
+			query:         "`Attr.CustomIntField` > 1",
+			expectedError: "NoSQL visibility only supports range conditions on StartTime, CloseTime and HistoryLength",
+		},
+		"other missing values are not supported": {
+			query:         "WorkflowType = missing",
+			expectedError: "NoSQL visibility only supports CloseStatus = missing and CloseTime = missing",
+		},
+		"unsupported system attribute": {
+			query:         "TaskList = 'tl'",
+			expectedError: `search attribute "TaskList" is not supported by NoSQL visibility`,
+		},
+		"unsupported custom attribute types": {
+			query:         "`Attr.CustomDatetimeField` = '2023-11-15T00:13:20+02:00'",
+			expectedError: `search attribute "CustomDatetimeField" of type DATETIME is not supported by NoSQL visibility, only Keyword, Int and Bool search attributes can be queried`,
+		},
+		"text attributes are not supported": {
+			query:         "`Attr.CustomStringField` = 'text'",
+			expectedError: `search attribute "CustomStringField" of type STRING is not supported by NoSQL visibility, only Keyword, Int and Bool search attributes can be queried`,
+		},
+		"unsupported order": {
+			query:         "order by CloseTime desc",
+			expectedError: "NoSQL visibility only supports ORDER BY StartTime DESC",
+		},
+		"invalid search attribute key": {
+			query:         "`Attr.Custom'Field` = 'a'",
+			expectedError: `invalid search attribute "Custom'Field"`,
+		},
+		"invalid query": {
+			query:         "WorkflowType =",
+			expectedError: "Invalid query.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			query, err := ParseVisibilityQuery(test.query, searchAttributeTypes)
+			if test.expectedError != "" {
+				assert.Equal(t, &types.BadRequestError{Message: test.expectedError}, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedConditions, query.Conditions)
+		})
+	}
+}
@@ -38,6 +38,11 @@ func (e *ShardingError) Error() string {
 }
 
 func convertCommonErrors(errChecker nosqlplugin.ClientErrorChecker, operation string, err error) error {
+	// returned by plugins which cannot write a request within the transaction limits of the database
+	if sizeErr, ok := err.(*persistence.TransactionSizeLimitError); ok {
+		return sizeErr
+	}
+
 	if errChecker.IsNotFoundError(err) {
 		return &types.EntityNotExistsError{
 			Message: fmt.Sprintf("%v failed. Error: %v ", operation, err),
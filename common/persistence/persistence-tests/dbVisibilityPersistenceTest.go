@@ -844,6 +844,11 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
 	defer cancel()
 
+	if s.VisibilityMgr.GetName() != "cassandra" {
+		s.testUpsertAndQueryWorkflowExecution(ctx)
+		return
+	}
+
 	tests := []struct {
 		request  *p.UpsertWorkflowExecutionRequest
 		expected error
@@ -896,6 +901,53 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	}
 }
 
+func (s *DBVisibilityPersistenceSuite) testUpsertAndQueryWorkflowExecution(ctx context.Context) {
+	testDomainUUID := uuid.New()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "visibility-upsert-test",
+		RunID:      uuid.New(),
+	}
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		ShardID:          1234,
+	})
+	s.Nil(err0)
+
+	err1 := s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string][]byte{
+			definition.CustomKeywordField: []byte(`"keyword"`),
+			definition.CustomIntField:     []byte(`10`),
+		},
+		ShardID: 1234,
+	})
+	s.Nil(err1)
+
+	resp, err2 := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "`Attr.CustomKeywordField` = 'keyword' and `Attr.CustomIntField` > 5 and CloseTime = missing",
+	})
+	s.Nil(err2)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(workflowExecution.WorkflowID, resp.Executions[0].Execution.GetWorkflowID())
+	s.Equal([]byte(`"keyword"`), resp.Executions[0].SearchAttributes.IndexedFields[definition.CustomKeywordField])
+
+	countResp, err3 := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      "`Attr.CustomIntField` < 5",
+	})
+	s.Nil(err3)
+	s.Equal(int64(0), countResp.Count)
+}
+
 func (s *DBVisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *types.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunID, resp.Execution.RunID)
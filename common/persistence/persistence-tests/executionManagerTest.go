@@ -1631,6 +1631,8 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.True(len(info0.SearchAttributes) == 0)
 	s.True(len(info0.Memo) == 0)
 	s.Equal(partitionConfig0, info0.PartitionConfig)
+	s.False(info0.Paused)
+	s.False(info0.DecisionDispatchSuppressed)
 	s.assertChecksumsEqual(testWorkflowChecksum, state0.Checksum)
 
 	s.T().Logf("Workflow execution last updated: %v\n", info0.LastUpdatedTimestamp)
@@ -1666,6 +1668,8 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	updatedInfo.Memo = map[string][]byte{memoKey: memoVal}
 	partitionConfig := map[string]string{"zone": "dca2"}
 	updatedInfo.PartitionConfig = partitionConfig
+	updatedInfo.Paused = true
+	updatedInfo.DecisionDispatchSuppressed = true
 	updatedStats.HistorySize = math.MaxInt64
 	versionHistory := p.NewVersionHistory([]byte{}, []*p.VersionHistoryItem{
 		{
@@ -1718,6 +1722,8 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(updatedInfo.MaximumInterval, info1.MaximumInterval)
 	s.Equal(updatedInfo.MaximumAttempts, info1.MaximumAttempts)
 	s.Equal(updatedInfo.ExpirationSeconds, info1.ExpirationSeconds)
+	s.True(info1.Paused)
+	s.True(info1.DecisionDispatchSuppressed)
 	s.EqualTimes(updatedInfo.ExpirationTime, info1.ExpirationTime)
 	s.Equal(updatedInfo.NonRetriableErrors, info1.NonRetriableErrors)
 	searchAttrVal1, ok := info1.SearchAttributes[searchAttrKey]
@@ -3435,6 +3441,8 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateActivities() {
 		LastFailureDetails:       []byte(uuid.New()),
 		LastFailureCategory:      types.FailureCategoryFatal,
 		LastRetryIntervalSeconds: 10,
+		Paused:                   true,
+		DispatchSuppressed:       true,
 	}}
 	versionHistory := p.NewVersionHistory([]byte{}, []*p.VersionHistoryItem{
 		{
@@ -35,8 +35,8 @@ import (
 
 type (
 	// HistoryTaskDLQPersistenceSuite contains history task DLQ persistence tests.
-	// These exercise the real CQL against the history_task_dlq and
-	// history_task_dlq_ack_level tables introduced for the history task DLQ feature.
+	// These exercise the real queries against the history_task_dlq and
+	// history_task_dlq_ack_level tables of each persistence backend.
 	HistoryTaskDLQPersistenceSuite struct {
 		*TestBase
 		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
@@ -38,6 +38,9 @@ type Retryer interface {
 	GetCurrentExecution(context.Context, *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 	IsWorkflowExecutionExists(context.Context, *IsWorkflowExecutionExistsRequest) (*IsWorkflowExecutionExistsResponse, error)
 	ReadHistoryBranch(context.Context, *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error)
+	ReadRawHistoryBranch(context.Context, *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error)
+	GetHistoryTree(context.Context, *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
+	DeleteHistoryBranch(context.Context, *DeleteHistoryBranchRequest) error
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) error
 	DeleteCurrentWorkflowExecution(context.Context, *DeleteCurrentWorkflowExecutionRequest) error
 	GetShardID() int
@@ -223,6 +226,62 @@ func (pr *persistenceRetryer) ReadHistoryBranch(
 	return resp, nil
 }
 
+// ReadRawHistoryBranch retries ReadRawHistoryBranch
+func (pr *persistenceRetryer) ReadRawHistoryBranch(
+	ctx context.Context,
+	req *ReadHistoryBranchRequest,
+) (*ReadRawHistoryBranchResponse, error) {
+	if req.ShardID == nil {
+		req.ShardID = pr.requestShardID()
+	}
+	var resp *ReadRawHistoryBranchResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = pr.historyManager.ReadRawHistoryBranch(ctx, req)
+		return err
+	}
+	err := pr.throttleRetry.Do(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetHistoryTree retries GetHistoryTree
+func (pr *persistenceRetryer) GetHistoryTree(
+	ctx context.Context,
+	req *GetHistoryTreeRequest,
+) (*GetHistoryTreeResponse, error) {
+	if req.ShardID == nil {
+		req.ShardID = pr.requestShardID()
+	}
+	var resp *GetHistoryTreeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = pr.historyManager.GetHistoryTree(ctx, req)
+		return err
+	}
+	err := pr.throttleRetry.Do(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteHistoryBranch retries DeleteHistoryBranch
+func (pr *persistenceRetryer) DeleteHistoryBranch(
+	ctx context.Context,
+	req *DeleteHistoryBranchRequest,
+) error {
+	if req.ShardID == nil {
+		req.ShardID = pr.requestShardID()
+	}
+	op := func(ctx context.Context) error {
+		return pr.historyManager.DeleteHistoryBranch(ctx, req)
+	}
+	return pr.throttleRetry.Do(ctx, op)
+}
+
 // DeleteWorkflowExecution retries DeleteWorkflowExecution
 func (pr *persistenceRetryer) DeleteWorkflowExecution(
 	ctx context.Context,
@@ -68,6 +68,20 @@ func (mr *MockRetryerMockRecorder) DeleteCurrentWorkflowExecution(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCurrentWorkflowExecution", reflect.TypeOf((*MockRetryer)(nil).DeleteCurrentWorkflowExecution), arg0, arg1)
 }
 
+// DeleteHistoryBranch mocks base method.
+func (m *MockRetryer) DeleteHistoryBranch(arg0 context.Context, arg1 *DeleteHistoryBranchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHistoryBranch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHistoryBranch indicates an expected call of DeleteHistoryBranch.
+func (mr *MockRetryerMockRecorder) DeleteHistoryBranch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistoryBranch", reflect.TypeOf((*MockRetryer)(nil).DeleteHistoryBranch), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockRetryer) DeleteWorkflowExecution(arg0 context.Context, arg1 *DeleteWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
@@ -112,6 +126,21 @@ func (mr *MockRetryerMockRecorder) GetHistoryTasks(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryTasks", reflect.TypeOf((*MockRetryer)(nil).GetHistoryTasks), arg0, arg1)
 }
 
+// GetHistoryTree mocks base method.
+func (m *MockRetryer) GetHistoryTree(arg0 context.Context, arg1 *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryTree", arg0, arg1)
+	ret0, _ := ret[0].(*GetHistoryTreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryTree indicates an expected call of GetHistoryTree.
+func (mr *MockRetryerMockRecorder) GetHistoryTree(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryTree", reflect.TypeOf((*MockRetryer)(nil).GetHistoryTree), arg0, arg1)
+}
+
 // GetShardID mocks base method.
 func (m *MockRetryer) GetShardID() int {
 	m.ctrl.T.Helper()
@@ -200,3 +229,18 @@ func (mr *MockRetryerMockRecorder) ReadHistoryBranch(arg0, arg1 any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryBranch", reflect.TypeOf((*MockRetryer)(nil).ReadHistoryBranch), arg0, arg1)
 }
+
+// ReadRawHistoryBranch mocks base method.
+func (m *MockRetryer) ReadRawHistoryBranch(arg0 context.Context, arg1 *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRawHistoryBranch", arg0, arg1)
+	ret0, _ := ret[0].(*ReadRawHistoryBranchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRawHistoryBranch indicates an expected call of ReadRawHistoryBranch.
+func (mr *MockRetryerMockRecorder) ReadRawHistoryBranch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRawHistoryBranch", reflect.TypeOf((*MockRetryer)(nil).ReadRawHistoryBranch), arg0, arg1)
+}
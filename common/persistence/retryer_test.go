@@ -358,6 +358,167 @@ func TestPersistenceRetryerReadHistoryBranch(t *testing.T) {
 	}
 }
 
+func TestPersistenceRetryerReadRawHistoryBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tests := map[string]struct {
+		request                      *ReadHistoryBranchRequest
+		mockHistoryManager           *MockHistoryManager
+		mockHistoryManagerAccordance func(mockHistoryManager *MockHistoryManager)
+		expectedResponse             *ReadRawHistoryBranchResponse
+		expectedError                error
+	}{
+		"Success": {
+			request:            &ReadHistoryBranchRequest{},
+			mockHistoryManager: NewMockHistoryManager(ctrl),
+			mockHistoryManagerAccordance: func(mockHistoryManager *MockHistoryManager) {
+				mockHistoryManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Eq(&ReadHistoryBranchRequest{})).Return(&ReadRawHistoryBranchResponse{}, nil)
+			},
+			expectedResponse: &ReadRawHistoryBranchResponse{},
+			expectedError:    nil,
+		},
+		"Transient Error": {
+			request:            &ReadHistoryBranchRequest{},
+			mockHistoryManager: NewMockHistoryManager(ctrl),
+			mockHistoryManagerAccordance: func(mockHistoryManager *MockHistoryManager) {
+				gomock.InOrder(
+					mockHistoryManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Eq(&ReadHistoryBranchRequest{})).Return(nil, &types.InternalServiceError{}),
+					mockHistoryManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Eq(&ReadHistoryBranchRequest{})).Return(&ReadRawHistoryBranchResponse{}, nil),
+				)
+			},
+			expectedResponse: &ReadRawHistoryBranchResponse{},
+			expectedError:    nil,
+		},
+		"Fatal Error": {
+			request:            &ReadHistoryBranchRequest{},
+			mockHistoryManager: NewMockHistoryManager(ctrl),
+			mockHistoryManagerAccordance: func(mockHistoryManager *MockHistoryManager) {
+				mockHistoryManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Eq(&ReadHistoryBranchRequest{})).Return(nil, &types.AccessDeniedError{}).Times(1)
+			},
+			expectedResponse: nil,
+			expectedError:    &types.AccessDeniedError{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.mockHistoryManager != nil {
+				test.mockHistoryManagerAccordance(test.mockHistoryManager)
+			}
+			retryer := NewPersistenceRetryer(NewMockExecutionManager(ctrl), test.mockHistoryManager, backoff.NewExponentialRetryPolicy(time.Nanosecond))
+
+			resp, err := retryer.ReadRawHistoryBranch(context.Background(), test.request)
+			assert.Equal(t, test.expectedResponse, resp)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func TestPersistenceRetryerGetHistoryTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tests := map[string]struct {
+		request                      *GetHistoryTreeRequest
+		mockHistoryManager           *MockHistoryManager
+		mockHistoryManagerAccordance func(mockHistoryManager *MockHistoryManager)
+		expectedResponse             *GetHistoryTreeResponse
+		expectedError                error
+	}{
+		"Success": {
+			request:            &GetHistoryTreeRequest{},
+			mockHistoryManager: NewMockHistoryManager(ctrl),
+			mockHistoryManagerAccordance: func(mockHistoryManager *MockHistoryManager) {
+				mockHistoryManager.EXPECT().GetHistoryTree(gomock.Any(), gomock.Eq(&GetHistoryTreeRequest{})).Return(&GetHistoryTreeResponse{}, nil)
+			},
+			expectedResponse: &GetHistoryTreeResponse{},
+			expectedError:    nil,
+		},
+		"Transient Error": {
+			request:            &GetHistoryTreeRequest{},
+			mockHistoryManager: NewMockHistoryManager(ctrl),
+			mockHistoryManagerAccordance: func(mockHistoryManager *MockHistoryManager) {
+				gomock.InOrder(
+					mockHistoryManager.EXPECT().GetHistoryTree(gomock.Any(), gomock.Eq(&GetHistoryTreeRequest{})).Return(nil, &types.InternalServiceError{}),
+					mockHistoryManager.EXPECT().GetHistoryTree(gomock.Any(), gomock.Eq(&GetHistoryTreeRequest{})).Return(&GetHistoryTreeResponse{}, nil),
+				)
+			},
+			expectedResponse: &GetHistoryTreeResponse{},
+			expectedError:    nil,
+		},
+		"Fatal Error": {
+			request:            &GetHistoryTreeRequest{},
+			mockHistoryManager: NewMockHistoryManager(ctrl),
+			mockHistoryManagerAccordance: func(mockHistoryManager *MockHistoryManager) {
+				mockHistoryManager.EXPECT().GetHistoryTree(gomock.Any(), gomock.Eq(&GetHistoryTreeRequest{})).Return(nil, &types.AccessDeniedError{}).Times(1)
+			},
+			expectedResponse: nil,
+			expectedError:    &types.AccessDeniedError{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.mockHistoryManager != nil {
+				test.mockHistoryManagerAccordance(test.mockHistoryManager)
+			}
+			retryer := NewPersistenceRetryer(NewMockExecutionManager(ctrl), test.mockHistoryManager, backoff.NewExponentialRetryPolicy(time.Nanosecond))
+
+			resp, err := retryer.GetHistoryTree(context.Background(), test.request)
+			assert.Equal(t, test.expectedResponse, resp)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func TestPersistenceRetryerDeleteHistoryBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tests := map[string]struct {
+		request                      *DeleteHistoryBranchRequest
+		mockHistoryManager           *MockHistoryManager
+		mockHistoryManagerAccordance func(mockHistoryManager *MockHistoryManager)
+		expectedError                error
+	}{
+		"Success": {
+			request:            &DeleteHistoryBranchRequest{},
+			mockHistoryManager: NewMockHistoryManager(ctrl),
+			mockHistoryManagerAccordance: func(mockHistoryManager *MockHistoryManager) {
+				mockHistoryManager.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Eq(&DeleteHistoryBranchRequest{})).Return(nil)
+			},
+			expectedError: nil,
+		},
+		"Transient Error": {
+			request:            &DeleteHistoryBranchRequest{},
+			mockHistoryManager: NewMockHistoryManager(ctrl),
+			mockHistoryManagerAccordance: func(mockHistoryManager *MockHistoryManager) {
+				gomock.InOrder(
+					mockHistoryManager.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Eq(&DeleteHistoryBranchRequest{})).Return(&types.InternalServiceError{}),
+					mockHistoryManager.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Eq(&DeleteHistoryBranchRequest{})).Return(nil),
+				)
+			},
+			expectedError: nil,
+		},
+		"Fatal Error": {
+			request:            &DeleteHistoryBranchRequest{},
+			mockHistoryManager: NewMockHistoryManager(ctrl),
+			mockHistoryManagerAccordance: func(mockHistoryManager *MockHistoryManager) {
+				mockHistoryManager.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Eq(&DeleteHistoryBranchRequest{})).Return(&types.AccessDeniedError{}).Times(1)
+			},
+			expectedError: &types.AccessDeniedError{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.mockHistoryManager != nil {
+				test.mockHistoryManagerAccordance(test.mockHistoryManager)
+			}
+			retryer := NewPersistenceRetryer(NewMockExecutionManager(ctrl), test.mockHistoryManager, backoff.NewExponentialRetryPolicy(time.Nanosecond))
+
+			err := retryer.DeleteHistoryBranch(context.Background(), test.request)
+			if test.expectedError != nil {
+				assert.Equal(t, test.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestPersistenceRetryerDeleteWorkflowExecution(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	tests := map[string]struct {
@@ -121,7 +121,11 @@ func (f *Factory) NewDomainAuditStore() (p.DomainAuditStore, error) {
 
 // NewHistoryDLQTaskStore returns a history DLQ task store.
 func (f *Factory) NewHistoryDLQTaskStore() (p.HistoryDLQTaskStore, error) {
-	return &sqlHistoryDLQTaskStore{}, nil
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+	return newSQLHistoryDLQTaskStore(conn, f.logger, f.parser)
 }
 
 // NewExecutionStore returns an ExecutionStore for a given shardID
@@ -136,7 +140,7 @@ func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
 // NewVisibilityStore returns a visibility store
 // TODO sortByCloseTime will be removed and implemented for https://github.com/uber/cadence/issues/3621
 func (f *Factory) NewVisibilityStore(sortByCloseTime bool) (p.VisibilityStore, error) {
-	return NewSQLVisibilityStore(f.cfg, f.logger)
+	return NewSQLVisibilityStore(f.cfg, f.logger, f.dc)
 }
 
 // NewQueue returns a new queue backed by sql
@@ -243,3 +243,25 @@ func TestFactoryNewDomainAuditStore(t *testing.T) {
 	assert.NoError(t, err)
 	factory.Close()
 }
+
+func TestFactoryNewHistoryDLQTaskStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cfg := config.SQL{}
+	clusterName := "test"
+	logger := testlogger.New(t)
+	mockParser := serialization.NewMockParser(ctrl)
+	dc := &persistence.DynamicConfiguration{}
+	factory := NewFactory(cfg, clusterName, logger, mockParser, dc)
+	historyDLQTaskStore, err := factory.NewHistoryDLQTaskStore()
+	assert.Nil(t, historyDLQTaskStore)
+	assert.Error(t, err)
+	factory.Close()
+
+	cfg.PluginName = "shared"
+	factory = NewFactory(cfg, clusterName, logger, mockParser, dc)
+	historyDLQTaskStore, err = factory.NewHistoryDLQTaskStore()
+	assert.NotNil(t, historyDLQTaskStore)
+	assert.NoError(t, err)
+	factory.Close()
+}
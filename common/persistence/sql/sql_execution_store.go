@@ -1153,6 +1153,8 @@ func (m *sqlExecutionStore) populateWorkflowMutableState(
 	state.ExecutionInfo.WorkflowID = execution.WorkflowID
 	state.ExecutionInfo.RunID = execution.RunID.String()
 	state.ExecutionInfo.NextEventID = execution.NextEventID
+	state.ExecutionInfo.Paused = execution.Paused
+	state.ExecutionInfo.DecisionDispatchSuppressed = execution.DecisionDispatchSuppressed
 	// TODO: remove this after all 2DC workflows complete
 	if info.LastWriteEventID != nil {
 		state.ReplicationState = &p.ReplicationState{}
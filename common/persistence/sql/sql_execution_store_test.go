@@ -1946,25 +1946,29 @@ func TestGetWorkflowExecution(t *testing.T) {
 			mockSetup: func(db *sqlplugin.MockDB, parser *serialization.MockParser) {
 				db.EXPECT().SelectFromExecutions(gomock.Any(), gomock.Any()).Return([]sqlplugin.ExecutionsRow{
 					{
-						ShardID:          0,
-						DomainID:         serialization.MustParseUUID("ff9c8a3f-0e4f-4d3e-a4d2-6f5f8f3f7d9d"),
-						WorkflowID:       "test-workflow-id",
-						RunID:            serialization.MustParseUUID("ee8d7b6e-876c-4b1e-9b6e-5e3e3c6b6b3f"),
-						NextEventID:      101,
-						LastWriteVersion: 11,
-						Data:             []byte("test data"),
-						DataEncoding:     "thriftrw",
+						ShardID:                    0,
+						DomainID:                   serialization.MustParseUUID("ff9c8a3f-0e4f-4d3e-a4d2-6f5f8f3f7d9d"),
+						WorkflowID:                 "test-workflow-id",
+						RunID:                      serialization.MustParseUUID("ee8d7b6e-876c-4b1e-9b6e-5e3e3c6b6b3f"),
+						NextEventID:                101,
+						LastWriteVersion:           11,
+						Paused:                     true,
+						DecisionDispatchSuppressed: true,
+						Data:                       []byte("test data"),
+						DataEncoding:               "thriftrw",
 					},
 				}, nil)
 				db.EXPECT().SelectFromActivityInfoMaps(gomock.Any(), gomock.Any()).Return([]sqlplugin.ActivityInfoMapsRow{
 					{
-						ShardID:      0,
-						DomainID:     serialization.MustParseUUID("ff9c8a3f-0e4f-4d3e-a4d2-6f5f8f3f7d9d"),
-						WorkflowID:   "test-workflow-id",
-						RunID:        serialization.MustParseUUID("ee8d7b6e-876c-4b1e-9b6e-5e3e3c6b6b3f"),
-						ScheduleID:   101,
-						Data:         []byte("test data"),
-						DataEncoding: "thriftrw",
+						ShardID:            0,
+						DomainID:           serialization.MustParseUUID("ff9c8a3f-0e4f-4d3e-a4d2-6f5f8f3f7d9d"),
+						WorkflowID:         "test-workflow-id",
+						RunID:              serialization.MustParseUUID("ee8d7b6e-876c-4b1e-9b6e-5e3e3c6b6b3f"),
+						ScheduleID:         101,
+						Paused:             true,
+						DispatchSuppressed: true,
+						Data:               []byte("test data"),
+						DataEncoding:       "thriftrw",
 					},
 				}, nil)
 				db.EXPECT().SelectFromTimerInfoMaps(gomock.Any(), gomock.Any()).Return([]sqlplugin.TimerInfoMapsRow{
@@ -2231,6 +2235,8 @@ func TestGetWorkflowExecution(t *testing.T) {
 						Memo:                               map[string][]byte{"test-key": []byte("test-value")},
 						ExpirationInterval:                 time.Duration(111),
 						ActiveClusterSelectionPolicy:       persistence.NewDataBlob([]byte("ActiveClusterSelectionPolicy"), constants.EncodingTypeJSON),
+						Paused:                             true,
+						DecisionDispatchSuppressed:         true,
 					},
 					VersionHistories: persistence.NewDataBlob([]byte("test-version-histories"), constants.EncodingTypeJSON),
 					ReplicationState: &persistence.ReplicationState{
@@ -2272,6 +2278,8 @@ func TestGetWorkflowExecution(t *testing.T) {
 							LastFailureReason:      "test-retry-last-failure-reason",
 							LastWorkerIdentity:     "test-retry-last-worker-identity",
 							LastFailureDetails:     []byte("test-retry-last-failure-details"),
+							Paused:                 true,
+							DispatchSuppressed:     true,
 						},
 					},
 					TimerInfos: map[string]*persistence.TimerInfo{
@@ -1026,14 +1026,16 @@ func buildExecutionRow(
 	}
 
 	return &sqlplugin.ExecutionsRow{
-		ShardID:          shardID,
-		DomainID:         serialization.MustParseUUID(executionInfo.DomainID),
-		WorkflowID:       executionInfo.WorkflowID,
-		RunID:            serialization.MustParseUUID(executionInfo.RunID),
-		NextEventID:      int64(executionInfo.NextEventID),
-		LastWriteVersion: lastWriteVersion,
-		Data:             blob.Data,
-		DataEncoding:     string(blob.Encoding),
+		ShardID:                    shardID,
+		DomainID:                   serialization.MustParseUUID(executionInfo.DomainID),
+		WorkflowID:                 executionInfo.WorkflowID,
+		RunID:                      serialization.MustParseUUID(executionInfo.RunID),
+		NextEventID:                int64(executionInfo.NextEventID),
+		LastWriteVersion:           lastWriteVersion,
+		Paused:                     executionInfo.Paused,
+		Data:                       blob.Data,
+		DataEncoding:               string(blob.Encoding),
+		DecisionDispatchSuppressed: executionInfo.DecisionDispatchSuppressed,
 	}, nil
 }
 
@@ -22,38 +22,234 @@ package sql
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"math"
+	"time"
 
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
 	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
-var errHistoryDLQNotImplemented = errors.New("history task DLQ not implemented for SQL")
+type sqlHistoryDLQTaskStore struct {
+	sqlStore
+}
+
+// historyDLQTaskPageToken is the inclusive task key the next page starts from
+type historyDLQTaskPageToken struct {
+	VisibilityTimestamp time.Time
+	TaskID              int64
+}
 
-type sqlHistoryDLQTaskStore struct{}
+// newSQLHistoryDLQTaskStore creates an instance of HistoryDLQTaskStore backed by SQL
+func newSQLHistoryDLQTaskStore(
+	db sqlplugin.DB,
+	logger log.Logger,
+	parser serialization.Parser,
+) (p.HistoryDLQTaskStore, error) {
+	return &sqlHistoryDLQTaskStore{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+			parser: parser,
+		},
+	}, nil
+}
 
-func (s *sqlHistoryDLQTaskStore) GetName() string { return "sql" }
-func (s *sqlHistoryDLQTaskStore) Close()          {}
+// CreateHistoryDLQTask writes a task to the history DLQ.
+func (m *sqlHistoryDLQTaskStore) CreateHistoryDLQTask(
+	ctx context.Context,
+	request p.InternalCreateHistoryDLQTaskRequest,
+) error {
+	if request.TaskBlob == nil {
+		return &p.InvalidPersistenceRequestError{
+			Msg: "unable to persist history DLQ task: task blob is required",
+		}
+	}
 
-func (s *sqlHistoryDLQTaskStore) CreateHistoryDLQTask(_ context.Context, _ p.InternalCreateHistoryDLQTaskRequest) error {
-	return errHistoryDLQNotImplemented
+	_, err := m.db.InsertIntoHistoryDLQTasks(ctx, &sqlplugin.HistoryDLQTaskRow{
+		ShardID:               request.ShardID,
+		DomainID:              request.DomainID,
+		ClusterAttributeScope: request.ClusterAttributeScope,
+		ClusterAttributeName:  request.ClusterAttributeName,
+		TaskCategory:          request.TaskCategory,
+		VisibilityTimestamp:   request.VisibilityTimestamp,
+		TaskID:                request.TaskID,
+		WorkflowID:            request.WorkflowID,
+		RunID:                 request.RunID,
+		Version:               request.Version,
+		Data:                  request.TaskBlob.Data,
+		DataEncoding:          string(request.TaskBlob.Encoding),
+		CreatedAt:             request.CreatedAt,
+	})
+	if err != nil {
+		return convertCommonErrors(m.db, "CreateHistoryDLQTask", "", err)
+	}
+	return nil
 }
 
-func (s *sqlHistoryDLQTaskStore) GetHistoryDLQTasks(_ context.Context, _ p.HistoryDLQGetTasksRequest) (p.InternalGetHistoryDLQTasksResponse, error) {
-	return p.InternalGetHistoryDLQTasksResponse{}, errHistoryDLQNotImplemented
+// GetHistoryDLQTasks reads a page of tasks from a history DLQ partition in task key order.
+func (m *sqlHistoryDLQTaskStore) GetHistoryDLQTasks(
+	ctx context.Context,
+	request p.HistoryDLQGetTasksRequest,
+) (p.InternalGetHistoryDLQTasksResponse, error) {
+	minKey := historyDLQTaskPageToken{
+		VisibilityTimestamp: request.InclusiveMinTaskKey.GetScheduledTime(),
+		TaskID:              request.InclusiveMinTaskKey.GetTaskID(),
+	}
+	if len(request.NextPageToken) > 0 {
+		if err := gobDeserialize(request.NextPageToken, &minKey); err != nil {
+			return p.InternalGetHistoryDLQTasksResponse{}, fmt.Errorf("unable to decode next page token")
+		}
+	}
+
+	// a non-positive page size reads the whole range in one go
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = math.MaxInt32
+	}
+
+	rows, err := m.db.SelectFromHistoryDLQTasks(ctx, &sqlplugin.HistoryDLQTaskFilter{
+		ShardID:                  request.ShardID,
+		DomainID:                 request.DomainID,
+		ClusterAttributeScope:    request.ClusterAttributeScope,
+		ClusterAttributeName:     request.ClusterAttributeName,
+		TaskCategory:             request.TaskCategory.ID(),
+		InclusiveMinVisibilityTS: minKey.VisibilityTimestamp,
+		InclusiveMinTaskID:       minKey.TaskID,
+		ExclusiveMaxVisibilityTS: request.ExclusiveMaxTaskKey.GetScheduledTime(),
+		ExclusiveMaxTaskID:       request.ExclusiveMaxTaskKey.GetTaskID(),
+		PageSize:                 pageSize,
+	})
+	if err != nil {
+		return p.InternalGetHistoryDLQTasksResponse{}, convertCommonErrors(m.db, "GetHistoryDLQTasks", "", err)
+	}
+
+	var nextPageToken []byte
+	if request.PageSize > 0 && len(rows) >= request.PageSize {
+		// there could be more results, the next page starts right after the last returned task key
+		lastRow := rows[len(rows)-1]
+		nextPageToken, err = gobSerialize(historyDLQTaskPageToken{
+			VisibilityTimestamp: lastRow.VisibilityTimestamp,
+			TaskID:              lastRow.TaskID + 1,
+		})
+		if err != nil {
+			return p.InternalGetHistoryDLQTasksResponse{}, &types.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		}
+	}
+
+	tasks := make([]*p.InternalHistoryDLQTask, 0, len(rows))
+	for _, row := range rows {
+		tasks = append(tasks, &p.InternalHistoryDLQTask{
+			DomainID:              row.DomainID,
+			WorkflowID:            row.WorkflowID,
+			RunID:                 row.RunID,
+			ClusterAttributeScope: row.ClusterAttributeScope,
+			ClusterAttributeName:  row.ClusterAttributeName,
+			TaskCategory:          row.TaskCategory,
+			VisibilityTimestamp:   row.VisibilityTimestamp,
+			TaskID:                row.TaskID,
+			TaskPayload:           &p.DataBlob{Data: row.Data, Encoding: constants.EncodingType(row.DataEncoding)},
+			Version:               row.Version,
+			CreatedAt:             row.CreatedAt,
+		})
+	}
+	return p.InternalGetHistoryDLQTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
-func (s *sqlHistoryDLQTaskStore) RangeDeleteHistoryDLQTasks(_ context.Context, _ p.HistoryDLQDeleteTasksRequest) error {
-	return errHistoryDLQNotImplemented
+// RangeDeleteHistoryDLQTasks deletes all tasks of a history DLQ partition strictly before the exclusive max key.
+func (m *sqlHistoryDLQTaskStore) RangeDeleteHistoryDLQTasks(
+	ctx context.Context,
+	request p.HistoryDLQDeleteTasksRequest,
+) error {
+	_, err := m.db.RangeDeleteFromHistoryDLQTasks(ctx, &sqlplugin.HistoryDLQTaskFilter{
+		ShardID:                  request.ShardID,
+		DomainID:                 request.DomainID,
+		ClusterAttributeScope:    request.ClusterAttributeScope,
+		ClusterAttributeName:     request.ClusterAttributeName,
+		TaskCategory:             request.TaskCategory.ID(),
+		ExclusiveMaxVisibilityTS: request.ExclusiveMaxTaskKey.GetScheduledTime(),
+		ExclusiveMaxTaskID:       request.ExclusiveMaxTaskKey.GetTaskID(),
+	})
+	if err != nil {
+		return convertCommonErrors(m.db, "RangeDeleteHistoryDLQTasks", "", err)
+	}
+	return nil
+}
+
+// GetHistoryDLQAckLevels reads ack-level rows for a shard, filtered by task category in the manager.
+func (m *sqlHistoryDLQTaskStore) GetHistoryDLQAckLevels(
+	ctx context.Context,
+	request p.HistoryDLQGetAckLevelsRequest,
+) (p.InternalGetHistoryDLQAckLevelsResponse, error) {
+	rows, err := m.db.SelectFromHistoryDLQAckLevels(ctx, &sqlplugin.HistoryDLQAckLevelFilter{
+		ShardID:               request.ShardID,
+		DomainID:              request.DomainID,
+		ClusterAttributeScope: request.ClusterAttributeScope,
+		ClusterAttributeName:  request.ClusterAttributeName,
+	})
+	if err != nil {
+		return p.InternalGetHistoryDLQAckLevelsResponse{}, convertCommonErrors(m.db, "GetHistoryDLQAckLevels", "", err)
+	}
+
+	ackLevels := make([]*p.InternalHistoryDLQAckLevel, 0, len(rows))
+	for _, row := range rows {
+		ackLevels = append(ackLevels, &p.InternalHistoryDLQAckLevel{
+			ShardID:               row.ShardID,
+			DomainID:              row.DomainID,
+			ClusterAttributeScope: row.ClusterAttributeScope,
+			ClusterAttributeName:  row.ClusterAttributeName,
+			TaskCategory:          row.TaskCategory,
+			AckLevelVisibilityTS:  row.AckLevelVisibilityTimestamp,
+			AckLevelTaskID:        row.AckLevelTaskID,
+			LastUpdatedAt:         row.LastUpdatedAt,
+		})
+	}
+	return p.InternalGetHistoryDLQAckLevelsResponse{
+		AckLevels: ackLevels,
+	}, nil
 }
 
-func (s *sqlHistoryDLQTaskStore) GetHistoryDLQAckLevels(_ context.Context, _ p.HistoryDLQGetAckLevelsRequest) (p.InternalGetHistoryDLQAckLevelsResponse, error) {
-	return p.InternalGetHistoryDLQAckLevelsResponse{}, errHistoryDLQNotImplemented
+// UpdateHistoryDLQAckLevel upserts a single ack-level row.
+func (m *sqlHistoryDLQTaskStore) UpdateHistoryDLQAckLevel(
+	ctx context.Context,
+	request p.InternalUpdateHistoryDLQAckLevelRequest,
+) error {
+	_, err := m.db.ReplaceIntoHistoryDLQAckLevels(ctx, toHistoryDLQAckLevelRow(request.Row))
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateHistoryDLQAckLevel", "", err)
+	}
+	return nil
 }
 
-func (s *sqlHistoryDLQTaskStore) UpdateHistoryDLQAckLevel(_ context.Context, _ p.InternalUpdateHistoryDLQAckLevelRequest) error {
-	return errHistoryDLQNotImplemented
+// CreateHistoryDLQAckLevelIfNotExists writes a sentinel ack-level row only when
+// no row already exists for this partition/task-type.
+func (m *sqlHistoryDLQTaskStore) CreateHistoryDLQAckLevelIfNotExists(
+	ctx context.Context,
+	row p.InternalHistoryDLQAckLevel,
+) error {
+	_, err := m.db.InsertIntoHistoryDLQAckLevelsIfNotExists(ctx, toHistoryDLQAckLevelRow(row))
+	if err != nil {
+		return convertCommonErrors(m.db, "CreateHistoryDLQAckLevelIfNotExists", "", err)
+	}
+	return nil
 }
 
-func (s *sqlHistoryDLQTaskStore) CreateHistoryDLQAckLevelIfNotExists(_ context.Context, _ p.InternalHistoryDLQAckLevel) error {
-	return errHistoryDLQNotImplemented
+func toHistoryDLQAckLevelRow(row p.InternalHistoryDLQAckLevel) *sqlplugin.HistoryDLQAckLevelRow {
+	return &sqlplugin.HistoryDLQAckLevelRow{
+		ShardID:                     row.ShardID,
+		DomainID:                    row.DomainID,
+		ClusterAttributeScope:       row.ClusterAttributeScope,
+		ClusterAttributeName:        row.ClusterAttributeName,
+		TaskCategory:                row.TaskCategory,
+		AckLevelVisibilityTimestamp: row.AckLevelVisibilityTS,
+		AckLevelTaskID:              row.AckLevelTaskID,
+		LastUpdatedAt:               row.LastUpdatedAt,
+	}
 }
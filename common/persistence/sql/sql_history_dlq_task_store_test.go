@@ -0,0 +1,480 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+func setUpMocksForHistoryDLQTaskStore(t *testing.T) (*sqlHistoryDLQTaskStore, *sqlplugin.MockDB) {
+	ctrl := gomock.NewController(t)
+	dbMock := sqlplugin.NewMockDB(ctrl)
+
+	historyDLQTaskStore := &sqlHistoryDLQTaskStore{
+		sqlStore: sqlStore{db: dbMock},
+	}
+
+	return historyDLQTaskStore, dbMock
+}
+
+func expectNonSpecificDBError(dbMock *sqlplugin.MockDB, err error) {
+	dbMock.EXPECT().IsNotFoundError(err).Return(false).AnyTimes()
+	dbMock.EXPECT().IsTimeoutError(err).Return(false).AnyTimes()
+	dbMock.EXPECT().IsThrottlingError(err).Return(false).AnyTimes()
+	dbMock.EXPECT().IsDupEntryError(err).Return(false).AnyTimes()
+}
+
+func TestCreateHistoryDLQTask(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1234567890, 0)
+
+	request := persistence.InternalCreateHistoryDLQTaskRequest{
+		ShardID:               10,
+		DomainID:              "domain-id",
+		ClusterAttributeScope: "region",
+		ClusterAttributeName:  "us-east",
+		TaskCategory:          persistence.HistoryTaskCategoryTimer.ID(),
+		TaskID:                101,
+		WorkflowID:            "workflow-id",
+		RunID:                 "run-id",
+		Version:               3,
+		VisibilityTimestamp:   now,
+		CreatedAt:             now.Add(time.Minute),
+		TaskBlob: &persistence.DataBlob{
+			Encoding: constants.EncodingTypeThriftRW,
+			Data:     []byte("task"),
+		},
+	}
+
+	tests := map[string]struct {
+		setupMock   func(*sqlplugin.MockDB)
+		request     persistence.InternalCreateHistoryDLQTaskRequest
+		expectError bool
+	}{
+		"success": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().InsertIntoHistoryDLQTasks(ctx, &sqlplugin.HistoryDLQTaskRow{
+					ShardID:               10,
+					DomainID:              "domain-id",
+					ClusterAttributeScope: "region",
+					ClusterAttributeName:  "us-east",
+					TaskCategory:          persistence.HistoryTaskCategoryTimer.ID(),
+					VisibilityTimestamp:   now,
+					TaskID:                101,
+					WorkflowID:            "workflow-id",
+					RunID:                 "run-id",
+					Version:               3,
+					Data:                  []byte("task"),
+					DataEncoding:          string(constants.EncodingTypeThriftRW),
+					CreatedAt:             now.Add(time.Minute),
+				}).Return(&sqlResult{rowsAffected: 1}, nil).Times(1)
+			},
+			request: request,
+		},
+		"missing task blob": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {},
+			request: func() persistence.InternalCreateHistoryDLQTaskRequest {
+				r := request
+				r.TaskBlob = nil
+				return r
+			}(),
+			expectError: true,
+		},
+		"database error": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				err := errors.New("database error")
+				dbMock.EXPECT().InsertIntoHistoryDLQTasks(ctx, gomock.Any()).Return(nil, err).Times(1)
+				expectNonSpecificDBError(dbMock, err)
+			},
+			request:     request,
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForHistoryDLQTaskStore(t)
+			tc.setupMock(dbMock)
+
+			err := store.CreateHistoryDLQTask(ctx, tc.request)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetHistoryDLQTasks(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1234567890, 0)
+
+	lastPageToken, err := gobSerialize(historyDLQTaskPageToken{VisibilityTimestamp: now, TaskID: 6})
+	require.NoError(t, err)
+
+	row := func(taskID int64) sqlplugin.HistoryDLQTaskRow {
+		return sqlplugin.HistoryDLQTaskRow{
+			ShardID:               10,
+			DomainID:              "domain-id",
+			ClusterAttributeScope: "region",
+			ClusterAttributeName:  "us-east",
+			TaskCategory:          persistence.HistoryTaskCategoryTimer.ID(),
+			VisibilityTimestamp:   now,
+			TaskID:                taskID,
+			WorkflowID:            "workflow-id",
+			RunID:                 "run-id",
+			Version:               3,
+			Data:                  []byte("task"),
+			DataEncoding:          string(constants.EncodingTypeThriftRW),
+			CreatedAt:             now,
+		}
+	}
+	baseRequest := persistence.HistoryDLQGetTasksRequest{
+		ShardID:               10,
+		DomainID:              "domain-id",
+		ClusterAttributeScope: "region",
+		ClusterAttributeName:  "us-east",
+		TaskCategory:          persistence.HistoryTaskCategoryTimer,
+		InclusiveMinTaskKey:   persistence.NewHistoryTaskKey(now.Add(-time.Hour), 1),
+		ExclusiveMaxTaskKey:   persistence.NewHistoryTaskKey(now.Add(time.Hour), 0),
+	}
+	baseFilter := sqlplugin.HistoryDLQTaskFilter{
+		ShardID:                  10,
+		DomainID:                 "domain-id",
+		ClusterAttributeScope:    "region",
+		ClusterAttributeName:     "us-east",
+		TaskCategory:             persistence.HistoryTaskCategoryTimer.ID(),
+		InclusiveMinVisibilityTS: now.Add(-time.Hour),
+		InclusiveMinTaskID:       1,
+		ExclusiveMaxVisibilityTS: now.Add(time.Hour),
+		ExclusiveMaxTaskID:       0,
+	}
+
+	tests := map[string]struct {
+		setupMock         func(*sqlplugin.MockDB)
+		pageSize          int
+		nextPageToken     []byte
+		expectError       bool
+		expectedTaskIDs   []int64
+		expectedNextToken []byte
+	}{
+		"full page returns a token after the last task": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				filter := baseFilter
+				filter.PageSize = 2
+				dbMock.EXPECT().SelectFromHistoryDLQTasks(ctx, &filter).Return([]sqlplugin.HistoryDLQTaskRow{row(4), row(5)}, nil).Times(1)
+			},
+			pageSize:          2,
+			expectedTaskIDs:   []int64{4, 5},
+			expectedNextToken: lastPageToken,
+		},
+		"next page token overrides the inclusive min key": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				filter := baseFilter
+				filter.InclusiveMinVisibilityTS = now
+				filter.InclusiveMinTaskID = 6
+				filter.PageSize = 2
+				dbMock.EXPECT().SelectFromHistoryDLQTasks(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, got *sqlplugin.HistoryDLQTaskFilter) ([]sqlplugin.HistoryDLQTaskRow, error) {
+						assert.True(t, filter.InclusiveMinVisibilityTS.Equal(got.InclusiveMinVisibilityTS))
+						assert.Equal(t, filter.InclusiveMinTaskID, got.InclusiveMinTaskID)
+						return []sqlplugin.HistoryDLQTaskRow{row(6)}, nil
+					}).Times(1)
+			},
+			pageSize:        2,
+			nextPageToken:   lastPageToken,
+			expectedTaskIDs: []int64{6},
+		},
+		"non-positive page size reads the whole range": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				filter := baseFilter
+				filter.PageSize = math.MaxInt32
+				dbMock.EXPECT().SelectFromHistoryDLQTasks(ctx, &filter).Return([]sqlplugin.HistoryDLQTaskRow{row(4)}, nil).Times(1)
+			},
+			expectedTaskIDs: []int64{4},
+		},
+		"invalid page token": {
+			setupMock:     func(dbMock *sqlplugin.MockDB) {},
+			pageSize:      2,
+			nextPageToken: []byte("invalid"),
+			expectError:   true,
+		},
+		"database error": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				err := errors.New("database error")
+				dbMock.EXPECT().SelectFromHistoryDLQTasks(ctx, gomock.Any()).Return(nil, err).Times(1)
+				expectNonSpecificDBError(dbMock, err)
+			},
+			pageSize:    2,
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForHistoryDLQTaskStore(t)
+			tc.setupMock(dbMock)
+
+			request := baseRequest
+			request.PageSize = tc.pageSize
+			request.NextPageToken = tc.nextPageToken
+			resp, err := store.GetHistoryDLQTasks(ctx, request)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var taskIDs []int64
+			for _, task := range resp.Tasks {
+				taskIDs = append(taskIDs, task.TaskID)
+				assert.Equal(t, "workflow-id", task.WorkflowID)
+				assert.Equal(t, "run-id", task.RunID)
+				assert.Equal(t, int64(3), task.Version)
+				assert.Equal(t, &persistence.DataBlob{Data: []byte("task"), Encoding: constants.EncodingTypeThriftRW}, task.TaskPayload)
+			}
+			assert.Equal(t, tc.expectedTaskIDs, taskIDs)
+			assert.Equal(t, tc.expectedNextToken, resp.NextPageToken)
+		})
+	}
+}
+
+func TestRangeDeleteHistoryDLQTasks(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1234567890, 0)
+
+	request := persistence.HistoryDLQDeleteTasksRequest{
+		ShardID:               10,
+		DomainID:              "domain-id",
+		ClusterAttributeScope: "region",
+		ClusterAttributeName:  "us-east",
+		TaskCategory:          persistence.HistoryTaskCategoryTimer,
+		ExclusiveMaxTaskKey:   persistence.NewHistoryTaskKey(now, 7),
+	}
+
+	tests := map[string]struct {
+		setupMock   func(*sqlplugin.MockDB)
+		expectError bool
+	}{
+		"success": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().RangeDeleteFromHistoryDLQTasks(ctx, &sqlplugin.HistoryDLQTaskFilter{
+					ShardID:                  10,
+					DomainID:                 "domain-id",
+					ClusterAttributeScope:    "region",
+					ClusterAttributeName:     "us-east",
+					TaskCategory:             persistence.HistoryTaskCategoryTimer.ID(),
+					ExclusiveMaxVisibilityTS: now,
+					ExclusiveMaxTaskID:       7,
+				}).Return(&sqlResult{rowsAffected: 3}, nil).Times(1)
+			},
+		},
+		"database error": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				err := errors.New("database error")
+				dbMock.EXPECT().RangeDeleteFromHistoryDLQTasks(ctx, gomock.Any()).Return(nil, err).Times(1)
+				expectNonSpecificDBError(dbMock, err)
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForHistoryDLQTaskStore(t)
+			tc.setupMock(dbMock)
+
+			err := store.RangeDeleteHistoryDLQTasks(ctx, request)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetHistoryDLQAckLevels(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1234567890, 0)
+
+	tests := map[string]struct {
+		setupMock   func(*sqlplugin.MockDB)
+		expectError bool
+		expected    []*persistence.InternalHistoryDLQAckLevel
+	}{
+		"success": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().SelectFromHistoryDLQAckLevels(ctx, &sqlplugin.HistoryDLQAckLevelFilter{
+					ShardID:  10,
+					DomainID: "domain-id",
+				}).Return([]sqlplugin.HistoryDLQAckLevelRow{
+					{
+						ShardID:                     10,
+						DomainID:                    "domain-id",
+						ClusterAttributeScope:       "region",
+						ClusterAttributeName:        "us-east",
+						TaskCategory:                persistence.HistoryTaskCategoryTransfer.ID(),
+						AckLevelVisibilityTimestamp: now,
+						AckLevelTaskID:              42,
+						LastUpdatedAt:               now,
+					},
+				}, nil).Times(1)
+			},
+			expected: []*persistence.InternalHistoryDLQAckLevel{
+				{
+					ShardID:               10,
+					DomainID:              "domain-id",
+					ClusterAttributeScope: "region",
+					ClusterAttributeName:  "us-east",
+					TaskCategory:          persistence.HistoryTaskCategoryTransfer.ID(),
+					AckLevelVisibilityTS:  now,
+					AckLevelTaskID:        42,
+					LastUpdatedAt:         now,
+				},
+			},
+		},
+		"database error": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				err := errors.New("database error")
+				dbMock.EXPECT().SelectFromHistoryDLQAckLevels(ctx, gomock.Any()).Return(nil, err).Times(1)
+				expectNonSpecificDBError(dbMock, err)
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForHistoryDLQTaskStore(t)
+			tc.setupMock(dbMock)
+
+			resp, err := store.GetHistoryDLQAckLevels(ctx, persistence.HistoryDLQGetAckLevelsRequest{
+				ShardID:  10,
+				DomainID: "domain-id",
+			})
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp.AckLevels)
+			}
+		})
+	}
+}
+
+func TestHistoryDLQAckLevelWrites(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1234567890, 0)
+
+	ackLevel := persistence.InternalHistoryDLQAckLevel{
+		ShardID:               10,
+		DomainID:              "domain-id",
+		ClusterAttributeScope: "region",
+		ClusterAttributeName:  "us-east",
+		TaskCategory:          persistence.HistoryTaskCategoryTransfer.ID(),
+		AckLevelVisibilityTS:  now,
+		AckLevelTaskID:        42,
+		LastUpdatedAt:         now,
+	}
+	expectedRow := &sqlplugin.HistoryDLQAckLevelRow{
+		ShardID:                     10,
+		DomainID:                    "domain-id",
+		ClusterAttributeScope:       "region",
+		ClusterAttributeName:        "us-east",
+		TaskCategory:                persistence.HistoryTaskCategoryTransfer.ID(),
+		AckLevelVisibilityTimestamp: now,
+		AckLevelTaskID:              42,
+		LastUpdatedAt:               now,
+	}
+
+	tests := map[string]struct {
+		setupMock   func(*sqlplugin.MockDB)
+		write       func(*sqlHistoryDLQTaskStore) error
+		expectError bool
+	}{
+		"update success": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().ReplaceIntoHistoryDLQAckLevels(ctx, expectedRow).Return(&sqlResult{rowsAffected: 1}, nil).Times(1)
+			},
+			write: func(store *sqlHistoryDLQTaskStore) error {
+				return store.UpdateHistoryDLQAckLevel(ctx, persistence.InternalUpdateHistoryDLQAckLevelRequest{Row: ackLevel})
+			},
+		},
+		"update database error": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				err := errors.New("database error")
+				dbMock.EXPECT().ReplaceIntoHistoryDLQAckLevels(ctx, expectedRow).Return(nil, err).Times(1)
+				expectNonSpecificDBError(dbMock, err)
+			},
+			write: func(store *sqlHistoryDLQTaskStore) error {
+				return store.UpdateHistoryDLQAckLevel(ctx, persistence.InternalUpdateHistoryDLQAckLevelRequest{Row: ackLevel})
+			},
+			expectError: true,
+		},
+		"create if not exists success": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().InsertIntoHistoryDLQAckLevelsIfNotExists(ctx, expectedRow).Return(&sqlResult{rowsAffected: 0}, nil).Times(1)
+			},
+			write: func(store *sqlHistoryDLQTaskStore) error {
+				return store.CreateHistoryDLQAckLevelIfNotExists(ctx, ackLevel)
+			},
+		},
+		"create if not exists database error": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				err := errors.New("database error")
+				dbMock.EXPECT().InsertIntoHistoryDLQAckLevelsIfNotExists(ctx, expectedRow).Return(nil, err).Times(1)
+				expectNonSpecificDBError(dbMock, err)
+			},
+			write: func(store *sqlHistoryDLQTaskStore) error {
+				return store.CreateHistoryDLQAckLevelIfNotExists(ctx, ackLevel)
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForHistoryDLQTaskStore(t)
+			tc.setupMock(dbMock)
+
+			err := tc.write(store)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -545,6 +545,7 @@ func (m *sqlHistoryStore) GetHistoryTree(
 	if err != nil {
 		return nil, convertCommonErrors(m.db, "GetHistoryTree", "", err)
 	}
+	branchDetails := make([]persistence.HistoryBranchDetail, 0, len(rows))
 	for _, row := range rows {
 		treeInfo, err := m.parser.HistoryTreeInfoFromBlob(row.Data, row.DataEncoding)
 		if err != nil {
@@ -556,9 +557,16 @@ func (m *sqlHistoryStore) GetHistoryTree(
 			Ancestors: treeInfo.Ancestors,
 		}
 		branches = append(branches, br)
+		branchDetails = append(branchDetails, persistence.HistoryBranchDetail{
+			TreeID:   request.TreeID,
+			BranchID: row.BranchID.String(),
+			ForkTime: treeInfo.CreatedTimestamp,
+			Info:     treeInfo.Info,
+		})
 	}
 
 	return &persistence.InternalGetHistoryTreeResponse{
-		Branches: branches,
+		Branches:      branches,
+		BranchDetails: branchDetails,
 	}, nil
 }
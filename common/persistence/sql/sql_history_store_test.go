@@ -68,6 +68,7 @@ func TestGetHistoryTree(t *testing.T) {
 					},
 				}, nil)
 				mockParser.EXPECT().HistoryTreeInfoFromBlob([]byte(`aaaa`), "json").Return(&serialization.HistoryTreeInfo{
+					CreatedTimestamp: time.Unix(1700000000, 0),
 					Ancestors: []*types.HistoryBranchRange{
 						{
 							BranchID:    "730ec3d3-f74b-423f-a138-3b35494fe691",
@@ -75,6 +76,7 @@ func TestGetHistoryTree(t *testing.T) {
 							EndNodeID:   3,
 						},
 					},
+					Info: "info",
 				}, nil)
 			},
 			want: &persistence.InternalGetHistoryTreeResponse{
@@ -91,6 +93,14 @@ func TestGetHistoryTree(t *testing.T) {
 						},
 					},
 				},
+				BranchDetails: []persistence.HistoryBranchDetail{
+					{
+						TreeID:   "530ec3d3-f74b-423f-a138-3b35494fe691",
+						BranchID: "630ec3d3-f74b-423f-a138-3b35494fe691",
+						ForkTime: time.Unix(1700000000, 0),
+						Info:     "info",
+					},
+				},
 			},
 			wantErr: false,
 		},
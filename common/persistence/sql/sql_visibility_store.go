@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -32,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
@@ -41,16 +43,21 @@ import (
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		dc *p.DynamicConfiguration
 	}
 
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
+		// Offset is only used by queries with an ORDER BY clause
+		Offset int `json:",omitempty"`
 	}
 )
 
+const defaultVisibilityQueryPageSize = 1000
+
 // NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger, dc *p.DynamicConfiguration) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
@@ -60,6 +67,7 @@ func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore
 			db:     db,
 			logger: logger,
 		},
+		dc: dc,
 	}, nil
 }
 
@@ -83,6 +91,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 		ShardID:                request.ShardID,
 		ExecutionStatus:        int32(request.ExecutionStatus),
 		ScheduledExecutionTime: request.ScheduledExecutionTime,
+		SearchAttributes:       s.serializeSearchAttributes(request.SearchAttributes),
 	})
 
 	if err != nil {
@@ -133,6 +142,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 		ShardID:                request.ShardID,
 		ExecutionStatus:        int32(executionStatus),
 		ScheduledExecutionTime: request.ScheduledExecutionTime,
+		SearchAttributes:       s.serializeSearchAttributes(request.SearchAttributes),
 	})
 	if err != nil {
 		return convertCommonErrors(s.db, "RecordWorkflowExecutionClosed", "", err)
@@ -160,13 +170,31 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionUninitialized(
 }
 
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(
-	_ context.Context,
+	ctx context.Context,
 	request *p.InternalUpsertWorkflowExecutionRequest,
 ) error {
-	if p.IsNopUpsertWorkflowRequest(request) {
-		return nil
+	_, err := s.db.UpsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+		DomainID:               request.DomainUUID,
+		WorkflowID:             request.WorkflowID,
+		RunID:                  request.RunID,
+		StartTime:              request.StartTimestamp,
+		ExecutionTime:          request.ExecutionTimestamp,
+		WorkflowTypeName:       request.WorkflowTypeName,
+		Memo:                   request.Memo.Data,
+		Encoding:               string(request.Memo.GetEncoding()),
+		IsCron:                 request.IsCron,
+		CronSchedule:           request.CronSchedule,
+		NumClusters:            request.NumClusters,
+		UpdateTime:             request.UpdateTimestamp,
+		ShardID:                int16(request.ShardID),
+		ExecutionStatus:        int32(request.ExecutionStatus),
+		ScheduledExecutionTime: time.Unix(0, request.ScheduledExecutionTimestamp),
+		SearchAttributes:       s.serializeSearchAttributes(request.SearchAttributes),
+	})
+	if err != nil {
+		return convertCommonErrors(s.db, "UpsertWorkflowExecution", "", err)
 	}
-	return p.ErrVisibilityOperationNotSupported
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
@@ -338,24 +366,92 @@ func (s *sqlVisibilityStore) DeleteUninitializedWorkflowExecution(
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	return s.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(
-	_ context.Context,
-	_ *p.ListWorkflowExecutionsByQueryRequest,
+	ctx context.Context,
+	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	return s.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(
-	_ context.Context,
-	_ *p.CountWorkflowExecutionsRequest,
+	ctx context.Context,
+	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.ErrVisibilityOperationNotSupported
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query, s.getSearchAttributeTypes())
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.db.CountFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "CountWorkflowExecutions", "", err)
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	request *p.ListWorkflowExecutionsByQueryRequest,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query, s.getSearchAttributeTypes())
+	if err != nil {
+		return nil, err
+	}
+	filter := &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+		PageSize: request.PageSize,
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = defaultVisibilityQueryPageSize
+	}
+	if len(request.NextPageToken) > 0 {
+		token, err := s.deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("%v: invalid next page token: %v", opName, err)}
+		}
+		if query.HasOrderBy() {
+			filter.Offset = token.Offset
+		} else {
+			filter.LastStartTime = &token.Time
+			filter.LastRunID = &token.RunID
+		}
+	}
+
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, filter)
+	if err != nil {
+		return nil, convertCommonErrors(s.db, opName, "", err)
+	}
+	infos := make([]*p.InternalVisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+
+	var nextPageToken []byte
+	if len(rows) == filter.PageSize {
+		lastRow := rows[len(rows)-1]
+		nextPageToken, err = s.serializePageToken(&visibilityPageToken{
+			Time:   lastRow.StartTime,
+			RunID:  lastRow.RunID,
+			Offset: filter.Offset + len(rows),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.InternalVisibilityWorkflowExecutionInfo {
@@ -376,6 +472,7 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Internal
 		ShardID:                row.ShardID,
 		ExecutionStatus:        types.WorkflowExecutionStatus(row.ExecutionStatus),
 		ScheduledExecutionTime: row.ScheduledExecutionTime,
+		SearchAttributes:       s.deserializeSearchAttributes(row),
 	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
@@ -437,3 +534,66 @@ func (s *sqlVisibilityStore) serializePageToken(token *visibilityPageToken) ([]b
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+// serializeSearchAttributes encodes search attributes as a JSON object of their decoded values,
+// so they can be queried with the JSON functions of the database
+func (s *sqlVisibilityStore) serializeSearchAttributes(attributes map[string][]byte) []byte {
+	if len(attributes) == 0 {
+		return nil
+	}
+	attributeTypes := s.getSearchAttributeTypes()
+	values := make(map[string]interface{}, len(attributes))
+	for key, data := range attributes {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			s.logger.Error("failed to decode search attribute", tag.Key(key), tag.Error(err))
+			continue
+		}
+		if attributeTypes[key] == types.IndexedValueTypeDatetime {
+			datetime, err := sqlplugin.ToSearchAttributeDatetime(value)
+			if err != nil {
+				s.logger.Error("failed to convert datetime search attribute", tag.Key(key), tag.Error(err))
+				continue
+			}
+			value = datetime
+		}
+		values[key] = value
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		s.logger.Error("failed to encode search attributes", tag.Error(err))
+		return nil
+	}
+	return data
+}
+
+func (s *sqlVisibilityStore) deserializeSearchAttributes(row *sqlplugin.VisibilityRow) map[string]interface{} {
+	if len(row.SearchAttributes) == 0 {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(row.SearchAttributes))
+	decoder.UseNumber()
+	var attributes map[string]interface{}
+	if err := decoder.Decode(&attributes); err != nil {
+		s.logger.Error("failed to decode search attributes",
+			tag.WorkflowID(row.WorkflowID),
+			tag.WorkflowRunID(row.RunID),
+			tag.Error(err))
+		return nil
+	}
+	return attributes
+}
+
+func (s *sqlVisibilityStore) getSearchAttributeTypes() map[string]types.IndexedValueType {
+	if s.dc == nil || s.dc.ValidSearchAttributes == nil {
+		return nil
+	}
+	validSearchAttributes := s.dc.ValidSearchAttributes()
+	attributeTypes := make(map[string]types.IndexedValueType, len(validSearchAttributes))
+	for key, valueType := range validSearchAttributes {
+		attributeTypes[key] = common.ConvertIndexedValueTypeToInternalType(valueType, s.logger)
+	}
+	return attributeTypes
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func setUpMocksForVisibilityStore(t *testing.T) (*sqlVisibilityStore, *sqlplugin.MockDB) {
+	ctrl := gomock.NewController(t)
+	dbMock := sqlplugin.NewMockDB(ctrl)
+
+	visibilityStore := &sqlVisibilityStore{
+		sqlStore: sqlStore{db: dbMock, logger: testlogger.New(t)},
+		dc: &persistence.DynamicConfiguration{
+			ValidSearchAttributes: dynamicproperties.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		},
+	}
+
+	return visibilityStore, dbMock
+}
+
+func TestUpsertWorkflowExecution(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+
+	tests := map[string]struct {
+		setupMock   func(*sqlplugin.MockDB)
+		expectError bool
+	}{
+		"success": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().UpsertIntoVisibility(ctx, gomock.Any()).Do(
+					func(_ context.Context, row *sqlplugin.VisibilityRow) {
+						assert.Equal(t, "domain-id", row.DomainID)
+						assert.Equal(t, "run-id", row.RunID)
+						assert.Equal(t, []byte("memo"), row.Memo)
+						var attributes map[string]interface{}
+						require.NoError(t, json.Unmarshal(row.SearchAttributes, &attributes))
+						assert.Equal(t, map[string]interface{}{
+							definition.CustomKeywordField:  "keyword",
+							definition.CustomIntField:      float64(10),
+							definition.CustomDatetimeField: "2023-11-14T22:13:20.000000000Z",
+						}, attributes)
+					}).Return(&sqlResult{rowsAffected: 1}, nil)
+			},
+		},
+		"database error": {
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().UpsertIntoVisibility(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+				dbMock.EXPECT().IsNotFoundError(gomock.Any()).Return(false)
+				dbMock.EXPECT().IsTimeoutError(gomock.Any()).Return(false)
+				dbMock.EXPECT().IsThrottlingError(gomock.Any()).Return(false)
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForVisibilityStore(t)
+			tc.setupMock(dbMock)
+
+			err := store.UpsertWorkflowExecution(ctx, &persistence.InternalUpsertWorkflowExecutionRequest{
+				DomainUUID:       "domain-id",
+				WorkflowID:       "workflow-id",
+				RunID:            "run-id",
+				WorkflowTypeName: "workflow-type",
+				StartTimestamp:   now,
+				UpdateTimestamp:  now,
+				Memo:             persistence.NewDataBlob([]byte("memo"), constants.EncodingTypeThriftRW),
+				SearchAttributes: map[string][]byte{
+					definition.CustomKeywordField:  []byte(`"keyword"`),
+					definition.CustomIntField:      []byte(`10`),
+					definition.CustomDatetimeField: []byte(`"2023-11-15T00:13:20+02:00"`),
+				},
+			})
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestListWorkflowExecutionsByQuery(t *testing.T) {
+	ctx := context.Background()
+	startTime := time.Unix(1700000000, 0)
+	rows := []sqlplugin.VisibilityRow{
+		{
+			RunID:            "run-1",
+			WorkflowID:       "workflow-1",
+			WorkflowTypeName: "workflow-type",
+			StartTime:        startTime,
+			ExecutionTime:    startTime,
+			SearchAttributes: []byte(`{"CustomKeywordField":"keyword"}`),
+		},
+		{
+			RunID:            "run-2",
+			WorkflowID:       "workflow-2",
+			WorkflowTypeName: "workflow-type",
+			StartTime:        startTime.Add(-time.Second),
+			ExecutionTime:    startTime.Add(-time.Second),
+		},
+	}
+
+	tests := map[string]struct {
+		query             string
+		pageSize          int
+		nextPageToken     []byte
+		setupMock         func(*testing.T, *sqlplugin.MockDB)
+		expectError       bool
+		expectedCount     int
+		expectedPageToken *visibilityPageToken
+	}{
+		"first page": {
+			query:    "`Attr.CustomKeywordField` = 'keyword'",
+			pageSize: 2,
+			setupMock: func(t *testing.T, dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().SelectFromVisibilityByQuery(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+						assert.Equal(t, "domain-id", filter.DomainID)
+						assert.Equal(t, 2, filter.PageSize)
+						assert.Nil(t, filter.LastStartTime)
+						return rows, nil
+					})
+			},
+			expectedCount:     2,
+			expectedPageToken: &visibilityPageToken{Time: rows[1].StartTime, RunID: "run-2", Offset: 2},
+		},
+		"next page": {
+			query:         "WorkflowType = 'workflow-type'",
+			pageSize:      2,
+			nextPageToken: []byte(`{"Time":"2023-11-14T22:13:19Z","RunID":"run-2","Offset":2}`),
+			setupMock: func(t *testing.T, dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().SelectFromVisibilityByQuery(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+						require.NotNil(t, filter.LastStartTime)
+						assert.True(t, filter.LastStartTime.Equal(rows[1].StartTime))
+						assert.Equal(t, "run-2", *filter.LastRunID)
+						assert.Equal(t, 0, filter.Offset)
+						return rows[:1], nil
+					})
+			},
+			expectedCount: 1,
+		},
+		"next page with order by": {
+			query:         "order by `Attr.CustomIntField` desc",
+			pageSize:      2,
+			nextPageToken: []byte(`{"Time":"2023-11-14T22:13:19Z","RunID":"run-2","Offset":2}`),
+			setupMock: func(t *testing.T, dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().SelectFromVisibilityByQuery(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+						assert.Nil(t, filter.LastStartTime)
+						assert.Equal(t, 2, filter.Offset)
+						return rows, nil
+					})
+			},
+			expectedCount:     2,
+			expectedPageToken: &visibilityPageToken{Time: rows[1].StartTime, RunID: "run-2", Offset: 4},
+		},
+		"invalid query": {
+			query:       "TaskList = 'tasklist'",
+			pageSize:    2,
+			setupMock:   func(*testing.T, *sqlplugin.MockDB) {},
+			expectError: true,
+		},
+		"database error": {
+			query:    "",
+			pageSize: 2,
+			setupMock: func(t *testing.T, dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().SelectFromVisibilityByQuery(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+				dbMock.EXPECT().IsNotFoundError(gomock.Any()).Return(false)
+				dbMock.EXPECT().IsTimeoutError(gomock.Any()).Return(false)
+				dbMock.EXPECT().IsThrottlingError(gomock.Any()).Return(false)
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForVisibilityStore(t)
+			tc.setupMock(t, dbMock)
+
+			resp, err := store.ListWorkflowExecutions(ctx, &persistence.ListWorkflowExecutionsByQueryRequest{
+				DomainUUID:    "domain-id",
+				Domain:        "domain",
+				PageSize:      tc.pageSize,
+				NextPageToken: tc.nextPageToken,
+				Query:         tc.query,
+			})
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, resp.Executions, tc.expectedCount)
+			assert.Equal(t, "keyword", resp.Executions[0].SearchAttributes[definition.CustomKeywordField])
+			if tc.expectedPageToken == nil {
+				assert.Nil(t, resp.NextPageToken)
+				return
+			}
+			token, err := store.deserializePageToken(resp.NextPageToken)
+			require.NoError(t, err)
+			assert.True(t, tc.expectedPageToken.Time.Equal(token.Time))
+			assert.Equal(t, tc.expectedPageToken.RunID, token.RunID)
+			assert.Equal(t, tc.expectedPageToken.Offset, token.Offset)
+		})
+	}
+}
+
+func TestCountWorkflowExecutions(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		query         string
+		setupMock     func(*sqlplugin.MockDB)
+		expectedError error
+		expectedCount int64
+	}{
+		"success": {
+			query: "CloseTime = missing",
+			setupMock: func(dbMock *sqlplugin.MockDB) {
+				dbMock.EXPECT().CountFromVisibilityByQuery(ctx, gomock.Any()).Return(int64(10), nil)
+			},
+			expectedCount: 10,
+		},
+		"invalid query": {
+			query:         "CloseStatus = 'unknown'",
+			setupMock:     func(*sqlplugin.MockDB) {},
+			expectedError: &types.BadRequestError{Message: `invalid close status "unknown"`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store, dbMock := setUpMocksForVisibilityStore(t)
+			tc.setupMock(dbMock)
+
+			resp, err := store.CountWorkflowExecutions(ctx, &persistence.CountWorkflowExecutionsRequest{
+				DomainUUID: "domain-id",
+				Domain:     "domain",
+				Query:      tc.query,
+			})
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCount, resp.Count)
+		})
+	}
+}
@@ -99,6 +99,21 @@ func (m *MocktableCRUD) EXPECT() *MocktableCRUDMockRecorder {
 	return m.recorder
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActiveClusterSelectionPolicy mocks base method.
 func (m *MocktableCRUD) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -697,6 +712,36 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoExecutions(ctx, row any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoExecutions", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoExecutions), ctx, row)
 }
 
+// InsertIntoHistoryDLQAckLevelsIfNotExists mocks base method.
+func (m *MocktableCRUD) InsertIntoHistoryDLQAckLevelsIfNotExists(ctx context.Context, row *HistoryDLQAckLevelRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoHistoryDLQAckLevelsIfNotExists", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoHistoryDLQAckLevelsIfNotExists indicates an expected call of InsertIntoHistoryDLQAckLevelsIfNotExists.
+func (mr *MocktableCRUDMockRecorder) InsertIntoHistoryDLQAckLevelsIfNotExists(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryDLQAckLevelsIfNotExists", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoHistoryDLQAckLevelsIfNotExists), ctx, row)
+}
+
+// InsertIntoHistoryDLQTasks mocks base method.
+func (m *MocktableCRUD) InsertIntoHistoryDLQTasks(ctx context.Context, row *HistoryDLQTaskRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoHistoryDLQTasks", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoHistoryDLQTasks indicates an expected call of InsertIntoHistoryDLQTasks.
+func (mr *MocktableCRUDMockRecorder) InsertIntoHistoryDLQTasks(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryDLQTasks", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoHistoryDLQTasks), ctx, row)
+}
+
 // InsertIntoHistoryNode mocks base method.
 func (m *MocktableCRUD) InsertIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -996,6 +1041,21 @@ func (mr *MocktableCRUDMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromHistoryDLQTasks mocks base method.
+func (m *MocktableCRUD) RangeDeleteFromHistoryDLQTasks(ctx context.Context, filter *HistoryDLQTaskFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromHistoryDLQTasks", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromHistoryDLQTasks indicates an expected call of RangeDeleteFromHistoryDLQTasks.
+func (mr *MocktableCRUDMockRecorder) RangeDeleteFromHistoryDLQTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromHistoryDLQTasks", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromHistoryDLQTasks), ctx, filter)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MocktableCRUD) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1131,6 +1191,21 @@ func (mr *MocktableCRUDMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoHistoryDLQAckLevels mocks base method.
+func (m *MocktableCRUD) ReplaceIntoHistoryDLQAckLevels(ctx context.Context, row *HistoryDLQAckLevelRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoHistoryDLQAckLevels", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoHistoryDLQAckLevels indicates an expected call of ReplaceIntoHistoryDLQAckLevels.
+func (mr *MocktableCRUDMockRecorder) ReplaceIntoHistoryDLQAckLevels(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoHistoryDLQAckLevels", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoHistoryDLQAckLevels), ctx, row)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MocktableCRUD) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1341,6 +1416,36 @@ func (mr *MocktableCRUDMockRecorder) SelectFromExecutions(ctx, filter any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromExecutions", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromExecutions), ctx, filter)
 }
 
+// SelectFromHistoryDLQAckLevels mocks base method.
+func (m *MocktableCRUD) SelectFromHistoryDLQAckLevels(ctx context.Context, filter *HistoryDLQAckLevelFilter) ([]HistoryDLQAckLevelRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromHistoryDLQAckLevels", ctx, filter)
+	ret0, _ := ret[0].([]HistoryDLQAckLevelRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromHistoryDLQAckLevels indicates an expected call of SelectFromHistoryDLQAckLevels.
+func (mr *MocktableCRUDMockRecorder) SelectFromHistoryDLQAckLevels(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryDLQAckLevels", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromHistoryDLQAckLevels), ctx, filter)
+}
+
+// SelectFromHistoryDLQTasks mocks base method.
+func (m *MocktableCRUD) SelectFromHistoryDLQTasks(ctx context.Context, filter *HistoryDLQTaskFilter) ([]HistoryDLQTaskRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromHistoryDLQTasks", ctx, filter)
+	ret0, _ := ret[0].([]HistoryDLQTaskRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromHistoryDLQTasks indicates an expected call of SelectFromHistoryDLQTasks.
+func (mr *MocktableCRUDMockRecorder) SelectFromHistoryDLQTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryDLQTasks", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromHistoryDLQTasks), ctx, filter)
+}
+
 // SelectFromHistoryNode mocks base method.
 func (m *MocktableCRUD) SelectFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) ([]HistoryNodeRow, error) {
 	m.ctrl.T.Helper()
@@ -1566,6 +1671,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromVisibility(ctx, filter any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MocktableCRUD) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MocktableCRUDMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MocktableCRUD) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -1728,6 +1848,21 @@ func (mr *MocktableCRUDMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MocktableCRUD)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpsertIntoVisibility mocks base method.
+func (m *MocktableCRUD) UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoVisibility indicates an expected call of UpsertIntoVisibility.
+func (mr *MocktableCRUDMockRecorder) UpsertIntoVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoVisibility", reflect.TypeOf((*MocktableCRUD)(nil).UpsertIntoVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MocktableCRUD) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -1967,6 +2102,21 @@ func (mr *MockTxMockRecorder) Commit() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTx)(nil).Commit))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockTx) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActiveClusterSelectionPolicy mocks base method.
 func (m *MockTx) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2565,6 +2715,36 @@ func (mr *MockTxMockRecorder) InsertIntoExecutions(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoExecutions", reflect.TypeOf((*MockTx)(nil).InsertIntoExecutions), ctx, row)
 }
 
+// InsertIntoHistoryDLQAckLevelsIfNotExists mocks base method.
+func (m *MockTx) InsertIntoHistoryDLQAckLevelsIfNotExists(ctx context.Context, row *HistoryDLQAckLevelRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoHistoryDLQAckLevelsIfNotExists", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoHistoryDLQAckLevelsIfNotExists indicates an expected call of InsertIntoHistoryDLQAckLevelsIfNotExists.
+func (mr *MockTxMockRecorder) InsertIntoHistoryDLQAckLevelsIfNotExists(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryDLQAckLevelsIfNotExists", reflect.TypeOf((*MockTx)(nil).InsertIntoHistoryDLQAckLevelsIfNotExists), ctx, row)
+}
+
+// InsertIntoHistoryDLQTasks mocks base method.
+func (m *MockTx) InsertIntoHistoryDLQTasks(ctx context.Context, row *HistoryDLQTaskRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoHistoryDLQTasks", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoHistoryDLQTasks indicates an expected call of InsertIntoHistoryDLQTasks.
+func (mr *MockTxMockRecorder) InsertIntoHistoryDLQTasks(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryDLQTasks", reflect.TypeOf((*MockTx)(nil).InsertIntoHistoryDLQTasks), ctx, row)
+}
+
 // InsertIntoHistoryNode mocks base method.
 func (m *MockTx) InsertIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2920,6 +3100,21 @@ func (mr *MockTxMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromHistoryDLQTasks mocks base method.
+func (m *MockTx) RangeDeleteFromHistoryDLQTasks(ctx context.Context, filter *HistoryDLQTaskFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromHistoryDLQTasks", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromHistoryDLQTasks indicates an expected call of RangeDeleteFromHistoryDLQTasks.
+func (mr *MockTxMockRecorder) RangeDeleteFromHistoryDLQTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromHistoryDLQTasks", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromHistoryDLQTasks), ctx, filter)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockTx) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3055,6 +3250,21 @@ func (mr *MockTxMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MockTx)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoHistoryDLQAckLevels mocks base method.
+func (m *MockTx) ReplaceIntoHistoryDLQAckLevels(ctx context.Context, row *HistoryDLQAckLevelRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoHistoryDLQAckLevels", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoHistoryDLQAckLevels indicates an expected call of ReplaceIntoHistoryDLQAckLevels.
+func (mr *MockTxMockRecorder) ReplaceIntoHistoryDLQAckLevels(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoHistoryDLQAckLevels", reflect.TypeOf((*MockTx)(nil).ReplaceIntoHistoryDLQAckLevels), ctx, row)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MockTx) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3279,6 +3489,36 @@ func (mr *MockTxMockRecorder) SelectFromExecutions(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromExecutions", reflect.TypeOf((*MockTx)(nil).SelectFromExecutions), ctx, filter)
 }
 
+// SelectFromHistoryDLQAckLevels mocks base method.
+func (m *MockTx) SelectFromHistoryDLQAckLevels(ctx context.Context, filter *HistoryDLQAckLevelFilter) ([]HistoryDLQAckLevelRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromHistoryDLQAckLevels", ctx, filter)
+	ret0, _ := ret[0].([]HistoryDLQAckLevelRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromHistoryDLQAckLevels indicates an expected call of SelectFromHistoryDLQAckLevels.
+func (mr *MockTxMockRecorder) SelectFromHistoryDLQAckLevels(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryDLQAckLevels", reflect.TypeOf((*MockTx)(nil).SelectFromHistoryDLQAckLevels), ctx, filter)
+}
+
+// SelectFromHistoryDLQTasks mocks base method.
+func (m *MockTx) SelectFromHistoryDLQTasks(ctx context.Context, filter *HistoryDLQTaskFilter) ([]HistoryDLQTaskRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromHistoryDLQTasks", ctx, filter)
+	ret0, _ := ret[0].([]HistoryDLQTaskRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromHistoryDLQTasks indicates an expected call of SelectFromHistoryDLQTasks.
+func (mr *MockTxMockRecorder) SelectFromHistoryDLQTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryDLQTasks", reflect.TypeOf((*MockTx)(nil).SelectFromHistoryDLQTasks), ctx, filter)
+}
+
 // SelectFromHistoryNode mocks base method.
 func (m *MockTx) SelectFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) ([]HistoryNodeRow, error) {
 	m.ctrl.T.Helper()
@@ -3504,6 +3744,21 @@ func (mr *MockTxMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockTx)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockTx) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockTxMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockTx)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockTx) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -3666,6 +3921,21 @@ func (mr *MockTxMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockTx)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpsertIntoVisibility mocks base method.
+func (m *MockTx) UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoVisibility indicates an expected call of UpsertIntoVisibility.
+func (mr *MockTxMockRecorder) UpsertIntoVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoVisibility", reflect.TypeOf((*MockTx)(nil).UpsertIntoVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockTx) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -3749,6 +4019,21 @@ func (mr *MockDBMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDB)(nil).Close))
 }
 
+// CountFromVisibilityByQuery mocks base method.
+func (m *MockDB) CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFromVisibilityByQuery indicates an expected call of CountFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) CountFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).CountFromVisibilityByQuery), ctx, filter)
+}
+
 // DeleteFromActiveClusterSelectionPolicy mocks base method.
 func (m *MockDB) DeleteFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4361,6 +4646,36 @@ func (mr *MockDBMockRecorder) InsertIntoExecutions(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoExecutions", reflect.TypeOf((*MockDB)(nil).InsertIntoExecutions), ctx, row)
 }
 
+// InsertIntoHistoryDLQAckLevelsIfNotExists mocks base method.
+func (m *MockDB) InsertIntoHistoryDLQAckLevelsIfNotExists(ctx context.Context, row *HistoryDLQAckLevelRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoHistoryDLQAckLevelsIfNotExists", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoHistoryDLQAckLevelsIfNotExists indicates an expected call of InsertIntoHistoryDLQAckLevelsIfNotExists.
+func (mr *MockDBMockRecorder) InsertIntoHistoryDLQAckLevelsIfNotExists(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryDLQAckLevelsIfNotExists", reflect.TypeOf((*MockDB)(nil).InsertIntoHistoryDLQAckLevelsIfNotExists), ctx, row)
+}
+
+// InsertIntoHistoryDLQTasks mocks base method.
+func (m *MockDB) InsertIntoHistoryDLQTasks(ctx context.Context, row *HistoryDLQTaskRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoHistoryDLQTasks", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoHistoryDLQTasks indicates an expected call of InsertIntoHistoryDLQTasks.
+func (mr *MockDBMockRecorder) InsertIntoHistoryDLQTasks(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryDLQTasks", reflect.TypeOf((*MockDB)(nil).InsertIntoHistoryDLQTasks), ctx, row)
+}
+
 // InsertIntoHistoryNode mocks base method.
 func (m *MockDB) InsertIntoHistoryNode(ctx context.Context, row *HistoryNodeRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4730,6 +5045,21 @@ func (mr *MockDBMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromHistoryDLQTasks mocks base method.
+func (m *MockDB) RangeDeleteFromHistoryDLQTasks(ctx context.Context, filter *HistoryDLQTaskFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromHistoryDLQTasks", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromHistoryDLQTasks indicates an expected call of RangeDeleteFromHistoryDLQTasks.
+func (mr *MockDBMockRecorder) RangeDeleteFromHistoryDLQTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromHistoryDLQTasks", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromHistoryDLQTasks), ctx, filter)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockDB) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4865,6 +5195,21 @@ func (mr *MockDBMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MockDB)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoHistoryDLQAckLevels mocks base method.
+func (m *MockDB) ReplaceIntoHistoryDLQAckLevels(ctx context.Context, row *HistoryDLQAckLevelRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoHistoryDLQAckLevels", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoHistoryDLQAckLevels indicates an expected call of ReplaceIntoHistoryDLQAckLevels.
+func (mr *MockDBMockRecorder) ReplaceIntoHistoryDLQAckLevels(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoHistoryDLQAckLevels", reflect.TypeOf((*MockDB)(nil).ReplaceIntoHistoryDLQAckLevels), ctx, row)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MockDB) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -5075,6 +5420,36 @@ func (mr *MockDBMockRecorder) SelectFromExecutions(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromExecutions", reflect.TypeOf((*MockDB)(nil).SelectFromExecutions), ctx, filter)
 }
 
+// SelectFromHistoryDLQAckLevels mocks base method.
+func (m *MockDB) SelectFromHistoryDLQAckLevels(ctx context.Context, filter *HistoryDLQAckLevelFilter) ([]HistoryDLQAckLevelRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromHistoryDLQAckLevels", ctx, filter)
+	ret0, _ := ret[0].([]HistoryDLQAckLevelRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromHistoryDLQAckLevels indicates an expected call of SelectFromHistoryDLQAckLevels.
+func (mr *MockDBMockRecorder) SelectFromHistoryDLQAckLevels(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryDLQAckLevels", reflect.TypeOf((*MockDB)(nil).SelectFromHistoryDLQAckLevels), ctx, filter)
+}
+
+// SelectFromHistoryDLQTasks mocks base method.
+func (m *MockDB) SelectFromHistoryDLQTasks(ctx context.Context, filter *HistoryDLQTaskFilter) ([]HistoryDLQTaskRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromHistoryDLQTasks", ctx, filter)
+	ret0, _ := ret[0].([]HistoryDLQTaskRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromHistoryDLQTasks indicates an expected call of SelectFromHistoryDLQTasks.
+func (mr *MockDBMockRecorder) SelectFromHistoryDLQTasks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryDLQTasks", reflect.TypeOf((*MockDB)(nil).SelectFromHistoryDLQTasks), ctx, filter)
+}
+
 // SelectFromHistoryNode mocks base method.
 func (m *MockDB) SelectFromHistoryNode(ctx context.Context, filter *HistoryNodeFilter) ([]HistoryNodeRow, error) {
 	m.ctrl.T.Helper()
@@ -5300,6 +5675,21 @@ func (mr *MockDBMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockDB)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectFromVisibilityByQuery mocks base method.
+func (m *MockDB) SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromVisibilityByQuery", ctx, filter)
+	ret0, _ := ret[0].([]VisibilityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromVisibilityByQuery indicates an expected call of SelectFromVisibilityByQuery.
+func (mr *MockDBMockRecorder) SelectFromVisibilityByQuery(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibilityByQuery", reflect.TypeOf((*MockDB)(nil).SelectFromVisibilityByQuery), ctx, filter)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockDB) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -5462,6 +5852,21 @@ func (mr *MockDBMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockDB)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpsertIntoVisibility mocks base method.
+func (m *MockDB) UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIntoVisibility", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIntoVisibility indicates an expected call of UpsertIntoVisibility.
+func (mr *MockDBMockRecorder) UpsertIntoVisibility(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIntoVisibility", reflect.TypeOf((*MockDB)(nil).UpsertIntoVisibility), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockDB) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -128,16 +128,18 @@ type (
 
 	// ExecutionsRow represents a row in executions table
 	ExecutionsRow struct {
-		ShardID                  int
-		DomainID                 serialization.UUID
-		WorkflowID               string
-		RunID                    serialization.UUID
-		NextEventID              int64
-		LastWriteVersion         int64
-		Data                     []byte
-		DataEncoding             string
-		VersionHistories         []byte
-		VersionHistoriesEncoding string
+		ShardID                    int
+		DomainID                   serialization.UUID
+		WorkflowID                 string
+		RunID                      serialization.UUID
+		NextEventID                int64
+		LastWriteVersion           int64
+		Data                       []byte
+		DataEncoding               string
+		VersionHistories           []byte
+		VersionHistoriesEncoding   string
+		Paused                     bool
+		DecisionDispatchSuppressed bool
 	}
 
 	// ExecutionsFilter contains the column names within executions table that
@@ -431,6 +433,8 @@ type (
 		DataEncoding             string
 		LastHeartbeatDetails     []byte
 		LastHeartbeatUpdatedTime time.Time
+		Paused                   bool
+		DispatchSuppressed       bool
 	}
 
 	// ActivityInfoMapsFilter contains the column names within activity_info_maps table that
@@ -566,6 +570,7 @@ type (
 		ShardID                int16
 		ExecutionStatus        int32
 		ScheduledExecutionTime time.Time
+		SearchAttributes       []byte
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -582,6 +587,18 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the parameters of a query based read from executions_visibility table
+	VisibilityQueryFilter struct {
+		DomainID string
+		Query    *VisibilityQuery
+		// LastStartTime and LastRunID identify the last row of the previous page when the query has no ORDER BY
+		LastStartTime *time.Time
+		LastRunID     *string
+		// Offset is the number of rows to skip when the query has an ORDER BY
+		Offset   int
+		PageSize int
+	}
+
 	// QueueRow represents a row in queue table
 	QueueRow struct {
 		QueueType      persistence.QueueType
@@ -631,6 +648,62 @@ type (
 		PageMinEventID     *string
 	}
 
+	// HistoryDLQTaskRow represents a row in history_task_dlq table
+	HistoryDLQTaskRow struct {
+		ShardID               int
+		DomainID              string
+		ClusterAttributeScope string
+		ClusterAttributeName  string
+		TaskCategory          int
+		VisibilityTimestamp   time.Time
+		TaskID                int64
+		WorkflowID            string
+		RunID                 string
+		Version               int64
+		Data                  []byte
+		DataEncoding          string
+		CreatedAt             time.Time
+	}
+
+	// HistoryDLQTaskFilter contains the filter criteria for querying or range deleting
+	// history DLQ tasks of a single partition. Task keys are compared as
+	// (visibility_timestamp, task_id) tuples.
+	HistoryDLQTaskFilter struct {
+		ShardID                  int
+		DomainID                 string
+		ClusterAttributeScope    string
+		ClusterAttributeName     string
+		TaskCategory             int
+		InclusiveMinVisibilityTS time.Time
+		InclusiveMinTaskID       int64
+		ExclusiveMaxVisibilityTS time.Time
+		ExclusiveMaxTaskID       int64
+		PageSize                 int
+	}
+
+	// HistoryDLQAckLevelRow represents a row in history_task_dlq_ack_level table
+	HistoryDLQAckLevelRow struct {
+		ShardID                     int
+		DomainID                    string
+		ClusterAttributeScope       string
+		ClusterAttributeName        string
+		TaskCategory                int
+		AckLevelVisibilityTimestamp time.Time
+		AckLevelTaskID              int64
+		LastUpdatedAt               time.Time
+	}
+
+	// HistoryDLQAckLevelFilter contains the filter criteria for querying history DLQ ack levels.
+	// DomainID, ClusterAttributeScope and ClusterAttributeName are optional; when DomainID is empty
+	// all ack levels of the shard are returned, and the cluster attribute is only applied when
+	// both of its fields are set
+	HistoryDLQAckLevelFilter struct {
+		ShardID               int
+		DomainID              string
+		ClusterAttributeScope string
+		ClusterAttributeName  string
+	}
+
 	// tableCRUD defines the API for interacting with the database tables
 	tableCRUD interface {
 		InsertIntoDomain(ctx context.Context, rows *DomainRow) (sql.Result, error)
@@ -858,6 +931,15 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+		// only its memo, update time and search attributes are updated
+		UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns a page of rows matching a visibility query
+		// Required filter params - {domainID, query, pageSize}
+		SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows matching a visibility query
+		// Required filter params - {domainID, query}
+		CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
@@ -881,6 +963,20 @@ type (
 		// SelectFromDomainAuditLogs returns audit log entries for a domain. Returns paginated results ordered by created_time DESC, event_id ASC
 		SelectFromDomainAuditLogs(ctx context.Context, filter *DomainAuditLogFilter) ([]*DomainAuditLogRow, error)
 
+		// InsertIntoHistoryDLQTasks inserts a single task into the history task DLQ
+		InsertIntoHistoryDLQTasks(ctx context.Context, row *HistoryDLQTaskRow) (sql.Result, error)
+		// SelectFromHistoryDLQTasks returns tasks of a DLQ partition within the filter's task key range,
+		// ordered by (visibility_timestamp, task_id) and limited to filter.PageSize rows
+		SelectFromHistoryDLQTasks(ctx context.Context, filter *HistoryDLQTaskFilter) ([]HistoryDLQTaskRow, error)
+		// RangeDeleteFromHistoryDLQTasks deletes all tasks of a DLQ partition below the filter's exclusive max task key
+		RangeDeleteFromHistoryDLQTasks(ctx context.Context, filter *HistoryDLQTaskFilter) (sql.Result, error)
+		// ReplaceIntoHistoryDLQAckLevels inserts or overwrites the ack level of a DLQ partition
+		ReplaceIntoHistoryDLQAckLevels(ctx context.Context, row *HistoryDLQAckLevelRow) (sql.Result, error)
+		// InsertIntoHistoryDLQAckLevelsIfNotExists inserts the ack level of a DLQ partition unless one already exists
+		InsertIntoHistoryDLQAckLevelsIfNotExists(ctx context.Context, row *HistoryDLQAckLevelRow) (sql.Result, error)
+		// SelectFromHistoryDLQAckLevels returns the ack levels of a shard matching the filter
+		SelectFromHistoryDLQAckLevels(ctx context.Context, filter *HistoryDLQAckLevelFilter) ([]HistoryDLQAckLevelRow, error)
+
 		// The follow provide information about the underlying sql crud implementation
 		SupportsTTL() bool
 		MaxAllowedTTL() (*time.Duration, error)
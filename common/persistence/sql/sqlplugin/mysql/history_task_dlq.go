@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertHistoryDLQTaskQuery = `INSERT INTO history_task_dlq (
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		visibility_timestamp, task_id, workflow_id, run_id, version, data, data_encoding, created_at
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	historyDLQTaskPartitionClause = `shard_id = ? AND domain_id = ? AND cluster_attribute_scope = ? AND cluster_attribute_name = ?
+	AND task_category = ?`
+
+	getHistoryDLQTasksQuery = `SELECT
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		visibility_timestamp, task_id, workflow_id, run_id, version, data, data_encoding, created_at
+	FROM history_task_dlq
+	WHERE ` + historyDLQTaskPartitionClause + `
+	AND (visibility_timestamp > ? OR (visibility_timestamp = ? AND task_id >= ?))
+	AND (visibility_timestamp < ? OR (visibility_timestamp = ? AND task_id < ?))
+	ORDER BY visibility_timestamp, task_id
+	LIMIT ?`
+
+	rangeDeleteHistoryDLQTasksQuery = `DELETE FROM history_task_dlq
+	WHERE ` + historyDLQTaskPartitionClause + `
+	AND (visibility_timestamp < ? OR (visibility_timestamp = ? AND task_id < ?))`
+
+	replaceHistoryDLQAckLevelQuery = `INSERT INTO history_task_dlq_ack_level (
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		ack_level_visibility_timestamp, ack_level_task_id, last_updated_at
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	ON DUPLICATE KEY UPDATE
+		ack_level_visibility_timestamp = VALUES(ack_level_visibility_timestamp),
+		ack_level_task_id = VALUES(ack_level_task_id),
+		last_updated_at = VALUES(last_updated_at)`
+
+	// Insert is silently skipped if the partition already has an ack level, so recorded progress is never reset
+	insertHistoryDLQAckLevelIfNotExistsQuery = `INSERT IGNORE INTO history_task_dlq_ack_level (
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		ack_level_visibility_timestamp, ack_level_task_id, last_updated_at
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	getHistoryDLQAckLevelsQuery = `SELECT
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		ack_level_visibility_timestamp, ack_level_task_id, last_updated_at
+	FROM history_task_dlq_ack_level
+	WHERE shard_id = ?`
+
+	getHistoryDLQAckLevelsByDomainQuery = getHistoryDLQAckLevelsQuery + ` AND domain_id = ?`
+
+	getHistoryDLQAckLevelsByClusterAttributeQuery = getHistoryDLQAckLevelsByDomainQuery +
+		` AND cluster_attribute_scope = ? AND cluster_attribute_name = ?`
+)
+
+// InsertIntoHistoryDLQTasks inserts a single row into history_task_dlq table
+func (mdb *DB) InsertIntoHistoryDLQTasks(ctx context.Context, row *sqlplugin.HistoryDLQTaskRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(row.ShardID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(
+		ctx,
+		dbShardID,
+		insertHistoryDLQTaskQuery,
+		row.ShardID,
+		row.DomainID,
+		row.ClusterAttributeScope,
+		row.ClusterAttributeName,
+		row.TaskCategory,
+		mdb.converter.ToDateTime(row.VisibilityTimestamp),
+		row.TaskID,
+		row.WorkflowID,
+		row.RunID,
+		row.Version,
+		row.Data,
+		row.DataEncoding,
+		mdb.converter.ToDateTime(row.CreatedAt),
+	)
+}
+
+// SelectFromHistoryDLQTasks reads one page of rows from history_task_dlq table
+func (mdb *DB) SelectFromHistoryDLQTasks(ctx context.Context, filter *sqlplugin.HistoryDLQTaskFilter) ([]sqlplugin.HistoryDLQTaskRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, mdb.GetTotalNumDBShards())
+	minVisibilityTS := mdb.converter.ToDateTime(filter.InclusiveMinVisibilityTS)
+	maxVisibilityTS := mdb.converter.ToDateTime(filter.ExclusiveMaxVisibilityTS)
+	var rows []sqlplugin.HistoryDLQTaskRow
+	err := mdb.driver.SelectContext(
+		ctx,
+		dbShardID,
+		&rows,
+		getHistoryDLQTasksQuery,
+		filter.ShardID,
+		filter.DomainID,
+		filter.ClusterAttributeScope,
+		filter.ClusterAttributeName,
+		filter.TaskCategory,
+		minVisibilityTS,
+		minVisibilityTS,
+		filter.InclusiveMinTaskID,
+		maxVisibilityTS,
+		maxVisibilityTS,
+		filter.ExclusiveMaxTaskID,
+		filter.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].VisibilityTimestamp = mdb.converter.FromDateTime(rows[i].VisibilityTimestamp)
+		rows[i].CreatedAt = mdb.converter.FromDateTime(rows[i].CreatedAt)
+	}
+	return rows, nil
+}
+
+// RangeDeleteFromHistoryDLQTasks deletes all rows of a partition below the exclusive max task key from history_task_dlq table
+func (mdb *DB) RangeDeleteFromHistoryDLQTasks(ctx context.Context, filter *sqlplugin.HistoryDLQTaskFilter) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, mdb.GetTotalNumDBShards())
+	maxVisibilityTS := mdb.converter.ToDateTime(filter.ExclusiveMaxVisibilityTS)
+	return mdb.driver.ExecContext(
+		ctx,
+		dbShardID,
+		rangeDeleteHistoryDLQTasksQuery,
+		filter.ShardID,
+		filter.DomainID,
+		filter.ClusterAttributeScope,
+		filter.ClusterAttributeName,
+		filter.TaskCategory,
+		maxVisibilityTS,
+		maxVisibilityTS,
+		filter.ExclusiveMaxTaskID,
+	)
+}
+
+// ReplaceIntoHistoryDLQAckLevels inserts or overwrites a row in history_task_dlq_ack_level table
+func (mdb *DB) ReplaceIntoHistoryDLQAckLevels(ctx context.Context, row *sqlplugin.HistoryDLQAckLevelRow) (sql.Result, error) {
+	return mdb.execHistoryDLQAckLevelQuery(ctx, replaceHistoryDLQAckLevelQuery, row)
+}
+
+// InsertIntoHistoryDLQAckLevelsIfNotExists inserts a row into history_task_dlq_ack_level table unless it already exists
+func (mdb *DB) InsertIntoHistoryDLQAckLevelsIfNotExists(ctx context.Context, row *sqlplugin.HistoryDLQAckLevelRow) (sql.Result, error) {
+	return mdb.execHistoryDLQAckLevelQuery(ctx, insertHistoryDLQAckLevelIfNotExistsQuery, row)
+}
+
+func (mdb *DB) execHistoryDLQAckLevelQuery(ctx context.Context, query string, row *sqlplugin.HistoryDLQAckLevelRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(row.ShardID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(
+		ctx,
+		dbShardID,
+		query,
+		row.ShardID,
+		row.DomainID,
+		row.ClusterAttributeScope,
+		row.ClusterAttributeName,
+		row.TaskCategory,
+		mdb.converter.ToDateTime(row.AckLevelVisibilityTimestamp),
+		row.AckLevelTaskID,
+		mdb.converter.ToDateTime(row.LastUpdatedAt),
+	)
+}
+
+// SelectFromHistoryDLQAckLevels reads rows from history_task_dlq_ack_level table
+func (mdb *DB) SelectFromHistoryDLQAckLevels(ctx context.Context, filter *sqlplugin.HistoryDLQAckLevelFilter) ([]sqlplugin.HistoryDLQAckLevelRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, mdb.GetTotalNumDBShards())
+	var rows []sqlplugin.HistoryDLQAckLevelRow
+	var err error
+	switch {
+	case filter.DomainID != "" && filter.ClusterAttributeScope != "" && filter.ClusterAttributeName != "":
+		err = mdb.driver.SelectContext(ctx, dbShardID, &rows, getHistoryDLQAckLevelsByClusterAttributeQuery,
+			filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName)
+	case filter.DomainID != "":
+		err = mdb.driver.SelectContext(ctx, dbShardID, &rows, getHistoryDLQAckLevelsByDomainQuery,
+			filter.ShardID, filter.DomainID)
+	default:
+		err = mdb.driver.SelectContext(ctx, dbShardID, &rows, getHistoryDLQAckLevelsQuery, filter.ShardID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].AckLevelVisibilityTimestamp = mdb.converter.FromDateTime(rows[i].AckLevelVisibilityTimestamp)
+		rows[i].LastUpdatedAt = mdb.converter.FromDateTime(rows[i].LastUpdatedAt)
+	}
+	return rows, nil
+}
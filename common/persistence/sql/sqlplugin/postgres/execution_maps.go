@@ -149,6 +149,8 @@ var (
 		"data_encoding",
 		"last_heartbeat_details",
 		"last_heartbeat_updated_time",
+		"paused",
+		"dispatch_suppressed",
 	}
 	activityInfoTableName = "activity_info_maps"
 	activityInfoKey       = "schedule_id"
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertHistoryDLQTaskQuery = `INSERT INTO history_task_dlq (
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		visibility_timestamp, task_id, workflow_id, run_id, version, data, data_encoding, created_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+	getHistoryDLQTasksQuery = `SELECT
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		visibility_timestamp, task_id, workflow_id, run_id, version, data, data_encoding, created_at
+	FROM history_task_dlq
+	WHERE shard_id = $1 AND domain_id = $2 AND cluster_attribute_scope = $3 AND cluster_attribute_name = $4
+	AND task_category = $5
+	AND (visibility_timestamp, task_id) >= ($6, $7)
+	AND (visibility_timestamp, task_id) < ($8, $9)
+	ORDER BY visibility_timestamp, task_id
+	LIMIT $10`
+
+	rangeDeleteHistoryDLQTasksQuery = `DELETE FROM history_task_dlq
+	WHERE shard_id = $1 AND domain_id = $2 AND cluster_attribute_scope = $3 AND cluster_attribute_name = $4
+	AND task_category = $5
+	AND (visibility_timestamp, task_id) < ($6, $7)`
+
+	insertHistoryDLQAckLevelQuery = `INSERT INTO history_task_dlq_ack_level (
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		ack_level_visibility_timestamp, ack_level_task_id, last_updated_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	ON CONFLICT (shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category)`
+
+	replaceHistoryDLQAckLevelQuery = insertHistoryDLQAckLevelQuery + ` DO UPDATE
+		SET ack_level_visibility_timestamp = excluded.ack_level_visibility_timestamp,
+			ack_level_task_id = excluded.ack_level_task_id,
+			last_updated_at = excluded.last_updated_at`
+
+	// Insert is silently skipped if the partition already has an ack level, so recorded progress is never reset
+	insertHistoryDLQAckLevelIfNotExistsQuery = insertHistoryDLQAckLevelQuery + ` DO NOTHING`
+
+	getHistoryDLQAckLevelsQuery = `SELECT
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		ack_level_visibility_timestamp, ack_level_task_id, last_updated_at
+	FROM history_task_dlq_ack_level
+	WHERE shard_id = $1`
+
+	getHistoryDLQAckLevelsByDomainQuery = getHistoryDLQAckLevelsQuery + ` AND domain_id = $2`
+
+	getHistoryDLQAckLevelsByClusterAttributeQuery = getHistoryDLQAckLevelsByDomainQuery +
+		` AND cluster_attribute_scope = $3 AND cluster_attribute_name = $4`
+)
+
+// InsertIntoHistoryDLQTasks inserts a single row into history_task_dlq table
+func (pdb *db) InsertIntoHistoryDLQTasks(ctx context.Context, row *sqlplugin.HistoryDLQTaskRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(row.ShardID, pdb.GetTotalNumDBShards())
+	return pdb.driver.ExecContext(
+		ctx,
+		dbShardID,
+		insertHistoryDLQTaskQuery,
+		row.ShardID,
+		row.DomainID,
+		row.ClusterAttributeScope,
+		row.ClusterAttributeName,
+		row.TaskCategory,
+		pdb.converter.ToPostgresDateTime(row.VisibilityTimestamp),
+		row.TaskID,
+		row.WorkflowID,
+		row.RunID,
+		row.Version,
+		row.Data,
+		row.DataEncoding,
+		pdb.converter.ToPostgresDateTime(row.CreatedAt),
+	)
+}
+
+// SelectFromHistoryDLQTasks reads one page of rows from history_task_dlq table
+func (pdb *db) SelectFromHistoryDLQTasks(ctx context.Context, filter *sqlplugin.HistoryDLQTaskFilter) ([]sqlplugin.HistoryDLQTaskRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, pdb.GetTotalNumDBShards())
+	var rows []sqlplugin.HistoryDLQTaskRow
+	err := pdb.driver.SelectContext(
+		ctx,
+		dbShardID,
+		&rows,
+		getHistoryDLQTasksQuery,
+		filter.ShardID,
+		filter.DomainID,
+		filter.ClusterAttributeScope,
+		filter.ClusterAttributeName,
+		filter.TaskCategory,
+		pdb.converter.ToPostgresDateTime(filter.InclusiveMinVisibilityTS),
+		filter.InclusiveMinTaskID,
+		pdb.converter.ToPostgresDateTime(filter.ExclusiveMaxVisibilityTS),
+		filter.ExclusiveMaxTaskID,
+		filter.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].VisibilityTimestamp = pdb.converter.FromPostgresDateTime(rows[i].VisibilityTimestamp)
+		rows[i].CreatedAt = pdb.converter.FromPostgresDateTime(rows[i].CreatedAt)
+	}
+	return rows, nil
+}
+
+// RangeDeleteFromHistoryDLQTasks deletes all rows of a partition below the exclusive max task key from history_task_dlq table
+func (pdb *db) RangeDeleteFromHistoryDLQTasks(ctx context.Context, filter *sqlplugin.HistoryDLQTaskFilter) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, pdb.GetTotalNumDBShards())
+	return pdb.driver.ExecContext(
+		ctx,
+		dbShardID,
+		rangeDeleteHistoryDLQTasksQuery,
+		filter.ShardID,
+		filter.DomainID,
+		filter.ClusterAttributeScope,
+		filter.ClusterAttributeName,
+		filter.TaskCategory,
+		pdb.converter.ToPostgresDateTime(filter.ExclusiveMaxVisibilityTS),
+		filter.ExclusiveMaxTaskID,
+	)
+}
+
+// ReplaceIntoHistoryDLQAckLevels inserts or overwrites a row in history_task_dlq_ack_level table
+func (pdb *db) ReplaceIntoHistoryDLQAckLevels(ctx context.Context, row *sqlplugin.HistoryDLQAckLevelRow) (sql.Result, error) {
+	return pdb.execHistoryDLQAckLevelQuery(ctx, replaceHistoryDLQAckLevelQuery, row)
+}
+
+// InsertIntoHistoryDLQAckLevelsIfNotExists inserts a row into history_task_dlq_ack_level table unless it already exists
+func (pdb *db) InsertIntoHistoryDLQAckLevelsIfNotExists(ctx context.Context, row *sqlplugin.HistoryDLQAckLevelRow) (sql.Result, error) {
+	return pdb.execHistoryDLQAckLevelQuery(ctx, insertHistoryDLQAckLevelIfNotExistsQuery, row)
+}
+
+func (pdb *db) execHistoryDLQAckLevelQuery(ctx context.Context, query string, row *sqlplugin.HistoryDLQAckLevelRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(row.ShardID, pdb.GetTotalNumDBShards())
+	return pdb.driver.ExecContext(
+		ctx,
+		dbShardID,
+		query,
+		row.ShardID,
+		row.DomainID,
+		row.ClusterAttributeScope,
+		row.ClusterAttributeName,
+		row.TaskCategory,
+		pdb.converter.ToPostgresDateTime(row.AckLevelVisibilityTimestamp),
+		row.AckLevelTaskID,
+		pdb.converter.ToPostgresDateTime(row.LastUpdatedAt),
+	)
+}
+
+// SelectFromHistoryDLQAckLevels reads rows from history_task_dlq_ack_level table
+func (pdb *db) SelectFromHistoryDLQAckLevels(ctx context.Context, filter *sqlplugin.HistoryDLQAckLevelFilter) ([]sqlplugin.HistoryDLQAckLevelRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(filter.ShardID, pdb.GetTotalNumDBShards())
+	var rows []sqlplugin.HistoryDLQAckLevelRow
+	var err error
+	switch {
+	case filter.DomainID != "" && filter.ClusterAttributeScope != "" && filter.ClusterAttributeName != "":
+		err = pdb.driver.SelectContext(ctx, dbShardID, &rows, getHistoryDLQAckLevelsByClusterAttributeQuery,
+			filter.ShardID, filter.DomainID, filter.ClusterAttributeScope, filter.ClusterAttributeName)
+	case filter.DomainID != "":
+		err = pdb.driver.SelectContext(ctx, dbShardID, &rows, getHistoryDLQAckLevelsByDomainQuery,
+			filter.ShardID, filter.DomainID)
+	default:
+		err = pdb.driver.SelectContext(ctx, dbShardID, &rows, getHistoryDLQAckLevelsQuery, filter.ShardID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].AckLevelVisibilityTimestamp = pdb.converter.FromPostgresDateTime(rows[i].AckLevelVisibilityTimestamp)
+		rows[i].LastUpdatedAt = pdb.converter.FromPostgresDateTime(rows[i].LastUpdatedAt)
+	}
+	return rows, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	insertHistoryDLQAckLevelQuery = `INSERT INTO history_task_dlq_ack_level (
+		shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category,
+		ack_level_visibility_timestamp, ack_level_task_id, last_updated_at
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT (shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category)`
+
+	replaceHistoryDLQAckLevelQuery = insertHistoryDLQAckLevelQuery + ` DO UPDATE
+		SET ack_level_visibility_timestamp = excluded.ack_level_visibility_timestamp,
+			ack_level_task_id = excluded.ack_level_task_id,
+			last_updated_at = excluded.last_updated_at`
+
+	// Insert is silently skipped if the partition already has an ack level, so recorded progress is never reset
+	insertHistoryDLQAckLevelIfNotExistsQuery = insertHistoryDLQAckLevelQuery + ` DO NOTHING`
+)
+
+// ReplaceIntoHistoryDLQAckLevels inserts or overwrites a row in history_task_dlq_ack_level table
+func (mdb *DB) ReplaceIntoHistoryDLQAckLevels(ctx context.Context, row *sqlplugin.HistoryDLQAckLevelRow) (sql.Result, error) {
+	return mdb.execHistoryDLQAckLevelQuery(ctx, replaceHistoryDLQAckLevelQuery, row)
+}
+
+// InsertIntoHistoryDLQAckLevelsIfNotExists inserts a row into history_task_dlq_ack_level table unless it already exists
+func (mdb *DB) InsertIntoHistoryDLQAckLevelsIfNotExists(ctx context.Context, row *sqlplugin.HistoryDLQAckLevelRow) (sql.Result, error) {
+	return mdb.execHistoryDLQAckLevelQuery(ctx, insertHistoryDLQAckLevelIfNotExistsQuery, row)
+}
+
+func (mdb *DB) execHistoryDLQAckLevelQuery(ctx context.Context, query string, row *sqlplugin.HistoryDLQAckLevelRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(row.ShardID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(
+		ctx,
+		dbShardID,
+		query,
+		row.ShardID,
+		row.DomainID,
+		row.ClusterAttributeScope,
+		row.ClusterAttributeName,
+		row.TaskCategory,
+		mdb.converter.ToDateTime(row.AckLevelVisibilityTimestamp),
+		row.AckLevelTaskID,
+		mdb.converter.ToDateTime(row.LastUpdatedAt),
+	)
+}
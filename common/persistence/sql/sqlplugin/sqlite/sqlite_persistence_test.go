@@ -121,3 +121,11 @@ func TestSQLiteDomainAuditPersistence(t *testing.T) {
 	s.TestBase.Setup()
 	suite.Run(t, s)
 }
+
+func TestSQLiteHistoryTaskDLQPersistence(t *testing.T) {
+	s := new(pt.HistoryTaskDLQPersistenceSuite)
+	option := GetTestClusterOption()
+	s.TestBase = pt.NewTestBaseWithSQL(t, option)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
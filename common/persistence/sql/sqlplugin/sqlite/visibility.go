@@ -25,14 +25,27 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT OR IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters, update_time, shard_id, execution_status, cron_schedule, scheduled_execution_time, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT (domain_id, run_id) DO UPDATE
+		   SET memo = excluded.memo,
+		       encoding = excluded.encoding,
+		       update_time = excluded.update_time,
+		       search_attributes = excluded.search_attributes`
 )
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -54,5 +67,79 @@ func (mdb *DB) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.IsCron,
 		row.NumClusters,
 		row.UpdateTime,
-		row.ShardID)
+		row.ShardID,
+		searchAttributesValue(row.SearchAttributes))
+}
+
+// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+// only its memo, update time and search attributes are updated
+func (mdb *DB) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToDateTime(row.StartTime)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.IsCron,
+		row.NumClusters,
+		row.UpdateTime,
+		row.ShardID,
+		row.ExecutionStatus,
+		row.CronSchedule,
+		mdb.converter.ToDateTime(row.ScheduledExecutionTime),
+		searchAttributesValue(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of rows matching a visibility query from visibility table
+func (mdb *DB) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := sqlplugin.BuildVisibilitySelectQuery(&visibilityQueryDialect{converter: mdb.converter}, filter)
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows matching a visibility query in visibility table
+func (mdb *DB) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := sqlplugin.BuildVisibilityCountQuery(&visibilityQueryDialect{converter: mdb.converter}, filter)
+	var count int64
+	err := mdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+func searchAttributesValue(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+type visibilityQueryDialect struct {
+	converter mysql.DataConverter
+}
+
+func (d *visibilityQueryDialect) Placeholder(int) string {
+	return "?"
+}
+
+func (d *visibilityQueryDialect) SearchAttribute(key string, valueType types.IndexedValueType) string {
+	if valueType == types.IndexedValueTypeBool {
+		// json_extract returns booleans as 1 and 0, while json_type returns them as 'true' and 'false'
+		return fmt.Sprintf("json_type(search_attributes, '$.%s')", key)
+	}
+	return fmt.Sprintf("json_extract(search_attributes, '$.%s')", key)
+}
+
+func (d *visibilityQueryDialect) ToDateTime(t time.Time) time.Time {
+	return d.converter.ToDateTime(t)
 }
@@ -0,0 +1,584 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+// SearchAttributeDatetimeLayout is the layout Datetime search attributes are stored with in the
+// search_attributes column. It is fixed width and always in UTC so values compare correctly as text.
+const SearchAttributeDatetimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+const (
+	visibilitySelectFields = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, update_time, shard_id, ` +
+		`close_time, close_status, history_length, COALESCE(num_clusters, 0) AS num_clusters, COALESCE(execution_status, 0) AS execution_status, ` +
+		`COALESCE(cron_schedule, '') AS cron_schedule, search_attributes`
+
+	visibilityMissingValue = "missing"
+)
+
+var searchAttributeKeyRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+type (
+	// VisibilityQueryDialect renders the plugin specific parts of a query based visibility read
+	VisibilityQueryDialect interface {
+		// Placeholder returns the bind parameter for the n-th (1-based) argument of the statement
+		Placeholder(n int) string
+		// SearchAttribute returns an expression reading key from the search_attributes column.
+		// Int and Double attributes must be read as numbers, all other types as text.
+		SearchAttribute(key string, valueType types.IndexedValueType) string
+		// ToDateTime converts a time to the value stored in the datetime columns
+		ToDateTime(t time.Time) time.Time
+	}
+
+	// VisibilityQuery is a parsed visibility query. The query is expected to have been validated
+	// by the frontend, so custom search attributes are prefixed with definition.Attr.
+	VisibilityQuery struct {
+		condition visibilityCondition
+		orderBy   []visibilityOrder
+	}
+
+	visibilityCondition interface {
+		render(b *visibilityQueryBuilder)
+	}
+
+	visibilityLogicalCondition struct {
+		operator    string
+		left, right visibilityCondition
+	}
+
+	visibilityComparison struct {
+		field    visibilityField
+		operator string
+		args     []interface{}
+	}
+
+	visibilityOrder struct {
+		field      visibilityField
+		descending bool
+	}
+
+	visibilityField struct {
+		// column is set for system search attributes, which have their own column
+		column string
+		// key and valueType are set for custom search attributes
+		key       string
+		valueType types.IndexedValueType
+		parse     func(sqlparser.Expr) (interface{}, error)
+	}
+
+	visibilityQueryBuilder struct {
+		dialect VisibilityQueryDialect
+		sb      strings.Builder
+		args    []interface{}
+	}
+)
+
+var visibilitySystemFields = map[string]visibilityField{
+	definition.DomainID:               {column: "domain_id", parse: parseVisibilityString},
+	definition.WorkflowID:             {column: "workflow_id", parse: parseVisibilityString},
+	definition.RunID:                  {column: "run_id", parse: parseVisibilityString},
+	definition.WorkflowType:           {column: "workflow_type_name", parse: parseVisibilityString},
+	definition.CronSchedule:           {column: "cron_schedule", parse: parseVisibilityString},
+	definition.StartTime:              {column: "start_time", parse: parseVisibilityTime},
+	definition.ExecutionTime:          {column: "execution_time", parse: parseVisibilityTime},
+	definition.CloseTime:              {column: "close_time", parse: parseVisibilityTime},
+	definition.UpdateTime:             {column: "update_time", parse: parseVisibilityTime},
+	definition.ScheduledExecutionTime: {column: "scheduled_execution_time", parse: parseVisibilityTime},
+	definition.CloseStatus:            {column: "close_status", parse: parseVisibilityCloseStatus},
+	definition.ExecutionStatus:        {column: "execution_status", parse: parseVisibilityExecutionStatus},
+	definition.HistoryLength:          {column: "history_length", parse: parseVisibilityInt},
+	definition.NumClusters:            {column: "num_clusters", parse: parseVisibilityInt},
+	definition.IsCron:                 {column: "is_cron", parse: parseVisibilityBool},
+}
+
+// ParseVisibilityQuery parses a visibility query into a VisibilityQuery. searchAttributeTypes is
+// used to compare custom search attributes with the right type; when a key is missing from it,
+// the type is inferred from the literal the attribute is compared with.
+func ParseVisibilityQuery(query string, searchAttributeTypes map[string]types.IndexedValueType) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return &VisibilityQuery{}, nil
+	}
+
+	// #nosec
+	placeholderQuery := fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: "Invalid query."}
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, &types.BadRequestError{Message: "Invalid select query."}
+	}
+
+	result := &VisibilityQuery{}
+	if sel.Where != nil {
+		result.condition, err = parseVisibilityCondition(sel.Where.Expr, searchAttributeTypes)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+	}
+	for _, order := range sel.OrderBy {
+		field, err := getVisibilityField(order.Expr, searchAttributeTypes, nil)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+		result.orderBy = append(result.orderBy, visibilityOrder{
+			field:      field,
+			descending: order.Direction == sqlparser.DescScr,
+		})
+	}
+	return result, nil
+}
+
+// HasOrderBy returns true if the query specifies its own ordering
+func (q *VisibilityQuery) HasOrderBy() bool {
+	return q != nil && len(q.orderBy) > 0
+}
+
+// BuildVisibilitySelectQuery returns the statement and its arguments reading a page of rows matching filter.
+// Rows are ordered by the query's ORDER BY clause and paginated by offset if it has one, otherwise they are
+// ordered by start_time and paginated from LastStartTime and LastRunID.
+func BuildVisibilitySelectQuery(dialect VisibilityQueryDialect, filter *VisibilityQueryFilter) (string, []interface{}) {
+	b := &visibilityQueryBuilder{dialect: dialect}
+	b.write("SELECT " + visibilitySelectFields + " FROM executions_visibility")
+	b.writeConditions(filter)
+	if filter.Query.HasOrderBy() {
+		b.write(" ORDER BY ")
+		for _, order := range filter.Query.orderBy {
+			b.writeField(order.field)
+			if order.descending {
+				b.write(" DESC")
+			}
+			b.write(", ")
+		}
+		b.write("run_id LIMIT " + b.bind(filter.PageSize) + " OFFSET " + b.bind(filter.Offset))
+	} else {
+		if filter.LastStartTime != nil && filter.LastRunID != nil {
+			lastStartTime := dialect.ToDateTime(*filter.LastStartTime)
+			b.write(" AND (start_time < " + b.bind(lastStartTime))
+			b.write(" OR (start_time = " + b.bind(lastStartTime) + " AND run_id > " + b.bind(*filter.LastRunID) + "))")
+		}
+		b.write(" ORDER BY start_time DESC, run_id LIMIT " + b.bind(filter.PageSize))
+	}
+	return b.sb.String(), b.args
+}
+
+// BuildVisibilityCountQuery returns the statement and its arguments counting the rows matching filter
+func BuildVisibilityCountQuery(dialect VisibilityQueryDialect, filter *VisibilityQueryFilter) (string, []interface{}) {
+	b := &visibilityQueryBuilder{dialect: dialect}
+	b.write("SELECT COUNT(*) FROM executions_visibility")
+	b.writeConditions(filter)
+	return b.sb.String(), b.args
+}
+
+// ToSearchAttributeDatetime converts a decoded Datetime search attribute value, either a time string
+// or unix nanoseconds, to the representation stored in the search_attributes column
+func ToSearchAttributeDatetime(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		if nanos, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.Unix(0, nanos).UTC().Format(SearchAttributeDatetimeLayout), nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return "", fmt.Errorf("invalid datetime %q", v)
+		}
+		return t.UTC().Format(SearchAttributeDatetimeLayout), nil
+	case json.Number:
+		nanos, err := v.Int64()
+		if err != nil {
+			return "", fmt.Errorf("invalid datetime %v", v)
+		}
+		return time.Unix(0, nanos).UTC().Format(SearchAttributeDatetimeLayout), nil
+	case int64:
+		return time.Unix(0, v).UTC().Format(SearchAttributeDatetimeLayout), nil
+	default:
+		return "", fmt.Errorf("invalid datetime %v", v)
+	}
+}
+
+func (b *visibilityQueryBuilder) write(s string) {
+	b.sb.WriteString(s)
+}
+
+func (b *visibilityQueryBuilder) bind(arg interface{}) string {
+	if t, ok := arg.(time.Time); ok {
+		arg = b.dialect.ToDateTime(t)
+	}
+	b.args = append(b.args, arg)
+	return b.dialect.Placeholder(len(b.args))
+}
+
+func (b *visibilityQueryBuilder) writeField(field visibilityField) {
+	if field.column != "" {
+		b.write(field.column)
+		return
+	}
+	b.write(b.dialect.SearchAttribute(field.key, field.valueType))
+}
+
+func (b *visibilityQueryBuilder) writeConditions(filter *VisibilityQueryFilter) {
+	b.write(" WHERE domain_id = " + b.bind(filter.DomainID))
+	if filter.Query != nil && filter.Query.condition != nil {
+		b.write(" AND ")
+		filter.Query.condition.render(b)
+	}
+}
+
+func (c *visibilityLogicalCondition) render(b *visibilityQueryBuilder) {
+	b.write("(")
+	c.left.render(b)
+	b.write(" " + c.operator + " ")
+	c.right.render(b)
+	b.write(")")
+}
+
+func (c *visibilityComparison) render(b *visibilityQueryBuilder) {
+	b.writeField(c.field)
+	switch c.operator {
+	case "IS NULL", "IS NOT NULL":
+		b.write(" " + c.operator)
+	case "IN", "NOT IN":
+		placeholders := make([]string, len(c.args))
+		for i, arg := range c.args {
+			placeholders[i] = b.bind(arg)
+		}
+		b.write(" " + c.operator + " (" + strings.Join(placeholders, ", ") + ")")
+	case "BETWEEN", "NOT BETWEEN":
+		b.write(" " + c.operator + " " + b.bind(c.args[0]) + " AND " + b.bind(c.args[1]))
+	default:
+		b.write(" " + c.operator + " " + b.bind(c.args[0]))
+	}
+}
+
+func parseVisibilityCondition(expr sqlparser.Expr, searchAttributeTypes map[string]types.IndexedValueType) (visibilityCondition, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return parseVisibilityLogicalCondition("AND", expr.Left, expr.Right, searchAttributeTypes)
+	case *sqlparser.OrExpr:
+		return parseVisibilityLogicalCondition("OR", expr.Left, expr.Right, searchAttributeTypes)
+	case *sqlparser.ParenExpr:
+		return parseVisibilityCondition(expr.Expr, searchAttributeTypes)
+	case *sqlparser.ComparisonExpr:
+		return parseVisibilityComparison(expr, searchAttributeTypes)
+	case *sqlparser.RangeCond:
+		return parseVisibilityRange(expr, searchAttributeTypes)
+	default:
+		return nil, fmt.Errorf("invalid where clause")
+	}
+}
+
+func parseVisibilityLogicalCondition(
+	operator string,
+	left sqlparser.Expr,
+	right sqlparser.Expr,
+	searchAttributeTypes map[string]types.IndexedValueType,
+) (visibilityCondition, error) {
+	leftCondition, err := parseVisibilityCondition(left, searchAttributeTypes)
+	if err != nil {
+		return nil, err
+	}
+	rightCondition, err := parseVisibilityCondition(right, searchAttributeTypes)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityLogicalCondition{operator: operator, left: leftCondition, right: rightCondition}, nil
+}
+
+func parseVisibilityComparison(expr *sqlparser.ComparisonExpr, searchAttributeTypes map[string]types.IndexedValueType) (visibilityCondition, error) {
+	if colName, ok := expr.Right.(*sqlparser.ColName); ok && strings.ToLower(colName.Name.String()) == visibilityMissingValue {
+		field, err := getVisibilityField(expr.Left, searchAttributeTypes, nil)
+		if err != nil {
+			return nil, err
+		}
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			return &visibilityComparison{field: field, operator: "IS NULL"}, nil
+		case sqlparser.NotEqualStr:
+			return &visibilityComparison{field: field, operator: "IS NOT NULL"}, nil
+		default:
+			return nil, fmt.Errorf("invalid operator %q for missing value", expr.Operator)
+		}
+	}
+
+	var values []sqlparser.Expr
+	operator := strings.ToUpper(expr.Operator)
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.LessThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr, sqlparser.LikeStr, sqlparser.NotLikeStr:
+		values = []sqlparser.Expr{expr.Right}
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid value for operator %q", expr.Operator)
+		}
+		values = tuple
+	default:
+		return nil, fmt.Errorf("operator %q is not supported", expr.Operator)
+	}
+
+	field, err := getVisibilityField(expr.Left, searchAttributeTypes, values[0])
+	if err != nil {
+		return nil, err
+	}
+	if (expr.Operator == sqlparser.LikeStr || expr.Operator == sqlparser.NotLikeStr) && !field.isText() {
+		return nil, fmt.Errorf("operator %q is only supported on string search attributes", expr.Operator)
+	}
+	args, err := field.parseValues(values...)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityComparison{field: field, operator: operator, args: args}, nil
+}
+
+func parseVisibilityRange(expr *sqlparser.RangeCond, searchAttributeTypes map[string]types.IndexedValueType) (visibilityCondition, error) {
+	field, err := getVisibilityField(expr.Left, searchAttributeTypes, expr.From)
+	if err != nil {
+		return nil, err
+	}
+	args, err := field.parseValues(expr.From, expr.To)
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityComparison{field: field, operator: strings.ToUpper(expr.Operator), args: args}, nil
+}
+
+// getVisibilityField resolves the search attribute expr refers to. value is used to infer the type of
+// custom search attributes which are not in searchAttributeTypes, and may be nil.
+func getVisibilityField(
+	expr sqlparser.Expr,
+	searchAttributeTypes map[string]types.IndexedValueType,
+	value sqlparser.Expr,
+) (visibilityField, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return visibilityField{}, fmt.Errorf("invalid search attribute expression")
+	}
+	name := colName.Name.String()
+	isCustom := false
+	if strings.HasPrefix(name, definition.Attr+".") {
+		name = strings.TrimPrefix(name, definition.Attr+".")
+		isCustom = true
+	} else if colName.Qualifier.Name.String() == definition.Attr {
+		isCustom = true
+	}
+
+	if !isCustom {
+		field, ok := visibilitySystemFields[name]
+		if !ok {
+			return visibilityField{}, fmt.Errorf("search attribute %q is not supported by SQL visibility", name)
+		}
+		return field, nil
+	}
+
+	if !searchAttributeKeyRegex.MatchString(name) {
+		return visibilityField{}, fmt.Errorf("invalid search attribute %q", name)
+	}
+	valueType, ok := searchAttributeTypes[name]
+	if !ok {
+		valueType = inferSearchAttributeType(value)
+	}
+	field := visibilityField{key: name, valueType: valueType}
+	switch valueType {
+	case types.IndexedValueTypeInt:
+		field.parse = parseVisibilityInt
+	case types.IndexedValueTypeDouble:
+		field.parse = parseVisibilityDouble
+	case types.IndexedValueTypeBool:
+		field.parse = parseVisibilityBoolText
+	case types.IndexedValueTypeDatetime:
+		field.parse = parseVisibilityDatetimeText
+	default:
+		field.parse = parseVisibilityString
+	}
+	return field, nil
+}
+
+func inferSearchAttributeType(value sqlparser.Expr) types.IndexedValueType {
+	switch value := value.(type) {
+	case sqlparser.BoolVal:
+		return types.IndexedValueTypeBool
+	case *sqlparser.SQLVal:
+		switch value.Type {
+		case sqlparser.IntVal:
+			return types.IndexedValueTypeInt
+		case sqlparser.FloatVal:
+			return types.IndexedValueTypeDouble
+		}
+	}
+	return types.IndexedValueTypeKeyword
+}
+
+func (f visibilityField) isText() bool {
+	switch f.column {
+	case "":
+		return f.valueType == types.IndexedValueTypeString || f.valueType == types.IndexedValueTypeKeyword
+	case "domain_id", "workflow_id", "run_id", "workflow_type_name", "cron_schedule":
+		return true
+	default:
+		return false
+	}
+}
+
+func (f visibilityField) parseValues(values ...sqlparser.Expr) ([]interface{}, error) {
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		arg, err := f.parse(value)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = arg
+	}
+	return args, nil
+}
+
+func getVisibilityLiteral(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		return string(expr.Val), nil
+	case sqlparser.BoolVal:
+		return strconv.FormatBool(bool(expr)), nil
+	default:
+		return "", fmt.Errorf("invalid value %v", sqlparser.String(expr))
+	}
+}
+
+func parseVisibilityString(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	return str, nil
+}
+
+func parseVisibilityInt(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid int value %q", str)
+	}
+	return value, nil
+}
+
+func parseVisibilityDouble(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid double value %q", str)
+	}
+	return value, nil
+}
+
+func parseVisibilityBool(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseBool(str)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bool value %q", str)
+	}
+	return value, nil
+}
+
+func parseVisibilityBoolText(expr sqlparser.Expr) (interface{}, error) {
+	value, err := parseVisibilityBool(expr)
+	if err != nil {
+		return nil, err
+	}
+	return strconv.FormatBool(value.(bool)), nil
+}
+
+func parseVisibilityTime(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	if nanos, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return time.Unix(0, nanos).UTC(), nil
+	}
+	value, err := time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time value %q", str)
+	}
+	return value.UTC(), nil
+}
+
+func parseVisibilityDatetimeText(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	return ToSearchAttributeDatetime(str)
+}
+
+func parseVisibilityCloseStatus(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	if value, err := strconv.ParseInt(str, 10, 32); err == nil {
+		return int32(value), nil
+	}
+	var status types.WorkflowExecutionCloseStatus
+	if err := status.UnmarshalText([]byte(str)); err != nil {
+		return nil, fmt.Errorf("invalid close status %q", str)
+	}
+	return int32(status), nil
+}
+
+func parseVisibilityExecutionStatus(expr sqlparser.Expr) (interface{}, error) {
+	str, err := getVisibilityLiteral(expr)
+	if err != nil {
+		return nil, err
+	}
+	if value, err := strconv.ParseInt(str, 10, 32); err == nil {
+		return int32(value), nil
+	}
+	var status types.WorkflowExecutionStatus
+	if err := status.UnmarshalText([]byte(str)); err != nil {
+		return nil, fmt.Errorf("invalid execution status %q", str)
+	}
+	return int32(status), nil
+}
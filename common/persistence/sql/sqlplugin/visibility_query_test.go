@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+type testVisibilityQueryDialect struct{}
+
+func (d *testVisibilityQueryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (d *testVisibilityQueryDialect) SearchAttribute(key string, valueType types.IndexedValueType) string {
+	return fmt.Sprintf("attr(%s, %v)", key, valueType)
+}
+
+func (d *testVisibilityQueryDialect) ToDateTime(t time.Time) time.Time {
+	return t
+}
+
+func TestBuildVisibilitySelectQuery(t *testing.T) {
+	searchAttributeTypes := map[string]types.IndexedValueType{
+		definition.CustomKeywordField:  types.IndexedValueTypeKeyword,
+		definition.CustomIntField:      types.IndexedValueTypeInt,
+		definition.CustomBoolField:     types.IndexedValueTypeBool,
+		definition.CustomDatetimeField: types.IndexedValueTypeDatetime,
+	}
+	startTime := time.Unix(0, 1700000000000000000).UTC()
+	lastRunID := "last-run-id"
+
+	tests := map[string]struct {
+		query         string
+		lastStartTime *time.Time
+		lastRunID     *string
+		offset        int
+		expectedWhere string
+		expectedOrder string
+		expectedArgs  []interface{}
+		expectedError error
+	}{
+		"empty query": {
+			query:         "",
+			expectedOrder: " ORDER BY start_time DESC, run_id LIMIT $2",
+			expectedArgs:  []interface{}{"domain-id", 10},
+		},
+		"system attributes": {
+			query:         "WorkflowType = 'type' and CloseStatus = 'FAILED' and StartTime > 1700000000000000000",
+			expectedWhere: " AND ((workflow_type_name = $2 AND close_status = $3) AND start_time > $4)",
+			expectedOrder: " ORDER BY start_time DESC, run_id LIMIT $5",
+			expectedArgs:  []interface{}{"domain-id", "type", int32(1), startTime, 10},
+		},
+		"custom attributes": {
+			query:         "(`Attr.CustomKeywordField` in ('a', 'b') or `Attr.CustomIntField` between 1 and 5) and `Attr.CustomBoolField` = true",
+			expectedWhere: " AND ((attr(CustomKeywordField, KEYWORD) IN ($2, $3) OR attr(CustomIntField, INT) BETWEEN $4 AND $5) AND attr(CustomBoolField, BOOL) = $6)",
+			expectedOrder: " ORDER BY start_time DESC, run_id LIMIT $7",
+			expectedArgs:  []interface{}{"domain-id", "a", "b", int64(1), int64(5), "true", 10},
+		},
+		"datetime attribute": {
+			query:         "`Attr.CustomDatetimeField` >= '2023-11-15T00:13:20+02:00'",
+			expectedWhere: " AND attr(CustomDatetimeField, DATETIME) >= $2",
+			expectedOrder: " ORDER BY start_time DESC, run_id LIMIT $3",
+			expectedArgs:  []interface{}{"domain-id", "2023-11-14T22:13:20.000000000Z", 10},
+		},
+		"unknown custom attribute type is inferred": {
+			query:         "`Attr.CustomDoubleField` < 1.5",
+			expectedWhere: " AND attr(CustomDoubleField, DOUBLE) < $2",
+			expectedOrder: " ORDER BY start_time DESC, run_id LIMIT $3",
+			expectedArgs:  []interface{}{"domain-id", 1.5, 10},
+		},
+		"missing values": {
+			query:         "CloseTime = missing and `Attr.CustomKeywordField` != missing",
+			expectedWhere: " AND (close_time IS NULL AND attr(CustomKeywordField, KEYWORD) IS NOT NULL)",
+			expectedOrder: " ORDER BY start_time DESC, run_id LIMIT $2",
+			expectedArgs:  []interface{}{"domain-id", 10},
+		},
+		"keyset pagination": {
+			query:         "WorkflowID = 'wid'",
+			lastStartTime: &startTime,
+			lastRunID:     &lastRunID,
+			expectedWhere: " AND workflow_id = $2",
+			expectedOrder: " AND (start_time < $3 OR (start_time = $4 AND run_id > $5)) ORDER BY start_time DESC, run_id LIMIT $6",
+			expectedArgs:  []interface{}{"domain-id", "wid", startTime, startTime, lastRunID, 10},
+		},
+		"order by": {
+			query:         "WorkflowID = 'wid' order by `Attr.CustomIntField` desc, StartTime",
+			offset:        20,
+			expectedWhere: " AND workflow_id = $2",
+			expectedOrder: " ORDER BY attr(CustomIntField, INT) DESC, start_time, run_id LIMIT $3 OFFSET $4",
+			expectedArgs:  []interface{}{"domain-id", "wid", 10, 20},
+		},
+		"invalid query": {
+			query:         "WorkflowID = ",
+			expectedError: &types.BadRequestError{Message: "Invalid query."},
+		},
+		"unsupported system attribute": {
+			query:         "TaskList = 'tasklist'",
+			expectedError: &types.BadRequestError{Message: `search attribute "TaskList" is not supported by SQL visibility`},
+		},
+		"invalid value type": {
+			query:         "`Attr.CustomIntField` = 'abc'",
+			expectedError: &types.BadRequestError{Message: `invalid int value "abc"`},
+		},
+		"like on non string attribute": {
+			query:         "HistoryLength like '1%'",
+			expectedError: &types.BadRequestError{Message: `operator "like" is only supported on string search attributes`},
+		},
+		"invalid search attribute key": {
+			query:         "`Attr.Custom')Field` = 'abc'",
+			expectedError: &types.BadRequestError{Message: `invalid search attribute "Custom')Field"`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			query, err := ParseVisibilityQuery(tc.query, searchAttributeTypes)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			require.NoError(t, err)
+
+			statement, args := BuildVisibilitySelectQuery(&testVisibilityQueryDialect{}, &VisibilityQueryFilter{
+				DomainID:      "domain-id",
+				Query:         query,
+				LastStartTime: tc.lastStartTime,
+				LastRunID:     tc.lastRunID,
+				Offset:        tc.offset,
+				PageSize:      10,
+			})
+			assert.Equal(t, "SELECT "+visibilitySelectFields+" FROM executions_visibility WHERE domain_id = $1"+tc.expectedWhere+tc.expectedOrder, statement)
+			assert.Equal(t, tc.expectedArgs, args)
+		})
+	}
+}
+
+func TestBuildVisibilityCountQuery(t *testing.T) {
+	query, err := ParseVisibilityQuery("ExecutionStatus = 'STARTED' order by StartTime", nil)
+	require.NoError(t, err)
+
+	statement, args := BuildVisibilityCountQuery(&testVisibilityQueryDialect{}, &VisibilityQueryFilter{
+		DomainID: "domain-id",
+		Query:    query,
+	})
+	assert.Equal(t, "SELECT COUNT(*) FROM executions_visibility WHERE domain_id = $1 AND execution_status = $2", statement)
+	assert.Equal(t, []interface{}{"domain-id", int32(types.WorkflowExecutionStatusStarted)}, args)
+}
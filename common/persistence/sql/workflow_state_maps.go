@@ -98,6 +98,8 @@ func updateActivityInfos(
 				ScheduleID:               activityInfo.ScheduleID,
 				LastHeartbeatUpdatedTime: activityInfo.LastHeartBeatUpdatedTime,
 				LastHeartbeatDetails:     activityInfo.Details,
+				Paused:                   activityInfo.Paused,
+				DispatchSuppressed:       activityInfo.DispatchSuppressed,
 				Data:                     blob.Data,
 				DataEncoding:             string(blob.Encoding),
 			}
@@ -154,6 +156,8 @@ func getActivityInfoMap(
 			ScheduleID:               row.ScheduleID,
 			Details:                  row.LastHeartbeatDetails,
 			LastHeartBeatUpdatedTime: row.LastHeartbeatUpdatedTime,
+			Paused:                   row.Paused,
+			DispatchSuppressed:       row.DispatchSuppressed,
 			Version:                  decoded.GetVersion(),
 			ScheduledEventBatchID:    decoded.GetScheduledEventBatchID(),
 			ScheduledEvent:           persistence.NewDataBlob(decoded.ScheduledEvent, constants.EncodingType(decoded.GetScheduledEventEncoding())),
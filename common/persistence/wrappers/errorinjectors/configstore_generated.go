@@ -18,6 +18,7 @@ type injectorConfigStoreManager struct {
 	wrapped   _sourcePersistence.ConfigStoreManager
 	starttime time.Time
 	errorRate float64
+	profiles  *Profiles
 	logger    log.Logger
 }
 
@@ -25,6 +26,7 @@ type injectorConfigStoreManager struct {
 func NewConfigStoreManager(
 	wrapped persistence.ConfigStoreManager,
 	errorRate float64,
+	profiles *Profiles,
 	logger log.Logger,
 	starttime time.Time,
 ) persistence.ConfigStoreManager {
@@ -32,6 +34,7 @@ func NewConfigStoreManager(
 		wrapped:   wrapped,
 		starttime: starttime,
 		errorRate: errorRate,
+		profiles:  profiles,
 		logger:    logger,
 	}
 }
@@ -43,6 +46,9 @@ func (c *injectorConfigStoreManager) Close() {
 
 func (c *injectorConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfgType _sourcePersistence.ConfigType) (fp1 *_sourcePersistence.FetchDynamicConfigResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ConfigStoreManager.FetchDynamicConfig", cfgType)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		fp1, err = c.wrapped.FetchDynamicConfig(ctx, cfgType)
@@ -58,6 +64,9 @@ func (c *injectorConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfg
 
 func (c *injectorConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *_sourcePersistence.UpdateDynamicConfigRequest, cfgType _sourcePersistence.ConfigType) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ConfigStoreManager.UpdateDynamicConfig", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.UpdateDynamicConfig(ctx, request, cfgType)
@@ -18,6 +18,7 @@ type injectorDomainManager struct {
 	wrapped   _sourcePersistence.DomainManager
 	starttime time.Time
 	errorRate float64
+	profiles  *Profiles
 	logger    log.Logger
 }
 
@@ -25,6 +26,7 @@ type injectorDomainManager struct {
 func NewDomainManager(
 	wrapped persistence.DomainManager,
 	errorRate float64,
+	profiles *Profiles,
 	logger log.Logger,
 	starttime time.Time,
 ) persistence.DomainManager {
@@ -32,6 +34,7 @@ func NewDomainManager(
 		wrapped:   wrapped,
 		starttime: starttime,
 		errorRate: errorRate,
+		profiles:  profiles,
 		logger:    logger,
 	}
 }
@@ -43,6 +46,9 @@ func (c *injectorDomainManager) Close() {
 
 func (c *injectorDomainManager) CreateDomain(ctx context.Context, request *_sourcePersistence.CreateDomainRequest) (cp1 *_sourcePersistence.CreateDomainResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "DomainManager.CreateDomain", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		cp1, err = c.wrapped.CreateDomain(ctx, request)
@@ -58,6 +64,9 @@ func (c *injectorDomainManager) CreateDomain(ctx context.Context, request *_sour
 
 func (c *injectorDomainManager) DeleteDomain(ctx context.Context, request *_sourcePersistence.DeleteDomainRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "DomainManager.DeleteDomain", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteDomain(ctx, request)
@@ -73,6 +82,9 @@ func (c *injectorDomainManager) DeleteDomain(ctx context.Context, request *_sour
 
 func (c *injectorDomainManager) DeleteDomainByName(ctx context.Context, request *_sourcePersistence.DeleteDomainByNameRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "DomainManager.DeleteDomainByName", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteDomainByName(ctx, request)
@@ -88,6 +100,9 @@ func (c *injectorDomainManager) DeleteDomainByName(ctx context.Context, request
 
 func (c *injectorDomainManager) GetDomain(ctx context.Context, request *_sourcePersistence.GetDomainRequest) (gp1 *_sourcePersistence.GetDomainResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "DomainManager.GetDomain", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetDomain(ctx, request)
@@ -103,6 +118,9 @@ func (c *injectorDomainManager) GetDomain(ctx context.Context, request *_sourceP
 
 func (c *injectorDomainManager) GetMetadata(ctx context.Context) (gp1 *_sourcePersistence.GetMetadataResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "DomainManager.GetMetadata", nil)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetMetadata(ctx)
@@ -122,6 +140,9 @@ func (c *injectorDomainManager) GetName() (s1 string) {
 
 func (c *injectorDomainManager) ListDomains(ctx context.Context, request *_sourcePersistence.ListDomainsRequest) (lp1 *_sourcePersistence.ListDomainsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "DomainManager.ListDomains", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListDomains(ctx, request)
@@ -137,6 +158,9 @@ func (c *injectorDomainManager) ListDomains(ctx context.Context, request *_sourc
 
 func (c *injectorDomainManager) UpdateDomain(ctx context.Context, request *_sourcePersistence.UpdateDomainRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "DomainManager.UpdateDomain", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.UpdateDomain(ctx, request)
@@ -19,6 +19,7 @@ type injectorExecutionManager struct {
 	wrapped   _sourcePersistence.ExecutionManager
 	starttime time.Time
 	errorRate float64
+	profiles  *Profiles
 	logger    log.Logger
 }
 
@@ -26,6 +27,7 @@ type injectorExecutionManager struct {
 func NewExecutionManager(
 	wrapped persistence.ExecutionManager,
 	errorRate float64,
+	profiles *Profiles,
 	logger log.Logger,
 	starttime time.Time,
 ) persistence.ExecutionManager {
@@ -33,6 +35,7 @@ func NewExecutionManager(
 		wrapped:   wrapped,
 		starttime: starttime,
 		errorRate: errorRate,
+		profiles:  profiles,
 		logger:    logger,
 	}
 }
@@ -44,6 +47,9 @@ func (c *injectorExecutionManager) Close() {
 
 func (c *injectorExecutionManager) CompleteHistoryTask(ctx context.Context, request *_sourcePersistence.CompleteHistoryTaskRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.CompleteHistoryTask", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.CompleteHistoryTask(ctx, request)
@@ -59,6 +65,9 @@ func (c *injectorExecutionManager) CompleteHistoryTask(ctx context.Context, requ
 
 func (c *injectorExecutionManager) ConflictResolveWorkflowExecution(ctx context.Context, request *_sourcePersistence.ConflictResolveWorkflowExecutionRequest) (cp1 *_sourcePersistence.ConflictResolveWorkflowExecutionResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.ConflictResolveWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		cp1, err = c.wrapped.ConflictResolveWorkflowExecution(ctx, request)
@@ -74,6 +83,9 @@ func (c *injectorExecutionManager) ConflictResolveWorkflowExecution(ctx context.
 
 func (c *injectorExecutionManager) CreateFailoverMarkerTasks(ctx context.Context, request *_sourcePersistence.CreateFailoverMarkersRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.CreateFailoverMarkerTasks", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.CreateFailoverMarkerTasks(ctx, request)
@@ -89,6 +101,9 @@ func (c *injectorExecutionManager) CreateFailoverMarkerTasks(ctx context.Context
 
 func (c *injectorExecutionManager) CreateHistoryTasks(ctx context.Context, request *_sourcePersistence.CreateHistoryTasksRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.CreateHistoryTasks", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.CreateHistoryTasks(ctx, request)
@@ -104,6 +119,9 @@ func (c *injectorExecutionManager) CreateHistoryTasks(ctx context.Context, reque
 
 func (c *injectorExecutionManager) CreateWorkflowExecution(ctx context.Context, request *_sourcePersistence.CreateWorkflowExecutionRequest) (cp1 *_sourcePersistence.CreateWorkflowExecutionResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.CreateWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		cp1, err = c.wrapped.CreateWorkflowExecution(ctx, request)
@@ -119,6 +137,9 @@ func (c *injectorExecutionManager) CreateWorkflowExecution(ctx context.Context,
 
 func (c *injectorExecutionManager) DeleteActiveClusterSelectionPolicy(ctx context.Context, request *_sourcePersistence.DeleteActiveClusterSelectionPolicyRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.DeleteActiveClusterSelectionPolicy", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteActiveClusterSelectionPolicy(ctx, request)
@@ -134,6 +155,9 @@ func (c *injectorExecutionManager) DeleteActiveClusterSelectionPolicy(ctx contex
 
 func (c *injectorExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Context, request *_sourcePersistence.DeleteCurrentWorkflowExecutionRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.DeleteCurrentWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteCurrentWorkflowExecution(ctx, request)
@@ -149,6 +173,9 @@ func (c *injectorExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Co
 
 func (c *injectorExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Context, request *_sourcePersistence.DeleteReplicationTaskFromDLQRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.DeleteReplicationTaskFromDLQ", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteReplicationTaskFromDLQ(ctx, request)
@@ -164,6 +191,9 @@ func (c *injectorExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Cont
 
 func (c *injectorExecutionManager) DeleteWorkflowExecution(ctx context.Context, request *_sourcePersistence.DeleteWorkflowExecutionRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.DeleteWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteWorkflowExecution(ctx, request)
@@ -179,6 +209,9 @@ func (c *injectorExecutionManager) DeleteWorkflowExecution(ctx context.Context,
 
 func (c *injectorExecutionManager) FetchWorkflowTimerTasksForCleanup(ctx context.Context, request *_sourcePersistence.FetchWorkflowTimerTasksForCleanupRequest) (ha1 []_sourcePersistence.HistoryTaskKey, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.FetchWorkflowTimerTasksForCleanup", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		ha1, err = c.wrapped.FetchWorkflowTimerTasksForCleanup(ctx, request)
@@ -194,6 +227,9 @@ func (c *injectorExecutionManager) FetchWorkflowTimerTasksForCleanup(ctx context
 
 func (c *injectorExecutionManager) GetActiveClusterSelectionPolicy(ctx context.Context, request *_sourcePersistence.GetActiveClusterSelectionPolicyRequest) (ap1 *types.ActiveClusterSelectionPolicy, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.GetActiveClusterSelectionPolicy", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		ap1, err = c.wrapped.GetActiveClusterSelectionPolicy(ctx, request)
@@ -209,6 +245,9 @@ func (c *injectorExecutionManager) GetActiveClusterSelectionPolicy(ctx context.C
 
 func (c *injectorExecutionManager) GetCurrentExecution(ctx context.Context, request *_sourcePersistence.GetCurrentExecutionRequest) (gp1 *_sourcePersistence.GetCurrentExecutionResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.GetCurrentExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetCurrentExecution(ctx, request)
@@ -224,6 +263,9 @@ func (c *injectorExecutionManager) GetCurrentExecution(ctx context.Context, requ
 
 func (c *injectorExecutionManager) GetHistoryTasks(ctx context.Context, request *_sourcePersistence.GetHistoryTasksRequest) (gp1 *_sourcePersistence.GetHistoryTasksResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.GetHistoryTasks", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetHistoryTasks(ctx, request)
@@ -243,6 +285,9 @@ func (c *injectorExecutionManager) GetName() (s1 string) {
 
 func (c *injectorExecutionManager) GetReplicationDLQSize(ctx context.Context, request *_sourcePersistence.GetReplicationDLQSizeRequest) (gp1 *_sourcePersistence.GetReplicationDLQSizeResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.GetReplicationDLQSize", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetReplicationDLQSize(ctx, request)
@@ -258,6 +303,9 @@ func (c *injectorExecutionManager) GetReplicationDLQSize(ctx context.Context, re
 
 func (c *injectorExecutionManager) GetReplicationTasksFromDLQ(ctx context.Context, request *_sourcePersistence.GetReplicationTasksFromDLQRequest) (gp1 *_sourcePersistence.GetReplicationDLQTasksResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.GetReplicationTasksFromDLQ", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetReplicationTasksFromDLQ(ctx, request)
@@ -277,6 +325,9 @@ func (c *injectorExecutionManager) GetShardID() (i1 int) {
 
 func (c *injectorExecutionManager) GetWorkflowExecution(ctx context.Context, request *_sourcePersistence.GetWorkflowExecutionRequest) (gp1 *_sourcePersistence.GetWorkflowExecutionResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.GetWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetWorkflowExecution(ctx, request)
@@ -292,6 +343,9 @@ func (c *injectorExecutionManager) GetWorkflowExecution(ctx context.Context, req
 
 func (c *injectorExecutionManager) IsWorkflowExecutionExists(ctx context.Context, request *_sourcePersistence.IsWorkflowExecutionExistsRequest) (ip1 *_sourcePersistence.IsWorkflowExecutionExistsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.IsWorkflowExecutionExists", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		ip1, err = c.wrapped.IsWorkflowExecutionExists(ctx, request)
@@ -307,6 +361,9 @@ func (c *injectorExecutionManager) IsWorkflowExecutionExists(ctx context.Context
 
 func (c *injectorExecutionManager) ListConcreteExecutions(ctx context.Context, request *_sourcePersistence.ListConcreteExecutionsRequest) (lp1 *_sourcePersistence.ListConcreteExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.ListConcreteExecutions", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListConcreteExecutions(ctx, request)
@@ -322,6 +379,9 @@ func (c *injectorExecutionManager) ListConcreteExecutions(ctx context.Context, r
 
 func (c *injectorExecutionManager) ListCurrentExecutions(ctx context.Context, request *_sourcePersistence.ListCurrentExecutionsRequest) (lp1 *_sourcePersistence.ListCurrentExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.ListCurrentExecutions", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListCurrentExecutions(ctx, request)
@@ -337,6 +397,9 @@ func (c *injectorExecutionManager) ListCurrentExecutions(ctx context.Context, re
 
 func (c *injectorExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *_sourcePersistence.PutReplicationTaskToDLQRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.PutReplicationTaskToDLQ", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.PutReplicationTaskToDLQ(ctx, request)
@@ -352,6 +415,9 @@ func (c *injectorExecutionManager) PutReplicationTaskToDLQ(ctx context.Context,
 
 func (c *injectorExecutionManager) RangeCompleteHistoryTask(ctx context.Context, request *_sourcePersistence.RangeCompleteHistoryTaskRequest) (rp1 *_sourcePersistence.RangeCompleteHistoryTaskResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.RangeCompleteHistoryTask", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		rp1, err = c.wrapped.RangeCompleteHistoryTask(ctx, request)
@@ -367,6 +433,9 @@ func (c *injectorExecutionManager) RangeCompleteHistoryTask(ctx context.Context,
 
 func (c *injectorExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *_sourcePersistence.RangeDeleteReplicationTaskFromDLQRequest) (rp1 *_sourcePersistence.RangeDeleteReplicationTaskFromDLQResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.RangeDeleteReplicationTaskFromDLQ", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		rp1, err = c.wrapped.RangeDeleteReplicationTaskFromDLQ(ctx, request)
@@ -382,6 +451,9 @@ func (c *injectorExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context
 
 func (c *injectorExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *_sourcePersistence.UpdateWorkflowExecutionRequest) (up1 *_sourcePersistence.UpdateWorkflowExecutionResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ExecutionManager.UpdateWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		up1, err = c.wrapped.UpdateWorkflowExecution(ctx, request)
@@ -18,6 +18,7 @@ type injectorHistoryManager struct {
 	wrapped   _sourcePersistence.HistoryManager
 	starttime time.Time
 	errorRate float64
+	profiles  *Profiles
 	logger    log.Logger
 }
 
@@ -25,6 +26,7 @@ type injectorHistoryManager struct {
 func NewHistoryManager(
 	wrapped persistence.HistoryManager,
 	errorRate float64,
+	profiles *Profiles,
 	logger log.Logger,
 	starttime time.Time,
 ) persistence.HistoryManager {
@@ -32,12 +34,16 @@ func NewHistoryManager(
 		wrapped:   wrapped,
 		starttime: starttime,
 		errorRate: errorRate,
+		profiles:  profiles,
 		logger:    logger,
 	}
 }
 
 func (c *injectorHistoryManager) AppendHistoryNodes(ctx context.Context, request *_sourcePersistence.AppendHistoryNodesRequest) (ap1 *_sourcePersistence.AppendHistoryNodesResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "HistoryManager.AppendHistoryNodes", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		ap1, err = c.wrapped.AppendHistoryNodes(ctx, request)
@@ -58,6 +64,9 @@ func (c *injectorHistoryManager) Close() {
 
 func (c *injectorHistoryManager) DeleteHistoryBranch(ctx context.Context, request *_sourcePersistence.DeleteHistoryBranchRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "HistoryManager.DeleteHistoryBranch", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteHistoryBranch(ctx, request)
@@ -73,6 +82,9 @@ func (c *injectorHistoryManager) DeleteHistoryBranch(ctx context.Context, reques
 
 func (c *injectorHistoryManager) ForkHistoryBranch(ctx context.Context, request *_sourcePersistence.ForkHistoryBranchRequest) (fp1 *_sourcePersistence.ForkHistoryBranchResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "HistoryManager.ForkHistoryBranch", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		fp1, err = c.wrapped.ForkHistoryBranch(ctx, request)
@@ -88,6 +100,9 @@ func (c *injectorHistoryManager) ForkHistoryBranch(ctx context.Context, request
 
 func (c *injectorHistoryManager) GetAllHistoryTreeBranches(ctx context.Context, request *_sourcePersistence.GetAllHistoryTreeBranchesRequest) (gp1 *_sourcePersistence.GetAllHistoryTreeBranchesResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "HistoryManager.GetAllHistoryTreeBranches", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetAllHistoryTreeBranches(ctx, request)
@@ -103,6 +118,9 @@ func (c *injectorHistoryManager) GetAllHistoryTreeBranches(ctx context.Context,
 
 func (c *injectorHistoryManager) GetHistoryTree(ctx context.Context, request *_sourcePersistence.GetHistoryTreeRequest) (gp1 *_sourcePersistence.GetHistoryTreeResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "HistoryManager.GetHistoryTree", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetHistoryTree(ctx, request)
@@ -122,6 +140,9 @@ func (c *injectorHistoryManager) GetName() (s1 string) {
 
 func (c *injectorHistoryManager) ReadHistoryBranch(ctx context.Context, request *_sourcePersistence.ReadHistoryBranchRequest) (rp1 *_sourcePersistence.ReadHistoryBranchResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "HistoryManager.ReadHistoryBranch", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		rp1, err = c.wrapped.ReadHistoryBranch(ctx, request)
@@ -137,6 +158,9 @@ func (c *injectorHistoryManager) ReadHistoryBranch(ctx context.Context, request
 
 func (c *injectorHistoryManager) ReadHistoryBranchByBatch(ctx context.Context, request *_sourcePersistence.ReadHistoryBranchRequest) (rp1 *_sourcePersistence.ReadHistoryBranchByBatchResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "HistoryManager.ReadHistoryBranchByBatch", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		rp1, err = c.wrapped.ReadHistoryBranchByBatch(ctx, request)
@@ -152,6 +176,9 @@ func (c *injectorHistoryManager) ReadHistoryBranchByBatch(ctx context.Context, r
 
 func (c *injectorHistoryManager) ReadRawHistoryBranch(ctx context.Context, request *_sourcePersistence.ReadHistoryBranchRequest) (rp1 *_sourcePersistence.ReadRawHistoryBranchResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "HistoryManager.ReadRawHistoryBranch", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		rp1, err = c.wrapped.ReadRawHistoryBranch(ctx, request)
@@ -181,14 +181,14 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 	switch injector.(type) {
 	case *injectorConfigStoreManager:
 		mocked := persistence.NewMockConfigStoreManager(ctrl)
-		object = NewConfigStoreManager(mocked, errorRate, logger, starttime)
+		object = NewConfigStoreManager(mocked, errorRate, nil, logger, starttime)
 		if expectCalls {
 			mocked.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().FetchDynamicConfig(gomock.Any(), gomock.Any()).Return(&persistence.FetchDynamicConfigResponse{}, expectedErr)
 		}
 	case *injectorDomainManager:
 		mocked := persistence.NewMockDomainManager(ctrl)
-		object = NewDomainManager(mocked, errorRate, logger, starttime)
+		object = NewDomainManager(mocked, errorRate, nil, logger, starttime)
 		if expectCalls {
 			mocked.EXPECT().CreateDomain(gomock.Any(), gomock.Any()).Return(&persistence.CreateDomainResponse{}, expectedErr)
 			mocked.EXPECT().GetDomain(gomock.Any(), gomock.Any()).Return(&persistence.GetDomainResponse{}, expectedErr)
@@ -200,7 +200,7 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 		}
 	case *injectorHistoryManager:
 		mocked := persistence.NewMockHistoryManager(ctrl)
-		object = NewHistoryManager(mocked, errorRate, logger, starttime)
+		object = NewHistoryManager(mocked, errorRate, nil, logger, starttime)
 		if expectCalls {
 			mocked.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{}, expectedErr)
 			mocked.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchResponse{}, expectedErr)
@@ -213,7 +213,7 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 		}
 	case *injectorQueueManager:
 		mocked := persistence.NewMockQueueManager(ctrl)
-		object = NewQueueManager(mocked, errorRate, logger, starttime)
+		object = NewQueueManager(mocked, errorRate, nil, logger, starttime)
 		if expectCalls {
 			mocked.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().ReadMessages(gomock.Any(), gomock.Any()).Return(&persistence.ReadMessagesResponse{Messages: []*persistence.QueueMessage{}}, expectedErr)
@@ -230,7 +230,7 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 		}
 	case *injectorShardManager:
 		mocked := persistence.NewMockShardManager(ctrl)
-		object = NewShardManager(mocked, errorRate, logger, starttime)
+		object = NewShardManager(mocked, errorRate, nil, logger, starttime)
 		if expectCalls {
 			mocked.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&persistence.GetShardResponse{}, expectedErr)
 			mocked.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(expectedErr)
@@ -238,7 +238,7 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 		}
 	case *injectorTaskManager:
 		mocked := persistence.NewMockTaskManager(ctrl)
-		object = NewTaskManager(mocked, errorRate, logger, starttime)
+		object = NewTaskManager(mocked, errorRate, nil, logger, starttime)
 		if expectCalls {
 			mocked.EXPECT().CompleteTasksLessThan(gomock.Any(), gomock.Any()).Return(&persistence.CompleteTasksLessThanResponse{}, expectedErr)
 			mocked.EXPECT().CompleteTask(gomock.Any(), gomock.Any()).Return(expectedErr)
@@ -254,7 +254,7 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 		}
 	case *injectorVisibilityManager:
 		mocked := persistence.NewMockVisibilityManager(ctrl)
-		object = NewVisibilityManager(mocked, errorRate, logger, starttime)
+		object = NewVisibilityManager(mocked, errorRate, nil, logger, starttime)
 		if expectCalls {
 			mocked.EXPECT().DeleteUninitializedWorkflowExecution(gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(expectedErr)
@@ -276,7 +276,7 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 		}
 	case *injectorExecutionManager:
 		mocked := persistence.NewMockExecutionManager(ctrl)
-		object = NewExecutionManager(mocked, errorRate, logger, starttime)
+		object = NewExecutionManager(mocked, errorRate, nil, logger, starttime)
 		if expectCalls {
 			mocked.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.CreateWorkflowExecutionResponse{}, expectedErr)
 			mocked.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{}, expectedErr)
@@ -0,0 +1,362 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package errorinjectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Fault types which can be listed in Profile.Errors
+const (
+	FaultTimeout            = "timeout"
+	FaultUnhandled          = "unhandled"
+	FaultServiceBusy        = "service-busy"
+	FaultInternalService    = "internal-service"
+	FaultDBUnavailable      = "db-unavailable"
+	FaultConditionFailed    = "condition-failed"
+	FaultShardOwnershipLost = "shard-ownership-lost"
+)
+
+// Latency distributions which can be used in LatencyProfile.Distribution
+const (
+	LatencyFixed       = "fixed"
+	LatencyUniform     = "uniform"
+	LatencyExponential = "exponential"
+)
+
+// profilesRefreshInterval is how often the profiles are read again from dynamic config
+const profilesRefreshInterval = time.Second
+
+var (
+	// ErrFakeDBUnavailable is a fake database unavailable error.
+	ErrFakeDBUnavailable = &persistence.DBUnavailableError{Msg: "Fake Persistence DB Unavailable Error."}
+	// ErrFakeConditionFailed is a fake condition failed error.
+	ErrFakeConditionFailed = &persistence.ConditionFailedError{Msg: "Fake Persistence Condition Failed Error."}
+	// ErrFakeShardOwnershipLost is a fake shard ownership lost error.
+	ErrFakeShardOwnershipLost = &persistence.ShardOwnershipLostError{Msg: "Fake Persistence Shard Ownership Lost Error."}
+
+	faultErrors = map[string]error{
+		FaultTimeout:            ErrFakeTimeout,
+		FaultUnhandled:          errors.ErrFakeUnhandled,
+		FaultServiceBusy:        errors.ErrFakeServiceBusy,
+		FaultInternalService:    errors.ErrFakeInternalService,
+		FaultDBUnavailable:      ErrFakeDBUnavailable,
+		FaultConditionFailed:    ErrFakeConditionFailed,
+		FaultShardOwnershipLost: ErrFakeShardOwnershipLost,
+	}
+)
+
+type (
+	// Profile is a fault injection profile of the system.persistenceFaultInjectionProfiles dynamic config
+	Profile struct {
+		// Name identifies the profile in logs
+		Name string `json:"name"`
+		// Operations are the patterns of the operations the profile applies to, e.g. "ExecutionManager.UpdateWorkflowExecution",
+		// "ExecutionManager.*" or "*.Get*", the profile applies to all operations when it's empty
+		Operations []string `json:"operations,omitempty"`
+		// Domains are the names of the domains the profile applies to, the profile applies to all operations
+		// including the ones which are not specific to a domain when it's empty
+		Domains []string `json:"domains,omitempty"`
+		// ErrorRate is the rate of the calls failed with one of Errors
+		ErrorRate float64 `json:"errorRate,omitempty"`
+		// Errors are the fault types injected, all of them are used when it's empty
+		Errors []string `json:"errors,omitempty"`
+		// Latency is added to the calls before they are forwarded to the database
+		Latency *LatencyProfile `json:"latency,omitempty"`
+		// StartTime and EndTime bound the chaos window in RFC3339 format, the profile is active all the time when they are empty
+		StartTime string `json:"startTime,omitempty"`
+		EndTime   string `json:"endTime,omitempty"`
+	}
+
+	// LatencyProfile defines the distribution of the latency injected by a Profile
+	LatencyProfile struct {
+		// Rate is the rate of the calls delayed
+		Rate float64 `json:"rate"`
+		// Distribution is one of fixed (Mean), uniform (between Min and Max) and exponential (Min plus an exponential
+		// distribution of mean Mean, capped at Max when it's set), durations are in Go format, e.g. "250ms"
+		Distribution string `json:"distribution"`
+		Min          string `json:"min,omitempty"`
+		Max          string `json:"max,omitempty"`
+		Mean         string `json:"mean,omitempty"`
+	}
+
+	// Profiles holds the fault injection profiles read from dynamic config, a nil Profiles injects no fault
+	Profiles struct {
+		enabled    dynamicproperties.BoolPropertyFn
+		profiles   dynamicproperties.ListPropertyFn
+		logger     log.Logger
+		timeSource clock.TimeSource
+
+		sync.RWMutex
+		refreshTime time.Time
+		parsed      []*profile
+	}
+
+	profile struct {
+		name       string
+		operations []string
+		domains    map[string]bool
+		errorRate  float64
+		errors     []error
+		latency    *latency
+		startTime  time.Time
+		endTime    time.Time
+	}
+
+	latency struct {
+		rate         float64
+		distribution string
+		min          time.Duration
+		max          time.Duration
+		mean         time.Duration
+	}
+)
+
+// NewProfiles creates the fault injection profiles controlled by the given dynamic config,
+// no fault is injected while enabled returns false
+func NewProfiles(enabled dynamicproperties.BoolPropertyFn, profiles dynamicproperties.ListPropertyFn, logger log.Logger) *Profiles {
+	return &Profiles{
+		enabled:    enabled,
+		profiles:   profiles,
+		logger:     logger,
+		timeSource: clock.NewRealTimeSource(),
+	}
+}
+
+// injectFault delays the call and returns the error to fail it with according to the active profiles
+// matching the operation and the domain of the request
+func (p *Profiles) injectFault(ctx context.Context, operation string, request any) error {
+	if p == nil || !p.enabled() {
+		return nil
+	}
+	now := p.timeSource.Now()
+	domainName := func() string { return getDomainName(request) }
+	for _, prof := range p.get(now) {
+		if !prof.matches(now, operation, domainName) {
+			continue
+		}
+		if prof.latency != nil && rand.Float64() < prof.latency.rate {
+			if err := p.timeSource.SleepWithContext(ctx, prof.latency.generate()); err != nil {
+				return err
+			}
+		}
+		if len(prof.errors) > 0 && rand.Float64() < prof.errorRate {
+			return prof.errors[rand.Intn(len(prof.errors))]
+		}
+	}
+	return nil
+}
+
+func (p *Profiles) get(now time.Time) []*profile {
+	p.RLock()
+	if now.Sub(p.refreshTime) < profilesRefreshInterval {
+		defer p.RUnlock()
+		return p.parsed
+	}
+	p.RUnlock()
+
+	p.Lock()
+	defer p.Unlock()
+	if now.Sub(p.refreshTime) >= profilesRefreshInterval {
+		p.parsed = p.parse(p.profiles())
+		p.refreshTime = now
+	}
+	return p.parsed
+}
+
+// parse converts the dynamic config value to profiles, invalid profiles are logged and skipped
+func (p *Profiles) parse(values []interface{}) []*profile {
+	parsed := make([]*profile, 0, len(values))
+	for _, value := range values {
+		prof, err := parseProfile(value)
+		if err != nil {
+			p.logger.Error("Invalid persistence fault injection profile", tag.Value(value), tag.Error(err))
+			continue
+		}
+		parsed = append(parsed, prof)
+	}
+	return parsed
+}
+
+func parseProfile(value interface{}) (*profile, error) {
+	var config Profile
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+
+	prof := &profile{
+		name:       config.Name,
+		operations: config.Operations,
+		errorRate:  config.ErrorRate,
+	}
+	for _, operation := range config.Operations {
+		if _, err := path.Match(operation, ""); err != nil {
+			return nil, fmt.Errorf("invalid operation pattern %q: %w", operation, err)
+		}
+	}
+	if len(config.Domains) > 0 {
+		prof.domains = make(map[string]bool, len(config.Domains))
+		for _, domain := range config.Domains {
+			prof.domains[domain] = true
+		}
+	}
+	if config.ErrorRate > 0 {
+		errorTypes := config.Errors
+		if len(errorTypes) == 0 {
+			errorTypes = []string{FaultTimeout, FaultUnhandled, FaultServiceBusy, FaultInternalService}
+		}
+		for _, errorType := range errorTypes {
+			fault, ok := faultErrors[errorType]
+			if !ok {
+				return nil, fmt.Errorf("unknown error type %q", errorType)
+			}
+			prof.errors = append(prof.errors, fault)
+		}
+	}
+	if config.Latency != nil && config.Latency.Rate > 0 {
+		if prof.latency, err = parseLatency(config.Latency); err != nil {
+			return nil, err
+		}
+	}
+	if prof.startTime, err = parseTime(config.StartTime); err != nil {
+		return nil, err
+	}
+	if prof.endTime, err = parseTime(config.EndTime); err != nil {
+		return nil, err
+	}
+	return prof, nil
+}
+
+func parseLatency(config *LatencyProfile) (*latency, error) {
+	l := &latency{
+		rate:         config.Rate,
+		distribution: config.Distribution,
+	}
+	var err error
+	if l.min, err = parseDuration(config.Min); err != nil {
+		return nil, err
+	}
+	if l.max, err = parseDuration(config.Max); err != nil {
+		return nil, err
+	}
+	if l.mean, err = parseDuration(config.Mean); err != nil {
+		return nil, err
+	}
+	switch l.distribution {
+	case LatencyFixed, LatencyExponential:
+		if l.mean <= 0 {
+			return nil, fmt.Errorf("%s latency requires a positive mean", l.distribution)
+		}
+	case LatencyUniform:
+		if l.max <= l.min {
+			return nil, fmt.Errorf("uniform latency requires max to be greater than min")
+		}
+	default:
+		return nil, fmt.Errorf("unknown latency distribution %q", l.distribution)
+	}
+	return l, nil
+}
+
+func parseDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(value)
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func (p *profile) matches(now time.Time, operation string, domainName func() string) bool {
+	if !p.startTime.IsZero() && now.Before(p.startTime) {
+		return false
+	}
+	if !p.endTime.IsZero() && !now.Before(p.endTime) {
+		return false
+	}
+	if p.domains != nil && !p.domains[domainName()] {
+		return false
+	}
+	if len(p.operations) == 0 {
+		return true
+	}
+	for _, pattern := range p.operations {
+		if matched, _ := path.Match(pattern, operation); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *latency) generate() time.Duration {
+	switch l.distribution {
+	case LatencyUniform:
+		return l.min + time.Duration(rand.Int63n(int64(l.max-l.min)))
+	case LatencyExponential:
+		d := l.min + time.Duration(rand.ExpFloat64()*float64(l.mean))
+		if l.max > 0 && d > l.max {
+			return l.max
+		}
+		return d
+	default:
+		return l.mean
+	}
+}
+
+// getDomainName returns the name of the domain of the request, when the request defines it
+// for the metered or the execution wrappers
+func getDomainName(request any) string {
+	switch r := request.(type) {
+	case interface{ GetDomainName() string }:
+		return r.GetDomainName()
+	case interface{ MetricTags() []metrics.Tag }:
+		for _, t := range r.MetricTags() {
+			if t.Key() == domainTagKey {
+				return t.Value()
+			}
+		}
+	}
+	return ""
+}
+
+var domainTagKey = metrics.DomainTag("").Key()
@@ -0,0 +1,287 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package errorinjectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func newTestProfiles(t *testing.T, now time.Time, values ...interface{}) (*Profiles, clock.MockedTimeSource, *[]interface{}) {
+	config := &values
+	timeSource := clock.NewMockedTimeSourceAt(now)
+	p := NewProfiles(
+		dynamicproperties.GetBoolPropertyFn(true),
+		func(...dynamicproperties.FilterOption) []interface{} { return *config },
+		testlogger.New(t),
+	)
+	p.timeSource = timeSource
+	return p, timeSource, config
+}
+
+func TestParseProfile(t *testing.T) {
+	tests := map[string]struct {
+		value   interface{}
+		want    *profile
+		wantErr bool
+	}{
+		"errors and latency": {
+			value: map[string]interface{}{
+				"name":       "slow-updates",
+				"operations": []interface{}{"ExecutionManager.Update*"},
+				"domains":    []interface{}{"test-domain"},
+				"errorRate":  0.5,
+				"errors":     []interface{}{"condition-failed", "shard-ownership-lost"},
+				"latency": map[string]interface{}{
+					"rate":         1,
+					"distribution": "uniform",
+					"min":          "10ms",
+					"max":          "50ms",
+				},
+				"startTime": "2026-01-01T10:00:00Z",
+				"endTime":   "2026-01-01T11:00:00Z",
+			},
+			want: &profile{
+				name:       "slow-updates",
+				operations: []string{"ExecutionManager.Update*"},
+				domains:    map[string]bool{"test-domain": true},
+				errorRate:  0.5,
+				errors:     []error{ErrFakeConditionFailed, ErrFakeShardOwnershipLost},
+				latency: &latency{
+					rate:         1,
+					distribution: LatencyUniform,
+					min:          10 * time.Millisecond,
+					max:          50 * time.Millisecond,
+				},
+				startTime: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+				endTime:   time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC),
+			},
+		},
+		"default errors": {
+			value: map[string]interface{}{"errorRate": 0.1},
+			want: &profile{
+				errorRate: 0.1,
+				errors:    []error{ErrFakeTimeout, errors.ErrFakeUnhandled, errors.ErrFakeServiceBusy, errors.ErrFakeInternalService},
+			},
+		},
+		"unknown error": {
+			value:   map[string]interface{}{"errorRate": 0.1, "errors": []interface{}{"boom"}},
+			wantErr: true,
+		},
+		"invalid operation pattern": {
+			value:   map[string]interface{}{"operations": []interface{}{"["}},
+			wantErr: true,
+		},
+		"unknown latency distribution": {
+			value:   map[string]interface{}{"latency": map[string]interface{}{"rate": 1, "distribution": "normal", "mean": "1s"}},
+			wantErr: true,
+		},
+		"fixed latency without mean": {
+			value:   map[string]interface{}{"latency": map[string]interface{}{"rate": 1, "distribution": "fixed"}},
+			wantErr: true,
+		},
+		"invalid window": {
+			value:   map[string]interface{}{"startTime": "tomorrow"},
+			wantErr: true,
+		},
+		"not an object": {
+			value:   "timeout",
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseProfile(tc.value)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestProfileMatches(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	domainName := func() string { return "test-domain" }
+	tests := map[string]struct {
+		profile *profile
+		op      string
+		want    bool
+	}{
+		"no filters": {
+			profile: &profile{},
+			op:      "ShardManager.GetShard",
+			want:    true,
+		},
+		"operation matches": {
+			profile: &profile{operations: []string{"TaskManager.*", "ExecutionManager.Get*"}},
+			op:      "ExecutionManager.GetWorkflowExecution",
+			want:    true,
+		},
+		"operation does not match": {
+			profile: &profile{operations: []string{"ExecutionManager.Get*"}},
+			op:      "ExecutionManager.UpdateWorkflowExecution",
+		},
+		"domain matches": {
+			profile: &profile{domains: map[string]bool{"test-domain": true}},
+			op:      "ExecutionManager.UpdateWorkflowExecution",
+			want:    true,
+		},
+		"domain does not match": {
+			profile: &profile{domains: map[string]bool{"other-domain": true}},
+			op:      "ExecutionManager.UpdateWorkflowExecution",
+		},
+		"within window": {
+			profile: &profile{startTime: now.Add(-time.Minute), endTime: now.Add(time.Minute)},
+			op:      "ShardManager.GetShard",
+			want:    true,
+		},
+		"before window": {
+			profile: &profile{startTime: now.Add(time.Minute)},
+			op:      "ShardManager.GetShard",
+		},
+		"after window": {
+			profile: &profile{endTime: now},
+			op:      "ShardManager.GetShard",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.profile.matches(now, tc.op, domainName))
+		})
+	}
+}
+
+func TestProfilesInjectFault(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	request := &persistence.UpdateWorkflowExecutionRequest{DomainName: "test-domain"}
+
+	t.Run("nil profiles", func(t *testing.T) {
+		var p *Profiles
+		assert.NoError(t, p.injectFault(context.Background(), "ExecutionManager.UpdateWorkflowExecution", request))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		p, _, _ := newTestProfiles(t, now, map[string]interface{}{
+			"domains":   []interface{}{"test-domain"},
+			"errorRate": 1,
+			"errors":    []interface{}{"shard-ownership-lost"},
+		})
+		assert.Equal(t, ErrFakeShardOwnershipLost, p.injectFault(context.Background(), "ExecutionManager.UpdateWorkflowExecution", request))
+		assert.NoError(t, p.injectFault(context.Background(), "ExecutionManager.UpdateWorkflowExecution", &persistence.UpdateWorkflowExecutionRequest{DomainName: "other-domain"}))
+		assert.NoError(t, p.injectFault(context.Background(), "ShardManager.GetShard", &persistence.GetShardRequest{}))
+	})
+
+	t.Run("toggled", func(t *testing.T) {
+		p, _, _ := newTestProfiles(t, now, map[string]interface{}{"errorRate": 1, "errors": []interface{}{"db-unavailable"}})
+		enabled := false
+		p.enabled = func(...dynamicproperties.FilterOption) bool { return enabled }
+		assert.NoError(t, p.injectFault(context.Background(), "ShardManager.GetShard", nil))
+
+		enabled = true
+		assert.Equal(t, ErrFakeDBUnavailable, p.injectFault(context.Background(), "ShardManager.GetShard", nil))
+
+		enabled = false
+		assert.NoError(t, p.injectFault(context.Background(), "ShardManager.GetShard", nil))
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		p, timeSource, _ := newTestProfiles(t, now, map[string]interface{}{
+			"operations": []interface{}{"ExecutionManager.*"},
+			"latency":    map[string]interface{}{"rate": 1, "distribution": "fixed", "mean": "1s"},
+		})
+		done := make(chan error)
+		go func() {
+			done <- p.injectFault(context.Background(), "ExecutionManager.UpdateWorkflowExecution", request)
+		}()
+		timeSource.BlockUntil(1)
+		timeSource.Advance(time.Second)
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("latency interrupted by context", func(t *testing.T) {
+		p, _, _ := newTestProfiles(t, now, map[string]interface{}{
+			"latency": map[string]interface{}{"rate": 1, "distribution": "fixed", "mean": "1h"},
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, p.injectFault(ctx, "ShardManager.GetShard", nil), context.Canceled)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		p, timeSource, config := newTestProfiles(t, now)
+		assert.NoError(t, p.injectFault(context.Background(), "ShardManager.GetShard", nil))
+
+		*config = []interface{}{map[string]interface{}{"errorRate": 1, "errors": []interface{}{"db-unavailable"}}}
+		assert.NoError(t, p.injectFault(context.Background(), "ShardManager.GetShard", nil), "profiles are cached until the next refresh")
+
+		timeSource.Advance(profilesRefreshInterval)
+		assert.Equal(t, ErrFakeDBUnavailable, p.injectFault(context.Background(), "ShardManager.GetShard", nil))
+	})
+
+	t.Run("invalid profiles are skipped", func(t *testing.T) {
+		p, _, _ := newTestProfiles(t, now,
+			map[string]interface{}{"errorRate": 1, "errors": []interface{}{"boom"}},
+			map[string]interface{}{"errorRate": 1, "errors": []interface{}{"timeout"}},
+		)
+		p.logger = log.NewNoop()
+		assert.Equal(t, ErrFakeTimeout, p.injectFault(context.Background(), "ShardManager.GetShard", nil))
+	})
+}
+
+func TestInjectorWithProfiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockShardManager(ctrl)
+	p, _, _ := newTestProfiles(t, time.Now(), map[string]interface{}{
+		"operations": []interface{}{"ShardManager.UpdateShard"},
+		"errorRate":  1,
+		"errors":     []interface{}{"condition-failed"},
+	})
+	object := NewShardManager(mocked, 0, p, log.NewNoop(), time.Now())
+
+	mocked.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&persistence.GetShardResponse{}, nil)
+	_, err := object.GetShard(context.Background(), &persistence.GetShardRequest{})
+	assert.NoError(t, err)
+
+	err = object.UpdateShard(context.Background(), &persistence.UpdateShardRequest{})
+	assert.Equal(t, ErrFakeConditionFailed, err)
+}
+
+func TestGetDomainName(t *testing.T) {
+	assert.Equal(t, "test-domain", getDomainName(&persistence.UpdateWorkflowExecutionRequest{DomainName: "test-domain"}))
+	assert.Equal(t, "test-domain", getDomainName(persistence.ReadHistoryBranchRequest{DomainName: "test-domain"}))
+	assert.Equal(t, "", getDomainName(&persistence.GetShardRequest{}))
+	assert.Equal(t, "", getDomainName(nil))
+}
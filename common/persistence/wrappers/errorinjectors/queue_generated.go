@@ -18,6 +18,7 @@ type injectorQueueManager struct {
 	wrapped   _sourcePersistence.QueueManager
 	starttime time.Time
 	errorRate float64
+	profiles  *Profiles
 	logger    log.Logger
 }
 
@@ -25,6 +26,7 @@ type injectorQueueManager struct {
 func NewQueueManager(
 	wrapped persistence.QueueManager,
 	errorRate float64,
+	profiles *Profiles,
 	logger log.Logger,
 	starttime time.Time,
 ) persistence.QueueManager {
@@ -32,6 +34,7 @@ func NewQueueManager(
 		wrapped:   wrapped,
 		starttime: starttime,
 		errorRate: errorRate,
+		profiles:  profiles,
 		logger:    logger,
 	}
 }
@@ -43,6 +46,9 @@ func (c *injectorQueueManager) Close() {
 
 func (c *injectorQueueManager) DeleteMessageFromDLQ(ctx context.Context, request *_sourcePersistence.DeleteMessageFromDLQRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.DeleteMessageFromDLQ", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteMessageFromDLQ(ctx, request)
@@ -58,6 +64,9 @@ func (c *injectorQueueManager) DeleteMessageFromDLQ(ctx context.Context, request
 
 func (c *injectorQueueManager) DeleteMessagesBefore(ctx context.Context, request *_sourcePersistence.DeleteMessagesBeforeRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.DeleteMessagesBefore", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteMessagesBefore(ctx, request)
@@ -73,6 +82,9 @@ func (c *injectorQueueManager) DeleteMessagesBefore(ctx context.Context, request
 
 func (c *injectorQueueManager) EnqueueMessage(ctx context.Context, request *_sourcePersistence.EnqueueMessageRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.EnqueueMessage", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.EnqueueMessage(ctx, request)
@@ -88,6 +100,9 @@ func (c *injectorQueueManager) EnqueueMessage(ctx context.Context, request *_sou
 
 func (c *injectorQueueManager) EnqueueMessageToDLQ(ctx context.Context, request *_sourcePersistence.EnqueueMessageToDLQRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.EnqueueMessageToDLQ", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.EnqueueMessageToDLQ(ctx, request)
@@ -103,6 +118,9 @@ func (c *injectorQueueManager) EnqueueMessageToDLQ(ctx context.Context, request
 
 func (c *injectorQueueManager) GetAckLevels(ctx context.Context, request *_sourcePersistence.GetAckLevelsRequest) (gp1 *_sourcePersistence.GetAckLevelsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.GetAckLevels", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetAckLevels(ctx, request)
@@ -118,6 +136,9 @@ func (c *injectorQueueManager) GetAckLevels(ctx context.Context, request *_sourc
 
 func (c *injectorQueueManager) GetDLQAckLevels(ctx context.Context, request *_sourcePersistence.GetDLQAckLevelsRequest) (gp1 *_sourcePersistence.GetDLQAckLevelsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.GetDLQAckLevels", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetDLQAckLevels(ctx, request)
@@ -133,6 +154,9 @@ func (c *injectorQueueManager) GetDLQAckLevels(ctx context.Context, request *_so
 
 func (c *injectorQueueManager) GetDLQSize(ctx context.Context, request *_sourcePersistence.GetDLQSizeRequest) (gp1 *_sourcePersistence.GetDLQSizeResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.GetDLQSize", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetDLQSize(ctx, request)
@@ -148,6 +172,9 @@ func (c *injectorQueueManager) GetDLQSize(ctx context.Context, request *_sourceP
 
 func (c *injectorQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, request *_sourcePersistence.RangeDeleteMessagesFromDLQRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.RangeDeleteMessagesFromDLQ", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.RangeDeleteMessagesFromDLQ(ctx, request)
@@ -163,6 +190,9 @@ func (c *injectorQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, r
 
 func (c *injectorQueueManager) ReadMessages(ctx context.Context, request *_sourcePersistence.ReadMessagesRequest) (rp1 *_sourcePersistence.ReadMessagesResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.ReadMessages", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		rp1, err = c.wrapped.ReadMessages(ctx, request)
@@ -178,6 +208,9 @@ func (c *injectorQueueManager) ReadMessages(ctx context.Context, request *_sourc
 
 func (c *injectorQueueManager) ReadMessagesFromDLQ(ctx context.Context, request *_sourcePersistence.ReadMessagesFromDLQRequest) (rp1 *_sourcePersistence.ReadMessagesFromDLQResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.ReadMessagesFromDLQ", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		rp1, err = c.wrapped.ReadMessagesFromDLQ(ctx, request)
@@ -193,6 +226,9 @@ func (c *injectorQueueManager) ReadMessagesFromDLQ(ctx context.Context, request
 
 func (c *injectorQueueManager) UpdateAckLevel(ctx context.Context, request *_sourcePersistence.UpdateAckLevelRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.UpdateAckLevel", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.UpdateAckLevel(ctx, request)
@@ -208,6 +244,9 @@ func (c *injectorQueueManager) UpdateAckLevel(ctx context.Context, request *_sou
 
 func (c *injectorQueueManager) UpdateDLQAckLevel(ctx context.Context, request *_sourcePersistence.UpdateDLQAckLevelRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "QueueManager.UpdateDLQAckLevel", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.UpdateDLQAckLevel(ctx, request)
@@ -18,6 +18,7 @@ type injectorShardManager struct {
 	wrapped   _sourcePersistence.ShardManager
 	starttime time.Time
 	errorRate float64
+	profiles  *Profiles
 	logger    log.Logger
 }
 
@@ -25,6 +26,7 @@ type injectorShardManager struct {
 func NewShardManager(
 	wrapped persistence.ShardManager,
 	errorRate float64,
+	profiles *Profiles,
 	logger log.Logger,
 	starttime time.Time,
 ) persistence.ShardManager {
@@ -32,6 +34,7 @@ func NewShardManager(
 		wrapped:   wrapped,
 		starttime: starttime,
 		errorRate: errorRate,
+		profiles:  profiles,
 		logger:    logger,
 	}
 }
@@ -43,6 +46,9 @@ func (c *injectorShardManager) Close() {
 
 func (c *injectorShardManager) CreateShard(ctx context.Context, request *_sourcePersistence.CreateShardRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ShardManager.CreateShard", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.CreateShard(ctx, request)
@@ -62,6 +68,9 @@ func (c *injectorShardManager) GetName() (s1 string) {
 
 func (c *injectorShardManager) GetShard(ctx context.Context, request *_sourcePersistence.GetShardRequest) (gp1 *_sourcePersistence.GetShardResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ShardManager.GetShard", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetShard(ctx, request)
@@ -77,6 +86,9 @@ func (c *injectorShardManager) GetShard(ctx context.Context, request *_sourcePer
 
 func (c *injectorShardManager) UpdateShard(ctx context.Context, request *_sourcePersistence.UpdateShardRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "ShardManager.UpdateShard", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.UpdateShard(ctx, request)
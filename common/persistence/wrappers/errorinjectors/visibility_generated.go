@@ -18,6 +18,7 @@ type injectorVisibilityManager struct {
 	wrapped   _sourcePersistence.VisibilityManager
 	starttime time.Time
 	errorRate float64
+	profiles  *Profiles
 	logger    log.Logger
 }
 
@@ -25,6 +26,7 @@ type injectorVisibilityManager struct {
 func NewVisibilityManager(
 	wrapped persistence.VisibilityManager,
 	errorRate float64,
+	profiles *Profiles,
 	logger log.Logger,
 	starttime time.Time,
 ) persistence.VisibilityManager {
@@ -32,6 +34,7 @@ func NewVisibilityManager(
 		wrapped:   wrapped,
 		starttime: starttime,
 		errorRate: errorRate,
+		profiles:  profiles,
 		logger:    logger,
 	}
 }
@@ -43,6 +46,9 @@ func (c *injectorVisibilityManager) Close() {
 
 func (c *injectorVisibilityManager) CountWorkflowExecutions(ctx context.Context, request *_sourcePersistence.CountWorkflowExecutionsRequest) (cp1 *_sourcePersistence.CountWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.CountWorkflowExecutions", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		cp1, err = c.wrapped.CountWorkflowExecutions(ctx, request)
@@ -58,6 +64,9 @@ func (c *injectorVisibilityManager) CountWorkflowExecutions(ctx context.Context,
 
 func (c *injectorVisibilityManager) DeleteUninitializedWorkflowExecution(ctx context.Context, request *_sourcePersistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.DeleteUninitializedWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteUninitializedWorkflowExecution(ctx, request)
@@ -73,6 +82,9 @@ func (c *injectorVisibilityManager) DeleteUninitializedWorkflowExecution(ctx con
 
 func (c *injectorVisibilityManager) DeleteWorkflowExecution(ctx context.Context, request *_sourcePersistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.DeleteWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.DeleteWorkflowExecution(ctx, request)
@@ -88,6 +100,9 @@ func (c *injectorVisibilityManager) DeleteWorkflowExecution(ctx context.Context,
 
 func (c *injectorVisibilityManager) GetClosedWorkflowExecution(ctx context.Context, request *_sourcePersistence.GetClosedWorkflowExecutionRequest) (gp1 *_sourcePersistence.GetClosedWorkflowExecutionResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.GetClosedWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		gp1, err = c.wrapped.GetClosedWorkflowExecution(ctx, request)
@@ -107,6 +122,9 @@ func (c *injectorVisibilityManager) GetName() (s1 string) {
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.ListClosedWorkflowExecutions", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListClosedWorkflowExecutions(ctx, request)
@@ -122,6 +140,9 @@ func (c *injectorVisibilityManager) ListClosedWorkflowExecutions(ctx context.Con
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *_sourcePersistence.ListClosedWorkflowExecutionsByStatusRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.ListClosedWorkflowExecutionsByStatus", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByStatus(ctx, request)
@@ -137,6 +158,9 @@ func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx con
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByType(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByTypeRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.ListClosedWorkflowExecutionsByType", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByType(ctx, request)
@@ -152,6 +176,9 @@ func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByType(ctx conte
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
@@ -167,6 +194,9 @@ func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(ctx
 
 func (c *injectorVisibilityManager) ListOpenWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.ListOpenWorkflowExecutions", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListOpenWorkflowExecutions(ctx, request)
@@ -182,6 +212,9 @@ func (c *injectorVisibilityManager) ListOpenWorkflowExecutions(ctx context.Conte
 
 func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByType(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByTypeRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.ListOpenWorkflowExecutionsByType", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListOpenWorkflowExecutionsByType(ctx, request)
@@ -197,6 +230,9 @@ func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByType(ctx context
 
 func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
@@ -212,6 +248,9 @@ func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ctx c
 
 func (c *injectorVisibilityManager) ListWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByQueryRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.ListWorkflowExecutions", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ListWorkflowExecutions(ctx, request)
@@ -227,6 +266,9 @@ func (c *injectorVisibilityManager) ListWorkflowExecutions(ctx context.Context,
 
 func (c *injectorVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Context, request *_sourcePersistence.RecordWorkflowExecutionClosedRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.RecordWorkflowExecutionClosed", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.RecordWorkflowExecutionClosed(ctx, request)
@@ -242,6 +284,9 @@ func (c *injectorVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Co
 
 func (c *injectorVisibilityManager) RecordWorkflowExecutionStarted(ctx context.Context, request *_sourcePersistence.RecordWorkflowExecutionStartedRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.RecordWorkflowExecutionStarted", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.RecordWorkflowExecutionStarted(ctx, request)
@@ -257,6 +302,9 @@ func (c *injectorVisibilityManager) RecordWorkflowExecutionStarted(ctx context.C
 
 func (c *injectorVisibilityManager) RecordWorkflowExecutionUninitialized(ctx context.Context, request *_sourcePersistence.RecordWorkflowExecutionUninitializedRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.RecordWorkflowExecutionUninitialized", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.RecordWorkflowExecutionUninitialized(ctx, request)
@@ -272,6 +320,9 @@ func (c *injectorVisibilityManager) RecordWorkflowExecutionUninitialized(ctx con
 
 func (c *injectorVisibilityManager) ScanWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByQueryRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.ScanWorkflowExecutions", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		lp1, err = c.wrapped.ScanWorkflowExecutions(ctx, request)
@@ -287,6 +338,9 @@ func (c *injectorVisibilityManager) ScanWorkflowExecutions(ctx context.Context,
 
 func (c *injectorVisibilityManager) UpsertWorkflowExecution(ctx context.Context, request *_sourcePersistence.UpsertWorkflowExecutionRequest) (err error) {
 	fakeErr := generateFakeError(c.errorRate, c.starttime)
+	if fakeErr == nil {
+		fakeErr = c.profiles.injectFault(ctx, "VisibilityManager.UpsertWorkflowExecution", request)
+	}
 	var forwardCall bool
 	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
 		err = c.wrapped.UpsertWorkflowExecution(ctx, request)
@@ -0,0 +1,275 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// readOperations are the operations that don't modify data, any other operation is written to both stores
+var readOperations = map[string]struct{}{
+	"DomainManager.GetDomain":                            {},
+	"DomainManager.GetMetadata":                          {},
+	"DomainManager.ListDomains":                          {},
+	"ExecutionManager.FetchWorkflowTimerTasksForCleanup": {},
+	"ExecutionManager.GetActiveClusterSelectionPolicy":   {},
+	"ExecutionManager.GetCurrentExecution":               {},
+	"ExecutionManager.GetHistoryTasks":                   {},
+	"ExecutionManager.GetReplicationDLQSize":             {},
+	"ExecutionManager.GetReplicationTasksFromDLQ":        {},
+	"ExecutionManager.GetWorkflowExecution":              {},
+	"ExecutionManager.IsWorkflowExecutionExists":         {},
+	"ExecutionManager.ListConcreteExecutions":            {},
+	"ExecutionManager.ListCurrentExecutions":             {},
+	"HistoryManager.GetAllHistoryTreeBranches":           {},
+	"HistoryManager.GetHistoryTree":                      {},
+	"HistoryManager.ReadHistoryBranch":                   {},
+	"HistoryManager.ReadHistoryBranchByBatch":            {},
+	"HistoryManager.ReadRawHistoryBranch":                {},
+	"ShardManager.GetShard":                              {},
+	"TaskManager.GetOrphanTasks":                         {},
+	"TaskManager.GetTaskList":                            {},
+	"TaskManager.GetTaskListSize":                        {},
+	"TaskManager.GetTasks":                               {},
+	"TaskManager.ListTaskList":                           {},
+}
+
+type (
+	storeCall func(ctx context.Context) (any, error)
+
+	base struct {
+		mode          dynamicproperties.StringPropertyFn
+		shardID       *int
+		state         *State
+		metricsClient metrics.Client
+		logger        log.Logger
+	}
+)
+
+// call routes a persistence operation to the primary and/or secondary store based on the current mode.
+// For writes, the result of the leading store is returned and a failure of the following store is logged
+// and counted. A failure of the secondary store keeps the shard from being switched to the cutover mode.
+func (b *base) call(
+	ctx context.Context,
+	scope metrics.ScopeIdx,
+	operation string,
+	request any,
+	primary storeCall,
+	secondary storeCall,
+) (any, error) {
+	shardID := b.getShardID(request)
+	mode := b.getMode(ctx, scope, operation, shardID)
+	if _, ok := readOperations[operation]; ok {
+		switch mode {
+		case ModeShadowRead:
+			result, err := primary(ctx)
+			b.shadowRead(ctx, scope, operation, shardID, result, err, secondary)
+			return result, err
+		case ModeCutover, ModeSecondary:
+			return secondary(ctx)
+		default:
+			return primary(ctx)
+		}
+	}
+
+	switch mode {
+	case ModeDualWrite, ModeShadowRead:
+		assignGeneratedIDs(request)
+		return b.dualWrite(ctx, scope, operation, shardID, primary, secondary, true)
+	case ModeCutover:
+		assignGeneratedIDs(request)
+		return b.dualWrite(ctx, scope, operation, shardID, secondary, primary, false)
+	case ModeSecondary:
+		return secondary(ctx)
+	default:
+		return primary(ctx)
+	}
+}
+
+func (b *base) dualWrite(
+	ctx context.Context,
+	scope metrics.ScopeIdx,
+	operation string,
+	shardID int,
+	leader storeCall,
+	follower storeCall,
+	secondaryFollows bool,
+) (any, error) {
+	result, err := leader(ctx)
+	if err != nil {
+		return result, err
+	}
+	if _, followerErr := follower(ctx); followerErr != nil {
+		b.metricsClient.IncCounter(scope, metrics.PersistenceMigrationFollowerWriteFailures)
+		b.logger.Error("Persistence migration failed to write to the following store",
+			append(b.logTags(operation, shardID), tag.Error(followerErr))...)
+		if secondaryFollows {
+			if err := b.state.setDiverged(ctx, shardID); err != nil {
+				b.logger.Error("Persistence migration failed to record that the migration store may be missing data, "+
+					"the cutover of the shard is blocked on this host until the service is restarted",
+					append(b.logTags(operation, shardID), tag.Error(err))...)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (b *base) shadowRead(
+	ctx context.Context,
+	scope metrics.ScopeIdx,
+	operation string,
+	shardID int,
+	primaryResult any,
+	primaryErr error,
+	secondary storeCall,
+) {
+	secondaryResult, secondaryErr := secondary(ctx)
+	switch {
+	case primaryErr == nil && secondaryErr != nil && !isEntityNotExistsError(secondaryErr):
+		b.metricsClient.IncCounter(scope, metrics.PersistenceMigrationShadowReadFailures)
+		b.logger.Warn("Persistence migration shadow read failed",
+			append(b.logTags(operation, shardID), tag.Error(secondaryErr))...)
+	case !isSameError(primaryErr, secondaryErr) || (primaryErr == nil && !reflect.DeepEqual(primaryResult, secondaryResult)):
+		b.metricsClient.IncCounter(scope, metrics.PersistenceMigrationShadowReadMismatches)
+		b.logger.Warn("Persistence migration shadow read mismatch",
+			append(b.logTags(operation, shardID), tag.Error(primaryErr), tag.StoreError(secondaryErr))...)
+	}
+}
+
+// getMode returns the mode of a shard. An invalid value is ignored and the last valid mode is kept, and a shard
+// whose secondary store may be missing data is kept in shadow-read instead of the cutover and secondary modes.
+func (b *base) getMode(ctx context.Context, scope metrics.ScopeIdx, operation string, shardID int) Mode {
+	if b.mode == nil {
+		return ModeDisabled
+	}
+	var value string
+	if shardID != noShardID {
+		value = b.mode(dynamicproperties.ShardIDFilter(shardID))
+	} else {
+		value = b.mode()
+	}
+	mode, err := ParseMode(value)
+	if err != nil {
+		b.metricsClient.IncCounter(scope, metrics.PersistenceMigrationInvalidMode)
+		var isNew bool
+		if mode, isNew = b.state.setInvalidValue(shardID, value); isNew {
+			b.logger.Error("Invalid persistence migration mode, the last valid mode is kept",
+				append(b.logTags(operation, shardID), tag.Mode(string(mode)), tag.Error(err))...)
+		}
+	} else {
+		b.state.setMode(shardID, mode)
+	}
+
+	if mode == ModeCutover || mode == ModeSecondary {
+		diverged, first, err := b.state.isDiverged(ctx, shardID)
+		if err != nil {
+			b.logger.Warn("Persistence migration failed to load the divergence markers",
+				append(b.logTags(operation, shardID), tag.Error(err))...)
+		}
+		if diverged {
+			b.metricsClient.IncCounter(scope, metrics.PersistenceMigrationCutoverBlocked)
+			if first {
+				b.logger.Error("Persistence migration cutover is blocked as writes to the migration store failed, "+
+					"the shard is kept in shadow-read mode until the backfill verified it again",
+					append(b.logTags(operation, shardID), tag.Mode(string(mode)))...)
+			}
+			return ModeShadowRead
+		}
+	}
+	return mode
+}
+
+// getShardID returns the shard of a call, or noShardID for calls which are not tied to a shard
+func (b *base) getShardID(request any) int {
+	if b.shardID != nil {
+		return *b.shardID
+	}
+	if shardID := shardIDFromRequest(request); shardID != nil {
+		return *shardID
+	}
+	return noShardID
+}
+
+func (b *base) logTags(operation string, shardID int) []tag.Tag {
+	tags := []tag.Tag{tag.OperationName(operation)}
+	if shardID != noShardID {
+		tags = append(tags, tag.ShardID(shardID))
+	}
+	return tags
+}
+
+// shardIDFromRequest returns the shard of requests that are not served by a per shard manager
+func shardIDFromRequest(request any) *int {
+	switch r := request.(type) {
+	case *persistence.CreateShardRequest:
+		if r.ShardInfo != nil {
+			return &r.ShardInfo.ShardID
+		}
+	case *persistence.GetShardRequest:
+		return &r.ShardID
+	case *persistence.UpdateShardRequest:
+		if r.ShardInfo != nil {
+			return &r.ShardInfo.ShardID
+		}
+	case *persistence.AppendHistoryNodesRequest:
+		return r.ShardID
+	case *persistence.ReadHistoryBranchRequest:
+		return r.ShardID
+	case *persistence.ForkHistoryBranchRequest:
+		return r.ShardID
+	case *persistence.DeleteHistoryBranchRequest:
+		return r.ShardID
+	case *persistence.GetHistoryTreeRequest:
+		return r.ShardID
+	}
+	return nil
+}
+
+// assignGeneratedIDs sets the IDs the persistence managers would otherwise generate on their own,
+// so that both stores end up with the same records
+func assignGeneratedIDs(request any) {
+	if r, ok := request.(*persistence.ForkHistoryBranchRequest); ok && r.NewBranchID == "" {
+		r.NewBranchID = uuid.New()
+	}
+}
+
+func isEntityNotExistsError(err error) bool {
+	var notExistsErr *types.EntityNotExistsError
+	return errors.As(err, &notExistsErr)
+}
+
+func isSameError(primaryErr error, secondaryErr error) bool {
+	if primaryErr == nil || secondaryErr == nil {
+		return primaryErr == nil && secondaryErr == nil
+	}
+	return reflect.TypeOf(primaryErr) == reflect.TypeOf(secondaryErr)
+}
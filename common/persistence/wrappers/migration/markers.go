@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// divergedShardsConfigName is the entry of the persistence migration config holding the divergence markers,
+	// each value is the time of the last failed write of a shard filtered by the shard ID
+	divergedShardsConfigName = "diverged-shards"
+
+	// markerRefreshInterval is how long the markers read from the config store are cached by the wrappers,
+	// a failed write of a shard is recorded at most once per interval
+	markerRefreshInterval = 10 * time.Second
+
+	markerUpdateAttempts = 5
+)
+
+type (
+	// DivergenceMarkers keeps the shards whose migration store may be missing data in the config store of the
+	// default store, along with the time of their last failed write. A marker is only removed by the backfill
+	// after it verified all the executions of the shard, so the cutover stays blocked across restarts.
+	DivergenceMarkers struct {
+		configStore persistence.ConfigStoreManager
+	}
+)
+
+// NewDivergenceMarkers creates the divergence markers kept in the given config store
+func NewDivergenceMarkers(configStore persistence.ConfigStoreManager) *DivergenceMarkers {
+	return &DivergenceMarkers{configStore: configStore}
+}
+
+// Get returns the time of the last failed write of each diverged shard
+func (m *DivergenceMarkers) Get(ctx context.Context) (map[int]time.Time, error) {
+	markers, _, err := m.fetch(ctx)
+	return markers, err
+}
+
+// Set records a failed write to the migration store of a shard
+func (m *DivergenceMarkers) Set(ctx context.Context, shardID int, failedAt time.Time) error {
+	return m.update(ctx, func(markers map[int]time.Time) bool {
+		if last, ok := markers[shardID]; ok && !last.Before(failedAt) {
+			return false
+		}
+		markers[shardID] = failedAt
+		return true
+	})
+}
+
+// Clear removes the marker of a shard whose backfill started at the given time, unless a write may have failed
+// after the backfill started. It returns whether the shard is no longer diverged.
+func (m *DivergenceMarkers) Clear(ctx context.Context, shardID int, backfillStartedAt time.Time) (bool, error) {
+	var cleared bool
+	err := m.update(ctx, func(markers map[int]time.Time) bool {
+		last, ok := markers[shardID]
+		// the later failed writes of the interval may not be recorded
+		cleared = !ok || last.Before(backfillStartedAt.Add(-markerRefreshInterval))
+		if !ok || !cleared {
+			return false
+		}
+		delete(markers, shardID)
+		return true
+	})
+	return cleared && err == nil, err
+}
+
+func (m *DivergenceMarkers) fetch(ctx context.Context) (map[int]time.Time, *persistence.DynamicConfigSnapshot, error) {
+	resp, err := m.configStore.FetchDynamicConfig(ctx, persistence.PersistenceMigrationConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	markers := make(map[int]time.Time)
+	if resp == nil || resp.Snapshot == nil || resp.Snapshot.Values == nil {
+		return markers, nil, nil
+	}
+	for _, entry := range resp.Snapshot.Values.Entries {
+		if entry.Name != divergedShardsConfigName {
+			continue
+		}
+		for _, value := range entry.Values {
+			shardID, failedAt, err := decodeMarker(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			markers[shardID] = failedAt
+		}
+	}
+	return markers, resp.Snapshot, nil
+}
+
+// update applies a change to the markers with a conditional write of a new version of the config,
+// apply returns false when there is nothing to write
+func (m *DivergenceMarkers) update(ctx context.Context, apply func(map[int]time.Time) bool) error {
+	var err error
+	for attempt := 0; attempt < markerUpdateAttempts; attempt++ {
+		var markers map[int]time.Time
+		var snapshot *persistence.DynamicConfigSnapshot
+		if markers, snapshot, err = m.fetch(ctx); err != nil {
+			return err
+		}
+		if !apply(markers) {
+			return nil
+		}
+		var newSnapshot *persistence.DynamicConfigSnapshot
+		if newSnapshot, err = newMarkersSnapshot(snapshot, markers); err != nil {
+			return err
+		}
+		err = m.configStore.UpdateDynamicConfig(ctx, &persistence.UpdateDynamicConfigRequest{Snapshot: newSnapshot}, persistence.PersistenceMigrationConfig)
+		if _, ok := err.(*persistence.ConditionFailedError); !ok {
+			return err
+		}
+	}
+	return fmt.Errorf("failed to update the persistence migration divergence markers after %v attempts: %w", markerUpdateAttempts, err)
+}
+
+// newMarkersSnapshot returns the next version of the config, with the given markers and the other entries of the current version
+func newMarkersSnapshot(current *persistence.DynamicConfigSnapshot, markers map[int]time.Time) (*persistence.DynamicConfigSnapshot, error) {
+	snapshot := &persistence.DynamicConfigSnapshot{
+		Version: 1,
+		Values:  &types.DynamicConfigBlob{},
+	}
+	if current != nil {
+		snapshot.Version = current.Version + 1
+		if current.Values != nil {
+			snapshot.Values.SchemaVersion = current.Values.SchemaVersion
+			for _, entry := range current.Values.Entries {
+				if entry.Name != divergedShardsConfigName {
+					snapshot.Values.Entries = append(snapshot.Values.Entries, entry)
+				}
+			}
+		}
+	}
+	if len(markers) == 0 {
+		return snapshot, nil
+	}
+
+	shardIDs := make([]int, 0, len(markers))
+	for shardID := range markers {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Ints(shardIDs)
+	entry := &types.DynamicConfigEntry{Name: divergedShardsConfigName}
+	for _, shardID := range shardIDs {
+		value, err := encodeMarker(shardID, markers[shardID])
+		if err != nil {
+			return nil, err
+		}
+		entry.Values = append(entry.Values, value)
+	}
+	snapshot.Values.Entries = append(snapshot.Values.Entries, entry)
+	return snapshot, nil
+}
+
+func encodeMarker(shardID int, failedAt time.Time) (*types.DynamicConfigValue, error) {
+	shardData, err := json.Marshal(shardID)
+	if err != nil {
+		return nil, err
+	}
+	failedAtData, err := json.Marshal(failedAt.UnixNano())
+	if err != nil {
+		return nil, err
+	}
+	return &types.DynamicConfigValue{
+		Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: failedAtData},
+		Filters: []*types.DynamicConfigFilter{{
+			Name:  dynamicproperties.ShardID.String(),
+			Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: shardData},
+		}},
+	}, nil
+}
+
+func decodeMarker(value *types.DynamicConfigValue) (int, time.Time, error) {
+	if value.Value == nil || len(value.Filters) != 1 || value.Filters[0].Name != dynamicproperties.ShardID.String() {
+		return 0, time.Time{}, fmt.Errorf("invalid persistence migration divergence marker %v", value)
+	}
+	var shardID int
+	if err := json.Unmarshal(value.Filters[0].Value.GetData(), &shardID); err != nil {
+		return 0, time.Time{}, err
+	}
+	var failedAt int64
+	if err := json.Unmarshal(value.Value.GetData(), &failedAt); err != nil {
+		return 0, time.Time{}, err
+	}
+	return shardID, time.Unix(0, failedAt), nil
+}
@@ -0,0 +1,497 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestParseMode(t *testing.T) {
+	for _, mode := range Modes {
+		parsed, err := ParseMode(string(mode))
+		assert.NoError(t, err)
+		assert.Equal(t, mode, parsed)
+	}
+
+	parsed, err := ParseMode("invalid")
+	assert.Error(t, err)
+	assert.Equal(t, ModeDisabled, parsed)
+}
+
+func TestShardManager(t *testing.T) {
+	getResponse := &persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 10}}
+	getRequest := &persistence.GetShardRequest{ShardID: 1}
+	updateRequest := &persistence.UpdateShardRequest{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 11}, PreviousRangeID: 10}
+
+	tests := []struct {
+		name            string
+		mode            Mode
+		prepareMocks    func(primary, secondary *persistence.MockShardManager)
+		wantGetErr      error
+		wantUpdateErr   error
+		wantCounters    map[string]int64
+		wantGetResponse *persistence.GetShardResponse
+	}{
+		{
+			name: "disabled",
+			mode: ModeDisabled,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+			},
+			wantGetResponse: getResponse,
+		},
+		{
+			name: "invalid mode is treated as disabled",
+			mode: Mode("invalid"),
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+			},
+			wantGetResponse: getResponse,
+			wantCounters:    map[string]int64{"persistence_migration_invalid_mode": 2},
+		},
+		{
+			name: "dual write",
+			mode: ModeDualWrite,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				gomock.InOrder(
+					primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil),
+					secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil),
+				)
+			},
+			wantGetResponse: getResponse,
+		},
+		{
+			name: "dual write, follower write failure is not returned",
+			mode: ModeDualWrite,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+				secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(errors.New("follower failure"))
+			},
+			wantGetResponse: getResponse,
+			wantCounters:    map[string]int64{"persistence_migration_follower_write_failures": 1},
+		},
+		{
+			name: "dual write, leader write failure skips the follower",
+			mode: ModeDualWrite,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(&persistence.ShardOwnershipLostError{ShardID: 1})
+			},
+			wantGetResponse: getResponse,
+			wantUpdateErr:   &persistence.ShardOwnershipLostError{ShardID: 1},
+		},
+		{
+			name: "shadow read, same results",
+			mode: ModeShadowRead,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				secondary.EXPECT().GetShard(gomock.Any(), getRequest).Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 10}}, nil)
+				primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+				secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+			},
+			wantGetResponse: getResponse,
+		},
+		{
+			name: "shadow read, mismatch",
+			mode: ModeShadowRead,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				secondary.EXPECT().GetShard(gomock.Any(), getRequest).Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: 1, RangeID: 9}}, nil)
+				primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+				secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+			},
+			wantGetResponse: getResponse,
+			wantCounters:    map[string]int64{"persistence_migration_shadow_read_mismatches": 1},
+		},
+		{
+			name: "shadow read, missing in secondary",
+			mode: ModeShadowRead,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				secondary.EXPECT().GetShard(gomock.Any(), getRequest).Return(nil, &types.EntityNotExistsError{})
+				primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+				secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+			},
+			wantGetResponse: getResponse,
+			wantCounters:    map[string]int64{"persistence_migration_shadow_read_mismatches": 1},
+		},
+		{
+			name: "shadow read, secondary failure",
+			mode: ModeShadowRead,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				secondary.EXPECT().GetShard(gomock.Any(), getRequest).Return(nil, errors.New("secondary failure"))
+				primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+				secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+			},
+			wantGetResponse: getResponse,
+			wantCounters:    map[string]int64{"persistence_migration_shadow_read_failures": 1},
+		},
+		{
+			name: "cutover",
+			mode: ModeCutover,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				secondary.EXPECT().GetShard(gomock.Any(), getRequest).Return(getResponse, nil)
+				gomock.InOrder(
+					secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil),
+					primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil),
+				)
+			},
+			wantGetResponse: getResponse,
+		},
+		{
+			name: "secondary",
+			mode: ModeSecondary,
+			prepareMocks: func(primary, secondary *persistence.MockShardManager) {
+				secondary.EXPECT().GetShard(gomock.Any(), getRequest).Return(nil, &types.EntityNotExistsError{})
+				secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+			},
+			wantGetErr: &types.EntityNotExistsError{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			primary := persistence.NewMockShardManager(ctrl)
+			secondary := persistence.NewMockShardManager(ctrl)
+			tc.prepareMocks(primary, secondary)
+			metricScope := tally.NewTestScope("", nil)
+
+			manager := NewShardManager(
+				primary,
+				secondary,
+				dynamicproperties.GetStringPropertyFn(string(tc.mode)),
+				NewState(nil),
+				metrics.NewClient(metricScope, metrics.History, metrics.MigrationConfig{}),
+				log.NewNoop(),
+			)
+
+			resp, err := manager.GetShard(context.Background(), getRequest)
+			assert.Equal(t, tc.wantGetErr, err)
+			assert.Equal(t, tc.wantGetResponse, resp)
+			assert.Equal(t, tc.wantUpdateErr, manager.UpdateShard(context.Background(), updateRequest))
+			assert.Equal(t, tc.wantCounters, migrationCounters(metricScope))
+		})
+	}
+}
+
+func TestModeIsResolvedPerShard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mode := func(opts ...dynamicproperties.FilterOption) string {
+		filters := map[dynamicproperties.Filter]interface{}{}
+		for _, opt := range opts {
+			opt(filters)
+		}
+		if filters[dynamicproperties.ShardID] == 2 {
+			return string(ModeSecondary)
+		}
+		return string(ModeDisabled)
+	}
+
+	primary := persistence.NewMockExecutionManager(ctrl)
+	secondary := persistence.NewMockExecutionManager(ctrl)
+	primary.EXPECT().GetShardID().Return(2).AnyTimes()
+	secondary.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetCurrentExecutionResponse{RunID: "run"}, nil)
+	executionManager := NewExecutionManager(primary, secondary, mode, NewState(nil), metrics.NewNoopMetricsClient(), log.NewNoop())
+	resp, err := executionManager.GetCurrentExecution(context.Background(), &persistence.GetCurrentExecutionRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "run", resp.RunID)
+	assert.Equal(t, 2, executionManager.GetShardID())
+
+	primaryHistory := persistence.NewMockHistoryManager(ctrl)
+	secondaryHistory := persistence.NewMockHistoryManager(ctrl)
+	historyManager := NewHistoryManager(primaryHistory, secondaryHistory, mode, NewState(nil), metrics.NewNoopMetricsClient(), log.NewNoop())
+	primaryHistory.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, historyManager.DeleteHistoryBranch(context.Background(), &persistence.DeleteHistoryBranchRequest{ShardID: common.IntPtr(1)}))
+	secondaryHistory.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, historyManager.DeleteHistoryBranch(context.Background(), &persistence.DeleteHistoryBranchRequest{ShardID: common.IntPtr(2)}))
+}
+
+func TestInvalidModeKeepsLastValidMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := persistence.NewMockShardManager(ctrl)
+	secondary := persistence.NewMockShardManager(ctrl)
+	mode := string(ModeSecondary)
+	metricScope := tally.NewTestScope("", nil)
+	manager := NewShardManager(
+		primary,
+		secondary,
+		func(...dynamicproperties.FilterOption) string { return mode },
+		NewState(nil),
+		metrics.NewClient(metricScope, metrics.History, metrics.MigrationConfig{}),
+		log.NewNoop(),
+	)
+	request := &persistence.GetShardRequest{ShardID: 1}
+
+	secondary.EXPECT().GetShard(gomock.Any(), request).Return(&persistence.GetShardResponse{}, nil).Times(2)
+	_, err := manager.GetShard(context.Background(), request)
+	require.NoError(t, err)
+	mode = "secondary "
+	_, err = manager.GetShard(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"persistence_migration_invalid_mode": 1}, migrationCounters(metricScope))
+
+	// the last valid mode is per shard
+	primary.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&persistence.GetShardResponse{}, nil)
+	_, err = manager.GetShard(context.Background(), &persistence.GetShardRequest{ShardID: 2})
+	require.NoError(t, err)
+}
+
+func TestFollowerWriteFailureBlocksCutover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := persistence.NewMockShardManager(ctrl)
+	secondary := persistence.NewMockShardManager(ctrl)
+	mode := string(ModeDualWrite)
+	metricScope := tally.NewTestScope("", nil)
+	state := NewState(nil)
+	manager := NewShardManager(
+		primary,
+		secondary,
+		func(...dynamicproperties.FilterOption) string { return mode },
+		state,
+		metrics.NewClient(metricScope, metrics.History, metrics.MigrationConfig{}),
+		log.NewNoop(),
+	)
+	updateRequest := &persistence.UpdateShardRequest{ShardInfo: &persistence.ShardInfo{ShardID: 1}}
+	getRequest := &persistence.GetShardRequest{ShardID: 1}
+
+	primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+	secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(errors.New("follower failure"))
+	require.NoError(t, manager.UpdateShard(context.Background(), updateRequest))
+
+	// the shard is kept in shadow-read, reads are still served by the primary store
+	mode = string(ModeCutover)
+	primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(&persistence.GetShardResponse{}, nil).Times(2)
+	secondary.EXPECT().GetShard(gomock.Any(), getRequest).Return(&persistence.GetShardResponse{}, nil).Times(2)
+	_, err := manager.GetShard(context.Background(), getRequest)
+	require.NoError(t, err)
+	mode = string(ModeSecondary)
+	_, err = manager.GetShard(context.Background(), getRequest)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{
+		"persistence_migration_follower_write_failures": 1,
+		"persistence_migration_cutover_blocked":         2,
+	}, migrationCounters(metricScope))
+
+	// other shards are not blocked
+	secondary.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 2}).Return(&persistence.GetShardResponse{}, nil)
+	_, err = manager.GetShard(context.Background(), &persistence.GetShardRequest{ShardID: 2})
+	require.NoError(t, err)
+
+	// the other managers of the shard share the state, their writes are still led by the primary store
+	primaryHistory := persistence.NewMockHistoryManager(ctrl)
+	secondaryHistory := persistence.NewMockHistoryManager(ctrl)
+	historyManager := NewHistoryManager(primaryHistory, secondaryHistory, func(...dynamicproperties.FilterOption) string { return mode }, state, metrics.NewNoopMetricsClient(), log.NewNoop())
+	gomock.InOrder(
+		primaryHistory.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(nil),
+		secondaryHistory.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(nil),
+	)
+	require.NoError(t, historyManager.DeleteHistoryBranch(context.Background(), &persistence.DeleteHistoryBranchRequest{ShardID: common.IntPtr(1)}))
+}
+
+func TestReadOperations(t *testing.T) {
+	managers := []reflect.Type{
+		reflect.TypeOf((*persistence.DomainManager)(nil)).Elem(),
+		reflect.TypeOf((*persistence.ExecutionManager)(nil)).Elem(),
+		reflect.TypeOf((*persistence.HistoryManager)(nil)).Elem(),
+		reflect.TypeOf((*persistence.ShardManager)(nil)).Elem(),
+		reflect.TypeOf((*persistence.TaskManager)(nil)).Elem(),
+	}
+	operations := map[string]bool{}
+	for _, manager := range managers {
+		for i := 0; i < manager.NumMethod(); i++ {
+			operations[manager.Name()+"."+manager.Method(i).Name] = true
+		}
+	}
+	for operation := range readOperations {
+		assert.True(t, operations[operation], "%v is not a persistence operation", operation)
+	}
+	// operations looking like reads must be listed, the other ones are written to both stores
+	for operation := range operations {
+		method := operation[strings.LastIndex(operation, ".")+1:]
+		for _, prefix := range []string{"Get", "List", "Read", "Is", "Fetch"} {
+			if _, ok := readOperations[operation]; !ok && strings.HasPrefix(method, prefix) && method != "GetName" && method != "GetShardID" {
+				t.Errorf("%v is not listed as a read operation", operation)
+			}
+		}
+	}
+}
+
+func TestForkHistoryBranchUsesSameBranchID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := persistence.NewMockHistoryManager(ctrl)
+	secondary := persistence.NewMockHistoryManager(ctrl)
+	manager := NewHistoryManager(primary, secondary, dynamicproperties.GetStringPropertyFn(string(ModeDualWrite)), NewState(nil), metrics.NewNoopMetricsClient(), log.NewNoop())
+
+	var branchIDs []string
+	captureBranchID := func(_ context.Context, request *persistence.ForkHistoryBranchRequest) (*persistence.ForkHistoryBranchResponse, error) {
+		branchIDs = append(branchIDs, request.NewBranchID)
+		return &persistence.ForkHistoryBranchResponse{}, nil
+	}
+	primary.EXPECT().ForkHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(captureBranchID)
+	secondary.EXPECT().ForkHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(captureBranchID)
+
+	_, err := manager.ForkHistoryBranch(context.Background(), &persistence.ForkHistoryBranchRequest{ShardID: common.IntPtr(1)})
+	require.NoError(t, err)
+	require.Len(t, branchIDs, 2)
+	assert.NotEmpty(t, branchIDs[0])
+	assert.Equal(t, branchIDs[0], branchIDs[1])
+}
+
+func TestCloseClosesBothStores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := persistence.NewMockDomainManager(ctrl)
+	secondary := persistence.NewMockDomainManager(ctrl)
+	primary.EXPECT().Close()
+	secondary.EXPECT().Close()
+	primary.EXPECT().GetName().Return("cassandra")
+
+	manager := NewDomainManager(primary, secondary, dynamicproperties.GetStringPropertyFn(string(ModeDisabled)), NewState(nil), metrics.NewNoopMetricsClient(), log.NewNoop())
+	assert.Equal(t, "cassandra", manager.GetName())
+	manager.Close()
+}
+
+func TestDivergenceMarkers(t *testing.T) {
+	ctx := context.Background()
+	configStore := &fakeConfigStore{}
+	markers := NewDivergenceMarkers(configStore)
+	failedAt := time.Unix(1000, 0)
+
+	require.NoError(t, markers.Set(ctx, 1, failedAt))
+	require.NoError(t, markers.Set(ctx, 2, failedAt))
+	// an older failure doesn't replace the last one
+	require.NoError(t, markers.Set(ctx, 1, failedAt.Add(-time.Minute)))
+	diverged, err := markers.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]time.Time{1: failedAt, 2: failedAt}, diverged)
+	assert.Equal(t, int64(2), configStore.snapshot.Version)
+
+	// a backfill which started before the last failed write keeps the marker
+	cleared, err := markers.Clear(ctx, 1, failedAt)
+	require.NoError(t, err)
+	assert.False(t, cleared)
+	cleared, err = markers.Clear(ctx, 1, failedAt.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, cleared)
+	cleared, err = markers.Clear(ctx, 3, failedAt)
+	require.NoError(t, err)
+	assert.True(t, cleared)
+	diverged, err = markers.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]time.Time{2: failedAt}, diverged)
+
+	// a concurrent update of the config is retried
+	configStore.conflicts = 1
+	require.NoError(t, markers.Set(ctx, 3, failedAt))
+	diverged, err = markers.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]time.Time{2: failedAt, 3: failedAt}, diverged)
+}
+
+func TestRecordedDivergenceBlocksCutoverAfterRestart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := persistence.NewMockShardManager(ctrl)
+	secondary := persistence.NewMockShardManager(ctrl)
+	configStore := &fakeConfigStore{}
+	newConfigStore := func() (persistence.ConfigStoreManager, error) { return configStore, nil }
+	mode := string(ModeDualWrite)
+	modeFn := func(...dynamicproperties.FilterOption) string { return mode }
+	updateRequest := &persistence.UpdateShardRequest{ShardInfo: &persistence.ShardInfo{ShardID: 1}}
+	getRequest := &persistence.GetShardRequest{ShardID: 1}
+
+	manager := NewShardManager(primary, secondary, modeFn, NewState(newConfigStore), metrics.NewNoopMetricsClient(), log.NewNoop())
+	primary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(nil)
+	secondary.EXPECT().UpdateShard(gomock.Any(), updateRequest).Return(errors.New("follower failure"))
+	require.NoError(t, manager.UpdateShard(context.Background(), updateRequest))
+
+	// a restarted host reads the marker and keeps the shard in shadow-read
+	mode = string(ModeCutover)
+	manager = NewShardManager(primary, secondary, modeFn, NewState(newConfigStore), metrics.NewNoopMetricsClient(), log.NewNoop())
+	primary.EXPECT().GetShard(gomock.Any(), getRequest).Return(&persistence.GetShardResponse{}, nil)
+	secondary.EXPECT().GetShard(gomock.Any(), getRequest).Return(&persistence.GetShardResponse{}, nil)
+	_, err := manager.GetShard(context.Background(), getRequest)
+	require.NoError(t, err)
+
+	// once the backfill cleared the marker, the shard is switched to cutover
+	cleared, err := NewDivergenceMarkers(configStore).Clear(context.Background(), 1, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, cleared)
+	manager = NewShardManager(primary, secondary, modeFn, NewState(newConfigStore), metrics.NewNoopMetricsClient(), log.NewNoop())
+	secondary.EXPECT().GetShard(gomock.Any(), getRequest).Return(&persistence.GetShardResponse{}, nil)
+	_, err = manager.GetShard(context.Background(), getRequest)
+	require.NoError(t, err)
+}
+
+// fakeConfigStore keeps the latest snapshot of the config, conflicts is the number of updates failing the version check
+type fakeConfigStore struct {
+	persistence.ConfigStoreManager
+	snapshot  *persistence.DynamicConfigSnapshot
+	conflicts int
+}
+
+func (s *fakeConfigStore) FetchDynamicConfig(_ context.Context, cfgType persistence.ConfigType) (*persistence.FetchDynamicConfigResponse, error) {
+	if cfgType != persistence.PersistenceMigrationConfig || s.snapshot == nil {
+		return nil, nil
+	}
+	return &persistence.FetchDynamicConfigResponse{Snapshot: s.snapshot}, nil
+}
+
+func (s *fakeConfigStore) UpdateDynamicConfig(_ context.Context, request *persistence.UpdateDynamicConfigRequest, _ persistence.ConfigType) error {
+	if s.conflicts > 0 || (s.snapshot != nil && request.Snapshot.Version <= s.snapshot.Version) {
+		s.conflicts--
+		return &persistence.ConditionFailedError{}
+	}
+	s.snapshot = request.Snapshot
+	return nil
+}
+
+func migrationCounters(scope tally.TestScope) map[string]int64 {
+	var counters map[string]int64
+	for _, counter := range scope.Snapshot().Counters() {
+		if counters == nil {
+			counters = map[string]int64{}
+		}
+		counters[counter.Name()] += counter.Value()
+	}
+	return counters
+}
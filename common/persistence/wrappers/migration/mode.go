@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"fmt"
+)
+
+// Mode defines how persistence calls are routed between the primary store (persistence.defaultStore)
+// and the secondary store (persistence.migrationStore) during a migration
+type Mode string
+
+const (
+	// ModeDisabled only uses the primary store
+	ModeDisabled Mode = "disabled"
+	// ModeDualWrite writes to the primary store then to the secondary store, reads are served by the primary store
+	ModeDualWrite Mode = "dual-write"
+	// ModeShadowRead is ModeDualWrite with reads also sent to the secondary store and compared with the primary results
+	ModeShadowRead Mode = "shadow-read"
+	// ModeCutover writes to the secondary store then to the primary store, reads are served by the secondary store
+	ModeCutover Mode = "cutover"
+	// ModeSecondary only uses the secondary store
+	ModeSecondary Mode = "secondary"
+)
+
+// Modes lists all modes in the order a migration goes through them
+var Modes = []Mode{
+	ModeDisabled,
+	ModeDualWrite,
+	ModeShadowRead,
+	ModeCutover,
+	ModeSecondary,
+}
+
+// ParseMode converts a dynamic config value to a Mode
+func ParseMode(value string) (Mode, error) {
+	for _, mode := range Modes {
+		if string(mode) == value {
+			return mode, nil
+		}
+	}
+	return ModeDisabled, fmt.Errorf("unknown persistence migration mode %q, valid modes are %v", value, Modes)
+}
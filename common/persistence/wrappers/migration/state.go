@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+// noShardID is the key of the calls which are not tied to a shard, e.g. the domain and task list calls
+const noShardID = -1
+
+type (
+	// State is shared by the migration wrappers of a service. It keeps the last valid mode of each shard,
+	// and the shards for which a write to the secondary store failed while it was following the primary one.
+	// These shards may be missing data in the secondary store, so they are not switched to a mode reading
+	// from it. They are recorded in the config store of the default store, and stay blocked until the backfill
+	// verified their executions again. A shard whose marker can't be recorded stays blocked on the host until
+	// the service is restarted.
+	State struct {
+		sync.RWMutex
+		modes         map[int]Mode
+		invalidValues map[int]string
+
+		markersLock    sync.Mutex
+		newConfigStore func() (persistence.ConfigStoreManager, error)
+		markers        *DivergenceMarkers
+		// divergedShards maps a shard to the time of its last failed write known by the host
+		divergedShards    map[int]time.Time
+		unrecordedShards  map[int]struct{}
+		markersLoadedAt   time.Time
+		blockLoggedShards map[int]struct{}
+	}
+)
+
+// NewState creates the state of the migration wrappers of a service, the divergence markers are kept in
+// the config store returned by newConfigStore, or only in memory when it's nil
+func NewState(newConfigStore func() (persistence.ConfigStoreManager, error)) *State {
+	return &State{
+		modes:             make(map[int]Mode),
+		invalidValues:     make(map[int]string),
+		newConfigStore:    newConfigStore,
+		divergedShards:    make(map[int]time.Time),
+		unrecordedShards:  make(map[int]struct{}),
+		blockLoggedShards: make(map[int]struct{}),
+	}
+}
+// setMode records the last valid mode of a shard
+func (s *State) setMode(shardID int, mode Mode) {
+	s.RLock()
+	current, ok := s.modes[shardID]
+	_, invalid := s.invalidValues[shardID]
+	s.RUnlock()
+	if ok && current == mode && !invalid {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.modes[shardID] = mode
+	delete(s.invalidValues, shardID)
+}
+
+// setInvalidValue returns the last valid mode of a shard, and whether the invalid value is a new one
+func (s *State) setInvalidValue(shardID int, value string) (Mode, bool) {
+	s.Lock()
+	defer s.Unlock()
+	mode, ok := s.modes[shardID]
+	if !ok {
+		mode = ModeDisabled
+	}
+	isNew := s.invalidValues[shardID] != value
+	s.invalidValues[shardID] = value
+	return mode, isNew
+}
+
+// setDiverged records that a write to the secondary store of a shard failed
+func (s *State) setDiverged(ctx context.Context, shardID int) error {
+	now := time.Now()
+	s.Lock()
+	if last, ok := s.divergedShards[shardID]; ok && now.Sub(last) < markerRefreshInterval {
+		s.Unlock()
+		return nil
+	}
+	s.divergedShards[shardID] = now
+	s.Unlock()
+
+	markers, err := s.getMarkers()
+	if err == nil && markers != nil {
+		err = markers.Set(ctx, shardID, now)
+	}
+	if err != nil || markers == nil {
+		s.Lock()
+		s.unrecordedShards[shardID] = struct{}{}
+		s.Unlock()
+	}
+	return err
+}
+
+// isDiverged returns whether the secondary store of a shard may be missing data, and whether it is the first
+// time it is asked since the shard diverged
+func (s *State) isDiverged(ctx context.Context, shardID int) (diverged bool, first bool, err error) {
+	err = s.refreshMarkers(ctx)
+
+	s.RLock()
+	_, diverged = s.divergedShards[shardID]
+	_, unrecorded := s.unrecordedShards[shardID]
+	_, logged := s.blockLoggedShards[shardID]
+	s.RUnlock()
+	diverged = diverged || unrecorded
+	if !diverged || logged {
+		return diverged, false, err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	_, logged = s.blockLoggedShards[shardID]
+	s.blockLoggedShards[shardID] = struct{}{}
+	return true, !logged, err
+}
+
+// refreshMarkers reloads the divergence markers from the config store once they are older than markerRefreshInterval
+func (s *State) refreshMarkers(ctx context.Context) error {
+	s.Lock()
+	if s.newConfigStore == nil || time.Since(s.markersLoadedAt) < markerRefreshInterval {
+		s.Unlock()
+		return nil
+	}
+	loadStartedAt := time.Now()
+	s.markersLoadedAt = loadStartedAt
+	s.Unlock()
+
+	markers, err := s.getMarkers()
+	if err != nil {
+		return err
+	}
+	divergedShards, err := markers.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	// keep the failed writes recorded while the markers were loaded
+	for shardID, failedAt := range s.divergedShards {
+		if failedAt.After(loadStartedAt) && failedAt.After(divergedShards[shardID]) {
+			divergedShards[shardID] = failedAt
+		}
+	}
+	s.divergedShards = divergedShards
+	for shardID := range s.blockLoggedShards {
+		_, diverged := s.divergedShards[shardID]
+		if _, unrecorded := s.unrecordedShards[shardID]; !diverged && !unrecorded {
+			delete(s.blockLoggedShards, shardID)
+		}
+	}
+	return nil
+}
+
+// getMarkers returns the divergence markers, or nil when they are only kept in memory
+func (s *State) getMarkers() (*DivergenceMarkers, error) {
+	s.markersLock.Lock()
+	defer s.markersLock.Unlock()
+	if s.markers != nil || s.newConfigStore == nil {
+		return s.markers, nil
+	}
+	configStore, err := s.newConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	s.markers = NewDivergenceMarkers(configStore)
+	return s.markers, nil
+}
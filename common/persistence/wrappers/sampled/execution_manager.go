@@ -0,0 +1,354 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sampled
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// To sample write audit records, all mutations have the same priority
+	numOfPriorityForWriteAudit = 1
+)
+
+type executionManager struct {
+	persistence  persistence.ExecutionManager
+	writer       WriteAuditWriter
+	rateLimiters RateLimiterFactory
+	enabled      dynamicproperties.BoolPropertyFnWithDomainFilter
+	hostName     string
+	timeSource   clock.TimeSource
+}
+
+type (
+	// WriteAuditConfig is config for the write audit of the execution mutations
+	WriteAuditConfig struct {
+		// EnableWriteAudit enables the write audit of a domain
+		EnableWriteAudit dynamicproperties.BoolPropertyFnWithDomainFilter `yaml:"-" json:"-"`
+		// WriteAuditMaxQPS max QPS of the mutations recorded for a domain
+		WriteAuditMaxQPS dynamicproperties.IntPropertyFnWithDomainFilter `yaml:"-" json:"-"`
+	}
+
+	WriteAuditParams struct {
+		Config                 *WriteAuditConfig
+		Writer                 WriteAuditWriter
+		HostName               string
+		TimeSource             clock.TimeSource
+		RateLimiterFactoryFunc RateLimiterFactoryFunc
+	}
+)
+
+// NewExecutionManager creates an execution manager which records a sampled audit trail of the workflow execution
+// mutations (create, update, conflict resolve and delete) of the domains with write audit enabled.
+// The mutations are recorded after they are sent to the database, along with their outcome. The records are
+// written in the background by the writer, and failing to record them does not fail the mutations.
+// The trail of a run is deleted once the run itself is deleted.
+func NewExecutionManager(persistence persistence.ExecutionManager, p WriteAuditParams) persistence.ExecutionManager {
+	return &executionManager{
+		persistence:  persistence,
+		writer:       p.Writer,
+		rateLimiters: p.RateLimiterFactoryFunc(p.TimeSource, numOfPriorityForWriteAudit, p.Config.WriteAuditMaxQPS),
+		enabled:      p.Config.EnableWriteAudit,
+		hostName:     p.HostName,
+		timeSource:   p.TimeSource,
+	}
+}
+
+func (p *executionManager) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.CreateWorkflowExecutionRequest,
+) (*persistence.CreateWorkflowExecutionResponse, error) {
+	shouldRecord := p.shouldSample(request.DomainName)
+	resp, err := p.persistence.CreateWorkflowExecution(ctx, request)
+	if shouldRecord {
+		p.record(err,
+			p.snapshotRecord("CreateWorkflowExecution", request.RangeID, int(request.Mode), &request.NewWorkflowSnapshot),
+		)
+	}
+	return resp, err
+}
+
+func (p *executionManager) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	shouldRecord := p.shouldSample(request.DomainName)
+	resp, err := p.persistence.UpdateWorkflowExecution(ctx, request)
+	if shouldRecord {
+		p.record(err,
+			p.mutationRecord("UpdateWorkflowExecution", request.RangeID, int(request.Mode), &request.UpdateWorkflowMutation),
+			p.snapshotRecord("UpdateWorkflowExecution", request.RangeID, int(request.Mode), request.NewWorkflowSnapshot),
+		)
+	}
+	return resp, err
+}
+
+func (p *executionManager) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.ConflictResolveWorkflowExecutionRequest,
+) (*persistence.ConflictResolveWorkflowExecutionResponse, error) {
+	shouldRecord := p.shouldSample(request.DomainName)
+	resp, err := p.persistence.ConflictResolveWorkflowExecution(ctx, request)
+	if shouldRecord {
+		p.record(err,
+			p.snapshotRecord("ConflictResolveWorkflowExecution", request.RangeID, int(request.Mode), &request.ResetWorkflowSnapshot),
+			p.snapshotRecord("ConflictResolveWorkflowExecution", request.RangeID, int(request.Mode), request.NewWorkflowSnapshot),
+			p.mutationRecord("ConflictResolveWorkflowExecution", request.RangeID, int(request.Mode), request.CurrentWorkflowMutation),
+		)
+	}
+	return resp, err
+}
+
+func (p *executionManager) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteWorkflowExecutionRequest,
+) error {
+	shouldRecord := p.shouldSample(request.DomainName)
+	err := p.persistence.DeleteWorkflowExecution(ctx, request)
+	if err == nil {
+		// the run is deleted by retention, its trail is deleted along with it whether the write audit
+		// of the domain is still enabled or not
+		p.writer.Delete(request.DomainID, request.WorkflowID, request.RunID)
+		return nil
+	}
+	if shouldRecord {
+		p.record(err, p.newRecord("DeleteWorkflowExecution", request.DomainID, request.WorkflowID, request.RunID))
+	}
+	return err
+}
+
+func (p *executionManager) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	shouldRecord := p.shouldSample(request.DomainName)
+	err := p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
+	if shouldRecord {
+		p.record(err, p.newRecord("DeleteCurrentWorkflowExecution", request.DomainID, request.WorkflowID, request.RunID))
+	}
+	return err
+}
+
+func (p *executionManager) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *executionManager) GetShardID() int {
+	return p.persistence.GetShardID()
+}
+
+func (p *executionManager) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.GetWorkflowExecutionResponse, error) {
+	return p.persistence.GetWorkflowExecution(ctx, request)
+}
+
+func (p *executionManager) GetCurrentExecution(
+	ctx context.Context,
+	request *persistence.GetCurrentExecutionRequest,
+) (*persistence.GetCurrentExecutionResponse, error) {
+	return p.persistence.GetCurrentExecution(ctx, request)
+}
+
+func (p *executionManager) IsWorkflowExecutionExists(
+	ctx context.Context,
+	request *persistence.IsWorkflowExecutionExistsRequest,
+) (*persistence.IsWorkflowExecutionExistsResponse, error) {
+	return p.persistence.IsWorkflowExecutionExists(ctx, request)
+}
+
+func (p *executionManager) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *persistence.PutReplicationTaskToDLQRequest,
+) error {
+	return p.persistence.PutReplicationTaskToDLQ(ctx, request)
+}
+
+func (p *executionManager) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (*persistence.GetReplicationDLQTasksResponse, error) {
+	return p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (p *executionManager) GetReplicationDLQSize(
+	ctx context.Context,
+	request *persistence.GetReplicationDLQSizeRequest,
+) (*persistence.GetReplicationDLQSizeResponse, error) {
+	return p.persistence.GetReplicationDLQSize(ctx, request)
+}
+
+func (p *executionManager) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.DeleteReplicationTaskFromDLQRequest,
+) error {
+	return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (p *executionManager) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
+) (*persistence.RangeDeleteReplicationTaskFromDLQResponse, error) {
+	return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (p *executionManager) CreateFailoverMarkerTasks(
+	ctx context.Context,
+	request *persistence.CreateFailoverMarkersRequest,
+) error {
+	return p.persistence.CreateFailoverMarkerTasks(ctx, request)
+}
+
+func (p *executionManager) CreateHistoryTasks(
+	ctx context.Context,
+	request *persistence.CreateHistoryTasksRequest,
+) error {
+	return p.persistence.CreateHistoryTasks(ctx, request)
+}
+
+func (p *executionManager) GetHistoryTasks(
+	ctx context.Context,
+	request *persistence.GetHistoryTasksRequest,
+) (*persistence.GetHistoryTasksResponse, error) {
+	return p.persistence.GetHistoryTasks(ctx, request)
+}
+
+func (p *executionManager) CompleteHistoryTask(
+	ctx context.Context,
+	request *persistence.CompleteHistoryTaskRequest,
+) error {
+	return p.persistence.CompleteHistoryTask(ctx, request)
+}
+
+func (p *executionManager) RangeCompleteHistoryTask(
+	ctx context.Context,
+	request *persistence.RangeCompleteHistoryTaskRequest,
+) (*persistence.RangeCompleteHistoryTaskResponse, error) {
+	return p.persistence.RangeCompleteHistoryTask(ctx, request)
+}
+
+func (p *executionManager) FetchWorkflowTimerTasksForCleanup(
+	ctx context.Context,
+	request *persistence.FetchWorkflowTimerTasksForCleanupRequest,
+) ([]persistence.HistoryTaskKey, error) {
+	return p.persistence.FetchWorkflowTimerTasksForCleanup(ctx, request)
+}
+
+func (p *executionManager) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.ListConcreteExecutionsResponse, error) {
+	return p.persistence.ListConcreteExecutions(ctx, request)
+}
+
+func (p *executionManager) ListCurrentExecutions(
+	ctx context.Context,
+	request *persistence.ListCurrentExecutionsRequest,
+) (*persistence.ListCurrentExecutionsResponse, error) {
+	return p.persistence.ListCurrentExecutions(ctx, request)
+}
+
+func (p *executionManager) GetActiveClusterSelectionPolicy(
+	ctx context.Context,
+	request *persistence.GetActiveClusterSelectionPolicyRequest,
+) (*types.ActiveClusterSelectionPolicy, error) {
+	return p.persistence.GetActiveClusterSelectionPolicy(ctx, request)
+}
+
+func (p *executionManager) DeleteActiveClusterSelectionPolicy(
+	ctx context.Context,
+	request *persistence.DeleteActiveClusterSelectionPolicyRequest,
+) error {
+	return p.persistence.DeleteActiveClusterSelectionPolicy(ctx, request)
+}
+
+func (p *executionManager) Close() {
+	p.persistence.Close()
+}
+
+func (p *executionManager) shouldSample(domainName string) bool {
+	if !p.enabled(domainName) {
+		return false
+	}
+	ok, _ := p.rateLimiters.GetRateLimiter(domainName).GetToken(0, 1)
+	return ok
+}
+
+func (p *executionManager) newRecord(operation, domainID, workflowID, runID string) *WriteAuditRecord {
+	return &WriteAuditRecord{
+		Timestamp:  p.timeSource.Now(),
+		Host:       p.hostName,
+		Operation:  operation,
+		ShardID:    p.persistence.GetShardID(),
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+}
+
+func (p *executionManager) snapshotRecord(operation string, rangeID int64, mode int, snapshot *persistence.WorkflowSnapshot) *WriteAuditRecord {
+	if snapshot == nil || snapshot.ExecutionInfo == nil {
+		return nil
+	}
+	return p.executionRecord(operation, rangeID, mode, snapshot.ExecutionInfo, snapshot.Condition)
+}
+
+func (p *executionManager) mutationRecord(operation string, rangeID int64, mode int, mutation *persistence.WorkflowMutation) *WriteAuditRecord {
+	if mutation == nil || mutation.ExecutionInfo == nil {
+		return nil
+	}
+	return p.executionRecord(operation, rangeID, mode, mutation.ExecutionInfo, mutation.Condition)
+}
+
+func (p *executionManager) executionRecord(
+	operation string,
+	rangeID int64,
+	mode int,
+	info *persistence.WorkflowExecutionInfo,
+	condition int64,
+) *WriteAuditRecord {
+	record := p.newRecord(operation, info.DomainID, info.WorkflowID, info.RunID)
+	record.RangeID = rangeID
+	record.Mode = mode
+	record.State = info.State
+	record.NextEventID = info.NextEventID
+	record.Condition = condition
+	return record
+}
+
+// record queues the records of the workflow runs of a mutation to be appended to their write audit trail
+func (p *executionManager) record(mutationErr error, records ...*WriteAuditRecord) {
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+		if mutationErr != nil {
+			record.Error = mutationErr.Error()
+		}
+		p.writer.Write(record)
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sampled
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tokenbucket"
+)
+
+// writeAuditWriterStub writes the records synchronously
+type writeAuditWriterStub struct {
+	records []*WriteAuditRecord
+	deleted []string
+}
+
+func (w *writeAuditWriterStub) Start() {}
+
+func (w *writeAuditWriterStub) Stop() {}
+
+func (w *writeAuditWriterStub) Write(record *WriteAuditRecord) {
+	w.records = append(w.records, record)
+}
+
+func (w *writeAuditWriterStub) Delete(domainID, workflowID, runID string) {
+	w.deleted = append(w.deleted, WriteAuditKeyPrefix(domainID, workflowID, runID))
+}
+
+func newTestExecutionManager(t *testing.T, tokens int) (*persistence.MockExecutionManager, *writeAuditWriterStub, clock.MockedTimeSource, persistence.ExecutionManager) {
+	ctrl := gomock.NewController(t)
+	mockedManager := persistence.NewMockExecutionManager(ctrl)
+	mockedManager.EXPECT().GetShardID().Return(7).AnyTimes()
+	writer := &writeAuditWriterStub{}
+	timeSource := clock.NewMockedTimeSource()
+	m := NewExecutionManager(mockedManager, WriteAuditParams{
+		Config: &WriteAuditConfig{
+			EnableWriteAudit: func(domain string) bool { return domain == "audited-domain" },
+			WriteAuditMaxQPS: dynamicproperties.GetIntPropertyFilteredByDomain(1),
+		},
+		Writer:     writer,
+		HostName:   "host-a",
+		TimeSource: timeSource,
+		RateLimiterFactoryFunc: rateLimiterStubFunc(map[string]tokenbucket.PriorityTokenBucket{
+			"audited-domain": &tokenBucketFactoryStub{tokens: map[int]int{0: tokens}},
+		}),
+	})
+	return mockedManager, writer, timeSource, m
+}
+
+func TestExecutionManagerWriteAudit(t *testing.T) {
+	t.Run("update with a new run", func(t *testing.T) {
+		mockedManager, writer, timeSource, m := newTestExecutionManager(t, 1)
+		request := &persistence.UpdateWorkflowExecutionRequest{
+			RangeID:    10,
+			Mode:       persistence.UpdateWorkflowModeUpdateCurrent,
+			DomainName: "audited-domain",
+			UpdateWorkflowMutation: persistence.WorkflowMutation{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:    "domain-id",
+					WorkflowID:  "wid",
+					RunID:       "rid",
+					NextEventID: 12,
+					State:       persistence.WorkflowStateCompleted,
+				},
+				Condition: 11,
+			},
+			NewWorkflowSnapshot: &persistence.WorkflowSnapshot{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:    "domain-id",
+					WorkflowID:  "wid",
+					RunID:       "new-rid",
+					NextEventID: 3,
+					State:       persistence.WorkflowStateCreated,
+				},
+			},
+		}
+		mockedManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+
+		_, err := m.UpdateWorkflowExecution(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, []*WriteAuditRecord{
+			{
+				Timestamp:   timeSource.Now(),
+				Host:        "host-a",
+				Operation:   "UpdateWorkflowExecution",
+				ShardID:     7,
+				RangeID:     10,
+				DomainID:    "domain-id",
+				WorkflowID:  "wid",
+				RunID:       "rid",
+				Mode:        int(persistence.UpdateWorkflowModeUpdateCurrent),
+				State:       persistence.WorkflowStateCompleted,
+				NextEventID: 12,
+				Condition:   11,
+			},
+			{
+				Timestamp:   timeSource.Now(),
+				Host:        "host-a",
+				Operation:   "UpdateWorkflowExecution",
+				ShardID:     7,
+				RangeID:     10,
+				DomainID:    "domain-id",
+				WorkflowID:  "wid",
+				RunID:       "new-rid",
+				Mode:        int(persistence.UpdateWorkflowModeUpdateCurrent),
+				State:       persistence.WorkflowStateCreated,
+				NextEventID: 3,
+			},
+		}, writer.records)
+	})
+
+	t.Run("failed create is recorded with its error", func(t *testing.T) {
+		mockedManager, writer, _, m := newTestExecutionManager(t, 1)
+		dbErr := &persistence.ShardOwnershipLostError{ShardID: 7, Msg: "range changed"}
+		mockedManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, dbErr)
+
+		_, err := m.CreateWorkflowExecution(context.Background(), &persistence.CreateWorkflowExecutionRequest{
+			RangeID:    9,
+			DomainName: "audited-domain",
+			NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", NextEventID: 3},
+			},
+		})
+		assert.Equal(t, dbErr, err)
+		require.Len(t, writer.records, 1)
+		assert.Equal(t, "CreateWorkflowExecution", writer.records[0].Operation)
+		assert.Equal(t, int64(9), writer.records[0].RangeID)
+		assert.Equal(t, dbErr.Error(), writer.records[0].Error)
+	})
+
+	t.Run("delete removes the trail", func(t *testing.T) {
+		mockedManager, writer, _, m := newTestExecutionManager(t, 1)
+		mockedManager.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		err := m.DeleteWorkflowExecution(context.Background(), &persistence.DeleteWorkflowExecutionRequest{
+			DomainID:   "domain-id",
+			WorkflowID: "wid",
+			RunID:      "rid",
+			DomainName: "audited-domain",
+		})
+		require.NoError(t, err)
+		err = m.DeleteWorkflowExecution(context.Background(), &persistence.DeleteWorkflowExecutionRequest{
+			DomainID:   "domain-id",
+			WorkflowID: "wid",
+			RunID:      "other-rid",
+			DomainName: "other-domain",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, writer.records)
+		assert.Equal(t, []string{
+			WriteAuditKeyPrefix("domain-id", "wid", "rid"),
+			WriteAuditKeyPrefix("domain-id", "wid", "other-rid"),
+		}, writer.deleted, "the trail is deleted even when the write audit of the domain is disabled")
+	})
+
+	t.Run("failed delete is recorded", func(t *testing.T) {
+		mockedManager, writer, _, m := newTestExecutionManager(t, 1)
+		mockedManager.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		err := m.DeleteWorkflowExecution(context.Background(), &persistence.DeleteWorkflowExecutionRequest{
+			DomainID:   "domain-id",
+			WorkflowID: "wid",
+			RunID:      "rid",
+			DomainName: "audited-domain",
+		})
+		assert.Equal(t, assert.AnError, err)
+		require.Len(t, writer.records, 1)
+		assert.Equal(t, "DeleteWorkflowExecution", writer.records[0].Operation)
+		assert.Equal(t, "rid", writer.records[0].RunID)
+		assert.Empty(t, writer.deleted)
+	})
+
+	t.Run("domain not audited", func(t *testing.T) {
+		mockedManager, writer, _, m := newTestExecutionManager(t, 1)
+		mockedManager.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+
+		err := m.DeleteCurrentWorkflowExecution(context.Background(), &persistence.DeleteCurrentWorkflowExecutionRequest{DomainName: "other-domain"})
+		require.NoError(t, err)
+		assert.Empty(t, writer.records)
+	})
+
+	t.Run("sampled out", func(t *testing.T) {
+		mockedManager, writer, _, m := newTestExecutionManager(t, 1)
+		mockedManager.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		request := &persistence.DeleteCurrentWorkflowExecutionRequest{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", DomainName: "audited-domain"}
+		require.NoError(t, m.DeleteCurrentWorkflowExecution(context.Background(), request))
+		require.NoError(t, m.DeleteCurrentWorkflowExecution(context.Background(), request))
+		assert.Len(t, writer.records, 1, "second call should not be recorded as the domain is out of tokens")
+	})
+}
+
+func TestExecutionManagerPassThrough(t *testing.T) {
+	mockedManager, writer, _, m := newTestExecutionManager(t, 1)
+	mockedManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{}, nil)
+	mockedManager.EXPECT().GetName().Return("test")
+
+	_, err := m.GetWorkflowExecution(context.Background(), &persistence.GetWorkflowExecutionRequest{DomainName: "audited-domain"})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", m.GetName())
+	assert.Equal(t, 7, m.GetShardID())
+	assert.Empty(t, writer.records)
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sampled
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+)
+
+type (
+	// WriteAuditRecord is a compact record of a workflow execution mutation sent to the database
+	WriteAuditRecord struct {
+		Timestamp   time.Time `json:"timestamp"`
+		Host        string    `json:"host"`
+		Operation   string    `json:"operation"`
+		ShardID     int       `json:"shardID"`
+		RangeID     int64     `json:"rangeID,omitempty"`
+		DomainID    string    `json:"domainID"`
+		WorkflowID  string    `json:"workflowID"`
+		RunID       string    `json:"runID"`
+		Mode        int       `json:"mode"`
+		State       int       `json:"state,omitempty"`
+		NextEventID int64     `json:"nextEventID,omitempty"`
+		Condition   int64     `json:"condition,omitempty"`
+		// Error is the error returned by the database, empty when the mutation succeeded
+		Error string `json:"error,omitempty"`
+	}
+
+	// WriteAuditStore stores the write audit trail of the workflow runs
+	WriteAuditStore interface {
+		Append(ctx context.Context, records []*WriteAuditRecord) error
+		Get(ctx context.Context, domainID, workflowID, runID string) ([]*WriteAuditRecord, error)
+		// Delete removes the trail of a workflow run, it is called once the run is deleted by retention
+		Delete(ctx context.Context, domainID, workflowID, runID string) error
+	}
+
+	blobstoreWriteAuditStore struct {
+		client     blobstore.Client
+		maxRecords dynamicproperties.IntPropertyFn
+		// writerID and sequence make the keys of the blobs written by this store unique across hosts
+		writerID string
+		sequence atomic.Int64
+
+		mu sync.Mutex
+		// untrimmed is the number of blobs appended to each trail since this store last trimmed it,
+		// so that the trail is listed every maxRecords appends rather than on each of them
+		untrimmed map[string]int
+	}
+)
+
+const (
+	// writeAuditMaxTrackedTrails bounds the number of trails whose appends are counted, the counts are
+	// reset above it and each trail is then trimmed on its next append
+	writeAuditMaxTrackedTrails = 10000
+)
+
+// NewBlobstoreWriteAuditStore creates a write audit store which keeps the trail of each workflow run in the blobstore,
+// bounded to the last maxRecords records.
+// Each append writes the records of a workflow run to a new blob, so records appended concurrently by different
+// hosts to the same trail are all kept. The blobs above maxRecords are deleted when the trail is next trimmed,
+// which each store does on its first append to the trail and then every maxRecords appends.
+func NewBlobstoreWriteAuditStore(client blobstore.Client, maxRecords dynamicproperties.IntPropertyFn) WriteAuditStore {
+	return &blobstoreWriteAuditStore{
+		client:     client,
+		maxRecords: maxRecords,
+		writerID:   uuid.New(),
+		untrimmed:  make(map[string]int),
+	}
+}
+
+// WriteAuditKeyPrefix returns the prefix of the blobstore keys of the write audit trail of a workflow run
+func WriteAuditKeyPrefix(domainID, workflowID, runID string) string {
+	return fmt.Sprintf("%v_%x_", domainID, sha256.Sum256([]byte(workflowID+"/"+runID)))
+}
+
+func (s *blobstoreWriteAuditStore) Append(ctx context.Context, records []*WriteAuditRecord) error {
+	trails := make(map[string][]*WriteAuditRecord)
+	var prefixes []string
+	for _, record := range records {
+		prefix := WriteAuditKeyPrefix(record.DomainID, record.WorkflowID, record.RunID)
+		if _, ok := trails[prefix]; !ok {
+			prefixes = append(prefixes, prefix)
+		}
+		trails[prefix] = append(trails[prefix], record)
+	}
+	for _, prefix := range prefixes {
+		if err := s.appendTrail(ctx, prefix, trails[prefix]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *blobstoreWriteAuditStore) appendTrail(ctx context.Context, prefix string, records []*WriteAuditRecord) error {
+	body, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	// keys start with the time of the first record so that listing them returns the blobs in order
+	key := fmt.Sprintf("%v%020d_%v_%d.writeaudit", prefix, records[0].Timestamp.UnixNano(), s.writerID, s.sequence.Add(1))
+	_, err = s.client.Put(ctx, &blobstore.PutRequest{
+		Key: key,
+		Blob: blobstore.Blob{
+			Tags: map[string]string{
+				"domainID":   records[0].DomainID,
+				"workflowID": records[0].WorkflowID,
+				"runID":      records[0].RunID,
+			},
+			Body: body,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// every blob holds at least one record, so keeping the last maxRecords blobs keeps the last maxRecords records
+	maxRecords := s.maxRecords()
+	if maxRecords <= 0 || !s.shouldTrim(prefix, maxRecords) {
+		return nil
+	}
+	keys, err := s.list(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	for len(keys) > maxRecords {
+		if _, err := s.client.Delete(ctx, &blobstore.DeleteRequest{Key: keys[0]}); err != nil {
+			return err
+		}
+		keys = keys[1:]
+	}
+	return nil
+}
+
+// shouldTrim counts an append to the trail and returns whether the trail is due to be trimmed
+func (s *blobstoreWriteAuditStore) shouldTrim(prefix string, maxRecords int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count, ok := s.untrimmed[prefix]
+	if !ok && len(s.untrimmed) >= writeAuditMaxTrackedTrails {
+		s.untrimmed = make(map[string]int)
+	}
+	if ok && count+1 < maxRecords {
+		s.untrimmed[prefix] = count + 1
+		return false
+	}
+	s.untrimmed[prefix] = 0
+	return true
+}
+
+func (s *blobstoreWriteAuditStore) Get(ctx context.Context, domainID, workflowID, runID string) ([]*WriteAuditRecord, error) {
+	keys, err := s.list(ctx, WriteAuditKeyPrefix(domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	var records []*WriteAuditRecord
+	for _, key := range keys {
+		resp, err := s.client.Get(ctx, &blobstore.GetRequest{Key: key})
+		if err != nil {
+			return nil, err
+		}
+		var blobRecords []*WriteAuditRecord
+		if err := json.Unmarshal(resp.Blob.Body, &blobRecords); err != nil {
+			return nil, fmt.Errorf("decoding write audit trail %v: %w", key, err)
+		}
+		records = append(records, blobRecords...)
+	}
+	// the blobs written by different hosts can overlap in time
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+	if maxRecords := s.maxRecords(); maxRecords > 0 && len(records) > maxRecords {
+		records = records[len(records)-maxRecords:]
+	}
+	return records, nil
+}
+
+func (s *blobstoreWriteAuditStore) Delete(ctx context.Context, domainID, workflowID, runID string) error {
+	prefix := WriteAuditKeyPrefix(domainID, workflowID, runID)
+	s.mu.Lock()
+	delete(s.untrimmed, prefix)
+	s.mu.Unlock()
+
+	keys, err := s.list(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if _, err := s.client.Delete(ctx, &blobstore.DeleteRequest{Key: key}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *blobstoreWriteAuditStore) list(ctx context.Context, prefix string) ([]string, error) {
+	resp, err := s.client.ListByPrefix(ctx, &blobstore.ListByPrefixRequest{Prefix: prefix})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Keys, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sampled
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+)
+
+func TestBlobstoreWriteAuditStore(t *testing.T) {
+	client, err := filestore.NewFilestoreClient(&config.FileBlobstore{OutputDirectory: t.TempDir()})
+	require.NoError(t, err)
+	store := NewBlobstoreWriteAuditStore(client, dynamicproperties.GetIntPropertyFn(3))
+	// another host writing to the same blobstore
+	otherStore := NewBlobstoreWriteAuditStore(client, dynamicproperties.GetIntPropertyFn(3))
+	ctx := context.Background()
+
+	records, err := store.Get(ctx, "domain-id", "workflow/id", "run-id")
+	require.NoError(t, err)
+	assert.Empty(t, records, "no trail before the first record")
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newRecord := func(host string, i int64) *WriteAuditRecord {
+		return &WriteAuditRecord{
+			Timestamp:   now.Add(time.Duration(i) * time.Second),
+			Host:        host,
+			Operation:   "UpdateWorkflowExecution",
+			ShardID:     1,
+			RangeID:     10,
+			DomainID:    "domain-id",
+			WorkflowID:  "workflow/id",
+			RunID:       "run-id",
+			NextEventID: i,
+		}
+	}
+	require.NoError(t, store.Append(ctx, []*WriteAuditRecord{
+		newRecord("host-a", 1),
+		{DomainID: "domain-id", WorkflowID: "workflow/id", RunID: "other-run-id"},
+		newRecord("host-a", 2),
+	}))
+	require.NoError(t, otherStore.Append(ctx, []*WriteAuditRecord{newRecord("host-b", 3)}))
+	require.NoError(t, store.Append(ctx, []*WriteAuditRecord{newRecord("host-a", 4)}))
+
+	records, err = store.Get(ctx, "domain-id", "workflow/id", "run-id")
+	require.NoError(t, err)
+	require.Len(t, records, 3, "the trail is bounded to the last records")
+	assert.Equal(t, int64(2), records[0].NextEventID)
+	assert.Equal(t, int64(3), records[1].NextEventID)
+	assert.Equal(t, "host-b", records[1].Host, "records written by other hosts are kept")
+	assert.Equal(t, int64(4), records[2].NextEventID)
+	assert.True(t, now.Add(4*time.Second).Equal(records[2].Timestamp))
+
+	records, err = store.Get(ctx, "domain-id", "workflow/id", "other-run-id")
+	require.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	listKeys := func(runID string) []string {
+		resp, err := client.ListByPrefix(ctx, &blobstore.ListByPrefixRequest{Prefix: WriteAuditKeyPrefix("domain-id", "workflow/id", runID)})
+		require.NoError(t, err)
+		return resp.Keys
+	}
+	for i := int64(5); i <= 7; i++ {
+		require.NoError(t, store.Append(ctx, []*WriteAuditRecord{newRecord("host-a", i)}))
+	}
+	assert.Len(t, listKeys("run-id"), 4, "the trail is trimmed every max records appends, not on each of them")
+	records, err = store.Get(ctx, "domain-id", "workflow/id", "run-id")
+	require.NoError(t, err)
+	require.Len(t, records, 3, "the trail is bounded to the last records until it is trimmed")
+	assert.Equal(t, int64(7), records[2].NextEventID)
+
+	for i := int64(8); i <= 9; i++ {
+		require.NoError(t, store.Append(ctx, []*WriteAuditRecord{newRecord("host-a", i)}))
+	}
+	assert.Len(t, listKeys("run-id"), 3, "the oldest blobs are deleted")
+
+	require.NoError(t, store.Delete(ctx, "domain-id", "workflow/id", "run-id"))
+	assert.Empty(t, listKeys("run-id"), "the trail of a deleted run is removed")
+	assert.Len(t, listKeys("other-run-id"), 1, "the trails of other runs are kept")
+	records, err = store.Get(ctx, "domain-id", "workflow/id", "run-id")
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestWriteAuditKeyPrefix(t *testing.T) {
+	assert.Equal(t, WriteAuditKeyPrefix("domain-id", "wid", "rid"), WriteAuditKeyPrefix("domain-id", "wid", "rid"))
+	assert.NotEqual(t, WriteAuditKeyPrefix("domain-id", "wid", "rid"), WriteAuditKeyPrefix("domain-id", "wid", "other-rid"))
+	assert.NotContains(t, WriteAuditKeyPrefix("domain-id", "workflow/id", "rid"), "/")
+}
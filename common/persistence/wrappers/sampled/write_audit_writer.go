@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sampled
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	// writeAuditBufferSize is the max number of records and deletions waiting to be written, they are dropped above it
+	writeAuditBufferSize = 10000
+	// writeAuditBatchSize is the max number of records written to the store at once
+	writeAuditBatchSize = 100
+	// writeAuditFlushInterval is how often the buffered records are written to the store
+	writeAuditFlushInterval = time.Second
+	// writeAuditTimeout bounds the time spent writing a batch of records to the store
+	writeAuditTimeout = 10 * time.Second
+)
+
+type (
+	// WriteAuditWriter writes the write audit records to a store in the background, so that recording
+	// a mutation does not add the latency of the store to it
+	WriteAuditWriter interface {
+		common.Daemon
+
+		// Write buffers a record, the record is dropped when the buffer is full
+		Write(record *WriteAuditRecord)
+		// Delete buffers the deletion of the trail of a workflow run, it is applied after the records
+		// of the run buffered before it and is dropped when the buffer is full
+		Delete(domainID, workflowID, runID string)
+	}
+
+	// writeAuditRequest is either a record to append or the trail of a run to delete
+	writeAuditRequest struct {
+		record  *WriteAuditRecord
+		deleted *writeAuditRun
+	}
+
+	writeAuditRun struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	asyncWriteAuditWriter struct {
+		store      WriteAuditStore
+		logger     log.Logger
+		timeSource clock.TimeSource
+
+		status     int32
+		requests   chan writeAuditRequest
+		dropped    atomic.Int64
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+)
+
+// NewAsyncWriteAuditWriter creates a writer which buffers the write audit records of a host
+// and appends them to the store in batches
+func NewAsyncWriteAuditWriter(store WriteAuditStore, logger log.Logger, timeSource clock.TimeSource) WriteAuditWriter {
+	return &asyncWriteAuditWriter{
+		store:      store,
+		logger:     logger,
+		timeSource: timeSource,
+		status:     common.DaemonStatusInitialized,
+		requests:   make(chan writeAuditRequest, writeAuditBufferSize),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+func (w *asyncWriteAuditWriter) Start() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	w.shutdownWG.Add(1)
+	go w.writeLoop()
+}
+
+// Stop writes the buffered records and stops the writer
+func (w *asyncWriteAuditWriter) Stop() {
+	if !atomic.CompareAndSwapInt32(&w.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(w.shutdownCh)
+	if success := common.AwaitWaitGroup(&w.shutdownWG, time.Minute); !success {
+		w.logger.Warn("Persistence write audit writer timed out on shutdown", tag.LifeCycleStopTimedout)
+	}
+}
+
+func (w *asyncWriteAuditWriter) Write(record *WriteAuditRecord) {
+	w.enqueue(writeAuditRequest{record: record})
+}
+
+func (w *asyncWriteAuditWriter) Delete(domainID, workflowID, runID string) {
+	w.enqueue(writeAuditRequest{deleted: &writeAuditRun{domainID: domainID, workflowID: workflowID, runID: runID}})
+}
+
+func (w *asyncWriteAuditWriter) enqueue(request writeAuditRequest) {
+	select {
+	case w.requests <- request:
+	default:
+		w.dropped.Add(1)
+	}
+}
+
+func (w *asyncWriteAuditWriter) writeLoop() {
+	defer w.shutdownWG.Done()
+
+	ticker := w.timeSource.NewTicker(writeAuditFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*WriteAuditRecord, 0, writeAuditBatchSize)
+	for {
+		select {
+		case request := <-w.requests:
+			batch = w.handle(batch, request)
+		case <-ticker.Chan():
+			batch = w.flush(batch)
+		case <-w.shutdownCh:
+			for {
+				select {
+				case request := <-w.requests:
+					batch = w.handle(batch, request)
+				default:
+					w.flush(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+// handle adds a record to the batch or deletes a trail, and returns the batch
+func (w *asyncWriteAuditWriter) handle(batch []*WriteAuditRecord, request writeAuditRequest) []*WriteAuditRecord {
+	if request.record != nil {
+		batch = append(batch, request.record)
+		if len(batch) >= writeAuditBatchSize {
+			batch = w.flush(batch)
+		}
+		return batch
+	}
+
+	// the batch can hold records of the run, they are appended before the trail is deleted
+	batch = w.flush(batch)
+	ctx, cancel := context.WithTimeout(context.Background(), writeAuditTimeout)
+	defer cancel()
+	deleted := request.deleted
+	if err := w.store.Delete(ctx, deleted.domainID, deleted.workflowID, deleted.runID); err != nil {
+		w.logger.Warn("Failed to delete persistence write audit trail",
+			tag.WorkflowDomainID(deleted.domainID),
+			tag.WorkflowID(deleted.workflowID),
+			tag.WorkflowRunID(deleted.runID),
+			tag.Error(err),
+		)
+	}
+	return batch
+}
+
+// flush appends the batch to the store and returns the emptied batch
+func (w *asyncWriteAuditWriter) flush(batch []*WriteAuditRecord) []*WriteAuditRecord {
+	if dropped := w.dropped.Swap(0); dropped > 0 {
+		w.logger.Warn("Persistence write audit records or deletions dropped, the buffer is full", tag.Counter(int(dropped)))
+	}
+	if len(batch) == 0 {
+		return batch
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), writeAuditTimeout)
+	defer cancel()
+	if err := w.store.Append(ctx, batch); err != nil {
+		w.logger.Warn("Failed to record persistence write audit", tag.Counter(len(batch)), tag.Error(err))
+	}
+	return batch[:0]
+}
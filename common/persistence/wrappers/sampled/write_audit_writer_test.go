@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sampled
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+)
+
+type writeAuditStoreStub struct {
+	sync.Mutex
+	batches [][]*WriteAuditRecord
+	deleted []string
+	err     error
+}
+
+func (s *writeAuditStoreStub) Append(_ context.Context, records []*WriteAuditRecord) error {
+	s.Lock()
+	defer s.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.batches = append(s.batches, append([]*WriteAuditRecord(nil), records...))
+	return nil
+}
+
+func (s *writeAuditStoreStub) Get(context.Context, string, string, string) ([]*WriteAuditRecord, error) {
+	return nil, nil
+}
+
+func (s *writeAuditStoreStub) Delete(_ context.Context, domainID, workflowID, runID string) error {
+	s.Lock()
+	defer s.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	// the records of the run written before the deletion are gone with the trail
+	prefix := WriteAuditKeyPrefix(domainID, workflowID, runID)
+	s.deleted = append(s.deleted, prefix)
+	for i, batch := range s.batches {
+		var kept []*WriteAuditRecord
+		for _, record := range batch {
+			if WriteAuditKeyPrefix(record.DomainID, record.WorkflowID, record.RunID) != prefix {
+				kept = append(kept, record)
+			}
+		}
+		s.batches[i] = kept
+	}
+	return nil
+}
+
+func (s *writeAuditStoreStub) getBatches() [][]*WriteAuditRecord {
+	s.Lock()
+	defer s.Unlock()
+	return s.batches
+}
+
+func TestAsyncWriteAuditWriter(t *testing.T) {
+	t.Run("records are written in batches", func(t *testing.T) {
+		store := &writeAuditStoreStub{}
+		timeSource := clock.NewMockedTimeSource()
+		w := NewAsyncWriteAuditWriter(store, testlogger.New(t), timeSource)
+		w.Start()
+		defer w.Stop()
+
+		for i := 0; i < writeAuditBatchSize+1; i++ {
+			w.Write(&WriteAuditRecord{NextEventID: int64(i)})
+		}
+		assert.Eventually(t, func() bool { return len(store.getBatches()) == 1 }, time.Second, time.Millisecond,
+			"a full batch is written right away")
+		assert.Len(t, store.getBatches()[0], writeAuditBatchSize)
+
+		timeSource.BlockUntil(1)
+		timeSource.Advance(writeAuditFlushInterval)
+		assert.Eventually(t, func() bool { return len(store.getBatches()) == 2 }, time.Second, time.Millisecond,
+			"the remaining records are written on the next flush")
+		assert.Equal(t, []*WriteAuditRecord{{NextEventID: writeAuditBatchSize}}, store.getBatches()[1])
+	})
+
+	t.Run("buffered records are written on stop", func(t *testing.T) {
+		store := &writeAuditStoreStub{}
+		w := NewAsyncWriteAuditWriter(store, testlogger.New(t), clock.NewMockedTimeSource())
+		w.Start()
+		w.Write(&WriteAuditRecord{NextEventID: 1})
+		w.Stop()
+
+		assert.Equal(t, [][]*WriteAuditRecord{{{NextEventID: 1}}}, store.getBatches())
+	})
+
+	t.Run("trail is deleted after the buffered records", func(t *testing.T) {
+		store := &writeAuditStoreStub{}
+		w := NewAsyncWriteAuditWriter(store, testlogger.New(t), clock.NewMockedTimeSource())
+		w.Start()
+		w.Write(&WriteAuditRecord{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid", NextEventID: 1})
+		w.Write(&WriteAuditRecord{DomainID: "domain-id", WorkflowID: "wid", RunID: "other-rid", NextEventID: 2})
+		w.Delete("domain-id", "wid", "rid")
+		w.Stop()
+
+		assert.Equal(t, []string{WriteAuditKeyPrefix("domain-id", "wid", "rid")}, store.deleted)
+		assert.Equal(t, [][]*WriteAuditRecord{
+			{{DomainID: "domain-id", WorkflowID: "wid", RunID: "other-rid", NextEventID: 2}},
+		}, store.getBatches(), "the records of the run buffered before the deletion are deleted with it")
+	})
+
+	t.Run("records are dropped when the buffer is full", func(t *testing.T) {
+		store := &writeAuditStoreStub{}
+		w := NewAsyncWriteAuditWriter(store, testlogger.New(t), clock.NewMockedTimeSource()).(*asyncWriteAuditWriter)
+		for i := 0; i < writeAuditBufferSize+2; i++ {
+			w.Write(&WriteAuditRecord{})
+		}
+		assert.Equal(t, int64(2), w.dropped.Load())
+	})
+
+	t.Run("store failure", func(t *testing.T) {
+		store := &writeAuditStoreStub{err: errors.New("blobstore unavailable")}
+		w := NewAsyncWriteAuditWriter(store, testlogger.New(t), clock.NewMockedTimeSource())
+		w.Start()
+		w.Write(&WriteAuditRecord{NextEventID: 1})
+		w.Stop()
+
+		assert.Empty(t, store.getBatches())
+	})
+}
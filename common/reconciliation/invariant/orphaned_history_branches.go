@@ -0,0 +1,372 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	c "github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// DefaultOrphanedHistoryBranchMinAge is the age a branch must have to be considered orphaned,
+	// so that branches which were just forked and are not yet referenced by the mutable state are left alone
+	DefaultOrphanedHistoryBranchMinAge = time.Hour
+
+	orphanedHistoryBranchReadPageSize = 100
+)
+
+type (
+	orphanedHistoryBranches struct {
+		pr      persistence.Retryer
+		dc      cache.DomainCache
+		minAge  time.Duration
+		encoder *codec.ThriftRWEncoder
+		now     func() time.Time
+	}
+
+	// OrphanedHistoryBranchesDetails is the InfoDetails of the results of the orphaned history branches invariant
+	OrphanedHistoryBranchesDetails struct {
+		Branches []OrphanedHistoryBranch
+		// Size is the total size in bytes of the history nodes only used by the orphaned branches
+		Size int64
+	}
+
+	// OrphanedHistoryBranch is a history branch forked by an execution and not referenced by its version histories
+	OrphanedHistoryBranch struct {
+		TreeID   string
+		BranchID string
+		ForkTime time.Time
+		// Size is the size in bytes of the history nodes only used by this branch
+		Size int64
+
+		token []byte
+	}
+)
+
+// NewOrphanedHistoryBranches returns an invariant checking that the history branches forked by a concrete execution
+// are referenced by its version histories. Branches forked less than minAge ago are ignored.
+func NewOrphanedHistoryBranches(
+	pr persistence.Retryer, dc cache.DomainCache, minAge time.Duration,
+) Invariant {
+	return &orphanedHistoryBranches{
+		pr:      pr,
+		dc:      dc,
+		minAge:  minAge,
+		encoder: codec.NewThriftRWEncoder(),
+		now:     time.Now,
+	}
+}
+
+func (o *orphanedHistoryBranches) Check(
+	ctx context.Context,
+	execution interface{},
+) CheckResult {
+	_, checkResult := o.check(ctx, execution)
+	return checkResult
+}
+
+func (o *orphanedHistoryBranches) Fix(
+	ctx context.Context,
+	execution interface{},
+) FixResult {
+	if fixResult := validateFixContext(ctx, o.Name()); fixResult != nil {
+		return *fixResult
+	}
+
+	// the check is not run through checkBeforeFix as the branch tokens are not part of the check result
+	branches, checkResult := o.check(ctx, execution)
+	switch checkResult.CheckResultType {
+	case CheckResultTypeHealthy:
+		return FixResult{
+			FixResultType: FixResultTypeSkipped,
+			InvariantName: o.Name(),
+			CheckResult:   checkResult,
+			Info:          "skipped fix because execution was healthy",
+		}
+	case CheckResultTypeFailed:
+		return FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantName: o.Name(),
+			CheckResult:   checkResult,
+			Info:          "failed fix because check failed",
+		}
+	}
+
+	concreteExecution := execution.(*entity.ConcreteExecution)
+	domainName, err := o.dc.GetDomainName(concreteExecution.DomainID)
+	if err != nil {
+		return FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantName: o.Name(),
+			CheckResult:   checkResult,
+			Info:          "failed to fetch domainName",
+			InfoDetails:   err.Error(),
+		}
+	}
+	for i, branch := range branches {
+		if err := o.pr.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			BranchToken: branch.token,
+			ShardID:     c.IntPtr(concreteExecution.ShardID),
+			DomainName:  domainName,
+		}); err != nil {
+			return FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: o.Name(),
+				CheckResult:   checkResult,
+				Info:          "failed to delete history branch " + branch.BranchID,
+				InfoDetails:   err.Error() + ", deleted branches: " + orphanedBranchesDetails(branches[:i]),
+			}
+		}
+	}
+	return FixResult{
+		FixResultType: FixResultTypeFixed,
+		InvariantName: o.Name(),
+		CheckResult:   checkResult,
+		Info:          "deleted orphaned history branches",
+		InfoDetails:   orphanedBranchesDetails(branches),
+	}
+}
+
+func (o *orphanedHistoryBranches) check(
+	ctx context.Context,
+	execution interface{},
+) ([]OrphanedHistoryBranch, CheckResult) {
+	if checkResult := validateCheckContext(ctx, o.Name()); checkResult != nil {
+		return nil, *checkResult
+	}
+
+	concreteExecution, ok := execution.(*entity.ConcreteExecution)
+	if !ok {
+		return nil, CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   o.Name(),
+			Info:            "failed to check: expected concrete execution",
+		}
+	}
+	branches, checkResult := o.findOrphanedBranches(ctx, concreteExecution)
+	if checkResult != nil {
+		return nil, *checkResult
+	}
+	if len(branches) == 0 {
+		return nil, CheckResult{
+			CheckResultType: CheckResultTypeHealthy,
+			InvariantName:   o.Name(),
+		}
+	}
+	return branches, CheckResult{
+		CheckResultType: CheckResultTypeCorrupted,
+		InvariantName:   o.Name(),
+		Info:            "history branches are not referenced by the version histories of the execution",
+		InfoDetails:     orphanedBranchesDetails(branches),
+		CorruptedSize:   orphanedBranchesSize(branches),
+	}
+}
+
+func (o *orphanedHistoryBranches) Name() Name {
+	return OrphanedHistoryBranches
+}
+
+// findOrphanedBranches returns the branches of the history tree of the execution that were forked by it
+// and are not referenced by its version histories, or a check result if this could not be determined
+func (o *orphanedHistoryBranches) findOrphanedBranches(
+	ctx context.Context,
+	execution *entity.ConcreteExecution,
+) ([]OrphanedHistoryBranch, *CheckResult) {
+	failed := func(info string, err error) *CheckResult {
+		return &CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   o.Name(),
+			Info:            info,
+			InfoDetails:     err.Error(),
+		}
+	}
+
+	domainName, err := o.dc.GetDomainName(execution.DomainID)
+	if err != nil {
+		return nil, failed("failed to check: expected DomainName", err)
+	}
+	// the execution is read again as the branches it references may have changed since it was listed
+	resp, err := o.pr.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: execution.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+		},
+		DomainName: domainName,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			// the history of deleted executions is cleaned up by the history scavenger
+			return nil, nil
+		}
+		return nil, failed("failed to get concrete execution", err)
+	}
+	referenced, err := o.referencedBranchIDs(resp.State)
+	if err != nil {
+		return nil, failed("failed to decode the branch tokens of the execution", err)
+	}
+
+	tree, err := o.pr.GetHistoryTree(ctx, &persistence.GetHistoryTreeRequest{
+		TreeID:     execution.TreeID,
+		ShardID:    c.IntPtr(execution.ShardID),
+		DomainName: domainName,
+	})
+	if err != nil {
+		return nil, failed("failed to get history tree", err)
+	}
+	if len(tree.BranchDetails) != len(tree.Branches) {
+		// the store did not return the fork times, so the age of the branches is unknown
+		return nil, nil
+	}
+
+	var orphaned []OrphanedHistoryBranch
+	for i, branch := range tree.Branches {
+		detail := tree.BranchDetails[i]
+		if _, ok := referenced[branch.GetBranchID()]; ok {
+			continue
+		}
+		// branches forked by other runs, e.g. by a reset, are checked with these runs
+		_, _, runID, err := persistence.SplitHistoryGarbageCleanupInfo(detail.Info)
+		if err != nil || runID != execution.RunID {
+			continue
+		}
+		if detail.ForkTime.IsZero() || o.now().Sub(detail.ForkTime) < o.minAge {
+			continue
+		}
+
+		token, err := o.encoder.Encode(branch)
+		if err != nil {
+			return nil, failed("failed to encode branch token", err)
+		}
+		size, err := o.branchSize(ctx, token, branch, tree.Branches, execution.ShardID, domainName)
+		if err != nil {
+			return nil, failed("failed to read history branch "+branch.GetBranchID(), err)
+		}
+		orphaned = append(orphaned, OrphanedHistoryBranch{
+			TreeID:   branch.GetTreeID(),
+			BranchID: branch.GetBranchID(),
+			ForkTime: detail.ForkTime,
+			Size:     size,
+			token:    token,
+		})
+	}
+	return orphaned, nil
+}
+
+func (o *orphanedHistoryBranches) referencedBranchIDs(state *persistence.WorkflowMutableState) (map[string]struct{}, error) {
+	var tokens [][]byte
+	if state.VersionHistories != nil {
+		for _, history := range state.VersionHistories.Histories {
+			tokens = append(tokens, history.GetBranchToken())
+		}
+	} else {
+		tokens = append(tokens, state.ExecutionInfo.BranchToken)
+	}
+
+	referenced := make(map[string]struct{}, len(tokens))
+	for _, token := range tokens {
+		var branch shared.HistoryBranch
+		if err := o.encoder.Decode(token, &branch); err != nil {
+			return nil, err
+		}
+		referenced[branch.GetBranchID()] = struct{}{}
+	}
+	return referenced, nil
+}
+
+// branchSize returns the size of the nodes of the branch which are not used by the other branches of the tree,
+// which are the nodes deleted with the branch
+func (o *orphanedHistoryBranches) branchSize(
+	ctx context.Context,
+	token []byte,
+	branch *shared.HistoryBranch,
+	tree []*shared.HistoryBranch,
+	shardID int,
+	domainName string,
+) (int64, error) {
+	minNodeID := constants.FirstEventID
+	if ancestors := branch.GetAncestors(); len(ancestors) > 0 {
+		minNodeID = ancestors[len(ancestors)-1].GetEndNodeID()
+	}
+	for _, other := range tree {
+		for _, ancestor := range other.GetAncestors() {
+			if ancestor.GetBranchID() == branch.GetBranchID() && ancestor.GetEndNodeID() > minNodeID {
+				minNodeID = ancestor.GetEndNodeID()
+			}
+		}
+	}
+
+	var size int64
+	var pageToken []byte
+	for {
+		resp, err := o.pr.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			BranchToken:   token,
+			MinEventID:    minNodeID,
+			MaxEventID:    constants.EndEventID,
+			PageSize:      orphanedHistoryBranchReadPageSize,
+			NextPageToken: pageToken,
+			ShardID:       c.IntPtr(shardID),
+			DomainName:    domainName,
+		})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				// all the nodes of the branch are used by other branches
+				return size, nil
+			}
+			return 0, err
+		}
+		size += int64(resp.Size)
+		if len(resp.NextPageToken) == 0 {
+			return size, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func orphanedBranchesDetails(branches []OrphanedHistoryBranch) string {
+	details := OrphanedHistoryBranchesDetails{
+		Branches: branches,
+		Size:     orphanedBranchesSize(branches),
+	}
+	data, err := json.Marshal(details)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
+
+func orphanedBranchesSize(branches []OrphanedHistoryBranch) int64 {
+	var size int64
+	for _, branch := range branches {
+		size += branch.Size
+	}
+	return size
+}
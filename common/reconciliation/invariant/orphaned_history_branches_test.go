@@ -0,0 +1,269 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestOrphanedHistoryBranches(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	old := now.Add(-2 * time.Hour)
+	ownInfo := persistence.BuildHistoryGarbageCleanupInfo(domainID, workflowID, runID)
+
+	current := &shared.HistoryBranch{TreeID: common.StringPtr(treeID), BranchID: common.StringPtr(branchID)}
+	// forked from the current branch at node 10, then the mutable state update failed
+	orphaned := &shared.HistoryBranch{
+		TreeID:   common.StringPtr(treeID),
+		BranchID: common.StringPtr("orphaned-branch-id"),
+		Ancestors: []*shared.HistoryBranchRange{
+			{BranchID: common.StringPtr(branchID), BeginNodeID: common.Int64Ptr(1), EndNodeID: common.Int64Ptr(10)},
+		},
+	}
+	// forked from the orphaned branch by a reset of the execution
+	resetRun := &shared.HistoryBranch{
+		TreeID:   common.StringPtr(treeID),
+		BranchID: common.StringPtr("reset-branch-id"),
+		Ancestors: []*shared.HistoryBranchRange{
+			{BranchID: common.StringPtr(branchID), BeginNodeID: common.Int64Ptr(1), EndNodeID: common.Int64Ptr(10)},
+			{BranchID: common.StringPtr("orphaned-branch-id"), BeginNodeID: common.Int64Ptr(10), EndNodeID: common.Int64Ptr(15)},
+		},
+	}
+	recent := &shared.HistoryBranch{TreeID: common.StringPtr(treeID), BranchID: common.StringPtr("recent-branch-id")}
+	unknownOwner := &shared.HistoryBranch{TreeID: common.StringPtr(treeID), BranchID: common.StringPtr("unknown-branch-id")}
+
+	encoder := codec.NewThriftRWEncoder()
+	encode := func(branch *shared.HistoryBranch) []byte {
+		token, err := encoder.Encode(branch)
+		require.NoError(t, err)
+		return token
+	}
+	execution := &persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{},
+			VersionHistories: &persistence.VersionHistories{
+				Histories: []*persistence.VersionHistory{{BranchToken: encode(current)}},
+			},
+		},
+	}
+	tree := &persistence.GetHistoryTreeResponse{
+		Branches: []*shared.HistoryBranch{current, orphaned, resetRun, recent, unknownOwner},
+		BranchDetails: []persistence.HistoryBranchDetail{
+			{TreeID: treeID, BranchID: branchID, ForkTime: old, Info: ownInfo},
+			{TreeID: treeID, BranchID: "orphaned-branch-id", ForkTime: old, Info: ownInfo},
+			{TreeID: treeID, BranchID: "reset-branch-id", ForkTime: old, Info: persistence.BuildHistoryGarbageCleanupInfo(domainID, workflowID, "reset-run-id")},
+			{TreeID: treeID, BranchID: "recent-branch-id", ForkTime: now.Add(-time.Minute), Info: ownInfo},
+			{TreeID: treeID, BranchID: "unknown-branch-id", ForkTime: old, Info: "unknown"},
+		},
+	}
+	expectedDetails := `{"Branches":[{"TreeID":"test-tree-id","BranchID":"orphaned-branch-id","ForkTime":"` +
+		old.Format(time.RFC3339Nano) + `","Size":30}],"Size":30}`
+
+	expectTreeAndSize := func(pr *persistence.MockRetryer) {
+		pr.EXPECT().GetHistoryTree(gomock.Any(), &persistence.GetHistoryTreeRequest{
+			TreeID:     treeID,
+			ShardID:    common.IntPtr(shardID),
+			DomainName: domainName,
+		}).Return(tree, nil)
+		// the nodes before 15 are used by the branch of the reset run
+		pr.EXPECT().ReadRawHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+			BranchToken: encode(orphaned),
+			MinEventID:  15,
+			MaxEventID:  1<<63 - 1,
+			PageSize:    orphanedHistoryBranchReadPageSize,
+			ShardID:     common.IntPtr(shardID),
+			DomainName:  domainName,
+		}).Return(&persistence.ReadRawHistoryBranchResponse{Size: 20, NextPageToken: []byte("next")}, nil)
+		pr.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *persistence.ReadHistoryBranchRequest) (*persistence.ReadRawHistoryBranchResponse, error) {
+				assert.Equal(t, []byte("next"), req.NextPageToken)
+				return &persistence.ReadRawHistoryBranchResponse{Size: 10}, nil
+			})
+	}
+
+	tests := []struct {
+		name        string
+		setupMock   func(pr *persistence.MockRetryer)
+		fix         bool
+		checkResult CheckResult
+		fixResult   FixResult
+	}{
+		{
+			name: "execution does not exist",
+			setupMock: func(pr *persistence.MockRetryer) {
+				pr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+			checkResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   OrphanedHistoryBranches,
+			},
+		},
+		{
+			name: "failed to get execution",
+			setupMock: func(pr *persistence.MockRetryer) {
+				pr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, errors.New("get execution failed"))
+			},
+			checkResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   OrphanedHistoryBranches,
+				Info:            "failed to get concrete execution",
+				InfoDetails:     "get execution failed",
+			},
+		},
+		{
+			name: "failed to get history tree",
+			setupMock: func(pr *persistence.MockRetryer) {
+				pr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(execution, nil)
+				pr.EXPECT().GetHistoryTree(gomock.Any(), gomock.Any()).Return(nil, errors.New("get tree failed"))
+			},
+			checkResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   OrphanedHistoryBranches,
+				Info:            "failed to get history tree",
+				InfoDetails:     "get tree failed",
+			},
+		},
+		{
+			name: "no orphaned branch",
+			setupMock: func(pr *persistence.MockRetryer) {
+				pr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(execution, nil)
+				pr.EXPECT().GetHistoryTree(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTreeResponse{
+					Branches:      tree.Branches[:1],
+					BranchDetails: tree.BranchDetails[:1],
+				}, nil)
+			},
+			checkResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   OrphanedHistoryBranches,
+			},
+		},
+		{
+			name: "orphaned branch",
+			setupMock: func(pr *persistence.MockRetryer) {
+				pr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(execution, nil)
+				expectTreeAndSize(pr)
+			},
+			checkResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   OrphanedHistoryBranches,
+				Info:            "history branches are not referenced by the version histories of the execution",
+				InfoDetails:     expectedDetails,
+				CorruptedSize:   30,
+			},
+		},
+		{
+			name: "fix healthy execution",
+			fix:  true,
+			setupMock: func(pr *persistence.MockRetryer) {
+				pr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+			fixResult: FixResult{
+				FixResultType: FixResultTypeSkipped,
+				InvariantName: OrphanedHistoryBranches,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeHealthy,
+					InvariantName:   OrphanedHistoryBranches,
+				},
+				Info: "skipped fix because execution was healthy",
+			},
+		},
+		{
+			name: "fix orphaned branch",
+			fix:  true,
+			setupMock: func(pr *persistence.MockRetryer) {
+				pr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(execution, nil)
+				expectTreeAndSize(pr)
+				pr.EXPECT().DeleteHistoryBranch(gomock.Any(), &persistence.DeleteHistoryBranchRequest{
+					BranchToken: encode(orphaned),
+					ShardID:     common.IntPtr(shardID),
+					DomainName:  domainName,
+				}).Return(nil)
+			},
+			fixResult: FixResult{
+				FixResultType: FixResultTypeFixed,
+				InvariantName: OrphanedHistoryBranches,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeCorrupted,
+					InvariantName:   OrphanedHistoryBranches,
+					Info:            "history branches are not referenced by the version histories of the execution",
+					InfoDetails:     expectedDetails,
+					CorruptedSize:   30,
+				},
+				Info:        "deleted orphaned history branches",
+				InfoDetails: expectedDetails,
+			},
+		},
+		{
+			name: "failed to delete orphaned branch",
+			fix:  true,
+			setupMock: func(pr *persistence.MockRetryer) {
+				pr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(execution, nil)
+				expectTreeAndSize(pr)
+				pr.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(errors.New("delete failed"))
+			},
+			fixResult: FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: OrphanedHistoryBranches,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeCorrupted,
+					InvariantName:   OrphanedHistoryBranches,
+					Info:            "history branches are not referenced by the version histories of the execution",
+					InfoDetails:     expectedDetails,
+					CorruptedSize:   30,
+				},
+				Info:        "failed to delete history branch orphaned-branch-id",
+				InfoDetails: `delete failed, deleted branches: {"Branches":[],"Size":0}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			pr := persistence.NewMockRetryer(ctrl)
+			domainCache := cache.NewMockDomainCache(ctrl)
+			domainCache.EXPECT().GetDomainName(domainID).Return(domainName, nil).AnyTimes()
+			tt.setupMock(pr)
+
+			o := NewOrphanedHistoryBranches(pr, domainCache, DefaultOrphanedHistoryBranchMinAge).(*orphanedHistoryBranches)
+			o.now = func() time.Time { return now }
+			if tt.fix {
+				assert.Equal(t, tt.fixResult, o.Fix(context.Background(), getOpenConcreteExecution()))
+			} else {
+				assert.Equal(t, tt.checkResult, o.Check(context.Background(), getOpenConcreteExecution()))
+			}
+		})
+	}
+}
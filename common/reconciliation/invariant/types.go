@@ -66,6 +66,9 @@ const (
 	// MismatchedRecords checks that current and concrete execution records agree on close status
 	MismatchedRecords Name = "mismatched_records"
 
+	// OrphanedHistoryBranches checks for history branches forked by an execution that are not referenced by its version histories
+	OrphanedHistoryBranches Name = "orphaned_history_branches"
+
 	// CollectionMutableState is the collection of invariants relating to mutable state
 	CollectionMutableState Collection = 0
 	// CollectionHistory is the collection  of invariants relating to history
@@ -126,6 +129,8 @@ type CheckResult struct {
 	InvariantName   Name
 	Info            string
 	InfoDetails     string
+	// CorruptedSize is the size in bytes of the corrupted data, only set by the invariants measuring it
+	CorruptedSize int64 `json:",omitempty"`
 }
 
 // FixResult is the result of running Fix.
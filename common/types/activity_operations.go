@@ -0,0 +1,372 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+// PauseActivityRequest stops new attempts of a pending activity from being dispatched.
+// An attempt that is already running is not interrupted.
+type PauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+}
+
+// GetDomain is an internal getter
+func (v *PauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter
+func (v *PauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter
+func (v *PauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetIdentity is an internal getter
+func (v *PauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetReason is an internal getter
+func (v *PauseActivityRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// PauseActivityResponse is an internal type
+type PauseActivityResponse struct{}
+
+// UnpauseActivityRequest lets a paused activity be dispatched again. If the activity
+// is waiting for its next attempt, that attempt is dispatched right away.
+type UnpauseActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	ResetAttempts     bool               `json:"resetAttempts,omitempty"`
+}
+
+// GetDomain is an internal getter
+func (v *UnpauseActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter
+func (v *UnpauseActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter
+func (v *UnpauseActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetIdentity is an internal getter
+func (v *UnpauseActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetResetAttempts is an internal getter
+func (v *UnpauseActivityRequest) GetResetAttempts() (o bool) {
+	if v != nil {
+		return v.ResetAttempts
+	}
+	return
+}
+
+// UnpauseActivityResponse is an internal type
+type UnpauseActivityResponse struct{}
+
+// ResetActivityRequest restarts the attempt count of a pending activity. If the activity
+// is waiting for a retry, the retry backoff is skipped and the next attempt is dispatched
+// right away, unless the activity is paused.
+type ResetActivityRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter
+func (v *ResetActivityRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter
+func (v *ResetActivityRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter
+func (v *ResetActivityRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetIdentity is an internal getter
+func (v *ResetActivityRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// ResetActivityResponse is an internal type
+type ResetActivityResponse struct{}
+
+// HistoryPauseActivityRequest is the history service request for PauseActivity.
+type HistoryPauseActivityRequest struct {
+	DomainUUID string                `json:"domainUUID,omitempty"`
+	Request    *PauseActivityRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter
+func (v *HistoryPauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter
+func (v *HistoryPauseActivityRequest) GetRequest() (o *PauseActivityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// HistoryUnpauseActivityRequest is the history service request for UnpauseActivity.
+type HistoryUnpauseActivityRequest struct {
+	DomainUUID string                  `json:"domainUUID,omitempty"`
+	Request    *UnpauseActivityRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter
+func (v *HistoryUnpauseActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter
+func (v *HistoryUnpauseActivityRequest) GetRequest() (o *UnpauseActivityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// HistoryResetActivityRequest is the history service request for ResetActivity.
+type HistoryResetActivityRequest struct {
+	DomainUUID string                `json:"domainUUID,omitempty"`
+	Request    *ResetActivityRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter
+func (v *HistoryResetActivityRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter
+func (v *HistoryResetActivityRequest) GetRequest() (o *ResetActivityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// ActivityOptions are the options of a pending activity that can be changed while the
+// activity is running. Fields left unset keep their current value.
+type ActivityOptions struct {
+	TaskList                      *TaskList    `json:"taskList,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32       `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32       `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32       `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32       `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// GetTaskList is an internal getter
+func (v *ActivityOptions) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetScheduleToCloseTimeoutSeconds is an internal getter
+func (v *ActivityOptions) GetScheduleToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToCloseTimeoutSeconds != nil {
+		return *v.ScheduleToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetScheduleToStartTimeoutSeconds is an internal getter
+func (v *ActivityOptions) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v != nil && v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
+	}
+	return
+}
+
+// GetStartToCloseTimeoutSeconds is an internal getter
+func (v *ActivityOptions) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v != nil && v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
+	}
+	return
+}
+
+// GetHeartbeatTimeoutSeconds is an internal getter
+func (v *ActivityOptions) GetHeartbeatTimeoutSeconds() (o int32) {
+	if v != nil && v.HeartbeatTimeoutSeconds != nil {
+		return *v.HeartbeatTimeoutSeconds
+	}
+	return
+}
+
+// GetRetryPolicy is an internal getter
+func (v *ActivityOptions) GetRetryPolicy() (o *RetryPolicy) {
+	if v != nil && v.RetryPolicy != nil {
+		return v.RetryPolicy
+	}
+	return
+}
+
+// UpdateActivityOptionsRequest changes the options of a pending activity. New timeouts
+// apply to the current attempt, a new task list applies to attempts that have not started.
+type UpdateActivityOptionsRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityID        string             `json:"activityID,omitempty"`
+	Options           *ActivityOptions   `json:"options,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter
+func (v *UpdateActivityOptionsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter
+func (v *UpdateActivityOptionsRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetActivityID is an internal getter
+func (v *UpdateActivityOptionsRequest) GetActivityID() (o string) {
+	if v != nil {
+		return v.ActivityID
+	}
+	return
+}
+
+// GetOptions is an internal getter
+func (v *UpdateActivityOptionsRequest) GetOptions() (o *ActivityOptions) {
+	if v != nil && v.Options != nil {
+		return v.Options
+	}
+	return
+}
+
+// GetIdentity is an internal getter
+func (v *UpdateActivityOptionsRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// UpdateActivityOptionsResponse is an internal type
+type UpdateActivityOptionsResponse struct{}
+
+// HistoryUpdateActivityOptionsRequest is the history service request for UpdateActivityOptions.
+type HistoryUpdateActivityOptionsRequest struct {
+	DomainUUID string                        `json:"domainUUID,omitempty"`
+	Request    *UpdateActivityOptionsRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter
+func (v *HistoryUpdateActivityOptionsRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter
+func (v *HistoryUpdateActivityOptionsRequest) GetRequest() (o *UpdateActivityOptionsRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPauseActivityRequest_Getters(t *testing.T) {
+	var empty *PauseActivityRequest
+	assert.Equal(t, "", empty.GetDomain())
+	assert.Nil(t, empty.GetWorkflowExecution())
+	assert.Equal(t, "", empty.GetActivityID())
+	assert.Equal(t, "", empty.GetIdentity())
+	assert.Equal(t, "", empty.GetReason())
+
+	v := &PauseActivityRequest{
+		Domain:            "d",
+		WorkflowExecution: &WorkflowExecution{WorkflowID: "wid"},
+		ActivityID:        "a",
+		Identity:          "i",
+		Reason:            "r",
+	}
+	assert.Equal(t, "d", v.GetDomain())
+	assert.Equal(t, "wid", v.GetWorkflowExecution().GetWorkflowID())
+	assert.Equal(t, "a", v.GetActivityID())
+	assert.Equal(t, "i", v.GetIdentity())
+	assert.Equal(t, "r", v.GetReason())
+}
+
+func TestUnpauseActivityRequest_Getters(t *testing.T) {
+	var empty *UnpauseActivityRequest
+	assert.Equal(t, "", empty.GetDomain())
+	assert.Nil(t, empty.GetWorkflowExecution())
+	assert.Equal(t, "", empty.GetActivityID())
+	assert.Equal(t, "", empty.GetIdentity())
+	assert.False(t, empty.GetResetAttempts())
+
+	v := &UnpauseActivityRequest{
+		Domain:            "d",
+		WorkflowExecution: &WorkflowExecution{WorkflowID: "wid"},
+		ActivityID:        "a",
+		Identity:          "i",
+		ResetAttempts:     true,
+	}
+	assert.Equal(t, "d", v.GetDomain())
+	assert.Equal(t, "wid", v.GetWorkflowExecution().GetWorkflowID())
+	assert.Equal(t, "a", v.GetActivityID())
+	assert.Equal(t, "i", v.GetIdentity())
+	assert.True(t, v.GetResetAttempts())
+}
+
+func TestResetActivityRequest_Getters(t *testing.T) {
+	var empty *ResetActivityRequest
+	assert.Equal(t, "", empty.GetDomain())
+	assert.Nil(t, empty.GetWorkflowExecution())
+	assert.Equal(t, "", empty.GetActivityID())
+	assert.Equal(t, "", empty.GetIdentity())
+
+	v := &ResetActivityRequest{
+		Domain:            "d",
+		WorkflowExecution: &WorkflowExecution{WorkflowID: "wid"},
+		ActivityID:        "a",
+		Identity:          "i",
+	}
+	assert.Equal(t, "d", v.GetDomain())
+	assert.Equal(t, "wid", v.GetWorkflowExecution().GetWorkflowID())
+	assert.Equal(t, "a", v.GetActivityID())
+	assert.Equal(t, "i", v.GetIdentity())
+}
+
+func TestHistoryActivityOperationRequests_Getters(t *testing.T) {
+	var pause *HistoryPauseActivityRequest
+	assert.Equal(t, "", pause.GetDomainUUID())
+	assert.Nil(t, pause.GetRequest())
+	pauseRequest := &PauseActivityRequest{ActivityID: "a"}
+	pause = &HistoryPauseActivityRequest{DomainUUID: "d", Request: pauseRequest}
+	assert.Equal(t, "d", pause.GetDomainUUID())
+	assert.Equal(t, pauseRequest, pause.GetRequest())
+
+	var unpause *HistoryUnpauseActivityRequest
+	assert.Equal(t, "", unpause.GetDomainUUID())
+	assert.Nil(t, unpause.GetRequest())
+	unpauseRequest := &UnpauseActivityRequest{ActivityID: "a"}
+	unpause = &HistoryUnpauseActivityRequest{DomainUUID: "d", Request: unpauseRequest}
+	assert.Equal(t, "d", unpause.GetDomainUUID())
+	assert.Equal(t, unpauseRequest, unpause.GetRequest())
+
+	var reset *HistoryResetActivityRequest
+	assert.Equal(t, "", reset.GetDomainUUID())
+	assert.Nil(t, reset.GetRequest())
+	resetRequest := &ResetActivityRequest{ActivityID: "a"}
+	reset = &HistoryResetActivityRequest{DomainUUID: "d", Request: resetRequest}
+	assert.Equal(t, "d", reset.GetDomainUUID())
+	assert.Equal(t, resetRequest, reset.GetRequest())
+}
+
+func TestActivityOptions_Getters(t *testing.T) {
+	var empty *ActivityOptions
+	assert.Nil(t, empty.GetTaskList())
+	assert.Equal(t, int32(0), empty.GetScheduleToCloseTimeoutSeconds())
+	assert.Equal(t, int32(0), empty.GetScheduleToStartTimeoutSeconds())
+	assert.Equal(t, int32(0), empty.GetStartToCloseTimeoutSeconds())
+	assert.Equal(t, int32(0), empty.GetHeartbeatTimeoutSeconds())
+	assert.Nil(t, empty.GetRetryPolicy())
+
+	timeout := int32(10)
+	v := &ActivityOptions{
+		TaskList:                      &TaskList{Name: "tl"},
+		ScheduleToCloseTimeoutSeconds: &timeout,
+		ScheduleToStartTimeoutSeconds: &timeout,
+		StartToCloseTimeoutSeconds:    &timeout,
+		HeartbeatTimeoutSeconds:       &timeout,
+		RetryPolicy:                   &RetryPolicy{MaximumAttempts: 3},
+	}
+	assert.Equal(t, "tl", v.GetTaskList().GetName())
+	assert.Equal(t, timeout, v.GetScheduleToCloseTimeoutSeconds())
+	assert.Equal(t, timeout, v.GetScheduleToStartTimeoutSeconds())
+	assert.Equal(t, timeout, v.GetStartToCloseTimeoutSeconds())
+	assert.Equal(t, timeout, v.GetHeartbeatTimeoutSeconds())
+	assert.Equal(t, int32(3), v.GetRetryPolicy().GetMaximumAttempts())
+}
+
+func TestUpdateActivityOptionsRequest_Getters(t *testing.T) {
+	var empty *UpdateActivityOptionsRequest
+	assert.Equal(t, "", empty.GetDomain())
+	assert.Nil(t, empty.GetWorkflowExecution())
+	assert.Equal(t, "", empty.GetActivityID())
+	assert.Nil(t, empty.GetOptions())
+	assert.Equal(t, "", empty.GetIdentity())
+
+	options := &ActivityOptions{TaskList: &TaskList{Name: "tl"}}
+	v := &UpdateActivityOptionsRequest{
+		Domain:            "d",
+		WorkflowExecution: &WorkflowExecution{WorkflowID: "wid"},
+		ActivityID:        "a",
+		Options:           options,
+		Identity:          "i",
+	}
+	assert.Equal(t, "d", v.GetDomain())
+	assert.Equal(t, "wid", v.GetWorkflowExecution().GetWorkflowID())
+	assert.Equal(t, "a", v.GetActivityID())
+	assert.Equal(t, options, v.GetOptions())
+	assert.Equal(t, "i", v.GetIdentity())
+
+	var history *HistoryUpdateActivityOptionsRequest
+	assert.Equal(t, "", history.GetDomainUUID())
+	assert.Nil(t, history.GetRequest())
+	history = &HistoryUpdateActivityOptionsRequest{DomainUUID: "d", Request: v}
+	assert.Equal(t, "d", history.GetDomainUUID())
+	assert.Equal(t, v, history.GetRequest())
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+// UpdateDomainIsolationRequest is the admin request to isolate the history tasks of a domain
+// into a dedicated history queue virtual queue, or to release them back to the default virtual queue
+type UpdateDomainIsolationRequest struct {
+	Domain   string `json:"domain,omitempty"`
+	Isolated bool   `json:"isolated,omitempty"`
+}
+
+// GetDomain is an internal getter
+func (v *UpdateDomainIsolationRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetIsolated is an internal getter
+func (v *UpdateDomainIsolationRequest) GetIsolated() (o bool) {
+	if v != nil {
+		return v.Isolated
+	}
+	return
+}
+
+// UpdateDomainIsolationResponse is the result of updating the isolation of a domain on all history shards
+type UpdateDomainIsolationResponse struct {
+	// FailedShards are the history shards which failed to apply the change, the request can be retried for them
+	FailedShards []int32 `json:"failedShards,omitempty"`
+}
+
+// GetFailedShards is an internal getter
+func (v *UpdateDomainIsolationResponse) GetFailedShards() (o []int32) {
+	if v != nil && v.FailedShards != nil {
+		return v.FailedShards
+	}
+	return
+}
+
+// HistoryUpdateDomainIsolationRequest is the history request to update the isolation of a domain on all shards of a host
+type HistoryUpdateDomainIsolationRequest struct {
+	DomainUUID string `json:"domainUUID,omitempty"`
+	Isolated   bool   `json:"isolated,omitempty"`
+}
+
+// GetDomainUUID is an internal getter
+func (v *HistoryUpdateDomainIsolationRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetIsolated is an internal getter
+func (v *HistoryUpdateDomainIsolationRequest) GetIsolated() (o bool) {
+	if v != nil {
+		return v.Isolated
+	}
+	return
+}
+
+// HistoryUpdateDomainIsolationResponse is the result of updating the isolation of a domain on the shards of a host
+type HistoryUpdateDomainIsolationResponse struct {
+	// FailedShards are the shards which failed to apply the change
+	FailedShards []int32 `json:"failedShards,omitempty"`
+}
+
+// GetFailedShards is an internal getter
+func (v *HistoryUpdateDomainIsolationResponse) GetFailedShards() (o []int32) {
+	if v != nil && v.FailedShards != nil {
+		return v.FailedShards
+	}
+	return
+}
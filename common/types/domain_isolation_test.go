@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateDomainIsolation_Getters(t *testing.T) {
+	var emptyRequest *UpdateDomainIsolationRequest
+	assert.Equal(t, "", emptyRequest.GetDomain())
+	assert.False(t, emptyRequest.GetIsolated())
+	request := &UpdateDomainIsolationRequest{Domain: "d", Isolated: true}
+	assert.Equal(t, "d", request.GetDomain())
+	assert.True(t, request.GetIsolated())
+
+	var emptyResponse *UpdateDomainIsolationResponse
+	assert.Nil(t, emptyResponse.GetFailedShards())
+	assert.Equal(t, []int32{1}, (&UpdateDomainIsolationResponse{FailedShards: []int32{1}}).GetFailedShards())
+
+	var emptyHistoryRequest *HistoryUpdateDomainIsolationRequest
+	assert.Equal(t, "", emptyHistoryRequest.GetDomainUUID())
+	assert.False(t, emptyHistoryRequest.GetIsolated())
+	historyRequest := &HistoryUpdateDomainIsolationRequest{DomainUUID: "id", Isolated: true}
+	assert.Equal(t, "id", historyRequest.GetDomainUUID())
+	assert.True(t, historyRequest.GetIsolated())
+
+	var emptyHistoryResponse *HistoryUpdateDomainIsolationResponse
+	assert.Nil(t, emptyHistoryResponse.GetFailedShards())
+	assert.Equal(t, []int32{2}, (&HistoryUpdateDomainIsolationResponse{FailedShards: []int32{2}}).GetFailedShards())
+}
@@ -1723,8 +1723,9 @@ func TestPendingActivityInfoArrayFuzz(t *testing.T) {
 	// LastFailureReason and LastFailureDetails have asymmetric mapping:
 	// FromFailure only creates a Failure object if reason is non-nil, so details without reason are dropped
 	// Excluding both fields from comparison to handle this asymmetry
+	// Paused has no field in the api.v1 PendingActivityInfo yet
 	testutils.RunMapperFuzzTest(t, FromPendingActivityInfoArray, ToPendingActivityInfoArray,
-		testutils.WithExcludedFields("LastFailureReason", "LastFailureDetails", "LastFailureOptions"),
+		testutils.WithExcludedFields("LastFailureReason", "LastFailureDetails", "LastFailureOptions", "Paused"),
 	)
 }
 
@@ -2727,8 +2728,9 @@ func TestWorkflowExecutionInfoFuzz(t *testing.T) {
 	// [Intended] ParentDomainID, ParentDomain: converted to empty string instead of nil when ParentExecutionInfo exists but field is empty
 	// [Intended] ParentInitiatedID: converted to 0 instead of nil when ParentExecutionInfo exists but field is 0
 	// [BUG] CronSchedule is not round trip safe with an empty string
+	// IsPaused has no field in the api.v1 WorkflowExecutionInfo yet
 	testutils.RunMapperFuzzTest(t, FromWorkflowExecutionInfo, ToWorkflowExecutionInfo,
-		testutils.WithExcludedFields("UpdateTime", "ParentDomainID", "ParentDomain", "ParentInitiatedID", "CronSchedule"),
+		testutils.WithExcludedFields("UpdateTime", "ParentDomainID", "ParentDomain", "ParentInitiatedID", "CronSchedule", "IsPaused"),
 	)
 }
 
@@ -2903,8 +2905,10 @@ func PendingActivityInfoFuzzer(a *types.PendingActivityInfo, c fuzz.Continue) {
 }
 
 func TestPendingActivityInfoFuzz(t *testing.T) {
+	// Paused is not carried by the IDL yet
 	testutils.RunMapperFuzzTest(t, FromPendingActivityInfo, ToPendingActivityInfo,
 		testutils.WithCustomFuncs(PendingActivityInfoFuzzer),
+		testutils.WithExcludedFields("Paused"),
 	)
 }
 
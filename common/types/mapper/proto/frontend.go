@@ -0,0 +1,1198 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
+	"github.com/uber/cadence/common/types"
+)
+
+// --- Enum mappers ---
+
+func FromScheduleActionOutcome(o types.ScheduleActionOutcome) frontendv1.ScheduleActionOutcome {
+	switch o {
+	case types.ScheduleActionOutcomeStarted:
+		return frontendv1.ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_STARTED
+	case types.ScheduleActionOutcomeSkipped:
+		return frontendv1.ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_SKIPPED
+	case types.ScheduleActionOutcomeFailed:
+		return frontendv1.ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_FAILED
+	}
+	return frontendv1.ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_INVALID
+}
+
+func ToScheduleActionOutcome(o frontendv1.ScheduleActionOutcome) types.ScheduleActionOutcome {
+	switch o {
+	case frontendv1.ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_STARTED:
+		return types.ScheduleActionOutcomeStarted
+	case frontendv1.ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_SKIPPED:
+		return types.ScheduleActionOutcomeSkipped
+	case frontendv1.ScheduleActionOutcome_SCHEDULE_ACTION_OUTCOME_FAILED:
+		return types.ScheduleActionOutcomeFailed
+	}
+	return types.ScheduleActionOutcomeInvalid
+}
+
+func FromScheduleBatchOperationType(t types.ScheduleBatchOperationType) frontendv1.ScheduleBatchOperationType {
+	switch t {
+	case types.ScheduleBatchOperationTypeTerminate:
+		return frontendv1.ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_TERMINATE
+	case types.ScheduleBatchOperationTypeCancel:
+		return frontendv1.ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_CANCEL
+	case types.ScheduleBatchOperationTypeSignal:
+		return frontendv1.ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_SIGNAL
+	}
+	return frontendv1.ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_INVALID
+}
+
+func ToScheduleBatchOperationType(t frontendv1.ScheduleBatchOperationType) types.ScheduleBatchOperationType {
+	switch t {
+	case frontendv1.ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_TERMINATE:
+		return types.ScheduleBatchOperationTypeTerminate
+	case frontendv1.ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_CANCEL:
+		return types.ScheduleBatchOperationTypeCancel
+	case frontendv1.ScheduleBatchOperationType_SCHEDULE_BATCH_OPERATION_TYPE_SIGNAL:
+		return types.ScheduleBatchOperationTypeSignal
+	}
+	return types.ScheduleBatchOperationTypeInvalid
+}
+
+// --- Schedule mappers ---
+
+func FromFrontendScheduleSpec(t *types.ScheduleSpec) *frontendv1.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ScheduleSpec{
+		CronExpression:   t.CronExpression,
+		StartTime:        timeToTimestamp(&t.StartTime),
+		EndTime:          timeToTimestamp(&t.EndTime),
+		Jitter:           durationToDurationProto(t.Jitter),
+		Calendars:        FromScheduleCalendarSpecArray(t.Calendars),
+		Intervals:        FromScheduleIntervalSpecArray(t.Intervals),
+		ExcludeCalendars: FromScheduleCalendarSpecArray(t.ExcludeCalendars),
+		Timezone:         t.Timezone,
+	}
+}
+
+func ToFrontendScheduleSpec(t *frontendv1.ScheduleSpec) *types.ScheduleSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleSpec{
+		CronExpression:   t.CronExpression,
+		StartTime:        timestampToTimeVal(t.StartTime),
+		EndTime:          timestampToTimeVal(t.EndTime),
+		Jitter:           durationProtoToDuration(t.Jitter),
+		Calendars:        ToScheduleCalendarSpecArray(t.Calendars),
+		Intervals:        ToScheduleIntervalSpecArray(t.Intervals),
+		ExcludeCalendars: ToScheduleCalendarSpecArray(t.ExcludeCalendars),
+		Timezone:         t.Timezone,
+	}
+}
+
+func FromScheduleCalendarSpec(t *types.ScheduleCalendarSpec) *frontendv1.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ScheduleCalendarSpec{
+		Second:     t.Second,
+		Minute:     t.Minute,
+		Hour:       t.Hour,
+		DayOfMonth: t.DayOfMonth,
+		Month:      t.Month,
+		DayOfWeek:  t.DayOfWeek,
+		Comment:    t.Comment,
+	}
+}
+
+func ToScheduleCalendarSpec(t *frontendv1.ScheduleCalendarSpec) *types.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleCalendarSpec{
+		Second:     t.Second,
+		Minute:     t.Minute,
+		Hour:       t.Hour,
+		DayOfMonth: t.DayOfMonth,
+		Month:      t.Month,
+		DayOfWeek:  t.DayOfWeek,
+		Comment:    t.Comment,
+	}
+}
+
+func FromScheduleCalendarSpecArray(t []*types.ScheduleCalendarSpec) []*frontendv1.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.ScheduleCalendarSpec, len(t))
+	for i := range t {
+		v[i] = FromScheduleCalendarSpec(t[i])
+	}
+	return v
+}
+
+func ToScheduleCalendarSpecArray(t []*frontendv1.ScheduleCalendarSpec) []*types.ScheduleCalendarSpec {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleCalendarSpec, len(t))
+	for i := range t {
+		v[i] = ToScheduleCalendarSpec(t[i])
+	}
+	return v
+}
+
+func FromScheduleIntervalSpec(t *types.ScheduleIntervalSpec) *frontendv1.ScheduleIntervalSpec {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ScheduleIntervalSpec{
+		Interval: durationToDurationProto(t.Interval),
+		Phase:    durationToDurationProto(t.Phase),
+	}
+}
+
+func ToScheduleIntervalSpec(t *frontendv1.ScheduleIntervalSpec) *types.ScheduleIntervalSpec {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleIntervalSpec{
+		Interval: durationProtoToDuration(t.Interval),
+		Phase:    durationProtoToDuration(t.Phase),
+	}
+}
+
+func FromScheduleIntervalSpecArray(t []*types.ScheduleIntervalSpec) []*frontendv1.ScheduleIntervalSpec {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.ScheduleIntervalSpec, len(t))
+	for i := range t {
+		v[i] = FromScheduleIntervalSpec(t[i])
+	}
+	return v
+}
+
+func ToScheduleIntervalSpecArray(t []*frontendv1.ScheduleIntervalSpec) []*types.ScheduleIntervalSpec {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleIntervalSpec, len(t))
+	for i := range t {
+		v[i] = ToScheduleIntervalSpec(t[i])
+	}
+	return v
+}
+
+func FromFrontendScheduleAction(t *types.ScheduleAction) *frontendv1.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ScheduleAction{
+		StartWorkflow:  FromStartWorkflowAction(t.StartWorkflow),
+		SignalWorkflow: FromSignalWorkflowAction(t.SignalWorkflow),
+		BatchOperation: FromBatchOperationAction(t.BatchOperation),
+	}
+}
+
+func ToFrontendScheduleAction(t *frontendv1.ScheduleAction) *types.ScheduleAction {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleAction{
+		StartWorkflow:  ToStartWorkflowAction(t.StartWorkflow),
+		SignalWorkflow: ToSignalWorkflowAction(t.SignalWorkflow),
+		BatchOperation: ToBatchOperationAction(t.BatchOperation),
+	}
+}
+
+func FromSignalWorkflowAction(t *types.SignalWorkflowAction) *frontendv1.SignalWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.SignalWorkflowAction{
+		WorkflowId:    t.WorkflowID,
+		SignalName:    t.SignalName,
+		SignalInput:   FromPayload(t.SignalInput),
+		StartWorkflow: FromStartWorkflowAction(t.StartWorkflow),
+	}
+}
+
+func ToSignalWorkflowAction(t *frontendv1.SignalWorkflowAction) *types.SignalWorkflowAction {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalWorkflowAction{
+		WorkflowID:    t.WorkflowId,
+		SignalName:    t.SignalName,
+		SignalInput:   ToPayload(t.SignalInput),
+		StartWorkflow: ToStartWorkflowAction(t.StartWorkflow),
+	}
+}
+
+func FromBatchOperationAction(t *types.BatchOperationAction) *frontendv1.BatchOperationAction {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.BatchOperationAction{
+		OperationType: FromScheduleBatchOperationType(t.OperationType),
+		Query:         t.Query,
+		Reason:        t.Reason,
+		SignalName:    t.SignalName,
+		SignalInput:   t.SignalInput,
+		Rps:           t.RPS,
+		Concurrency:   t.Concurrency,
+	}
+}
+
+func ToBatchOperationAction(t *frontendv1.BatchOperationAction) *types.BatchOperationAction {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationAction{
+		OperationType: ToScheduleBatchOperationType(t.OperationType),
+		Query:         t.Query,
+		Reason:        t.Reason,
+		SignalName:    t.SignalName,
+		SignalInput:   t.SignalInput,
+		RPS:           t.Rps,
+		Concurrency:   t.Concurrency,
+	}
+}
+
+func FromFrontendSchedulePolicies(t *types.SchedulePolicies) *frontendv1.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.SchedulePolicies{
+		OverlapPolicy:           FromScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchUpPolicy:           FromScheduleCatchUpPolicy(t.CatchUpPolicy),
+		CatchUpWindow:           durationToDurationProto(t.CatchUpWindow),
+		PauseOnFailure:          t.PauseOnFailure,
+		BufferLimit:             t.BufferLimit,
+		ConcurrencyLimit:        t.ConcurrencyLimit,
+		LimitedActions:          t.LimitedActions,
+		RemainingActions:        t.RemainingActions,
+		PauseOnFailureThreshold: t.PauseOnFailureThreshold,
+		PauseOnFailureCooldown:  durationToDurationProto(t.PauseOnFailureCooldown),
+	}
+}
+
+func ToFrontendSchedulePolicies(t *frontendv1.SchedulePolicies) *types.SchedulePolicies {
+	if t == nil {
+		return nil
+	}
+	return &types.SchedulePolicies{
+		OverlapPolicy:           ToScheduleOverlapPolicy(t.OverlapPolicy),
+		CatchUpPolicy:           ToScheduleCatchUpPolicy(t.CatchUpPolicy),
+		CatchUpWindow:           durationProtoToDuration(t.CatchUpWindow),
+		PauseOnFailure:          t.PauseOnFailure,
+		BufferLimit:             t.BufferLimit,
+		ConcurrencyLimit:        t.ConcurrencyLimit,
+		LimitedActions:          t.LimitedActions,
+		RemainingActions:        t.RemainingActions,
+		PauseOnFailureThreshold: t.PauseOnFailureThreshold,
+		PauseOnFailureCooldown:  durationProtoToDuration(t.PauseOnFailureCooldown),
+	}
+}
+
+func FromFrontendSchedulePauseInfo(t *types.SchedulePauseInfo) *frontendv1.SchedulePauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.SchedulePauseInfo{
+		Reason:          t.Reason,
+		PausedAt:        timeToTimestamp(&t.PausedAt),
+		PausedBy:        t.PausedBy,
+		AutoUnpauseTime: timeToTimestamp(&t.AutoUnpauseTime),
+	}
+}
+
+func ToFrontendSchedulePauseInfo(t *frontendv1.SchedulePauseInfo) *types.SchedulePauseInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.SchedulePauseInfo{
+		Reason:          t.Reason,
+		PausedAt:        timestampToTimeVal(t.PausedAt),
+		PausedBy:        t.PausedBy,
+		AutoUnpauseTime: timestampToTimeVal(t.AutoUnpauseTime),
+	}
+}
+
+func FromFrontendScheduleState(t *types.ScheduleState) *frontendv1.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ScheduleState{
+		Paused:    t.Paused,
+		PauseInfo: FromFrontendSchedulePauseInfo(t.PauseInfo),
+		Completed: t.Completed,
+	}
+}
+
+func ToFrontendScheduleState(t *frontendv1.ScheduleState) *types.ScheduleState {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleState{
+		Paused:    t.Paused,
+		PauseInfo: ToFrontendSchedulePauseInfo(t.PauseInfo),
+		Completed: t.Completed,
+	}
+}
+
+func FromFrontendScheduleListEntry(t *types.ScheduleListEntry) *frontendv1.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ScheduleListEntry{
+		ScheduleId:     t.ScheduleID,
+		WorkflowType:   FromWorkflowType(t.WorkflowType),
+		State:          FromFrontendScheduleState(t.State),
+		CronExpression: t.CronExpression,
+	}
+}
+
+func ToFrontendScheduleListEntry(t *frontendv1.ScheduleListEntry) *types.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleListEntry{
+		ScheduleID:     t.ScheduleId,
+		WorkflowType:   ToWorkflowType(t.WorkflowType),
+		State:          ToFrontendScheduleState(t.State),
+		CronExpression: t.CronExpression,
+	}
+}
+
+func FromFrontendScheduleListEntryArray(t []*types.ScheduleListEntry) []*frontendv1.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = FromFrontendScheduleListEntry(t[i])
+	}
+	return v
+}
+
+func ToFrontendScheduleListEntryArray(t []*frontendv1.ScheduleListEntry) []*types.ScheduleListEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleListEntry, len(t))
+	for i := range t {
+		v[i] = ToFrontendScheduleListEntry(t[i])
+	}
+	return v
+}
+
+func FromScheduleActionResult(t *types.ScheduleActionResult) *frontendv1.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ScheduleActionResult{
+		ScheduledTime:     timeToTimestamp(&t.ScheduledTime),
+		ActualTime:        timeToTimestamp(&t.ActualTime),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Outcome:           FromScheduleActionOutcome(t.Outcome),
+	}
+}
+
+func ToScheduleActionResult(t *frontendv1.ScheduleActionResult) *types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleActionResult{
+		ScheduledTime:     timestampToTimeVal(t.ScheduledTime),
+		ActualTime:        timestampToTimeVal(t.ActualTime),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Outcome:           ToScheduleActionOutcome(t.Outcome),
+	}
+}
+
+func FromScheduleActionResultArray(t []*types.ScheduleActionResult) []*frontendv1.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = FromScheduleActionResult(t[i])
+	}
+	return v
+}
+
+func ToScheduleActionResultArray(t []*frontendv1.ScheduleActionResult) []*types.ScheduleActionResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ScheduleActionResult, len(t))
+	for i := range t {
+		v[i] = ToScheduleActionResult(t[i])
+	}
+	return v
+}
+
+func FromFrontendScheduleInfo(t *types.ScheduleInfo) *frontendv1.ScheduleInfo {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ScheduleInfo{
+		LastRunTime:          timeToTimestamp(&t.LastRunTime),
+		NextRunTime:          timeToTimestamp(&t.NextRunTime),
+		TotalRuns:            t.TotalRuns,
+		MissedRuns:           t.MissedRuns,
+		SkippedRuns:          t.SkippedRuns,
+		CreateTime:           timeToTimestamp(&t.CreateTime),
+		LastUpdateTime:       timeToTimestamp(&t.LastUpdateTime),
+		OngoingBackfills:     FromBackfillInfoArray(t.OngoingBackfills),
+		BufferedFireCount:    t.BufferedFireCount,
+		RunningWorkflowCount: t.RunningWorkflowCount,
+		RecentActions:        FromScheduleActionResultArray(t.RecentActions),
+		ConsecutiveFailures:  t.ConsecutiveFailures,
+		LastFailure:          FromScheduleFailureInfo(t.LastFailure),
+	}
+}
+
+func ToFrontendScheduleInfo(t *frontendv1.ScheduleInfo) *types.ScheduleInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleInfo{
+		LastRunTime:          timestampToTimeVal(t.LastRunTime),
+		NextRunTime:          timestampToTimeVal(t.NextRunTime),
+		TotalRuns:            t.TotalRuns,
+		MissedRuns:           t.MissedRuns,
+		SkippedRuns:          t.SkippedRuns,
+		CreateTime:           timestampToTimeVal(t.CreateTime),
+		LastUpdateTime:       timestampToTimeVal(t.LastUpdateTime),
+		OngoingBackfills:     ToBackfillInfoArray(t.OngoingBackfills),
+		BufferedFireCount:    t.BufferedFireCount,
+		RunningWorkflowCount: t.RunningWorkflowCount,
+		RecentActions:        ToScheduleActionResultArray(t.RecentActions),
+		ConsecutiveFailures:  t.ConsecutiveFailures,
+		LastFailure:          ToScheduleFailureInfo(t.LastFailure),
+	}
+}
+
+func FromScheduleFailureInfo(t *types.ScheduleFailureInfo) *frontendv1.ScheduleFailureInfo {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ScheduleFailureInfo{
+		WorkflowExecution:   FromWorkflowExecution(t.WorkflowExecution),
+		CloseStatus:         FromWorkflowExecutionCloseStatus(&t.CloseStatus),
+		FailureReason:       t.FailureReason,
+		ObservedTime:        timeToTimestamp(&t.ObservedTime),
+		ConsecutiveFailures: t.ConsecutiveFailures,
+	}
+}
+
+func ToScheduleFailureInfo(t *frontendv1.ScheduleFailureInfo) *types.ScheduleFailureInfo {
+	if t == nil {
+		return nil
+	}
+	var closeStatus types.WorkflowExecutionCloseStatus
+	if s := ToWorkflowExecutionCloseStatus(t.CloseStatus); s != nil {
+		closeStatus = *s
+	}
+	return &types.ScheduleFailureInfo{
+		WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+		CloseStatus:         closeStatus,
+		FailureReason:       t.FailureReason,
+		ObservedTime:        timestampToTimeVal(t.ObservedTime),
+		ConsecutiveFailures: t.ConsecutiveFailures,
+	}
+}
+
+// --- Schedule request/response mappers ---
+
+func FromFrontendCreateScheduleRequest(t *types.CreateScheduleRequest) *frontendv1.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.CreateScheduleRequest{
+		Domain:           t.Domain,
+		ScheduleId:       t.ScheduleID,
+		Spec:             FromFrontendScheduleSpec(t.Spec),
+		Action:           FromFrontendScheduleAction(t.Action),
+		Policies:         FromFrontendSchedulePolicies(t.Policies),
+		Memo:             FromMemo(t.Memo),
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+func ToFrontendCreateScheduleRequest(t *frontendv1.CreateScheduleRequest) *types.CreateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleRequest{
+		Domain:           t.Domain,
+		ScheduleID:       t.ScheduleId,
+		Spec:             ToFrontendScheduleSpec(t.Spec),
+		Action:           ToFrontendScheduleAction(t.Action),
+		Policies:         ToFrontendSchedulePolicies(t.Policies),
+		Memo:             ToMemo(t.Memo),
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+func FromFrontendCreateScheduleResponse(t *types.CreateScheduleResponse) *frontendv1.CreateScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.CreateScheduleResponse{
+		ScheduleId: t.ScheduleID,
+	}
+}
+
+func ToFrontendCreateScheduleResponse(t *frontendv1.CreateScheduleResponse) *types.CreateScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.CreateScheduleResponse{
+		ScheduleID: t.ScheduleId,
+	}
+}
+
+func FromFrontendDescribeScheduleRequest(t *types.DescribeScheduleRequest) *frontendv1.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleId: t.ScheduleID,
+	}
+}
+
+func ToFrontendDescribeScheduleRequest(t *frontendv1.DescribeScheduleRequest) *types.DescribeScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleRequest{
+		Domain:     t.Domain,
+		ScheduleID: t.ScheduleId,
+	}
+}
+
+func FromFrontendDescribeScheduleResponse(t *types.DescribeScheduleResponse) *frontendv1.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DescribeScheduleResponse{
+		Spec:             FromFrontendScheduleSpec(t.Spec),
+		Action:           FromFrontendScheduleAction(t.Action),
+		Policies:         FromFrontendSchedulePolicies(t.Policies),
+		State:            FromFrontendScheduleState(t.State),
+		Info:             FromFrontendScheduleInfo(t.Info),
+		Memo:             FromMemo(t.Memo),
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+func ToFrontendDescribeScheduleResponse(t *frontendv1.DescribeScheduleResponse) *types.DescribeScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeScheduleResponse{
+		Spec:             ToFrontendScheduleSpec(t.Spec),
+		Action:           ToFrontendScheduleAction(t.Action),
+		Policies:         ToFrontendSchedulePolicies(t.Policies),
+		State:            ToFrontendScheduleState(t.State),
+		Info:             ToFrontendScheduleInfo(t.Info),
+		Memo:             ToMemo(t.Memo),
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+func FromFrontendListSchedulesRequest(t *types.ListSchedulesRequest) *frontendv1.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToFrontendListSchedulesRequest(t *frontendv1.ListSchedulesRequest) *types.ListSchedulesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromFrontendListSchedulesResponse(t *types.ListSchedulesResponse) *frontendv1.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ListSchedulesResponse{
+		Schedules:     FromFrontendScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToFrontendListSchedulesResponse(t *frontendv1.ListSchedulesResponse) *types.ListSchedulesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListSchedulesResponse{
+		Schedules:     ToFrontendScheduleListEntryArray(t.Schedules),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromFrontendUpdateScheduleRequest(t *types.UpdateScheduleRequest) *frontendv1.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.UpdateScheduleRequest{
+		Domain:           t.Domain,
+		ScheduleId:       t.ScheduleID,
+		Spec:             FromFrontendScheduleSpec(t.Spec),
+		Action:           FromFrontendScheduleAction(t.Action),
+		Policies:         FromFrontendSchedulePolicies(t.Policies),
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+func ToFrontendUpdateScheduleRequest(t *frontendv1.UpdateScheduleRequest) *types.UpdateScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleRequest{
+		Domain:           t.Domain,
+		ScheduleID:       t.ScheduleId,
+		Spec:             ToFrontendScheduleSpec(t.Spec),
+		Action:           ToFrontendScheduleAction(t.Action),
+		Policies:         ToFrontendSchedulePolicies(t.Policies),
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+func FromFrontendUpdateScheduleResponse(t *types.UpdateScheduleResponse) *frontendv1.UpdateScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.UpdateScheduleResponse{}
+}
+
+func ToFrontendUpdateScheduleResponse(t *frontendv1.UpdateScheduleResponse) *types.UpdateScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateScheduleResponse{}
+}
+
+func FromFrontendTriggerScheduleRequest(t *types.TriggerScheduleRequest) *frontendv1.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.TriggerScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleId:    t.ScheduleID,
+		OverlapPolicy: FromScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.Identity,
+	}
+}
+
+func ToFrontendTriggerScheduleRequest(t *frontendv1.TriggerScheduleRequest) *types.TriggerScheduleRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.TriggerScheduleRequest{
+		Domain:        t.Domain,
+		ScheduleID:    t.ScheduleId,
+		OverlapPolicy: ToScheduleOverlapPolicy(t.OverlapPolicy),
+		Identity:      t.Identity,
+	}
+}
+
+func FromFrontendTriggerScheduleResponse(t *types.TriggerScheduleResponse) *frontendv1.TriggerScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.TriggerScheduleResponse{}
+}
+
+func ToFrontendTriggerScheduleResponse(t *frontendv1.TriggerScheduleResponse) *types.TriggerScheduleResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.TriggerScheduleResponse{}
+}
+
+func FromFrontendListScheduleMatchingTimesRequest(t *types.ListScheduleMatchingTimesRequest) *frontendv1.ListScheduleMatchingTimesRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ListScheduleMatchingTimesRequest{
+		Domain:       t.Domain,
+		ScheduleId:   t.ScheduleID,
+		Spec:         FromFrontendScheduleSpec(t.Spec),
+		StartTime:    timeToTimestamp(&t.StartTime),
+		EndTime:      timeToTimestamp(&t.EndTime),
+		MaximumTimes: t.MaximumTimes,
+	}
+}
+
+func ToFrontendListScheduleMatchingTimesRequest(t *frontendv1.ListScheduleMatchingTimesRequest) *types.ListScheduleMatchingTimesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListScheduleMatchingTimesRequest{
+		Domain:       t.Domain,
+		ScheduleID:   t.ScheduleId,
+		Spec:         ToFrontendScheduleSpec(t.Spec),
+		StartTime:    timestampToTimeVal(t.StartTime),
+		EndTime:      timestampToTimeVal(t.EndTime),
+		MaximumTimes: t.MaximumTimes,
+	}
+}
+
+func FromFrontendListScheduleMatchingTimesResponse(t *types.ListScheduleMatchingTimesResponse) *frontendv1.ListScheduleMatchingTimesResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ListScheduleMatchingTimesResponse{
+		StartTimes:       timeArrayToTimestampArray(t.StartTimes),
+		TotalCount:       t.TotalCount,
+		TotalCountCapped: t.TotalCountCapped,
+	}
+}
+
+func ToFrontendListScheduleMatchingTimesResponse(t *frontendv1.ListScheduleMatchingTimesResponse) *types.ListScheduleMatchingTimesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListScheduleMatchingTimesResponse{
+		StartTimes:       timestampArrayToTimeArray(t.StartTimes),
+		TotalCount:       t.TotalCount,
+		TotalCountCapped: t.TotalCountCapped,
+	}
+}
+
+// --- Activity operation mappers ---
+
+func FromFrontendPauseActivityRequest(t *types.PauseActivityRequest) *frontendv1.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.PauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+		Reason:            t.Reason,
+	}
+}
+
+func ToFrontendPauseActivityRequest(t *frontendv1.PauseActivityRequest) *types.PauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+		Reason:            t.Reason,
+	}
+}
+
+func FromFrontendPauseActivityResponse(t *types.PauseActivityResponse) *frontendv1.PauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.PauseActivityResponse{}
+}
+
+func ToFrontendPauseActivityResponse(t *frontendv1.PauseActivityResponse) *types.PauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityResponse{}
+}
+
+func FromFrontendUnpauseActivityRequest(t *types.UnpauseActivityRequest) *frontendv1.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.UnpauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+		ResetAttempts:     t.ResetAttempts,
+	}
+}
+
+func ToFrontendUnpauseActivityRequest(t *frontendv1.UnpauseActivityRequest) *types.UnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+		ResetAttempts:     t.ResetAttempts,
+	}
+}
+
+func FromFrontendUnpauseActivityResponse(t *types.UnpauseActivityResponse) *frontendv1.UnpauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.UnpauseActivityResponse{}
+}
+
+func ToFrontendUnpauseActivityResponse(t *frontendv1.UnpauseActivityResponse) *types.UnpauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityResponse{}
+}
+
+func FromFrontendResetActivityRequest(t *types.ResetActivityRequest) *frontendv1.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Identity:          t.Identity,
+	}
+}
+
+func ToFrontendResetActivityRequest(t *frontendv1.ResetActivityRequest) *types.ResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Identity:          t.Identity,
+	}
+}
+
+func FromFrontendResetActivityResponse(t *types.ResetActivityResponse) *frontendv1.ResetActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ResetActivityResponse{}
+}
+
+func ToFrontendResetActivityResponse(t *frontendv1.ResetActivityResponse) *types.ResetActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityResponse{}
+}
+
+// --- Activity options mappers ---
+
+func FromFrontendUpdateActivityOptionsRequest(t *types.UpdateActivityOptionsRequest) *frontendv1.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.UpdateActivityOptionsRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:        t.ActivityID,
+		Options:           FromFrontendActivityOptions(t.Options),
+		Identity:          t.Identity,
+	}
+}
+
+func ToFrontendUpdateActivityOptionsRequest(t *frontendv1.UpdateActivityOptionsRequest) *types.UpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		ActivityID:        t.ActivityId,
+		Options:           ToFrontendActivityOptions(t.Options),
+		Identity:          t.Identity,
+	}
+}
+
+func FromFrontendUpdateActivityOptionsResponse(t *types.UpdateActivityOptionsResponse) *frontendv1.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.UpdateActivityOptionsResponse{}
+}
+
+func ToFrontendUpdateActivityOptionsResponse(t *frontendv1.UpdateActivityOptionsResponse) *types.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsResponse{}
+}
+
+func FromFrontendActivityOptions(t *types.ActivityOptions) *frontendv1.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ActivityOptions{
+		TaskList:               FromTaskList(t.TaskList),
+		ScheduleToCloseTimeout: secondsToDuration(t.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+func ToFrontendActivityOptions(t *frontendv1.ActivityOptions) *types.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityOptions{
+		TaskList:                      ToTaskList(t.TaskList),
+		ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+		StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// --- Workflow pause mappers ---
+
+func FromFrontendPauseWorkflowExecutionRequest(t *types.PauseWorkflowExecutionRequest) *frontendv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func ToFrontendPauseWorkflowExecutionRequest(t *frontendv1.PauseWorkflowExecutionRequest) *types.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.Reason,
+		Identity:          t.Identity,
+	}
+}
+
+func FromFrontendPauseWorkflowExecutionResponse(t *types.PauseWorkflowExecutionResponse) *frontendv1.PauseWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.PauseWorkflowExecutionResponse{}
+}
+
+func ToFrontendPauseWorkflowExecutionResponse(t *frontendv1.PauseWorkflowExecutionResponse) *types.PauseWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionResponse{}
+}
+
+func FromFrontendResumeWorkflowExecutionRequest(t *types.ResumeWorkflowExecutionRequest) *frontendv1.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ResumeWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
+	}
+}
+
+func ToFrontendResumeWorkflowExecutionRequest(t *frontendv1.ResumeWorkflowExecutionRequest) *types.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.Identity,
+	}
+}
+
+func FromFrontendResumeWorkflowExecutionResponse(t *types.ResumeWorkflowExecutionResponse) *frontendv1.ResumeWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ResumeWorkflowExecutionResponse{}
+}
+
+func ToFrontendResumeWorkflowExecutionResponse(t *frontendv1.ResumeWorkflowExecutionResponse) *types.ResumeWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeWorkflowExecutionResponse{}
+}
+
+// --- Domain replication status mappers ---
+
+func FromFrontendGetDomainReplicationStatusRequest(t *types.GetDomainReplicationStatusRequest) *frontendv1.GetDomainReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.GetDomainReplicationStatusRequest{
+		Domain: t.Domain,
+	}
+}
+
+func ToFrontendGetDomainReplicationStatusRequest(t *frontendv1.GetDomainReplicationStatusRequest) *types.GetDomainReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainReplicationStatusRequest{
+		Domain: t.Domain,
+	}
+}
+
+func FromFrontendGetDomainReplicationStatusResponse(t *types.GetDomainReplicationStatusResponse) *frontendv1.GetDomainReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.GetDomainReplicationStatusResponse{
+		Domain:       t.Domain,
+		Clusters:     FromFrontendReplicationClusterStatusArray(t.Clusters),
+		FailedShards: t.FailedShards,
+	}
+}
+
+func ToFrontendGetDomainReplicationStatusResponse(t *frontendv1.GetDomainReplicationStatusResponse) *types.GetDomainReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainReplicationStatusResponse{
+		Domain:       t.Domain,
+		Clusters:     ToFrontendReplicationClusterStatusArray(t.Clusters),
+		FailedShards: t.FailedShards,
+	}
+}
+
+func FromFrontendReplicationClusterStatus(t *types.ReplicationClusterStatus) *frontendv1.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ReplicationClusterStatus{
+		ClusterName:          t.ClusterName,
+		LastReplicatedTaskId: t.LastReplicatedTaskID,
+		LastReplicatedTime:   unixNanoToTime(t.LastReplicatedTimestamp),
+		PendingTaskCount:     t.PendingTaskCount,
+		HasMorePendingTasks:  t.HasMorePendingTasks,
+		DlqMessageCount:      t.DLQMessageCount,
+		EstimatedLag:         durationToDurationProto(t.EstimatedLag),
+	}
+}
+
+func ToFrontendReplicationClusterStatus(t *frontendv1.ReplicationClusterStatus) *types.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationClusterStatus{
+		ClusterName:             t.ClusterName,
+		LastReplicatedTaskID:    t.LastReplicatedTaskId,
+		LastReplicatedTimestamp: timeToUnixNano(t.LastReplicatedTime),
+		PendingTaskCount:        t.PendingTaskCount,
+		HasMorePendingTasks:     t.HasMorePendingTasks,
+		DLQMessageCount:         t.DlqMessageCount,
+		EstimatedLag:            durationProtoToDuration(t.EstimatedLag),
+	}
+}
+
+func FromFrontendReplicationClusterStatusArray(t []*types.ReplicationClusterStatus) []*frontendv1.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.ReplicationClusterStatus, len(t))
+	for i := range t {
+		v[i] = FromFrontendReplicationClusterStatus(t[i])
+	}
+	return v
+}
+
+func ToFrontendReplicationClusterStatusArray(t []*frontendv1.ReplicationClusterStatus) []*types.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationClusterStatus, len(t))
+	for i := range t {
+		v[i] = ToFrontendReplicationClusterStatus(t[i])
+	}
+	return v
+}
+
+// --- Domain isolation mappers ---
+
+func FromFrontendUpdateDomainIsolationRequest(t *types.UpdateDomainIsolationRequest) *frontendv1.UpdateDomainIsolationRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.UpdateDomainIsolationRequest{
+		Domain:   t.Domain,
+		Isolated: t.Isolated,
+	}
+}
+
+func ToFrontendUpdateDomainIsolationRequest(t *frontendv1.UpdateDomainIsolationRequest) *types.UpdateDomainIsolationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateDomainIsolationRequest{
+		Domain:   t.Domain,
+		Isolated: t.Isolated,
+	}
+}
+
+func FromFrontendUpdateDomainIsolationResponse(t *types.UpdateDomainIsolationResponse) *frontendv1.UpdateDomainIsolationResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.UpdateDomainIsolationResponse{
+		FailedShards: t.FailedShards,
+	}
+}
+
+func ToFrontendUpdateDomainIsolationResponse(t *frontendv1.UpdateDomainIsolationResponse) *types.UpdateDomainIsolationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateDomainIsolationResponse{
+		FailedShards: t.FailedShards,
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/testutils"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestFrontendCreateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.CreateScheduleRequest{nil, {}, &testdata.CreateScheduleRequest} {
+		assert.Equal(t, item, ToFrontendCreateScheduleRequest(FromFrontendCreateScheduleRequest(item)))
+	}
+}
+
+func TestFrontendDescribeScheduleResponse(t *testing.T) {
+	for _, item := range []*types.DescribeScheduleResponse{nil, {}, &testdata.DescribeScheduleResponse} {
+		assert.Equal(t, item, ToFrontendDescribeScheduleResponse(FromFrontendDescribeScheduleResponse(item)))
+	}
+}
+
+func TestFrontendUpdateScheduleRequest(t *testing.T) {
+	for _, item := range []*types.UpdateScheduleRequest{nil, {}, &testdata.UpdateScheduleRequest} {
+		assert.Equal(t, item, ToFrontendUpdateScheduleRequest(FromFrontendUpdateScheduleRequest(item)))
+	}
+}
+
+func TestFrontendScheduleSpecFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendScheduleSpec, ToFrontendScheduleSpec)
+}
+
+func TestScheduleCalendarSpecFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromScheduleCalendarSpec, ToScheduleCalendarSpec)
+}
+
+func TestFrontendScheduleActionFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendScheduleAction, ToFrontendScheduleAction,
+		withScheduleBatchOperationTypeFuzzer(),
+	)
+}
+
+func TestSignalWorkflowActionFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromSignalWorkflowAction, ToSignalWorkflowAction)
+}
+
+func TestBatchOperationActionFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromBatchOperationAction, ToBatchOperationAction,
+		withScheduleBatchOperationTypeFuzzer(),
+	)
+}
+
+func TestFrontendSchedulePoliciesFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendSchedulePolicies, ToFrontendSchedulePolicies,
+		WithScheduleEnumFuzzers(),
+	)
+}
+
+func TestFrontendSchedulePauseInfoFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendSchedulePauseInfo, ToFrontendSchedulePauseInfo)
+}
+
+func TestFrontendScheduleStateFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendScheduleState, ToFrontendScheduleState)
+}
+
+func TestFrontendScheduleListEntryFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendScheduleListEntry, ToFrontendScheduleListEntry)
+}
+
+func TestScheduleActionResultFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromScheduleActionResult, ToScheduleActionResult,
+		withScheduleActionOutcomeFuzzer(),
+	)
+}
+
+func TestFrontendScheduleInfoFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendScheduleInfo, ToFrontendScheduleInfo,
+		withScheduleActionOutcomeFuzzer(),
+		testutils.WithCustomFuncs(WorkflowExecutionCloseStatusFuzzer),
+	)
+}
+
+func TestScheduleFailureInfoFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromScheduleFailureInfo, ToScheduleFailureInfo,
+		testutils.WithCustomFuncs(WorkflowExecutionCloseStatusFuzzer),
+	)
+}
+
+func TestScheduleIntervalSpecFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromScheduleIntervalSpec, ToScheduleIntervalSpec)
+}
+
+func TestFrontendCreateScheduleRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendCreateScheduleRequest, ToFrontendCreateScheduleRequest,
+		WithScheduleEnumFuzzers(),
+		withScheduleBatchOperationTypeFuzzer(),
+	)
+}
+
+func TestFrontendCreateScheduleResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendCreateScheduleResponse, ToFrontendCreateScheduleResponse)
+}
+
+func TestFrontendDescribeScheduleRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendDescribeScheduleRequest, ToFrontendDescribeScheduleRequest)
+}
+
+func TestFrontendDescribeScheduleResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendDescribeScheduleResponse, ToFrontendDescribeScheduleResponse,
+		WithScheduleEnumFuzzers(),
+		withScheduleBatchOperationTypeFuzzer(),
+		withScheduleActionOutcomeFuzzer(),
+		testutils.WithCustomFuncs(WorkflowExecutionCloseStatusFuzzer),
+	)
+}
+
+func TestFrontendListSchedulesRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendListSchedulesRequest, ToFrontendListSchedulesRequest)
+}
+
+func TestFrontendListSchedulesResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendListSchedulesResponse, ToFrontendListSchedulesResponse)
+}
+
+func TestFrontendUpdateScheduleRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendUpdateScheduleRequest, ToFrontendUpdateScheduleRequest,
+		WithScheduleEnumFuzzers(),
+		withScheduleBatchOperationTypeFuzzer(),
+	)
+}
+
+func TestFrontendUpdateScheduleResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendUpdateScheduleResponse, ToFrontendUpdateScheduleResponse)
+}
+
+func TestFrontendTriggerScheduleRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendTriggerScheduleRequest, ToFrontendTriggerScheduleRequest,
+		WithScheduleEnumFuzzers(),
+	)
+}
+
+func TestFrontendTriggerScheduleResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendTriggerScheduleResponse, ToFrontendTriggerScheduleResponse)
+}
+
+func TestFrontendListScheduleMatchingTimesRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendListScheduleMatchingTimesRequest, ToFrontendListScheduleMatchingTimesRequest)
+}
+
+func TestFrontendListScheduleMatchingTimesResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendListScheduleMatchingTimesResponse, ToFrontendListScheduleMatchingTimesResponse)
+}
+
+func TestFrontendPauseActivityRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendPauseActivityRequest, ToFrontendPauseActivityRequest)
+}
+
+func TestFrontendPauseActivityResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendPauseActivityResponse, ToFrontendPauseActivityResponse)
+}
+
+func TestFrontendUnpauseActivityRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendUnpauseActivityRequest, ToFrontendUnpauseActivityRequest)
+}
+
+func TestFrontendUnpauseActivityResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendUnpauseActivityResponse, ToFrontendUnpauseActivityResponse)
+}
+
+func TestFrontendResetActivityRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendResetActivityRequest, ToFrontendResetActivityRequest)
+}
+
+func TestFrontendResetActivityResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendResetActivityResponse, ToFrontendResetActivityResponse)
+}
+
+func TestFrontendUpdateActivityOptionsRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendUpdateActivityOptionsRequest, ToFrontendUpdateActivityOptionsRequest)
+}
+
+func TestFrontendUpdateActivityOptionsResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendUpdateActivityOptionsResponse, ToFrontendUpdateActivityOptionsResponse)
+}
+
+func TestFrontendPauseWorkflowExecutionRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendPauseWorkflowExecutionRequest, ToFrontendPauseWorkflowExecutionRequest)
+}
+
+func TestFrontendPauseWorkflowExecutionResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendPauseWorkflowExecutionResponse, ToFrontendPauseWorkflowExecutionResponse)
+}
+
+func TestFrontendResumeWorkflowExecutionRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendResumeWorkflowExecutionRequest, ToFrontendResumeWorkflowExecutionRequest)
+}
+
+func TestFrontendResumeWorkflowExecutionResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendResumeWorkflowExecutionResponse, ToFrontendResumeWorkflowExecutionResponse)
+}
+
+func TestFrontendGetDomainReplicationStatusRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendGetDomainReplicationStatusRequest, ToFrontendGetDomainReplicationStatusRequest)
+}
+
+func TestFrontendGetDomainReplicationStatusResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendGetDomainReplicationStatusResponse, ToFrontendGetDomainReplicationStatusResponse)
+}
+
+func withScheduleBatchOperationTypeFuzzer() testutils.FuzzOption {
+	return testutils.WithCustomFuncs(
+		func(e *types.ScheduleBatchOperationType, c fuzz.Continue) {
+			*e = types.ScheduleBatchOperationType(c.Intn(4)) // 0-3: Invalid through Signal
+		},
+	)
+}
+
+func withScheduleActionOutcomeFuzzer() testutils.FuzzOption {
+	return testutils.WithCustomFuncs(
+		func(e *types.ScheduleActionOutcome, c fuzz.Continue) {
+			*e = types.ScheduleActionOutcome(c.Intn(4)) // 0-3: Invalid through Failed
+		},
+	)
+}
+
+func TestFrontendUpdateDomainIsolationRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendUpdateDomainIsolationRequest, ToFrontendUpdateDomainIsolationRequest)
+}
+
+func TestFrontendUpdateDomainIsolationResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromFrontendUpdateDomainIsolationResponse, ToFrontendUpdateDomainIsolationResponse)
+}
@@ -104,6 +104,28 @@ func timestampToTimeVal(t *gogo.Timestamp) time.Time {
 	return time.Unix(t.GetSeconds(), int64(t.GetNanos())).UTC()
 }
 
+func timeArrayToTimestampArray(t []time.Time) []*gogo.Timestamp {
+	if t == nil {
+		return nil
+	}
+	v := make([]*gogo.Timestamp, len(t))
+	for i := range t {
+		v[i] = timeToTimestamp(&t[i])
+	}
+	return v
+}
+
+func timestampArrayToTimeArray(t []*gogo.Timestamp) []time.Time {
+	if t == nil {
+		return nil
+	}
+	v := make([]time.Time, len(t))
+	for i := range t {
+		v[i] = timestampToTimeVal(t[i])
+	}
+	return v
+}
+
 func durationToDurationProto(d time.Duration) *gogo.Duration {
 	if d == 0 {
 		return nil
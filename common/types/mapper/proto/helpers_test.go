@@ -81,6 +81,10 @@ func TestTimeToTimestampFuzz(t *testing.T) {
 	)
 }
 
+func TestTimeArrayToTimestampArrayFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, timeArrayToTimestampArray, timestampArrayToTimeArray)
+}
+
 func TestDurationToDurationProtoFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, durationToDurationProto, durationProtoToDuration)
 }
@@ -1623,3 +1623,420 @@ func ToHistoryRatelimitUpdateResponse(t *historyv1.RatelimitUpdateResponse) *typ
 		Any: ToAny(t.Data),
 	}
 }
+
+func FromHistoryPauseActivityRequest(t *types.HistoryPauseActivityRequest) *historyv1.PauseActivityRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.PauseActivityRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+		Identity:          t.Request.Identity,
+		Reason:            t.Request.Reason,
+	}
+}
+
+func ToHistoryPauseActivityRequest(t *historyv1.PauseActivityRequest) *types.HistoryPauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseActivityRequest{
+		Request: &types.PauseActivityRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Identity:          t.Identity,
+			Reason:            t.Reason,
+		},
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromHistoryPauseActivityResponse(t *types.PauseActivityResponse) *historyv1.PauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseActivityResponse{}
+}
+
+func ToHistoryPauseActivityResponse(t *historyv1.PauseActivityResponse) *types.PauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseActivityResponse{}
+}
+
+func FromHistoryUnpauseActivityRequest(t *types.HistoryUnpauseActivityRequest) *historyv1.UnpauseActivityRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.UnpauseActivityRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+		Identity:          t.Request.Identity,
+		ResetAttempts:     t.Request.ResetAttempts,
+	}
+}
+
+func ToHistoryUnpauseActivityRequest(t *historyv1.UnpauseActivityRequest) *types.HistoryUnpauseActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUnpauseActivityRequest{
+		Request: &types.UnpauseActivityRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Identity:          t.Identity,
+			ResetAttempts:     t.ResetAttempts,
+		},
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromHistoryUnpauseActivityResponse(t *types.UnpauseActivityResponse) *historyv1.UnpauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UnpauseActivityResponse{}
+}
+
+func ToHistoryUnpauseActivityResponse(t *historyv1.UnpauseActivityResponse) *types.UnpauseActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UnpauseActivityResponse{}
+}
+
+func FromHistoryResetActivityRequest(t *types.HistoryResetActivityRequest) *historyv1.ResetActivityRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.ResetActivityRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryResetActivityRequest(t *historyv1.ResetActivityRequest) *types.HistoryResetActivityRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResetActivityRequest{
+		Request: &types.ResetActivityRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Identity:          t.Identity,
+		},
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromHistoryResetActivityResponse(t *types.ResetActivityResponse) *historyv1.ResetActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ResetActivityResponse{}
+}
+
+func ToHistoryResetActivityResponse(t *historyv1.ResetActivityResponse) *types.ResetActivityResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetActivityResponse{}
+}
+
+func FromHistoryUpdateActivityOptionsRequest(t *types.HistoryUpdateActivityOptionsRequest) *historyv1.UpdateActivityOptionsRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.UpdateActivityOptionsRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		ActivityId:        t.Request.ActivityID,
+		Options:           FromActivityOptions(t.Request.Options),
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryUpdateActivityOptionsRequest(t *historyv1.UpdateActivityOptionsRequest) *types.HistoryUpdateActivityOptionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateActivityOptionsRequest{
+		Request: &types.UpdateActivityOptionsRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			ActivityID:        t.ActivityId,
+			Options:           ToActivityOptions(t.Options),
+			Identity:          t.Identity,
+		},
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromHistoryUpdateActivityOptionsResponse(t *types.UpdateActivityOptionsResponse) *historyv1.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateActivityOptionsResponse{}
+}
+
+func ToHistoryUpdateActivityOptionsResponse(t *historyv1.UpdateActivityOptionsResponse) *types.UpdateActivityOptionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateActivityOptionsResponse{}
+}
+
+func FromActivityOptions(t *types.ActivityOptions) *historyv1.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ActivityOptions{
+		TaskList:               FromTaskList(t.TaskList),
+		ScheduleToCloseTimeout: secondsToDuration(t.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeout:    secondsToDuration(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeout:       secondsToDuration(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:            FromRetryPolicy(t.RetryPolicy),
+	}
+}
+
+func ToActivityOptions(t *historyv1.ActivityOptions) *types.ActivityOptions {
+	if t == nil {
+		return nil
+	}
+	return &types.ActivityOptions{
+		TaskList:                      ToTaskList(t.TaskList),
+		ScheduleToCloseTimeoutSeconds: durationToSeconds(t.ScheduleToCloseTimeout),
+		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
+		StartToCloseTimeoutSeconds:    durationToSeconds(t.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       durationToSeconds(t.HeartbeatTimeout),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+	}
+}
+
+func FromHistoryPauseWorkflowExecutionRequest(t *types.HistoryPauseWorkflowExecutionRequest) *historyv1.PauseWorkflowExecutionRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.PauseWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		Reason:            t.Request.Reason,
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryPauseWorkflowExecutionRequest(t *historyv1.PauseWorkflowExecutionRequest) *types.HistoryPauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryPauseWorkflowExecutionRequest{
+		Request: &types.PauseWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Reason:            t.Reason,
+			Identity:          t.Identity,
+		},
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromHistoryPauseWorkflowExecutionResponse(t *types.PauseWorkflowExecutionResponse) *historyv1.PauseWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.PauseWorkflowExecutionResponse{}
+}
+
+func ToHistoryPauseWorkflowExecutionResponse(t *historyv1.PauseWorkflowExecutionResponse) *types.PauseWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.PauseWorkflowExecutionResponse{}
+}
+
+func FromHistoryResumeWorkflowExecutionRequest(t *types.HistoryResumeWorkflowExecutionRequest) *historyv1.ResumeWorkflowExecutionRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.ResumeWorkflowExecutionRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.WorkflowExecution),
+		Identity:          t.Request.Identity,
+	}
+}
+
+func ToHistoryResumeWorkflowExecutionRequest(t *historyv1.ResumeWorkflowExecutionRequest) *types.HistoryResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryResumeWorkflowExecutionRequest{
+		Request: &types.ResumeWorkflowExecutionRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Identity:          t.Identity,
+		},
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromHistoryResumeWorkflowExecutionResponse(t *types.ResumeWorkflowExecutionResponse) *historyv1.ResumeWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ResumeWorkflowExecutionResponse{}
+}
+
+func ToHistoryResumeWorkflowExecutionResponse(t *historyv1.ResumeWorkflowExecutionResponse) *types.ResumeWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ResumeWorkflowExecutionResponse{}
+}
+
+func FromHistoryGetDomainReplicationStatusRequest(t *types.HistoryGetDomainReplicationStatusRequest) *historyv1.GetDomainReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetDomainReplicationStatusRequest{
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistoryGetDomainReplicationStatusRequest(t *historyv1.GetDomainReplicationStatusRequest) *types.HistoryGetDomainReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetDomainReplicationStatusRequest{
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromHistoryGetDomainReplicationStatusResponse(t *types.HistoryGetDomainReplicationStatusResponse) *historyv1.GetDomainReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetDomainReplicationStatusResponse{
+		Entries:      FromDomainReplicationStatusEntryArray(t.Entries),
+		FailedShards: t.FailedShards,
+	}
+}
+
+func ToHistoryGetDomainReplicationStatusResponse(t *historyv1.GetDomainReplicationStatusResponse) *types.HistoryGetDomainReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetDomainReplicationStatusResponse{
+		Entries:      ToDomainReplicationStatusEntryArray(t.Entries),
+		FailedShards: t.FailedShards,
+	}
+}
+
+func FromDomainReplicationStatusEntryArray(t map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus) []*historyv1.DomainReplicationStatusEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.DomainReplicationStatusEntry, 0, len(t))
+	for key, status := range t {
+		v = append(v, &historyv1.DomainReplicationStatusEntry{
+			ShardId:     key.ShardID,
+			ClusterName: key.ClusterName,
+			Status:      FromReplicationClusterStatus(status),
+		})
+	}
+	return v
+}
+
+func ToDomainReplicationStatusEntryArray(t []*historyv1.DomainReplicationStatusEntry) map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	v := make(map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus, len(t))
+	for _, entry := range t {
+		key := types.HistoryReplicationStatusKey{ShardID: entry.ShardId, ClusterName: entry.ClusterName}
+		v[key] = ToReplicationClusterStatus(entry.Status)
+	}
+	return v
+}
+
+func FromReplicationClusterStatus(t *types.ReplicationClusterStatus) *historyv1.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReplicationClusterStatus{
+		ClusterName:          t.ClusterName,
+		LastReplicatedTaskId: t.LastReplicatedTaskID,
+		LastReplicatedTime:   unixNanoToTime(t.LastReplicatedTimestamp),
+		PendingTaskCount:     t.PendingTaskCount,
+		HasMorePendingTasks:  t.HasMorePendingTasks,
+		DlqMessageCount:      t.DLQMessageCount,
+		EstimatedLag:         durationToDurationProto(t.EstimatedLag),
+	}
+}
+
+func ToReplicationClusterStatus(t *historyv1.ReplicationClusterStatus) *types.ReplicationClusterStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationClusterStatus{
+		ClusterName:             t.ClusterName,
+		LastReplicatedTaskID:    t.LastReplicatedTaskId,
+		LastReplicatedTimestamp: timeToUnixNano(t.LastReplicatedTime),
+		PendingTaskCount:        t.PendingTaskCount,
+		HasMorePendingTasks:     t.HasMorePendingTasks,
+		DLQMessageCount:         t.DlqMessageCount,
+		EstimatedLag:            durationProtoToDuration(t.EstimatedLag),
+	}
+}
+
+func FromHistoryUpdateDomainIsolationRequest(t *types.HistoryUpdateDomainIsolationRequest) *historyv1.UpdateDomainIsolationRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateDomainIsolationRequest{
+		DomainId: t.DomainUUID,
+		Isolated: t.Isolated,
+	}
+}
+
+func ToHistoryUpdateDomainIsolationRequest(t *historyv1.UpdateDomainIsolationRequest) *types.HistoryUpdateDomainIsolationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateDomainIsolationRequest{
+		DomainUUID: t.DomainId,
+		Isolated:   t.Isolated,
+	}
+}
+
+func FromHistoryUpdateDomainIsolationResponse(t *types.HistoryUpdateDomainIsolationResponse) *historyv1.UpdateDomainIsolationResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.UpdateDomainIsolationResponse{
+		FailedShards: t.FailedShards,
+	}
+}
+
+func ToHistoryUpdateDomainIsolationResponse(t *historyv1.UpdateDomainIsolationResponse) *types.HistoryUpdateDomainIsolationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateDomainIsolationResponse{
+		FailedShards: t.FailedShards,
+	}
+}
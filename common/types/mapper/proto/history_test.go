@@ -822,3 +822,133 @@ func TestHistoryStartWorkflowExecutionRequestFuzz(t *testing.T) {
 		testutils.WithExcludedFields("StartRequest"),
 	)
 }
+
+func TestHistoryPauseActivityRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryPauseActivityRequest, ToHistoryPauseActivityRequest,
+		testutils.WithCustomFuncs(HistoryPauseActivityRequestFuzzer),
+	)
+}
+
+// HistoryPauseActivityRequestFuzzer ensures Request is always non-nil, as its fields
+// are inlined into the proto message.
+func HistoryPauseActivityRequestFuzzer(r *types.HistoryPauseActivityRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.Request == nil {
+		r.Request = &types.PauseActivityRequest{}
+	}
+}
+
+func TestHistoryPauseActivityResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryPauseActivityResponse, ToHistoryPauseActivityResponse)
+}
+
+func TestHistoryUnpauseActivityRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUnpauseActivityRequest, ToHistoryUnpauseActivityRequest,
+		testutils.WithCustomFuncs(HistoryUnpauseActivityRequestFuzzer),
+	)
+}
+
+// HistoryUnpauseActivityRequestFuzzer ensures Request is always non-nil, as its fields
+// are inlined into the proto message.
+func HistoryUnpauseActivityRequestFuzzer(r *types.HistoryUnpauseActivityRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.Request == nil {
+		r.Request = &types.UnpauseActivityRequest{}
+	}
+}
+
+func TestHistoryUnpauseActivityResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUnpauseActivityResponse, ToHistoryUnpauseActivityResponse)
+}
+
+func TestHistoryResetActivityRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryResetActivityRequest, ToHistoryResetActivityRequest,
+		testutils.WithCustomFuncs(HistoryResetActivityRequestFuzzer),
+	)
+}
+
+// HistoryResetActivityRequestFuzzer ensures Request is always non-nil, as its fields
+// are inlined into the proto message.
+func HistoryResetActivityRequestFuzzer(r *types.HistoryResetActivityRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.Request == nil {
+		r.Request = &types.ResetActivityRequest{}
+	}
+}
+
+func TestHistoryResetActivityResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryResetActivityResponse, ToHistoryResetActivityResponse)
+}
+
+func TestHistoryUpdateActivityOptionsRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateActivityOptionsRequest, ToHistoryUpdateActivityOptionsRequest,
+		testutils.WithCustomFuncs(HistoryUpdateActivityOptionsRequestFuzzer),
+	)
+}
+
+// HistoryUpdateActivityOptionsRequestFuzzer ensures Request is always non-nil, as its fields
+// are inlined into the proto message.
+func HistoryUpdateActivityOptionsRequestFuzzer(r *types.HistoryUpdateActivityOptionsRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.Request == nil {
+		r.Request = &types.UpdateActivityOptionsRequest{}
+	}
+}
+
+func TestHistoryUpdateActivityOptionsResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateActivityOptionsResponse, ToHistoryUpdateActivityOptionsResponse)
+}
+
+func TestHistoryPauseWorkflowExecutionRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryPauseWorkflowExecutionRequest, ToHistoryPauseWorkflowExecutionRequest,
+		testutils.WithCustomFuncs(HistoryPauseWorkflowExecutionRequestFuzzer),
+	)
+}
+
+// HistoryPauseWorkflowExecutionRequestFuzzer ensures Request is always non-nil, as its fields
+// are inlined into the proto message.
+func HistoryPauseWorkflowExecutionRequestFuzzer(r *types.HistoryPauseWorkflowExecutionRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.Request == nil {
+		r.Request = &types.PauseWorkflowExecutionRequest{}
+	}
+}
+
+func TestHistoryPauseWorkflowExecutionResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryPauseWorkflowExecutionResponse, ToHistoryPauseWorkflowExecutionResponse)
+}
+
+func TestHistoryResumeWorkflowExecutionRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryResumeWorkflowExecutionRequest, ToHistoryResumeWorkflowExecutionRequest,
+		testutils.WithCustomFuncs(HistoryResumeWorkflowExecutionRequestFuzzer),
+	)
+}
+
+// HistoryResumeWorkflowExecutionRequestFuzzer ensures Request is always non-nil, as its fields
+// are inlined into the proto message.
+func HistoryResumeWorkflowExecutionRequestFuzzer(r *types.HistoryResumeWorkflowExecutionRequest, c fuzz.Continue) {
+	c.FuzzNoCustom(r)
+	if r.Request == nil {
+		r.Request = &types.ResumeWorkflowExecutionRequest{}
+	}
+}
+
+func TestHistoryResumeWorkflowExecutionResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryResumeWorkflowExecutionResponse, ToHistoryResumeWorkflowExecutionResponse)
+}
+
+func TestHistoryGetDomainReplicationStatusRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryGetDomainReplicationStatusRequest, ToHistoryGetDomainReplicationStatusRequest)
+}
+
+func TestHistoryGetDomainReplicationStatusResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryGetDomainReplicationStatusResponse, ToHistoryGetDomainReplicationStatusResponse)
+}
+
+func TestHistoryUpdateDomainIsolationRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateDomainIsolationRequest, ToHistoryUpdateDomainIsolationRequest)
+}
+
+func TestHistoryUpdateDomainIsolationResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryUpdateDomainIsolationResponse, ToHistoryUpdateDomainIsolationResponse)
+}
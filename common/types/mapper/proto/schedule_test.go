@@ -88,6 +88,7 @@ func TestScheduleListEntry(t *testing.T) {
 func TestScheduleSpecFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleSpec, ToScheduleSpec,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
@@ -100,24 +101,28 @@ func TestStartWorkflowActionFuzz(t *testing.T) {
 func TestScheduleActionFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleAction, ToScheduleAction,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
 func TestSchedulePoliciesFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromSchedulePolicies, ToSchedulePolicies,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
 func TestSchedulePauseInfoFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromSchedulePauseInfo, ToSchedulePauseInfo,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
 func TestScheduleStateFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleState, ToScheduleState,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
@@ -130,12 +135,27 @@ func TestBackfillInfoFuzz(t *testing.T) {
 func TestScheduleInfoFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleInfo, ToScheduleInfo,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
 func TestScheduleListEntryFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleListEntry, ToScheduleListEntry,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
+	)
+}
+
+// withScheduleUnmappedFields skips the schedule fields that the api.v1
+// messages do not carry, they are mapped by the frontend messages instead.
+func withScheduleUnmappedFields() testutils.FuzzOption {
+	return testutils.WithExcludedFields(
+		"Calendars", "Intervals", "ExcludeCalendars", "Timezone",
+		"RecentActions",
+		"SignalWorkflow", "BatchOperation",
+		"LimitedActions", "RemainingActions", "Completed",
+		"PauseOnFailureThreshold", "PauseOnFailureCooldown", "AutoUnpauseTime",
+		"ConsecutiveFailures", "LastFailure",
 	)
 }
 
@@ -206,6 +226,7 @@ func TestDeleteScheduleResponse(t *testing.T) {
 func TestCreateScheduleRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromCreateScheduleRequest, ToCreateScheduleRequest,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
@@ -218,12 +239,14 @@ func TestDescribeScheduleRequestFuzz(t *testing.T) {
 func TestDescribeScheduleResponseFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromDescribeScheduleResponse, ToDescribeScheduleResponse,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
 func TestUpdateScheduleRequestFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromUpdateScheduleRequest, ToUpdateScheduleRequest,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
@@ -306,6 +329,7 @@ func TestListSchedulesRequestFuzz(t *testing.T) {
 func TestListSchedulesResponseFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromListSchedulesResponse, ToListSchedulesResponse,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
@@ -343,6 +367,7 @@ func TestScheduleOverlapPolicyFuzz(t *testing.T) {
 func TestScheduleListEntryArrayFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromScheduleListEntryArray, ToScheduleListEntryArray,
 		WithScheduleEnumFuzzers(),
+		withScheduleUnmappedFields(),
 	)
 }
 
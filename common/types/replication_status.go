@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import "time"
+
+// GetDomainReplicationStatusRequest is the admin request for the replication status of a domain
+type GetDomainReplicationStatusRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+// GetDomain is an internal getter
+func (v *GetDomainReplicationStatusRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetDomainReplicationStatusResponse reports the replication status of a domain
+// to each remote cluster, aggregated across all history shards
+type GetDomainReplicationStatusResponse struct {
+	Domain   string                      `json:"domain,omitempty"`
+	Clusters []*ReplicationClusterStatus `json:"clusters,omitempty"`
+	// FailedShards are the history shards whose status could not be read, they are not part of the cluster statuses
+	FailedShards []int32 `json:"failedShards,omitempty"`
+}
+
+// GetDomain is an internal getter
+func (v *GetDomainReplicationStatusResponse) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetClusters is an internal getter
+func (v *GetDomainReplicationStatusResponse) GetClusters() (o []*ReplicationClusterStatus) {
+	if v != nil && v.Clusters != nil {
+		return v.Clusters
+	}
+	return
+}
+
+// GetFailedShards is an internal getter
+func (v *GetDomainReplicationStatusResponse) GetFailedShards() (o []int32) {
+	if v != nil && v.FailedShards != nil {
+		return v.FailedShards
+	}
+	return
+}
+
+// ReplicationClusterStatus is the replication status of a domain to a remote cluster
+type ReplicationClusterStatus struct {
+	ClusterName string `json:"clusterName,omitempty"`
+	// LastReplicatedTaskID and LastReplicatedTimestamp identify the latest replication task
+	// of the domain acknowledged by the remote cluster since the shard was loaded, when aggregated
+	// across shards they identify the oldest of these tasks
+	LastReplicatedTaskID    int64  `json:"lastReplicatedTaskID,omitempty"`
+	LastReplicatedTimestamp *int64 `json:"lastReplicatedTimestamp,omitempty"`
+	// PendingTaskCount is the number of replication tasks of the domain not yet acknowledged
+	// by the remote cluster, HasMorePendingTasks is set when the count is truncated
+	PendingTaskCount    int64 `json:"pendingTaskCount,omitempty"`
+	HasMorePendingTasks bool  `json:"hasMorePendingTasks,omitempty"`
+	// DLQMessageCount is the number of replication tasks of the domain received from the
+	// remote cluster that failed to apply in the current cluster
+	DLQMessageCount int64 `json:"dlqMessageCount,omitempty"`
+	// EstimatedLag is the age of the oldest pending replication task of the domain
+	EstimatedLag time.Duration `json:"estimatedLag,omitempty"`
+}
+
+// GetClusterName is an internal getter
+func (v *ReplicationClusterStatus) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetLastReplicatedTaskID is an internal getter
+func (v *ReplicationClusterStatus) GetLastReplicatedTaskID() (o int64) {
+	if v != nil {
+		return v.LastReplicatedTaskID
+	}
+	return
+}
+
+// GetLastReplicatedTimestamp is an internal getter
+func (v *ReplicationClusterStatus) GetLastReplicatedTimestamp() (o int64) {
+	if v != nil && v.LastReplicatedTimestamp != nil {
+		return *v.LastReplicatedTimestamp
+	}
+	return
+}
+
+// GetPendingTaskCount is an internal getter
+func (v *ReplicationClusterStatus) GetPendingTaskCount() (o int64) {
+	if v != nil {
+		return v.PendingTaskCount
+	}
+	return
+}
+
+// GetHasMorePendingTasks is an internal getter
+func (v *ReplicationClusterStatus) GetHasMorePendingTasks() (o bool) {
+	if v != nil {
+		return v.HasMorePendingTasks
+	}
+	return
+}
+
+// GetDLQMessageCount is an internal getter
+func (v *ReplicationClusterStatus) GetDLQMessageCount() (o int64) {
+	if v != nil {
+		return v.DLQMessageCount
+	}
+	return
+}
+
+// GetEstimatedLag is an internal getter
+func (v *ReplicationClusterStatus) GetEstimatedLag() (o time.Duration) {
+	if v != nil {
+		return v.EstimatedLag
+	}
+	return
+}
+
+// HistoryGetDomainReplicationStatusRequest is the history request for the replication status of a domain on all shards of a host
+type HistoryGetDomainReplicationStatusRequest struct {
+	DomainUUID string `json:"domainUUID,omitempty"`
+}
+
+// GetDomainUUID is an internal getter
+func (v *HistoryGetDomainReplicationStatusRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// HistoryReplicationStatusKey identifies the replication status of a shard to a remote cluster
+type HistoryReplicationStatusKey struct {
+	ShardID     int32
+	ClusterName string
+}
+
+// HistoryGetDomainReplicationStatusResponse is the replication status of a domain per shard and remote cluster
+type HistoryGetDomainReplicationStatusResponse struct {
+	Entries map[HistoryReplicationStatusKey]*ReplicationClusterStatus
+	// FailedShards are the shards whose status could not be read
+	FailedShards []int32
+}
+
+// GetEntries is an internal getter
+func (v *HistoryGetDomainReplicationStatusResponse) GetEntries() (o map[HistoryReplicationStatusKey]*ReplicationClusterStatus) {
+	if v != nil && v.Entries != nil {
+		return v.Entries
+	}
+	return
+}
+
+// GetFailedShards is an internal getter
+func (v *HistoryGetDomainReplicationStatusResponse) GetFailedShards() (o []int32) {
+	if v != nil && v.FailedShards != nil {
+		return v.FailedShards
+	}
+	return
+}
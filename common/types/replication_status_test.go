@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDomainReplicationStatus_Getters(t *testing.T) {
+	var emptyRequest *GetDomainReplicationStatusRequest
+	assert.Equal(t, "", emptyRequest.GetDomain())
+	assert.Equal(t, "d", (&GetDomainReplicationStatusRequest{Domain: "d"}).GetDomain())
+
+	var emptyResponse *GetDomainReplicationStatusResponse
+	assert.Equal(t, "", emptyResponse.GetDomain())
+	assert.Nil(t, emptyResponse.GetClusters())
+	assert.Nil(t, emptyResponse.GetFailedShards())
+
+	status := &ReplicationClusterStatus{ClusterName: "c"}
+	response := &GetDomainReplicationStatusResponse{Domain: "d", Clusters: []*ReplicationClusterStatus{status}, FailedShards: []int32{1}}
+	assert.Equal(t, "d", response.GetDomain())
+	assert.Equal(t, []*ReplicationClusterStatus{status}, response.GetClusters())
+	assert.Equal(t, []int32{1}, response.GetFailedShards())
+
+	var emptyHistoryRequest *HistoryGetDomainReplicationStatusRequest
+	assert.Equal(t, "", emptyHistoryRequest.GetDomainUUID())
+	assert.Equal(t, "id", (&HistoryGetDomainReplicationStatusRequest{DomainUUID: "id"}).GetDomainUUID())
+
+	var emptyHistoryResponse *HistoryGetDomainReplicationStatusResponse
+	assert.Nil(t, emptyHistoryResponse.GetEntries())
+	assert.Nil(t, emptyHistoryResponse.GetFailedShards())
+	entries := map[HistoryReplicationStatusKey]*ReplicationClusterStatus{{ShardID: 1, ClusterName: "c"}: status}
+	historyResponse := &HistoryGetDomainReplicationStatusResponse{Entries: entries, FailedShards: []int32{2}}
+	assert.Equal(t, entries, historyResponse.GetEntries())
+	assert.Equal(t, []int32{2}, historyResponse.GetFailedShards())
+}
+
+func TestReplicationClusterStatus_Getters(t *testing.T) {
+	var empty *ReplicationClusterStatus
+	assert.Equal(t, "", empty.GetClusterName())
+	assert.Equal(t, int64(0), empty.GetLastReplicatedTaskID())
+	assert.Equal(t, int64(0), empty.GetLastReplicatedTimestamp())
+	assert.Equal(t, int64(0), empty.GetPendingTaskCount())
+	assert.False(t, empty.GetHasMorePendingTasks())
+	assert.Equal(t, int64(0), empty.GetDLQMessageCount())
+	assert.Equal(t, time.Duration(0), empty.GetEstimatedLag())
+
+	timestamp := int64(2)
+	v := &ReplicationClusterStatus{
+		ClusterName:             "c",
+		LastReplicatedTaskID:    1,
+		LastReplicatedTimestamp: &timestamp,
+		PendingTaskCount:        3,
+		HasMorePendingTasks:     true,
+		DLQMessageCount:         4,
+		EstimatedLag:            time.Second,
+	}
+	assert.Equal(t, "c", v.GetClusterName())
+	assert.Equal(t, int64(1), v.GetLastReplicatedTaskID())
+	assert.Equal(t, int64(2), v.GetLastReplicatedTimestamp())
+	assert.Equal(t, int64(3), v.GetPendingTaskCount())
+	assert.True(t, v.GetHasMorePendingTasks())
+	assert.Equal(t, int64(4), v.GetDLQMessageCount())
+	assert.Equal(t, time.Second, v.GetEstimatedLag())
+}
@@ -139,14 +139,131 @@ func (e ScheduleCatchUpPolicy) MarshalText() ([]byte, error) {
 	return []byte(e.String()), nil
 }
 
+// ScheduleActionOutcome describes what happened to a single schedule fire.
+type ScheduleActionOutcome int32
+
+const (
+	ScheduleActionOutcomeInvalid ScheduleActionOutcome = iota
+	ScheduleActionOutcomeStarted                       // Target workflow was started
+	ScheduleActionOutcomeSkipped                       // Fire was dropped by the overlap policy or buffer limit
+	ScheduleActionOutcomeFailed                        // Action could not be performed
+)
+
+func (e ScheduleActionOutcome) Ptr() *ScheduleActionOutcome { return &e }
+
+func (e ScheduleActionOutcome) String() string {
+	switch e {
+	case ScheduleActionOutcomeInvalid:
+		return "INVALID"
+	case ScheduleActionOutcomeStarted:
+		return "STARTED"
+	case ScheduleActionOutcomeSkipped:
+		return "SKIPPED"
+	case ScheduleActionOutcomeFailed:
+		return "FAILED"
+	}
+	return fmt.Sprintf("ScheduleActionOutcome(%d)", int32(e))
+}
+
+func (e *ScheduleActionOutcome) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "INVALID":
+		*e = ScheduleActionOutcomeInvalid
+	case "STARTED":
+		*e = ScheduleActionOutcomeStarted
+	case "SKIPPED":
+		*e = ScheduleActionOutcomeSkipped
+	case "FAILED":
+		*e = ScheduleActionOutcomeFailed
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ScheduleActionOutcome", err)
+		}
+		*e = ScheduleActionOutcome(val)
+	}
+	return nil
+}
+
+func (e ScheduleActionOutcome) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// ScheduleBatchOperationType is the operation a BatchOperationAction applies
+// to every workflow matched by its query.
+type ScheduleBatchOperationType int32
+
+const (
+	ScheduleBatchOperationTypeInvalid   ScheduleBatchOperationType = iota
+	ScheduleBatchOperationTypeTerminate                            // Terminate matched workflows
+	ScheduleBatchOperationTypeCancel                               // Request cancellation of matched workflows
+	ScheduleBatchOperationTypeSignal                               // Signal matched workflows
+)
+
+func (e ScheduleBatchOperationType) Ptr() *ScheduleBatchOperationType { return &e }
+
+func (e ScheduleBatchOperationType) String() string {
+	switch e {
+	case ScheduleBatchOperationTypeInvalid:
+		return "INVALID"
+	case ScheduleBatchOperationTypeTerminate:
+		return "TERMINATE"
+	case ScheduleBatchOperationTypeCancel:
+		return "CANCEL"
+	case ScheduleBatchOperationTypeSignal:
+		return "SIGNAL"
+	}
+	return fmt.Sprintf("ScheduleBatchOperationType(%d)", int32(e))
+}
+
+func (e *ScheduleBatchOperationType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "INVALID":
+		*e = ScheduleBatchOperationTypeInvalid
+	case "TERMINATE":
+		*e = ScheduleBatchOperationTypeTerminate
+	case "CANCEL":
+		*e = ScheduleBatchOperationTypeCancel
+	case "SIGNAL":
+		*e = ScheduleBatchOperationTypeSignal
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ScheduleBatchOperationType", err)
+		}
+		*e = ScheduleBatchOperationType(val)
+	}
+	return nil
+}
+
+func (e ScheduleBatchOperationType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
 // --- Core Types ---
 
 // ScheduleSpec defines when a schedule should trigger.
+// The schedule fires at the union of CronExpression, Calendars and Intervals,
+// minus any time matched by ExcludeCalendars. At least one of CronExpression,
+// Calendars or Intervals must be set.
 type ScheduleSpec struct {
 	CronExpression string        `json:"cronExpression,omitempty"`
 	StartTime      time.Time     `json:"startTime,omitempty"`
 	EndTime        time.Time     `json:"endTime,omitempty"`
 	Jitter         time.Duration `json:"jitter,omitempty"`
+	// Calendars are additional calendar-based fire times. Each entry is
+	// matched independently; the schedule fires if any of them matches.
+	Calendars []*ScheduleCalendarSpec `json:"calendars,omitempty"`
+	// Intervals are fixed-period fire times aligned to the Unix epoch.
+	Intervals []*ScheduleIntervalSpec `json:"intervals,omitempty"`
+	// ExcludeCalendars suppresses any fire time that matches one of these
+	// calendars (e.g. holidays), regardless of which spec produced it.
+	// Empty Second, Minute and Hour fields match the whole day here.
+	ExcludeCalendars []*ScheduleCalendarSpec `json:"excludeCalendars,omitempty"`
+	// Timezone is an IANA location name (e.g. "America/New_York") used to
+	// evaluate CronExpression and calendars. Defaults to UTC. A CRON_TZ
+	// prefix on CronExpression takes precedence for that expression.
+	Timezone string `json:"timezone,omitempty"`
 }
 
 func (v *ScheduleSpec) GetCronExpression() (o string) {
@@ -177,6 +294,122 @@ func (v *ScheduleSpec) GetJitter() (o time.Duration) {
 	return
 }
 
+func (v *ScheduleSpec) GetCalendars() (o []*ScheduleCalendarSpec) {
+	if v != nil {
+		return v.Calendars
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetIntervals() (o []*ScheduleIntervalSpec) {
+	if v != nil {
+		return v.Intervals
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetExcludeCalendars() (o []*ScheduleCalendarSpec) {
+	if v != nil {
+		return v.ExcludeCalendars
+	}
+	return
+}
+
+func (v *ScheduleSpec) GetTimezone() (o string) {
+	if v != nil {
+		return v.Timezone
+	}
+	return
+}
+
+// ScheduleCalendarSpec describes a set of calendar times. Every field accepts
+// cron field syntax (lists, ranges, steps and month/weekday names). Unlike a
+// cron expression, DayOfMonth and DayOfWeek are combined with AND, so
+// "second Tuesday of the month" is DayOfMonth "8-14" with DayOfWeek "TUE".
+// Empty fields default to "0" for Second, Minute and Hour and to "*" for the
+// date fields.
+type ScheduleCalendarSpec struct {
+	Second     string `json:"second,omitempty"`
+	Minute     string `json:"minute,omitempty"`
+	Hour       string `json:"hour,omitempty"`
+	DayOfMonth string `json:"dayOfMonth,omitempty"`
+	Month      string `json:"month,omitempty"`
+	DayOfWeek  string `json:"dayOfWeek,omitempty"`
+	// Comment is a free-form description shown by describe, e.g. "payroll run".
+	Comment string `json:"comment,omitempty"`
+}
+
+func (v *ScheduleCalendarSpec) GetSecond() (o string) {
+	if v != nil {
+		return v.Second
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetMinute() (o string) {
+	if v != nil {
+		return v.Minute
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetHour() (o string) {
+	if v != nil {
+		return v.Hour
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetDayOfMonth() (o string) {
+	if v != nil {
+		return v.DayOfMonth
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetMonth() (o string) {
+	if v != nil {
+		return v.Month
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetDayOfWeek() (o string) {
+	if v != nil {
+		return v.DayOfWeek
+	}
+	return
+}
+
+func (v *ScheduleCalendarSpec) GetComment() (o string) {
+	if v != nil {
+		return v.Comment
+	}
+	return
+}
+
+// ScheduleIntervalSpec fires every Interval, at times t where
+// (t - UnixEpoch - Phase) is a multiple of Interval. Phase shifts the grid,
+// e.g. Interval=1h Phase=15m fires at 00:15, 01:15, ...
+type ScheduleIntervalSpec struct {
+	Interval time.Duration `json:"interval,omitempty"`
+	Phase    time.Duration `json:"phase,omitempty"`
+}
+
+func (v *ScheduleIntervalSpec) GetInterval() (o time.Duration) {
+	if v != nil {
+		return v.Interval
+	}
+	return
+}
+
+func (v *ScheduleIntervalSpec) GetPhase() (o time.Duration) {
+	if v != nil {
+		return v.Phase
+	}
+	return
+}
+
 // StartWorkflowAction defines a workflow to start when the schedule triggers.
 // Input, Memo, and SearchAttributes must JSON-round-trip: the scheduler workflow
 // encodes types.ScheduleAction with encoding/json (create input, update signals,
@@ -228,10 +461,114 @@ func (v *StartWorkflowAction) GetTaskStartToCloseTimeoutSeconds() (o int32) {
 	return
 }
 
+// SignalWorkflowAction signals a workflow in the schedule's domain when the
+// schedule triggers. When StartWorkflow is set the signal is sent with
+// signal-with-start, starting the workflow with those options if it is not
+// running; StartWorkflow.WorkflowIDPrefix is ignored in that case.
+type SignalWorkflowAction struct {
+	WorkflowID    string               `json:"workflowId,omitempty"`
+	SignalName    string               `json:"signalName,omitempty"`
+	SignalInput   []byte               `json:"signalInput,omitempty"`
+	StartWorkflow *StartWorkflowAction `json:"startWorkflow,omitempty"`
+}
+
+func (v *SignalWorkflowAction) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *SignalWorkflowAction) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+func (v *SignalWorkflowAction) GetSignalInput() (o []byte) {
+	if v != nil {
+		return v.SignalInput
+	}
+	return
+}
+
+func (v *SignalWorkflowAction) GetStartWorkflow() *StartWorkflowAction {
+	if v != nil {
+		return v.StartWorkflow
+	}
+	return nil
+}
+
+// BatchOperationAction starts a batch operation over the workflows in the
+// schedule's domain that match Query. SignalName and SignalInput are only
+// used by ScheduleBatchOperationTypeSignal. RPS and Concurrency fall back to
+// the batcher defaults when zero.
+type BatchOperationAction struct {
+	OperationType ScheduleBatchOperationType `json:"operationType,omitempty"`
+	Query         string                     `json:"query,omitempty"`
+	Reason        string                     `json:"reason,omitempty"`
+	SignalName    string                     `json:"signalName,omitempty"`
+	SignalInput   string                     `json:"signalInput,omitempty"`
+	RPS           int32                      `json:"rps,omitempty"`
+	Concurrency   int32                      `json:"concurrency,omitempty"`
+}
+
+func (v *BatchOperationAction) GetOperationType() (o ScheduleBatchOperationType) {
+	if v != nil {
+		return v.OperationType
+	}
+	return
+}
+
+func (v *BatchOperationAction) GetQuery() (o string) {
+	if v != nil {
+		return v.Query
+	}
+	return
+}
+
+func (v *BatchOperationAction) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *BatchOperationAction) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+func (v *BatchOperationAction) GetSignalInput() (o string) {
+	if v != nil {
+		return v.SignalInput
+	}
+	return
+}
+
+func (v *BatchOperationAction) GetRPS() (o int32) {
+	if v != nil {
+		return v.RPS
+	}
+	return
+}
+
+func (v *BatchOperationAction) GetConcurrency() (o int32) {
+	if v != nil {
+		return v.Concurrency
+	}
+	return
+}
+
 // ScheduleAction defines the action to take when the schedule triggers.
 // Exactly one action field must be set.
 type ScheduleAction struct {
-	StartWorkflow *StartWorkflowAction `json:"startWorkflow,omitempty"`
+	StartWorkflow  *StartWorkflowAction  `json:"startWorkflow,omitempty"`
+	SignalWorkflow *SignalWorkflowAction `json:"signalWorkflow,omitempty"`
+	BatchOperation *BatchOperationAction `json:"batchOperation,omitempty"`
 }
 
 func (v *ScheduleAction) GetStartWorkflow() *StartWorkflowAction {
@@ -241,7 +578,33 @@ func (v *ScheduleAction) GetStartWorkflow() *StartWorkflowAction {
 	return nil
 }
 
+func (v *ScheduleAction) GetSignalWorkflow() *SignalWorkflowAction {
+	if v != nil {
+		return v.SignalWorkflow
+	}
+	return nil
+}
+
+func (v *ScheduleAction) GetBatchOperation() *BatchOperationAction {
+	if v != nil {
+		return v.BatchOperation
+	}
+	return nil
+}
+
 // SchedulePolicies configures schedule behavior.
+//
+// When LimitedActions is set, every scheduled fire consumes one of
+// RemainingActions (whether it starts, is skipped or is buffered by the
+// overlap policy); manual triggers and backfills do not. The schedule
+// completes once RemainingActions reaches zero. RemainingActions is
+// decremented by the scheduler, so DescribeSchedule reports the live count.
+//
+// When PauseOnFailure is set, the schedule pauses itself once
+// PauseOnFailureThreshold consecutive started workflows have failed or timed
+// out (zero or one means the first failure). A non-zero
+// PauseOnFailureCooldown unpauses the schedule automatically after that long;
+// otherwise it stays paused until UnpauseSchedule is called.
 type SchedulePolicies struct {
 	OverlapPolicy    ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
 	CatchUpPolicy    ScheduleCatchUpPolicy `json:"catchUpPolicy,omitempty"`
@@ -249,6 +612,12 @@ type SchedulePolicies struct {
 	PauseOnFailure   bool                  `json:"pauseOnFailure,omitempty"`
 	BufferLimit      int32                 `json:"bufferLimit,omitempty"`
 	ConcurrencyLimit int32                 `json:"concurrencyLimit,omitempty"`
+	LimitedActions   bool                  `json:"limitedActions,omitempty"`
+	RemainingActions int64                 `json:"remainingActions,omitempty"`
+	// PauseOnFailureThreshold and PauseOnFailureCooldown only apply when
+	// PauseOnFailure is set.
+	PauseOnFailureThreshold int32         `json:"pauseOnFailureThreshold,omitempty"`
+	PauseOnFailureCooldown  time.Duration `json:"pauseOnFailureCooldown,omitempty"`
 }
 
 func (v *SchedulePolicies) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
@@ -293,11 +662,42 @@ func (v *SchedulePolicies) GetConcurrencyLimit() int32 {
 	return 0
 }
 
+func (v *SchedulePolicies) GetLimitedActions() (o bool) {
+	if v != nil {
+		return v.LimitedActions
+	}
+	return
+}
+
+func (v *SchedulePolicies) GetRemainingActions() (o int64) {
+	if v != nil {
+		return v.RemainingActions
+	}
+	return
+}
+
+func (v *SchedulePolicies) GetPauseOnFailureThreshold() (o int32) {
+	if v != nil {
+		return v.PauseOnFailureThreshold
+	}
+	return
+}
+
+func (v *SchedulePolicies) GetPauseOnFailureCooldown() (o time.Duration) {
+	if v != nil {
+		return v.PauseOnFailureCooldown
+	}
+	return
+}
+
 // SchedulePauseInfo captures the state of a paused schedule (response-only, server-populated).
+// AutoUnpauseTime is set when the schedule paused itself on failure and will
+// unpause automatically at that time.
 type SchedulePauseInfo struct {
-	Reason   string    `json:"reason,omitempty"`
-	PausedAt time.Time `json:"pausedAt,omitempty"`
-	PausedBy string    `json:"pausedBy,omitempty"`
+	Reason          string    `json:"reason,omitempty"`
+	PausedAt        time.Time `json:"pausedAt,omitempty"`
+	PausedBy        string    `json:"pausedBy,omitempty"`
+	AutoUnpauseTime time.Time `json:"autoUnpauseTime,omitempty"`
 }
 
 func (v *SchedulePauseInfo) GetReason() (o string) {
@@ -321,10 +721,20 @@ func (v *SchedulePauseInfo) GetPausedBy() (o string) {
 	return
 }
 
+func (v *SchedulePauseInfo) GetAutoUnpauseTime() (o time.Time) {
+	if v != nil {
+		return v.AutoUnpauseTime
+	}
+	return
+}
+
 // ScheduleState represents the current runtime state of a schedule.
+// Completed is set once the schedule has no more actions to take, either
+// because LimitedActions ran out or because Spec.EndTime has passed.
 type ScheduleState struct {
 	Paused    bool               `json:"paused,omitempty"`
 	PauseInfo *SchedulePauseInfo `json:"pauseInfo,omitempty"`
+	Completed bool               `json:"completed,omitempty"`
 }
 
 func (v *ScheduleState) GetPaused() (o bool) {
@@ -341,6 +751,13 @@ func (v *ScheduleState) GetPauseInfo() *SchedulePauseInfo {
 	return nil
 }
 
+func (v *ScheduleState) GetCompleted() (o bool) {
+	if v != nil {
+		return v.Completed
+	}
+	return
+}
+
 // BackfillInfo tracks the progress of an ongoing backfill operation.
 type BackfillInfo struct {
 	BackfillID    string    `json:"backfillId,omitempty"`
@@ -385,18 +802,107 @@ func (v *BackfillInfo) GetRunsTotal() (o int32) {
 	return
 }
 
+// ScheduleActionResult records a single action taken by the schedule.
+// WorkflowExecution identifies the target run and is nil unless the
+// outcome is STARTED.
+type ScheduleActionResult struct {
+	ScheduledTime     time.Time             `json:"scheduledTime,omitempty"`
+	ActualTime        time.Time             `json:"actualTime,omitempty"`
+	WorkflowExecution *WorkflowExecution    `json:"workflowExecution,omitempty"`
+	Outcome           ScheduleActionOutcome `json:"outcome,omitempty"`
+}
+
+func (v *ScheduleActionResult) GetScheduledTime() (o time.Time) {
+	if v != nil {
+		return v.ScheduledTime
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetActualTime() (o time.Time) {
+	if v != nil {
+		return v.ActualTime
+	}
+	return
+}
+
+func (v *ScheduleActionResult) GetWorkflowExecution() *WorkflowExecution {
+	if v != nil {
+		return v.WorkflowExecution
+	}
+	return nil
+}
+
+func (v *ScheduleActionResult) GetOutcome() (o ScheduleActionOutcome) {
+	if v != nil {
+		return v.Outcome
+	}
+	return
+}
+
+// ScheduleFailureInfo describes a failed run observed by a schedule with
+// PauseOnFailure set. ObservedTime is when the scheduler noticed the failure,
+// and ConsecutiveFailures is the failure streak including this run.
+type ScheduleFailureInfo struct {
+	WorkflowExecution   *WorkflowExecution           `json:"workflowExecution,omitempty"`
+	CloseStatus         WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	FailureReason       string                       `json:"failureReason,omitempty"`
+	ObservedTime        time.Time                    `json:"observedTime,omitempty"`
+	ConsecutiveFailures int32                        `json:"consecutiveFailures,omitempty"`
+}
+
+func (v *ScheduleFailureInfo) GetWorkflowExecution() *WorkflowExecution {
+	if v != nil {
+		return v.WorkflowExecution
+	}
+	return nil
+}
+
+func (v *ScheduleFailureInfo) GetCloseStatus() (o WorkflowExecutionCloseStatus) {
+	if v != nil {
+		return v.CloseStatus
+	}
+	return
+}
+
+func (v *ScheduleFailureInfo) GetFailureReason() (o string) {
+	if v != nil {
+		return v.FailureReason
+	}
+	return
+}
+
+func (v *ScheduleFailureInfo) GetObservedTime() (o time.Time) {
+	if v != nil {
+		return v.ObservedTime
+	}
+	return
+}
+
+func (v *ScheduleFailureInfo) GetConsecutiveFailures() (o int32) {
+	if v != nil {
+		return v.ConsecutiveFailures
+	}
+	return
+}
+
 // ScheduleInfo provides runtime information about the schedule.
+// RecentActions holds the most recent actions, oldest first.
+// ConsecutiveFailures and LastFailure are only tracked when PauseOnFailure is set.
 type ScheduleInfo struct {
-	LastRunTime          time.Time       `json:"lastRunTime,omitempty"`
-	NextRunTime          time.Time       `json:"nextRunTime,omitempty"`
-	TotalRuns            int64           `json:"totalRuns,omitempty"`
-	MissedRuns           int64           `json:"missedRuns,omitempty"`
-	SkippedRuns          int64           `json:"skippedRuns,omitempty"`
-	CreateTime           time.Time       `json:"createTime,omitempty"`
-	LastUpdateTime       time.Time       `json:"lastUpdateTime,omitempty"`
-	OngoingBackfills     []*BackfillInfo `json:"ongoingBackfills,omitempty"`
-	BufferedFireCount    int64           `json:"bufferedFireCount,omitempty"`
-	RunningWorkflowCount int64           `json:"runningWorkflowCount,omitempty"`
+	LastRunTime          time.Time               `json:"lastRunTime,omitempty"`
+	NextRunTime          time.Time               `json:"nextRunTime,omitempty"`
+	TotalRuns            int64                   `json:"totalRuns,omitempty"`
+	MissedRuns           int64                   `json:"missedRuns,omitempty"`
+	SkippedRuns          int64                   `json:"skippedRuns,omitempty"`
+	CreateTime           time.Time               `json:"createTime,omitempty"`
+	LastUpdateTime       time.Time               `json:"lastUpdateTime,omitempty"`
+	OngoingBackfills     []*BackfillInfo         `json:"ongoingBackfills,omitempty"`
+	BufferedFireCount    int64                   `json:"bufferedFireCount,omitempty"`
+	RunningWorkflowCount int64                   `json:"runningWorkflowCount,omitempty"`
+	RecentActions        []*ScheduleActionResult `json:"recentActions,omitempty"`
+	ConsecutiveFailures  int32                   `json:"consecutiveFailures,omitempty"`
+	LastFailure          *ScheduleFailureInfo    `json:"lastFailure,omitempty"`
 }
 
 func (v *ScheduleInfo) GetLastRunTime() (o time.Time) {
@@ -469,6 +975,27 @@ func (v *ScheduleInfo) GetRunningWorkflowCount() (o int64) {
 	return
 }
 
+func (v *ScheduleInfo) GetRecentActions() (o []*ScheduleActionResult) {
+	if v != nil {
+		return v.RecentActions
+	}
+	return
+}
+
+func (v *ScheduleInfo) GetConsecutiveFailures() (o int32) {
+	if v != nil {
+		return v.ConsecutiveFailures
+	}
+	return
+}
+
+func (v *ScheduleInfo) GetLastFailure() *ScheduleFailureInfo {
+	if v != nil {
+		return v.LastFailure
+	}
+	return nil
+}
+
 func (v *StartWorkflowAction) GetInput() (o []byte) {
 	if v != nil {
 		return v.Input
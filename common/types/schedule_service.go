@@ -470,3 +470,132 @@ func (v *BackfillScheduleRequest) GetBackfillID() (o string) {
 
 // BackfillScheduleResponse is the response for triggering a backfill.
 type BackfillScheduleResponse struct{}
+
+// TriggerScheduleRequest is the request to fire a schedule immediately.
+// OverlapPolicy overrides the schedule's policy for this fire when set.
+type TriggerScheduleRequest struct {
+	Domain        string                `json:"domain,omitempty"`
+	ScheduleID    string                `json:"scheduleId,omitempty"`
+	OverlapPolicy ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	Identity      string                `json:"identity,omitempty"`
+}
+
+func (v *TriggerScheduleRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *TriggerScheduleRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+func (v *TriggerScheduleRequest) GetOverlapPolicy() (o ScheduleOverlapPolicy) {
+	if v != nil {
+		return v.OverlapPolicy
+	}
+	return
+}
+
+func (v *TriggerScheduleRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// TriggerScheduleResponse is the response for triggering a schedule.
+type TriggerScheduleResponse struct{}
+
+// ListScheduleMatchingTimesRequest asks for the fire times of a schedule
+// within [StartTime, EndTime] without taking any action. When Spec is set it
+// is previewed as given (e.g. a proposed cron change or a schedule that has
+// not been created yet); otherwise the current spec of ScheduleID is used.
+// MaximumTimes bounds the number of times returned; zero uses the server
+// default.
+type ListScheduleMatchingTimesRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	ScheduleID   string        `json:"scheduleId,omitempty"`
+	Spec         *ScheduleSpec `json:"spec,omitempty"`
+	StartTime    time.Time     `json:"startTime,omitempty"`
+	EndTime      time.Time     `json:"endTime,omitempty"`
+	MaximumTimes int32         `json:"maximumTimes,omitempty"`
+}
+
+func (v *ListScheduleMatchingTimesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *ListScheduleMatchingTimesRequest) GetScheduleID() (o string) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+func (v *ListScheduleMatchingTimesRequest) GetSpec() *ScheduleSpec {
+	if v != nil {
+		return v.Spec
+	}
+	return nil
+}
+
+func (v *ListScheduleMatchingTimesRequest) GetStartTime() (o time.Time) {
+	if v != nil {
+		return v.StartTime
+	}
+	return
+}
+
+func (v *ListScheduleMatchingTimesRequest) GetEndTime() (o time.Time) {
+	if v != nil {
+		return v.EndTime
+	}
+	return
+}
+
+func (v *ListScheduleMatchingTimesRequest) GetMaximumTimes() (o int32) {
+	if v != nil {
+		return v.MaximumTimes
+	}
+	return
+}
+
+// ListScheduleMatchingTimesResponse holds the matching fire times in
+// ascending order. TotalCount is the number of fire times in the whole range,
+// counted up to the same cap BackfillSchedule uses for progress reporting;
+// TotalCountCapped is true when the range holds more than that. StartTimes
+// is shorter than TotalCount when MaximumTimes was reached.
+type ListScheduleMatchingTimesResponse struct {
+	StartTimes       []time.Time `json:"startTimes,omitempty"`
+	TotalCount       int32       `json:"totalCount,omitempty"`
+	TotalCountCapped bool        `json:"totalCountCapped,omitempty"`
+}
+
+func (v *ListScheduleMatchingTimesResponse) GetStartTimes() (o []time.Time) {
+	if v != nil {
+		return v.StartTimes
+	}
+	return
+}
+
+func (v *ListScheduleMatchingTimesResponse) GetTotalCount() (o int32) {
+	if v != nil {
+		return v.TotalCount
+	}
+	return
+}
+
+func (v *ListScheduleMatchingTimesResponse) GetTotalCountCapped() (o bool) {
+	if v != nil {
+		return v.TotalCountCapped
+	}
+	return
+}
@@ -163,3 +163,47 @@ func TestBackfillScheduleRequest_Getters(t *testing.T) {
 	assert.Equal(t, ScheduleOverlapPolicyBuffer, v.GetOverlapPolicy())
 	assert.Equal(t, "bf-1", v.GetBackfillID())
 }
+
+func TestTriggerScheduleRequest_NilGetters(t *testing.T) {
+	var v *TriggerScheduleRequest
+	assert.Equal(t, "", v.GetDomain())
+	assert.Equal(t, "", v.GetScheduleID())
+	assert.Equal(t, ScheduleOverlapPolicyInvalid, v.GetOverlapPolicy())
+	assert.Equal(t, "", v.GetIdentity())
+}
+
+func TestTriggerScheduleRequest_Getters(t *testing.T) {
+	v := &TriggerScheduleRequest{
+		Domain:        "test-domain",
+		ScheduleID:    "sched-1",
+		OverlapPolicy: ScheduleOverlapPolicyConcurrent,
+		Identity:      "tester",
+	}
+	assert.Equal(t, "test-domain", v.GetDomain())
+	assert.Equal(t, "sched-1", v.GetScheduleID())
+	assert.Equal(t, ScheduleOverlapPolicyConcurrent, v.GetOverlapPolicy())
+	assert.Equal(t, "tester", v.GetIdentity())
+}
+
+func TestListScheduleMatchingTimesRequest_NilGetters(t *testing.T) {
+	var v *ListScheduleMatchingTimesRequest
+	assert.Equal(t, "", v.GetDomain())
+	assert.Equal(t, "", v.GetScheduleID())
+	assert.Nil(t, v.GetSpec())
+	assert.Equal(t, time.Time{}, v.GetStartTime())
+	assert.Equal(t, time.Time{}, v.GetEndTime())
+	assert.Equal(t, int32(0), v.GetMaximumTimes())
+}
+
+func TestListScheduleMatchingTimesResponse_Getters(t *testing.T) {
+	var nilResp *ListScheduleMatchingTimesResponse
+	assert.Nil(t, nilResp.GetStartTimes())
+	assert.Equal(t, int32(0), nilResp.GetTotalCount())
+	assert.False(t, nilResp.GetTotalCountCapped())
+
+	ts := []time.Time{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	v := &ListScheduleMatchingTimesResponse{StartTimes: ts, TotalCount: 5, TotalCountCapped: true}
+	assert.Equal(t, ts, v.GetStartTimes())
+	assert.Equal(t, int32(5), v.GetTotalCount())
+	assert.True(t, v.GetTotalCountCapped())
+}
@@ -22,6 +22,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -175,3 +176,143 @@ func TestScheduleCatchUpPolicy_Ptr(t *testing.T) {
 	ptr := val.Ptr()
 	assert.Equal(t, &val, ptr)
 }
+
+func TestScheduleActionOutcome_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want ScheduleActionOutcome
+		err  bool
+	}{
+		{name: "invalid", text: "INVALID", want: ScheduleActionOutcomeInvalid},
+		{name: "started", text: "STARTED", want: ScheduleActionOutcomeStarted},
+		{name: "skipped", text: "SKIPPED", want: ScheduleActionOutcomeSkipped},
+		{name: "failed", text: "FAILED", want: ScheduleActionOutcomeFailed},
+		{name: "lowercase", text: "started", want: ScheduleActionOutcomeStarted},
+		{name: "numeric", text: "2", want: ScheduleActionOutcome(2)},
+		{name: "unknown", text: "UNKNOWN", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ScheduleActionOutcome
+			err := got.UnmarshalText([]byte(tt.text))
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScheduleActionOutcome_RoundTrip(t *testing.T) {
+	for _, val := range []ScheduleActionOutcome{
+		ScheduleActionOutcomeInvalid,
+		ScheduleActionOutcomeStarted,
+		ScheduleActionOutcomeSkipped,
+		ScheduleActionOutcomeFailed,
+	} {
+		b, err := val.MarshalText()
+		assert.NoError(t, err)
+		var got ScheduleActionOutcome
+		err = got.UnmarshalText(b)
+		assert.NoError(t, err)
+		assert.Equal(t, val, got)
+	}
+}
+
+func TestScheduleActionResult_NilGetters(t *testing.T) {
+	var v *ScheduleActionResult
+	assert.Equal(t, time.Time{}, v.GetScheduledTime())
+	assert.Equal(t, time.Time{}, v.GetActualTime())
+	assert.Nil(t, v.GetWorkflowExecution())
+	assert.Equal(t, ScheduleActionOutcomeInvalid, v.GetOutcome())
+}
+
+func TestScheduleBatchOperationType_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want ScheduleBatchOperationType
+		err  bool
+	}{
+		{name: "invalid", text: "INVALID", want: ScheduleBatchOperationTypeInvalid},
+		{name: "terminate", text: "TERMINATE", want: ScheduleBatchOperationTypeTerminate},
+		{name: "cancel", text: "CANCEL", want: ScheduleBatchOperationTypeCancel},
+		{name: "signal", text: "SIGNAL", want: ScheduleBatchOperationTypeSignal},
+		{name: "lowercase", text: "terminate", want: ScheduleBatchOperationTypeTerminate},
+		{name: "numeric", text: "3", want: ScheduleBatchOperationType(3)},
+		{name: "unknown", text: "RESET", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ScheduleBatchOperationType
+			err := got.UnmarshalText([]byte(tt.text))
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScheduleBatchOperationType_RoundTrip(t *testing.T) {
+	for _, val := range []ScheduleBatchOperationType{
+		ScheduleBatchOperationTypeInvalid,
+		ScheduleBatchOperationTypeTerminate,
+		ScheduleBatchOperationTypeCancel,
+		ScheduleBatchOperationTypeSignal,
+	} {
+		b, err := val.MarshalText()
+		assert.NoError(t, err)
+		var got ScheduleBatchOperationType
+		err = got.UnmarshalText(b)
+		assert.NoError(t, err)
+		assert.Equal(t, val, got)
+	}
+}
+
+func TestScheduleAction_NilGetters(t *testing.T) {
+	var v *ScheduleAction
+	assert.Nil(t, v.GetStartWorkflow())
+	assert.Nil(t, v.GetSignalWorkflow())
+	assert.Nil(t, v.GetBatchOperation())
+
+	var sw *SignalWorkflowAction
+	assert.Equal(t, "", sw.GetWorkflowID())
+	assert.Equal(t, "", sw.GetSignalName())
+	assert.Nil(t, sw.GetSignalInput())
+	assert.Nil(t, sw.GetStartWorkflow())
+
+	var bo *BatchOperationAction
+	assert.Equal(t, ScheduleBatchOperationTypeInvalid, bo.GetOperationType())
+	assert.Equal(t, "", bo.GetQuery())
+	assert.Equal(t, "", bo.GetReason())
+	assert.Equal(t, "", bo.GetSignalName())
+	assert.Equal(t, "", bo.GetSignalInput())
+	assert.Equal(t, int32(0), bo.GetRPS())
+	assert.Equal(t, int32(0), bo.GetConcurrency())
+}
+
+func TestScheduleFailureInfo_NilGetters(t *testing.T) {
+	var v *ScheduleFailureInfo
+	assert.Nil(t, v.GetWorkflowExecution())
+	assert.Equal(t, WorkflowExecutionCloseStatusCompleted, v.GetCloseStatus())
+	assert.Equal(t, "", v.GetFailureReason())
+	assert.Equal(t, time.Time{}, v.GetObservedTime())
+	assert.Equal(t, int32(0), v.GetConsecutiveFailures())
+
+	var p *SchedulePolicies
+	assert.Equal(t, int32(0), p.GetPauseOnFailureThreshold())
+	assert.Equal(t, time.Duration(0), p.GetPauseOnFailureCooldown())
+
+	var pi *SchedulePauseInfo
+	assert.Equal(t, time.Time{}, pi.GetAutoUnpauseTime())
+
+	var i *ScheduleInfo
+	assert.Equal(t, int32(0), i.GetConsecutiveFailures())
+	assert.Nil(t, i.GetLastFailure())
+}
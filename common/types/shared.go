@@ -4810,6 +4810,7 @@ type PendingActivityInfo struct {
 	LastFailureDetails     []byte                `json:"lastFailureDetails,omitempty"`
 	LastFailureOptions     *FailureOptions       `json:"lastFailureOptions,omitempty"`
 	ScheduleID             int64                 `json:"scheduleID,omitempty"`
+	Paused                 bool                  `json:"paused,omitempty"`
 }
 
 // GetActivityID is an internal getter (TBD...)
@@ -4900,6 +4901,14 @@ func (v *PendingActivityInfo) GetScheduleID() (o int64) {
 	return
 }
 
+// GetPaused is an internal getter
+func (v *PendingActivityInfo) GetPaused() (o bool) {
+	if v != nil {
+		return v.Paused
+	}
+	return
+}
+
 // PendingActivityState is an internal type (TBD...)
 type PendingActivityState int32
 
@@ -8762,6 +8771,7 @@ type WorkflowExecutionInfo struct {
 	CronSchedule                 *string                       `json:"cronSchedule,omitempty"`
 	ExecutionStatus              *WorkflowExecutionStatus      `json:"executionStatus,omitempty"`
 	ScheduledExecutionTime       *int64                        `json:"scheduledExecutionTime,omitempty"`
+	IsPaused                     bool                          `json:"isPaused,omitempty"`
 }
 
 // GetExecution is an internal getter (TBD...)
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPauseWorkflowExecutionRequest_Getters(t *testing.T) {
+	var empty *PauseWorkflowExecutionRequest
+	assert.Equal(t, "", empty.GetDomain())
+	assert.Nil(t, empty.GetWorkflowExecution())
+	assert.Equal(t, "", empty.GetReason())
+	assert.Equal(t, "", empty.GetIdentity())
+
+	v := &PauseWorkflowExecutionRequest{
+		Domain:            "d",
+		WorkflowExecution: &WorkflowExecution{WorkflowID: "wid"},
+		Reason:            "r",
+		Identity:          "i",
+	}
+	assert.Equal(t, "d", v.GetDomain())
+	assert.Equal(t, "wid", v.GetWorkflowExecution().GetWorkflowID())
+	assert.Equal(t, "r", v.GetReason())
+	assert.Equal(t, "i", v.GetIdentity())
+}
+
+func TestResumeWorkflowExecutionRequest_Getters(t *testing.T) {
+	var empty *ResumeWorkflowExecutionRequest
+	assert.Equal(t, "", empty.GetDomain())
+	assert.Nil(t, empty.GetWorkflowExecution())
+	assert.Equal(t, "", empty.GetIdentity())
+
+	v := &ResumeWorkflowExecutionRequest{
+		Domain:            "d",
+		WorkflowExecution: &WorkflowExecution{WorkflowID: "wid"},
+		Identity:          "i",
+	}
+	assert.Equal(t, "d", v.GetDomain())
+	assert.Equal(t, "wid", v.GetWorkflowExecution().GetWorkflowID())
+	assert.Equal(t, "i", v.GetIdentity())
+}
+
+func TestHistoryWorkflowPauseRequests_Getters(t *testing.T) {
+	var emptyPause *HistoryPauseWorkflowExecutionRequest
+	assert.Equal(t, "", emptyPause.GetDomainUUID())
+	assert.Nil(t, emptyPause.GetRequest())
+	var emptyResume *HistoryResumeWorkflowExecutionRequest
+	assert.Equal(t, "", emptyResume.GetDomainUUID())
+	assert.Nil(t, emptyResume.GetRequest())
+
+	pause := &HistoryPauseWorkflowExecutionRequest{DomainUUID: "id", Request: &PauseWorkflowExecutionRequest{Domain: "d"}}
+	assert.Equal(t, "id", pause.GetDomainUUID())
+	assert.Equal(t, "d", pause.GetRequest().GetDomain())
+	resume := &HistoryResumeWorkflowExecutionRequest{DomainUUID: "id", Request: &ResumeWorkflowExecutionRequest{Domain: "d"}}
+	assert.Equal(t, "id", resume.GetDomainUUID())
+	assert.Equal(t, "d", resume.GetRequest().GetDomain())
+}
@@ -31,6 +31,70 @@ import (
 	"github.com/uber/cadence/testflags"
 )
 
+func TestMongoDBHistoryPersistence(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.HistoryV2PersistenceSuite)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMongoDBMatchingPersistence(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.MatchingPersistenceSuite)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMongoDBDomainPersistence(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.MetadataPersistenceSuiteV2)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMongoDBShardPersistence(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.ShardPersistenceSuite)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMongoDBVisibilityPersistence(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.DBVisibilityPersistenceSuite)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMongoDBExecutionManager(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.ExecutionManagerSuite)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMongoDBExecutionManagerWithEventsV2(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.ExecutionManagerSuiteForEventsV2)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMongoDBQueuePersistence(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.QueuePersistenceSuite)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
 func TestMongoDBConfigStorePersistence(t *testing.T) {
 	testflags.RequireMongoDB(t)
 	s := new(persistencetests.ConfigStorePersistenceSuite)
@@ -39,70 +103,24 @@ func TestMongoDBConfigStorePersistence(t *testing.T) {
 	suite.Run(t, s)
 }
 
-// TODO uncomment the test once HistoryEventsCRUD is implemented
-// func TestMongoDBHistoryPersistence(t *testing.T) {
-// 	s := new(persistencetests.HistoryV2PersistenceSuite)
-// 	s.TestBase = NewTestBaseWithMongo()
-// 	s.TestBase.Setup()
-// 	suite.Run(t, s)
-// }
-
-// TODO uncomment the test once TaskCRUD is implemented
-// func TestMongoDBMatchingPersistence(t *testing.T) {
-// 	s := new(persistencetests.MatchingPersistenceSuite)
-// 	s.TestBase = NewTestBaseWithMongo()
-// 	s.TestBase.Setup()
-// 	suite.Run(t, s)
-// }
-
-// TODO uncomment the test once DomainCRUD is implemented
-// func TestMongoDBDomainPersistence(t *testing.T) {
-// 	s := new(persistencetests.MetadataPersistenceSuiteV2)
-// 	s.TestBase = NewTestBaseWithMongo()
-// 	s.TestBase.Setup()
-// 	suite.Run(t, s)
-// }
-
-// TODO uncomment the test once MessageQueueCRUD is implemented
-// func TestMongoDBQueuePersistence(t *testing.T) {
-// 	s := new(persistencetests.QueuePersistenceSuite)
-// 	s.TestBase = NewTestBaseWithMongo()
-// 	s.TestBase.Setup()
-// 	suite.Run(t, s)
-// }
-
-// TODO uncomment the test once ShardCRUD is implemented
-// func TestMongoDBShardPersistence(t *testing.T) {
-// 	s := new(persistencetests.ShardPersistenceSuite)
-// 	s.TestBase = NewTestBaseWithMongo()
-// 	s.TestBase.Setup()
-// 	suite.Run(t, s)
-// }
-
-// TODO uncomment the test once VisibilityCRUD is implemented
-// func TestMongoDBVisibilityPersistence(t *testing.T) {
-// 	s := new(persistencetests.DBVisibilityPersistenceSuite)
-// 	s.TestBase = NewTestBaseWithMongo()
-// 	s.TestBase.Setup()
-// 	suite.Run(t, s)
-// }
-
-// TODO uncomment the test once WorkflowCRUD is implemented
-// func TestMongoDBExecutionManager(t *testing.T) {
-// 	s := new(persistencetests.ExecutionManagerSuite)
-// 	s.TestBase = NewTestBaseWithMongo()
-// 	s.TestBase.Setup()
-// 	suite.Run(t, s)
-// }
-
-// TODO uncomment the test once WorkflowCRUD is implemented
-// func TestMongoDBExecutionManagerWithEventsV2(t *testing.T) {
-// 	s := new(persistencetests.ExecutionManagerSuiteForEventsV2)
-// 	s.TestBase = NewTestBaseWithMongo()
-// 	s.TestBase.Setup()
-// 	suite.Run(t, s)
-// }
+func TestMongoDBDomainAuditPersistence(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.DomainAuditPersistenceSuite)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
+func TestMongoDBHistoryTaskDLQPersistence(t *testing.T) {
+	testflags.RequireMongoDB(t)
+	s := new(persistencetests.HistoryTaskDLQPersistenceSuite)
+	s.TestBase = NewTestBaseWithMongo(t)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
 
+// NewTestBaseWithMongo returns a persistence test base backed by a single node MongoDB replica set,
+// transactions are not supported by a standalone MongoDB
 func NewTestBaseWithMongo(t *testing.T) *persistencetests.TestBase {
 	port, err := environment.GetMongoPort()
 	if err != nil {
@@ -112,8 +130,6 @@ func NewTestBaseWithMongo(t *testing.T) *persistencetests.TestBase {
 	options := &persistencetests.TestBaseOptions{
 		DBPluginName: mongodb.PluginName,
 		DBHost:       environment.GetMongoAddress(),
-		DBUsername:   "root",
-		DBPassword:   "cadence",
 		DBPort:       port,
 	}
 	return persistencetests.NewTestBaseWithNoSQL(t, options)
@@ -158,3 +158,13 @@ func TestPostgresSQLDomainAuditPersistence(t *testing.T) {
 	s.TestBase.Setup()
 	suite.Run(t, s)
 }
+
+func TestPostgresSQLHistoryTaskDLQPersistence(t *testing.T) {
+	testflags.RequirePostgres(t)
+	s := new(pt.HistoryTaskDLQPersistenceSuite)
+	options, err := postgres.GetTestClusterOption()
+	assert.NoError(t, err)
+	s.TestBase = pt.NewTestBaseWithSQL(t, options)
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
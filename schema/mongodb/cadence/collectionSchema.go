@@ -20,9 +20,30 @@
 
 package cadence
 
+import "time"
+
 // below are the names of all mongoDB collections
 const (
-	ClusterConfigCollectionName = "cluster_config"
+	ClusterConfigCollectionName                = "cluster_config"
+	ShardCollectionName                        = "shard"
+	CurrentWorkflowCollectionName              = "current_workflow"
+	WorkflowExecutionCollectionName            = "workflow_execution"
+	WorkflowRequestCollectionName              = "workflow_request"
+	ActiveClusterSelectionPolicyCollectionName = "active_cluster_selection_policy"
+	HistoryTaskCollectionName                  = "history_task"
+	ReplicationDLQTaskCollectionName           = "replication_dlq_task"
+	HistoryTreeCollectionName                  = "history_tree"
+	HistoryNodeCollectionName                  = "history_node"
+	TaskListCollectionName                     = "task_list"
+	TaskCollectionName                         = "task"
+	DomainCollectionName                       = "domain"
+	DomainMetadataCollectionName               = "domain_metadata"
+	QueueMessageCollectionName                 = "queue_message"
+	QueueMetadataCollectionName                = "queue_metadata"
+	VisibilityCollectionName                   = "visibility"
+	DomainAuditLogCollectionName               = "domain_audit_log"
+	HistoryTaskDLQCollectionName               = "history_task_dlq"
+	HistoryTaskDLQAckLevelCollectionName       = "history_task_dlq_ack_level"
 )
 
 // NOTE1: MongoDB collection is schemaless -- there is no schema file for collection. We use Go lang structs to define the collection fields.
@@ -39,3 +60,240 @@ type ClusterConfigCollectionEntry struct {
 	DataEncoding         string `json:"dataencoding"`
 	UnixTimestampSeconds int64  `json:"unixtimestampseconds"`
 }
+
+// ShardCollectionEntry is the schema of shard
+// The shard info is kept as JSON. TxnVersion is bumped by every transaction guarded by the shard rangeID,
+// so that concurrent transactions of the same shard always conflict with each other.
+type ShardCollectionEntry struct {
+	ShardID      int    `bson:"shardid"`
+	RangeID      int64  `bson:"rangeid"`
+	Info         []byte `bson:"info"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+	TxnVersion   int64  `bson:"txnversion"`
+}
+
+// CurrentWorkflowCollectionEntry is the schema of the current run of a workflow
+type CurrentWorkflowCollectionEntry struct {
+	ShardID          int    `bson:"shardid"`
+	DomainID         string `bson:"domainid"`
+	WorkflowID       string `bson:"workflowid"`
+	RunID            string `bson:"runid"`
+	CreateRequestID  string `bson:"createrequestid"`
+	State            int    `bson:"state"`
+	CloseStatus      int    `bson:"closestatus"`
+	LastWriteVersion int64  `bson:"lastwriteversion"`
+	LastUpdated      int64  `bson:"lastupdated"`
+}
+
+// WorkflowExecutionCollectionEntry is the schema of a workflow run, including the mutable state maps and the buffered events
+// The maps are kept as JSON because the keys(e.g. timerID) can contain the characters that are not allowed in field names.
+type WorkflowExecutionCollectionEntry struct {
+	ShardID                  int                           `bson:"shardid"`
+	DomainID                 string                        `bson:"domainid"`
+	WorkflowID               string                        `bson:"workflowid"`
+	RunID                    string                        `bson:"runid"`
+	NextEventID              int64                         `bson:"nexteventid"`
+	LastWriteVersion         int64                         `bson:"lastwriteversion"`
+	Info                     []byte                        `bson:"info"`
+	VersionHistories         []byte                        `bson:"versionhistories"`
+	VersionHistoriesEncoding string                        `bson:"versionhistoriesencoding"`
+	Checksum                 []byte                        `bson:"checksum"`
+	ActivityMap              []byte                        `bson:"activitymap"`
+	TimerMap                 []byte                        `bson:"timermap"`
+	ChildExecutionMap        []byte                        `bson:"childexecutionmap"`
+	RequestCancelMap         []byte                        `bson:"requestcancelmap"`
+	SignalMap                []byte                        `bson:"signalmap"`
+	SignalRequested          []string                      `bson:"signalrequested"`
+	WorkflowTimerTasks       []WorkflowTimerTaskEntry      `bson:"workflowtimertasks"`
+	BufferedEvents           []WorkflowBufferedEventsEntry `bson:"bufferedevents"`
+	LastUpdated              int64                         `bson:"lastupdated"`
+}
+
+// WorkflowTimerTaskEntry is the key of a timer task of a workflow run
+type WorkflowTimerTaskEntry struct {
+	VisibilityTS int64 `bson:"visibilityts"`
+	TaskID       int64 `bson:"taskid"`
+}
+
+// WorkflowBufferedEventsEntry is a batch of buffered events of a workflow run
+type WorkflowBufferedEventsEntry struct {
+	Data     []byte `bson:"data"`
+	Encoding string `bson:"encoding"`
+}
+
+// WorkflowRequestCollectionEntry is the schema of the workflow requests for deduplication
+// The documents are removed by the TTL index on the expiry field.
+type WorkflowRequestCollectionEntry struct {
+	ShardID     int       `bson:"shardid"`
+	DomainID    string    `bson:"domainid"`
+	WorkflowID  string    `bson:"workflowid"`
+	RequestType int       `bson:"requesttype"`
+	RequestID   string    `bson:"requestid"`
+	RunID       string    `bson:"runid"`
+	Version     int64     `bson:"version"`
+	LastUpdated int64     `bson:"lastupdated"`
+	Expiry      time.Time `bson:"expiry"`
+}
+
+// ActiveClusterSelectionPolicyCollectionEntry is the schema of the active cluster selection policy of a workflow run
+type ActiveClusterSelectionPolicyCollectionEntry struct {
+	ShardID      int    `bson:"shardid"`
+	DomainID     string `bson:"domainid"`
+	WorkflowID   string `bson:"workflowid"`
+	RunID        string `bson:"runid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+	LastUpdated  int64  `bson:"lastupdated"`
+}
+
+// HistoryTaskCollectionEntry is the schema of the transfer, timer and replication tasks of a shard
+// VisibilityTS is only set for timer tasks, in milliseconds.
+type HistoryTaskCollectionEntry struct {
+	ShardID      int    `bson:"shardid"`
+	TaskType     int    `bson:"tasktype"`
+	VisibilityTS int64  `bson:"visibilityts"`
+	TaskID       int64  `bson:"taskid"`
+	Info         []byte `bson:"info"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+	CreatedTime  int64  `bson:"createdtime"`
+}
+
+// ReplicationDLQTaskCollectionEntry is the schema of the replication tasks that failed to be applied from a source cluster
+type ReplicationDLQTaskCollectionEntry struct {
+	ShardID       int    `bson:"shardid"`
+	SourceCluster string `bson:"sourcecluster"`
+	TaskID        int64  `bson:"taskid"`
+	Info          []byte `bson:"info"`
+	Data          []byte `bson:"data"`
+	DataEncoding  string `bson:"dataencoding"`
+	CreatedTime   int64  `bson:"createdtime"`
+}
+
+// HistoryTreeCollectionEntry is the schema of a history branch
+type HistoryTreeCollectionEntry struct {
+	TreeID      string `bson:"treeid"`
+	BranchID    string `bson:"branchid"`
+	Ancestors   []byte `bson:"ancestors"`
+	Info        string `bson:"info"`
+	CreatedTime int64  `bson:"createdtime"`
+}
+
+// HistoryNodeCollectionEntry is the schema of a batch of history events
+type HistoryNodeCollectionEntry struct {
+	TreeID       string `bson:"treeid"`
+	BranchID     string `bson:"branchid"`
+	NodeID       int64  `bson:"nodeid"`
+	TxnID        int64  `bson:"txnid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+	CreatedTime  int64  `bson:"createdtime"`
+}
+
+// TaskListCollectionEntry is the schema of a tasklist
+// Expiry is only set by UpdateTaskListWithTTL, the document is removed by the TTL index after that.
+type TaskListCollectionEntry struct {
+	DomainID                string     `bson:"domainid"`
+	TaskListName            string     `bson:"tasklistname"`
+	TaskListType            int        `bson:"tasklisttype"`
+	RangeID                 int64      `bson:"rangeid"`
+	AckLevel                int64      `bson:"acklevel"`
+	Kind                    int        `bson:"kind"`
+	LastUpdated             int64      `bson:"lastupdated"`
+	AdaptivePartitionConfig []byte     `bson:"adaptivepartitionconfig"`
+	Expiry                  *time.Time `bson:"expiry,omitempty"`
+	TxnVersion              int64      `bson:"txnversion"`
+}
+
+// TaskCollectionEntry is the schema of a task of a tasklist
+type TaskCollectionEntry struct {
+	DomainID        string     `bson:"domainid"`
+	TaskListName    string     `bson:"tasklistname"`
+	TaskListType    int        `bson:"tasklisttype"`
+	TaskID          int64      `bson:"taskid"`
+	WorkflowID      string     `bson:"workflowid"`
+	RunID           string     `bson:"runid"`
+	ScheduleID      int64      `bson:"scheduleid"`
+	CreatedTime     int64      `bson:"createdtime"`
+	PartitionConfig []byte     `bson:"partitionconfig"`
+	Expiry          *time.Time `bson:"expiry,omitempty"`
+}
+
+// DomainCollectionEntry is the schema of a domain, the domain is kept as JSON
+type DomainCollectionEntry struct {
+	DomainID string `bson:"domainid"`
+	Name     string `bson:"name"`
+	Data     []byte `bson:"data"`
+}
+
+// DomainMetadataCollectionEntry is the schema of the single document of the domain metadata
+type DomainMetadataCollectionEntry struct {
+	ID                  string `bson:"_id"`
+	NotificationVersion int64  `bson:"notificationversion"`
+}
+
+// QueueMessageCollectionEntry is the schema of a message of a queue
+type QueueMessageCollectionEntry struct {
+	QueueType   int    `bson:"queuetype"`
+	MessageID   int64  `bson:"messageid"`
+	Payload     []byte `bson:"payload"`
+	CreatedTime int64  `bson:"createdtime"`
+}
+
+// QueueMetadataCollectionEntry is the schema of the metadata of a queue, the ack levels are kept as JSON
+type QueueMetadataCollectionEntry struct {
+	QueueType        int    `bson:"queuetype"`
+	ClusterAckLevels []byte `bson:"clusteracklevels"`
+	Version          int64  `bson:"version"`
+	CreatedTime      int64  `bson:"createdtime"`
+	LastUpdated      int64  `bson:"lastupdated"`
+}
+
+// VisibilityCollectionEntry is the schema of the visibility record of a workflow run
+// StartTime and CloseTime are in milliseconds, same as Cassandra. The record is kept as JSON.
+type VisibilityCollectionEntry struct {
+	DomainID     string     `bson:"domainid"`
+	WorkflowID   string     `bson:"workflowid"`
+	RunID        string     `bson:"runid"`
+	WorkflowType string     `bson:"workflowtype"`
+	StartTime    int64      `bson:"starttime"`
+	CloseTime    int64      `bson:"closetime"`
+	CloseStatus  int        `bson:"closestatus"`
+	Closed       bool       `bson:"closed"`
+	Data         []byte     `bson:"data"`
+	Expiry       *time.Time `bson:"expiry,omitempty"`
+}
+
+// DomainAuditLogCollectionEntry is the schema of an audit log entry of a domain, the entry is kept as JSON
+type DomainAuditLogCollectionEntry struct {
+	DomainID      string     `bson:"domainid"`
+	OperationType int        `bson:"operationtype"`
+	CreatedTime   int64      `bson:"createdtime"`
+	EventID       string     `bson:"eventid"`
+	Data          []byte     `bson:"data"`
+	Expiry        *time.Time `bson:"expiry,omitempty"`
+}
+
+// HistoryTaskDLQCollectionEntry is the schema of a task in the history DLQ, the task is kept as JSON
+// VisibilityTS is in nanoseconds.
+type HistoryTaskDLQCollectionEntry struct {
+	ShardID               int    `bson:"shardid"`
+	DomainID              string `bson:"domainid"`
+	ClusterAttributeScope string `bson:"clusterattributescope"`
+	ClusterAttributeName  string `bson:"clusterattributename"`
+	TaskCategory          int    `bson:"taskcategory"`
+	VisibilityTS          int64  `bson:"visibilityts"`
+	TaskID                int64  `bson:"taskid"`
+	Data                  []byte `bson:"data"`
+}
+
+// HistoryTaskDLQAckLevelCollectionEntry is the schema of the ack level of the history DLQ, the ack level is kept as JSON
+type HistoryTaskDLQAckLevelCollectionEntry struct {
+	ShardID               int    `bson:"shardid"`
+	DomainID              string `bson:"domainid"`
+	ClusterAttributeScope string `bson:"clusterattributescope"`
+	ClusterAttributeName  string `bson:"clusterattributename"`
+	TaskCategory          int    `bson:"taskcategory"`
+	Data                  []byte `bson:"data"`
+}
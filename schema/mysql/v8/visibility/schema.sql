@@ -18,6 +18,7 @@ CREATE TABLE executions_visibility (
   cron_schedule            VARCHAR(255) NULL,
   execution_status         INT NULL,
   scheduled_execution_time DATETIME(6) NULL,
+  search_attributes        JSON NULL,
 
   PRIMARY KEY  (domain_id, run_id)
 );
@@ -0,0 +1,30 @@
+CREATE TABLE history_task_dlq (
+  shard_id                INTEGER     NOT NULL,
+  domain_id               TEXT        NOT NULL,
+  cluster_attribute_scope TEXT        NOT NULL,
+  cluster_attribute_name  TEXT        NOT NULL,
+  task_category           INTEGER     NOT NULL,
+  visibility_timestamp    TIMESTAMP   NOT NULL,
+  task_id                 BIGINT      NOT NULL,
+  --
+  workflow_id             TEXT        NOT NULL,
+  run_id                  TEXT        NOT NULL,
+  version                 BIGINT      NOT NULL,
+  data                    BYTEA       NOT NULL,
+  data_encoding           VARCHAR(16) NOT NULL,
+  created_at              TIMESTAMP   NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category, visibility_timestamp, task_id)
+);
+
+CREATE TABLE history_task_dlq_ack_level (
+  shard_id                       INTEGER   NOT NULL,
+  domain_id                      TEXT      NOT NULL,
+  cluster_attribute_scope        TEXT      NOT NULL,
+  cluster_attribute_name         TEXT      NOT NULL,
+  task_category                  INTEGER   NOT NULL,
+  --
+  ack_level_visibility_timestamp TIMESTAMP NOT NULL,
+  ack_level_task_id              BIGINT    NOT NULL,
+  last_updated_at                TIMESTAMP NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category)
+);
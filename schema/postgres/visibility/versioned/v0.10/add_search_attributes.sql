@@ -0,0 +1,2 @@
+-- Add search_attributes field to store custom search attributes used by query based visibility
+ALTER TABLE executions_visibility ADD search_attributes JSONB NULL;
@@ -61,6 +61,8 @@ CREATE TABLE executions
     last_write_version BIGINT       NOT NULL,
     data               MEDIUMBLOB   NOT NULL,
     data_encoding      VARCHAR(16)  NOT NULL,
+    paused             BOOLEAN      DEFAULT false NOT NULL,
+    decision_dispatch_suppressed BOOLEAN DEFAULT false NOT NULL,
     PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
 );
 
@@ -163,6 +165,8 @@ CREATE TABLE activity_info_maps
     data_encoding               VARCHAR(16),
     last_heartbeat_details      BLOB,
     last_heartbeat_updated_time DATETIME(6)  NOT NULL,
+    paused                      BOOLEAN      DEFAULT false NOT NULL,
+    dispatch_suppressed         BOOLEAN      DEFAULT false NOT NULL,
     PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, schedule_id)
 );
 
@@ -329,3 +333,36 @@ CREATE TABLE active_cluster_selection_policy
     data_encoding VARCHAR(16)  NOT NULL,
     PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
 );
+
+CREATE TABLE history_task_dlq
+(
+    shard_id                INT          NOT NULL,
+    domain_id               VARCHAR(255) NOT NULL,
+    cluster_attribute_scope VARCHAR(255) NOT NULL,
+    cluster_attribute_name  VARCHAR(255) NOT NULL,
+    task_category           INT          NOT NULL,
+    visibility_timestamp    DATETIME(6)  NOT NULL,
+    task_id                 BIGINT       NOT NULL,
+    --
+    workflow_id             VARCHAR(255) NOT NULL,
+    run_id                  VARCHAR(255) NOT NULL,
+    version                 BIGINT       NOT NULL,
+    data                    MEDIUMBLOB   NOT NULL,
+    data_encoding           VARCHAR(16)  NOT NULL,
+    created_at              DATETIME(6)  NOT NULL,
+    PRIMARY KEY (shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category, visibility_timestamp, task_id)
+);
+
+CREATE TABLE history_task_dlq_ack_level
+(
+    shard_id                       INT          NOT NULL,
+    domain_id                      VARCHAR(255) NOT NULL,
+    cluster_attribute_scope        VARCHAR(255) NOT NULL,
+    cluster_attribute_name         VARCHAR(255) NOT NULL,
+    task_category                  INT          NOT NULL,
+    --
+    ack_level_visibility_timestamp DATETIME(6)  NOT NULL,
+    ack_level_task_id              BIGINT       NOT NULL,
+    last_updated_at                DATETIME(6)  NOT NULL,
+    PRIMARY KEY (shard_id, domain_id, cluster_attribute_scope, cluster_attribute_name, task_category)
+);
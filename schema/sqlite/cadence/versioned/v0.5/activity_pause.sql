@@ -0,0 +1,2 @@
+-- Add paused field to hold back new attempts of a paused activity
+ALTER TABLE activity_info_maps ADD paused BOOLEAN DEFAULT false NOT NULL;
@@ -0,0 +1,2 @@
+-- Add paused field to stop dispatching new decision and activity tasks of a paused workflow
+ALTER TABLE executions ADD paused BOOLEAN DEFAULT false NOT NULL;
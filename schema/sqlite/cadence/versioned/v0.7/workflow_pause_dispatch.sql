@@ -0,0 +1,3 @@
+-- Add the flags of the decision and activity tasks which were dropped while a workflow was paused
+ALTER TABLE executions ADD decision_dispatch_suppressed BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE activity_info_maps ADD dispatch_suppressed BOOLEAN DEFAULT false NOT NULL;
@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"time"
 
@@ -1203,6 +1204,127 @@ func (adh *adminHandlerImpl) CountDLQMessages(
 	}, err
 }
 
+// GetDomainReplicationStatus returns the replication status of the domain to each of its remote clusters,
+// aggregated across all history shards. The shards whose status could not be read are returned separately.
+func (adh *adminHandlerImpl) GetDomainReplicationStatus(
+	ctx context.Context,
+	request *types.GetDomainReplicationStatusRequest,
+) (resp *types.GetDomainReplicationStatusResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetDomainReplicationStatusScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	scope = scope.Tagged(metrics.DomainTag(request.GetDomain()))
+
+	history, err := adh.GetHistoryClient().GetDomainReplicationStatus(ctx, &types.HistoryGetDomainReplicationStatusRequest{
+		DomainUUID: domainID,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	clusters := map[string]*types.ReplicationClusterStatus{}
+	for key, shardStatus := range history.Entries {
+		status, ok := clusters[key.ClusterName]
+		if !ok {
+			status = &types.ReplicationClusterStatus{ClusterName: key.ClusterName}
+			clusters[key.ClusterName] = status
+		}
+		mergeReplicationClusterStatus(status, shardStatus)
+	}
+
+	resp = &types.GetDomainReplicationStatusResponse{
+		Domain:   request.GetDomain(),
+		Clusters: make([]*types.ReplicationClusterStatus, 0, len(clusters)),
+	}
+	for _, status := range clusters {
+		resp.Clusters = append(resp.Clusters, status)
+	}
+	sort.Slice(resp.Clusters, func(i, j int) bool {
+		return resp.Clusters[i].ClusterName < resp.Clusters[j].ClusterName
+	})
+	if len(history.FailedShards) > 0 {
+		resp.FailedShards = append([]int32(nil), history.FailedShards...)
+		sort.Slice(resp.FailedShards, func(i, j int) bool {
+			return resp.FailedShards[i] < resp.FailedShards[j]
+		})
+	}
+	return resp, nil
+}
+
+// UpdateDomainIsolation isolates the history tasks of the domain into dedicated history queue virtual queues,
+// or releases them back to the default virtual queue. The override is stored in the queue state of each shard,
+// the shards which failed to apply it are returned so that the request can be retried.
+func (adh *adminHandlerImpl) UpdateDomainIsolation(
+	ctx context.Context,
+	request *types.UpdateDomainIsolationRequest,
+) (resp *types.UpdateDomainIsolationResponse, err error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &err) }()
+
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUpdateDomainIsolationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	scope = scope.Tagged(metrics.DomainTag(request.GetDomain()))
+
+	history, err := adh.GetHistoryClient().UpdateDomainIsolation(ctx, &types.HistoryUpdateDomainIsolationRequest{
+		DomainUUID: domainID,
+		Isolated:   request.GetIsolated(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp = &types.UpdateDomainIsolationResponse{}
+	if len(history.GetFailedShards()) > 0 {
+		resp.FailedShards = append([]int32(nil), history.GetFailedShards()...)
+		sort.Slice(resp.FailedShards, func(i, j int) bool {
+			return resp.FailedShards[i] < resp.FailedShards[j]
+		})
+	}
+	return resp, nil
+}
+
+// mergeReplicationClusterStatus adds the replication status of a single shard into the status of the cluster.
+// The last replicated task of the cluster is the oldest one across the shards, so that a single lagging shard
+// is not hidden behind the shards which are up to date.
+func mergeReplicationClusterStatus(status *types.ReplicationClusterStatus, shardStatus *types.ReplicationClusterStatus) {
+	if shardStatus == nil {
+		return
+	}
+	status.PendingTaskCount += shardStatus.PendingTaskCount
+	status.DLQMessageCount += shardStatus.DLQMessageCount
+	status.HasMorePendingTasks = status.HasMorePendingTasks || shardStatus.HasMorePendingTasks
+	if shardStatus.EstimatedLag > status.EstimatedLag {
+		status.EstimatedLag = shardStatus.EstimatedLag
+	}
+	if shardStatus.LastReplicatedTimestamp != nil &&
+		(status.LastReplicatedTimestamp == nil || *shardStatus.LastReplicatedTimestamp < *status.LastReplicatedTimestamp) {
+		status.LastReplicatedTaskID = shardStatus.LastReplicatedTaskID
+		status.LastReplicatedTimestamp = shardStatus.LastReplicatedTimestamp
+	}
+}
+
 // MergeDLQMessages merges DLQ messages
 func (adh *adminHandlerImpl) MergeDLQMessages(
 	ctx context.Context,
@@ -2150,6 +2150,263 @@ func Test_CountDQLMessages(t *testing.T) {
 	}
 }
 
+func Test_GetDomainReplicationStatus(t *testing.T) {
+	lastReplicatedTimestamp := time.Unix(1000, 0).UnixNano()
+	olderReplicatedTimestamp := time.Unix(900, 0).UnixNano()
+	laggingReplicatedTimestamp := time.Unix(100, 0).UnixNano()
+
+	tests := map[string]struct {
+		input         *types.GetDomainReplicationStatusRequest
+		dcHandlerFunc func(mock *cache.MockDomainCache)
+		hcHandlerFunc func(mock *history.MockClient)
+		want          *types.GetDomainReplicationStatusResponse
+		wantErr       bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"domain not set": {
+			input:   &types.GetDomainReplicationStatusRequest{},
+			wantErr: true,
+		},
+		"domain cache error": {
+			input: &types.GetDomainReplicationStatusRequest{Domain: "test-domain"},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("", &types.EntityNotExistsError{})
+			},
+			wantErr: true,
+		},
+		"history client error": {
+			input: &types.GetDomainReplicationStatusRequest{Domain: "test-domain"},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().GetDomainReplicationStatus(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		"normal request": {
+			input: &types.GetDomainReplicationStatusRequest{Domain: "test-domain"},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().GetDomainReplicationStatus(gomock.Any(), &types.HistoryGetDomainReplicationStatusRequest{
+					DomainUUID: "test-domain-id",
+				}).Return(&types.HistoryGetDomainReplicationStatusResponse{
+					Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{
+						{ShardID: 1, ClusterName: "cluster-b"}: {
+							ClusterName:             "cluster-b",
+							LastReplicatedTaskID:    10,
+							LastReplicatedTimestamp: &olderReplicatedTimestamp,
+							PendingTaskCount:        2,
+							DLQMessageCount:         1,
+							EstimatedLag:            time.Minute,
+						},
+						{ShardID: 2, ClusterName: "cluster-b"}: {
+							ClusterName:             "cluster-b",
+							LastReplicatedTaskID:    20,
+							LastReplicatedTimestamp: &lastReplicatedTimestamp,
+							PendingTaskCount:        3,
+							HasMorePendingTasks:     true,
+							EstimatedLag:            time.Second,
+						},
+						{ShardID: 1, ClusterName: "cluster-a"}: {
+							ClusterName: "cluster-a",
+						},
+					},
+					FailedShards: []int32{4, 3},
+				}, nil)
+			},
+			want: &types.GetDomainReplicationStatusResponse{
+				Domain: "test-domain",
+				Clusters: []*types.ReplicationClusterStatus{
+					{
+						ClusterName: "cluster-a",
+					},
+					{
+						ClusterName:             "cluster-b",
+						LastReplicatedTaskID:    10,
+						LastReplicatedTimestamp: &olderReplicatedTimestamp,
+						PendingTaskCount:        5,
+						HasMorePendingTasks:     true,
+						DLQMessageCount:         1,
+						EstimatedLag:            time.Minute,
+					},
+				},
+				FailedShards: []int32{3, 4},
+			},
+		},
+		"one lagging shard": {
+			input: &types.GetDomainReplicationStatusRequest{Domain: "test-domain"},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().GetDomainReplicationStatus(gomock.Any(), gomock.Any()).Return(&types.HistoryGetDomainReplicationStatusResponse{
+					Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{
+						{ShardID: 1, ClusterName: "cluster-b"}: {
+							ClusterName:             "cluster-b",
+							LastReplicatedTaskID:    30,
+							LastReplicatedTimestamp: &lastReplicatedTimestamp,
+						},
+						{ShardID: 2, ClusterName: "cluster-b"}: {
+							ClusterName:             "cluster-b",
+							LastReplicatedTaskID:    5,
+							LastReplicatedTimestamp: &laggingReplicatedTimestamp,
+							PendingTaskCount:        100,
+							EstimatedLag:            time.Hour,
+						},
+						{ShardID: 3, ClusterName: "cluster-b"}: {
+							ClusterName:             "cluster-b",
+							LastReplicatedTaskID:    40,
+							LastReplicatedTimestamp: &lastReplicatedTimestamp,
+						},
+						{ShardID: 4, ClusterName: "cluster-b"}: {
+							ClusterName: "cluster-b",
+						},
+					},
+				}, nil)
+			},
+			want: &types.GetDomainReplicationStatusResponse{
+				Domain: "test-domain",
+				Clusters: []*types.ReplicationClusterStatus{
+					{
+						ClusterName:             "cluster-b",
+						LastReplicatedTaskID:    5,
+						LastReplicatedTimestamp: &laggingReplicatedTimestamp,
+						PendingTaskCount:        100,
+						EstimatedLag:            time.Hour,
+					},
+				},
+			},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			dcMock := cache.NewMockDomainCache(goMock)
+			hcMock := history.NewMockClient(goMock)
+			if td.dcHandlerFunc != nil {
+				td.dcHandlerFunc(dcMock)
+			}
+			if td.hcHandlerFunc != nil {
+				td.hcHandlerFunc(hcMock)
+			}
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					DomainCache:   dcMock,
+					HistoryClient: hcMock,
+				},
+			}
+
+			resp, err := handler.GetDomainReplicationStatus(context.Background(), td.input)
+			if td.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, td.want, resp)
+			}
+		})
+	}
+}
+
+func Test_UpdateDomainIsolation(t *testing.T) {
+	tests := map[string]struct {
+		input         *types.UpdateDomainIsolationRequest
+		dcHandlerFunc func(mock *cache.MockDomainCache)
+		hcHandlerFunc func(mock *history.MockClient)
+		want          *types.UpdateDomainIsolationResponse
+		wantErr       bool
+	}{
+		"nil request": {
+			input:   nil,
+			wantErr: true,
+		},
+		"domain not set": {
+			input:   &types.UpdateDomainIsolationRequest{},
+			wantErr: true,
+		},
+		"domain cache error": {
+			input: &types.UpdateDomainIsolationRequest{Domain: "test-domain"},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("", &types.EntityNotExistsError{})
+			},
+			wantErr: true,
+		},
+		"history client error": {
+			input: &types.UpdateDomainIsolationRequest{Domain: "test-domain", Isolated: true},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpdateDomainIsolation(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		"normal request": {
+			input: &types.UpdateDomainIsolationRequest{Domain: "test-domain", Isolated: true},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpdateDomainIsolation(gomock.Any(), &types.HistoryUpdateDomainIsolationRequest{
+					DomainUUID: "test-domain-id",
+					Isolated:   true,
+				}).Return(&types.HistoryUpdateDomainIsolationResponse{}, nil)
+			},
+			want: &types.UpdateDomainIsolationResponse{},
+		},
+		"some shards failed": {
+			input: &types.UpdateDomainIsolationRequest{Domain: "test-domain"},
+			dcHandlerFunc: func(mock *cache.MockDomainCache) {
+				mock.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+			},
+			hcHandlerFunc: func(mock *history.MockClient) {
+				mock.EXPECT().UpdateDomainIsolation(gomock.Any(), &types.HistoryUpdateDomainIsolationRequest{
+					DomainUUID: "test-domain-id",
+				}).Return(&types.HistoryUpdateDomainIsolationResponse{FailedShards: []int32{4, 3}}, nil)
+			},
+			want: &types.UpdateDomainIsolationResponse{FailedShards: []int32{3, 4}},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			goMock := gomock.NewController(t)
+			dcMock := cache.NewMockDomainCache(goMock)
+			hcMock := history.NewMockClient(goMock)
+			if td.dcHandlerFunc != nil {
+				td.dcHandlerFunc(dcMock)
+			}
+			if td.hcHandlerFunc != nil {
+				td.hcHandlerFunc(hcMock)
+			}
+			handler := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:        testlogger.New(t),
+					MetricsClient: metrics.NewNoopMetricsClient(),
+					DomainCache:   dcMock,
+					HistoryClient: hcMock,
+				},
+			}
+
+			resp, err := handler.UpdateDomainIsolation(context.Background(), td.input)
+			if td.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, td.want, resp)
+			}
+		})
+	}
+}
+
 func Test_MergeDLQMessages(t *testing.T) {
 	tests := map[string]struct {
 		input            *types.MergeDLQMessagesRequest
@@ -250,6 +250,21 @@ func (mr *MockHandlerMockRecorder) GetDomainReplicationMessages(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationMessages", reflect.TypeOf((*MockHandler)(nil).GetDomainReplicationMessages), arg0, arg1)
 }
 
+// GetDomainReplicationStatus mocks base method.
+func (m *MockHandler) GetDomainReplicationStatus(arg0 context.Context, arg1 *types.GetDomainReplicationStatusRequest) (*types.GetDomainReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetDomainReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainReplicationStatus indicates an expected call of GetDomainReplicationStatus.
+func (mr *MockHandlerMockRecorder) GetDomainReplicationStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationStatus", reflect.TypeOf((*MockHandler)(nil).GetDomainReplicationStatus), arg0, arg1)
+}
+
 // GetDynamicConfig mocks base method.
 func (m *MockHandler) GetDynamicConfig(arg0 context.Context, arg1 *types.GetDynamicConfigRequest) (*types.GetDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -566,6 +581,21 @@ func (mr *MockHandlerMockRecorder) UpdateDomainAsyncWorkflowConfiguraton(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainAsyncWorkflowConfiguraton", reflect.TypeOf((*MockHandler)(nil).UpdateDomainAsyncWorkflowConfiguraton), arg0, arg1)
 }
 
+// UpdateDomainIsolation mocks base method.
+func (m *MockHandler) UpdateDomainIsolation(arg0 context.Context, arg1 *types.UpdateDomainIsolationRequest) (*types.UpdateDomainIsolationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDomainIsolation", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateDomainIsolationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDomainIsolation indicates an expected call of UpdateDomainIsolation.
+func (mr *MockHandlerMockRecorder) UpdateDomainIsolation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainIsolation", reflect.TypeOf((*MockHandler)(nil).UpdateDomainIsolation), arg0, arg1)
+}
+
 // UpdateDomainIsolationGroups mocks base method.
 func (m *MockHandler) UpdateDomainIsolationGroups(ctx context.Context, request *types.UpdateDomainIsolationGroupsRequest) (*types.UpdateDomainIsolationGroupsResponse, error) {
 	m.ctrl.T.Helper()
@@ -2501,6 +2501,316 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseActivity stops dispatching new attempts of a pending activity, an attempt which
+// is already running is not affected.
+func (wh *WorkflowHandler) PauseActivity(
+	ctx context.Context,
+	pauseRequest *types.PauseActivityRequest,
+) (resp *types.PauseActivityResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if pauseRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := pauseRequest.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(pauseRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if pauseRequest.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendPauseActivityScope, pauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if !common.IsValidIDLength(
+		pauseRequest.GetIdentity(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.IdentityMaxLength(domainName),
+		metrics.CadenceErrIdentityExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeIdentity) {
+		return nil, validate.ErrIdentityTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().PauseActivity(ctx, &types.HistoryPauseActivityRequest{
+		DomainUUID: domainID,
+		Request:    pauseRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// UnpauseActivity resumes dispatching a paused activity, optionally resetting its attempts.
+func (wh *WorkflowHandler) UnpauseActivity(
+	ctx context.Context,
+	unpauseRequest *types.UnpauseActivityRequest,
+) (resp *types.UnpauseActivityResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if unpauseRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := unpauseRequest.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(unpauseRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if unpauseRequest.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendUnpauseActivityScope, unpauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if !common.IsValidIDLength(
+		unpauseRequest.GetIdentity(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.IdentityMaxLength(domainName),
+		metrics.CadenceErrIdentityExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeIdentity) {
+		return nil, validate.ErrIdentityTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().UnpauseActivity(ctx, &types.HistoryUnpauseActivityRequest{
+		DomainUUID: domainID,
+		Request:    unpauseRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// ResetActivity resets the attempt count and retry backoff of a pending activity.
+func (wh *WorkflowHandler) ResetActivity(
+	ctx context.Context,
+	resetRequest *types.ResetActivityRequest,
+) (resp *types.ResetActivityResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if resetRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := resetRequest.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(resetRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if resetRequest.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendResetActivityScope, resetRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if !common.IsValidIDLength(
+		resetRequest.GetIdentity(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.IdentityMaxLength(domainName),
+		metrics.CadenceErrIdentityExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeIdentity) {
+		return nil, validate.ErrIdentityTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().ResetActivity(ctx, &types.HistoryResetActivityRequest{
+		DomainUUID: domainID,
+		Request:    resetRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// UpdateActivityOptions changes the timeouts, retry policy or task list of a pending activity
+func (wh *WorkflowHandler) UpdateActivityOptions(
+	ctx context.Context,
+	updateRequest *types.UpdateActivityOptionsRequest,
+) (resp *types.UpdateActivityOptionsResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if updateRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := updateRequest.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(updateRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+	if updateRequest.GetActivityID() == "" {
+		return nil, validate.ErrActivityIDNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendUpdateActivityOptionsScope, updateRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if err := wh.validateActivityOptions(updateRequest.GetOptions(), scope, domainName); err != nil {
+		return nil, err
+	}
+	if !common.IsValidIDLength(
+		updateRequest.GetIdentity(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.IdentityMaxLength(domainName),
+		metrics.CadenceErrIdentityExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeIdentity) {
+		return nil, validate.ErrIdentityTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().UpdateActivityOptions(ctx, &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: domainID,
+		Request:    updateRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// PauseWorkflowExecution stops dispatching new decision and activity tasks of a workflow until it is resumed
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.PauseWorkflowExecutionRequest,
+) (resp *types.PauseWorkflowExecutionResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if pauseRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := pauseRequest.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(pauseRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pauseRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if !common.IsValidIDLength(
+		pauseRequest.GetIdentity(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.IdentityMaxLength(domainName),
+		metrics.CadenceErrIdentityExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeIdentity) {
+		return nil, validate.ErrIdentityTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().PauseWorkflowExecution(ctx, &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Request:    pauseRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
+// ResumeWorkflowExecution dispatches the pending decision and activity tasks of a paused workflow
+func (wh *WorkflowHandler) ResumeWorkflowExecution(
+	ctx context.Context,
+	resumeRequest *types.ResumeWorkflowExecutionRequest,
+) (resp *types.ResumeWorkflowExecutionResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if resumeRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := resumeRequest.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(resumeRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendResumeWorkflowExecutionScope, resumeRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if !common.IsValidIDLength(
+		resumeRequest.GetIdentity(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.IdentityMaxLength(domainName),
+		metrics.CadenceErrIdentityExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeIdentity) {
+		return nil, validate.ErrIdentityTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = wh.GetHistoryClient().ResumeWorkflowExecution(ctx, &types.HistoryResumeWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Request:    resumeRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+	return resp, nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -2920,6 +3230,31 @@ func (wh *WorkflowHandler) validateTransientDecisionEvents(
 		decision.StartedEvent.ID)
 }
 
+func (wh *WorkflowHandler) validateActivityOptions(options *types.ActivityOptions, scope metrics.Scope, domain string) error {
+	if options == nil {
+		return validate.ErrActivityOptionsNotSet
+	}
+	if options.TaskList != nil {
+		if err := wh.validateTaskList(options.TaskList, scope, domain); err != nil {
+			return err
+		}
+	}
+	for _, timeout := range []*int32{
+		options.ScheduleToCloseTimeoutSeconds,
+		options.ScheduleToStartTimeoutSeconds,
+		options.StartToCloseTimeoutSeconds,
+	} {
+		if timeout != nil && *timeout <= 0 {
+			return validate.ErrInvalidActivityTimeoutSeconds
+		}
+	}
+	// a zero heartbeat timeout turns heartbeat timeouts off
+	if options.HeartbeatTimeoutSeconds != nil && options.GetHeartbeatTimeoutSeconds() < 0 {
+		return validate.ErrInvalidActivityTimeoutSeconds
+	}
+	return common.ValidateRetryPolicy(options.RetryPolicy)
+}
+
 func (wh *WorkflowHandler) validateTaskList(t *types.TaskList, scope metrics.Scope, domain string) error {
 	if t == nil || t.GetName() == "" {
 		return validate.ErrTaskListNotSet
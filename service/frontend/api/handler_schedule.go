@@ -46,6 +46,12 @@ const (
 	schedulerWorkflowDecisionTimeout  = 10 * time.Second
 	defaultListSchedulesPageSize      = 10
 
+	// defaultScheduleMatchingTimes and maxScheduleMatchingTimes bound the
+	// number of fire times ListScheduleMatchingTimes returns. TotalCount is
+	// reported for the whole range regardless.
+	defaultScheduleMatchingTimes = 100
+	maxScheduleMatchingTimes     = 1000
+
 	// describeScheduleCANRetryAttempts bounds the DWE probe in describeSchedulerExecution,
 	// which retries while CloseStatus=CONTINUED_AS_NEW to ride out the executionCache
 	// invalidation window. describeScheduleQueryRetryAttempts bounds the query retry in
@@ -73,6 +79,24 @@ func validateSchedulePolicies(policies *types.SchedulePolicies) error {
 				"caught-up fires would be immediately skipped due to overlap with the previous run.",
 		}
 	}
+	if policies.RemainingActions < 0 {
+		return &types.BadRequestError{Message: "RemainingActions must not be negative."}
+	}
+	if policies.LimitedActions && policies.RemainingActions == 0 {
+		return &types.BadRequestError{Message: "RemainingActions must be positive when LimitedActions is set."}
+	}
+	if !policies.LimitedActions && policies.RemainingActions > 0 {
+		return &types.BadRequestError{Message: "RemainingActions requires LimitedActions to be set."}
+	}
+	if policies.PauseOnFailureThreshold < 0 {
+		return &types.BadRequestError{Message: "PauseOnFailureThreshold must not be negative."}
+	}
+	if policies.PauseOnFailureCooldown < 0 {
+		return &types.BadRequestError{Message: "PauseOnFailureCooldown must not be negative."}
+	}
+	if !policies.PauseOnFailure && (policies.PauseOnFailureThreshold > 0 || policies.PauseOnFailureCooldown > 0) {
+		return &types.BadRequestError{Message: "PauseOnFailureThreshold and PauseOnFailureCooldown require PauseOnFailure to be set."}
+	}
 	return nil
 }
 
@@ -95,6 +119,85 @@ func validateScheduleSpecTimeRange(spec *types.ScheduleSpec) error {
 	return nil
 }
 
+// hasScheduleSpecSource reports whether spec sets at least one fire-time
+// source. A spec with only StartTime/EndTime/Jitter can never fire.
+func hasScheduleSpecSource(spec *types.ScheduleSpec) bool {
+	return spec.GetCronExpression() != "" || len(spec.GetCalendars()) > 0 || len(spec.GetIntervals()) > 0
+}
+
+// validateScheduleSpec compiles the spec with the same parser the scheduler
+// workflow uses, so calendar, interval and timezone errors are reported at
+// the API rather than surfacing later as a failed or ignored update.
+func validateScheduleSpec(spec *types.ScheduleSpec) error {
+	if spec == nil {
+		return nil
+	}
+	if _, err := scheduler.ParseScheduleSpec(*spec); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("invalid schedule spec: %v", err)}
+	}
+	return nil
+}
+
+// validateScheduleAction checks that exactly one action is set and that it
+// carries the fields the scheduler workflow needs to perform it.
+func validateScheduleAction(action *types.ScheduleAction) error {
+	set := 0
+	for _, present := range []bool{
+		action.GetStartWorkflow() != nil,
+		action.GetSignalWorkflow() != nil,
+		action.GetBatchOperation() != nil,
+	} {
+		if present {
+			set++
+		}
+	}
+	if set == 0 {
+		return &types.BadRequestError{Message: "Action must set one of StartWorkflow, SignalWorkflow or BatchOperation."}
+	}
+	if set > 1 {
+		return &types.BadRequestError{Message: "Action must set only one of StartWorkflow, SignalWorkflow or BatchOperation."}
+	}
+
+	if sw := action.GetStartWorkflow(); sw != nil {
+		return common.ValidateRetryPolicy(sw.GetRetryPolicy())
+	}
+	if sig := action.GetSignalWorkflow(); sig != nil {
+		if sig.GetWorkflowID() == "" {
+			return &types.BadRequestError{Message: "Action.SignalWorkflow.WorkflowID is not set."}
+		}
+		if sig.GetSignalName() == "" {
+			return &types.BadRequestError{Message: "Action.SignalWorkflow.SignalName is not set."}
+		}
+		if start := sig.GetStartWorkflow(); start != nil {
+			if start.GetWorkflowType().GetName() == "" {
+				return &types.BadRequestError{Message: "Action.SignalWorkflow.StartWorkflow.WorkflowType is not set."}
+			}
+			if start.GetTaskList().GetName() == "" {
+				return &types.BadRequestError{Message: "Action.SignalWorkflow.StartWorkflow.TaskList is not set."}
+			}
+			return common.ValidateRetryPolicy(start.GetRetryPolicy())
+		}
+		return nil
+	}
+	batch := action.GetBatchOperation()
+	if batch.GetQuery() == "" {
+		return &types.BadRequestError{Message: "Action.BatchOperation.Query is not set."}
+	}
+	switch batch.GetOperationType() {
+	case types.ScheduleBatchOperationTypeTerminate, types.ScheduleBatchOperationTypeCancel:
+	case types.ScheduleBatchOperationTypeSignal:
+		if batch.GetSignalName() == "" {
+			return &types.BadRequestError{Message: "Action.BatchOperation.SignalName is required for SIGNAL operations."}
+		}
+	default:
+		return &types.BadRequestError{Message: fmt.Sprintf("Action.BatchOperation.OperationType %v is not supported.", batch.GetOperationType())}
+	}
+	if batch.GetRPS() < 0 || batch.GetConcurrency() < 0 {
+		return &types.BadRequestError{Message: "Action.BatchOperation RPS and Concurrency must not be negative."}
+	}
+	return nil
+}
+
 // warnIfBufferLimitExceedsSystemLimit logs a warning when buffer_limit exceeds
 // MaxBufferedFiresSystemLimit. The value is accepted (the policy still queues
 // up to the system limit), but drops at that cap will be tagged
@@ -148,6 +251,21 @@ func ongoingBackfillsForResponse(in []types.BackfillInfo) []*types.BackfillInfo
 	return out
 }
 
+// recentActionsForResponse converts the scheduler workflow's recent action
+// history into the pointer slice carried in DescribeScheduleResponse. Returns
+// nil when there is no history so the marshalled response omits the field.
+func recentActionsForResponse(in []types.ScheduleActionResult) []*types.ScheduleActionResult {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*types.ScheduleActionResult, 0, len(in))
+	for i := range in {
+		action := in[i]
+		out = append(out, &action)
+	}
+	return out
+}
+
 func (wh *WorkflowHandler) CreateSchedule(
 	ctx context.Context,
 	request *types.CreateScheduleRequest,
@@ -170,19 +288,24 @@ func (wh *WorkflowHandler) CreateSchedule(
 	if request.GetSpec() == nil {
 		return nil, &types.BadRequestError{Message: "Spec is not set on request."}
 	}
-	if request.GetSpec().GetCronExpression() == "" {
-		return nil, &types.BadRequestError{Message: "CronExpression is not set on request."}
+	if !hasScheduleSpecSource(request.GetSpec()) {
+		return nil, &types.BadRequestError{Message: "One of CronExpression, Calendars or Intervals must be set on request."}
 	}
-	if _, err := backoff.ValidateSchedule(request.GetSpec().GetCronExpression()); err != nil {
+	if cronExpr := request.GetSpec().GetCronExpression(); cronExpr != "" {
+		if _, err := backoff.ValidateSchedule(cronExpr); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateScheduleSpec(request.GetSpec()); err != nil {
 		return nil, err
 	}
 	if err := validateScheduleSpecTimeRange(request.GetSpec()); err != nil {
 		return nil, err
 	}
-	if request.GetAction() == nil || request.GetAction().GetStartWorkflow() == nil {
-		return nil, &types.BadRequestError{Message: "Action.StartWorkflow is not set on request."}
+	if request.GetAction() == nil {
+		return nil, &types.BadRequestError{Message: "Action is not set on request."}
 	}
-	if err := common.ValidateRetryPolicy(request.GetAction().GetStartWorkflow().GetRetryPolicy()); err != nil {
+	if err := validateScheduleAction(request.GetAction()); err != nil {
 		return nil, err
 	}
 	if err := validateSchedulePolicies(request.GetPolicies()); err != nil {
@@ -291,7 +414,10 @@ func (wh *WorkflowHandler) DescribeSchedule(
 	if err != nil {
 		return nil, err
 	}
-	if info.CloseStatus != nil {
+	// A COMPLETED scheduler is either deleted or ran out of actions; only the
+	// describe query can tell them apart, so let it through.
+	closedCleanly := info.CloseStatus != nil && *info.CloseStatus == types.WorkflowExecutionCloseStatusCompleted
+	if info.CloseStatus != nil && !closedCleanly {
 		if *info.CloseStatus == types.WorkflowExecutionCloseStatusContinuedAsNew {
 			return nil, yarpcerrors.Newf(yarpcerrors.CodeUnavailable,
 				"schedule %q in domain %q: scheduler mid-ContinueAsNew, retry", scheduleID, domainName)
@@ -338,6 +464,14 @@ func (wh *WorkflowHandler) DescribeSchedule(
 	if err := json.Unmarshal(queryResp.GetQueryResult(), &desc); err != nil {
 		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to deserialize scheduler describe response: %v", err)}
 	}
+	if closedCleanly && !desc.Completed {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf(
+				"schedule %q in domain %q is not operational: scheduler workflow ended with status %s",
+				scheduleID, domainName, info.CloseStatus.String(),
+			),
+		}
+	}
 
 	return &types.DescribeScheduleResponse{
 		Spec:     &desc.Spec,
@@ -350,11 +484,13 @@ func (wh *WorkflowHandler) DescribeSchedule(
 					return nil
 				}
 				return &types.SchedulePauseInfo{
-					Reason:   desc.PauseReason,
-					PausedBy: desc.PausedBy,
-					PausedAt: desc.PausedAt,
+					Reason:          desc.PauseReason,
+					PausedBy:        desc.PausedBy,
+					PausedAt:        desc.PausedAt,
+					AutoUnpauseTime: desc.AutoUnpauseAt,
 				}
 			}(),
+			Completed: desc.Completed,
 		},
 		Info: &types.ScheduleInfo{
 			LastRunTime:          desc.LastRunTime,
@@ -367,6 +503,9 @@ func (wh *WorkflowHandler) DescribeSchedule(
 			CreateTime:           desc.CreateTime,
 			LastUpdateTime:       desc.LastUpdateTime,
 			OngoingBackfills:     ongoingBackfillsForResponse(desc.OngoingBackfills),
+			RecentActions:        recentActionsForResponse(desc.RecentActions),
+			ConsecutiveFailures:  desc.ConsecutiveFailures,
+			LastFailure:          desc.LastFailure,
 		},
 		Memo:             desc.Memo,
 		SearchAttributes: desc.SearchAttributes,
@@ -404,11 +543,19 @@ func (wh *WorkflowHandler) UpdateSchedule(
 			return nil, err
 		}
 	}
+	if spec := request.GetSpec(); spec != nil {
+		if !hasScheduleSpecSource(spec) {
+			return nil, &types.BadRequestError{Message: "Spec must set one of CronExpression, Calendars or Intervals."}
+		}
+		if err := validateScheduleSpec(spec); err != nil {
+			return nil, err
+		}
+	}
 	if err := validateScheduleSpecTimeRange(request.GetSpec()); err != nil {
 		return nil, err
 	}
-	if action := request.GetAction(); action != nil && action.GetStartWorkflow() != nil {
-		if err := common.ValidateRetryPolicy(action.GetStartWorkflow().GetRetryPolicy()); err != nil {
+	if action := request.GetAction(); action != nil {
+		if err := validateScheduleAction(action); err != nil {
 			return nil, err
 		}
 	}
@@ -614,6 +761,112 @@ func (wh *WorkflowHandler) BackfillSchedule(
 	return &types.BackfillScheduleResponse{}, nil
 }
 
+func (wh *WorkflowHandler) TriggerSchedule(
+	ctx context.Context,
+	request *types.TriggerScheduleRequest,
+) (*types.TriggerScheduleResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	scheduleID := request.GetScheduleID()
+	if scheduleID == "" {
+		return nil, &types.BadRequestError{Message: "ScheduleID is not set on request."}
+	}
+
+	signal := scheduler.TriggerSignal{
+		OverlapPolicy: request.GetOverlapPolicy(),
+		TriggeredBy:   request.GetIdentity(),
+	}
+
+	if err := wh.signalScheduleWorkflow(ctx, domainName, scheduleID, scheduler.SignalNameTrigger, signal); err != nil {
+		return nil, err
+	}
+	return &types.TriggerScheduleResponse{}, nil
+}
+
+// ListScheduleMatchingTimes returns the times a schedule would fire within
+// the requested range without signalling the scheduler workflow. Times are
+// computed with the scheduler's own next-fire logic so they match what a
+// BackfillSchedule over the same range would fire.
+func (wh *WorkflowHandler) ListScheduleMatchingTimes(
+	ctx context.Context,
+	request *types.ListScheduleMatchingTimesRequest,
+) (*types.ListScheduleMatchingTimesResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := request.GetDomain()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if request.GetStartTime().IsZero() {
+		return nil, &types.BadRequestError{Message: "StartTime is not set on request."}
+	}
+	if request.GetEndTime().IsZero() {
+		return nil, &types.BadRequestError{Message: "EndTime is not set on request."}
+	}
+	if !request.GetEndTime().After(request.GetStartTime()) {
+		return nil, &types.BadRequestError{Message: "EndTime must be after StartTime."}
+	}
+	if request.GetMaximumTimes() < 0 {
+		return nil, &types.BadRequestError{Message: "MaximumTimes must not be negative."}
+	}
+
+	spec := request.GetSpec()
+	if spec == nil {
+		scheduleID := request.GetScheduleID()
+		if scheduleID == "" {
+			return nil, &types.BadRequestError{Message: "One of ScheduleID or Spec must be set on request."}
+		}
+		resp, err := wh.DescribeSchedule(ctx, &types.DescribeScheduleRequest{
+			Domain:     domainName,
+			ScheduleID: scheduleID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		spec = resp.GetSpec()
+	} else {
+		if !hasScheduleSpecSource(spec) {
+			return nil, &types.BadRequestError{Message: "Spec must set at least one of CronExpression, Calendars or Intervals."}
+		}
+		if err := validateScheduleSpecTimeRange(spec); err != nil {
+			return nil, err
+		}
+	}
+	if spec == nil {
+		return nil, &types.InternalServiceError{Message: "schedule has no spec"}
+	}
+
+	maxTimes := int(request.GetMaximumTimes())
+	if maxTimes == 0 {
+		maxTimes = defaultScheduleMatchingTimes
+	}
+	maxTimes = min(maxTimes, maxScheduleMatchingTimes)
+
+	matching, err := scheduler.ListMatchingTimes(*spec, request.GetStartTime(), request.GetEndTime(), maxTimes)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("invalid schedule spec: %v", err)}
+	}
+	return &types.ListScheduleMatchingTimesResponse{
+		StartTimes:       matching.Times,
+		TotalCount:       int32(matching.TotalCount),
+		TotalCountCapped: matching.TotalCountCapped,
+	}, nil
+}
+
 func resolveBackfillID(clientID string) string {
 	if id := strings.TrimSpace(clientID); id != "" {
 		return id
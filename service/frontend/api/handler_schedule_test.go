@@ -171,6 +171,18 @@ func TestCreateSchedule(t *testing.T) {
 			mockFn:  func(f *scheduleTestFixture) {},
 			wantErr: true,
 		},
+		"invalid spec timezone": {
+			request: &types.CreateScheduleRequest{
+				Domain:     testDomain,
+				ScheduleID: "s1",
+				Spec: &types.ScheduleSpec{
+					Intervals: []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute}},
+					Timezone:  "Not/AZone",
+				},
+			},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
 		"spec endTime not after startTime": {
 			request: &types.CreateScheduleRequest{
 				Domain:     testDomain,
@@ -439,6 +451,25 @@ func TestDescribeSchedule(t *testing.T) {
 		},
 	}
 	descBytes, _ := json.Marshal(descResult)
+	completedDesc := descResult
+	completedDesc.Paused = false
+	completedDesc.Policies = types.SchedulePolicies{LimitedActions: true}
+	completedDesc.Completed = true
+	completedDescBytes, _ := json.Marshal(completedDesc)
+	autoUnpauseAt := time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC)
+	failedDesc := descResult
+	failedDesc.Policies = types.SchedulePolicies{PauseOnFailure: true, PauseOnFailureThreshold: 2, PauseOnFailureCooldown: time.Hour}
+	failedDesc.PauseReason = "paused after 2 consecutive failed runs"
+	failedDesc.PausedBy = "cadence-scheduler"
+	failedDesc.AutoUnpauseAt = autoUnpauseAt
+	failedDesc.ConsecutiveFailures = 2
+	failedDesc.LastFailure = &types.ScheduleFailureInfo{
+		WorkflowExecution:   &types.WorkflowExecution{WorkflowID: "wf", RunID: "run-2"},
+		CloseStatus:         types.WorkflowExecutionCloseStatusTimedOut,
+		FailureReason:       "timeout: START_TO_CLOSE",
+		ConsecutiveFailures: 2,
+	}
+	failedDescBytes, _ := json.Marshal(failedDesc)
 
 	validRequest := &types.DescribeScheduleRequest{
 		Domain:     testDomain,
@@ -766,6 +797,79 @@ func TestDescribeSchedule(t *testing.T) {
 				assert.Contains(t, yarpcerrors.FromError(err).Message(), "mid-ContinueAsNew")
 			},
 		},
+		// A scheduler that ran out of actions closes COMPLETED; the describe
+		// query against the closed run still reports its final state.
+		"scheduler completed - out of actions": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				closeStatus := types.WorkflowExecutionCloseStatusCompleted
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: &closeStatus},
+					}, nil)
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(&types.HistoryQueryWorkflowResponse{
+						Response: &types.QueryWorkflowResponse{QueryResult: completedDescBytes},
+					}, nil)
+			},
+			wantErr: false,
+			check: func(t *testing.T, resp *types.DescribeScheduleResponse) {
+				assert.True(t, resp.State.Completed)
+				assert.False(t, resp.State.Paused)
+				assert.True(t, resp.Policies.LimitedActions)
+				assert.Zero(t, resp.Policies.RemainingActions)
+			},
+		},
+		"paused on failure - failure info surfaced": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
+					}, nil)
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(&types.HistoryQueryWorkflowResponse{
+						Response: &types.QueryWorkflowResponse{QueryResult: failedDescBytes},
+					}, nil)
+			},
+			wantErr: false,
+			check: func(t *testing.T, resp *types.DescribeScheduleResponse) {
+				require.True(t, resp.State.Paused)
+				assert.Equal(t, "cadence-scheduler", resp.State.PauseInfo.PausedBy)
+				assert.Equal(t, autoUnpauseAt, resp.State.PauseInfo.AutoUnpauseTime)
+				assert.Equal(t, int32(2), resp.Policies.PauseOnFailureThreshold)
+				assert.Equal(t, time.Hour, resp.Policies.PauseOnFailureCooldown)
+				assert.Equal(t, int32(2), resp.Info.ConsecutiveFailures)
+				require.NotNil(t, resp.Info.LastFailure)
+				assert.Equal(t, "run-2", resp.Info.LastFailure.WorkflowExecution.RunID)
+				assert.Equal(t, types.WorkflowExecutionCloseStatusTimedOut, resp.Info.LastFailure.CloseStatus)
+				assert.Equal(t, "timeout: START_TO_CLOSE", resp.Info.LastFailure.FailureReason)
+			},
+		},
+		// A deleted scheduler also closes COMPLETED but is not marked completed.
+		"scheduler deleted - completed without completed state": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				closeStatus := types.WorkflowExecutionCloseStatusCompleted
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: &closeStatus},
+					}, nil)
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(&types.HistoryQueryWorkflowResponse{
+						Response: &types.QueryWorkflowResponse{QueryResult: descBytes},
+					}, nil)
+			},
+			wantErr: true,
+			checkErr: func(t *testing.T, err error) {
+				var internalErr *types.InternalServiceError
+				assert.ErrorAs(t, err, &internalErr)
+				assert.Contains(t, internalErr.Message, "COMPLETED")
+			},
+		},
 		"success": {
 			request: validRequest,
 			mockFn: func(f *scheduleTestFixture) {
@@ -1604,6 +1708,38 @@ func TestValidateSchedulePolicies(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"valid limited actions": {
+			policies: &types.SchedulePolicies{LimitedActions: true, RemainingActions: 10},
+			wantErr:  false,
+		},
+		"invalid limited actions with none remaining": {
+			policies: &types.SchedulePolicies{LimitedActions: true},
+			wantErr:  true,
+		},
+		"invalid negative remaining actions": {
+			policies: &types.SchedulePolicies{LimitedActions: true, RemainingActions: -1},
+			wantErr:  true,
+		},
+		"invalid remaining actions without limited actions": {
+			policies: &types.SchedulePolicies{RemainingActions: 3},
+			wantErr:  true,
+		},
+		"valid pause on failure threshold and cooldown": {
+			policies: &types.SchedulePolicies{PauseOnFailure: true, PauseOnFailureThreshold: 3, PauseOnFailureCooldown: time.Hour},
+			wantErr:  false,
+		},
+		"invalid negative pause on failure threshold": {
+			policies: &types.SchedulePolicies{PauseOnFailure: true, PauseOnFailureThreshold: -1},
+			wantErr:  true,
+		},
+		"invalid negative pause on failure cooldown": {
+			policies: &types.SchedulePolicies{PauseOnFailure: true, PauseOnFailureCooldown: -time.Minute},
+			wantErr:  true,
+		},
+		"invalid pause on failure threshold without pause on failure": {
+			policies: &types.SchedulePolicies{PauseOnFailureThreshold: 2},
+			wantErr:  true,
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -1719,6 +1855,55 @@ func TestValidateScheduleSpecTimeRange(t *testing.T) {
 	}
 }
 
+func TestValidateScheduleSpec(t *testing.T) {
+	tests := map[string]struct {
+		spec      *types.ScheduleSpec
+		hasSource bool
+		wantErr   bool
+	}{
+		"nil spec": {spec: nil},
+		"cron only": {
+			spec:      &types.ScheduleSpec{CronExpression: "0 * * * *"},
+			hasSource: true,
+		},
+		"calendars and intervals with timezone": {
+			spec: &types.ScheduleSpec{
+				Calendars:        []*types.ScheduleCalendarSpec{{Hour: "9", DayOfMonth: "8-14", DayOfWeek: "TUE"}},
+				Intervals:        []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute, Phase: 15 * time.Minute}},
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{{Month: "DEC", DayOfMonth: "25"}},
+				Timezone:         "America/New_York",
+			},
+			hasSource: true,
+		},
+		"time bounds only": {
+			spec:    &types.ScheduleSpec{StartTime: time.Unix(1000, 0)},
+			wantErr: true,
+		},
+		"invalid calendar field": {
+			spec:      &types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Hour: "25"}}},
+			hasSource: true,
+			wantErr:   true,
+		},
+		"phase not below interval": {
+			spec:      &types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour, Phase: time.Hour}}},
+			hasSource: true,
+			wantErr:   true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.hasSource, hasScheduleSpecSource(tt.spec))
+			err := validateScheduleSpec(tt.spec)
+			if tt.wantErr {
+				var badReq *types.BadRequestError
+				assert.ErrorAs(t, err, &badReq)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // TestWarnIfBufferLimitExceedsSystemLimit_NilSafe verifies the helper does not
 // panic when policies or policies.BufferLimit is nil, and that it stays a no-op
 // when the overlap policy is not Buffer (BufferLimit has no effect there).
@@ -1770,3 +1955,330 @@ func TestCreateSchedule_ShuttingDown(t *testing.T) {
 	_, err := f.handler.CreateSchedule(context.Background(), &types.CreateScheduleRequest{})
 	assert.Equal(t, validate.ErrShuttingDown, err)
 }
+
+func TestTriggerSchedule(t *testing.T) {
+	tests := map[string]struct {
+		request *types.TriggerScheduleRequest
+		mockFn  func(*scheduleTestFixture)
+		wantErr bool
+	}{
+		"nil request": {
+			request: nil,
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"empty domain": {
+			request: &types.TriggerScheduleRequest{},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"empty schedule ID": {
+			request: &types.TriggerScheduleRequest{Domain: testDomain},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"signal error": {
+			request: &types.TriggerScheduleRequest{Domain: testDomain, ScheduleID: "s1"},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(errors.New("signal failed"))
+			},
+			wantErr: true,
+		},
+		"success": {
+			request: &types.TriggerScheduleRequest{
+				Domain:        testDomain,
+				ScheduleID:    "s1",
+				OverlapPolicy: types.ScheduleOverlapPolicyConcurrent,
+				Identity:      "admin",
+			},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistorySignalWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+						assert.Equal(t, "cadence-scheduler:s1", req.SignalRequest.WorkflowExecution.WorkflowID)
+						assert.Equal(t, scheduler.SignalNameTrigger, req.SignalRequest.SignalName)
+
+						var signal scheduler.TriggerSignal
+						require.NoError(t, json.Unmarshal(req.SignalRequest.Input, &signal))
+						assert.Equal(t, types.ScheduleOverlapPolicyConcurrent, signal.OverlapPolicy)
+						assert.Equal(t, "admin", signal.TriggeredBy)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newScheduleTestFixture(t)
+			defer f.finish()
+			tt.mockFn(f)
+
+			resp, err := f.handler.TriggerSchedule(context.Background(), tt.request)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
+
+func TestListScheduleMatchingTimes(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+	hourly := &types.ScheduleSpec{CronExpression: "0 * * * *"}
+	descBytes, _ := json.Marshal(scheduler.ScheduleDescription{
+		ScheduleID: "s1",
+		Domain:     testDomain,
+		Spec:       types.ScheduleSpec{CronExpression: "0 */2 * * *"},
+	})
+
+	tests := map[string]struct {
+		request *types.ListScheduleMatchingTimesRequest
+		mockFn  func(*scheduleTestFixture)
+		wantErr bool
+		want    *types.ListScheduleMatchingTimesResponse
+	}{
+		"nil request": {
+			request: nil,
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"empty domain": {
+			request: &types.ListScheduleMatchingTimesRequest{Spec: hourly, StartTime: start, EndTime: end},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"missing start time": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, Spec: hourly, EndTime: end},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"end before start": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, Spec: hourly, StartTime: end, EndTime: start},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"negative maximum times": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, Spec: hourly, StartTime: start, EndTime: end, MaximumTimes: -1},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"neither schedule ID nor spec": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, StartTime: start, EndTime: end},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"spec without a fire-time source": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, Spec: &types.ScheduleSpec{}, StartTime: start, EndTime: end},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"invalid spec": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, Spec: &types.ScheduleSpec{CronExpression: "bogus"}, StartTime: start, EndTime: end},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: true,
+		},
+		"proposed spec": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, Spec: hourly, StartTime: start, EndTime: end},
+			mockFn:  func(f *scheduleTestFixture) {},
+			want: &types.ListScheduleMatchingTimesResponse{
+				StartTimes: []time.Time{start, start.Add(time.Hour), start.Add(2 * time.Hour), end},
+				TotalCount: 4,
+			},
+		},
+		"maximum times limits the returned times": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, Spec: hourly, StartTime: start, EndTime: end, MaximumTimes: 1},
+			mockFn:  func(f *scheduleTestFixture) {},
+			want: &types.ListScheduleMatchingTimesResponse{
+				StartTimes: []time.Time{start},
+				TotalCount: 4,
+			},
+		},
+		"existing schedule spec": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, ScheduleID: "s1", StartTime: start, EndTime: end},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
+					}, nil)
+				f.historyClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(&types.HistoryQueryWorkflowResponse{
+						Response: &types.QueryWorkflowResponse{QueryResult: descBytes},
+					}, nil)
+			},
+			want: &types.ListScheduleMatchingTimesResponse{
+				StartTimes: []time.Time{start, start.Add(2 * time.Hour)},
+				TotalCount: 2,
+			},
+		},
+		"existing schedule not found": {
+			request: &types.ListScheduleMatchingTimesRequest{Domain: testDomain, ScheduleID: "s1", StartTime: start, EndTime: end},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(testDomain).Return(testDomainID, nil).AnyTimes()
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{Message: "workflow not found"})
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newScheduleTestFixture(t)
+			defer f.finish()
+			tt.mockFn(f)
+
+			resp, err := f.handler.ListScheduleMatchingTimes(context.Background(), tt.request)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
+
+func TestRecentActionsForResponse(t *testing.T) {
+	t.Run("empty input returns nil", func(t *testing.T) {
+		assert.Nil(t, recentActionsForResponse(nil))
+	})
+	t.Run("non-empty input is mapped one-to-one and copies each entry", func(t *testing.T) {
+		in := []types.ScheduleActionResult{
+			{
+				ScheduledTime:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+				ActualTime:        time.Date(2026, 3, 1, 0, 0, 1, 0, time.UTC),
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf-1", RunID: "run-1"},
+				Outcome:           types.ScheduleActionOutcomeStarted,
+			},
+			{
+				ScheduledTime: time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC),
+				Outcome:       types.ScheduleActionOutcomeSkipped,
+			},
+		}
+		out := recentActionsForResponse(in)
+		require.Len(t, out, 2)
+		assert.Equal(t, in[0], *out[0])
+		assert.Equal(t, in[1], *out[1])
+
+		out[1].Outcome = types.ScheduleActionOutcomeFailed
+		assert.Equal(t, types.ScheduleActionOutcomeSkipped, in[1].Outcome, "mutating out must not affect in")
+	})
+}
+
+func TestValidateScheduleAction(t *testing.T) {
+	startWorkflow := &types.StartWorkflowAction{
+		WorkflowType: &types.WorkflowType{Name: "wf"},
+		TaskList:     &types.TaskList{Name: "tl"},
+	}
+
+	tests := map[string]struct {
+		action  *types.ScheduleAction
+		wantErr bool
+	}{
+		"no action set": {
+			action:  &types.ScheduleAction{},
+			wantErr: true,
+		},
+		"more than one action set": {
+			action: &types.ScheduleAction{
+				StartWorkflow:  startWorkflow,
+				SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "wf-1", SignalName: "sig"},
+			},
+			wantErr: true,
+		},
+		"start workflow": {
+			action: &types.ScheduleAction{StartWorkflow: startWorkflow},
+		},
+		"signal workflow": {
+			action: &types.ScheduleAction{
+				SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "wf-1", SignalName: "sig"},
+			},
+		},
+		"signal workflow without workflow ID": {
+			action: &types.ScheduleAction{
+				SignalWorkflow: &types.SignalWorkflowAction{SignalName: "sig"},
+			},
+			wantErr: true,
+		},
+		"signal workflow without signal name": {
+			action: &types.ScheduleAction{
+				SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "wf-1"},
+			},
+			wantErr: true,
+		},
+		"signal-with-start": {
+			action: &types.ScheduleAction{
+				SignalWorkflow: &types.SignalWorkflowAction{WorkflowID: "wf-1", SignalName: "sig", StartWorkflow: startWorkflow},
+			},
+		},
+		"signal-with-start without task list": {
+			action: &types.ScheduleAction{
+				SignalWorkflow: &types.SignalWorkflowAction{
+					WorkflowID:    "wf-1",
+					SignalName:    "sig",
+					StartWorkflow: &types.StartWorkflowAction{WorkflowType: &types.WorkflowType{Name: "wf"}},
+				},
+			},
+			wantErr: true,
+		},
+		"batch terminate": {
+			action: &types.ScheduleAction{
+				BatchOperation: &types.BatchOperationAction{
+					OperationType: types.ScheduleBatchOperationTypeTerminate,
+					Query:         "CloseTime = missing",
+				},
+			},
+		},
+		"batch without query": {
+			action: &types.ScheduleAction{
+				BatchOperation: &types.BatchOperationAction{OperationType: types.ScheduleBatchOperationTypeCancel},
+			},
+			wantErr: true,
+		},
+		"batch signal without signal name": {
+			action: &types.ScheduleAction{
+				BatchOperation: &types.BatchOperationAction{
+					OperationType: types.ScheduleBatchOperationTypeSignal,
+					Query:         "WorkflowType = 'wf'",
+				},
+			},
+			wantErr: true,
+		},
+		"batch with invalid operation type": {
+			action: &types.ScheduleAction{
+				BatchOperation: &types.BatchOperationAction{Query: "WorkflowType = 'wf'"},
+			},
+			wantErr: true,
+		},
+		"batch with negative rps": {
+			action: &types.ScheduleAction{
+				BatchOperation: &types.BatchOperationAction{
+					OperationType: types.ScheduleBatchOperationTypeTerminate,
+					Query:         "WorkflowType = 'wf'",
+					RPS:           -1,
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateScheduleAction(tt.action)
+			if tt.wantErr {
+				var badRequest *types.BadRequestError
+				assert.ErrorAs(t, err, &badRequest)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3600,6 +3600,339 @@ func (s *workflowHandlerSuite) TestSignalWorkflowExecution() {
 	}
 }
 
+func (s *workflowHandlerSuite) TestActivityOperations() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+
+	execution := &types.WorkflowExecution{
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	pauseRequest := &types.PauseActivityRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: execution,
+		ActivityID:        "activity-id",
+		Identity:          "identity",
+		Reason:            "reason",
+	}
+	unpauseRequest := &types.UnpauseActivityRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: execution,
+		ActivityID:        "activity-id",
+		ResetAttempts:     true,
+	}
+	resetRequest := &types.ResetActivityRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: execution,
+		ActivityID:        "activity-id",
+	}
+	updateOptionsRequest := func(options *types.ActivityOptions) *types.UpdateActivityOptionsRequest {
+		return &types.UpdateActivityOptionsRequest{
+			Domain:            s.testDomain,
+			WorkflowExecution: execution,
+			ActivityID:        "activity-id",
+			Options:           options,
+		}
+	}
+	validOptions := &types.ActivityOptions{
+		TaskList:                &types.TaskList{Name: "task-list"},
+		HeartbeatTimeoutSeconds: common.Int32Ptr(0),
+		RetryPolicy: &types.RetryPolicy{
+			InitialIntervalInSeconds: 1,
+			BackoffCoefficient:       2,
+			MaximumAttempts:          3,
+		},
+	}
+
+	testInput := map[string]struct {
+		call            func() (any, error)
+		mockFn          func()
+		expectError     bool
+		expectErrorType error
+	}{
+		"shutting down": {
+			call: func() (any, error) { return wh.PauseActivity(context.Background(), pauseRequest) },
+			mockFn: func() {
+				wh.shuttingDown = int32(1)
+			},
+			expectError:     true,
+			expectErrorType: validate.ErrShuttingDown,
+		},
+		"nil request": {
+			call:            func() (any, error) { return wh.UnpauseActivity(context.Background(), nil) },
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrRequestNotSet,
+		},
+		"empty domain": {
+			call:            func() (any, error) { return wh.ResetActivity(context.Background(), &types.ResetActivityRequest{}) },
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrDomainNotSet,
+		},
+		"empty workflow ID": {
+			call: func() (any, error) {
+				return wh.PauseActivity(context.Background(), &types.PauseActivityRequest{
+					Domain:            s.testDomain,
+					WorkflowExecution: &types.WorkflowExecution{},
+					ActivityID:        "activity-id",
+				})
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrWorkflowIDNotSet,
+		},
+		"empty activity ID": {
+			call: func() (any, error) {
+				return wh.PauseActivity(context.Background(), &types.PauseActivityRequest{
+					Domain:            s.testDomain,
+					WorkflowExecution: execution,
+				})
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrActivityIDNotSet,
+		},
+		"identity length exceeds limit": {
+			call: func() (any, error) { return wh.PauseActivity(context.Background(), pauseRequest) },
+			mockFn: func() {
+				wh.config.IdentityMaxLength = dynamicproperties.GetIntPropertyFilteredByDomain(1)
+			},
+			expectError:     true,
+			expectErrorType: validate.ErrIdentityTooLong,
+		},
+		"cannot get domain ID": {
+			call: func() (any, error) { return wh.ResetActivity(context.Background(), resetRequest) },
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return("", errors.New("error getting domain ID"))
+			},
+			expectError: true,
+		},
+		"history client returns error": {
+			call: func() (any, error) { return wh.UnpauseActivity(context.Background(), unpauseRequest) },
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().UnpauseActivity(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+			expectError: true,
+		},
+		"pause success": {
+			call: func() (any, error) { return wh.PauseActivity(context.Background(), pauseRequest) },
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().PauseActivity(gomock.Any(), &types.HistoryPauseActivityRequest{
+					DomainUUID: s.testDomainID,
+					Request:    pauseRequest,
+				}).Return(&types.PauseActivityResponse{}, nil)
+			},
+		},
+		"unpause success": {
+			call: func() (any, error) { return wh.UnpauseActivity(context.Background(), unpauseRequest) },
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().UnpauseActivity(gomock.Any(), &types.HistoryUnpauseActivityRequest{
+					DomainUUID: s.testDomainID,
+					Request:    unpauseRequest,
+				}).Return(&types.UnpauseActivityResponse{}, nil)
+			},
+		},
+		"reset success": {
+			call: func() (any, error) { return wh.ResetActivity(context.Background(), resetRequest) },
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().ResetActivity(gomock.Any(), &types.HistoryResetActivityRequest{
+					DomainUUID: s.testDomainID,
+					Request:    resetRequest,
+				}).Return(&types.ResetActivityResponse{}, nil)
+			},
+		},
+		"update options without options": {
+			call: func() (any, error) {
+				return wh.UpdateActivityOptions(context.Background(), updateOptionsRequest(nil))
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrActivityOptionsNotSet,
+		},
+		"update options with empty task list": {
+			call: func() (any, error) {
+				return wh.UpdateActivityOptions(context.Background(), updateOptionsRequest(&types.ActivityOptions{TaskList: &types.TaskList{}}))
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrTaskListNotSet,
+		},
+		"update options with invalid timeout": {
+			call: func() (any, error) {
+				return wh.UpdateActivityOptions(context.Background(), updateOptionsRequest(&types.ActivityOptions{StartToCloseTimeoutSeconds: common.Int32Ptr(0)}))
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrInvalidActivityTimeoutSeconds,
+		},
+		"update options with invalid retry policy": {
+			call: func() (any, error) {
+				return wh.UpdateActivityOptions(context.Background(), updateOptionsRequest(&types.ActivityOptions{RetryPolicy: &types.RetryPolicy{}}))
+			},
+			mockFn:      func() {},
+			expectError: true,
+		},
+		"update options success": {
+			call: func() (any, error) {
+				return wh.UpdateActivityOptions(context.Background(), updateOptionsRequest(validOptions))
+			},
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().UpdateActivityOptions(gomock.Any(), &types.HistoryUpdateActivityOptionsRequest{
+					DomainUUID: s.testDomainID,
+					Request:    updateOptionsRequest(validOptions),
+				}).Return(&types.UpdateActivityOptionsResponse{}, nil)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := input.call()
+			if input.expectError {
+				s.Error(err)
+				if input.expectErrorType != nil {
+					s.ErrorIs(err, input.expectErrorType)
+				}
+			} else {
+				s.NoError(err)
+				s.NotNil(resp)
+			}
+			wh.shuttingDown = int32(0)
+			wh.config.IdentityMaxLength = dynamicproperties.GetIntPropertyFilteredByDomain(1000)
+		})
+	}
+}
+
+func (s *workflowHandlerSuite) TestPauseResumeWorkflowExecution() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+
+	execution := &types.WorkflowExecution{
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	pauseRequest := &types.PauseWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: execution,
+		Reason:            "reason",
+		Identity:          "identity",
+	}
+	resumeRequest := &types.ResumeWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: execution,
+		Identity:          "identity",
+	}
+
+	testInput := map[string]struct {
+		call            func() (any, error)
+		mockFn          func()
+		expectError     bool
+		expectErrorType error
+	}{
+		"shutting down": {
+			call: func() (any, error) { return wh.PauseWorkflowExecution(context.Background(), pauseRequest) },
+			mockFn: func() {
+				wh.shuttingDown = int32(1)
+			},
+			expectError:     true,
+			expectErrorType: validate.ErrShuttingDown,
+		},
+		"nil request": {
+			call:            func() (any, error) { return wh.ResumeWorkflowExecution(context.Background(), nil) },
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrRequestNotSet,
+		},
+		"empty domain": {
+			call: func() (any, error) {
+				return wh.PauseWorkflowExecution(context.Background(), &types.PauseWorkflowExecutionRequest{})
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrDomainNotSet,
+		},
+		"empty workflow ID": {
+			call: func() (any, error) {
+				return wh.ResumeWorkflowExecution(context.Background(), &types.ResumeWorkflowExecutionRequest{
+					Domain:            s.testDomain,
+					WorkflowExecution: &types.WorkflowExecution{},
+				})
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrWorkflowIDNotSet,
+		},
+		"identity length exceeds limit": {
+			call: func() (any, error) { return wh.ResumeWorkflowExecution(context.Background(), resumeRequest) },
+			mockFn: func() {
+				wh.config.IdentityMaxLength = dynamicproperties.GetIntPropertyFilteredByDomain(1)
+			},
+			expectError:     true,
+			expectErrorType: validate.ErrIdentityTooLong,
+		},
+		"cannot get domain ID": {
+			call: func() (any, error) { return wh.PauseWorkflowExecution(context.Background(), pauseRequest) },
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return("", errors.New("error getting domain ID"))
+			},
+			expectError: true,
+		},
+		"history client returns error": {
+			call: func() (any, error) { return wh.ResumeWorkflowExecution(context.Background(), resumeRequest) },
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().ResumeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+			expectError: true,
+		},
+		"pause success": {
+			call: func() (any, error) { return wh.PauseWorkflowExecution(context.Background(), pauseRequest) },
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().PauseWorkflowExecution(gomock.Any(), &types.HistoryPauseWorkflowExecutionRequest{
+					DomainUUID: s.testDomainID,
+					Request:    pauseRequest,
+				}).Return(&types.PauseWorkflowExecutionResponse{}, nil)
+			},
+		},
+		"resume success": {
+			call: func() (any, error) { return wh.ResumeWorkflowExecution(context.Background(), resumeRequest) },
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().ResumeWorkflowExecution(gomock.Any(), &types.HistoryResumeWorkflowExecutionRequest{
+					DomainUUID: s.testDomainID,
+					Request:    resumeRequest,
+				}).Return(&types.ResumeWorkflowExecutionResponse{}, nil)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := input.call()
+			if input.expectError {
+				s.Error(err)
+				if input.expectErrorType != nil {
+					s.ErrorIs(err, input.expectErrorType)
+				}
+			} else {
+				s.NoError(err)
+				s.NotNil(resp)
+			}
+			wh.shuttingDown = int32(0)
+			wh.config.IdentityMaxLength = dynamicproperties.GetIntPropertyFilteredByDomain(1000)
+		})
+	}
+}
+
 func updateRequest(
 	historyArchivalURI *string,
 	historyArchivalStatus *types.ArchivalStatus,
@@ -24,7 +24,7 @@
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/versioncheck.tmpl -o ../wrappers/versioncheck/api_generated.go
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/metered.tmpl -o ../wrappers/metered/api_generated.go -v handler=API
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/ratelimited.tmpl -o ../wrappers/ratelimited/api_generated.go -v handler=API
-//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/api_generated.go -v handler=API -v package=apiv1 -v path=github.com/uber/cadence-idl/go/proto/api/v1 -v prefix=
+//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/api_generated.go -v handler=API -v package=apiv1 -v path=github.com/uber/cadence-idl/go/proto/api/v1 -v prefix= -v localPackage=frontendv1 -v localPath=github.com/uber/cadence/.gen/proto/frontend/v1 -v localPrefix=Frontend
 //go:generate gowrap gen -g -p ../../../.gen/go/cadence/workflowserviceserver -i Interface -t ../../templates/thrift.tmpl -o ../wrappers/thrift/api_generated.go -v handler=API -v prefix=
 
 package api
@@ -94,6 +94,14 @@ type (
 		PauseSchedule(context.Context, *types.PauseScheduleRequest) (*types.PauseScheduleResponse, error)
 		UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest) (*types.UnpauseScheduleResponse, error)
 		BackfillSchedule(context.Context, *types.BackfillScheduleRequest) (*types.BackfillScheduleResponse, error)
+		TriggerSchedule(context.Context, *types.TriggerScheduleRequest) (*types.TriggerScheduleResponse, error)
+		PauseActivity(context.Context, *types.PauseActivityRequest) (*types.PauseActivityResponse, error)
+		UnpauseActivity(context.Context, *types.UnpauseActivityRequest) (*types.UnpauseActivityResponse, error)
+		ResetActivity(context.Context, *types.ResetActivityRequest) (*types.ResetActivityResponse, error)
+		UpdateActivityOptions(context.Context, *types.UpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error)
+		PauseWorkflowExecution(context.Context, *types.PauseWorkflowExecutionRequest) (*types.PauseWorkflowExecutionResponse, error)
+		ResumeWorkflowExecution(context.Context, *types.ResumeWorkflowExecutionRequest) (*types.ResumeWorkflowExecutionResponse, error)
+		ListScheduleMatchingTimes(context.Context, *types.ListScheduleMatchingTimesRequest) (*types.ListScheduleMatchingTimesResponse, error)
 		ListSchedules(context.Context, *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error)
 	}
 )
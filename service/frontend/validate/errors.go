@@ -37,6 +37,8 @@ var (
 	ErrExecutionNotSet                            = &types.BadRequestError{Message: "Execution is not set on request."}
 	ErrWorkflowIDNotSet                           = &types.BadRequestError{Message: "WorkflowId is not set on request."}
 	ErrActivityIDNotSet                           = &types.BadRequestError{Message: "ActivityID is not set on request."}
+	ErrActivityOptionsNotSet                      = &types.BadRequestError{Message: "ActivityOptions is not set on request."}
+	ErrInvalidActivityTimeoutSeconds              = &types.BadRequestError{Message: "A valid activity timeout is not set on request."}
 	ErrSignalNameNotSet                           = &types.BadRequestError{Message: "SignalName is not set on request."}
 	ErrInvalidRunID                               = &types.BadRequestError{Message: "Invalid RunId."}
 	ErrInvalidNextPageToken                       = &types.BadRequestError{Message: "Invalid NextPageToken."}
@@ -261,6 +261,22 @@ func (a *adminHandler) GetDomainReplicationMessages(ctx context.Context, gp1 *ty
 	return a.handler.GetDomainReplicationMessages(ctx, gp1)
 }
 
+func (a *adminHandler) GetDomainReplicationStatus(ctx context.Context, gp1 *types.GetDomainReplicationStatusRequest) (gp2 *types.GetDomainReplicationStatusResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "GetDomainReplicationStatus",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetDomainReplicationStatus(ctx, gp1)
+}
+
 func (a *adminHandler) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynamicConfigRequest) (gp2 *types.GetDynamicConfigResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "GetDynamicConfig",
@@ -589,6 +605,22 @@ func (a *adminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context
 	return a.handler.UpdateDomainAsyncWorkflowConfiguraton(ctx, up1)
 }
 
+func (a *adminHandler) UpdateDomainIsolation(ctx context.Context, up1 *types.UpdateDomainIsolationRequest) (up2 *types.UpdateDomainIsolationResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "UpdateDomainIsolation",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.UpdateDomainIsolation(ctx, up1)
+}
+
 func (a *adminHandler) UpdateDomainIsolationGroups(ctx context.Context, request *types.UpdateDomainIsolationGroupsRequest) (up1 *types.UpdateDomainIsolationGroupsResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "UpdateDomainIsolationGroups",
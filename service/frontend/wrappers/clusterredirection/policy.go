@@ -127,6 +127,12 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
+	"PauseActivity":                    {},
+	"UnpauseActivity":                  {},
+	"ResetActivity":                    {},
+	"UpdateActivityOptions":            {},
+	"PauseWorkflowExecution":           {},
+	"ResumeWorkflowExecution":          {},
 }
 
 // selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 contains a list of non-worker APIs which can be redirected.
@@ -139,6 +145,12 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
+	"PauseActivity":                    {},
+	"UnpauseActivity":                  {},
+	"ResetActivity":                    {},
+	"UpdateActivityOptions":            {},
+	"PauseWorkflowExecution":           {},
+	"ResumeWorkflowExecution":          {},
 	// additional endpoints
 	"RespondActivityTaskCanceled":      {},
 	"RespondActivityTaskCanceledByID":  {},
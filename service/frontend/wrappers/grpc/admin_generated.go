@@ -9,6 +9,7 @@ import (
 
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/common/types/mapper/proto"
 	_sourceAdmin "github.com/uber/cadence/service/frontend/admin"
 )
@@ -215,3 +216,21 @@ func (g AdminHandler) UpdateTaskListPartitionConfig(ctx context.Context, request
 	response, err := g.h.UpdateTaskListPartitionConfig(ctx, proto.ToAdminUpdateTaskListPartitionConfigRequest(request))
 	return proto.FromAdminUpdateTaskListPartitionConfigResponse(response), proto.FromError(err)
 }
+
+type FrontendAdminHandler struct {
+	h _sourceAdmin.Handler
+}
+
+func NewFrontendAdminHandler(h _sourceAdmin.Handler) FrontendAdminHandler {
+	return FrontendAdminHandler{h}
+}
+
+func (g FrontendAdminHandler) GetDomainReplicationStatus(ctx context.Context, request *frontendv1.GetDomainReplicationStatusRequest) (*frontendv1.GetDomainReplicationStatusResponse, error) {
+	response, err := g.h.GetDomainReplicationStatus(ctx, proto.ToFrontendGetDomainReplicationStatusRequest(request))
+	return proto.FromFrontendGetDomainReplicationStatusResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAdminHandler) UpdateDomainIsolation(ctx context.Context, request *frontendv1.UpdateDomainIsolationRequest) (*frontendv1.UpdateDomainIsolationResponse, error) {
+	response, err := g.h.UpdateDomainIsolation(ctx, proto.ToFrontendUpdateDomainIsolationRequest(request))
+	return proto.FromFrontendUpdateDomainIsolationResponse(response), proto.FromError(err)
+}
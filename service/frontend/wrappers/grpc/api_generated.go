@@ -9,6 +9,7 @@ import (
 
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/common/types/mapper/proto"
 	_sourceApi "github.com/uber/cadence/service/frontend/api"
 )
@@ -295,3 +296,71 @@ func (g APIHandler) UpdateSchedule(ctx context.Context, request *apiv1.UpdateSch
 	response, err := g.h.UpdateSchedule(ctx, proto.ToUpdateScheduleRequest(request))
 	return proto.FromUpdateScheduleResponse(response), proto.FromError(err)
 }
+
+type FrontendAPIHandler struct {
+	h _sourceApi.Handler
+}
+
+func NewFrontendAPIHandler(h _sourceApi.Handler) FrontendAPIHandler {
+	return FrontendAPIHandler{h}
+}
+
+func (g FrontendAPIHandler) CreateSchedule(ctx context.Context, request *frontendv1.CreateScheduleRequest) (*frontendv1.CreateScheduleResponse, error) {
+	response, err := g.h.CreateSchedule(ctx, proto.ToFrontendCreateScheduleRequest(request))
+	return proto.FromFrontendCreateScheduleResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) DescribeSchedule(ctx context.Context, request *frontendv1.DescribeScheduleRequest) (*frontendv1.DescribeScheduleResponse, error) {
+	response, err := g.h.DescribeSchedule(ctx, proto.ToFrontendDescribeScheduleRequest(request))
+	return proto.FromFrontendDescribeScheduleResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) ListScheduleMatchingTimes(ctx context.Context, request *frontendv1.ListScheduleMatchingTimesRequest) (*frontendv1.ListScheduleMatchingTimesResponse, error) {
+	response, err := g.h.ListScheduleMatchingTimes(ctx, proto.ToFrontendListScheduleMatchingTimesRequest(request))
+	return proto.FromFrontendListScheduleMatchingTimesResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) ListSchedules(ctx context.Context, request *frontendv1.ListSchedulesRequest) (*frontendv1.ListSchedulesResponse, error) {
+	response, err := g.h.ListSchedules(ctx, proto.ToFrontendListSchedulesRequest(request))
+	return proto.FromFrontendListSchedulesResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) PauseActivity(ctx context.Context, request *frontendv1.PauseActivityRequest) (*frontendv1.PauseActivityResponse, error) {
+	response, err := g.h.PauseActivity(ctx, proto.ToFrontendPauseActivityRequest(request))
+	return proto.FromFrontendPauseActivityResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) PauseWorkflowExecution(ctx context.Context, request *frontendv1.PauseWorkflowExecutionRequest) (*frontendv1.PauseWorkflowExecutionResponse, error) {
+	response, err := g.h.PauseWorkflowExecution(ctx, proto.ToFrontendPauseWorkflowExecutionRequest(request))
+	return proto.FromFrontendPauseWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) ResetActivity(ctx context.Context, request *frontendv1.ResetActivityRequest) (*frontendv1.ResetActivityResponse, error) {
+	response, err := g.h.ResetActivity(ctx, proto.ToFrontendResetActivityRequest(request))
+	return proto.FromFrontendResetActivityResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) ResumeWorkflowExecution(ctx context.Context, request *frontendv1.ResumeWorkflowExecutionRequest) (*frontendv1.ResumeWorkflowExecutionResponse, error) {
+	response, err := g.h.ResumeWorkflowExecution(ctx, proto.ToFrontendResumeWorkflowExecutionRequest(request))
+	return proto.FromFrontendResumeWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) TriggerSchedule(ctx context.Context, request *frontendv1.TriggerScheduleRequest) (*frontendv1.TriggerScheduleResponse, error) {
+	response, err := g.h.TriggerSchedule(ctx, proto.ToFrontendTriggerScheduleRequest(request))
+	return proto.FromFrontendTriggerScheduleResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) UnpauseActivity(ctx context.Context, request *frontendv1.UnpauseActivityRequest) (*frontendv1.UnpauseActivityResponse, error) {
+	response, err := g.h.UnpauseActivity(ctx, proto.ToFrontendUnpauseActivityRequest(request))
+	return proto.FromFrontendUnpauseActivityResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) UpdateActivityOptions(ctx context.Context, request *frontendv1.UpdateActivityOptionsRequest) (*frontendv1.UpdateActivityOptionsResponse, error) {
+	response, err := g.h.UpdateActivityOptions(ctx, proto.ToFrontendUpdateActivityOptionsRequest(request))
+	return proto.FromFrontendUpdateActivityOptionsResponse(response), proto.FromError(err)
+}
+
+func (g FrontendAPIHandler) UpdateSchedule(ctx context.Context, request *frontendv1.UpdateScheduleRequest) (*frontendv1.UpdateScheduleResponse, error) {
+	response, err := g.h.UpdateSchedule(ctx, proto.ToFrontendUpdateScheduleRequest(request))
+	return proto.FromFrontendUpdateScheduleResponse(response), proto.FromError(err)
+}
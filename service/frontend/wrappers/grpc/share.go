@@ -29,11 +29,37 @@ import (
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	"go.uber.org/yarpc"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
+// scheduleAPIHandler serves the ScheduleAPI of the frontend proto package, with the extended
+// messages for the methods which have them and the api.v1 messages for the others. The api.v1
+// ScheduleAPI is still served with its own messages under its own procedure names.
+type scheduleAPIHandler struct {
+	APIHandler
+	FrontendAPIHandler
+}
+
+func (g scheduleAPIHandler) CreateSchedule(ctx context.Context, request *frontendv1.CreateScheduleRequest) (*frontendv1.CreateScheduleResponse, error) {
+	return g.FrontendAPIHandler.CreateSchedule(ctx, request)
+}
+
+func (g scheduleAPIHandler) DescribeSchedule(ctx context.Context, request *frontendv1.DescribeScheduleRequest) (*frontendv1.DescribeScheduleResponse, error) {
+	return g.FrontendAPIHandler.DescribeSchedule(ctx, request)
+}
+
+func (g scheduleAPIHandler) ListSchedules(ctx context.Context, request *frontendv1.ListSchedulesRequest) (*frontendv1.ListSchedulesResponse, error) {
+	return g.FrontendAPIHandler.ListSchedules(ctx, request)
+}
+
+func (g scheduleAPIHandler) UpdateSchedule(ctx context.Context, request *frontendv1.UpdateScheduleRequest) (*frontendv1.UpdateScheduleResponse, error) {
+	return g.FrontendAPIHandler.UpdateSchedule(ctx, request)
+}
+
 func (g AdminHandler) Register(dispatcher *yarpc.Dispatcher) {
 	dispatcher.Register(adminv1.BuildAdminAPIYARPCProcedures(g))
+	dispatcher.Register(frontendv1.BuildFrontendAdminAPIYARPCProcedures(NewFrontendAdminHandler(g.h)))
 }
 
 func (g APIHandler) Register(dispatcher *yarpc.Dispatcher) {
@@ -43,6 +69,8 @@ func (g APIHandler) Register(dispatcher *yarpc.Dispatcher) {
 	dispatcher.Register(apiv1.BuildVisibilityAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildMetaAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildScheduleAPIYARPCProcedures(g))
+	dispatcher.Register(frontendv1.BuildScheduleAPIYARPCProcedures(scheduleAPIHandler{g, NewFrontendAPIHandler(g.h)}))
+	dispatcher.Register(frontendv1.BuildFrontendAPIYARPCProcedures(NewFrontendAPIHandler(g.h)))
 }
 
 func (g APIHandler) Health(ctx context.Context, request *apiv1.HealthRequest) (*apiv1.HealthResponse, error) {
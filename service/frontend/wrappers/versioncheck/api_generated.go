@@ -197,6 +197,14 @@ func (h *versionCheckHandler) ListOpenWorkflowExecutions(ctx context.Context, lp
 	return h.frontendHandler.ListOpenWorkflowExecutions(ctx, lp1)
 }
 
+func (h *versionCheckHandler) ListScheduleMatchingTimes(ctx context.Context, lp1 *types.ListScheduleMatchingTimesRequest) (lp2 *types.ListScheduleMatchingTimesResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ListScheduleMatchingTimes(ctx, lp1)
+}
+
 func (h *versionCheckHandler) ListSchedules(ctx context.Context, lp1 *types.ListSchedulesRequest) (lp2 *types.ListSchedulesResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -217,6 +225,14 @@ func (h *versionCheckHandler) ListWorkflowExecutions(ctx context.Context, lp1 *t
 	return h.frontendHandler.ListWorkflowExecutions(ctx, lp1)
 }
 
+func (h *versionCheckHandler) PauseActivity(ctx context.Context, pp1 *types.PauseActivityRequest) (pp2 *types.PauseActivityResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.PauseActivity(ctx, pp1)
+}
+
 func (h *versionCheckHandler) PauseSchedule(ctx context.Context, pp1 *types.PauseScheduleRequest) (pp2 *types.PauseScheduleResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -225,6 +241,14 @@ func (h *versionCheckHandler) PauseSchedule(ctx context.Context, pp1 *types.Paus
 	return h.frontendHandler.PauseSchedule(ctx, pp1)
 }
 
+func (h *versionCheckHandler) PauseWorkflowExecution(ctx context.Context, pp1 *types.PauseWorkflowExecutionRequest) (pp2 *types.PauseWorkflowExecutionResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.PauseWorkflowExecution(ctx, pp1)
+}
+
 func (h *versionCheckHandler) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest) (pp2 *types.PollForActivityTaskResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -285,6 +309,14 @@ func (h *versionCheckHandler) RequestCancelWorkflowExecution(ctx context.Context
 	return h.frontendHandler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (h *versionCheckHandler) ResetActivity(ctx context.Context, rp1 *types.ResetActivityRequest) (rp2 *types.ResetActivityResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ResetActivity(ctx, rp1)
+}
+
 func (h *versionCheckHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -381,6 +413,14 @@ func (h *versionCheckHandler) RestartWorkflowExecution(ctx context.Context, rp1
 	return h.frontendHandler.RestartWorkflowExecution(ctx, rp1)
 }
 
+func (h *versionCheckHandler) ResumeWorkflowExecution(ctx context.Context, rp1 *types.ResumeWorkflowExecutionRequest) (rp2 *types.ResumeWorkflowExecutionResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ResumeWorkflowExecution(ctx, rp1)
+}
+
 func (h *versionCheckHandler) ScanWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -437,6 +477,22 @@ func (h *versionCheckHandler) TerminateWorkflowExecution(ctx context.Context, tp
 	return h.frontendHandler.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (h *versionCheckHandler) TriggerSchedule(ctx context.Context, tp1 *types.TriggerScheduleRequest) (tp2 *types.TriggerScheduleResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.TriggerSchedule(ctx, tp1)
+}
+
+func (h *versionCheckHandler) UnpauseActivity(ctx context.Context, up1 *types.UnpauseActivityRequest) (up2 *types.UnpauseActivityResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UnpauseActivity(ctx, up1)
+}
+
 func (h *versionCheckHandler) UnpauseSchedule(ctx context.Context, up1 *types.UnpauseScheduleRequest) (up2 *types.UnpauseScheduleResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -445,6 +501,14 @@ func (h *versionCheckHandler) UnpauseSchedule(ctx context.Context, up1 *types.Un
 	return h.frontendHandler.UnpauseSchedule(ctx, up1)
 }
 
+func (h *versionCheckHandler) UpdateActivityOptions(ctx context.Context, up1 *types.UpdateActivityOptionsRequest) (up2 *types.UpdateActivityOptionsResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UpdateActivityOptions(ctx, up1)
+}
+
 func (h *versionCheckHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -110,6 +110,7 @@ type Config struct {
 	ResurrectionCheckMinDelay                         dynamicproperties.DurationPropertyFnWithDomainFilter
 	EnableHierarchicalWeightedRoundRobinTaskScheduler dynamicproperties.BoolPropertyFn
 	EnableTaskListAwareTaskSchedulerByDomain          dynamicproperties.BoolPropertyFnWithDomainFilter
+	EnableTaskPriorityByTaskType                      dynamicproperties.BoolPropertyFnWithDomainFilter
 	TaskListNiceValue                                 dynamicproperties.IntPropertyFnWithDomainAndTaskListFilter
 
 	// History Queue (v2) settings
@@ -121,6 +122,13 @@ type Config struct {
 	QueueCriticalPendingTaskCount              dynamicproperties.IntPropertyFn
 	QueueMaxVirtualQueueCount                  dynamicproperties.IntPropertyFn
 	VirtualSliceForceAppendInterval            dynamicproperties.DurationPropertyFn
+	QueueCriticalAckLevelStuckDuration         dynamicproperties.DurationPropertyFn
+	QueueCriticalTaskAttempt                   dynamicproperties.IntPropertyFn
+	QueueCriticalReadLevelLag                  dynamicproperties.IntPropertyFn
+	QueueCriticalVirtualSliceCount             dynamicproperties.IntPropertyFn
+	QueueThrottledDomainRPS                    dynamicproperties.IntPropertyFn
+	QueueDomainThrottleDuration                dynamicproperties.DurationPropertyFn
+	QueueIsolatedDomainSettings                dynamicproperties.MapPropertyFn
 
 	// QueueProcessor settings
 	QueueProcessorEnableSplit                          dynamicproperties.BoolPropertyFn
@@ -155,6 +163,8 @@ type Config struct {
 	TimerProcessorSplitQueueIntervalJitterCoefficient dynamicproperties.FloatPropertyFn
 	TimerProcessorMaxRedispatchQueueSize              dynamicproperties.IntPropertyFn
 	TimerProcessorMaxTimeShift                        dynamicproperties.DurationPropertyFn
+	TimerBurstSmoothingThreshold                      dynamicproperties.IntPropertyFn
+	TimerBurstSmoothingWindow                         dynamicproperties.DurationPropertyFnWithDomainFilter
 	TimerProcessorHistoryArchivalSizeLimit            dynamicproperties.IntPropertyFn
 	TimerProcessorArchivalTimeLimit                   dynamicproperties.DurationPropertyFn
 	DisableTimerFailoverQueue                         dynamicproperties.BoolPropertyFn
@@ -322,6 +332,11 @@ type Config struct {
 	// History check for corruptions
 	EnableHistoryCorruptionCheck dynamicproperties.BoolPropertyFnWithDomainFilter
 
+	// Sampled audit trail of the execution mutations
+	EnablePersistenceWriteAudit     dynamicproperties.BoolPropertyFnWithDomainFilter
+	PersistenceWriteAuditMaxQPS     dynamicproperties.IntPropertyFnWithDomainFilter
+	PersistenceWriteAuditMaxRecords dynamicproperties.IntPropertyFn
+
 	// Failover marker heartbeat
 	NotifyFailoverMarkerInterval               dynamicproperties.DurationPropertyFn
 	NotifyFailoverMarkerTimerJitterCoefficient dynamicproperties.FloatPropertyFn
@@ -432,6 +447,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		ResurrectionCheckMinDelay:                         dc.GetDurationPropertyFilteredByDomain(dynamicproperties.ResurrectionCheckMinDelay),
 		EnableHierarchicalWeightedRoundRobinTaskScheduler: dc.GetBoolProperty(dynamicproperties.EnableHierarchicalWeightedRoundRobinTaskScheduler),
 		EnableTaskListAwareTaskSchedulerByDomain:          dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableTaskListAwareTaskSchedulerByDomain),
+		EnableTaskPriorityByTaskType:                      dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableTaskPriorityByTaskType),
 		TaskListNiceValue:                                 dc.GetIntPropertyFilteredByDomainAndTaskList(dynamicproperties.HistoryTaskListNiceValue),
 
 		EnableTimerQueueV2:                         dc.GetBoolPropertyFilteredByShardID(dynamicproperties.EnableTimerQueueV2),
@@ -442,6 +458,13 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		QueueCriticalPendingTaskCount:              dc.GetIntProperty(dynamicproperties.QueueCriticalPendingTaskCount),
 		QueueMaxVirtualQueueCount:                  dc.GetIntProperty(dynamicproperties.QueueMaxVirtualQueueCount),
 		VirtualSliceForceAppendInterval:            dc.GetDurationProperty(dynamicproperties.VirtualSliceForceAppendInterval),
+		QueueCriticalAckLevelStuckDuration:         dc.GetDurationProperty(dynamicproperties.QueueCriticalAckLevelStuckDuration),
+		QueueCriticalTaskAttempt:                   dc.GetIntProperty(dynamicproperties.QueueCriticalTaskAttempt),
+		QueueCriticalReadLevelLag:                  dc.GetIntProperty(dynamicproperties.QueueCriticalReadLevelLag),
+		QueueCriticalVirtualSliceCount:             dc.GetIntProperty(dynamicproperties.QueueCriticalVirtualSliceCount),
+		QueueThrottledDomainRPS:                    dc.GetIntProperty(dynamicproperties.QueueThrottledDomainRPS),
+		QueueDomainThrottleDuration:                dc.GetDurationProperty(dynamicproperties.QueueDomainThrottleDuration),
+		QueueIsolatedDomainSettings:                dc.GetMapProperty(dynamicproperties.QueueIsolatedDomainSettings),
 
 		QueueProcessorEnableSplit:                          dc.GetBoolProperty(dynamicproperties.QueueProcessorEnableSplit),
 		QueueProcessorSplitMaxLevel:                        dc.GetIntProperty(dynamicproperties.QueueProcessorSplitMaxLevel),
@@ -474,6 +497,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		TimerProcessorSplitQueueIntervalJitterCoefficient:    dc.GetFloat64Property(dynamicproperties.TimerProcessorSplitQueueIntervalJitterCoefficient),
 		TimerProcessorMaxRedispatchQueueSize:                 dc.GetIntProperty(dynamicproperties.TimerProcessorMaxRedispatchQueueSize),
 		TimerProcessorMaxTimeShift:                           dc.GetDurationProperty(dynamicproperties.TimerProcessorMaxTimeShift),
+		TimerBurstSmoothingThreshold:                         dc.GetIntProperty(dynamicproperties.TimerBurstSmoothingThreshold),
+		TimerBurstSmoothingWindow:                            dc.GetDurationPropertyFilteredByDomain(dynamicproperties.TimerBurstSmoothingWindow),
 		TimerProcessorHistoryArchivalSizeLimit:               dc.GetIntProperty(dynamicproperties.TimerProcessorHistoryArchivalSizeLimit),
 		TimerProcessorArchivalTimeLimit:                      dc.GetDurationProperty(dynamicproperties.TimerProcessorArchivalTimeLimit),
 		DisableTimerFailoverQueue:                            dc.GetBoolProperty(dynamicproperties.DisableTimerFailoverQueue),
@@ -606,6 +631,10 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 
 		EnableHistoryCorruptionCheck: dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableHistoryCorruptionCheck),
 
+		EnablePersistenceWriteAudit:     dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnablePersistenceWriteAudit),
+		PersistenceWriteAuditMaxQPS:     dc.GetIntPropertyFilteredByDomain(dynamicproperties.PersistenceWriteAuditMaxQPS),
+		PersistenceWriteAuditMaxRecords: dc.GetIntProperty(dynamicproperties.PersistenceWriteAuditMaxRecords),
+
 		NotifyFailoverMarkerInterval:               dc.GetDurationProperty(dynamicproperties.NotifyFailoverMarkerInterval),
 		NotifyFailoverMarkerTimerJitterCoefficient: dc.GetFloat64Property(dynamicproperties.NotifyFailoverMarkerTimerJitterCoefficient),
 		EnableGracefulFailover:                     dc.GetBoolProperty(dynamicproperties.EnableGracefulFailover),
@@ -138,6 +138,8 @@ func TestNewConfig(t *testing.T) {
 		"TimerProcessorSplitQueueIntervalJitterCoefficient":    {dynamicproperties.TimerProcessorSplitQueueIntervalJitterCoefficient, 4.0},
 		"TimerProcessorMaxRedispatchQueueSize":                 {dynamicproperties.TimerProcessorMaxRedispatchQueueSize, 45},
 		"TimerProcessorMaxTimeShift":                           {dynamicproperties.TimerProcessorMaxTimeShift, time.Second},
+		"TimerBurstSmoothingThreshold":                         {dynamicproperties.TimerBurstSmoothingThreshold, 107},
+		"TimerBurstSmoothingWindow":                            {dynamicproperties.TimerBurstSmoothingWindow, time.Minute},
 		"TimerProcessorHistoryArchivalSizeLimit":               {dynamicproperties.TimerProcessorHistoryArchivalSizeLimit, 46},
 		"TimerProcessorArchivalTimeLimit":                      {dynamicproperties.TimerProcessorArchivalTimeLimit, time.Second},
 		"TimerProcessorCachedQueueReaderMode":                  {dynamicproperties.TimerProcessorCachedQueueReaderMode, "disabled"},
@@ -246,6 +248,9 @@ func TestNewConfig(t *testing.T) {
 		"MutableStateChecksumVerifyProbability":                {dynamicproperties.MutableStateChecksumVerifyProbability, 91},
 		"MutableStateChecksumInvalidateBefore":                 {dynamicproperties.MutableStateChecksumInvalidateBefore, 15.0},
 		"EnableHistoryCorruptionCheck":                         {dynamicproperties.EnableHistoryCorruptionCheck, true},
+		"EnablePersistenceWriteAudit":                          {dynamicproperties.EnablePersistenceWriteAudit, true},
+		"PersistenceWriteAuditMaxQPS":                          {dynamicproperties.PersistenceWriteAuditMaxQPS, 211},
+		"PersistenceWriteAuditMaxRecords":                      {dynamicproperties.PersistenceWriteAuditMaxRecords, 212},
 		"NotifyFailoverMarkerInterval":                         {dynamicproperties.NotifyFailoverMarkerInterval, time.Second},
 		"NotifyFailoverMarkerTimerJitterCoefficient":           {dynamicproperties.NotifyFailoverMarkerTimerJitterCoefficient, 16.0},
 		"EnableGracefulFailover":                               {dynamicproperties.EnableGracefulFailover, true},
@@ -281,10 +286,18 @@ func TestNewConfig(t *testing.T) {
 		"QueueCriticalPendingTaskCount":                        {dynamicproperties.QueueCriticalPendingTaskCount, 100},
 		"QueueMaxVirtualQueueCount":                            {dynamicproperties.QueueMaxVirtualQueueCount, 101},
 		"VirtualSliceForceAppendInterval":                      {dynamicproperties.VirtualSliceForceAppendInterval, time.Second},
+		"QueueCriticalAckLevelStuckDuration":                   {dynamicproperties.QueueCriticalAckLevelStuckDuration, time.Minute},
+		"QueueCriticalTaskAttempt":                             {dynamicproperties.QueueCriticalTaskAttempt, 104},
+		"QueueCriticalReadLevelLag":                            {dynamicproperties.QueueCriticalReadLevelLag, 105},
+		"QueueCriticalVirtualSliceCount":                       {dynamicproperties.QueueCriticalVirtualSliceCount, 106},
+		"QueueThrottledDomainRPS":                              {dynamicproperties.QueueThrottledDomainRPS, 108},
+		"QueueDomainThrottleDuration":                          {dynamicproperties.QueueDomainThrottleDuration, time.Hour},
+		"QueueIsolatedDomainSettings":                          {dynamicproperties.QueueIsolatedDomainSettings, map[string]interface{}{"test-domain": map[string]interface{}{"maxPollRPS": 10}}},
 		"ReplicationTaskProcessorLatencyLogThreshold":          {dynamicproperties.ReplicationTaskProcessorLatencyLogThreshold, time.Duration(0)},
 		"EnableCleanupOrphanedHistoryBranchOnWorkflowCreation": {dynamicproperties.EnableCleanupOrphanedHistoryBranchOnWorkflowCreation, true},
 		"EnableHierarchicalWeightedRoundRobinTaskScheduler":    {dynamicproperties.EnableHierarchicalWeightedRoundRobinTaskScheduler, true},
 		"EnableTaskListAwareTaskSchedulerByDomain":             {dynamicproperties.EnableTaskListAwareTaskSchedulerByDomain, true},
+		"EnableTaskPriorityByTaskType":                         {dynamicproperties.EnableTaskPriorityByTaskType, true},
 		"TaskListNiceValue":                                    {dynamicproperties.HistoryTaskListNiceValue, 5},
 		"EnableCorruptionAutoRepair":                           {dynamicproperties.EnableCorruptionAutoRepair, true},
 		"CorruptionRepairTimeout":                              {dynamicproperties.CorruptionRepairTimeout, time.Duration(1)},
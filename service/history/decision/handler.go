@@ -172,6 +172,7 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 	requestID := req.GetRequestID()
 
 	var resp *types.RecordDecisionTaskStartedResponse
+	var pausedError error
 	err = workflow.UpdateWithActionFunc(
 		ctx,
 		handler.logger,
@@ -228,6 +229,18 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 				return nil, &types.EventAlreadyStartedError{Message: "Decision task already started."}
 			}
 
+			if mutableState.IsWorkflowExecutionPaused() {
+				// the task is dispatched again when the workflow is resumed
+				if mutableState.GetExecutionInfo().DecisionDispatchSuppressed {
+					return nil, workflow.ErrWorkflowExecutionPaused
+				}
+				mutableState.SuppressDecisionDispatch()
+
+				// save paused error but return the update action here, so that mutable state would get updated in DB
+				pausedError = workflow.ErrWorkflowExecutionPaused
+				return updateAction, nil
+			}
+
 			_, decision, err = mutableState.AddDecisionTaskStartedEvent(scheduleID, requestID, req.PollRequest)
 			if err != nil {
 				// Unable to add DecisionTaskStarted event to history
@@ -245,6 +258,9 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 	if err != nil {
 		return nil, err
 	}
+	if pausedError != nil {
+		return nil, pausedError
+	}
 	return resp, nil
 }
 
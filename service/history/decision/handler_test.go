@@ -448,6 +448,46 @@ func TestHandleDecisionTaskStarted(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "failure - workflow paused",
+			domainID: constants.TestDomainID,
+			expectCalls: func(ctrl *gomock.Controller, h *handlerImpl) {
+				h.shard.(*shard.MockContext).EXPECT().GetEventsCache().Times(1).Return(events.NewMockCache(ctrl))
+				h.shard.(*shard.MockContext).EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+						assert.True(t, request.UpdateWorkflowMutation.ExecutionInfo.DecisionDispatchSuppressed)
+						return &persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil
+					})
+				engine := engine.NewMockEngine(ctrl)
+				h.shard.(*shard.MockContext).EXPECT().GetEngine().Return(engine).AnyTimes()
+				engine.EXPECT().NotifyNewHistoryEvent(gomock.Any()).AnyTimes()
+				engine.EXPECT().NotifyNewReplicationTasks(gomock.Any()).AnyTimes()
+			},
+			expectErr: workflow.ErrWorkflowExecutionPaused,
+			mutablestate: &persistence.WorkflowMutableState{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DecisionStartedID: -23,
+					NextEventID:       2,
+					Paused:            true,
+				},
+			},
+		},
+		{
+			name:     "failure - workflow paused and decision already suppressed",
+			domainID: constants.TestDomainID,
+			expectCalls: func(ctrl *gomock.Controller, h *handlerImpl) {
+				h.shard.(*shard.MockContext).EXPECT().GetEventsCache().Times(1).Return(events.NewMockCache(ctrl))
+			},
+			expectErr: workflow.ErrWorkflowExecutionPaused,
+			mutablestate: &persistence.WorkflowMutableState{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DecisionStartedID:          -23,
+					NextEventID:                2,
+					Paused:                     true,
+					DecisionDispatchSuppressed: true,
+				},
+			},
+		},
 		{
 			name:     "success",
 			domainID: constants.TestDomainID,
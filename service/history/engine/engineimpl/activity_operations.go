@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+// updatePendingActivity applies the given action to a pending activity of a running workflow
+func (e *historyEngineImpl) updatePendingActivity(
+	ctx context.Context,
+	domainUUID string,
+	workflowExecution *types.WorkflowExecution,
+	activityID string,
+	action func(mutableState execution.MutableState, ai *persistence.ActivityInfo) error,
+) error {
+
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, domainUUID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	if err != nil {
+		return err
+	}
+
+	return workflow.UpdateWithAction(ctx, e.logger, e.executionCache, domainEntry.GetInfo().ID,
+		types.WorkflowExecution{
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		}, false, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrAlreadyCompleted
+			}
+
+			scheduleID, err := getScheduleID(activityID, mutableState)
+			if err != nil {
+				return err
+			}
+			ai, ok := mutableState.GetActivityInfo(scheduleID)
+			if !ok {
+				return workflow.ErrActivityTaskNotFound
+			}
+			return action(mutableState, ai)
+		},
+	)
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/cluster"
+	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestActivityOperations(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	activityID := "some-activity"
+	pause := func(e engine.Engine) error {
+		_, err := e.PauseActivity(context.Background(), &types.HistoryPauseActivityRequest{
+			DomainUUID: constants.TestDomainID,
+			Request:    &types.PauseActivityRequest{WorkflowExecution: execution, ActivityID: activityID},
+		})
+		return err
+	}
+	unpause := func(e engine.Engine) error {
+		_, err := e.UnpauseActivity(context.Background(), &types.HistoryUnpauseActivityRequest{
+			DomainUUID: constants.TestDomainID,
+			Request:    &types.UnpauseActivityRequest{WorkflowExecution: execution, ActivityID: activityID, ResetAttempts: true},
+		})
+		return err
+	}
+	reset := func(e engine.Engine) error {
+		_, err := e.ResetActivity(context.Background(), &types.HistoryResetActivityRequest{
+			DomainUUID: constants.TestDomainID,
+			Request:    &types.ResetActivityRequest{WorkflowExecution: execution, ActivityID: activityID},
+		})
+		return err
+	}
+	updateOptions := func(e engine.Engine) error {
+		_, err := e.UpdateActivityOptions(context.Background(), &types.HistoryUpdateActivityOptionsRequest{
+			DomainUUID: constants.TestDomainID,
+			Request: &types.UpdateActivityOptionsRequest{
+				WorkflowExecution: execution,
+				ActivityID:        activityID,
+				Options:           &types.ActivityOptions{StartToCloseTimeoutSeconds: common.Int32Ptr(60)},
+			},
+		})
+		return err
+	}
+
+	cases := []struct {
+		name        string
+		state       int
+		activity    *persistence.ActivityInfo
+		call        func(e engine.Engine) error
+		matchUpdate func(ai *persistence.ActivityInfo) bool
+		expectedErr error
+	}{
+		{
+			name:        "completed workflow",
+			state:       persistence.WorkflowStateCompleted,
+			call:        pause,
+			expectedErr: workflow.ErrAlreadyCompleted,
+		},
+		{
+			name:        "activity not found",
+			call:        pause,
+			expectedErr: &types.BadRequestError{Message: "Cannot locate Activity ScheduleID"},
+		},
+		{
+			name:     "pause",
+			activity: &persistence.ActivityInfo{ScheduleID: 5, ActivityID: activityID, StartedID: commonconstants.EmptyEventID},
+			call:     pause,
+			matchUpdate: func(ai *persistence.ActivityInfo) bool {
+				return ai.Paused
+			},
+		},
+		{
+			name:     "unpause",
+			activity: &persistence.ActivityInfo{ScheduleID: 5, ActivityID: activityID, StartedID: commonconstants.EmptyEventID, Attempt: 3, Paused: true},
+			call:     unpause,
+			matchUpdate: func(ai *persistence.ActivityInfo) bool {
+				return !ai.Paused && ai.Attempt == 0
+			},
+		},
+		{
+			name:     "reset",
+			activity: &persistence.ActivityInfo{ScheduleID: 5, ActivityID: activityID, StartedID: commonconstants.EmptyEventID, Attempt: 3},
+			call:     reset,
+			matchUpdate: func(ai *persistence.ActivityInfo) bool {
+				return ai.Attempt == 0
+			},
+		},
+		{
+			name:     "update options",
+			activity: &persistence.ActivityInfo{ScheduleID: 5, ActivityID: activityID, StartedID: commonconstants.EmptyEventID, StartToCloseTimeout: 10},
+			call:     updateOptions,
+			matchUpdate: func(ai *persistence.ActivityInfo) bool {
+				return ai.StartToCloseTimeout == 60
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			activityInfos := map[int64]*persistence.ActivityInfo{}
+			if testCase.activity != nil {
+				activityInfos[testCase.activity.ScheduleID] = testCase.activity
+			}
+			eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+				return req.Execution == *execution
+			})).Return(&persistence.GetWorkflowExecutionResponse{
+				State: &persistence.WorkflowMutableState{
+					ExecutionInfo: &persistence.WorkflowExecutionInfo{
+						DomainID:   constants.TestDomainID,
+						WorkflowID: execution.WorkflowID,
+						RunID:      execution.RunID,
+						State:      testCase.state,
+					},
+					ActivityInfos:  activityInfos,
+					ExecutionStats: &persistence.ExecutionStats{},
+					Checksum:       checksum.Checksum{},
+				},
+			}, nil)
+			eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+				Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil).AnyTimes()
+			if testCase.matchUpdate != nil {
+				eft.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+					upserted := req.UpdateWorkflowMutation.UpsertActivityInfos
+					return len(upserted) == 1 && testCase.matchUpdate(upserted[0])
+				})).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+			}
+
+			eft.Engine.Start()
+			err := testCase.call(eft.Engine)
+			eft.Engine.Stop()
+
+			if testCase.expectedErr == nil {
+				assert.NoError(t, err)
+				eft.ShardCtx.Resource.ExecutionMgr.AssertNumberOfCalls(t, "UpdateWorkflowExecution", 1)
+			} else {
+				assert.Equal(t, testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestPauseActivityGlobalDomain(t *testing.T) {
+	eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+	eft.ShardCtx.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestActiveActiveDomainID).Return(constants.TestActiveActiveDomainEntry, nil).AnyTimes()
+	eft.ShardCtx.Resource.DomainCache.EXPECT().GetDomainName(constants.TestActiveActiveDomainID).Return(constants.TestActiveActiveDomainName, nil).AnyTimes()
+	eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestActiveActiveDomainID, constants.TestWorkflowID, constants.TestRunID).
+		Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil).AnyTimes()
+	eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:   constants.TestActiveActiveDomainID,
+				WorkflowID: constants.TestWorkflowID,
+				RunID:      constants.TestRunID,
+			},
+			ActivityInfos: map[int64]*persistence.ActivityInfo{
+				5: {ScheduleID: 5, ActivityID: "some-activity", StartedID: commonconstants.EmptyEventID},
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+			Checksum:       checksum.Checksum{},
+		},
+	}, nil)
+	// the activity is paused in the active cluster only, no event is written to the history
+	eft.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+		upserted := req.UpdateWorkflowMutation.UpsertActivityInfos
+		return len(upserted) == 1 && upserted[0].Paused
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+
+	eft.Engine.Start()
+	_, err := eft.Engine.PauseActivity(context.Background(), &types.HistoryPauseActivityRequest{
+		DomainUUID: constants.TestActiveActiveDomainID,
+		Request: &types.PauseActivityRequest{
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID},
+			ActivityID:        "some-activity",
+		},
+	})
+	eft.Engine.Stop()
+
+	assert.NoError(t, err)
+	eft.ShardCtx.Resource.HistoryMgr.AssertNotCalled(t, "AppendHistoryNodes", mock.Anything, mock.Anything)
+}
+
+func TestUpdateActivityOptionsGlobalDomain(t *testing.T) {
+	eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+	eft.ShardCtx.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestActiveActiveDomainID).Return(constants.TestActiveActiveDomainEntry, nil).AnyTimes()
+	eft.ShardCtx.Resource.DomainCache.EXPECT().GetDomainName(constants.TestActiveActiveDomainID).Return(constants.TestActiveActiveDomainName, nil).AnyTimes()
+	eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestActiveActiveDomainID, constants.TestWorkflowID, constants.TestRunID).
+		Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil).AnyTimes()
+	eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:   constants.TestActiveActiveDomainID,
+				WorkflowID: constants.TestWorkflowID,
+				RunID:      constants.TestRunID,
+			},
+			ActivityInfos: map[int64]*persistence.ActivityInfo{
+				5: {ScheduleID: 5, ActivityID: "some-activity", StartedID: commonconstants.EmptyEventID, StartToCloseTimeout: 10},
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+			Checksum:       checksum.Checksum{},
+		},
+	}, nil)
+	// the options are changed in the active cluster only, no event is written to the history
+	eft.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+		upserted := req.UpdateWorkflowMutation.UpsertActivityInfos
+		return len(upserted) == 1 && upserted[0].StartToCloseTimeout == 60
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+
+	eft.Engine.Start()
+	_, err := eft.Engine.UpdateActivityOptions(context.Background(), &types.HistoryUpdateActivityOptionsRequest{
+		DomainUUID: constants.TestActiveActiveDomainID,
+		Request: &types.UpdateActivityOptionsRequest{
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID},
+			ActivityID:        "some-activity",
+			Options:           &types.ActivityOptions{StartToCloseTimeoutSeconds: common.Int32Ptr(60)},
+		},
+	})
+	eft.Engine.Stop()
+
+	assert.NoError(t, err)
+	eft.ShardCtx.Resource.HistoryMgr.AssertNotCalled(t, "AppendHistoryNodes", mock.Anything, mock.Anything)
+}
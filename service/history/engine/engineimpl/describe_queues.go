@@ -58,10 +58,17 @@ func (e *historyEngineImpl) describeQueue(
 		return nil, err
 	}
 
-	serializedStates := make([]string, 0, len(resp.GetStateActionResult.States))
-	for _, state := range resp.GetStateActionResult.States {
+	if resp == nil || resp.GetStateActionResult == nil {
+		return &types.DescribeQueueResponse{}, nil
+	}
+
+	result := resp.GetStateActionResult
+	serializedStates := make([]string, 0, len(result.States)+len(result.VirtualQueueStates)+len(result.Mitigations))
+	for _, state := range result.States {
 		serializedStates = append(serializedStates, e.serializeQueueState(state))
 	}
+	serializedStates = append(serializedStates, result.VirtualQueueStates...)
+	serializedStates = append(serializedStates, result.Mitigations...)
 	return &types.DescribeQueueResponse{
 		ProcessingQueueStates: serializedStates,
 	}, nil
@@ -165,6 +165,7 @@ func mapWorkflowExecutionInfo(executionInfo *persistence.WorkflowExecutionInfo,
 		PartitionConfig:              executionInfo.CopyPartitionConfig(),
 		CronOverlapPolicy:            &executionInfo.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy: executionInfo.ActiveClusterSelectionPolicy,
+		IsPaused:                     executionInfo.Paused,
 	}
 
 	backoffDuration := time.Duration(startEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstDecisionTaskBackoffSeconds()) * time.Second
@@ -198,6 +199,7 @@ func mapPendingActivityInfo(ai *persistence.ActivityInfo, activityScheduledEvent
 	p := &types.PendingActivityInfo{
 		ActivityID: ai.ActivityID,
 		ScheduleID: ai.ScheduleID,
+		Paused:     ai.Paused,
 	}
 
 	state := types.PendingActivityStateScheduled
@@ -628,6 +628,7 @@ func TestMapWorkflowExecutionInfo(t *testing.T) {
 				PartitionConfig:      map[string]string{},
 				CronSchedule:         "0 0 * * *",
 				State:                persistence.WorkflowStateRunning,
+				Paused:               true,
 			},
 			startEvent: &types.HistoryEvent{
 				WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
@@ -656,6 +657,7 @@ func TestMapWorkflowExecutionInfo(t *testing.T) {
 				PartitionConfig:              map[string]string{},
 				CronOverlapPolicy:            &historyConstants.CronSkip,
 				ActiveClusterSelectionPolicy: nil,
+				IsPaused:                     true,
 				ExecutionTime:                common.Int64Ptr(1000000 + (5 * time.Second).Nanoseconds()),
 			},
 		},
@@ -868,6 +870,33 @@ func TestMapPendingActivityInfo(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success - paused activity",
+			activityInfo: &persistence.ActivityInfo{
+				ActivityID:    "test-activity-id-4",
+				ScheduleID:    128,
+				StartedID:     constants.EmptyEventID,
+				ScheduledTime: time.Unix(0, 2002),
+				Paused:        true,
+			},
+			activityScheduledEvent: &types.HistoryEvent{
+				ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+					ActivityType: &types.ActivityType{
+						Name: "test-activity-type-4",
+					},
+				},
+			},
+			expected: &types.PendingActivityInfo{
+				ActivityID:         "test-activity-id-4",
+				ScheduleID:         128,
+				State:              types.PendingActivityStateScheduled.Ptr(),
+				ScheduledTimestamp: common.Int64Ptr(2002),
+				Paused:             true,
+				ActivityType: &types.ActivityType{
+					Name: "test-activity-type-4",
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -38,6 +38,9 @@ type fakeDLQHandler struct {
 	tasks    []*types.ReplicationTask
 	taskInfo []*types.ReplicationTaskInfo
 	token    []byte
+
+	domainCounts map[string]int64
+	domainErr    error
 }
 
 func (f *fakeDLQHandler) Start() {}
@@ -47,6 +50,10 @@ func (f *fakeDLQHandler) GetMessageCount(ctx context.Context, forceFetch bool) (
 	return nil, nil
 }
 
+func (f *fakeDLQHandler) GetDomainMessageCount(ctx context.Context, domainID string) (map[string]int64, error) {
+	return f.domainCounts, f.domainErr
+}
+
 func (f *fakeDLQHandler) ReadMessages(
 	ctx context.Context,
 	sourceCluster string,
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func (e *historyEngineImpl) GetDomainReplicationStatus(
+	ctx context.Context,
+	domainID string,
+) (map[string]*types.ReplicationClusterStatus, error) {
+
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	var remoteClusters []string
+	for _, cluster := range domainEntry.GetReplicationConfig().Clusters {
+		if cluster.ClusterName != e.currentClusterName {
+			remoteClusters = append(remoteClusters, cluster.ClusterName)
+		}
+	}
+
+	status, err := e.replicationAckManager.GetDomainReplicationStatus(ctx, domainID, remoteClusters)
+	if err != nil {
+		return nil, err
+	}
+
+	// the DLQ holds the tasks received from the remote clusters that failed to be applied in this cluster
+	dlqCounts, err := e.replicationDLQHandler.GetDomainMessageCount(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+	for cluster, count := range dlqCounts {
+		if _, ok := status[cluster]; !ok {
+			status[cluster] = &types.ReplicationClusterStatus{ClusterName: cluster}
+		}
+		status[cluster].DLQMessageCount = count
+	}
+	return status, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/replication"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+func TestGetDomainReplicationStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		domainEntryErr error
+		dlqCounts      map[string]int64
+		dlqErr         error
+		want           map[string]*types.ReplicationClusterStatus
+		wantErr        bool
+	}{
+		{
+			name:           "failed to get domain",
+			domainEntryErr: &types.EntityNotExistsError{},
+			wantErr:        true,
+		},
+		{
+			name:    "failed to count DLQ messages",
+			dlqErr:  errors.New("some random error"),
+			wantErr: true,
+		},
+		{
+			name: "success",
+			want: map[string]*types.ReplicationClusterStatus{
+				cluster.TestAlternativeClusterName: {ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		{
+			name: "success with DLQ messages",
+			dlqCounts: map[string]int64{
+				cluster.TestAlternativeClusterName: 3,
+				// the cluster was removed from the domain after the messages were written to the DLQ
+				"removed-cluster": 1,
+			},
+			want: map[string]*types.ReplicationClusterStatus{
+				cluster.TestAlternativeClusterName: {ClusterName: cluster.TestAlternativeClusterName, DLQMessageCount: 3},
+				"removed-cluster":                  {ClusterName: "removed-cluster", DLQMessageCount: 1},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			historyCfg := config.NewForTest()
+			shardCtx := shard.NewTestContext(t, ctrl, &persistence.ShardInfo{RangeID: 1}, historyCfg)
+			shardCtx.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestGlobalDomainEntry, tc.domainEntryErr).Times(1)
+			// no replication task is pending
+			shardCtx.Resource.ExecutionMgr.On("GetHistoryTasks", mock.Anything, mock.Anything).Return(&persistence.GetHistoryTasksResponse{}, nil).Maybe()
+
+			e := &historyEngineImpl{
+				currentClusterName: cluster.TestCurrentClusterName,
+				shard:              shardCtx,
+				replicationAckManager: replication.NewTaskAckManager(
+					shardCtx.GetShardID(),
+					shardCtx,
+					metrics.NewNoopMetricsClient(),
+					log.NewNoop(),
+					replication.NewTaskReader(shardCtx.GetShardID(), shardCtx.Resource.ExecutionMgr),
+					nil,
+					clock.NewMockedTimeSource(),
+					historyCfg,
+					proto.ReplicationMessagesSize,
+					nil,
+				),
+				replicationDLQHandler: &fakeDLQHandler{domainCounts: tc.dlqCounts, domainErr: tc.dlqErr},
+			}
+
+			status, err := e.GetDomainReplicationStatus(context.Background(), constants.TestDomainID)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, status)
+		})
+	}
+}
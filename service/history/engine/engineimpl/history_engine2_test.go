@@ -893,6 +893,47 @@ func (s *engine2Suite) TestRecordActivityTaskStartedResurrected() {
 	s.Equal(err, workflow.ErrActivityTaskNotFound)
 }
 
+func (s *engine2Suite) TestRecordActivityTaskStartedWorkflowPaused() {
+	domainID := constants.TestDomainID
+	workflowExecution := types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID}
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
+		FailoverVersion:   0,
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).Times(2)
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	scheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, "activity1_id", "activity_type1", tl, []byte("input1"), 100, 10, 1, 5)
+	msBuilder.GetExecutionInfo().Paused = true
+
+	ms1 := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms1}, nil).Once()
+
+	// Expect that mutable state will be updated to record the dropped task
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return len(request.UpdateWorkflowMutation.UpsertActivityInfos) == 1 && request.UpdateWorkflowMutation.UpsertActivityInfos[0].DispatchSuppressed
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID(),
+		decisionCompletedEvent.ID, scheduledEvent.ID, gomock.Any(),
+	).Return(scheduledEvent, nil)
+	_, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &types.RecordActivityTaskStartedRequest{
+		DomainUUID:        domainID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleID:        scheduledEvent.ID,
+		TaskID:            100,
+		RequestID:         "reqId",
+		PollRequest:       &types.PollForActivityTaskRequest{TaskList: &types.TaskList{Name: tl}, Identity: identity},
+	})
+
+	s.Equal(workflow.ErrWorkflowExecutionPaused, err)
+}
+
 func (s *engine2Suite) TestRecordActivityTaskStartedStaleState() {
 	domainID := constants.TestDomainID
 	workflowExecution := types.WorkflowExecution{
@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+func (e *historyEngineImpl) PauseActivity(
+	ctx context.Context,
+	pauseRequest *types.HistoryPauseActivityRequest,
+) (*types.PauseActivityResponse, error) {
+
+	request := pauseRequest.GetRequest()
+	// the paused flag is only kept in the mutable state of the active cluster, the replication tasks
+	// don't carry it and an activity of a global domain is dispatched again after a failover
+	err := e.updatePendingActivity(ctx, pauseRequest.GetDomainUUID(), request.GetWorkflowExecution(), request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) error {
+			if ai.Paused {
+				return nil
+			}
+			return mutableState.PauseActivity(ai)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PauseActivityResponse{}, nil
+}
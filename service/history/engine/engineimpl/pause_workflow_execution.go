@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *types.HistoryPauseWorkflowExecutionRequest,
+) (*types.PauseWorkflowExecutionResponse, error) {
+
+	request := pauseRequest.GetRequest()
+	workflowExecution := request.GetWorkflowExecution()
+	domainEntry, err := e.getActiveDomainByWorkflow(ctx, pauseRequest.GetDomainUUID(), workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	if err != nil {
+		return nil, err
+	}
+
+	// the paused state is only kept in the mutable state of the active cluster, the replication tasks
+	// don't carry it and a workflow of a global domain is dispatched again after a failover
+	err = workflow.UpdateWithAction(ctx, e.logger, e.executionCache, domainEntry.GetInfo().ID,
+		types.WorkflowExecution{
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		}, false, e.timeSource.Now(),
+		func(wfContext execution.Context, mutableState execution.MutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return workflow.ErrAlreadyCompleted
+			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return nil
+			}
+			return mutableState.PauseWorkflowExecution()
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PauseWorkflowExecutionResponse{}, nil
+}
@@ -56,6 +56,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 	}
 
 	var resurrectError error
+	var pausedError error
 	response := &types.RecordActivityTaskStartedResponse{}
 	err = workflow.UpdateWithAction(ctx, e.logger, e.executionCache, domainID, workflowExecution, false, e.timeSource.Now(),
 		func(wfContext execution.Context, mutableState execution.MutableState) error {
@@ -148,6 +149,22 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &types.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if ai.Paused {
+				// the task is dispatched again when the activity is unpaused
+				return workflow.ErrActivityTaskPaused
+			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				// the task is dispatched again when the workflow is resumed
+				if ai.DispatchSuppressed {
+					return workflow.ErrWorkflowExecutionPaused
+				}
+				mutableState.SuppressActivityDispatch(ai)
+
+				// save paused error but return nil here, so that mutable state would get updated in DB
+				pausedError = workflow.ErrWorkflowExecutionPaused
+				return nil
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -165,6 +182,9 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 	if resurrectError != nil {
 		return nil, resurrectError
 	}
+	if pausedError != nil {
+		return nil, pausedError
+	}
 
 	return response, err
 }
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/execution"
+)
+
+func (e *historyEngineImpl) UpdateActivityOptions(
+	ctx context.Context,
+	updateRequest *types.HistoryUpdateActivityOptionsRequest,
+) (*types.UpdateActivityOptionsResponse, error) {
+
+	request := updateRequest.GetRequest()
+	// the options are only kept in the mutable state of the active cluster, the replication tasks don't
+	// carry them and an activity of a global domain runs with its original options after a failover
+	err := e.updatePendingActivity(ctx, updateRequest.GetDomainUUID(), request.GetWorkflowExecution(), request.GetActivityID(),
+		func(mutableState execution.MutableState, ai *persistence.ActivityInfo) error {
+			return mutableState.UpdateActivityOptions(ai, request.GetOptions())
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.UpdateActivityOptionsResponse{}, nil
+}
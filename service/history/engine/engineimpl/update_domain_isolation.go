@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/queue"
+)
+
+// UpdateDomainIsolation isolates the transfer and timer tasks of the domain into dedicated virtual queues,
+// or releases them back to the default virtual queue. It's only supported by history queue v2.
+func (e *historyEngineImpl) UpdateDomainIsolation(
+	ctx context.Context,
+	domainID string,
+	isolated bool,
+) error {
+	for _, category := range []persistence.HistoryTaskCategory{
+		persistence.HistoryTaskCategoryTransfer,
+		persistence.HistoryTaskCategoryTimer,
+	} {
+		queueProcessor, ok := e.queueProcessors[category]
+		if !ok {
+			return fmt.Errorf("queue processor not found for category %v", category)
+		}
+		if _, err := queueProcessor.HandleAction(ctx, e.currentClusterName, queue.NewUpdateDomainIsolationAction(domainID, isolated)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
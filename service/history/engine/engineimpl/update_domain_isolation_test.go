@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/queue"
+)
+
+func TestUpdateDomainIsolation(t *testing.T) {
+	action := queue.NewUpdateDomainIsolationAction(constants.TestDomainID, true)
+	tests := []struct {
+		name       string
+		setupMocks func(transferProcessor, timerProcessor *queue.MockProcessor)
+		noTimer    bool
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(transferProcessor, timerProcessor *queue.MockProcessor) {
+				transferProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, action).Return(&queue.ActionResult{}, nil).Times(1)
+				timerProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, action).Return(&queue.ActionResult{}, nil).Times(1)
+			},
+		},
+		{
+			name: "transfer queue failed",
+			setupMocks: func(transferProcessor, timerProcessor *queue.MockProcessor) {
+				transferProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, action).Return(nil, errors.New("some random error")).Times(1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "timer queue not found",
+			setupMocks: func(transferProcessor, timerProcessor *queue.MockProcessor) {
+				transferProcessor.EXPECT().HandleAction(gomock.Any(), cluster.TestCurrentClusterName, action).Return(&queue.ActionResult{}, nil).Times(1)
+			},
+			noTimer: true,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			transferProcessor := queue.NewMockProcessor(ctrl)
+			timerProcessor := queue.NewMockProcessor(ctrl)
+			tc.setupMocks(transferProcessor, timerProcessor)
+
+			e := &historyEngineImpl{
+				currentClusterName: cluster.TestCurrentClusterName,
+				queueProcessors: map[persistence.HistoryTaskCategory]queue.Processor{
+					persistence.HistoryTaskCategoryTransfer: transferProcessor,
+				},
+			}
+			if !tc.noTimer {
+				e.queueProcessors[persistence.HistoryTaskCategoryTimer] = timerProcessor
+			}
+
+			err := e.UpdateDomainIsolation(context.Background(), constants.TestDomainID, true)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
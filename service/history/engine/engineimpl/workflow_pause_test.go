@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine"
+	"github.com/uber/cadence/service/history/engine/testdata"
+	"github.com/uber/cadence/service/history/workflow"
+)
+
+func TestPauseResumeWorkflowExecution(t *testing.T) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	pause := func(e engine.Engine) error {
+		_, err := e.PauseWorkflowExecution(context.Background(), &types.HistoryPauseWorkflowExecutionRequest{
+			DomainUUID: constants.TestDomainID,
+			Request:    &types.PauseWorkflowExecutionRequest{WorkflowExecution: execution, Reason: "incident"},
+		})
+		return err
+	}
+	resume := func(e engine.Engine) error {
+		_, err := e.ResumeWorkflowExecution(context.Background(), &types.HistoryResumeWorkflowExecutionRequest{
+			DomainUUID: constants.TestDomainID,
+			Request:    &types.ResumeWorkflowExecutionRequest{WorkflowExecution: execution},
+		})
+		return err
+	}
+
+	cases := []struct {
+		name           string
+		state          int
+		paused         bool
+		call           func(e engine.Engine) error
+		expectedPaused bool
+		// expectedTasks is whether the update must generate tasks again
+		expectedTasks bool
+		expectedErr   error
+	}{
+		{
+			name:        "pause completed workflow",
+			state:       persistence.WorkflowStateCompleted,
+			call:        pause,
+			expectedErr: workflow.ErrAlreadyCompleted,
+		},
+		{
+			name:           "pause",
+			call:           pause,
+			expectedPaused: true,
+		},
+		{
+			name:           "pause already paused workflow",
+			paused:         true,
+			call:           pause,
+			expectedPaused: true,
+		},
+		{
+			name:        "resume completed workflow",
+			state:       persistence.WorkflowStateCompleted,
+			paused:      true,
+			call:        resume,
+			expectedErr: workflow.ErrAlreadyCompleted,
+		},
+		{
+			name:           "resume",
+			paused:         true,
+			call:           resume,
+			expectedPaused: false,
+			expectedTasks:  true,
+		},
+		{
+			name:        "resume workflow not paused",
+			call:        resume,
+			expectedErr: workflow.ErrWorkflowNotPaused,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+
+			eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.GetWorkflowExecutionRequest) bool {
+				return req.Execution == *execution
+			})).Return(&persistence.GetWorkflowExecutionResponse{
+				State: &persistence.WorkflowMutableState{
+					ExecutionInfo: &persistence.WorkflowExecutionInfo{
+						DomainID:   constants.TestDomainID,
+						WorkflowID: execution.WorkflowID,
+						RunID:      execution.RunID,
+						State:      testCase.state,
+						Paused:     testCase.paused,
+					},
+					ExecutionStats: &persistence.ExecutionStats{},
+					Checksum:       checksum.Checksum{},
+				},
+			}, nil)
+			eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, execution.WorkflowID, execution.RunID).
+				Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil).AnyTimes()
+			if testCase.expectedErr == nil {
+				eft.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+					if testCase.expectedTasks && len(req.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTransfer]) == 0 {
+						return false
+					}
+					return req.UpdateWorkflowMutation.ExecutionInfo.Paused == testCase.expectedPaused
+				})).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+			}
+
+			eft.Engine.Start()
+			err := testCase.call(eft.Engine)
+			eft.Engine.Stop()
+
+			if testCase.expectedErr == nil {
+				assert.NoError(t, err)
+				eft.ShardCtx.Resource.ExecutionMgr.AssertNumberOfCalls(t, "UpdateWorkflowExecution", 1)
+			} else {
+				assert.Equal(t, testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestPauseWorkflowExecutionGlobalDomain(t *testing.T) {
+	eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+	eft.ShardCtx.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestActiveActiveDomainID).Return(constants.TestActiveActiveDomainEntry, nil).AnyTimes()
+	eft.ShardCtx.Resource.DomainCache.EXPECT().GetDomainName(constants.TestActiveActiveDomainID).Return(constants.TestActiveActiveDomainName, nil).AnyTimes()
+	eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestActiveActiveDomainID, constants.TestWorkflowID, constants.TestRunID).
+		Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil).AnyTimes()
+	eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:   constants.TestActiveActiveDomainID,
+				WorkflowID: constants.TestWorkflowID,
+				RunID:      constants.TestRunID,
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+			Checksum:       checksum.Checksum{},
+		},
+	}, nil)
+	// the workflow is paused in the active cluster only, no event is written to its history
+	eft.ShardCtx.Resource.ExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+		return req.UpdateWorkflowMutation.ExecutionInfo.Paused
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+
+	eft.Engine.Start()
+	_, err := eft.Engine.PauseWorkflowExecution(context.Background(), &types.HistoryPauseWorkflowExecutionRequest{
+		DomainUUID: constants.TestActiveActiveDomainID,
+		Request: &types.PauseWorkflowExecutionRequest{
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID},
+		},
+	})
+	eft.Engine.Stop()
+
+	assert.NoError(t, err)
+	eft.ShardCtx.Resource.HistoryMgr.AssertNotCalled(t, "AppendHistoryNodes", mock.Anything, mock.Anything)
+}
@@ -64,8 +64,16 @@ type (
 		GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64) (*types.ReplicationMessages, error)
 		GetDLQReplicationMessages(ctx context.Context, taskInfos []*types.ReplicationTaskInfo) ([]*types.ReplicationTask, error)
 		QueryWorkflow(ctx context.Context, request *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
+		PauseWorkflowExecution(ctx context.Context, request *types.HistoryPauseWorkflowExecutionRequest) (*types.PauseWorkflowExecutionResponse, error)
+		ResumeWorkflowExecution(ctx context.Context, request *types.HistoryResumeWorkflowExecutionRequest) (*types.ResumeWorkflowExecutionResponse, error)
+		PauseActivity(ctx context.Context, request *types.HistoryPauseActivityRequest) (*types.PauseActivityResponse, error)
+		UnpauseActivity(ctx context.Context, request *types.HistoryUnpauseActivityRequest) (*types.UnpauseActivityResponse, error)
+		ResetActivity(ctx context.Context, request *types.HistoryResetActivityRequest) (*types.ResetActivityResponse, error)
+		UpdateActivityOptions(ctx context.Context, request *types.HistoryUpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error)
 		ReapplyEvents(ctx context.Context, domainUUID string, workflowID string, runID string, events []*types.HistoryEvent) error
 		CountDLQMessages(ctx context.Context, forceFetch bool) (map[string]int64, error)
+		GetDomainReplicationStatus(ctx context.Context, domainID string) (map[string]*types.ReplicationClusterStatus, error)
+		UpdateDomainIsolation(ctx context.Context, domainID string, isolated bool) error
 		ReadDLQMessages(ctx context.Context, messagesRequest *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 		PurgeDLQMessages(ctx context.Context, messagesRequest *types.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
@@ -109,6 +109,15 @@ type (
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
 		RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte, failureOptions *types.FailureOptions) (bool, error)
+		PauseActivity(ai *persistence.ActivityInfo) error
+		UnpauseActivity(ai *persistence.ActivityInfo, resetAttempts bool) error
+		ResetActivity(ai *persistence.ActivityInfo) error
+		UpdateActivityOptions(ai *persistence.ActivityInfo, options *types.ActivityOptions) error
+		PauseWorkflowExecution() error
+		ResumeWorkflowExecution() error
+		ClearWorkflowPause() error
+		SuppressActivityDispatch(ai *persistence.ActivityInfo)
+		SuppressDecisionDispatch()
 		CreateNewHistoryEvent(eventType types.EventType) *types.HistoryEvent
 		CreateNewHistoryEventWithTimestamp(eventType types.EventType, timestamp int64) *types.HistoryEvent
 		CreateTransientDecisionEvents(di *DecisionInfo, identity string) (*types.HistoryEvent, *types.HistoryEvent)
@@ -165,6 +174,7 @@ type (
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
 		IsWorkflowExecutionRunning() bool
+		IsWorkflowExecutionPaused() bool
 		IsWorkflowCompleted() bool
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
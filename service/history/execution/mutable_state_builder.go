@@ -81,6 +81,8 @@ var (
 	ErrMissingVersionHistories = &types.BadRequestError{Message: "versionHistories is empty, which is required for NDC feature. It's probably from deprecated 2dc workflows"}
 	// ErrTooManyPendingActivities is the error that currently there are too many pending activities in the workflow
 	ErrTooManyPendingActivities = &types.InternalServiceError{Message: "Too many pending activities"}
+	// ErrActivityAttemptRunning is the error that the attempts of an activity cannot be reset while one of them is running
+	ErrActivityAttemptRunning = &types.BadRequestError{Message: "Activity attempt is running, attempts can be reset once it is closed"}
 )
 
 type (
@@ -1101,6 +1103,10 @@ func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 	return e.executionInfo.IsRunning()
 }
 
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	return e.executionInfo.Paused
+}
+
 func (e *mutableStateBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	decisionCompletedEventID int64,
 	request *types.UpsertWorkflowSearchAttributesDecisionAttributes,
@@ -102,6 +102,9 @@ func (e *mutableStateBuilder) ReplicateActivityInfo(
 	ai.LastFailureDetails = request.GetLastFailureDetails()
 	ai.LastFailureCategory = request.GetLastFailureOptions().GetFailureCategory()
 	ai.LastRetryIntervalSeconds = request.GetLastFailureOptions().GetNextRetryIntervalSeconds()
+	// the paused flag is not replicated, a pause made while this cluster was active
+	// doesn't apply to the activity state of the cluster which is active now
+	ai.Paused = false
 
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = TimerTaskStatusNone
@@ -725,19 +728,180 @@ func (e *mutableStateBuilder) RetryActivity(
 	ai.RequestID = ""
 	ai.StartedTime = time.Time{}
 	ai.TimerTaskStatus = TimerTaskStatusNone
+	ai.DispatchSuppressed = false
 	ai.LastFailureReason = failureReason
 	ai.LastWorkerIdentity = ai.StartedIdentity
 	ai.LastFailureDetails = failureDetails
 	ai.LastFailureCategory = failureOptions.GetFailureCategory()
 	ai.LastRetryIntervalSeconds = failureOptions.GetNextRetryIntervalSeconds()
 
-	if err := e.taskGenerator.GenerateActivityRetryTasks(
-		ai.ScheduleID,
-	); err != nil {
-		return false, err
+	// the next attempt of a paused activity is scheduled when it is unpaused
+	if !ai.Paused {
+		if err := e.taskGenerator.GenerateActivityRetryTasks(
+			ai.ScheduleID,
+		); err != nil {
+			return false, err
+		}
 	}
 
 	e.updateActivityInfos[ai.ScheduleID] = ai
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
 	return true, nil
 }
+
+// PauseActivity stops new attempts of an activity from being dispatched,
+// an attempt which is already running is not affected
+func (e *mutableStateBuilder) PauseActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskPause
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = true
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// UnpauseActivity resumes dispatching a paused activity, an activity waiting
+// for its next attempt is dispatched right away
+func (e *mutableStateBuilder) UnpauseActivity(
+	ai *persistence.ActivityInfo,
+	resetAttempts bool,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskUnpause
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+	if resetAttempts && ai.StartedID != constants.EmptyEventID {
+		return ErrActivityAttemptRunning
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = false
+	if resetAttempts {
+		ai.Attempt = 0
+	}
+	if ai.StartedID == constants.EmptyEventID {
+		if err := e.rescheduleActivity(ai); err != nil {
+			return err
+		}
+	}
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// ResetActivity resets the attempt count and the retry backoff of an activity,
+// an activity waiting for its next attempt is dispatched right away
+func (e *mutableStateBuilder) ResetActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskReset
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+	if ai.StartedID != constants.EmptyEventID {
+		return ErrActivityAttemptRunning
+	}
+
+	previousAttempt := ai.Attempt
+	ai.Version = e.GetCurrentVersion()
+	ai.Attempt = 0
+	// the first attempt is dispatched when the activity is scheduled, only a
+	// retry can be waiting for its backoff
+	if !ai.Paused && previousAttempt > 0 {
+		if err := e.rescheduleActivity(ai); err != nil {
+			return err
+		}
+	}
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// UpdateActivityOptions changes the options of a pending activity. New timeouts apply to
+// the current attempt, an attempt waiting in its task list is dispatched again when the
+// task list changes
+func (e *mutableStateBuilder) UpdateActivityOptions(
+	ai *persistence.ActivityInfo,
+	options *types.ActivityOptions,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskOptionsUpdate
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	previousTaskList := ai.TaskList
+	ai.Version = e.GetCurrentVersion()
+	if options.GetTaskList() != nil {
+		ai.TaskList = options.GetTaskList().GetName()
+		if options.GetTaskList().Kind != nil {
+			ai.TaskListKind = options.GetTaskList().GetKind()
+		}
+	}
+	if options.ScheduleToCloseTimeoutSeconds != nil {
+		ai.ScheduleToCloseTimeout = options.GetScheduleToCloseTimeoutSeconds()
+	}
+	if options.ScheduleToStartTimeoutSeconds != nil {
+		ai.ScheduleToStartTimeout = options.GetScheduleToStartTimeoutSeconds()
+	}
+	if options.StartToCloseTimeoutSeconds != nil {
+		ai.StartToCloseTimeout = options.GetStartToCloseTimeoutSeconds()
+	}
+	if options.HeartbeatTimeoutSeconds != nil {
+		ai.HeartbeatTimeout = options.GetHeartbeatTimeoutSeconds()
+	}
+	if retryPolicy := options.GetRetryPolicy(); retryPolicy != nil {
+		ai.HasRetryPolicy = true
+		ai.InitialInterval = retryPolicy.GetInitialIntervalInSeconds()
+		ai.BackoffCoefficient = retryPolicy.GetBackoffCoefficient()
+		ai.MaximumInterval = retryPolicy.GetMaximumIntervalInSeconds()
+		ai.MaximumAttempts = retryPolicy.GetMaximumAttempts()
+		ai.NonRetriableErrors = retryPolicy.NonRetriableErrorReasons
+		// the expiration interval of the new policy counts from the update
+		ai.ExpirationTime = time.Time{}
+		if retryPolicy.GetExpirationIntervalInSeconds() != 0 {
+			ai.ExpirationTime = e.timeSource.Now().Add(time.Duration(retryPolicy.GetExpirationIntervalInSeconds()) * time.Second)
+		}
+	}
+	// timer tasks are created again from the new timeouts
+	ai.TimerTaskStatus = TimerTaskStatusNone
+
+	// a started attempt is not affected by the task list and an attempt waiting for its
+	// retry backoff or a paused one reads the task list when it is dispatched
+	if ai.TaskList != previousTaskList &&
+		!ai.Paused &&
+		ai.StartedID == constants.EmptyEventID &&
+		!ai.ScheduledTime.After(e.timeSource.Now()) {
+		if err := e.rescheduleActivity(ai); err != nil {
+			return err
+		}
+	}
+
+	e.updateActivityInfos[ai.ScheduleID] = ai
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return e.taskGenerator.GenerateActivityTimerTasks()
+}
+
+func (e *mutableStateBuilder) rescheduleActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	ai.ScheduledTime = e.timeSource.Now()
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	ai.DispatchSuppressed = false
+	return e.taskGenerator.GenerateActivityRetryTasks(
+		ai.ScheduleID,
+	)
+}
@@ -266,7 +266,7 @@ func Test__ReplicateActivityInfo(t *testing.T) {
 			NextRetryIntervalSeconds: common.Int32Ptr(100),
 		},
 	}
-	ai := &persistence.ActivityInfo{}
+	ai := &persistence.ActivityInfo{Paused: true}
 
 	err := mb.ReplicateActivityInfo(request, true)
 	assert.Error(t, err)
@@ -281,6 +281,7 @@ func Test__ReplicateActivityInfo(t *testing.T) {
 	assert.Equal(t, now.UTC(), ai.LastHeartBeatUpdatedTime.UTC())
 	assert.Equal(t, request.LastFailureOptions.GetFailureCategory(), ai.LastFailureCategory)
 	assert.Equal(t, request.LastFailureOptions.GetNextRetryIntervalSeconds(), ai.LastRetryIntervalSeconds)
+	assert.False(t, ai.Paused)
 }
 
 func Test__UpdateActivity(t *testing.T) {
@@ -654,3 +655,237 @@ func Test__AddActivityTaskFailedEvent(t *testing.T) {
 		assert.Equal(t, int32(200), event.ActivityTaskFailedEventAttributes.FailureOptions.GetNextRetryIntervalSeconds())
 	})
 }
+
+func Test__RetryActivity_Paused(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ai := &persistence.ActivityInfo{
+		ScheduleID:         1,
+		StartedID:          2,
+		HasRetryPolicy:     true,
+		MaximumAttempts:    10,
+		InitialInterval:    1,
+		BackoffCoefficient: 2,
+		Paused:             true,
+	}
+	mb.pendingActivityInfoIDs[1] = ai
+
+	retried, err := mb.RetryActivity(ai, "some reason", nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, retried)
+	assert.Equal(t, int32(1), ai.Attempt)
+	assert.Equal(t, commonconstants.EmptyEventID, ai.StartedID)
+	assert.Empty(t, mb.insertTimerTasks)
+	assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+}
+
+func Test__PauseActivity(t *testing.T) {
+	t.Run("error workflow finished", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.executionInfo.State = persistence.WorkflowStateCompleted
+		err := mb.PauseActivity(&persistence.ActivityInfo{ScheduleID: 1})
+		assert.Equal(t, ErrWorkflowFinished, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{Version: 1, ScheduleID: 1, StartedID: commonconstants.EmptyEventID}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.PauseActivity(ai)
+		assert.NoError(t, err)
+		assert.True(t, ai.Paused)
+		assert.Equal(t, commonconstants.EmptyVersion, ai.Version)
+		assert.Empty(t, mb.insertTimerTasks)
+		assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+		assert.NotNil(t, mb.syncActivityTasks[ai.ScheduleID])
+	})
+}
+
+func Test__UnpauseActivity(t *testing.T) {
+	t.Run("error attempt running", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{ScheduleID: 1, StartedID: 2, Attempt: 3, Paused: true}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.UnpauseActivity(ai, true)
+		assert.Equal(t, ErrActivityAttemptRunning, err)
+		assert.True(t, ai.Paused)
+	})
+	t.Run("running attempt", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{ScheduleID: 1, StartedID: 2, Attempt: 3, Paused: true}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.UnpauseActivity(ai, false)
+		assert.NoError(t, err)
+		assert.False(t, ai.Paused)
+		assert.Equal(t, int32(3), ai.Attempt)
+		assert.Empty(t, mb.insertTimerTasks)
+	})
+	t.Run("waiting attempt is rescheduled", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{
+			ScheduleID:      1,
+			StartedID:       commonconstants.EmptyEventID,
+			ScheduledTime:   currentTime.Add(time.Hour),
+			Attempt:         3,
+			TimerTaskStatus: TimerTaskStatusCreatedScheduleToStart,
+			Paused:          true,
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.UnpauseActivity(ai, true)
+		assert.NoError(t, err)
+		assert.False(t, ai.Paused)
+		assert.Equal(t, int32(0), ai.Attempt)
+		assert.Equal(t, currentTime, ai.ScheduledTime)
+		assert.Equal(t, int32(TimerTaskStatusNone), ai.TimerTaskStatus)
+		assert.Len(t, mb.insertTimerTasks, 1)
+		assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+	})
+}
+
+func Test__ResetActivity(t *testing.T) {
+	t.Run("error attempt running", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{ScheduleID: 1, StartedID: 2, Attempt: 3}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.ResetActivity(ai)
+		assert.Equal(t, ErrActivityAttemptRunning, err)
+		assert.Equal(t, int32(3), ai.Attempt)
+	})
+	t.Run("waiting attempt is rescheduled", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{
+			ScheduleID:    1,
+			StartedID:     commonconstants.EmptyEventID,
+			ScheduledTime: currentTime.Add(time.Hour),
+			Attempt:       3,
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.ResetActivity(ai)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), ai.Attempt)
+		assert.Equal(t, currentTime, ai.ScheduledTime)
+		assert.Len(t, mb.insertTimerTasks, 1)
+		assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+	})
+	t.Run("paused attempt waits for unpause", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{
+			ScheduleID: 1,
+			StartedID:  commonconstants.EmptyEventID,
+			Attempt:    3,
+			Paused:     true,
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.ResetActivity(ai)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), ai.Attempt)
+		assert.True(t, ai.Paused)
+		assert.Empty(t, mb.insertTimerTasks)
+	})
+}
+
+func Test__UpdateActivityOptions(t *testing.T) {
+	t.Run("error workflow finished", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.executionInfo.State = persistence.WorkflowStateCompleted
+		err := mb.UpdateActivityOptions(&persistence.ActivityInfo{ScheduleID: 1}, &types.ActivityOptions{})
+		assert.Equal(t, ErrWorkflowFinished, err)
+	})
+	t.Run("timeouts and retry policy", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{
+			ScheduleID:             1,
+			ScheduledTime:          currentTime,
+			StartedID:              2,
+			StartedTime:            currentTime,
+			ScheduleToCloseTimeout: 120,
+			StartToCloseTimeout:    30,
+			TimerTaskStatus:        TimerTaskStatusCreatedStartToClose,
+			TaskList:               "taskList",
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+		options := &types.ActivityOptions{
+			StartToCloseTimeoutSeconds: common.Int32Ptr(60),
+			HeartbeatTimeoutSeconds:    common.Int32Ptr(10),
+			RetryPolicy: &types.RetryPolicy{
+				InitialIntervalInSeconds:    1,
+				BackoffCoefficient:          2,
+				MaximumAttempts:             5,
+				ExpirationIntervalInSeconds: 100,
+			},
+		}
+
+		err := mb.UpdateActivityOptions(ai, options)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(60), ai.StartToCloseTimeout)
+		assert.Equal(t, int32(10), ai.HeartbeatTimeout)
+		assert.True(t, ai.HasRetryPolicy)
+		assert.Equal(t, int32(5), ai.MaximumAttempts)
+		assert.Equal(t, currentTime.Add(100*time.Second), ai.ExpirationTime)
+		assert.Equal(t, "taskList", ai.TaskList)
+		// the heartbeat timer now fires first and is created right away
+		assert.Equal(t, int32(TimerTaskStatusCreatedHeartbeat), ai.TimerTaskStatus)
+		assert.Len(t, mb.insertTimerTasks, 1)
+		timerTask, ok := mb.insertTimerTasks[0].(*persistence.ActivityTimeoutTask)
+		assert.True(t, ok)
+		assert.Equal(t, int(types.TimeoutTypeHeartbeat), timerTask.TimeoutType)
+		assert.Equal(t, currentTime.Add(10*time.Second), timerTask.VisibilityTimestamp)
+		assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+	})
+	t.Run("dispatched attempt moves to the new task list", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{
+			ScheduleID:             1,
+			StartedID:              commonconstants.EmptyEventID,
+			ScheduledTime:          currentTime.Add(-time.Minute),
+			ScheduleToStartTimeout: 10,
+			ScheduleToCloseTimeout: 100,
+			TaskList:               "taskList",
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.UpdateActivityOptions(ai, &types.ActivityOptions{
+			TaskList: &types.TaskList{Name: "newTaskList"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "newTaskList", ai.TaskList)
+		assert.Equal(t, currentTime, ai.ScheduledTime)
+		// the attempt is dispatched again and its schedule to start timeout starts over
+		assert.Len(t, mb.insertTimerTasks, 2)
+		retryTask, ok := mb.insertTimerTasks[0].(*persistence.ActivityRetryTimerTask)
+		assert.True(t, ok)
+		assert.Equal(t, "newTaskList", retryTask.TaskList)
+		timerTask, ok := mb.insertTimerTasks[1].(*persistence.ActivityTimeoutTask)
+		assert.True(t, ok)
+		assert.Equal(t, int(types.TimeoutTypeScheduleToStart), timerTask.TimeoutType)
+		assert.Equal(t, currentTime.Add(10*time.Second), timerTask.VisibilityTimestamp)
+	})
+	t.Run("attempt waiting for backoff reads the new task list when dispatched", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		ai := &persistence.ActivityInfo{
+			ScheduleID:             1,
+			StartedID:              commonconstants.EmptyEventID,
+			ScheduledTime:          currentTime.Add(time.Minute),
+			ScheduleToStartTimeout: 10,
+			ScheduleToCloseTimeout: 100,
+			Attempt:                2,
+			TaskList:               "taskList",
+		}
+		mb.pendingActivityInfoIDs[1] = ai
+
+		err := mb.UpdateActivityOptions(ai, &types.ActivityOptions{
+			TaskList: &types.TaskList{Name: "newTaskList"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "newTaskList", ai.TaskList)
+		assert.Equal(t, currentTime.Add(time.Minute), ai.ScheduledTime)
+		for _, task := range mb.insertTimerTasks {
+			assert.IsType(t, &persistence.ActivityTimeoutTask{}, task, "the attempt is not dispatched again")
+		}
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"strconv"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// PauseWorkflowExecution stops new decision and activity tasks of the workflow from being
+// dispatched, tasks which are already running are not affected
+func (e *mutableStateBuilder) PauseWorkflowExecution() error {
+
+	opTag := tag.WorkflowActionWorkflowPause
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	e.executionInfo.Paused = true
+	return e.upsertPausedSearchAttribute()
+}
+
+// ResumeWorkflowExecution dispatches the pending decision and activities of a paused
+// workflow again, only the tasks which were dropped while it was paused are generated again
+func (e *mutableStateBuilder) ResumeWorkflowExecution() error {
+
+	opTag := tag.WorkflowActionWorkflowResume
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	e.executionInfo.Paused = false
+	if err := e.upsertPausedSearchAttribute(); err != nil {
+		return err
+	}
+
+	decisionSuppressed := e.executionInfo.DecisionDispatchSuppressed
+	e.executionInfo.DecisionDispatchSuppressed = false
+	if decision, ok := e.GetPendingDecision(); ok && decisionSuppressed && decision.StartedID == constants.EmptyEventID {
+		// schedule to start timeout counts from the resume
+		decision.ScheduledTimestamp = e.timeSource.Now().UnixNano()
+		e.UpdateDecision(decision)
+		if err := e.taskGenerator.GenerateDecisionScheduleTasks(decision.ScheduleID); err != nil {
+			return err
+		}
+	}
+
+	for _, ai := range e.pendingActivityInfoIDs {
+		if !ai.DispatchSuppressed {
+			// the task of the activity is still pending and dispatches the activity
+			continue
+		}
+		ai.DispatchSuppressed = false
+		e.updateActivityInfos[ai.ScheduleID] = ai
+		if ai.StartedID != constants.EmptyEventID || ai.Paused {
+			continue
+		}
+		if err := e.rescheduleActivity(ai); err != nil {
+			return err
+		}
+	}
+	// the timeouts of the activities which are not started were not created while the workflow was paused
+	return e.taskGenerator.GenerateActivityTimerTasks()
+}
+
+// ClearWorkflowPause drops the paused state of a workflow which turned passive, the
+// paused state is not replicated and doesn't apply to the cluster which is active now
+func (e *mutableStateBuilder) ClearWorkflowPause() error {
+
+	if !e.executionInfo.Paused && !e.executionInfo.DecisionDispatchSuppressed {
+		return nil
+	}
+
+	e.executionInfo.Paused = false
+	e.executionInfo.DecisionDispatchSuppressed = false
+	for _, ai := range e.pendingActivityInfoIDs {
+		if ai.DispatchSuppressed {
+			ai.DispatchSuppressed = false
+			e.updateActivityInfos[ai.ScheduleID] = ai
+		}
+	}
+	return e.upsertPausedSearchAttribute()
+}
+
+// SuppressDecisionDispatch records that a task of the pending decision was dropped
+// because the workflow is paused, the decision is dispatched again when it is resumed
+func (e *mutableStateBuilder) SuppressDecisionDispatch() {
+	e.executionInfo.DecisionDispatchSuppressed = true
+}
+
+// SuppressActivityDispatch records that a task of the activity was dropped because
+// the workflow is paused, the activity is dispatched again when it is resumed
+func (e *mutableStateBuilder) SuppressActivityDispatch(
+	ai *persistence.ActivityInfo,
+) {
+	ai.DispatchSuppressed = true
+	e.updateActivityInfos[ai.ScheduleID] = ai
+}
+
+func (e *mutableStateBuilder) upsertPausedSearchAttribute() error {
+	e.executionInfo.SearchAttributes = mergeMapOfByteArray(
+		e.executionInfo.SearchAttributes,
+		map[string][]byte{definition.CadenceWorkflowPaused: []byte(strconv.FormatBool(e.executionInfo.Paused))},
+	)
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func Test__PauseWorkflowExecution(t *testing.T) {
+	t.Run("error workflow finished", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.executionInfo.State = persistence.WorkflowStateCompleted
+		err := mb.PauseWorkflowExecution()
+		assert.Equal(t, ErrWorkflowFinished, err)
+		assert.False(t, mb.IsWorkflowExecutionPaused())
+	})
+	t.Run("success", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+
+		err := mb.PauseWorkflowExecution()
+		assert.NoError(t, err)
+		assert.True(t, mb.IsWorkflowExecutionPaused())
+		assert.Equal(t, []byte("true"), mb.executionInfo.SearchAttributes[definition.CadenceWorkflowPaused])
+		assert.Len(t, mb.insertTransferTasks, 1)
+	})
+}
+
+func Test__ResumeWorkflowExecution(t *testing.T) {
+	t.Run("error workflow finished", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.executionInfo.Paused = true
+		mb.executionInfo.State = persistence.WorkflowStateCompleted
+		err := mb.ResumeWorkflowExecution()
+		assert.Equal(t, ErrWorkflowFinished, err)
+		assert.True(t, mb.IsWorkflowExecutionPaused())
+	})
+	t.Run("suppressed decision is dispatched again", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.executionInfo.Paused = true
+		mb.executionInfo.DecisionDispatchSuppressed = true
+		mb.executionInfo.DecisionScheduleID = 5
+		mb.executionInfo.DecisionStartedID = commonconstants.EmptyEventID
+
+		err := mb.ResumeWorkflowExecution()
+		assert.NoError(t, err)
+		assert.False(t, mb.executionInfo.DecisionDispatchSuppressed)
+		assert.Equal(t, currentTime.UnixNano(), mb.executionInfo.DecisionScheduledTimestamp)
+		// the search attribute upsert and the decision
+		assert.Len(t, mb.insertTransferTasks, 2)
+	})
+	t.Run("decision which was not suppressed is not dispatched again", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.executionInfo.Paused = true
+		mb.executionInfo.DecisionScheduleID = 5
+		mb.executionInfo.DecisionStartedID = commonconstants.EmptyEventID
+
+		err := mb.ResumeWorkflowExecution()
+		assert.NoError(t, err)
+		assert.Len(t, mb.insertTransferTasks, 1)
+	})
+	t.Run("only suppressed activities are dispatched again", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.executionInfo.Paused = true
+		pending := &persistence.ActivityInfo{
+			ScheduleID:    1,
+			StartedID:     commonconstants.EmptyEventID,
+			ScheduledTime: currentTime.Add(-time.Hour),
+		}
+		suppressed := &persistence.ActivityInfo{
+			ScheduleID:         2,
+			StartedID:          commonconstants.EmptyEventID,
+			ScheduledTime:      currentTime.Add(-time.Hour),
+			DispatchSuppressed: true,
+		}
+		paused := &persistence.ActivityInfo{
+			ScheduleID:         3,
+			StartedID:          commonconstants.EmptyEventID,
+			Paused:             true,
+			DispatchSuppressed: true,
+		}
+		for _, ai := range []*persistence.ActivityInfo{pending, suppressed, paused} {
+			mb.pendingActivityInfoIDs[ai.ScheduleID] = ai
+		}
+
+		err := mb.ResumeWorkflowExecution()
+		assert.NoError(t, err)
+		assert.False(t, mb.IsWorkflowExecutionPaused())
+		assert.Equal(t, []byte("false"), mb.executionInfo.SearchAttributes[definition.CadenceWorkflowPaused])
+		assert.Equal(t, currentTime.Add(-time.Hour), pending.ScheduledTime)
+		assert.Equal(t, currentTime, suppressed.ScheduledTime)
+		assert.False(t, suppressed.DispatchSuppressed)
+		assert.Equal(t, suppressed, mb.updateActivityInfos[suppressed.ScheduleID])
+		assert.False(t, paused.DispatchSuppressed)
+		assert.Equal(t, paused, mb.updateActivityInfos[paused.ScheduleID])
+		assert.True(t, paused.ScheduledTime.IsZero())
+	})
+}
+
+func Test__ClearWorkflowPause(t *testing.T) {
+	t.Run("workflow not paused", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+
+		err := mb.ClearWorkflowPause()
+		assert.NoError(t, err)
+		assert.Empty(t, mb.insertTransferTasks)
+	})
+	t.Run("paused workflow", func(t *testing.T) {
+		mb := testMutableStateBuilder(t)
+		mb.executionInfo.Paused = true
+		mb.executionInfo.DecisionDispatchSuppressed = true
+		suppressed := &persistence.ActivityInfo{ScheduleID: 1, StartedID: commonconstants.EmptyEventID, DispatchSuppressed: true}
+		mb.pendingActivityInfoIDs[suppressed.ScheduleID] = suppressed
+
+		err := mb.ClearWorkflowPause()
+		assert.NoError(t, err)
+		assert.False(t, mb.IsWorkflowExecutionPaused())
+		assert.False(t, mb.executionInfo.DecisionDispatchSuppressed)
+		assert.False(t, suppressed.DispatchSuppressed)
+		assert.Equal(t, suppressed, mb.updateActivityInfos[suppressed.ScheduleID])
+		assert.Equal(t, []byte("false"), mb.executionInfo.SearchAttributes[definition.CadenceWorkflowPaused])
+		// only the search attribute upsert, the tasks are dispatched by the active cluster
+		assert.Len(t, mb.insertTransferTasks, 1)
+	})
+}
+
+func Test__SuppressDispatch(t *testing.T) {
+	mb := testMutableStateBuilder(t)
+	ai := &persistence.ActivityInfo{ScheduleID: 1, StartedID: commonconstants.EmptyEventID}
+	mb.pendingActivityInfoIDs[ai.ScheduleID] = ai
+
+	mb.SuppressDecisionDispatch()
+	mb.SuppressActivityDispatch(ai)
+	assert.True(t, mb.executionInfo.DecisionDispatchSuppressed)
+	assert.True(t, ai.DispatchSuppressed)
+	assert.Equal(t, ai, mb.updateActivityInfos[ai.ScheduleID])
+}
@@ -14,14 +14,13 @@ import (
 	reflect "reflect"
 	time "time"
 
-	gomock "go.uber.org/mock/gomock"
-
 	cache "github.com/uber/cadence/common/cache"
 	checksum "github.com/uber/cadence/common/checksum"
 	definition "github.com/uber/cadence/common/definition"
 	persistence "github.com/uber/cadence/common/persistence"
 	types "github.com/uber/cadence/common/types"
 	query "github.com/uber/cadence/service/history/query"
+	gomock "go.uber.org/mock/gomock"
 )
 
 // MockMutableState is a mock of MutableState interface.
@@ -830,6 +829,20 @@ func (mr *MockMutableStateMockRecorder) ClearStickyness() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearStickyness", reflect.TypeOf((*MockMutableState)(nil).ClearStickyness))
 }
 
+// ClearWorkflowPause mocks base method.
+func (m *MockMutableState) ClearWorkflowPause() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearWorkflowPause")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearWorkflowPause indicates an expected call of ClearWorkflowPause.
+func (mr *MockMutableStateMockRecorder) ClearWorkflowPause() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearWorkflowPause", reflect.TypeOf((*MockMutableState)(nil).ClearWorkflowPause))
+}
+
 // CloseTransactionAsMutation mocks base method.
 func (m *MockMutableState) CloseTransactionAsMutation(now time.Time, transactionPolicy TransactionPolicy) (*persistence.WorkflowMutation, []*persistence.WorkflowEvents, error) {
 	m.ctrl.T.Helper()
@@ -1769,6 +1782,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowCompleted() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowCompleted", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowCompleted))
 }
 
+// IsWorkflowExecutionPaused mocks base method.
+func (m *MockMutableState) IsWorkflowExecutionPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionPaused indicates an expected call of IsWorkflowExecutionPaused.
+func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionPaused", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionPaused))
+}
+
 // IsWorkflowExecutionRunning mocks base method.
 func (m *MockMutableState) IsWorkflowExecutionRunning() bool {
 	m.ctrl.T.Helper()
@@ -1795,6 +1822,34 @@ func (mr *MockMutableStateMockRecorder) Load(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockMutableState)(nil).Load), arg0, arg1)
 }
 
+// PauseActivity mocks base method.
+func (m *MockMutableState) PauseActivity(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockMutableStateMockRecorder) PauseActivity(ai any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockMutableState)(nil).PauseActivity), ai)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockMutableState) PauseWorkflowExecution() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockMutableStateMockRecorder) PauseWorkflowExecution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockMutableState)(nil).PauseWorkflowExecution))
+}
+
 // ReplicateActivityInfo mocks base method.
 func (m *MockMutableState) ReplicateActivityInfo(arg0 *types.SyncActivityRequest, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -2376,6 +2431,34 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionTimedoutEvent(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionTimedoutEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionTimedoutEvent), arg0, arg1)
 }
 
+// ResetActivity mocks base method.
+func (m *MockMutableState) ResetActivity(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivity indicates an expected call of ResetActivity.
+func (mr *MockMutableStateMockRecorder) ResetActivity(ai any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockMutableState)(nil).ResetActivity), ai)
+}
+
+// ResumeWorkflowExecution mocks base method.
+func (m *MockMutableState) ResumeWorkflowExecution() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution.
+func (mr *MockMutableStateMockRecorder) ResumeWorkflowExecution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockMutableState)(nil).ResumeWorkflowExecution))
+}
+
 // RetryActivity mocks base method.
 func (m *MockMutableState) RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte, failureOptions *types.FailureOptions) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2496,6 +2579,44 @@ func (mr *MockMutableStateMockRecorder) StartTransaction(ctx, entry, incomingTas
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTransaction", reflect.TypeOf((*MockMutableState)(nil).StartTransaction), ctx, entry, incomingTaskVersion)
 }
 
+// SuppressActivityDispatch mocks base method.
+func (m *MockMutableState) SuppressActivityDispatch(ai *persistence.ActivityInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SuppressActivityDispatch", ai)
+}
+
+// SuppressActivityDispatch indicates an expected call of SuppressActivityDispatch.
+func (mr *MockMutableStateMockRecorder) SuppressActivityDispatch(ai any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressActivityDispatch", reflect.TypeOf((*MockMutableState)(nil).SuppressActivityDispatch), ai)
+}
+
+// SuppressDecisionDispatch mocks base method.
+func (m *MockMutableState) SuppressDecisionDispatch() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SuppressDecisionDispatch")
+}
+
+// SuppressDecisionDispatch indicates an expected call of SuppressDecisionDispatch.
+func (mr *MockMutableStateMockRecorder) SuppressDecisionDispatch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuppressDecisionDispatch", reflect.TypeOf((*MockMutableState)(nil).SuppressDecisionDispatch))
+}
+
+// UnpauseActivity mocks base method.
+func (m *MockMutableState) UnpauseActivity(ai *persistence.ActivityInfo, resetAttempts bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", ai, resetAttempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockMutableStateMockRecorder) UnpauseActivity(ai, resetAttempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockMutableState)(nil).UnpauseActivity), ai, resetAttempts)
+}
+
 // UpdateActivity mocks base method.
 func (m *MockMutableState) UpdateActivity(arg0 *persistence.ActivityInfo) error {
 	m.ctrl.T.Helper()
@@ -2510,6 +2631,20 @@ func (mr *MockMutableStateMockRecorder) UpdateActivity(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*MockMutableState)(nil).UpdateActivity), arg0)
 }
 
+// UpdateActivityOptions mocks base method.
+func (m *MockMutableState) UpdateActivityOptions(ai *persistence.ActivityInfo, options *types.ActivityOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", ai, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockMutableStateMockRecorder) UpdateActivityOptions(ai, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockMutableState)(nil).UpdateActivityOptions), ai, options)
+}
+
 // UpdateActivityProgress mocks base method.
 func (m *MockMutableState) UpdateActivityProgress(ai *persistence.ActivityInfo, request *types.RecordActivityTaskHeartbeatRequest) {
 	m.ctrl.T.Helper()
@@ -129,6 +129,8 @@ func CopyWorkflowExecutionInfo(t *testing.T, sourceInfo *persistence.WorkflowExe
 		CancelRequested:                    sourceInfo.CancelRequested,
 		CancelRequestID:                    sourceInfo.CancelRequestID,
 		CronSchedule:                       sourceInfo.CronSchedule,
+		Paused:                             sourceInfo.Paused,
+		DecisionDispatchSuppressed:         sourceInfo.DecisionDispatchSuppressed,
 		ClientLibraryVersion:               sourceInfo.ClientLibraryVersion,
 		ClientFeatureVersion:               sourceInfo.ClientFeatureVersion,
 		ClientImpl:                         sourceInfo.ClientImpl,
@@ -194,6 +196,8 @@ func CopyActivityInfo(t *testing.T, sourceInfo *persistence.ActivityInfo) *persi
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
 		LastFailureCategory:      sourceInfo.LastFailureCategory,
 		LastRetryIntervalSeconds: sourceInfo.LastRetryIntervalSeconds,
+		Paused:                   sourceInfo.Paused,
+		DispatchSuppressed:       sourceInfo.DispatchSuppressed,
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds: sourceInfo.LastHeartbeatTimeoutVisibilityInSeconds,
 	}
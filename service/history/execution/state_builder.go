@@ -104,6 +104,10 @@ func (b *stateBuilderImpl) ApplyEvents(
 
 	// need to clear the stickiness since workflow turned to passive
 	b.mutableState.ClearStickyness()
+	// the paused state is not replicated either, the cluster which is active now dispatches the tasks
+	if err := b.mutableState.ClearWorkflowPause(); err != nil {
+		return nil, err
+	}
 
 	historyLength := len(history)
 	for i, event := range history {
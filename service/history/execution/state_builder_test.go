@@ -96,6 +96,7 @@ func (s *stateBuilderSuite) SetupTest() {
 	s.logger = s.mockShard.GetLogger()
 
 	s.mockMutableState.EXPECT().GetVersionHistories().Return(persistence.NewVersionHistories(&persistence.VersionHistory{})).AnyTimes()
+	s.mockMutableState.EXPECT().ClearWorkflowPause().Return(nil).AnyTimes()
 
 	s.stateBuilder = NewStateBuilder(
 		s.mockShard,
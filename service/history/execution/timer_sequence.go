@@ -298,6 +298,11 @@ func (t *timerSequenceImpl) getActivityScheduleToStartTimeout(
 		return nil
 	}
 
+	// activity or workflow is paused, the timeout restarts when it is unpaused
+	if activityInfo.Paused || t.mutableState.IsWorkflowExecutionPaused() {
+		return nil
+	}
+
 	startTimeout := activityInfo.ScheduledTime.Add(
 		time.Duration(activityInfo.ScheduleToStartTimeout) * time.Second,
 	)
@@ -320,6 +325,11 @@ func (t *timerSequenceImpl) getActivityScheduleToCloseTimeout(
 		return nil
 	}
 
+	// activity or workflow is paused and the activity is not running, the timeout restarts when it is unpaused
+	if (activityInfo.Paused || t.mutableState.IsWorkflowExecutionPaused()) && activityInfo.StartedID == constants.EmptyEventID {
+		return nil
+	}
+
 	closeTimeout := activityInfo.ScheduledTime.Add(
 		time.Duration(activityInfo.ScheduleToCloseTimeout) * time.Second,
 	)
@@ -63,6 +63,7 @@ func (s *timerSequenceSuite) SetupTest() {
 
 	s.controller = gomock.NewController(s.T())
 	s.mockMutableState = NewMockMutableState(s.controller)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionPaused().Return(false).AnyTimes()
 
 	s.timerSequence = NewTimerSequence(s.mockMutableState).(*timerSequenceImpl)
 }
@@ -419,6 +420,53 @@ func (s *timerSequenceSuite) TestLoadAndSortActivityTimers_One_Scheduled_NotStar
 	}, TimerSequenceIDs)
 }
 
+func (s *timerSequenceSuite) TestLoadAndSortActivityTimers_One_Scheduled_NotStarted_Paused() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                  123,
+		ScheduleID:               234,
+		ScheduledTime:            now,
+		StartedID:                constants.EmptyEventID,
+		StartedTime:              time.Time{},
+		ActivityID:               "some random activity ID",
+		ScheduleToStartTimeout:   10,
+		ScheduleToCloseTimeout:   1000,
+		StartToCloseTimeout:      100,
+		HeartbeatTimeout:         1,
+		LastHeartBeatUpdatedTime: time.Time{},
+		TimerTaskStatus:          TimerTaskStatusCreatedScheduleToClose | TimerTaskStatusCreatedScheduleToStart,
+		Attempt:                  12,
+		Paused:                   true,
+	}
+	activityInfos := map[int64]*persistence.ActivityInfo{activityInfo.ScheduleID: activityInfo}
+	s.mockMutableState.EXPECT().GetPendingActivityInfos().Return(activityInfos).Times(1)
+
+	TimerSequenceIDs := s.timerSequence.LoadAndSortActivityTimers()
+	s.Empty(TimerSequenceIDs)
+}
+
+func (s *timerSequenceSuite) TestLoadAndSortActivityTimers_One_Scheduled_NotStarted_WorkflowPaused() {
+	now := time.Now()
+	activityInfo := &persistence.ActivityInfo{
+		Version:                123,
+		ScheduleID:             234,
+		ScheduledTime:          now,
+		StartedID:              constants.EmptyEventID,
+		ActivityID:             "some random activity ID",
+		ScheduleToStartTimeout: 10,
+		ScheduleToCloseTimeout: 1000,
+		StartToCloseTimeout:    100,
+		TimerTaskStatus:        TimerTaskStatusCreatedScheduleToClose | TimerTaskStatusCreatedScheduleToStart,
+		Attempt:                12,
+	}
+	mockMutableState := NewMockMutableState(s.controller)
+	mockMutableState.EXPECT().IsWorkflowExecutionPaused().Return(true).AnyTimes()
+	mockMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{activityInfo.ScheduleID: activityInfo}).Times(1)
+
+	TimerSequenceIDs := NewTimerSequence(mockMutableState).LoadAndSortActivityTimers()
+	s.Empty(TimerSequenceIDs)
+}
+
 func (s *timerSequenceSuite) TestLoadAndSortActivityTimers_One_Scheduled_Started_WithHeartbeatTimeout() {
 	now := time.Now()
 	activityInfo := &persistence.ActivityInfo{
@@ -1321,6 +1321,240 @@ func (h *handlerImpl) QueryWorkflow(
 	return resp, nil
 }
 
+// PauseActivity stops dispatching new attempts of a pending activity
+func (h *handlerImpl) PauseActivity(
+	ctx context.Context,
+	request *types.HistoryPauseActivityRequest,
+) (resp *types.PauseActivityResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	runID := request.GetRequest().GetWorkflowExecution().GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	resp, err2 := engine.PauseActivity(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
+// UnpauseActivity resumes dispatching a paused activity
+func (h *handlerImpl) UnpauseActivity(
+	ctx context.Context,
+	request *types.HistoryUnpauseActivityRequest,
+) (resp *types.UnpauseActivityResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUnpauseActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	runID := request.GetRequest().GetWorkflowExecution().GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	resp, err2 := engine.UnpauseActivity(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
+// ResetActivity resets the attempt count and retry backoff of a pending activity
+func (h *handlerImpl) ResetActivity(
+	ctx context.Context,
+	request *types.HistoryResetActivityRequest,
+) (resp *types.ResetActivityResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResetActivityScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	runID := request.GetRequest().GetWorkflowExecution().GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	resp, err2 := engine.ResetActivity(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
+// UpdateActivityOptions changes the timeouts, retry policy or task list of a pending activity
+func (h *handlerImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *types.HistoryUpdateActivityOptionsRequest,
+) (resp *types.UpdateActivityOptionsResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateActivityOptionsScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	runID := request.GetRequest().GetWorkflowExecution().GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	resp, err2 := engine.UpdateActivityOptions(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
+// PauseWorkflowExecution stops dispatching new decision and activity tasks of a workflow
+func (h *handlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryPauseWorkflowExecutionRequest,
+) (resp *types.PauseWorkflowExecutionResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryPauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	runID := request.GetRequest().GetWorkflowExecution().GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	resp, err2 := engine.PauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
+// ResumeWorkflowExecution dispatches the pending decision and activity tasks of a paused workflow
+func (h *handlerImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *types.HistoryResumeWorkflowExecutionRequest,
+) (resp *types.ResumeWorkflowExecutionResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryResumeWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowID := request.GetRequest().GetWorkflowExecution().GetWorkflowID()
+	runID := request.GetRequest().GetWorkflowExecution().GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	resp, err2 := engine.ResumeWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
 // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
 // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
 // child execution without creating the decision task and then calls this API after updating the mutable state of
@@ -1922,6 +2156,135 @@ func (h *handlerImpl) CountDLQMessages(
 	return &types.HistoryCountDLQMessagesResponse{Entries: entries}, h.error(err, scope, "", "", "")
 }
 
+// GetDomainReplicationStatus returns the replication status of the domain on each of the shards owned by the host
+func (h *handlerImpl) GetDomainReplicationStatus(
+	ctx context.Context,
+	request *types.HistoryGetDomainReplicationStatusRequest,
+) (resp *types.HistoryGetDomainReplicationStatusResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetDomainReplicationStatusScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	// a shard failing to report its status does not fail the request, it is returned in the failed shards
+	// so that the caller can still see the status of the other shards
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	resp = &types.HistoryGetDomainReplicationStatusResponse{
+		Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{},
+	}
+	for _, shardID := range h.controller.ShardIDs() {
+		shardID := shardID
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			status, err := h.getShardDomainReplicationStatus(ctx, shardID, domainID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				h.GetLogger().Warn("Failed to get domain replication status of shard",
+					tag.ShardID(int(shardID)), tag.WorkflowDomainID(domainID), tag.Error(err))
+				resp.FailedShards = append(resp.FailedShards, shardID)
+				return
+			}
+			for clusterName, clusterStatus := range status {
+				key := types.HistoryReplicationStatusKey{ShardID: shardID, ClusterName: clusterName}
+				resp.Entries[key] = clusterStatus
+			}
+		}()
+	}
+	wg.Wait()
+	return resp, nil
+}
+
+func (h *handlerImpl) getShardDomainReplicationStatus(
+	ctx context.Context,
+	shardID int32,
+	domainID string,
+) (status map[string]*types.ReplicationClusterStatus, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+
+	engine, err := h.controller.GetEngineForShard(int(shardID))
+	if err != nil {
+		return nil, err
+	}
+	return engine.GetDomainReplicationStatus(ctx, domainID)
+}
+
+// UpdateDomainIsolation isolates the history tasks of the domain into dedicated virtual queues,
+// or releases them back to the default virtual queue, on each of the shards owned by the host
+func (h *handlerImpl) UpdateDomainIsolation(
+	ctx context.Context,
+	request *types.HistoryUpdateDomainIsolationRequest,
+) (resp *types.HistoryUpdateDomainIsolationResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateDomainIsolationScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	// a shard failing to apply the change does not fail the request, it is returned in the failed shards
+	// so that the caller can retry, the change is idempotent
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	resp = &types.HistoryUpdateDomainIsolationResponse{}
+	for _, shardID := range h.controller.ShardIDs() {
+		shardID := shardID
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := h.updateShardDomainIsolation(ctx, shardID, domainID, request.GetIsolated())
+			if err == nil {
+				return
+			}
+			h.GetLogger().Warn("Failed to update domain isolation of shard",
+				tag.ShardID(int(shardID)), tag.WorkflowDomainID(domainID), tag.Error(err))
+			mu.Lock()
+			defer mu.Unlock()
+			resp.FailedShards = append(resp.FailedShards, shardID)
+		}()
+	}
+	wg.Wait()
+	return resp, nil
+}
+
+func (h *handlerImpl) updateShardDomainIsolation(
+	ctx context.Context,
+	shardID int32,
+	domainID string,
+	isolated bool,
+) (retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+
+	engine, err := h.controller.GetEngineForShard(int(shardID))
+	if err != nil {
+		return err
+	}
+	return engine.UpdateDomainIsolation(ctx, domainID, isolated)
+}
+
 // ReadDLQMessages reads replication DLQ messages
 func (h *handlerImpl) ReadDLQMessages(
 	ctx context.Context,
@@ -3188,6 +3188,178 @@ func (s *handlerSuite) TestCountDLQMessages() {
 	}
 }
 
+func (s *handlerSuite) TestGetDomainReplicationStatus() {
+	validInput := &types.HistoryGetDomainReplicationStatusRequest{
+		DomainUUID: testDomainID,
+	}
+
+	testInput := map[string]struct {
+		input         *types.HistoryGetDomainReplicationStatusRequest
+		expected      *types.HistoryGetDomainReplicationStatusResponse
+		expectedError bool
+		mockFn        func()
+	}{
+		"shutting down": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.handler.shuttingDown = int32(1)
+			},
+		},
+		"domain not set": {
+			input:         &types.HistoryGetDomainReplicationStatusRequest{},
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"cannot get engine": {
+			input: validInput,
+			expected: &types.HistoryGetDomainReplicationStatusResponse{
+				Entries:      map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{},
+				FailedShards: []int32{0},
+			},
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(gomock.Any()).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"getDomainReplicationStatus error on some shards": {
+			input: validInput,
+			expected: &types.HistoryGetDomainReplicationStatusResponse{
+				Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{
+					{ShardID: 0, ClusterName: "standby"}: {ClusterName: "standby", PendingTaskCount: 1},
+				},
+				FailedShards: []int32{1},
+			},
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0, 1}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(s.mockEngine, nil).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(1).Return(nil, errors.New("error")).Times(1)
+				s.mockEngine.EXPECT().GetDomainReplicationStatus(gomock.Any(), testDomainID).Return(map[string]*types.ReplicationClusterStatus{
+					"standby": {ClusterName: "standby", PendingTaskCount: 1},
+				}, nil).Times(1)
+			},
+		},
+		"getDomainReplicationStatus error": {
+			input: validInput,
+			expected: &types.HistoryGetDomainReplicationStatusResponse{
+				Entries:      map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{},
+				FailedShards: []int32{0},
+			},
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(gomock.Any()).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().GetDomainReplicationStatus(gomock.Any(), testDomainID).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"success": {
+			input: validInput,
+			expected: &types.HistoryGetDomainReplicationStatusResponse{
+				Entries: map[types.HistoryReplicationStatusKey]*types.ReplicationClusterStatus{
+					{ShardID: 0, ClusterName: "standby"}: {ClusterName: "standby", PendingTaskCount: 1},
+					{ShardID: 1, ClusterName: "standby"}: {ClusterName: "standby", PendingTaskCount: 1},
+				},
+			},
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0, 1}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(gomock.Any()).Return(s.mockEngine, nil).Times(2)
+				s.mockEngine.EXPECT().GetDomainReplicationStatus(gomock.Any(), testDomainID).DoAndReturn(
+					func(ctx context.Context, domainID string) (map[string]*types.ReplicationClusterStatus, error) {
+						return map[string]*types.ReplicationClusterStatus{
+							"standby": {ClusterName: "standby", PendingTaskCount: 1},
+						}, nil
+					}).Times(2)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := s.handler.GetDomainReplicationStatus(context.Background(), input.input)
+			s.handler.shuttingDown = int32(0)
+			if input.expectedError {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(input.expected, resp)
+			}
+		})
+	}
+}
+
+func (s *handlerSuite) TestUpdateDomainIsolation() {
+	validInput := &types.HistoryUpdateDomainIsolationRequest{
+		DomainUUID: testDomainID,
+		Isolated:   true,
+	}
+
+	testInput := map[string]struct {
+		input         *types.HistoryUpdateDomainIsolationRequest
+		expected      *types.HistoryUpdateDomainIsolationResponse
+		expectedError bool
+		mockFn        func()
+	}{
+		"shutting down": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.handler.shuttingDown = int32(1)
+			},
+		},
+		"domain not set": {
+			input:         &types.HistoryUpdateDomainIsolationRequest{},
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"updateDomainIsolation error on some shards": {
+			input: validInput,
+			expected: &types.HistoryUpdateDomainIsolationResponse{
+				FailedShards: []int32{1},
+			},
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0, 1}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(s.mockEngine, nil).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(1).Return(nil, errors.New("error")).Times(1)
+				s.mockEngine.EXPECT().UpdateDomainIsolation(gomock.Any(), testDomainID, true).Return(nil).Times(1)
+			},
+		},
+		"updateDomainIsolation error": {
+			input: validInput,
+			expected: &types.HistoryUpdateDomainIsolationResponse{
+				FailedShards: []int32{0},
+			},
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(gomock.Any()).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().UpdateDomainIsolation(gomock.Any(), testDomainID, true).Return(errors.New("error")).Times(1)
+			},
+		},
+		"success": {
+			input:    validInput,
+			expected: &types.HistoryUpdateDomainIsolationResponse{},
+			mockFn: func() {
+				s.mockShardController.EXPECT().ShardIDs().Return([]int32{0, 1}).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(gomock.Any()).Return(s.mockEngine, nil).Times(2)
+				s.mockEngine.EXPECT().UpdateDomainIsolation(gomock.Any(), testDomainID, true).Return(nil).Times(2)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := s.handler.UpdateDomainIsolation(context.Background(), input.input)
+			s.handler.shuttingDown = int32(0)
+			if input.expectedError {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(input.expected, resp)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestReadDLQMessages() {
 	validInput := &types.ReadDLQMessagesRequest{
 		ShardID: 1,
@@ -51,6 +51,8 @@ type Handler interface {
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 	CountDLQMessages(context.Context, *types.CountDLQMessagesRequest) (*types.HistoryCountDLQMessagesResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
+	GetDomainReplicationStatus(context.Context, *types.HistoryGetDomainReplicationStatusRequest) (*types.HistoryGetDomainReplicationStatusResponse, error)
+	UpdateDomainIsolation(context.Context, *types.HistoryUpdateDomainIsolationRequest) (*types.HistoryUpdateDomainIsolationResponse, error)
 	GetMutableState(context.Context, *types.GetMutableStateRequest) (*types.GetMutableStateResponse, error)
 	GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
 	MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
@@ -85,6 +87,12 @@ type Handler interface {
 	SyncActivity(context.Context, *types.SyncActivityRequest) error
 	SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 	TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
+	PauseWorkflowExecution(context.Context, *types.HistoryPauseWorkflowExecutionRequest) (*types.PauseWorkflowExecutionResponse, error)
+	ResumeWorkflowExecution(context.Context, *types.HistoryResumeWorkflowExecutionRequest) (*types.ResumeWorkflowExecutionResponse, error)
+	PauseActivity(context.Context, *types.HistoryPauseActivityRequest) (*types.PauseActivityResponse, error)
+	UnpauseActivity(context.Context, *types.HistoryUnpauseActivityRequest) (*types.UnpauseActivityResponse, error)
+	ResetActivity(context.Context, *types.HistoryResetActivityRequest) (*types.ResetActivityResponse, error)
+	UpdateActivityOptions(context.Context, *types.HistoryUpdateActivityOptionsRequest) (*types.UpdateActivityOptionsResponse, error)
 	GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
 	RatelimitUpdate(context.Context, *types.RatelimitUpdateRequest) (*types.RatelimitUpdateResponse, error)
 }
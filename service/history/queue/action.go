@@ -33,6 +33,8 @@ type (
 		GetStateActionAttributes *GetStateActionAttributes
 		GetTasksAttributes       *GetTasksAttributes
 		UpdateTaskAttributes     *UpdateTasksAttributes
+		// UpdateDomainIsolationAttributes are only handled by history queue v2
+		UpdateDomainIsolationAttributes *UpdateDomainIsolationAttributes
 		// add attributes for other action types here
 	}
 
@@ -43,6 +45,8 @@ type (
 		GetStateActionResult *GetStateActionResult
 		GetTasksResult       *GetTasksResult
 		UpdateTaskResult     *UpdateTasksResult
+		// UpdateDomainIsolationResult is only returned by history queue v2
+		UpdateDomainIsolationResult *UpdateDomainIsolationResult
 	}
 
 	// ResetActionAttributes contains the parameter for performing Reset Action
@@ -55,6 +59,10 @@ type (
 	// GetStateActionResult is the result for performing GetState Action
 	GetStateActionResult struct {
 		States []ProcessingQueueState
+		// VirtualQueueStates and Mitigations are only returned by history queue v2,
+		// they contain the serialized virtual queue states and the recent alerts mitigated by the queue
+		VirtualQueueStates []string
+		Mitigations        []string
 	}
 
 	// GetTasksAttributes contains the parameter to get tasks
@@ -70,6 +78,15 @@ type (
 	// UpdateTasksResult is the result for performing UpdateTask Action
 	UpdateTasksResult struct {
 	}
+
+	// UpdateDomainIsolationAttributes contains the parameter to isolate a domain into its own virtual queue
+	// or to release it back to the default virtual queue
+	UpdateDomainIsolationAttributes struct {
+		DomainID string
+		Isolated bool
+	}
+	// UpdateDomainIsolationResult is the result for performing UpdateDomainIsolation Action
+	UpdateDomainIsolationResult struct{}
 )
 
 const (
@@ -81,6 +98,8 @@ const (
 	ActionTypeGetTasks
 	// ActionTypeUpdateTask is the ActionType to update outstanding task
 	ActionTypeUpdateTask
+	// ActionTypeUpdateDomainIsolation is the ActionType to isolate or release a domain
+	ActionTypeUpdateDomainIsolation
 	// add more ActionType here
 )
 
@@ -120,3 +139,18 @@ func NewUpdateTasksAction(
 		},
 	}
 }
+
+// NewUpdateDomainIsolationAction creates a queue action for isolating a domain into its own virtual queue
+// or releasing an isolated domain
+func NewUpdateDomainIsolationAction(
+	domainID string,
+	isolated bool,
+) *Action {
+	return &Action{
+		ActionType: ActionTypeUpdateDomainIsolation,
+		UpdateDomainIsolationAttributes: &UpdateDomainIsolationAttributes{
+			DomainID: domainID,
+			Isolated: isolated,
+		},
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -40,6 +41,10 @@ const (
 	warnPendingTasks = 2000
 )
 
+var (
+	errDomainIsolationNotSupported = &types.BadRequestError{Message: "domain isolation is only supported by history queue v2"}
+)
+
 type (
 	updateMaxReadLevelFn          func() task.Key
 	updateClusterAckLevelFn       func(task.Key) error // TODO: deprecate this in favor of updateProcessingQueueStatesFn
@@ -328,6 +333,8 @@ func (p *processorBase) handleActionNotification(notification actionNotification
 		result, err = p.resetProcessingQueueStates()
 	case ActionTypeGetState:
 		result = p.getProcessingQueueStates()
+	case ActionTypeUpdateDomainIsolation:
+		err = errDomainIsolationNotSupported
 	default:
 		err = fmt.Errorf("unknown queue action type: %v", notification.action.ActionType)
 	}
@@ -1,10 +1,21 @@
 package queuev2
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
 type (
 	// Alert is created by a Monitor when some statistics of the Queue is abnormal
 	Alert struct {
-		AlertType                            AlertType
-		AlertAttributesQueuePendingTaskCount *AlertAttributesQueuePendingTaskCount
+		AlertType                             AlertType
+		AlertAttributesQueuePendingTaskCount  *AlertAttributesQueuePendingTaskCount
+		AlertAttributesQueueStuckAckLevel     *AlertAttributesQueueStuckAckLevel
+		AlertAttributesQueueTaskAttempt       *AlertAttributesQueueTaskAttempt
+		AlertAttributesQueueReadLevelLag      *AlertAttributesQueueReadLevelLag
+		AlertAttributesQueueVirtualSliceCount *AlertAttributesQueueVirtualSliceCount
 	}
 
 	AlertType int
@@ -13,9 +24,75 @@ type (
 		CurrentPendingTaskCount  int
 		CriticalPendingTaskCount int
 	}
+
+	AlertAttributesQueueStuckAckLevel struct {
+		AckLevel              persistence.HistoryTaskKey
+		StuckDuration         time.Duration
+		CriticalStuckDuration time.Duration
+	}
+
+	AlertAttributesQueueTaskAttempt struct {
+		CurrentMaxTaskAttempt int
+		CriticalTaskAttempt   int
+	}
+
+	// AlertAttributesQueueReadLevelLag is only used by immediate queues, the lag is the number of task IDs
+	// between the read level of the root virtual queue and the max task ID of the queue
+	AlertAttributesQueueReadLevelLag struct {
+		CurrentReadLevelLag  int64
+		CriticalReadLevelLag int64
+	}
+
+	AlertAttributesQueueVirtualSliceCount struct {
+		CurrentVirtualSliceCount  int
+		CriticalVirtualSliceCount int
+	}
 )
 
 const (
 	AlertTypeUnspecified AlertType = iota
 	AlertTypeQueuePendingTaskCount
+	AlertTypeQueueStuckAckLevel
+	AlertTypeQueueTaskAttempt
+	AlertTypeQueueReadLevelLag
+	AlertTypeQueueVirtualSliceCount
 )
+
+func (t AlertType) String() string {
+	switch t {
+	case AlertTypeQueuePendingTaskCount:
+		return "QueuePendingTaskCount"
+	case AlertTypeQueueStuckAckLevel:
+		return "QueueStuckAckLevel"
+	case AlertTypeQueueTaskAttempt:
+		return "QueueTaskAttempt"
+	case AlertTypeQueueReadLevelLag:
+		return "QueueReadLevelLag"
+	case AlertTypeQueueVirtualSliceCount:
+		return "QueueVirtualSliceCount"
+	default:
+		return "Unspecified"
+	}
+}
+
+func (a Alert) String() string {
+	switch {
+	case a.AlertAttributesQueuePendingTaskCount != nil:
+		attr := a.AlertAttributesQueuePendingTaskCount
+		return fmt.Sprintf("%v: pending task count %d, critical %d", a.AlertType, attr.CurrentPendingTaskCount, attr.CriticalPendingTaskCount)
+	case a.AlertAttributesQueueStuckAckLevel != nil:
+		attr := a.AlertAttributesQueueStuckAckLevel
+		return fmt.Sprintf("%v: ack level %v stuck for %v, critical %v", a.AlertType, attr.AckLevel, attr.StuckDuration, attr.CriticalStuckDuration)
+	case a.AlertAttributesQueueTaskAttempt != nil:
+		attr := a.AlertAttributesQueueTaskAttempt
+		return fmt.Sprintf("%v: max task attempt %d, critical %d", a.AlertType, attr.CurrentMaxTaskAttempt, attr.CriticalTaskAttempt)
+	case a.AlertAttributesQueueReadLevelLag != nil:
+		attr := a.AlertAttributesQueueReadLevelLag
+		return fmt.Sprintf("%v: read level lag %d, critical %d", a.AlertType, attr.CurrentReadLevelLag, attr.CriticalReadLevelLag)
+	case a.AlertAttributesQueueVirtualSliceCount != nil:
+		attr := a.AlertAttributesQueueVirtualSliceCount
+		return fmt.Sprintf("%v: virtual slice count %d, critical %d", a.AlertType, attr.CurrentVirtualSliceCount, attr.CriticalVirtualSliceCount)
+	default:
+		return a.AlertType.String()
+	}
+}
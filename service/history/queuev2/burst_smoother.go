@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queuev2
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/history/task"
+)
+
+const (
+	// timer tasks are grouped into buckets of their scheduled time to detect bursts
+	burstSmoothingBucketSize = time.Second
+	// upper bound of the per domain smoothing window, so that a misconfiguration can't delay timers indefinitely
+	maxBurstSmoothingWindow = 10 * time.Minute
+)
+
+type (
+	// burstSmoother wraps the task processor of a scheduled queue. When the number of tasks scheduled
+	// within the same bucket exceeds the threshold, the dispatch of the remaining tasks of the bucket
+	// is delayed by a deterministic offset within the smoothing window of their domain.
+	// The offset only depends on the task, so a delayed task is dispatched as is when it's submitted again by the rescheduler.
+	burstSmoother struct {
+		task.Processor
+
+		// rescheduler is set after the queue base is created, since the rescheduler submits tasks to the burst smoother
+		rescheduler  task.Rescheduler
+		domainCache  cache.DomainCache
+		timeSource   clock.TimeSource
+		metricsScope metrics.Scope
+		threshold    dynamicproperties.IntPropertyFn
+		window       dynamicproperties.DurationPropertyFnWithDomainFilter
+
+		sync.Mutex
+		buckets map[burstSmoothingBucketKey]map[int64]int
+	}
+
+	// burstSmoothingBucketKey identifies the bucket of a domain, buckets are per domain like the smoothing window
+	burstSmoothingBucketKey struct {
+		domainID string
+		bucket   time.Time
+	}
+)
+
+func newBurstSmoother(
+	processor task.Processor,
+	domainCache cache.DomainCache,
+	timeSource clock.TimeSource,
+	metricsScope metrics.Scope,
+	threshold dynamicproperties.IntPropertyFn,
+	window dynamicproperties.DurationPropertyFnWithDomainFilter,
+) *burstSmoother {
+	return &burstSmoother{
+		Processor:    processor,
+		domainCache:  domainCache,
+		timeSource:   timeSource,
+		metricsScope: metricsScope,
+		threshold:    threshold,
+		window:       window,
+		buckets:      make(map[burstSmoothingBucketKey]map[int64]int),
+	}
+}
+
+func (s *burstSmoother) TrySubmit(t task.Task) (bool, error) {
+	dispatchTime, ok := s.getDispatchTime(t)
+	if !ok {
+		return s.Processor.TrySubmit(t)
+	}
+
+	delay := dispatchTime.Sub(s.timeSource.Now())
+	s.metricsScope.IncCounter(metrics.TimerBurstSmoothedTaskCounter)
+	s.metricsScope.RecordHistogramDuration(metrics.TimerBurstSmoothingDelay, delay)
+	s.rescheduler.RescheduleTask(t, dispatchTime)
+	return true, nil
+}
+
+// getDispatchTime returns the time the task should be dispatched at and whether the dispatch should be delayed
+func (s *burstSmoother) getDispatchTime(t task.Task) (time.Time, bool) {
+	threshold := s.threshold()
+	if threshold <= 0 || t.GetAttempt() > 0 || s.rescheduler == nil {
+		return time.Time{}, false
+	}
+
+	domainName, err := s.domainCache.GetDomainName(t.GetDomainID())
+	if err != nil {
+		return time.Time{}, false
+	}
+	window := min(s.window(domainName), maxBurstSmoothingWindow)
+	if window <= 0 {
+		return time.Time{}, false
+	}
+
+	scheduledTime := t.GetTaskKey().GetScheduledTime()
+	dispatchTime := scheduledTime.Add(getBurstSmoothingOffset(t.GetTaskID(), window))
+	now := s.timeSource.Now()
+	if !now.Before(dispatchTime) {
+		// either the task is late already, or it's been delayed and submitted again by the rescheduler
+		return time.Time{}, false
+	}
+
+	if !s.isDenseBucket(t.GetDomainID(), t.GetTaskID(), scheduledTime, now, threshold) {
+		return time.Time{}, false
+	}
+	return dispatchTime, true
+}
+
+// isDenseBucket counts the task into the bucket of its domain and scheduled time and returns whether
+// the task is beyond the threshold of the bucket. A task submitted again, e.g. after its slice is reloaded,
+// keeps the position it was counted at, so it's not counted twice.
+func (s *burstSmoother) isDenseBucket(domainID string, taskID int64, scheduledTime, now time.Time, threshold int) bool {
+	key := burstSmoothingBucketKey{
+		domainID: domainID,
+		bucket:   scheduledTime.Truncate(burstSmoothingBucketSize),
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	taskPositions, ok := s.buckets[key]
+	if !ok {
+		// tasks of buckets older than the max window are never delayed, the counts are no longer needed
+		expiration := now.Add(-maxBurstSmoothingWindow - burstSmoothingBucketSize)
+		for k := range s.buckets {
+			if k.bucket.Before(expiration) {
+				delete(s.buckets, k)
+			}
+		}
+		taskPositions = make(map[int64]int)
+		s.buckets[key] = taskPositions
+	}
+	position, ok := taskPositions[taskID]
+	if !ok {
+		position = len(taskPositions) + 1
+		taskPositions[taskID] = position
+	}
+	return position > threshold
+}
+
+// getBurstSmoothingOffset spreads the tasks of a bucket over the window by multiplicative hashing of the task ID,
+// tasks of the same bucket usually have consecutive task IDs
+func getBurstSmoothingOffset(taskID int64, window time.Duration) time.Duration {
+	return time.Duration(uint64(taskID) * 2654435761 % uint64(window))
+}
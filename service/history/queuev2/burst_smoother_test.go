@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queuev2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/history/task"
+)
+
+func newTestBurstSmoother(
+	ctrl *gomock.Controller,
+	timeSource clock.TimeSource,
+	threshold int,
+	window time.Duration,
+) (*burstSmoother, *task.MockProcessor, *task.MockRescheduler) {
+	mockProcessor := task.NewMockProcessor(ctrl)
+	mockRescheduler := task.NewMockRescheduler(ctrl)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain", nil).AnyTimes()
+	mockDomainCache.EXPECT().GetDomainName("other-domain-id").Return("other-domain", nil).AnyTimes()
+
+	smoother := newBurstSmoother(
+		mockProcessor,
+		mockDomainCache,
+		timeSource,
+		metrics.NoopScope,
+		dynamicproperties.GetIntPropertyFn(threshold),
+		func(domain string) time.Duration {
+			if domain == "test-domain" || domain == "other-domain" {
+				return window
+			}
+			return 0
+		},
+	)
+	smoother.rescheduler = mockRescheduler
+	return smoother, mockProcessor, mockRescheduler
+}
+
+func newTestTimerTask(ctrl *gomock.Controller, scheduledTime time.Time, taskID int64, attempt int) *task.MockTask {
+	return newTestDomainTimerTask(ctrl, "test-domain-id", scheduledTime, taskID, attempt)
+}
+
+func newTestDomainTimerTask(ctrl *gomock.Controller, domainID string, scheduledTime time.Time, taskID int64, attempt int) *task.MockTask {
+	mockTask := task.NewMockTask(ctrl)
+	mockTask.EXPECT().GetDomainID().Return(domainID).AnyTimes()
+	mockTask.EXPECT().GetTaskKey().Return(persistence.NewHistoryTaskKey(scheduledTime, taskID)).AnyTimes()
+	mockTask.EXPECT().GetTaskID().Return(taskID).AnyTimes()
+	mockTask.EXPECT().GetAttempt().Return(attempt).AnyTimes()
+	return mockTask
+}
+
+func TestBurstSmoother_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Unix(0, 0)
+	timeSource := clock.NewMockedTimeSourceAt(now)
+
+	// threshold 0 disables burst smoothing
+	smoother, mockProcessor, _ := newTestBurstSmoother(ctrl, timeSource, 0, time.Minute)
+	for i := int64(1); i <= 5; i++ {
+		mockTask := newTestTimerTask(ctrl, now, i, 0)
+		mockProcessor.EXPECT().TrySubmit(mockTask).Return(true, nil)
+		submitted, err := smoother.TrySubmit(mockTask)
+		require.NoError(t, err)
+		assert.True(t, submitted)
+	}
+
+	// window 0 disables burst smoothing for the domain
+	smoother, mockProcessor, _ = newTestBurstSmoother(ctrl, timeSource, 1, 0)
+	for i := int64(1); i <= 5; i++ {
+		mockTask := newTestTimerTask(ctrl, now, i, 0)
+		mockProcessor.EXPECT().TrySubmit(mockTask).Return(true, nil)
+		submitted, err := smoother.TrySubmit(mockTask)
+		require.NoError(t, err)
+		assert.True(t, submitted)
+	}
+}
+
+func TestBurstSmoother_DenseBucket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Unix(1000, 0)
+	timeSource := clock.NewMockedTimeSourceAt(now)
+	window := time.Minute
+	smoother, mockProcessor, mockRescheduler := newTestBurstSmoother(ctrl, timeSource, 2, window)
+
+	// the first tasks of the bucket are dispatched right away
+	for i := int64(1); i <= 2; i++ {
+		mockTask := newTestTimerTask(ctrl, now, i, 0)
+		mockProcessor.EXPECT().TrySubmit(mockTask).Return(true, nil)
+		submitted, err := smoother.TrySubmit(mockTask)
+		require.NoError(t, err)
+		assert.True(t, submitted)
+	}
+
+	// the remaining tasks are spread over the window
+	delayedTask := newTestTimerTask(ctrl, now.Add(100*time.Millisecond), 3, 0)
+	dispatchTime := now.Add(100 * time.Millisecond).Add(getBurstSmoothingOffset(3, window))
+	require.True(t, dispatchTime.After(now))
+	mockRescheduler.EXPECT().RescheduleTask(delayedTask, dispatchTime)
+	submitted, err := smoother.TrySubmit(delayedTask)
+	require.NoError(t, err)
+	assert.True(t, submitted)
+
+	// the delayed task is dispatched once it's submitted again by the rescheduler
+	timeSource.Advance(dispatchTime.Sub(now))
+	mockProcessor.EXPECT().TrySubmit(delayedTask).Return(true, nil)
+	submitted, err = smoother.TrySubmit(delayedTask)
+	require.NoError(t, err)
+	assert.True(t, submitted)
+
+	// retried tasks are never delayed
+	retriedTask := newTestTimerTask(ctrl, now, 4, 1)
+	mockProcessor.EXPECT().TrySubmit(retriedTask).Return(false, nil)
+	submitted, err = smoother.TrySubmit(retriedTask)
+	require.NoError(t, err)
+	assert.False(t, submitted)
+}
+
+func TestBurstSmoother_ExpireBuckets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Unix(1000, 0)
+	timeSource := clock.NewMockedTimeSourceAt(now)
+	smoother, mockProcessor, _ := newTestBurstSmoother(ctrl, timeSource, 10, maxBurstSmoothingWindow)
+
+	mockTask := newTestTimerTask(ctrl, now, 1, 0)
+	mockProcessor.EXPECT().TrySubmit(mockTask).Return(true, nil)
+	_, err := smoother.TrySubmit(mockTask)
+	require.NoError(t, err)
+	assert.Len(t, smoother.buckets, 1)
+
+	later := now.Add(2 * maxBurstSmoothingWindow)
+	timeSource.Advance(later.Sub(now))
+	mockTask = newTestTimerTask(ctrl, later, 2, 0)
+	mockProcessor.EXPECT().TrySubmit(mockTask).Return(true, nil)
+	_, err = smoother.TrySubmit(mockTask)
+	require.NoError(t, err)
+	assert.Equal(t, map[burstSmoothingBucketKey]map[int64]int{
+		{domainID: "test-domain-id", bucket: later}: {2: 1},
+	}, smoother.buckets)
+}
+
+func TestBurstSmoother_DenseBucketPerDomain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Unix(1000, 0)
+	timeSource := clock.NewMockedTimeSourceAt(now)
+	smoother, mockProcessor, _ := newTestBurstSmoother(ctrl, timeSource, 2, time.Minute)
+
+	// the tasks of other domains don't count towards the threshold of the bucket of a domain
+	for i := int64(1); i <= 4; i++ {
+		domainID := "test-domain-id"
+		if i%2 == 0 {
+			domainID = "other-domain-id"
+		}
+		mockTask := newTestDomainTimerTask(ctrl, domainID, now, i, 0)
+		mockProcessor.EXPECT().TrySubmit(mockTask).Return(true, nil)
+		submitted, err := smoother.TrySubmit(mockTask)
+		require.NoError(t, err)
+		assert.True(t, submitted)
+	}
+}
+
+func TestBurstSmoother_TaskSubmittedAgain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Unix(1000, 0)
+	timeSource := clock.NewMockedTimeSourceAt(now)
+	window := time.Minute
+	smoother, mockProcessor, mockRescheduler := newTestBurstSmoother(ctrl, timeSource, 2, window)
+
+	// the tasks of a reloaded slice are submitted again, they are not counted twice
+	for round := 0; round < 2; round++ {
+		for i := int64(1); i <= 2; i++ {
+			mockTask := newTestTimerTask(ctrl, now, i, 0)
+			mockProcessor.EXPECT().TrySubmit(mockTask).Return(true, nil)
+			submitted, err := smoother.TrySubmit(mockTask)
+			require.NoError(t, err)
+			assert.True(t, submitted)
+		}
+	}
+
+	// a delayed task is delayed again when it's submitted again before its dispatch time
+	delayedTask := newTestTimerTask(ctrl, now, 3, 0)
+	dispatchTime := now.Add(getBurstSmoothingOffset(3, window))
+	require.True(t, dispatchTime.After(now))
+	mockRescheduler.EXPECT().RescheduleTask(delayedTask, dispatchTime).Times(2)
+	for round := 0; round < 2; round++ {
+		submitted, err := smoother.TrySubmit(delayedTask)
+		require.NoError(t, err)
+		assert.True(t, submitted)
+	}
+}
+
+func TestGetBurstSmoothingOffset(t *testing.T) {
+	window := time.Minute
+	offsets := make(map[time.Duration]struct{})
+	for taskID := int64(1); taskID <= 1000; taskID++ {
+		offset := getBurstSmoothingOffset(taskID, window)
+		assert.GreaterOrEqual(t, offset, time.Duration(0))
+		assert.Less(t, offset, window)
+		assert.Equal(t, offset, getBurstSmoothingOffset(taskID, window))
+		offsets[offset] = struct{}{}
+	}
+	assert.Len(t, offsets, 1000)
+}
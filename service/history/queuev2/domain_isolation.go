@@ -0,0 +1,412 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queuev2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
+)
+
+const (
+	// virtual queue IDs starting from isolatedQueueIDBase are reserved for the domains isolated by operators,
+	// they are far above the max virtual queue count, so the mitigator never moves slices into them
+	isolatedQueueIDBase int64 = 1 << 16
+
+	isolatedDomainMaxPollRPSKey          = "maxPollRPS"
+	isolatedDomainMaxPendingTaskCountKey = "maxPendingTaskCount"
+)
+
+type (
+	domainIsolationSettings struct {
+		maxPollRPS          int
+		maxPendingTaskCount int
+	}
+
+	// domainIsolation tracks the domains isolated into their own virtual queue by operators.
+	// The domain to virtual queue mapping is recovered from the persisted queue state, so it survives shard movement.
+	domainIsolation struct {
+		sync.RWMutex
+		queueIDs map[string]int64                   // domain ID -> virtual queue ID
+		settings map[string]domainIsolationSettings // domain ID -> settings
+	}
+
+	domainIsolationRequest struct {
+		domainID string
+		isolated bool
+		doneCh   chan struct{}
+	}
+)
+
+func isIsolatedQueueID(queueID int64) bool {
+	return queueID >= isolatedQueueIDBase
+}
+
+func newDomainIsolation(virtualQueueStates map[int64][]VirtualSliceState) *domainIsolation {
+	d := &domainIsolation{
+		queueIDs: make(map[string]int64),
+		settings: make(map[string]domainIsolationSettings),
+	}
+	for queueID, states := range virtualQueueStates {
+		if !isIsolatedQueueID(queueID) {
+			continue
+		}
+		for _, state := range states {
+			if domainID, ok := getIsolatedDomainID(state.Predicate); ok {
+				d.queueIDs[domainID] = queueID
+				break
+			}
+		}
+	}
+	return d
+}
+
+// getIsolatedDomainID returns the domain ID if the predicate only matches the tasks of a single domain
+func getIsolatedDomainID(predicate Predicate) (string, bool) {
+	p, ok := predicate.(*domainIDPredicate)
+	if !ok || p.isExclusive || len(p.domainIDs) != 1 {
+		return "", false
+	}
+	for domainID := range p.domainIDs {
+		return domainID, true
+	}
+	return "", false
+}
+
+func (d *domainIsolation) getQueueID(domainID string) (int64, bool) {
+	d.RLock()
+	defer d.RUnlock()
+	queueID, ok := d.queueIDs[domainID]
+	return queueID, ok
+}
+
+func (d *domainIsolation) getDomainID(queueID int64) (string, bool) {
+	d.RLock()
+	defer d.RUnlock()
+	for domainID, id := range d.queueIDs {
+		if id == queueID {
+			return domainID, true
+		}
+	}
+	return "", false
+}
+
+func (d *domainIsolation) getQueueIDs() map[string]int64 {
+	d.RLock()
+	defer d.RUnlock()
+	return maps.Clone(d.queueIDs)
+}
+
+// addDomain assigns the smallest unused isolated virtual queue ID to the domain
+func (d *domainIsolation) addDomain(domainID string) int64 {
+	d.Lock()
+	defer d.Unlock()
+	if queueID, ok := d.queueIDs[domainID]; ok {
+		return queueID
+	}
+	usedQueueIDs := make(map[int64]struct{}, len(d.queueIDs))
+	for _, queueID := range d.queueIDs {
+		usedQueueIDs[queueID] = struct{}{}
+	}
+	queueID := isolatedQueueIDBase
+	for _, ok := usedQueueIDs[queueID]; ok; _, ok = usedQueueIDs[queueID] {
+		queueID++
+	}
+	d.queueIDs[domainID] = queueID
+	return queueID
+}
+
+func (d *domainIsolation) removeDomain(domainID string) {
+	d.Lock()
+	defer d.Unlock()
+	delete(d.queueIDs, domainID)
+}
+
+func (d *domainIsolation) updateSettings(settings map[string]domainIsolationSettings) {
+	d.Lock()
+	defer d.Unlock()
+	d.settings = settings
+}
+
+func (d *domainIsolation) getSettings(queueID int64) domainIsolationSettings {
+	domainID, ok := d.getDomainID(queueID)
+	if !ok {
+		return domainIsolationSettings{}
+	}
+	d.RLock()
+	defer d.RUnlock()
+	return d.settings[domainID]
+}
+
+// virtualQueueOptions returns the options and the task load rate limiter of an isolated virtual queue,
+// settings not specified for the isolated domain fall back to the ones of the non-root virtual queues
+func (d *domainIsolation) virtualQueueOptions(
+	queueID int64,
+	nonRootQueueOptions *VirtualQueueOptions,
+	maxPollRPS dynamicproperties.IntPropertyFn,
+) (*VirtualQueueOptions, quotas.Limiter, bool) {
+	if _, ok := d.getDomainID(queueID); !ok {
+		return nil, nil, false
+	}
+	options := &VirtualQueueOptions{
+		PageSize: nonRootQueueOptions.PageSize,
+		MaxPendingTasksCount: func(opts ...dynamicproperties.FilterOption) int {
+			if settings := d.getSettings(queueID); settings.maxPendingTaskCount > 0 {
+				return settings.maxPendingTaskCount
+			}
+			return nonRootQueueOptions.MaxPendingTasksCount(opts...)
+		},
+		PollBackoffInterval:                  nonRootQueueOptions.PollBackoffInterval,
+		PollBackoffIntervalJitterCoefficient: nonRootQueueOptions.PollBackoffIntervalJitterCoefficient,
+	}
+	taskLoadRateLimiter := quotas.NewDynamicRateLimiter(func() float64 {
+		if settings := d.getSettings(queueID); settings.maxPollRPS > 0 {
+			return float64(settings.maxPollRPS)
+		}
+		return float64(maxPollRPS())
+	})
+	return options, taskLoadRateLimiter, true
+}
+
+func parseDomainIsolationSettings(value interface{}) (domainIsolationSettings, error) {
+	if value == nil {
+		return domainIsolationSettings{}, nil
+	}
+	settings, ok := value.(map[string]interface{})
+	if !ok {
+		return domainIsolationSettings{}, fmt.Errorf("unexpected type %T of domain isolation settings", value)
+	}
+	maxPollRPS, err := getIntSetting(settings, isolatedDomainMaxPollRPSKey)
+	if err != nil {
+		return domainIsolationSettings{}, err
+	}
+	maxPendingTaskCount, err := getIntSetting(settings, isolatedDomainMaxPendingTaskCountKey)
+	if err != nil {
+		return domainIsolationSettings{}, err
+	}
+	return domainIsolationSettings{
+		maxPollRPS:          maxPollRPS,
+		maxPendingTaskCount: maxPendingTaskCount,
+	}, nil
+}
+
+func getIntSetting(settings map[string]interface{}, key string) (int, error) {
+	switch v := settings[key].(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T of domain isolation setting %v", v, key)
+	}
+}
+
+// refreshDomainIsolationSettings reads the settings of the isolated virtual queues from dynamic config
+func (q *queueBase) refreshDomainIsolationSettings() {
+	if q.options.IsolatedDomainSettings == nil {
+		return
+	}
+
+	settings := make(map[string]domainIsolationSettings)
+	for domainName, value := range q.options.IsolatedDomainSettings() {
+		domainID, err := q.shard.GetDomainCache().GetDomainID(domainName)
+		if err != nil {
+			q.logger.Warn("Failed to get domain ID of isolated domain", tag.WorkflowDomainName(domainName), tag.Error(err))
+			continue
+		}
+		domainSettings, err := parseDomainIsolationSettings(value)
+		if err != nil {
+			q.logger.Warn("Invalid domain isolation settings, falling back to the default settings", tag.WorkflowDomainName(domainName), tag.Error(err))
+		}
+		settings[domainID] = domainSettings
+	}
+	q.domainIsolation.updateSettings(settings)
+}
+
+// updateDomainIsolation hands the request over to the event loop of the queue, which owns the virtual queues,
+// and waits until the queue state with the change is stored
+func (q *queueBase) updateDomainIsolation(ctx context.Context, attributes *queue.UpdateDomainIsolationAttributes) (*queue.ActionResult, error) {
+	if attributes == nil || attributes.DomainID == "" {
+		return nil, &types.BadRequestError{Message: "domain ID is not set"}
+	}
+	request := &domainIsolationRequest{
+		domainID: attributes.DomainID,
+		isolated: attributes.Isolated,
+		doneCh:   make(chan struct{}),
+	}
+	select {
+	case q.domainIsolationCh <- request:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case <-request.doneCh:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return &queue.ActionResult{
+		ActionType:                  queue.ActionTypeUpdateDomainIsolation,
+		UpdateDomainIsolationResult: &queue.UpdateDomainIsolationResult{},
+	}, nil
+}
+
+// handleDomainIsolationRequest isolates or releases the domain, the queue state is stored right away
+// so that the override survives shard movement
+func (q *queueBase) handleDomainIsolationRequest(ctx context.Context, request *domainIsolationRequest) {
+	if request == nil {
+		return
+	}
+	defer close(request.doneCh)
+
+	if request.isolated {
+		if _, ok := q.domainIsolation.getQueueID(request.domainID); !ok {
+			q.isolateDomain(request.domainID)
+		}
+	} else {
+		q.releaseDomain(request.domainID)
+	}
+	q.updateQueueStateFn(ctx)
+}
+
+// addDrainedIsolatedQueueStates keeps the isolated domains whose virtual queues have no slices in the queue state,
+// they're stored as a single empty slice at the max read level, which doesn't hold back the ack level
+func (q *queueBase) addDrainedIsolatedQueueStates(state *QueueState) {
+	for domainID, queueID := range q.domainIsolation.getQueueIDs() {
+		if len(state.VirtualQueueStates[queueID]) != 0 {
+			continue
+		}
+		state.VirtualQueueStates[queueID] = []VirtualSliceState{
+			{
+				Range: Range{
+					InclusiveMinTaskKey: state.ExclusiveMaxReadLevel,
+					ExclusiveMaxTaskKey: state.ExclusiveMaxReadLevel,
+				},
+				Predicate: NewDomainIDPredicate([]string{domainID}, false),
+			},
+		}
+	}
+}
+
+// removeEmptyVirtualSliceStates drops the empty slices of the drained isolated virtual queues,
+// it must be called after the isolated domains are recovered from the queue state
+func removeEmptyVirtualSliceStates(virtualQueueStates map[int64][]VirtualSliceState) map[int64][]VirtualSliceState {
+	result := make(map[int64][]VirtualSliceState, len(virtualQueueStates))
+	for queueID, states := range virtualQueueStates {
+		nonEmptyStates := make([]VirtualSliceState, 0, len(states))
+		for _, state := range states {
+			if !state.Range.IsEmpty() {
+				nonEmptyStates = append(nonEmptyStates, state)
+			}
+		}
+		if len(nonEmptyStates) != 0 {
+			result[queueID] = nonEmptyStates
+		}
+	}
+	return result
+}
+
+// isolateDomain moves the slices of the domain from the other virtual queues to a dedicated virtual queue,
+// the moved slices are cleared so that their tasks are reloaded by the isolated virtual queue
+func (q *queueBase) isolateDomain(domainID string) {
+	queueID := q.domainIsolation.addDomain(domainID)
+	q.logger.Info("Isolating domain into its own virtual queue", tag.WorkflowDomainID(domainID), tag.VirtualQueueID(queueID))
+	isolatedQueue := q.virtualQueueManager.GetOrCreateVirtualQueue(queueID)
+
+	predicate := NewDomainIDPredicate([]string{domainID}, false)
+	var isolatedSlices []VirtualSlice
+	for id, virtualQueue := range q.virtualQueueManager.VirtualQueues() {
+		if isIsolatedQueueID(id) {
+			continue
+		}
+		virtualQueue.SplitSlices(func(slice VirtualSlice) ([]VirtualSlice, bool) {
+			slicePredicate := slice.GetState().Predicate
+			if And(slicePredicate, predicate).IsEmpty() {
+				return nil, false
+			}
+			if And(slicePredicate, Not(predicate)).IsEmpty() {
+				slice.Clear()
+				isolatedSlices = append(isolatedSlices, slice)
+				return nil, true
+			}
+			isolatedSlice, remainingSlice, ok := slice.TrySplitByPredicate(predicate)
+			if !ok {
+				return nil, false
+			}
+			isolatedSlice.Clear()
+			isolatedSlices = append(isolatedSlices, isolatedSlice)
+			return []VirtualSlice{remainingSlice}, true
+		})
+	}
+	slices.SortFunc(isolatedSlices, func(a, b VirtualSlice) int {
+		return a.GetState().Range.InclusiveMinTaskKey.Compare(b.GetState().Range.InclusiveMinTaskKey)
+	})
+	isolatedQueue.MergeSlices(isolatedSlices...)
+}
+
+// releaseDomain moves the slices of an isolated domain back to the root virtual queue,
+// the isolated virtual queue is deleted by the virtual queue manager once it's empty
+func (q *queueBase) releaseDomain(domainID string) {
+	queueID, ok := q.domainIsolation.getQueueID(domainID)
+	if !ok {
+		return
+	}
+	q.logger.Info("Releasing isolated domain to the root virtual queue", tag.WorkflowDomainID(domainID), tag.VirtualQueueID(queueID))
+	q.domainIsolation.removeDomain(domainID)
+
+	isolatedQueue, ok := q.virtualQueueManager.VirtualQueues()[queueID]
+	if !ok {
+		return
+	}
+	var releasedSlices []VirtualSlice
+	isolatedQueue.SplitSlices(func(slice VirtualSlice) ([]VirtualSlice, bool) {
+		slice.Clear()
+		releasedSlices = append(releasedSlices, slice)
+		return nil, true
+	})
+	q.virtualQueueManager.GetOrCreateVirtualQueue(rootQueueID).MergeSlices(releasedSlices...)
+}
+
+// isolateNewVirtualSlice moves the new tasks of the isolated domains to their virtual queues
+// and returns the remaining slice which belongs to the root virtual queue
+func (q *queueBase) isolateNewVirtualSlice(slice VirtualSlice) VirtualSlice {
+	queueIDs := q.domainIsolation.getQueueIDs()
+	for _, domainID := range slices.Sorted(maps.Keys(queueIDs)) {
+		isolatedSlice, remainingSlice, ok := slice.TrySplitByPredicate(NewDomainIDPredicate([]string{domainID}, false))
+		if !ok {
+			continue
+		}
+		q.virtualQueueManager.GetOrCreateVirtualQueue(queueIDs[domainID]).MergeWithLastSlice(isolatedSlice)
+		slice = remainingSlice
+	}
+	return slice
+}
@@ -0,0 +1,348 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queuev2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+func TestNewDomainIsolation(t *testing.T) {
+	isolatedSliceState := newTestSliceState(100, 200)
+	isolatedSliceState.Predicate = NewDomainIDPredicate([]string{"domain1"}, false)
+	nonRootSliceState := newTestSliceState(100, 200)
+	nonRootSliceState.Predicate = NewDomainIDPredicate([]string{"domain2"}, false)
+
+	d := newDomainIsolation(map[int64][]VirtualSliceState{
+		rootQueueID:             {newTestSliceState(100, 200)},
+		1:                       {nonRootSliceState},
+		isolatedQueueIDBase + 1: {isolatedSliceState},
+	})
+
+	assert.Equal(t, map[string]int64{"domain1": isolatedQueueIDBase + 1}, d.getQueueIDs())
+	domainID, ok := d.getDomainID(isolatedQueueIDBase + 1)
+	assert.True(t, ok)
+	assert.Equal(t, "domain1", domainID)
+	_, ok = d.getDomainID(1)
+	assert.False(t, ok)
+}
+
+func TestDomainIsolation_AddAndRemoveDomain(t *testing.T) {
+	d := newDomainIsolation(nil)
+
+	assert.Equal(t, isolatedQueueIDBase, d.addDomain("domain1"))
+	assert.Equal(t, isolatedQueueIDBase+1, d.addDomain("domain2"))
+	assert.Equal(t, isolatedQueueIDBase, d.addDomain("domain1"))
+
+	d.removeDomain("domain1")
+	_, ok := d.getQueueID("domain1")
+	assert.False(t, ok)
+	// the virtual queue ID of a released domain is reused
+	assert.Equal(t, isolatedQueueIDBase, d.addDomain("domain3"))
+}
+
+func TestDomainIsolation_VirtualQueueOptions(t *testing.T) {
+	nonRootQueueOptions := &VirtualQueueOptions{
+		PageSize:             dynamicproperties.GetIntPropertyFn(100),
+		MaxPendingTasksCount: dynamicproperties.GetIntPropertyFn(800),
+	}
+	d := newDomainIsolation(nil)
+	queueID := d.addDomain("domain1")
+
+	_, _, ok := d.virtualQueueOptions(queueID+1, nonRootQueueOptions, dynamicproperties.GetIntPropertyFn(50))
+	assert.False(t, ok)
+
+	options, rateLimiter, ok := d.virtualQueueOptions(queueID, nonRootQueueOptions, dynamicproperties.GetIntPropertyFn(50))
+	require.True(t, ok)
+	assert.Equal(t, 100, options.PageSize())
+	assert.Equal(t, 800, options.MaxPendingTasksCount())
+	assert.Equal(t, float64(50), float64(rateLimiter.Limit()))
+
+	d.updateSettings(map[string]domainIsolationSettings{
+		"domain1": {maxPollRPS: 10, maxPendingTaskCount: 200},
+	})
+	assert.Equal(t, 200, options.MaxPendingTasksCount())
+	_, rateLimiter, ok = d.virtualQueueOptions(queueID, nonRootQueueOptions, dynamicproperties.GetIntPropertyFn(50))
+	require.True(t, ok)
+	assert.Equal(t, float64(10), float64(rateLimiter.Limit()))
+}
+
+func TestParseDomainIsolationSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       interface{}
+		expected    domainIsolationSettings
+		expectError bool
+	}{
+		{
+			name:     "nil settings",
+			value:    nil,
+			expected: domainIsolationSettings{},
+		},
+		{
+			name: "settings from json",
+			value: map[string]interface{}{
+				isolatedDomainMaxPollRPSKey:          float64(10),
+				isolatedDomainMaxPendingTaskCountKey: float64(200),
+			},
+			expected: domainIsolationSettings{maxPollRPS: 10, maxPendingTaskCount: 200},
+		},
+		{
+			name: "partial settings",
+			value: map[string]interface{}{
+				isolatedDomainMaxPollRPSKey: 10,
+			},
+			expected: domainIsolationSettings{maxPollRPS: 10},
+		},
+		{
+			name:        "invalid settings type",
+			value:       "invalid",
+			expectError: true,
+		},
+		{
+			name: "invalid setting type",
+			value: map[string]interface{}{
+				isolatedDomainMaxPendingTaskCountKey: "200",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := parseDomainIsolationSettings(tt.value)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, settings)
+		})
+	}
+}
+
+func TestQueueBase_RefreshDomainIsolationSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShard := shard.NewMockContext(ctrl)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockShard.EXPECT().GetDomainCache().Return(mockDomainCache).AnyTimes()
+	mockDomainCache.EXPECT().GetDomainID("domain1").Return("domain1-id", nil).AnyTimes()
+	mockDomainCache.EXPECT().GetDomainID("unknown").Return("", errors.New("domain not found")).AnyTimes()
+
+	queueBase := &queueBase{
+		shard:           mockShard,
+		logger:          testlogger.New(t),
+		domainIsolation: newDomainIsolation(nil),
+		options: &Options{
+			IsolatedDomainSettings: func(...dynamicproperties.FilterOption) map[string]interface{} {
+				return map[string]interface{}{
+					"domain1": map[string]interface{}{isolatedDomainMaxPollRPSKey: float64(10)},
+					"unknown": nil,
+				}
+			},
+		},
+	}
+	queueID := queueBase.domainIsolation.addDomain("domain1-id")
+
+	queueBase.refreshDomainIsolationSettings()
+	assert.Equal(t, domainIsolationSettings{maxPollRPS: 10}, queueBase.domainIsolation.getSettings(queueID))
+	// the settings don't isolate the domains by themselves
+	assert.Equal(t, map[string]int64{"domain1-id": queueID}, queueBase.domainIsolation.getQueueIDs())
+}
+
+func TestQueueBase_HandleDomainIsolationRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockRootQueue := NewMockVirtualQueue(ctrl)
+	mockIsolatedQueue := NewMockVirtualQueue(ctrl)
+
+	queueStateUpdates := 0
+	queueBase := &queueBase{
+		logger:              testlogger.New(t),
+		virtualQueueManager: mockVirtualQueueManager,
+		domainIsolation:     newDomainIsolation(nil),
+		updateQueueStateFn: func(context.Context) {
+			queueStateUpdates++
+		},
+	}
+	isolatedPredicate := NewDomainIDPredicate([]string{"domain1-id"}, false)
+	remainingPredicate := NewDomainIDPredicate([]string{"domain1-id"}, true)
+	logger := testlogger.New(t)
+
+	// isolate the domain
+	mockVirtualQueueManager.EXPECT().GetOrCreateVirtualQueue(isolatedQueueIDBase).Return(mockIsolatedQueue)
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+		rootQueueID: mockRootQueue,
+	})
+	mockRootQueue.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+		remaining, split := f(NewVirtualSlice(newTestSliceState(100, 200), nil, nil, NewPendingTaskTracker(), logger))
+		assert.True(t, split)
+		require.Len(t, remaining, 1)
+		assert.True(t, remainingPredicate.Equals(remaining[0].GetState().Predicate))
+
+		otherDomainSliceState := newTestSliceState(200, 300)
+		otherDomainSliceState.Predicate = NewDomainIDPredicate([]string{"domain2-id"}, false)
+		_, split = f(NewVirtualSlice(otherDomainSliceState, nil, nil, NewPendingTaskTracker(), logger))
+		assert.False(t, split)
+	})
+	mockIsolatedQueue.EXPECT().MergeSlices(gomock.Any()).Do(func(slices ...VirtualSlice) {
+		require.Len(t, slices, 1)
+		assert.True(t, isolatedPredicate.Equals(slices[0].GetState().Predicate))
+		assert.Equal(t, newTestSliceState(100, 200).Range, slices[0].GetState().Range)
+	})
+
+	request := &domainIsolationRequest{domainID: "domain1-id", isolated: true, doneCh: make(chan struct{})}
+	queueBase.handleDomainIsolationRequest(context.Background(), request)
+	<-request.doneCh
+	queueID, ok := queueBase.domainIsolation.getQueueID("domain1-id")
+	require.True(t, ok)
+	assert.Equal(t, isolatedQueueIDBase, queueID)
+	assert.Equal(t, 1, queueStateUpdates)
+
+	// isolating the domain again doesn't move any slice
+	request = &domainIsolationRequest{domainID: "domain1-id", isolated: true, doneCh: make(chan struct{})}
+	queueBase.handleDomainIsolationRequest(context.Background(), request)
+	<-request.doneCh
+	assert.Equal(t, 2, queueStateUpdates)
+
+	// release the domain
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+		rootQueueID:         mockRootQueue,
+		isolatedQueueIDBase: mockIsolatedQueue,
+	})
+	mockIsolatedQueue.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+		isolatedSliceState := newTestSliceState(100, 200)
+		isolatedSliceState.Predicate = isolatedPredicate
+		remaining, split := f(NewVirtualSlice(isolatedSliceState, nil, nil, NewPendingTaskTracker(), logger))
+		assert.True(t, split)
+		assert.Empty(t, remaining)
+	})
+	mockVirtualQueueManager.EXPECT().GetOrCreateVirtualQueue(int64(rootQueueID)).Return(mockRootQueue)
+	mockRootQueue.EXPECT().MergeSlices(gomock.Any()).Do(func(slices ...VirtualSlice) {
+		require.Len(t, slices, 1)
+		assert.True(t, isolatedPredicate.Equals(slices[0].GetState().Predicate))
+	})
+
+	request = &domainIsolationRequest{domainID: "domain1-id", isolated: false, doneCh: make(chan struct{})}
+	queueBase.handleDomainIsolationRequest(context.Background(), request)
+	<-request.doneCh
+	assert.Empty(t, queueBase.domainIsolation.getQueueIDs())
+	assert.Equal(t, 3, queueStateUpdates)
+}
+
+func TestQueueBase_UpdateDomainIsolation(t *testing.T) {
+	queueBase := &queueBase{
+		domainIsolationCh: make(chan *domainIsolationRequest),
+	}
+
+	_, err := queueBase.HandleAction(context.Background(), "", queue.NewUpdateDomainIsolationAction("", true))
+	var badRequestErr *types.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+
+	go func() {
+		request := <-queueBase.domainIsolationCh
+		assert.Equal(t, "domain1-id", request.domainID)
+		assert.True(t, request.isolated)
+		close(request.doneCh)
+	}()
+	result, err := queueBase.HandleAction(context.Background(), "", queue.NewUpdateDomainIsolationAction("domain1-id", true))
+	require.NoError(t, err)
+	assert.Equal(t, queue.ActionTypeUpdateDomainIsolation, result.ActionType)
+	assert.NotNil(t, result.UpdateDomainIsolationResult)
+
+	// the request is not picked up by the event loop
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = queueBase.HandleAction(ctx, "", queue.NewUpdateDomainIsolationAction("domain1-id", false))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestQueueBase_AddDrainedIsolatedQueueStates(t *testing.T) {
+	queueBase := &queueBase{
+		domainIsolation: newDomainIsolation(nil),
+	}
+	drainedQueueID := queueBase.domainIsolation.addDomain("domain1-id")
+	queueID := queueBase.domainIsolation.addDomain("domain2-id")
+
+	isolatedSliceState := newTestSliceState(100, 200)
+	isolatedSliceState.Predicate = NewDomainIDPredicate([]string{"domain2-id"}, false)
+	state := &QueueState{
+		VirtualQueueStates: map[int64][]VirtualSliceState{
+			rootQueueID: {newTestSliceState(100, 300)},
+			queueID:     {isolatedSliceState},
+		},
+		ExclusiveMaxReadLevel: persistence.NewImmediateTaskKey(300),
+	}
+	queueBase.addDrainedIsolatedQueueStates(state)
+
+	assert.Equal(t, []VirtualSliceState{isolatedSliceState}, state.VirtualQueueStates[queueID])
+	require.Len(t, state.VirtualQueueStates[drainedQueueID], 1)
+	drainedState := state.VirtualQueueStates[drainedQueueID][0]
+	assert.True(t, drainedState.Range.IsEmpty())
+	assert.Equal(t, persistence.NewImmediateTaskKey(300), drainedState.Range.InclusiveMinTaskKey)
+	assert.True(t, NewDomainIDPredicate([]string{"domain1-id"}, false).Equals(drainedState.Predicate))
+	// the drained isolated virtual queue doesn't hold back the ack level
+	ackLevel, _ := getExclusiveAckLevelAndMaxQueueIDFromQueueState(state)
+	assert.Equal(t, persistence.NewImmediateTaskKey(100), ackLevel)
+
+	// the isolated domains are recovered from the persisted queue state after shard movement
+	persistedState := FromPersistenceQueueState(ToPersistenceQueueState(state))
+	d := newDomainIsolation(persistedState.VirtualQueueStates)
+	assert.Equal(t, map[string]int64{"domain1-id": drainedQueueID, "domain2-id": queueID}, d.getQueueIDs())
+	virtualQueueStates := removeEmptyVirtualSliceStates(persistedState.VirtualQueueStates)
+	assert.NotContains(t, virtualQueueStates, drainedQueueID)
+	assert.Len(t, virtualQueueStates[queueID], 1)
+	assert.Len(t, virtualQueueStates[rootQueueID], 1)
+}
+
+func TestQueueBase_IsolateNewVirtualSlice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockIsolatedQueue := NewMockVirtualQueue(ctrl)
+
+	queueBase := &queueBase{
+		virtualQueueManager: mockVirtualQueueManager,
+		domainIsolation:     newDomainIsolation(nil),
+	}
+	queueID := queueBase.domainIsolation.addDomain("domain1-id")
+
+	mockVirtualQueueManager.EXPECT().GetOrCreateVirtualQueue(queueID).Return(mockIsolatedQueue)
+	mockIsolatedQueue.EXPECT().MergeWithLastSlice(gomock.Any()).Do(func(slice VirtualSlice) {
+		assert.True(t, NewDomainIDPredicate([]string{"domain1-id"}, false).Equals(slice.GetState().Predicate))
+	})
+
+	remaining := queueBase.isolateNewVirtualSlice(NewVirtualSlice(newTestSliceState(100, 200), nil, nil, NewPendingTaskTracker(), testlogger.New(t)))
+	assert.True(t, NewDomainIDPredicate([]string{"domain1-id"}, true).Equals(remaining.GetState().Predicate))
+	assert.Equal(t, newTestSliceState(100, 200).Range, remaining.GetState().Range)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queuev2
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+)
+
+type (
+	// DomainThrottler limits the rate at which the tasks of the domains throttled by the mitigator are submitted.
+	// The tasks of a throttled domain are spread out at the configured rate by rescheduling them, and a domain
+	// stays throttled for a while after it was last throttled.
+	DomainThrottler interface {
+		// Throttle starts or extends the throttling of the domains
+		Throttle(domainIDs ...string)
+		// Delay returns how long the submission of a task of the domain must be delayed, which is zero
+		// if the domain is not throttled. Each call takes the next submission slot of the domain.
+		Delay(domainID string) time.Duration
+		// ThrottledDomains returns the IDs of the domains currently throttled
+		ThrottledDomains() []string
+	}
+
+	DomainThrottlerOptions struct {
+		// RPS is the rate of the tasks of a throttled domain, 0 disables the throttling
+		RPS dynamicproperties.IntPropertyFn
+		// Duration is how long a domain stays throttled after it was last throttled
+		Duration dynamicproperties.DurationPropertyFn
+	}
+
+	domainThrottlerImpl struct {
+		timeSource clock.TimeSource
+		options    *DomainThrottlerOptions
+
+		sync.Mutex
+		domains map[string]*throttledDomain
+	}
+
+	throttledDomain struct {
+		expireTime     time.Time
+		nextSubmitTime time.Time
+	}
+
+	noopDomainThrottler struct{}
+)
+
+func NewDomainThrottler(timeSource clock.TimeSource, options *DomainThrottlerOptions) DomainThrottler {
+	return &domainThrottlerImpl{
+		timeSource: timeSource,
+		options:    options,
+		domains:    make(map[string]*throttledDomain),
+	}
+}
+
+func (t *domainThrottlerImpl) Throttle(domainIDs ...string) {
+	if t.options.RPS() <= 0 {
+		return
+	}
+	expireTime := t.timeSource.Now().Add(t.options.Duration())
+
+	t.Lock()
+	defer t.Unlock()
+	for _, domainID := range domainIDs {
+		if domain, ok := t.domains[domainID]; ok {
+			domain.expireTime = expireTime
+			continue
+		}
+		t.domains[domainID] = &throttledDomain{expireTime: expireTime}
+	}
+}
+
+func (t *domainThrottlerImpl) Delay(domainID string) time.Duration {
+	now := t.timeSource.Now()
+
+	t.Lock()
+	defer t.Unlock()
+	domain, ok := t.domains[domainID]
+	if !ok {
+		return 0
+	}
+	rps := t.options.RPS()
+	if rps <= 0 || !now.Before(domain.expireTime) {
+		delete(t.domains, domainID)
+		return 0
+	}
+	submitTime := domain.nextSubmitTime
+	if submitTime.Before(now) {
+		submitTime = now
+	}
+	domain.nextSubmitTime = submitTime.Add(time.Second / time.Duration(rps))
+	return submitTime.Sub(now)
+}
+
+func (t *domainThrottlerImpl) ThrottledDomains() []string {
+	now := t.timeSource.Now()
+
+	t.Lock()
+	defer t.Unlock()
+	var domainIDs []string
+	for domainID, domain := range t.domains {
+		if now.Before(domain.expireTime) {
+			domainIDs = append(domainIDs, domainID)
+		}
+	}
+	return domainIDs
+}
+
+func (noopDomainThrottler) Throttle(...string) {}
+
+func (noopDomainThrottler) Delay(string) time.Duration { return 0 }
+
+func (noopDomainThrottler) ThrottledDomains() []string { return nil }
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queuev2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+)
+
+func TestDomainThrottler(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	rps := 10
+	throttler := NewDomainThrottler(timeSource, &DomainThrottlerOptions{
+		RPS:      func(...dynamicproperties.FilterOption) int { return rps },
+		Duration: dynamicproperties.GetDurationPropertyFn(time.Minute),
+	})
+
+	assert.Zero(t, throttler.Delay("domain1"), "a domain is not throttled until the mitigator throttles it")
+
+	throttler.Throttle("domain1")
+	assert.Equal(t, []string{"domain1"}, throttler.ThrottledDomains())
+	assert.Zero(t, throttler.Delay("domain1"))
+	assert.Equal(t, 100*time.Millisecond, throttler.Delay("domain1"))
+	assert.Equal(t, 200*time.Millisecond, throttler.Delay("domain1"))
+	assert.Zero(t, throttler.Delay("domain2"), "the other domains are not throttled")
+
+	// the submission slots don't accumulate while the domain has no task
+	timeSource.Advance(time.Second)
+	assert.Zero(t, throttler.Delay("domain1"))
+	assert.Equal(t, 100*time.Millisecond, throttler.Delay("domain1"))
+
+	// throttling the domain again extends the throttling
+	timeSource.Advance(50 * time.Second)
+	throttler.Throttle("domain1")
+	timeSource.Advance(50 * time.Second)
+	assert.Equal(t, []string{"domain1"}, throttler.ThrottledDomains())
+	assert.Zero(t, throttler.Delay("domain1"))
+
+	timeSource.Advance(10 * time.Second)
+	assert.Empty(t, throttler.ThrottledDomains())
+	assert.Zero(t, throttler.Delay("domain1"))
+	assert.Zero(t, throttler.Delay("domain1"), "the throttling of the domain expired")
+
+	rps = 0
+	throttler.Throttle("domain1")
+	assert.Empty(t, throttler.ThrottledDomains(), "a zero rate disables the throttling")
+}
@@ -31,6 +31,7 @@ type (
 	mitigatorImpl struct {
 		virtualQueueManager VirtualQueueManager
 		monitor             Monitor
+		domainThrottler     DomainThrottler
 		logger              log.Logger
 		metricsScope        metrics.Scope
 		options             *MitigatorOptions
@@ -49,6 +50,7 @@ type (
 func NewMitigator(
 	virtualQueueManager VirtualQueueManager,
 	monitor Monitor,
+	domainThrottler DomainThrottler,
 	logger log.Logger,
 	metricsScope metrics.Scope,
 	options *MitigatorOptions,
@@ -56,12 +58,17 @@ func NewMitigator(
 	m := &mitigatorImpl{
 		virtualQueueManager: virtualQueueManager,
 		monitor:             monitor,
+		domainThrottler:     domainThrottler,
 		logger:              logger,
 		metricsScope:        metricsScope,
 		options:             options,
 	}
 	m.handlers = map[AlertType]func(Alert){
-		AlertTypeQueuePendingTaskCount: m.handleQueuePendingTaskCount,
+		AlertTypeQueuePendingTaskCount:  m.handleQueuePendingTaskCount,
+		AlertTypeQueueStuckAckLevel:     m.handleQueueStuckAckLevel,
+		AlertTypeQueueTaskAttempt:       m.handleQueueTaskAttempt,
+		AlertTypeQueueReadLevelLag:      m.handleQueueReadLevelLag,
+		AlertTypeQueueVirtualSliceCount: m.handleQueueVirtualSliceCount,
 	}
 	return m
 }
@@ -130,6 +137,122 @@ func (m *mitigatorImpl) handleQueuePendingTaskCount(alert Alert) {
 	}
 }
 
+func (m *mitigatorImpl) handleQueueStuckAckLevel(alert Alert) {
+	// First, shrink the slices by pruning acknowledged tasks to see if the ack level can move forward
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	var oldestSlice VirtualSlice
+	for queueID, virtualQueue := range virtualQueues {
+		if isIsolatedQueueID(queueID) {
+			continue
+		}
+		virtualQueue.UpdateAndGetState()
+		virtualQueue.IterateSlices(func(slice VirtualSlice) {
+			if oldestSlice == nil || slice.GetState().Range.InclusiveMinTaskKey.Compare(oldestSlice.GetState().Range.InclusiveMinTaskKey) < 0 {
+				oldestSlice = slice
+			}
+		})
+	}
+	if oldestSlice == nil || oldestSlice.GetState().Range.InclusiveMinTaskKey.Compare(alert.AlertAttributesQueueStuckAckLevel.AckLevel) > 0 {
+		m.logger.Debug("mitigating queue alert, skip mitigation because the alert is no longer valid")
+		return
+	}
+
+	// Second, the oldest slice is the one holding the ack level, move the pending tasks of its domains to the
+	// next virtual queue, or clear them from the slice in the last one, and throttle these domains, so that they
+	// don't block the other domains
+	var domains []string
+	for domain, count := range oldestSlice.PendingTaskStats().PendingTaskCountPerDomain {
+		if count > 0 {
+			domains = append(domains, domain)
+		}
+	}
+	if len(domains) == 0 {
+		m.logger.Debug("mitigating queue alert, skip mitigation because the oldest slice has no pending tasks")
+		return
+	}
+	slices.Sort(domains)
+	m.logger.Info("mitigating queue alert, move out and throttle the domains blocking the ack level",
+		tag.AlertType(int(alert.AlertType)),
+		tag.Dynamic("slice", ToPersistenceVirtualSliceState(oldestSlice.GetState())),
+		tag.WorkflowDomainIDs(domains),
+	)
+	m.processQueueSplitsAndClear(virtualQueues, map[VirtualSlice][]string{oldestSlice: domains})
+	m.domainThrottler.Throttle(domains...)
+}
+
+// handleQueueTaskAttempt moves the pending tasks of the domains retried too many times out of their slices
+// and throttles these domains
+func (m *mitigatorImpl) handleQueueTaskAttempt(alert Alert) {
+	criticalTaskAttempt := alert.AlertAttributesQueueTaskAttempt.CriticalTaskAttempt
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	domainsToClearPerSlice := make(map[VirtualSlice][]string)
+	for queueID, virtualQueue := range virtualQueues {
+		if isIsolatedQueueID(queueID) {
+			continue
+		}
+		virtualQueue.IterateSlices(func(slice VirtualSlice) {
+			for domain, attempt := range slice.PendingTaskStats().MaxTaskAttemptPerDomain {
+				if attempt > criticalTaskAttempt {
+					domainsToClearPerSlice[slice] = append(domainsToClearPerSlice[slice], domain)
+				}
+			}
+		})
+	}
+	if len(domainsToClearPerSlice) == 0 {
+		m.logger.Debug("mitigating queue alert, skip mitigation because the alert is no longer valid")
+		return
+	}
+	for slice, domains := range domainsToClearPerSlice {
+		slices.Sort(domains)
+		m.logger.Info("mitigating queue alert, move out and throttle the domains with too many task attempts",
+			tag.AlertType(int(alert.AlertType)),
+			tag.Dynamic("slice", ToPersistenceVirtualSliceState(slice.GetState())),
+			tag.WorkflowDomainIDs(domains),
+		)
+		m.domainThrottler.Throttle(domains...)
+	}
+	m.processQueueSplitsAndClear(virtualQueues, domainsToClearPerSlice)
+}
+
+func (m *mitigatorImpl) handleQueueReadLevelLag(alert Alert) {
+	// The root and non-root virtual queues share the same rate limiter to load tasks, pause the non-root virtual queues
+	// so that the root virtual queue can catch up with the new tasks. The isolated virtual queues have their own
+	// rate limiter, so they are left alone.
+	for queueID, virtualQueue := range m.virtualQueueManager.VirtualQueues() {
+		if queueID == rootQueueID || isIsolatedQueueID(queueID) {
+			continue
+		}
+		virtualQueue.Pause(clearSliceThrottleDuration)
+		m.logger.Info("mitigating queue alert, pause non-root virtual queue", tag.AlertType(int(alert.AlertType)), tag.VirtualQueueID(queueID))
+	}
+}
+
+func (m *mitigatorImpl) handleQueueVirtualSliceCount(alert Alert) {
+	// First, shrink the slices to see if empty slices can be removed
+	virtualQueues := m.virtualQueueManager.VirtualQueues()
+	for _, virtualQueue := range virtualQueues {
+		virtualQueue.UpdateAndGetState()
+	}
+	if m.monitor.GetVirtualSliceCount() <= alert.AlertAttributesQueueVirtualSliceCount.CriticalVirtualSliceCount {
+		m.logger.Debug("mitigating queue alert, skip mitigation because the alert is no longer valid")
+		return
+	}
+
+	// Second, take all slices out of each virtual queue and merge them back, so that overlapping and adjacent slices are merged
+	for _, virtualQueue := range virtualQueues {
+		var slicesToMerge []VirtualSlice
+		virtualQueue.SplitSlices(func(slice VirtualSlice) ([]VirtualSlice, bool) {
+			slicesToMerge = append(slicesToMerge, slice)
+			return nil, true
+		})
+		virtualQueue.MergeSlices(slicesToMerge...)
+	}
+	m.logger.Info("mitigating queue alert, merged virtual slices",
+		tag.AlertType(int(alert.AlertType)),
+		tag.Dynamic("virtual-slice-count", m.monitor.GetVirtualSliceCount()),
+	)
+}
+
 // The stats of pending tasks are used to calculate the domains to clear. We need:
 // 1. The total number of pending tasks per domain
 // 2. The number of pending tasks per domain per slice
@@ -141,7 +264,11 @@ func (m *mitigatorImpl) collectPendingTaskStats() pendingTaskStats {
 		slicesPerDomain:                   make(map[string][]VirtualSlice),
 	}
 
-	for _, virtualQueue := range m.virtualQueueManager.VirtualQueues() {
+	for queueID, virtualQueue := range m.virtualQueueManager.VirtualQueues() {
+		if isIsolatedQueueID(queueID) {
+			// the tasks of isolated domains can't be moved by the mitigator
+			continue
+		}
 		virtualQueue.IterateSlices(func(slice VirtualSlice) {
 			perDomain := slice.PendingTaskStats().PendingTaskCountPerDomain
 			stats.pendingTaskCountPerDomainPerSlice[slice] = perDomain
@@ -196,9 +323,16 @@ func (m *mitigatorImpl) findDomainsToClear(stats pendingTaskStats, targetCount i
 	return domainsToClear
 }
 
+// processQueueSplitsAndClear moves the pending tasks of the given domains out of their slices. In every virtual queue
+// but the last one, the tasks are split into a new slice of the next virtual queue, which is paused for a while before
+// loading them again. In the last virtual queue, the slices are cleared and the queue is paused before loading them again.
+// The virtual queues of isolated domains are left alone, their tasks are only moved by the operators.
 func (m *mitigatorImpl) processQueueSplitsAndClear(virtualQueues map[int64]VirtualQueue, domainsToClear map[VirtualSlice][]string) {
 	maxQueueID := m.options.MaxVirtualQueueCount() - 1
 	for queueID, vq := range virtualQueues {
+		if isIsolatedQueueID(queueID) {
+			continue
+		}
 		if queueID >= int64(maxQueueID) {
 			// Clear slices in the last queue
 			cleared := false
@@ -2,11 +2,13 @@ package queuev2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
@@ -27,6 +29,7 @@ func TestNewMitigator(t *testing.T) {
 	mitigator := NewMitigator(
 		mockVirtualQueueManager,
 		mockMonitor,
+		noopDomainThrottler{},
 		logger,
 		metricsScope,
 		options,
@@ -44,9 +47,17 @@ func TestNewMitigator(t *testing.T) {
 
 	// Verify handlers are properly initialized
 	assert.NotNil(t, impl.handlers)
-	assert.Len(t, impl.handlers, 1)
-	_, exists := impl.handlers[AlertTypeQueuePendingTaskCount]
-	assert.True(t, exists)
+	assert.Len(t, impl.handlers, 5)
+	for _, alertType := range []AlertType{
+		AlertTypeQueuePendingTaskCount,
+		AlertTypeQueueStuckAckLevel,
+		AlertTypeQueueTaskAttempt,
+		AlertTypeQueueReadLevelLag,
+		AlertTypeQueueVirtualSliceCount,
+	} {
+		_, exists := impl.handlers[alertType]
+		assert.True(t, exists, "alert type: %v", alertType)
+	}
 }
 
 func TestMitigator_Mitigate_KnownAlertType(t *testing.T) {
@@ -64,6 +75,7 @@ func TestMitigator_Mitigate_KnownAlertType(t *testing.T) {
 	mitigator := NewMitigator(
 		mockVirtualQueueManager,
 		mockMonitor,
+		noopDomainThrottler{},
 		logger,
 		metricsScope,
 		options,
@@ -104,6 +116,7 @@ func TestMitigator_Mitigate_UnknownAlertType(t *testing.T) {
 	mitigator := NewMitigator(
 		mockVirtualQueueManager,
 		mockMonitor,
+		noopDomainThrottler{},
 		logger,
 		metricsScope,
 		options,
@@ -144,6 +157,23 @@ func TestMitigator_collectPendingTaskStats(t *testing.T) {
 				assert.Empty(t, stats.pendingTaskCountPerDomainPerSlice)
 			},
 		},
+		{
+			name: "isolated queue is skipped",
+			setupMocks: func(ctrl *gomock.Controller) (*MockVirtualQueueManager, map[string][]VirtualSlice, map[VirtualSlice]map[string]int) {
+				mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+				// IterateSlices is not expected on the virtual queue of an isolated domain
+				mockVirtualQueue := NewMockVirtualQueue(ctrl)
+				mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{isolatedQueueIDBase: mockVirtualQueue}).Times(1)
+				return mockVirtualQueueManager, map[string][]VirtualSlice{}, map[VirtualSlice]map[string]int{}
+			},
+			expectedTotalPendingTaskCount:     0,
+			expectedPendingTaskCountPerDomain: map[string]int{},
+			expectedSlicesPerDomainLength:     map[string]int{},
+			validateResults: func(t *testing.T, stats pendingTaskStats, expectedSlicesPerDomain map[string][]VirtualSlice, expectedPendingTaskCountPerDomainPerSlice map[VirtualSlice]map[string]int) {
+				assert.Empty(t, stats.pendingTaskCountPerDomainPerSlice)
+				assert.Empty(t, stats.slicesPerDomain)
+			},
+		},
 		{
 			name: "single queue single slice",
 			setupMocks: func(ctrl *gomock.Controller) (*MockVirtualQueueManager, map[string][]VirtualSlice, map[VirtualSlice]map[string]int) {
@@ -369,6 +399,7 @@ func TestMitigator_collectPendingTaskStats(t *testing.T) {
 			mitigator := NewMitigator(
 				mockVirtualQueueManager,
 				mockMonitor,
+				noopDomainThrottler{},
 				logger,
 				metricsScope,
 				options,
@@ -452,6 +483,7 @@ func TestMitigator_findDomainsToClear(t *testing.T) {
 			mitigator := NewMitigator(
 				mockVirtualQueueManager,
 				mockMonitor,
+				noopDomainThrottler{},
 				logger,
 				metricsScope,
 				options,
@@ -529,6 +561,23 @@ func TestMitigator_processQueueSplitsAndClear(t *testing.T) {
 				return virtualQueues, domainsToClear, maxQueueCount
 			},
 		},
+		{
+			name: "isolated queue - no operations",
+			setupMocks: func(ctrl *gomock.Controller) (map[int64]VirtualQueue, map[VirtualSlice][]string, int) {
+				mockSlice := NewMockVirtualSlice(ctrl)
+				// no calls are expected on the virtual queue of an isolated domain
+				mockVQ := NewMockVirtualQueue(ctrl)
+
+				virtualQueues := map[int64]VirtualQueue{
+					isolatedQueueIDBase: mockVQ,
+				}
+				domainsToClear := map[VirtualSlice][]string{
+					mockSlice: {"domain1"},
+				}
+				maxQueueCount := 3
+				return virtualQueues, domainsToClear, maxQueueCount
+			},
+		},
 		{
 			name: "split and move slices - successful split",
 			setupMocks: func(ctrl *gomock.Controller) (map[int64]VirtualQueue, map[VirtualSlice][]string, int) {
@@ -733,3 +782,240 @@ func TestMitigator_processQueueSplitsAndClear(t *testing.T) {
 		})
 	}
 }
+
+func newTestMitigator(t *testing.T, mockVirtualQueueManager VirtualQueueManager, mockMonitor Monitor, maxVirtualQueueCount int) *mitigatorImpl {
+	mitigator := NewMitigator(
+		mockVirtualQueueManager,
+		mockMonitor,
+		NewDomainThrottler(clock.NewMockedTimeSource(), &DomainThrottlerOptions{
+			RPS:      dynamicproperties.GetIntPropertyFn(10),
+			Duration: dynamicproperties.GetDurationPropertyFn(time.Minute),
+		}),
+		testlogger.New(t),
+		metrics.NoopScope,
+		&MitigatorOptions{
+			MaxVirtualQueueCount: dynamicproperties.GetIntPropertyFn(maxVirtualQueueCount),
+		},
+	)
+	impl, ok := mitigator.(*mitigatorImpl)
+	require.True(t, ok)
+	return impl
+}
+
+func newTestSliceState(inclusiveMinTaskID, exclusiveMaxTaskID int64) VirtualSliceState {
+	return VirtualSliceState{
+		Range: Range{
+			InclusiveMinTaskKey: persistence.NewImmediateTaskKey(inclusiveMinTaskID),
+			ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(exclusiveMaxTaskID),
+		},
+		Predicate: NewUniversalPredicate(),
+	}
+}
+
+func TestMitigator_handleQueueStuckAckLevel(t *testing.T) {
+	alert := Alert{
+		AlertType: AlertTypeQueueStuckAckLevel,
+		AlertAttributesQueueStuckAckLevel: &AlertAttributesQueueStuckAckLevel{
+			AckLevel:              persistence.NewImmediateTaskKey(100),
+			StuckDuration:         time.Minute * 2,
+			CriticalStuckDuration: time.Minute,
+		},
+	}
+
+	t.Run("ack level moved after shrinking slices", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+		mockVirtualQueue := NewMockVirtualQueue(ctrl)
+		mockSlice := NewMockVirtualSlice(ctrl)
+
+		mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: mockVirtualQueue})
+		mockVirtualQueue.EXPECT().UpdateAndGetState()
+		mockVirtualQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+			f(mockSlice)
+		})
+		mockSlice.EXPECT().GetState().Return(newTestSliceState(200, 300)).AnyTimes()
+
+		newTestMitigator(t, mockVirtualQueueManager, NewMockMonitor(ctrl), 2).handleQueueStuckAckLevel(alert)
+	})
+
+	t.Run("move out domains of the oldest slice", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+		mockVirtualQueue := NewMockVirtualQueue(ctrl)
+		mockSlice1 := NewMockVirtualSlice(ctrl)
+		mockSlice2 := NewMockVirtualSlice(ctrl)
+
+		mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: mockVirtualQueue})
+		mockVirtualQueue.EXPECT().UpdateAndGetState()
+		mockVirtualQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+			f(mockSlice1)
+			f(mockSlice2)
+		})
+		mockSlice1.EXPECT().GetState().Return(newTestSliceState(100, 200)).AnyTimes()
+		mockSlice2.EXPECT().GetState().Return(newTestSliceState(200, 300)).AnyTimes()
+		mockSlice1.EXPECT().PendingTaskStats().Return(PendingTaskStats{
+			PendingTaskCountPerDomain: map[string]int{"domain1": 2, "domain2": 0},
+		})
+		// the root queue is the last queue, so the slice is cleared instead of being moved
+		mockVirtualQueue.EXPECT().ClearSlices(gomock.Any()).Do(func(f func(VirtualSlice) bool) {
+			assert.True(t, f(mockSlice1))
+			assert.False(t, f(mockSlice2))
+		})
+		mockVirtualQueue.EXPECT().Pause(clearSliceThrottleDuration)
+
+		mitigator := newTestMitigator(t, mockVirtualQueueManager, NewMockMonitor(ctrl), 1)
+		mitigator.handleQueueStuckAckLevel(alert)
+		assert.Equal(t, []string{"domain1"}, mitigator.domainThrottler.ThrottledDomains())
+	})
+
+	t.Run("isolated queue is skipped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+		mockVirtualQueue := NewMockVirtualQueue(ctrl)
+		mockIsolatedQueue := NewMockVirtualQueue(ctrl)
+		mockSlice := NewMockVirtualSlice(ctrl)
+
+		mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+			rootQueueID:         mockVirtualQueue,
+			isolatedQueueIDBase: mockIsolatedQueue,
+		})
+		mockVirtualQueue.EXPECT().UpdateAndGetState()
+		mockVirtualQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+			f(mockSlice)
+		})
+		mockSlice.EXPECT().GetState().Return(newTestSliceState(200, 300)).AnyTimes()
+
+		mitigator := newTestMitigator(t, mockVirtualQueueManager, NewMockMonitor(ctrl), 2)
+		mitigator.handleQueueStuckAckLevel(alert)
+		assert.Empty(t, mitigator.domainThrottler.ThrottledDomains())
+	})
+}
+
+func TestMitigator_handleQueueTaskAttempt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockVirtualQueue := NewMockVirtualQueue(ctrl)
+	mockNextVirtualQueue := NewMockVirtualQueue(ctrl)
+	mockSlice1 := NewMockVirtualSlice(ctrl)
+	mockSlice2 := NewMockVirtualSlice(ctrl)
+	mockSplitSlice := NewMockVirtualSlice(ctrl)
+	mockRemainingSlice := NewMockVirtualSlice(ctrl)
+
+	// the tasks of the isolated domains are never moved
+	mockIsolatedQueue := NewMockVirtualQueue(ctrl)
+
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+		rootQueueID:         mockVirtualQueue,
+		isolatedQueueIDBase: mockIsolatedQueue,
+	})
+	mockVirtualQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+		f(mockSlice1)
+		f(mockSlice2)
+	})
+	mockSlice1.EXPECT().GetState().Return(newTestSliceState(100, 200)).AnyTimes()
+	mockSlice1.EXPECT().PendingTaskStats().Return(PendingTaskStats{
+		MaxTaskAttemptPerDomain: map[string]int{"domain1": 11, "domain2": 3},
+	})
+	mockSlice2.EXPECT().PendingTaskStats().Return(PendingTaskStats{
+		MaxTaskAttemptPerDomain: map[string]int{"domain1": 10},
+	})
+	mockVirtualQueue.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+		remaining, split := f(mockSlice1)
+		assert.True(t, split)
+		assert.Equal(t, []VirtualSlice{mockRemainingSlice}, remaining)
+		remaining, split = f(mockSlice2)
+		assert.False(t, split)
+		assert.Nil(t, remaining)
+	})
+	mockSlice1.EXPECT().TrySplitByPredicate(NewDomainIDPredicate([]string{"domain1"}, false)).Return(mockSplitSlice, mockRemainingSlice, true)
+	mockSplitSlice.EXPECT().Clear()
+	mockVirtualQueueManager.EXPECT().GetOrCreateVirtualQueue(int64(rootQueueID + 1)).Return(mockNextVirtualQueue)
+	mockNextVirtualQueue.EXPECT().Pause(clearSliceThrottleDuration)
+	mockNextVirtualQueue.EXPECT().MergeSlices(mockSplitSlice)
+
+	mitigator := newTestMitigator(t, mockVirtualQueueManager, NewMockMonitor(ctrl), 2)
+	mitigator.handleQueueTaskAttempt(Alert{
+		AlertType: AlertTypeQueueTaskAttempt,
+		AlertAttributesQueueTaskAttempt: &AlertAttributesQueueTaskAttempt{
+			CurrentMaxTaskAttempt: 11,
+			CriticalTaskAttempt:   10,
+		},
+	})
+	assert.Equal(t, []string{"domain1"}, mitigator.domainThrottler.ThrottledDomains())
+}
+
+func TestMitigator_handleQueueReadLevelLag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockRootQueue := NewMockVirtualQueue(ctrl)
+	mockVirtualQueue1 := NewMockVirtualQueue(ctrl)
+	mockVirtualQueue2 := NewMockVirtualQueue(ctrl)
+	mockIsolatedQueue := NewMockVirtualQueue(ctrl)
+
+	// the isolated queues have their own task load rate limiter, so they are not paused
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+		rootQueueID:         mockRootQueue,
+		1:                   mockVirtualQueue1,
+		2:                   mockVirtualQueue2,
+		isolatedQueueIDBase: mockIsolatedQueue,
+	})
+	mockVirtualQueue1.EXPECT().Pause(clearSliceThrottleDuration)
+	mockVirtualQueue2.EXPECT().Pause(clearSliceThrottleDuration)
+
+	newTestMitigator(t, mockVirtualQueueManager, NewMockMonitor(ctrl), 3).handleQueueReadLevelLag(Alert{
+		AlertType: AlertTypeQueueReadLevelLag,
+		AlertAttributesQueueReadLevelLag: &AlertAttributesQueueReadLevelLag{
+			CurrentReadLevelLag:  2000,
+			CriticalReadLevelLag: 1000,
+		},
+	})
+}
+
+func TestMitigator_handleQueueVirtualSliceCount(t *testing.T) {
+	alert := Alert{
+		AlertType: AlertTypeQueueVirtualSliceCount,
+		AlertAttributesQueueVirtualSliceCount: &AlertAttributesQueueVirtualSliceCount{
+			CurrentVirtualSliceCount:  5,
+			CriticalVirtualSliceCount: 3,
+		},
+	}
+
+	t.Run("slice count reduced after shrinking slices", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+		mockMonitor := NewMockMonitor(ctrl)
+		mockVirtualQueue := NewMockVirtualQueue(ctrl)
+
+		mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: mockVirtualQueue})
+		mockVirtualQueue.EXPECT().UpdateAndGetState()
+		mockMonitor.EXPECT().GetVirtualSliceCount().Return(3)
+
+		newTestMitigator(t, mockVirtualQueueManager, mockMonitor, 2).handleQueueVirtualSliceCount(alert)
+	})
+
+	t.Run("merge slices", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+		mockMonitor := NewMockMonitor(ctrl)
+		mockVirtualQueue := NewMockVirtualQueue(ctrl)
+		mockSlice1 := NewMockVirtualSlice(ctrl)
+		mockSlice2 := NewMockVirtualSlice(ctrl)
+
+		mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{rootQueueID: mockVirtualQueue})
+		mockVirtualQueue.EXPECT().UpdateAndGetState()
+		gomock.InOrder(
+			mockMonitor.EXPECT().GetVirtualSliceCount().Return(5),
+			mockMonitor.EXPECT().GetVirtualSliceCount().Return(1),
+		)
+		mockVirtualQueue.EXPECT().SplitSlices(gomock.Any()).Do(func(f func(VirtualSlice) ([]VirtualSlice, bool)) {
+			for _, slice := range []VirtualSlice{mockSlice1, mockSlice2} {
+				remaining, split := f(slice)
+				assert.True(t, split)
+				assert.Nil(t, remaining)
+			}
+		})
+		mockVirtualQueue.EXPECT().MergeSlices(mockSlice1, mockSlice2)
+
+		newTestMitigator(t, mockVirtualQueueManager, mockMonitor, 2).handleQueueVirtualSliceCount(alert)
+	})
+}
@@ -3,7 +3,9 @@ package queuev2
 
 import (
 	"sync"
+	"time"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -16,35 +18,50 @@ type (
 		GetSlicePendingTaskCount(VirtualSlice) int
 		SetSlicePendingTaskCount(VirtualSlice, int)
 		RemoveSlice(VirtualSlice)
+		GetVirtualSliceCount() int
+		SetExclusiveAckLevel(persistence.HistoryTaskKey)
+		SetMaxTaskAttempt(int)
+		SetReadLevelLag(int64)
 		ResolveAlert(AlertType)
 	}
 
+	// MonitorOptions contains the thresholds of the alerts, an alert is disabled if its threshold is not positive
 	MonitorOptions struct {
-		EnablePendingTaskCountAlert func() bool
-		CriticalPendingTaskCount    dynamicproperties.IntPropertyFn
+		EnablePendingTaskCountAlert   func() bool
+		CriticalPendingTaskCount      dynamicproperties.IntPropertyFn
+		CriticalAckLevelStuckDuration dynamicproperties.DurationPropertyFn
+		CriticalTaskAttempt           dynamicproperties.IntPropertyFn
+		CriticalReadLevelLag          dynamicproperties.IntPropertyFn
+		CriticalVirtualSliceCount     dynamicproperties.IntPropertyFn
 	}
 
 	monitorImpl struct {
 		sync.Mutex
 
-		category persistence.HistoryTaskCategory
-		options  *MonitorOptions
+		category   persistence.HistoryTaskCategory
+		timeSource clock.TimeSource
+		options    *MonitorOptions
 
 		subscriber            chan<- *Alert
 		pendingAlerts         map[AlertType]struct{}
 		totalPendingTaskCount int
 		slicePendingTaskCount map[VirtualSlice]int
+		exclusiveAckLevel     persistence.HistoryTaskKey
+		ackLevelUpdateTime    time.Time
 	}
 )
 
-func NewMonitor(category persistence.HistoryTaskCategory, options *MonitorOptions) Monitor {
+func NewMonitor(category persistence.HistoryTaskCategory, timeSource clock.TimeSource, options *MonitorOptions) Monitor {
 	return &monitorImpl{
-		category: category,
-		options:  options,
+		category:   category,
+		timeSource: timeSource,
+		options:    options,
 
 		pendingAlerts:         make(map[AlertType]struct{}),
 		totalPendingTaskCount: 0,
 		slicePendingTaskCount: make(map[VirtualSlice]int),
+		exclusiveAckLevel:     persistence.MinimumHistoryTaskKey,
+		ackLevelUpdateTime:    timeSource.Now(),
 	}
 }
 
@@ -91,6 +108,17 @@ func (m *monitorImpl) SetSlicePendingTaskCount(slice VirtualSlice, count int) {
 			},
 		})
 	}
+
+	criticalVirtualSliceCount := m.getIntOption(m.options.CriticalVirtualSliceCount)
+	if criticalVirtualSliceCount > 0 && len(m.slicePendingTaskCount) > criticalVirtualSliceCount {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeQueueVirtualSliceCount,
+			AlertAttributesQueueVirtualSliceCount: &AlertAttributesQueueVirtualSliceCount{
+				CurrentVirtualSliceCount:  len(m.slicePendingTaskCount),
+				CriticalVirtualSliceCount: criticalVirtualSliceCount,
+			},
+		})
+	}
 }
 
 func (m *monitorImpl) RemoveSlice(slice VirtualSlice) {
@@ -103,6 +131,93 @@ func (m *monitorImpl) RemoveSlice(slice VirtualSlice) {
 	}
 }
 
+func (m *monitorImpl) GetVirtualSliceCount() int {
+	m.Lock()
+	defer m.Unlock()
+	return len(m.slicePendingTaskCount)
+}
+
+// SetExclusiveAckLevel records the exclusive ack level of the queue and sends an alert if the ack level
+// hasn't moved for longer than the critical duration while the queue has pending tasks.
+func (m *monitorImpl) SetExclusiveAckLevel(ackLevel persistence.HistoryTaskKey) {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.timeSource.Now()
+	if ackLevel.Compare(m.exclusiveAckLevel) != 0 || m.totalPendingTaskCount == 0 {
+		m.exclusiveAckLevel = ackLevel
+		m.ackLevelUpdateTime = now
+		return
+	}
+
+	var criticalStuckDuration time.Duration
+	if m.options.CriticalAckLevelStuckDuration != nil {
+		criticalStuckDuration = m.options.CriticalAckLevelStuckDuration()
+	}
+	if criticalStuckDuration <= 0 {
+		return
+	}
+
+	stuckSince := m.ackLevelUpdateTime
+	if m.category.Type() == persistence.HistoryTaskCategoryTypeScheduled && ackLevel.GetScheduledTime().After(stuckSince) {
+		// a timer task cannot be completed before it fires, so the ack level is not stuck until then
+		stuckSince = ackLevel.GetScheduledTime()
+	}
+	stuckDuration := now.Sub(stuckSince)
+	if stuckDuration <= criticalStuckDuration {
+		return
+	}
+	if m.sendAlertLocked(&Alert{
+		AlertType: AlertTypeQueueStuckAckLevel,
+		AlertAttributesQueueStuckAckLevel: &AlertAttributesQueueStuckAckLevel{
+			AckLevel:              ackLevel,
+			StuckDuration:         stuckDuration,
+			CriticalStuckDuration: criticalStuckDuration,
+		},
+	}) {
+		// give the mitigation a full critical duration to take effect before alerting again
+		m.ackLevelUpdateTime = now
+	}
+}
+
+// SetMaxTaskAttempt records the max attempt of the pending tasks and sends an alert if it's above the critical attempt.
+func (m *monitorImpl) SetMaxTaskAttempt(attempt int) {
+	m.Lock()
+	defer m.Unlock()
+
+	criticalTaskAttempt := m.getIntOption(m.options.CriticalTaskAttempt)
+	if criticalTaskAttempt > 0 && attempt > criticalTaskAttempt {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeQueueTaskAttempt,
+			AlertAttributesQueueTaskAttempt: &AlertAttributesQueueTaskAttempt{
+				CurrentMaxTaskAttempt: attempt,
+				CriticalTaskAttempt:   criticalTaskAttempt,
+			},
+		})
+	}
+}
+
+// SetReadLevelLag records the lag between the read level of the root virtual queue and the max task ID of the queue
+// and sends an alert if it's above the critical lag. It's only meaningful for immediate queues.
+func (m *monitorImpl) SetReadLevelLag(lag int64) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.category.Type() != persistence.HistoryTaskCategoryTypeImmediate {
+		return
+	}
+	criticalReadLevelLag := int64(m.getIntOption(m.options.CriticalReadLevelLag))
+	if criticalReadLevelLag > 0 && lag > criticalReadLevelLag {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeQueueReadLevelLag,
+			AlertAttributesQueueReadLevelLag: &AlertAttributesQueueReadLevelLag{
+				CurrentReadLevelLag:  lag,
+				CriticalReadLevelLag: criticalReadLevelLag,
+			},
+		})
+	}
+}
+
 func (m *monitorImpl) ResolveAlert(alertType AlertType) {
 	m.Lock()
 	defer m.Unlock()
@@ -110,16 +225,25 @@ func (m *monitorImpl) ResolveAlert(alertType AlertType) {
 	delete(m.pendingAlerts, alertType)
 }
 
-func (m *monitorImpl) sendAlertLocked(alert *Alert) {
+func (m *monitorImpl) getIntOption(option dynamicproperties.IntPropertyFn) int {
+	if option == nil {
+		return 0
+	}
+	return option()
+}
+
+func (m *monitorImpl) sendAlertLocked(alert *Alert) bool {
 	// deduplicate alerts
 	if _, ok := m.pendingAlerts[alert.AlertType]; ok {
-		return
+		return false
 	}
 
 	select {
 	case m.subscriber <- alert:
 		m.pendingAlerts[alert.AlertType] = struct{}{}
+		return true
 	default:
 		// do not block if subscriber is not ready
+		return false
 	}
 }
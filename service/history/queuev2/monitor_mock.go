@@ -13,6 +13,8 @@ import (
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
+
+	persistence "github.com/uber/cadence/common/persistence"
 )
 
 // MockMonitor is a mock of Monitor interface.
@@ -67,6 +69,20 @@ func (mr *MockMonitorMockRecorder) GetTotalPendingTaskCount() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPendingTaskCount", reflect.TypeOf((*MockMonitor)(nil).GetTotalPendingTaskCount))
 }
 
+// GetVirtualSliceCount mocks base method.
+func (m *MockMonitor) GetVirtualSliceCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualSliceCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetVirtualSliceCount indicates an expected call of GetVirtualSliceCount.
+func (mr *MockMonitorMockRecorder) GetVirtualSliceCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualSliceCount", reflect.TypeOf((*MockMonitor)(nil).GetVirtualSliceCount))
+}
+
 // RemoveSlice mocks base method.
 func (m *MockMonitor) RemoveSlice(arg0 VirtualSlice) {
 	m.ctrl.T.Helper()
@@ -91,6 +107,42 @@ func (mr *MockMonitorMockRecorder) ResolveAlert(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveAlert", reflect.TypeOf((*MockMonitor)(nil).ResolveAlert), arg0)
 }
 
+// SetExclusiveAckLevel mocks base method.
+func (m *MockMonitor) SetExclusiveAckLevel(arg0 persistence.HistoryTaskKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetExclusiveAckLevel", arg0)
+}
+
+// SetExclusiveAckLevel indicates an expected call of SetExclusiveAckLevel.
+func (mr *MockMonitorMockRecorder) SetExclusiveAckLevel(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExclusiveAckLevel", reflect.TypeOf((*MockMonitor)(nil).SetExclusiveAckLevel), arg0)
+}
+
+// SetMaxTaskAttempt mocks base method.
+func (m *MockMonitor) SetMaxTaskAttempt(arg0 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaxTaskAttempt", arg0)
+}
+
+// SetMaxTaskAttempt indicates an expected call of SetMaxTaskAttempt.
+func (mr *MockMonitorMockRecorder) SetMaxTaskAttempt(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxTaskAttempt", reflect.TypeOf((*MockMonitor)(nil).SetMaxTaskAttempt), arg0)
+}
+
+// SetReadLevelLag mocks base method.
+func (m *MockMonitor) SetReadLevelLag(arg0 int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetReadLevelLag", arg0)
+}
+
+// SetReadLevelLag indicates an expected call of SetReadLevelLag.
+func (mr *MockMonitorMockRecorder) SetReadLevelLag(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadLevelLag", reflect.TypeOf((*MockMonitor)(nil).SetReadLevelLag), arg0)
+}
+
 // SetSlicePendingTaskCount mocks base method.
 func (m *MockMonitor) SetSlicePendingTaskCount(arg0 VirtualSlice, arg1 int) {
 	m.ctrl.T.Helper()
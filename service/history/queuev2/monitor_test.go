@@ -2,15 +2,17 @@ package queuev2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 )
 
 func TestMonitorPendingTaskCount(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{
 		CriticalPendingTaskCount:    dynamicproperties.GetIntPropertyFn(100),
 		EnablePendingTaskCountAlert: func() bool { return true },
 	})
@@ -69,7 +71,7 @@ func TestMonitorPendingTaskCount(t *testing.T) {
 }
 
 func TestMonitorSubscribeAndUnsubscribe(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{})
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{})
 
 	alertCh := make(chan *Alert, alertChSize)
 	monitor.Subscribe(alertCh)
@@ -82,7 +84,7 @@ func TestMonitorSubscribeAndUnsubscribe(t *testing.T) {
 }
 
 func TestMonitorResolveAlert(t *testing.T) {
-	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, &MonitorOptions{})
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{})
 
 	monitor.(*monitorImpl).pendingAlerts[AlertTypeQueuePendingTaskCount] = struct{}{}
 	assert.Equal(t, 1, len(monitor.(*monitorImpl).pendingAlerts))
@@ -90,3 +92,133 @@ func TestMonitorResolveAlert(t *testing.T) {
 	monitor.ResolveAlert(AlertTypeQueuePendingTaskCount)
 	assert.Equal(t, 0, len(monitor.(*monitorImpl).pendingAlerts))
 }
+
+func TestMonitorVirtualSliceCount(t *testing.T) {
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{
+		CriticalPendingTaskCount:    dynamicproperties.GetIntPropertyFn(0),
+		EnablePendingTaskCountAlert: func() bool { return false },
+		CriticalVirtualSliceCount:   dynamicproperties.GetIntPropertyFn(2),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 1)
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 1)
+	assert.Equal(t, 2, monitor.GetVirtualSliceCount())
+	assert.Empty(t, alertCh)
+
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 1)
+	assert.Equal(t, 3, monitor.GetVirtualSliceCount())
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeQueueVirtualSliceCount, alert.AlertType)
+	assert.Equal(t, &AlertAttributesQueueVirtualSliceCount{CurrentVirtualSliceCount: 3, CriticalVirtualSliceCount: 2}, alert.AlertAttributesQueueVirtualSliceCount)
+}
+
+func TestMonitorStuckAckLevel(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTransfer, timeSource, &MonitorOptions{
+		CriticalPendingTaskCount:      dynamicproperties.GetIntPropertyFn(0),
+		EnablePendingTaskCountAlert:   func() bool { return false },
+		CriticalAckLevelStuckDuration: dynamicproperties.GetDurationPropertyFn(time.Minute),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	// the ack level is not considered stuck if there are no pending tasks
+	monitor.SetExclusiveAckLevel(persistence.NewImmediateTaskKey(10))
+	timeSource.Advance(2 * time.Minute)
+	monitor.SetExclusiveAckLevel(persistence.NewImmediateTaskKey(10))
+	assert.Empty(t, alertCh)
+
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 1)
+	timeSource.Advance(30 * time.Second)
+	monitor.SetExclusiveAckLevel(persistence.NewImmediateTaskKey(10))
+	assert.Empty(t, alertCh)
+
+	timeSource.Advance(time.Minute)
+	monitor.SetExclusiveAckLevel(persistence.NewImmediateTaskKey(10))
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeQueueStuckAckLevel, alert.AlertType)
+	assert.Equal(t, &AlertAttributesQueueStuckAckLevel{
+		AckLevel:              persistence.NewImmediateTaskKey(10),
+		StuckDuration:         90 * time.Second,
+		CriticalStuckDuration: time.Minute,
+	}, alert.AlertAttributesQueueStuckAckLevel)
+
+	// the stuck duration restarts after an alert is sent
+	monitor.ResolveAlert(AlertTypeQueueStuckAckLevel)
+	timeSource.Advance(30 * time.Second)
+	monitor.SetExclusiveAckLevel(persistence.NewImmediateTaskKey(10))
+	assert.Empty(t, alertCh)
+
+	// moving the ack level resets the stuck duration
+	timeSource.Advance(time.Minute)
+	monitor.SetExclusiveAckLevel(persistence.NewImmediateTaskKey(20))
+	assert.Empty(t, alertCh)
+}
+
+func TestMonitorStuckAckLevel_ScheduledQueue(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, timeSource, &MonitorOptions{
+		CriticalPendingTaskCount:      dynamicproperties.GetIntPropertyFn(0),
+		EnablePendingTaskCountAlert:   func() bool { return false },
+		CriticalAckLevelStuckDuration: dynamicproperties.GetDurationPropertyFn(time.Minute),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+	monitor.SetSlicePendingTaskCount(&virtualSliceImpl{}, 1)
+
+	// a timer can't be completed before it fires
+	ackLevel := persistence.NewHistoryTaskKey(timeSource.Now().Add(time.Hour), 0)
+	monitor.SetExclusiveAckLevel(ackLevel)
+	timeSource.Advance(time.Hour)
+	monitor.SetExclusiveAckLevel(ackLevel)
+	assert.Empty(t, alertCh)
+
+	timeSource.Advance(2 * time.Minute)
+	monitor.SetExclusiveAckLevel(ackLevel)
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeQueueStuckAckLevel, alert.AlertType)
+	assert.Equal(t, 2*time.Minute, alert.AlertAttributesQueueStuckAckLevel.StuckDuration)
+}
+
+func TestMonitorTaskAttempt(t *testing.T) {
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), &MonitorOptions{
+		CriticalTaskAttempt: dynamicproperties.GetIntPropertyFn(10),
+	})
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	monitor.SetMaxTaskAttempt(10)
+	assert.Empty(t, alertCh)
+
+	monitor.SetMaxTaskAttempt(11)
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeQueueTaskAttempt, alert.AlertType)
+	assert.Equal(t, &AlertAttributesQueueTaskAttempt{CurrentMaxTaskAttempt: 11, CriticalTaskAttempt: 10}, alert.AlertAttributesQueueTaskAttempt)
+}
+
+func TestMonitorReadLevelLag(t *testing.T) {
+	options := &MonitorOptions{
+		CriticalReadLevelLag: dynamicproperties.GetIntPropertyFn(1000),
+	}
+
+	// read level lag is ignored for scheduled queues
+	timerMonitor := NewMonitor(persistence.HistoryTaskCategoryTimer, clock.NewMockedTimeSource(), options)
+	timerAlertCh := make(chan *Alert, alertChSize)
+	timerMonitor.Subscribe(timerAlertCh)
+	timerMonitor.SetReadLevelLag(1001)
+	assert.Empty(t, timerAlertCh)
+
+	monitor := NewMonitor(persistence.HistoryTaskCategoryTransfer, clock.NewMockedTimeSource(), options)
+	alertCh := make(chan *Alert, alertChSize)
+	monitor.Subscribe(alertCh)
+
+	monitor.SetReadLevelLag(1000)
+	assert.Empty(t, alertCh)
+
+	monitor.SetReadLevelLag(1001)
+	alert := <-alertCh
+	assert.Equal(t, AlertTypeQueueReadLevelLag, alert.AlertType)
+	assert.Equal(t, &AlertAttributesQueueReadLevelLag{CurrentReadLevelLag: 1001, CriticalReadLevelLag: 1000}, alert.AlertAttributesQueueReadLevelLag)
+}
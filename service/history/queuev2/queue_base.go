@@ -24,6 +24,11 @@ package queuev2
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -46,6 +51,8 @@ const (
 	// as its max pending task count so that their loading will never trigger pending
 	// task alert & action
 	nonRootQueueMaxPendingTaskCoefficient = 0.8
+	// max number of recent mitigations kept in memory for DescribeQueue
+	maxMitigationHistorySize = 20
 )
 
 type (
@@ -63,9 +70,21 @@ type (
 		PollBackoffIntervalJitterCoefficient dynamicproperties.FloatPropertyFn
 		VirtualSliceForceAppendInterval      dynamicproperties.DurationPropertyFn
 		// monitor & mitigator options
-		CriticalPendingTaskCount    dynamicproperties.IntPropertyFn
-		EnablePendingTaskCountAlert func() bool
-		MaxVirtualQueueCount        dynamicproperties.IntPropertyFn
+		CriticalPendingTaskCount      dynamicproperties.IntPropertyFn
+		EnablePendingTaskCountAlert   func() bool
+		MaxVirtualQueueCount          dynamicproperties.IntPropertyFn
+		CriticalAckLevelStuckDuration dynamicproperties.DurationPropertyFn
+		CriticalTaskAttempt           dynamicproperties.IntPropertyFn
+		CriticalReadLevelLag          dynamicproperties.IntPropertyFn
+		CriticalVirtualSliceCount     dynamicproperties.IntPropertyFn
+		// the rate of the tasks of a domain throttled by the mitigator and how long it stays throttled
+		ThrottledDomainRPS     dynamicproperties.IntPropertyFn
+		DomainThrottleDuration dynamicproperties.DurationPropertyFn
+		// domain name -> settings of the virtual queue dedicated to the domain, see dynamicproperties.QueueIsolatedDomainSettings
+		IsolatedDomainSettings dynamicproperties.MapPropertyFn
+		// timer burst smoothing options, only used by scheduled queues, see dynamicproperties.TimerBurstSmoothingThreshold
+		BurstSmoothingThreshold dynamicproperties.IntPropertyFn
+		BurstSmoothingWindow    dynamicproperties.DurationPropertyFnWithDomainFilter
 
 		EnableValidator        dynamicproperties.BoolPropertyFn
 		ValidationInterval     dynamicproperties.DurationPropertyFn
@@ -87,13 +106,24 @@ type (
 		queueReader           QueueReader
 		monitor               Monitor
 		mitigator             Mitigator
+		domainThrottler       DomainThrottler
 		updateQueueStateTimer clock.Timer
 		virtualQueueManager   VirtualQueueManager
 		exclusiveAckLevel     persistence.HistoryTaskKey
 		alertCh               chan *Alert
 		newVirtualSliceState  VirtualSliceState
+		domainIsolation       *domainIsolation
+		domainIsolationCh     chan *domainIsolationRequest
 
 		updateQueueStateFn func(ctx context.Context)
+
+		mitigationsLock sync.Mutex
+		mitigations     []mitigationRecord
+	}
+
+	mitigationRecord struct {
+		alert     Alert
+		timestamp time.Time
 	}
 )
 
@@ -143,11 +173,32 @@ func newQueueBase(
 	}
 	monitor := NewMonitor(
 		category,
+		timeSource,
 		&MonitorOptions{
-			CriticalPendingTaskCount:    options.CriticalPendingTaskCount,
-			EnablePendingTaskCountAlert: options.EnablePendingTaskCountAlert,
+			CriticalPendingTaskCount:      options.CriticalPendingTaskCount,
+			EnablePendingTaskCountAlert:   options.EnablePendingTaskCountAlert,
+			CriticalAckLevelStuckDuration: options.CriticalAckLevelStuckDuration,
+			CriticalTaskAttempt:           options.CriticalTaskAttempt,
+			CriticalReadLevelLag:          options.CriticalReadLevelLag,
+			CriticalVirtualSliceCount:     options.CriticalVirtualSliceCount,
 		},
 	)
+	nonRootQueueOptions := &VirtualQueueOptions{
+		PageSize: options.PageSize,
+		// non-root queues should not trigger task unloading
+		// otherwise those virtual queues will keep loading, hit pending task count limit, unload, throttle, load, etc...
+		// use a limit lower than the critical pending task count instead
+		MaxPendingTasksCount: func(opts ...dynamicproperties.FilterOption) int {
+			return int(float64(options.CriticalPendingTaskCount(opts...)) * nonRootQueueMaxPendingTaskCoefficient)
+		},
+		PollBackoffInterval:                  options.PollBackoffInterval,
+		PollBackoffIntervalJitterCoefficient: options.PollBackoffIntervalJitterCoefficient,
+	}
+	domainIsolation := newDomainIsolation(queueState.VirtualQueueStates)
+	domainThrottler := NewDomainThrottler(timeSource, &DomainThrottlerOptions{
+		RPS:      options.ThrottledDomainRPS,
+		Duration: options.DomainThrottleDuration,
+	})
 	virtualQueueManager := NewVirtualQueueManager(
 		taskProcessor,
 		rescheduler,
@@ -157,6 +208,7 @@ func newQueueBase(
 		metricsScope,
 		timeSource,
 		quotas.NewDynamicRateLimiter(options.MaxPollRPS.AsFloat64()),
+		domainThrottler,
 		monitor,
 		&VirtualQueueManagerOptions{
 			RootQueueOptions: &VirtualQueueOptions{
@@ -165,24 +217,18 @@ func newQueueBase(
 				PollBackoffInterval:                  options.PollBackoffInterval,
 				PollBackoffIntervalJitterCoefficient: options.PollBackoffIntervalJitterCoefficient,
 			},
-			NonRootQueueOptions: &VirtualQueueOptions{
-				PageSize: options.PageSize,
-				// non-root queues should not trigger task unloading
-				// otherwise those virtual queues will keep loading, hit pending task count limit, unload, throttle, load, etc...
-				// use a limit lower than the critical pending task count instead
-				MaxPendingTasksCount: func(opts ...dynamicproperties.FilterOption) int {
-					return int(float64(options.CriticalPendingTaskCount(opts...)) * nonRootQueueMaxPendingTaskCoefficient)
-				},
-				PollBackoffInterval:                  options.PollBackoffInterval,
-				PollBackoffIntervalJitterCoefficient: options.PollBackoffIntervalJitterCoefficient,
-			},
+			NonRootQueueOptions:             nonRootQueueOptions,
 			VirtualSliceForceAppendInterval: options.VirtualSliceForceAppendInterval,
+			IsolatedQueueOptions: func(queueID int64) (*VirtualQueueOptions, quotas.Limiter, bool) {
+				return domainIsolation.virtualQueueOptions(queueID, nonRootQueueOptions, options.MaxPollRPS)
+			},
 		},
-		queueState.VirtualQueueStates,
+		removeEmptyVirtualSliceStates(queueState.VirtualQueueStates),
 	)
 	mitigator := NewMitigator(
 		virtualQueueManager,
 		monitor,
+		domainThrottler,
 		logger,
 		metricsScope,
 		&MitigatorOptions{
@@ -203,9 +249,12 @@ func newQueueBase(
 		queueReader:         queueReader,
 		monitor:             monitor,
 		mitigator:           mitigator,
+		domainThrottler:     domainThrottler,
 		exclusiveAckLevel:   exclusiveAckLevel,
 		virtualQueueManager: virtualQueueManager,
 		alertCh:             make(chan *Alert, alertChSize),
+		domainIsolation:     domainIsolation,
+		domainIsolationCh:   make(chan *domainIsolationRequest),
 		newVirtualSliceState: VirtualSliceState{
 			Range: Range{
 				InclusiveMinTaskKey: queueState.ExclusiveMaxReadLevel,
@@ -246,7 +295,50 @@ func (q *queueBase) FailoverDomain(domainIDs map[string]struct{}) {
 }
 
 func (q *queueBase) HandleAction(ctx context.Context, clusterName string, action *queue.Action) (*queue.ActionResult, error) {
-	return nil, nil
+	if action == nil {
+		return nil, nil
+	}
+	switch action.ActionType {
+	case queue.ActionTypeGetState:
+		return q.getState()
+	case queue.ActionTypeUpdateDomainIsolation:
+		return q.updateDomainIsolation(ctx, action.UpdateDomainIsolationAttributes)
+	default:
+		return nil, nil
+	}
+}
+
+func (q *queueBase) getState() (*queue.ActionResult, error) {
+	virtualQueues := q.virtualQueueManager.VirtualQueues()
+	queueIDs := slices.Sorted(maps.Keys(virtualQueues))
+	result := &queue.GetStateActionResult{
+		VirtualQueueStates: make([]string, 0, len(queueIDs)),
+	}
+	for _, queueID := range queueIDs {
+		state, err := json.Marshal(ToPersistenceVirtualQueueState(virtualQueues[queueID].GetState()))
+		if err != nil {
+			return nil, err
+		}
+		if domainID, ok := q.domainIsolation.getDomainID(queueID); ok {
+			result.VirtualQueueStates = append(result.VirtualQueueStates, fmt.Sprintf("virtual queue %d (isolated domain %s): %s", queueID, domainID, state))
+			continue
+		}
+		result.VirtualQueueStates = append(result.VirtualQueueStates, fmt.Sprintf("virtual queue %d: %s", queueID, state))
+	}
+
+	q.mitigationsLock.Lock()
+	defer q.mitigationsLock.Unlock()
+	for _, record := range q.mitigations {
+		result.Mitigations = append(result.Mitigations, fmt.Sprintf("%v mitigated alert %v", record.timestamp.Format(time.RFC3339), record.alert))
+	}
+	if throttledDomains := q.domainThrottler.ThrottledDomains(); len(throttledDomains) > 0 {
+		slices.Sort(throttledDomains)
+		result.Mitigations = append(result.Mitigations, fmt.Sprintf("throttled domains %v", throttledDomains))
+	}
+	return &queue.ActionResult{
+		ActionType:           queue.ActionTypeGetState,
+		GetStateActionResult: result,
+	}, nil
 }
 
 func (q *queueBase) LockTaskProcessing() {}
@@ -268,18 +360,22 @@ func (q *queueBase) processNewTasks() bool {
 	newVirtualSlice := NewVirtualSlice(newVirtualSliceState, q.taskInitializer, q.queueReader, NewPendingTaskTracker(), q.logger)
 
 	q.logger.Debug("processing new tasks", tag.Dynamic("inclusiveMinTaskKey", newVirtualSliceState.Range.InclusiveMinTaskKey), tag.Dynamic("exclusiveMaxTaskKey", newVirtualSliceState.Range.ExclusiveMaxTaskKey))
-	q.virtualQueueManager.AddNewVirtualSliceToRootQueue(newVirtualSlice)
+	q.virtualQueueManager.AddNewVirtualSliceToRootQueue(q.isolateNewVirtualSlice(newVirtualSlice))
 	return true
 }
 
 func (q *queueBase) updateQueueState(ctx context.Context) {
 	q.metricsScope.IncCounter(metrics.AckLevelUpdateCounter)
+	q.refreshDomainIsolationSettings()
 	queueState := &QueueState{
 		VirtualQueueStates:    q.virtualQueueManager.UpdateAndGetState(),
 		ExclusiveMaxReadLevel: q.newVirtualSliceState.Range.InclusiveMinTaskKey,
 	}
+	q.addDrainedIsolatedQueueStates(queueState)
 	newExclusiveAckLevel, maxQueueID := getExclusiveAckLevelAndMaxQueueIDFromQueueState(queueState)
 	q.metricsScope.UpdateGauge(metrics.VirtualQueueCountGauge, float64(maxQueueID+1))
+	q.metricsScope.UpdateGauge(metrics.IsolatedDomainCountGauge, float64(len(q.domainIsolation.getQueueIDs())))
+	q.updateMonitor(newExclusiveAckLevel)
 
 	// for backward compatibility, we record the timer metrics in shard info scope
 	pendingTaskCount := q.monitor.GetTotalPendingTaskCount()
@@ -343,15 +439,57 @@ func (q *queueBase) updateQueueState(ctx context.Context) {
 	))
 }
 
+// updateMonitor reports the health statistics of the queue to the monitor, which may raise alerts
+func (q *queueBase) updateMonitor(exclusiveAckLevel persistence.HistoryTaskKey) {
+	q.monitor.SetExclusiveAckLevel(exclusiveAckLevel)
+	q.metricsScope.UpdateGauge(metrics.VirtualSliceCountGauge, float64(q.monitor.GetVirtualSliceCount()))
+
+	maxTaskAttempt := 0
+	rootQueueReadLevel := q.newVirtualSliceState.Range.InclusiveMinTaskKey
+	for queueID, virtualQueue := range q.virtualQueueManager.VirtualQueues() {
+		virtualQueue.IterateSlices(func(slice VirtualSlice) {
+			for _, attempt := range slice.PendingTaskStats().MaxTaskAttemptPerDomain {
+				maxTaskAttempt = max(maxTaskAttempt, attempt)
+			}
+			if queueID == rootQueueID && slice.HasMoreTasks() {
+				rootQueueReadLevel = persistence.MinHistoryTaskKey(rootQueueReadLevel, slice.GetReadLevel())
+			}
+		})
+	}
+	q.metricsScope.UpdateGauge(metrics.QueueMaxTaskAttemptGauge, float64(maxTaskAttempt))
+	q.monitor.SetMaxTaskAttempt(maxTaskAttempt)
+
+	if q.category.Type() == persistence.HistoryTaskCategoryTypeImmediate {
+		readLevelLag := q.newVirtualSliceState.Range.InclusiveMinTaskKey.GetTaskID() - rootQueueReadLevel.GetTaskID()
+		q.metricsScope.UpdateGauge(metrics.QueueReadLevelLagGauge, float64(readLevelLag))
+		q.monitor.SetReadLevelLag(readLevelLag)
+	}
+}
+
 func (q *queueBase) handleAlert(ctx context.Context, alert *Alert) {
 	if alert == nil {
 		return
 	}
 
+	q.metricsScope.Tagged(metrics.AlertTypeTag(alert.AlertType.String())).IncCounter(metrics.QueueAlertCounter)
 	q.mitigator.Mitigate(*alert)
+	q.recordMitigation(*alert)
 	q.updateQueueStateFn(ctx)
 }
 
+func (q *queueBase) recordMitigation(alert Alert) {
+	q.mitigationsLock.Lock()
+	defer q.mitigationsLock.Unlock()
+
+	q.mitigations = append(q.mitigations, mitigationRecord{
+		alert:     alert,
+		timestamp: q.timeSource.Now(),
+	})
+	if len(q.mitigations) > maxMitigationHistorySize {
+		q.mitigations = q.mitigations[len(q.mitigations)-maxMitigationHistorySize:]
+	}
+}
+
 func getExclusiveAckLevelAndMaxQueueIDFromQueueState(state *QueueState) (persistence.HistoryTaskKey, int64) {
 	maxQueueID := int64(0)
 	newExclusiveAckLevel := state.ExclusiveMaxReadLevel
@@ -359,7 +497,10 @@ func getExclusiveAckLevelAndMaxQueueIDFromQueueState(state *QueueState) (persist
 		if len(virtualQueueState) != 0 {
 			newExclusiveAckLevel = persistence.MinHistoryTaskKey(newExclusiveAckLevel, virtualQueueState[0].Range.InclusiveMinTaskKey)
 		}
-		maxQueueID = max(maxQueueID, queueID)
+		// isolated virtual queues are not created by the mitigator, so they're not counted
+		if !isIsolatedQueueID(queueID) {
+			maxQueueID = max(maxQueueID, queueID)
+		}
 	}
 	return newExclusiveAckLevel, maxQueueID
 }
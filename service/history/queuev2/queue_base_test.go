@@ -2,10 +2,12 @@ package queuev2
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common"
@@ -16,6 +18,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/queue"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
 )
@@ -149,6 +152,7 @@ func TestQueueBase_ProcessNewTasks(t *testing.T) {
 			mockShard, mockTaskProcessor, mockTimeSource, mockVirtualQueueManager := tt.setupMocks(ctrl)
 
 			queueBase := &queueBase{
+				domainIsolation:      newDomainIsolation(nil),
 				shard:                mockShard,
 				taskProcessor:        mockTaskProcessor,
 				metricsClient:        metrics.NoopClient,
@@ -375,8 +379,15 @@ func TestQueueBase_UpdateQueueState(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			mockShard, mockTaskProcessor, mockTimeSource, mockVirtualQueueManager, mockMonitor, mockMitigator := tt.setupMocks(ctrl)
+			// the health statistics of the queue are reported to the monitor on every update
+			mockMonitor.EXPECT().SetExclusiveAckLevel(gomock.Any()).Times(1)
+			mockMonitor.EXPECT().GetVirtualSliceCount().Return(1).Times(1)
+			mockMonitor.EXPECT().SetMaxTaskAttempt(0).Times(1)
+			mockMonitor.EXPECT().SetReadLevelLag(int64(0)).Times(1)
+			mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{}).Times(1)
 
 			queueBase := &queueBase{
+				domainIsolation:       newDomainIsolation(nil),
 				shard:                 mockShard,
 				taskProcessor:         mockTaskProcessor,
 				metricsClient:         metrics.NoopClient,
@@ -421,6 +432,7 @@ func TestQueueBase_HandleAlert(t *testing.T) {
 
 	updateQueueStateCalled := false
 	queueBase := &queueBase{
+		domainIsolation:     newDomainIsolation(nil),
 		shard:               mockShard,
 		taskProcessor:       mockTaskProcessor,
 		metricsClient:       metrics.NoopClient,
@@ -453,6 +465,140 @@ func TestQueueBase_HandleAlert(t *testing.T) {
 	queueBase.handleAlert(context.Background(), &Alert{AlertType: AlertTypeQueuePendingTaskCount})
 
 	assert.True(t, updateQueueStateCalled)
+	assert.Equal(t, []mitigationRecord{{alert: Alert{AlertType: AlertTypeQueuePendingTaskCount}, timestamp: mockTimeSource.Now()}}, queueBase.mitigations)
+}
+
+func TestQueueBase_RecordMitigation(t *testing.T) {
+	mockTimeSource := clock.NewMockedTimeSource()
+	queueBase := &queueBase{
+		domainIsolation: newDomainIsolation(nil),
+		timeSource:      mockTimeSource,
+	}
+
+	for i := 0; i < maxMitigationHistorySize+5; i++ {
+		queueBase.recordMitigation(Alert{AlertType: AlertType(i)})
+	}
+
+	assert.Len(t, queueBase.mitigations, maxMitigationHistorySize)
+	assert.Equal(t, AlertType(5), queueBase.mitigations[0].alert.AlertType)
+	assert.Equal(t, AlertType(maxMitigationHistorySize+4), queueBase.mitigations[maxMitigationHistorySize-1].alert.AlertType)
+}
+
+func TestQueueBase_UpdateMonitor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockMonitor := NewMockMonitor(ctrl)
+	mockRootQueue := NewMockVirtualQueue(ctrl)
+	mockVirtualQueue := NewMockVirtualQueue(ctrl)
+	mockSlice1 := NewMockVirtualSlice(ctrl)
+	mockSlice2 := NewMockVirtualSlice(ctrl)
+	mockSlice3 := NewMockVirtualSlice(ctrl)
+
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+		rootQueueID: mockRootQueue,
+		1:           mockVirtualQueue,
+	})
+	mockRootQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+		f(mockSlice1)
+		f(mockSlice2)
+	})
+	mockVirtualQueue.EXPECT().IterateSlices(gomock.Any()).Do(func(f func(VirtualSlice)) {
+		f(mockSlice3)
+	})
+	mockSlice1.EXPECT().PendingTaskStats().Return(PendingTaskStats{MaxTaskAttemptPerDomain: map[string]int{"domain1": 3}})
+	mockSlice1.EXPECT().HasMoreTasks().Return(false)
+	mockSlice2.EXPECT().PendingTaskStats().Return(PendingTaskStats{MaxTaskAttemptPerDomain: map[string]int{"domain1": 1, "domain2": 2}})
+	mockSlice2.EXPECT().HasMoreTasks().Return(true)
+	mockSlice2.EXPECT().GetReadLevel().Return(persistence.NewImmediateTaskKey(600))
+	// read level of non-root queues doesn't count
+	mockSlice3.EXPECT().PendingTaskStats().Return(PendingTaskStats{MaxTaskAttemptPerDomain: map[string]int{"domain3": 7}})
+
+	mockMonitor.EXPECT().SetExclusiveAckLevel(persistence.NewImmediateTaskKey(100))
+	mockMonitor.EXPECT().GetVirtualSliceCount().Return(3)
+	mockMonitor.EXPECT().SetMaxTaskAttempt(7)
+	mockMonitor.EXPECT().SetReadLevelLag(int64(400))
+
+	queueBase := &queueBase{
+		domainIsolation:     newDomainIsolation(nil),
+		metricsScope:        metrics.NoopScope,
+		category:            persistence.HistoryTaskCategoryTransfer,
+		monitor:             mockMonitor,
+		virtualQueueManager: mockVirtualQueueManager,
+		newVirtualSliceState: VirtualSliceState{
+			Range: Range{
+				InclusiveMinTaskKey: persistence.NewImmediateTaskKey(1000),
+				ExclusiveMaxTaskKey: persistence.MaximumHistoryTaskKey,
+			},
+			Predicate: NewUniversalPredicate(),
+		},
+	}
+
+	queueBase.updateMonitor(persistence.NewImmediateTaskKey(100))
+}
+
+func TestQueueBase_HandleAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockVirtualQueueManager := NewMockVirtualQueueManager(ctrl)
+	mockRootQueue := NewMockVirtualQueue(ctrl)
+	mockVirtualQueue := NewMockVirtualQueue(ctrl)
+	mockIsolatedQueue := NewMockVirtualQueue(ctrl)
+	mockTimeSource := clock.NewMockedTimeSource()
+
+	mockVirtualQueueManager.EXPECT().VirtualQueues().Return(map[int64]VirtualQueue{
+		1:                   mockVirtualQueue,
+		rootQueueID:         mockRootQueue,
+		isolatedQueueIDBase: mockIsolatedQueue,
+	})
+	mockRootQueue.EXPECT().GetState().Return([]VirtualSliceState{
+		{
+			Range: Range{
+				InclusiveMinTaskKey: persistence.NewImmediateTaskKey(100),
+				ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(200),
+			},
+			Predicate: NewUniversalPredicate(),
+		},
+	})
+	mockVirtualQueue.EXPECT().GetState().Return(nil)
+	mockIsolatedQueue.EXPECT().GetState().Return(nil)
+
+	queueBase := &queueBase{
+		domainIsolation: newDomainIsolation(nil),
+		domainThrottler: NewDomainThrottler(mockTimeSource, &DomainThrottlerOptions{
+			RPS:      dynamicproperties.GetIntPropertyFn(10),
+			Duration: dynamicproperties.GetDurationPropertyFn(time.Minute),
+		}),
+		timeSource:          mockTimeSource,
+		virtualQueueManager: mockVirtualQueueManager,
+	}
+	queueBase.domainIsolation.addDomain("domain1")
+	queueBase.domainThrottler.Throttle("domain3", "domain2")
+	queueBase.recordMitigation(Alert{
+		AlertType: AlertTypeQueueTaskAttempt,
+		AlertAttributesQueueTaskAttempt: &AlertAttributesQueueTaskAttempt{
+			CurrentMaxTaskAttempt: 11,
+			CriticalTaskAttempt:   10,
+		},
+	})
+
+	result, err := queueBase.HandleAction(context.Background(), "cluster", queue.NewGetStateAction())
+	require.NoError(t, err)
+	require.NotNil(t, result.GetStateActionResult)
+	assert.Equal(t, queue.ActionTypeGetState, result.ActionType)
+	require.Len(t, result.GetStateActionResult.VirtualQueueStates, 3)
+	assert.True(t, strings.HasPrefix(result.GetStateActionResult.VirtualQueueStates[0], "virtual queue 0: "))
+	assert.Contains(t, result.GetStateActionResult.VirtualQueueStates[0], `"TaskID":100`)
+	assert.True(t, strings.HasPrefix(result.GetStateActionResult.VirtualQueueStates[1], "virtual queue 1: "))
+	assert.True(t, strings.HasPrefix(result.GetStateActionResult.VirtualQueueStates[2], "virtual queue 65536 (isolated domain domain1): "))
+	assert.Equal(t, []string{
+		mockTimeSource.Now().Format(time.RFC3339) + " mitigated alert QueueTaskAttempt: max task attempt 11, critical 10",
+		"throttled domains [domain2 domain3]",
+	}, result.GetStateActionResult.Mitigations)
+
+	result, err = queueBase.HandleAction(context.Background(), "cluster", queue.NewResetAction())
+	require.NoError(t, err)
+	assert.Nil(t, result)
 }
 
 func TestNewQueueBase(t *testing.T) {
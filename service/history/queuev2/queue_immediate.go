@@ -190,6 +190,8 @@ func (q *immediateQueue) processEventLoop() {
 			q.base.updateQueueState(q.ctx)
 		case alert := <-q.base.alertCh:
 			q.base.handleAlert(q.ctx, alert)
+		case request := <-q.base.domainIsolationCh:
+			q.base.handleDomainIsolationRequest(q.ctx, request)
 		case <-q.ctx.Done():
 			return
 		}
@@ -68,18 +68,34 @@ func newScheduledQueue(
 	options *Options,
 ) *scheduledQueue {
 	ctx, cancel := context.WithCancel(context.Background())
-	return &scheduledQueue{
-		base: newQueueBase(
-			shard,
+	var smoother *burstSmoother
+	if options.BurstSmoothingThreshold != nil && options.BurstSmoothingWindow != nil {
+		smoother = newBurstSmoother(
 			taskProcessor,
-			logger,
-			metricsClient,
+			shard.GetDomainCache(),
+			shard.GetTimeSource(),
 			metricsScope,
-			category,
-			taskExecutor,
-			queueReader,
-			options,
-		),
+			options.BurstSmoothingThreshold,
+			options.BurstSmoothingWindow,
+		)
+		taskProcessor = smoother
+	}
+	base := newQueueBase(
+		shard,
+		taskProcessor,
+		logger,
+		metricsClient,
+		metricsScope,
+		category,
+		taskExecutor,
+		queueReader,
+		options,
+	)
+	if smoother != nil {
+		smoother.rescheduler = base.rescheduler
+	}
+	return &scheduledQueue{
+		base:       base,
 		timerGate:  clock.NewTimerGate(shard.GetTimeSource()),
 		newTimerCh: make(chan struct{}, 1),
 		ctx:        ctx,
@@ -207,6 +223,8 @@ func (q *scheduledQueue) processEventLoop() {
 			q.base.updateQueueStateFn(q.ctx)
 		case alert := <-q.base.alertCh:
 			q.base.handleAlert(q.ctx, alert)
+		case request := <-q.base.domainIsolationCh:
+			q.base.handleDomainIsolationRequest(q.ctx, request)
 		case <-q.ctx.Done():
 			return
 		}
@@ -113,7 +113,8 @@ func TestCachedScheduledQueue_NotifyNewTask(t *testing.T) {
 			csq := &cachedScheduledQueue{
 				scheduledQueue: &scheduledQueue{
 					base: &queueBase{
-						metricsScope: metrics.NoopScope,
+						domainIsolation: newDomainIsolation(nil),
+						metricsScope:    metrics.NoopScope,
 					},
 					newTimerCh: make(chan struct{}, 1),
 				},
@@ -223,6 +224,7 @@ func TestCachedScheduledQueue_UpdateQueueStateFn_PropagatesReadLevel(t *testing.
 
 			inner := &scheduledQueue{
 				base: &queueBase{
+					domainIsolation:     newDomainIsolation(nil),
 					metricsScope:        metrics.NoopScope,
 					virtualQueueManager: mockVQM,
 					exclusiveAckLevel:   ackLevel,
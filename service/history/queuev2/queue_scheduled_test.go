@@ -214,11 +214,12 @@ func TestScheduledQueue_LookAheadTask(t *testing.T) {
 			// Create scheduled queue directly
 			queue := &scheduledQueue{
 				base: &queueBase{
-					logger:       mockLogger,
-					metricsScope: mockMetricsScope,
-					category:     persistence.HistoryTaskCategoryTimer,
-					options:      options,
-					queueReader:  mockQueueReader,
+					domainIsolation: newDomainIsolation(nil),
+					logger:          mockLogger,
+					metricsScope:    mockMetricsScope,
+					category:        persistence.HistoryTaskCategoryTimer,
+					options:         options,
+					queueReader:     mockQueueReader,
 					newVirtualSliceState: VirtualSliceState{
 						Range: Range{
 							InclusiveMinTaskKey: persistence.NewHistoryTaskKey(now, 0),
@@ -150,6 +150,15 @@ func (f *timerQueueFactory) createQueuev2(
 		CriticalPendingTaskCount:             config.QueueCriticalPendingTaskCount,
 		EnablePendingTaskCountAlert:          func() bool { return config.EnableTimerQueueV2PendingTaskCountAlert(shard.GetShardID()) },
 		MaxVirtualQueueCount:                 config.QueueMaxVirtualQueueCount,
+		CriticalAckLevelStuckDuration:        config.QueueCriticalAckLevelStuckDuration,
+		CriticalTaskAttempt:                  config.QueueCriticalTaskAttempt,
+		CriticalReadLevelLag:                 config.QueueCriticalReadLevelLag,
+		CriticalVirtualSliceCount:            config.QueueCriticalVirtualSliceCount,
+		ThrottledDomainRPS:                   config.QueueThrottledDomainRPS,
+		DomainThrottleDuration:               config.QueueDomainThrottleDuration,
+		IsolatedDomainSettings:               config.QueueIsolatedDomainSettings,
+		BurstSmoothingThreshold:              config.TimerBurstSmoothingThreshold,
+		BurstSmoothingWindow:                 config.TimerBurstSmoothingWindow,
 	}
 
 	var cachedReader CachedQueueReader
@@ -173,6 +173,13 @@ func (f *transferQueueFactory) createQueuev2(
 			CriticalPendingTaskCount:             config.QueueCriticalPendingTaskCount,
 			EnablePendingTaskCountAlert:          func() bool { return config.EnableTransferQueueV2PendingTaskCountAlert(shard.GetShardID()) },
 			MaxVirtualQueueCount:                 config.QueueMaxVirtualQueueCount,
+			CriticalAckLevelStuckDuration:        config.QueueCriticalAckLevelStuckDuration,
+			CriticalTaskAttempt:                  config.QueueCriticalTaskAttempt,
+			CriticalReadLevelLag:                 config.QueueCriticalReadLevelLag,
+			CriticalVirtualSliceCount:            config.QueueCriticalVirtualSliceCount,
+			ThrottledDomainRPS:                   config.QueueThrottledDomainRPS,
+			DomainThrottleDuration:               config.QueueDomainThrottleDuration,
+			IsolatedDomainSettings:               config.QueueIsolatedDomainSettings,
 		},
 	)
 }
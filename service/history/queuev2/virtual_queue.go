@@ -84,6 +84,7 @@ type (
 		metricsScope        metrics.Scope
 		timeSource          clock.TimeSource
 		taskLoadRateLimiter quotas.Limiter
+		domainThrottler     DomainThrottler
 		monitor             Monitor
 
 		sync.RWMutex
@@ -105,6 +106,7 @@ func NewVirtualQueue(
 	metricsScope metrics.Scope,
 	timeSource clock.TimeSource,
 	taskLoadRateLimiter quotas.Limiter,
+	domainThrottler DomainThrottler,
 	monitor Monitor,
 	virtualSlices []VirtualSlice,
 	queueOptions *VirtualQueueOptions,
@@ -124,6 +126,7 @@ func NewVirtualQueue(
 		metricsScope:        metricsScope,
 		timeSource:          timeSource,
 		taskLoadRateLimiter: taskLoadRateLimiter,
+		domainThrottler:     domainThrottler,
 		monitor:             monitor,
 
 		status:          common.DaemonStatusInitialized,
@@ -407,6 +410,12 @@ func (q *virtualQueueImpl) loadAndSubmitTasks() {
 			q.rescheduler.RescheduleTask(task, scheduledTime)
 			continue
 		}
+		// the tasks of the domains throttled by the mitigator are spread out at the rate of the throttling
+		if delay := q.domainThrottler.Delay(task.GetDomainID()); delay > 0 {
+			q.metricsScope.IncCounter(metrics.ProcessingQueueThrottledCounter)
+			q.rescheduler.RescheduleTask(task, now.Add(delay))
+			continue
+		}
 		// shard level metrics for the duration between a task being written to a queue and being fetched from it
 		q.metricsScope.RecordHistogramDuration(metrics.TaskEnqueueToFetchLatency, now.Sub(task.GetVisibilityTimestamp()))
 		task.SetInitialSubmitTime(now)
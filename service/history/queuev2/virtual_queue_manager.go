@@ -48,6 +48,9 @@ type (
 		RootQueueOptions                *VirtualQueueOptions
 		NonRootQueueOptions             *VirtualQueueOptions
 		VirtualSliceForceAppendInterval dynamicproperties.DurationPropertyFn
+		// IsolatedQueueOptions returns the options and the task load rate limiter of the virtual queue dedicated to an isolated domain,
+		// ok is false if the virtual queue is not an isolated one, and it can be nil if domain isolation is not supported
+		IsolatedQueueOptions func(queueID int64) (options *VirtualQueueOptions, taskLoadRateLimiter quotas.Limiter, ok bool)
 	}
 	VirtualQueueManager interface {
 		common.Daemon
@@ -71,6 +74,7 @@ type (
 		metricsScope        metrics.Scope
 		timeSource          clock.TimeSource
 		taskLoadRateLimiter quotas.Limiter
+		domainThrottler     DomainThrottler
 		monitor             Monitor
 		queueManagerOptions *VirtualQueueManagerOptions
 
@@ -92,25 +96,12 @@ func NewVirtualQueueManager(
 	metricsScope metrics.Scope,
 	timeSource clock.TimeSource,
 	taskLoadRateLimiter quotas.Limiter,
+	domainThrottler DomainThrottler,
 	monitor Monitor,
 	queueManagerOptions *VirtualQueueManagerOptions,
 	virtualQueueStates map[int64][]VirtualSliceState,
 ) VirtualQueueManager {
-	virtualQueues := make(map[int64]VirtualQueue)
-	for queueID, states := range virtualQueueStates {
-		virtualSlices := make([]VirtualSlice, len(states))
-		for i, state := range states {
-			virtualSlices[i] = NewVirtualSlice(state, taskInitializer, queueReader, NewPendingTaskTracker(), logger)
-		}
-		var opts *VirtualQueueOptions
-		if queueID == rootQueueID {
-			opts = queueManagerOptions.RootQueueOptions
-		} else {
-			opts = queueManagerOptions.NonRootQueueOptions
-		}
-		virtualQueues[queueID] = NewVirtualQueue(processor, rescheduler, logger.WithTags(tag.VirtualQueueID(queueID)), metricsScope, timeSource, taskLoadRateLimiter, monitor, virtualSlices, opts)
-	}
-	return &virtualQueueManagerImpl{
+	m := &virtualQueueManagerImpl{
 		processor:           processor,
 		taskInitializer:     taskInitializer,
 		queueReader:         queueReader,
@@ -119,20 +110,21 @@ func NewVirtualQueueManager(
 		metricsScope:        metricsScope,
 		timeSource:          timeSource,
 		taskLoadRateLimiter: taskLoadRateLimiter,
+		domainThrottler:     domainThrottler,
 		monitor:             monitor,
 		queueManagerOptions: queueManagerOptions,
 		status:              common.DaemonStatusInitialized,
-		virtualQueues:       virtualQueues,
-		createVirtualQueueFn: func(queueID int64, s ...VirtualSlice) VirtualQueue {
-			var opts *VirtualQueueOptions
-			if queueID == rootQueueID {
-				opts = queueManagerOptions.RootQueueOptions
-			} else {
-				opts = queueManagerOptions.NonRootQueueOptions
-			}
-			return NewVirtualQueue(processor, rescheduler, logger.WithTags(tag.VirtualQueueID(queueID)), metricsScope, timeSource, taskLoadRateLimiter, monitor, s, opts)
-		},
+		virtualQueues:       make(map[int64]VirtualQueue),
+	}
+	m.createVirtualQueueFn = m.newVirtualQueue
+	for queueID, states := range virtualQueueStates {
+		virtualSlices := make([]VirtualSlice, len(states))
+		for i, state := range states {
+			virtualSlices[i] = NewVirtualSlice(state, taskInitializer, queueReader, NewPendingTaskTracker(), logger)
+		}
+		m.virtualQueues[queueID] = m.newVirtualQueue(queueID, virtualSlices...)
 	}
+	return m
 }
 
 func (m *virtualQueueManagerImpl) Start() {
@@ -238,6 +230,24 @@ func (m *virtualQueueManagerImpl) GetMinReadLevel() persistence.HistoryTaskKey {
 	return minReadLevel
 }
 
+func (m *virtualQueueManagerImpl) newVirtualQueue(queueID int64, s ...VirtualSlice) VirtualQueue {
+	opts := m.queueManagerOptions.NonRootQueueOptions
+	taskLoadRateLimiter := m.taskLoadRateLimiter
+	domainThrottler := m.domainThrottler
+	if queueID == rootQueueID {
+		opts = m.queueManagerOptions.RootQueueOptions
+	} else if m.queueManagerOptions.IsolatedQueueOptions != nil {
+		// isolated virtual queues have their own task load rate limiter, so loading tasks of an isolated domain doesn't consume the budget of the other domains
+		if isolatedOpts, isolatedRateLimiter, ok := m.queueManagerOptions.IsolatedQueueOptions(queueID); ok {
+			opts = isolatedOpts
+			taskLoadRateLimiter = isolatedRateLimiter
+			// the tasks of an isolated domain are only limited by the settings of its isolation
+			domainThrottler = noopDomainThrottler{}
+		}
+	}
+	return NewVirtualQueue(m.processor, m.rescheduler, m.logger.WithTags(tag.VirtualQueueID(queueID)), m.metricsScope, m.timeSource, taskLoadRateLimiter, domainThrottler, m.monitor, s, opts)
+}
+
 func (m *virtualQueueManagerImpl) appendOrMergeSlice(vq VirtualQueue, s VirtualSlice) {
 	now := m.timeSource.Now()
 	newVirtualSliceState := s.GetState()
@@ -63,6 +63,7 @@ func TestVirtualQueueImpl_GetState(t *testing.T) {
 		mockMetricsScope,
 		mockTimeSource,
 		mockRateLimiter,
+		noopDomainThrottler{},
 		mockMonitor,
 		mockVirtualSlices,
 		&VirtualQueueOptions{
@@ -142,6 +143,7 @@ func TestVirtualQueueImpl_UpdateAndGetState(t *testing.T) {
 		mockMetricsScope,
 		mockTimeSource,
 		mockRateLimiter,
+		noopDomainThrottler{},
 		mockMonitor,
 		mockVirtualSlices,
 		&VirtualQueueOptions{
@@ -366,6 +368,7 @@ func TestVirtualQueue_MergeSlices(t *testing.T) {
 				mockMetricsScope,
 				mockTimeSource,
 				mockRateLimiter,
+				noopDomainThrottler{},
 				monitor,
 				existingSlices,
 				&VirtualQueueOptions{
@@ -636,7 +639,7 @@ func TestVirtualQueue_LoadAndSubmitTasks(t *testing.T) {
 	}
 
 	mockTask1 := task.NewMockTask(ctrl)
-	mockTask1.EXPECT().GetDomainID().Return("some random domainID")
+	mockTask1.EXPECT().GetDomainID().Return("some random domainID").Times(2)
 	mockTask1.EXPECT().GetWorkflowID().Return("some random workflowID")
 	mockTask1.EXPECT().GetRunID().Return("some random runID")
 	mockTask1.EXPECT().GetTaskKey().Return(persistence.NewHistoryTaskKey(mockTimeSource.Now().Add(time.Second*-1), 1))
@@ -650,7 +653,7 @@ func TestVirtualQueue_LoadAndSubmitTasks(t *testing.T) {
 	mockTask2.EXPECT().GetRunID().Return("some random runID")
 	mockTask2.EXPECT().GetTaskKey().Return(persistence.NewHistoryTaskKey(mockTimeSource.Now().Add(time.Second*1), 2))
 	mockTask3 := task.NewMockTask(ctrl)
-	mockTask3.EXPECT().GetDomainID().Return("some random domainID")
+	mockTask3.EXPECT().GetDomainID().Return("some random domainID").Times(2)
 	mockTask3.EXPECT().GetWorkflowID().Return("some random workflowID")
 	mockTask3.EXPECT().GetRunID().Return("some random runID")
 	mockTask3.EXPECT().GetTaskKey().Return(persistence.NewHistoryTaskKey(mockTimeSource.Now().Add(time.Second*-1), 1))
@@ -685,6 +688,7 @@ func TestVirtualQueue_LoadAndSubmitTasks(t *testing.T) {
 		mockMetricsScope,
 		mockTimeSource,
 		mockRateLimiter,
+		noopDomainThrottler{},
 		mockMonitor,
 		mockVirtualSlices,
 		&VirtualQueueOptions{
@@ -704,6 +708,74 @@ func TestVirtualQueue_LoadAndSubmitTasks(t *testing.T) {
 	assert.Nil(t, queue.sliceToRead)
 }
 
+func TestVirtualQueue_LoadAndSubmitTasks_ThrottledDomain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockProcessor := task.NewMockProcessor(ctrl)
+	mockRescheduler := task.NewMockRescheduler(ctrl)
+	mockTimeSource := clock.NewMockedTimeSource()
+	mockRateLimiter := quotas.NewMockLimiter(ctrl)
+	mockRateLimiter.EXPECT().Wait(gomock.Any()).Return(nil).AnyTimes()
+	mockMonitor := NewMockMonitor(ctrl)
+	mockVirtualSlice := NewMockVirtualSlice(ctrl)
+	domainThrottler := NewDomainThrottler(mockTimeSource, &DomainThrottlerOptions{
+		RPS:      dynamicproperties.GetIntPropertyFn(10),
+		Duration: dynamicproperties.GetDurationPropertyFn(time.Minute),
+	})
+	domainThrottler.Throttle("throttled-domain")
+
+	newMockTask := func(domainID string) *task.MockTask {
+		mockTask := task.NewMockTask(ctrl)
+		mockTask.EXPECT().GetDomainID().Return(domainID).AnyTimes()
+		mockTask.EXPECT().GetWorkflowID().Return("some random workflowID").AnyTimes()
+		mockTask.EXPECT().GetRunID().Return("some random runID").AnyTimes()
+		mockTask.EXPECT().GetTaskKey().Return(persistence.NewHistoryTaskKey(mockTimeSource.Now().Add(time.Second*-1), 1)).AnyTimes()
+		return mockTask
+	}
+	expectSubmit := func(mockTask *task.MockTask) {
+		mockTask.EXPECT().GetVisibilityTimestamp().Return(mockTimeSource.Now().Add(time.Second * -1))
+		mockTask.EXPECT().SetInitialSubmitTime(gomock.Any())
+		mockTask.EXPECT().GetOriginalTaskList().Return("some random taskList")
+		mockTask.EXPECT().GetOriginalTaskListKind().Return(types.TaskListKindNormal)
+		mockProcessor.EXPECT().TrySubmit(mockTask).Return(true, nil)
+	}
+	mockTask1 := newMockTask("throttled-domain")
+	mockTask2 := newMockTask("throttled-domain")
+	mockTask3 := newMockTask("other-domain")
+	expectSubmit(mockTask1)
+	expectSubmit(mockTask3)
+	// the second task of the throttled domain takes the next submission slot
+	mockRescheduler.EXPECT().RescheduleTask(mockTask2, mockTimeSource.Now().Add(100*time.Millisecond))
+
+	mockMonitor.EXPECT().GetTotalPendingTaskCount().Return(0)
+	mockVirtualSlice.EXPECT().GetTasks(gomock.Any(), 10).Return([]task.Task{mockTask1, mockTask2, mockTask3}, nil)
+	mockVirtualSlice.EXPECT().GetPendingTaskCount().Return(3)
+	mockMonitor.EXPECT().SetSlicePendingTaskCount(mockVirtualSlice, 3)
+	mockVirtualSlice.EXPECT().HasMoreTasks().Return(false)
+
+	queue := NewVirtualQueue(
+		mockProcessor,
+		mockRescheduler,
+		testlogger.New(t),
+		metrics.NoopScope,
+		mockTimeSource,
+		mockRateLimiter,
+		domainThrottler,
+		mockMonitor,
+		[]VirtualSlice{mockVirtualSlice},
+		&VirtualQueueOptions{
+			PageSize:                             dynamicproperties.GetIntPropertyFn(10),
+			MaxPendingTasksCount:                 dynamicproperties.GetIntPropertyFn(100),
+			PollBackoffInterval:                  dynamicproperties.GetDurationPropertyFn(time.Second * 10),
+			PollBackoffIntervalJitterCoefficient: dynamicproperties.GetFloatPropertyFn(0.0),
+		},
+	).(*virtualQueueImpl)
+
+	queue.loadAndSubmitTasks()
+
+	assert.Nil(t, queue.sliceToRead)
+}
+
 func TestVirtualQueue_LifeCycle(t *testing.T) {
 	defer goleak.VerifyNone(t)
 	ctrl := gomock.NewController(t)
@@ -743,6 +815,7 @@ func TestVirtualQueue_LifeCycle(t *testing.T) {
 		mockMetricsScope,
 		mockTimeSource,
 		mockRateLimiter,
+		noopDomainThrottler{},
 		mockMonitor,
 		mockVirtualSlices,
 		&VirtualQueueOptions{
@@ -785,6 +858,7 @@ func TestVirtualQueue_LifeCycle_Pause(t *testing.T) {
 		mockMetricsScope,
 		mockTimeSource,
 		mockRateLimiter,
+		noopDomainThrottler{},
 		mockMonitor,
 		mockVirtualSlices,
 		&VirtualQueueOptions{
@@ -860,6 +934,7 @@ func TestVirtualQueue_IterateSlices(t *testing.T) {
 		mockMetricsScope,
 		mockTimeSource,
 		mockRateLimiter,
+		noopDomainThrottler{},
 		mockMonitor,
 		mockVirtualSlices,
 		&VirtualQueueOptions{
@@ -945,6 +1020,7 @@ func TestVirtualQueue_ClearSlices(t *testing.T) {
 		mockMetricsScope,
 		mockTimeSource,
 		mockRateLimiter,
+		noopDomainThrottler{},
 		mockMonitor,
 		mockVirtualSlices,
 		&VirtualQueueOptions{
@@ -1028,6 +1104,7 @@ func TestVirtualQueue_SplitSlices(t *testing.T) {
 		mockMetricsScope,
 		mockTimeSource,
 		mockRateLimiter,
+		noopDomainThrottler{},
 		mockMonitor,
 		mockVirtualSlices,
 		&VirtualQueueOptions{
@@ -1363,6 +1440,7 @@ func TestVirtualQueue_AppendSlices(t *testing.T) {
 				mockMetricsScope,
 				mockTimeSource,
 				mockRateLimiter,
+				noopDomainThrottler{},
 				monitor,
 				existingSlices,
 				&VirtualQueueOptions{
@@ -1754,6 +1832,7 @@ func TestVirtualQueue_MergeWithLastSlice(t *testing.T) {
 				mockMetricsScope,
 				mockTimeSource,
 				mockRateLimiter,
+				noopDomainThrottler{},
 				monitor,
 				existingSlices,
 				&VirtualQueueOptions{
@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
+	ctask "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/service/history/task"
 )
 
@@ -51,6 +52,8 @@ type (
 
 	PendingTaskStats struct {
 		PendingTaskCountPerDomain map[string]int
+		// MaxTaskAttemptPerDomain is the max attempt of the tasks that are not acked yet per domain
+		MaxTaskAttemptPerDomain map[string]int
 	}
 
 	virtualSliceImpl struct {
@@ -170,8 +173,17 @@ func (s *virtualSliceImpl) HasMoreTasks() bool {
 }
 
 func (s *virtualSliceImpl) PendingTaskStats() PendingTaskStats {
+	maxTaskAttemptPerDomain := make(map[string]int)
+	for _, task := range s.pendingTaskTracker.GetTasks() {
+		if task.State() == ctask.TaskStateAcked {
+			continue
+		}
+		domainID := task.GetDomainID()
+		maxTaskAttemptPerDomain[domainID] = max(maxTaskAttemptPerDomain[domainID], task.GetAttempt())
+	}
 	return PendingTaskStats{
 		PendingTaskCountPerDomain: s.pendingTaskTracker.GetPerDomainPendingTaskCount(),
+		MaxTaskAttemptPerDomain:   maxTaskAttemptPerDomain,
 	}
 }
 
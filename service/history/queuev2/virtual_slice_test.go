@@ -10,6 +10,7 @@ import (
 
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/persistence"
+	ctask "github.com/uber/cadence/common/task"
 	"github.com/uber/cadence/service/history/task"
 )
 
@@ -3014,15 +3015,18 @@ func TestPendingTaskStats(t *testing.T) {
 		{
 			name: "Empty pending task tracker - should return empty stats",
 			mockSetup: func(mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetTasks().Return(map[persistence.HistoryTaskKey]task.Task{})
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{})
 			},
 			expectedStats: PendingTaskStats{
 				PendingTaskCountPerDomain: map[string]int{},
+				MaxTaskAttemptPerDomain:   map[string]int{},
 			},
 		},
 		{
 			name: "Single domain with tasks - should return correct stats",
 			mockSetup: func(mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetTasks().Return(map[persistence.HistoryTaskKey]task.Task{})
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
 					"domain1": 5,
 				})
@@ -3031,11 +3035,13 @@ func TestPendingTaskStats(t *testing.T) {
 				PendingTaskCountPerDomain: map[string]int{
 					"domain1": 5,
 				},
+				MaxTaskAttemptPerDomain: map[string]int{},
 			},
 		},
 		{
 			name: "Multiple domains with tasks - should return correct stats",
 			mockSetup: func(mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetTasks().Return(map[persistence.HistoryTaskKey]task.Task{})
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
 					"domain1": 3,
 					"domain2": 7,
@@ -3048,11 +3054,13 @@ func TestPendingTaskStats(t *testing.T) {
 					"domain2": 7,
 					"domain3": 2,
 				},
+				MaxTaskAttemptPerDomain: map[string]int{},
 			},
 		},
 		{
 			name: "Domain with zero tasks - should include zero counts",
 			mockSetup: func(mock *MockPendingTaskTracker) {
+				mock.EXPECT().GetTasks().Return(map[persistence.HistoryTaskKey]task.Task{})
 				mock.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
 					"domain1": 5,
 					"domain2": 0,
@@ -3065,6 +3073,7 @@ func TestPendingTaskStats(t *testing.T) {
 					"domain2": 0,
 					"domain3": 3,
 				},
+				MaxTaskAttemptPerDomain: map[string]int{},
 			},
 		},
 	}
@@ -3098,6 +3107,44 @@ func TestPendingTaskStats(t *testing.T) {
 	}
 }
 
+func TestPendingTaskStats_MaxTaskAttempt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPendingTaskTracker := NewMockPendingTaskTracker(ctrl)
+
+	newMockTask := func(domainID string, attempt int, state ctask.State) task.Task {
+		mockTask := task.NewMockTask(ctrl)
+		mockTask.EXPECT().State().Return(state).AnyTimes()
+		mockTask.EXPECT().GetDomainID().Return(domainID).AnyTimes()
+		mockTask.EXPECT().GetAttempt().Return(attempt).AnyTimes()
+		return mockTask
+	}
+	mockPendingTaskTracker.EXPECT().GetTasks().Return(map[persistence.HistoryTaskKey]task.Task{
+		persistence.NewImmediateTaskKey(1): newMockTask("domain1", 3, ctask.TaskStatePending),
+		persistence.NewImmediateTaskKey(2): newMockTask("domain1", 7, ctask.TaskStatePending),
+		persistence.NewImmediateTaskKey(3): newMockTask("domain2", 1, ctask.TaskStatePending),
+		persistence.NewImmediateTaskKey(4): newMockTask("domain2", 10, ctask.TaskStateAcked),
+	})
+	mockPendingTaskTracker.EXPECT().GetPerDomainPendingTaskCount().Return(map[string]int{
+		"domain1": 2,
+		"domain2": 2,
+	})
+
+	slice := &virtualSliceImpl{
+		pendingTaskTracker: mockPendingTaskTracker,
+	}
+
+	assert.Equal(t, PendingTaskStats{
+		PendingTaskCountPerDomain: map[string]int{
+			"domain1": 2,
+			"domain2": 2,
+		},
+		MaxTaskAttemptPerDomain: map[string]int{
+			"domain1": 7,
+			"domain2": 1,
+		},
+	}, slice.PendingTaskStats())
+}
+
 func TestMergeVirtualSlicesWithDifferentPredicate(t *testing.T) {
 	tests := []struct {
 		name           string
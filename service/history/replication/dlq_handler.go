@@ -23,6 +23,7 @@ package replication
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -41,6 +42,8 @@ import (
 
 const (
 	defaultBeginningMessageID = -1
+	// the DLQ is scanned in pages of this size when counting the messages of a domain
+	domainMessageCountPageSize = 1000
 )
 
 var (
@@ -56,6 +59,10 @@ type (
 			ctx context.Context,
 			forceFetch bool,
 		) (map[string]int64, error)
+		GetDomainMessageCount(
+			ctx context.Context,
+			domainID string,
+		) (map[string]int64, error)
 		ReadMessages(
 			ctx context.Context,
 			sourceCluster string,
@@ -172,6 +179,40 @@ func (r *dlqHandlerImpl) fetchAndEmitMessageCount(ctx context.Context) error {
 	return nil
 }
 
+// GetDomainMessageCount returns the number of DLQ messages of the domain per source cluster
+func (r *dlqHandlerImpl) GetDomainMessageCount(ctx context.Context, domainID string) (map[string]int64, error) {
+	result := map[string]int64{}
+	for sourceCluster := range r.taskExecutors {
+		var pageToken []byte
+		for {
+			resp, err := r.shard.GetExecutionManager().GetReplicationTasksFromDLQ(
+				ctx,
+				&persistence.GetReplicationTasksFromDLQRequest{
+					SourceClusterName: sourceCluster,
+					ReadLevel:         defaultBeginningMessageID + 1,
+					MaxReadLevel:      math.MaxInt64,
+					BatchSize:         domainMessageCountPageSize,
+					NextPageToken:     pageToken,
+					ShardID:           common.Ptr(r.shard.GetShardID()),
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			for _, task := range resp.Tasks {
+				if task.Info != nil && task.Info.DomainID == domainID {
+					result[sourceCluster]++
+				}
+			}
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+	return result, nil
+}
+
 func (r *dlqHandlerImpl) emitDLQSizeMetricsLoop() {
 	getInterval := func() time.Duration {
 		return backoff.JitDuration(
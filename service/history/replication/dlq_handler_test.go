@@ -23,6 +23,7 @@ package replication
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
 	"time"
 
@@ -365,6 +366,41 @@ func (s *dlqHandlerSuite) TestReadMessagesWithAckLevel_OK() {
 	s.Equal(nextPageToken, replicationTasksResponse.NextPageToken)
 }
 
+func (s *dlqHandlerSuite) TestGetDomainMessageCount() {
+	ctx := context.Background()
+	newDLQTask := func(domainID string) *persistence.ReplicationDLQTask {
+		return &persistence.ReplicationDLQTask{Info: &persistence.ReplicationTaskInfo{DomainID: domainID}}
+	}
+	request := &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		ReadLevel:         0,
+		MaxReadLevel:      math.MaxInt64,
+		BatchSize:         domainMessageCountPageSize,
+		ShardID:           common.Ptr(0),
+	}
+	s.executionManager.On("GetReplicationTasksFromDLQ", ctx, request).Return(&persistence.GetReplicationDLQTasksResponse{
+		Tasks:         []*persistence.ReplicationDLQTask{newDLQTask("domainID"), newDLQTask("otherDomainID")},
+		NextPageToken: []byte("token"),
+	}, nil).Times(1)
+	nextRequest := *request
+	nextRequest.NextPageToken = []byte("token")
+	s.executionManager.On("GetReplicationTasksFromDLQ", ctx, &nextRequest).Return(&persistence.GetReplicationDLQTasksResponse{
+		Tasks: []*persistence.ReplicationDLQTask{newDLQTask("domainID"), {}},
+	}, nil).Times(1)
+
+	counts, err := s.messageHandler.GetDomainMessageCount(ctx, "domainID")
+	s.NoError(err)
+	s.Equal(map[string]int64{s.sourceCluster: 2}, counts)
+}
+
+func (s *dlqHandlerSuite) TestGetDomainMessageCount_Failed() {
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).Return(nil, errors.New("test-error")).Times(1)
+
+	counts, err := s.messageHandler.GetDomainMessageCount(context.Background(), "domainID")
+	s.Error(err)
+	s.Nil(counts)
+}
+
 func (s *dlqHandlerSuite) TestReadMessagesWithAckLevel_GetReplicationTasksFromDLQFailed() {
 	errorMessage := "GetReplicationTasksFromDLQFailed"
 	ctx := context.Background()
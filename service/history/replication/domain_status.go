@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// pending replication tasks are read in batches of this size when computing the replication status of a domain
+	domainStatusReadBatchSize = 1000
+	// max number of pending replication tasks read per remote cluster, the pending task count is truncated above it
+	domainStatusMaxReadTaskCount = 10000
+)
+
+type (
+	// replicatedTaskTracker keeps the latest replication task of each domain sent to and acknowledged by each polling cluster.
+	// It is kept in memory, so the last replicated tasks are unknown until the remote clusters poll a shard after it's loaded.
+	replicatedTaskTracker struct {
+		sync.Mutex
+		// polling cluster -> domain ID -> latest task sent to the cluster
+		sent map[string]map[string]replicatedTask
+		// polling cluster -> domain ID -> latest task acknowledged by the cluster
+		acked map[string]map[string]replicatedTask
+	}
+
+	replicatedTask struct {
+		taskID    int64
+		timestamp time.Time
+	}
+)
+
+func newReplicatedTaskTracker() *replicatedTaskTracker {
+	return &replicatedTaskTracker{
+		sent:  make(map[string]map[string]replicatedTask),
+		acked: make(map[string]map[string]replicatedTask),
+	}
+}
+
+// ack marks the tasks sent to the polling cluster up to the ack level as replicated
+func (r *replicatedTaskTracker) ack(pollingCluster string, ackLevel int64) {
+	r.Lock()
+	defer r.Unlock()
+
+	for domainID, task := range r.sent[pollingCluster] {
+		if task.taskID > ackLevel {
+			continue
+		}
+		if r.acked[pollingCluster] == nil {
+			r.acked[pollingCluster] = make(map[string]replicatedTask)
+		}
+		r.acked[pollingCluster][domainID] = task
+		delete(r.sent[pollingCluster], domainID)
+	}
+}
+
+// send records the tasks sent to the polling cluster, up to the last retrieved task ID
+func (r *replicatedTaskTracker) send(pollingCluster string, tasks []persistence.Task, lastRetrievedTaskID int64) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, task := range tasks {
+		if task.GetTaskID() > lastRetrievedTaskID {
+			break
+		}
+		if r.sent[pollingCluster] == nil {
+			r.sent[pollingCluster] = make(map[string]replicatedTask)
+		}
+		r.sent[pollingCluster][task.GetDomainID()] = replicatedTask{
+			taskID:    task.GetTaskID(),
+			timestamp: task.GetVisibilityTimestamp(),
+		}
+	}
+}
+
+// getLastReplicated returns the latest task of the domain acknowledged by the cluster
+func (r *replicatedTaskTracker) getLastReplicated(cluster string, domainID string, ackLevel int64) (replicatedTask, bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	if task, ok := r.sent[cluster][domainID]; ok && task.taskID <= ackLevel {
+		return task, true
+	}
+	task, ok := r.acked[cluster][domainID]
+	return task, ok
+}
+
+// GetDomainReplicationStatus returns the replication status of the domain to each of the given remote clusters on this shard
+func (t *TaskAckManager) GetDomainReplicationStatus(
+	ctx context.Context,
+	domainID string,
+	clusters []string,
+) (map[string]*types.ReplicationClusterStatus, error) {
+	result := make(map[string]*types.ReplicationClusterStatus, len(clusters))
+	for _, cluster := range clusters {
+		status := &types.ReplicationClusterStatus{ClusterName: cluster}
+
+		readLevel := t.ackLevels.GetQueueClusterAckLevel(persistence.HistoryTaskCategoryReplication, cluster).GetTaskID()
+		if task, ok := t.replicatedTasks.getLastReplicated(cluster, domainID, readLevel); ok {
+			status.LastReplicatedTaskID = task.taskID
+			status.LastReplicatedTimestamp = common.Ptr(task.timestamp.UnixNano())
+		}
+
+		maxReadLevel := t.ackLevels.UpdateIfNeededAndGetQueueMaxReadLevel(persistence.HistoryTaskCategoryReplication, cluster).GetTaskID()
+		readCount := 0
+		for readLevel < maxReadLevel {
+			if readCount >= domainStatusMaxReadTaskCount {
+				status.HasMorePendingTasks = true
+				break
+			}
+			tasks, hasMore, err := t.reader.Read(ctx, readLevel, maxReadLevel, domainStatusReadBatchSize)
+			if err != nil {
+				return nil, err
+			}
+			for _, task := range tasks {
+				if task.GetDomainID() != domainID {
+					continue
+				}
+				if status.PendingTaskCount == 0 {
+					// tasks are ordered by task ID, so the first one is the oldest pending task
+					status.EstimatedLag = t.timeSource.Now().Sub(task.GetVisibilityTimestamp())
+				}
+				status.PendingTaskCount++
+			}
+			readCount += len(tasks)
+			if !hasMore || len(tasks) == 0 {
+				break
+			}
+			readLevel = tasks[len(tasks)-1].GetTaskID()
+		}
+		result[cluster] = status
+	}
+	return result, nil
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
+)
+
+func newTestReplicationTask(domainID string, taskID int64, timestamp time.Time) *persistence.HistoryReplicationTask {
+	return &persistence.HistoryReplicationTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID: domainID,
+		},
+		TaskData: persistence.TaskData{
+			TaskID:              taskID,
+			VisibilityTimestamp: timestamp,
+		},
+	}
+}
+
+func TestReplicatedTaskTracker(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tracker := newReplicatedTaskTracker()
+
+	_, ok := tracker.getLastReplicated(testClusterA, testDomainID, 100)
+	assert.False(t, ok)
+
+	tracker.send(testClusterA, []persistence.Task{
+		newTestReplicationTask(testDomainID, 11, now),
+		newTestReplicationTask("other-domain-id", 12, now),
+		newTestReplicationTask(testDomainID, 13, now.Add(time.Second)),
+		// not sent to the cluster, e.g. the response was shrunk
+		newTestReplicationTask(testDomainID, 14, now.Add(2*time.Second)),
+	}, 13)
+
+	// the sent tasks are not replicated until the cluster acknowledges them
+	_, ok = tracker.getLastReplicated(testClusterA, testDomainID, 10)
+	assert.False(t, ok)
+	task, ok := tracker.getLastReplicated(testClusterA, testDomainID, 13)
+	assert.True(t, ok)
+	assert.Equal(t, replicatedTask{taskID: 13, timestamp: now.Add(time.Second)}, task)
+	_, ok = tracker.getLastReplicated(testClusterB, testDomainID, 13)
+	assert.False(t, ok)
+
+	tracker.ack(testClusterA, 13)
+	tracker.send(testClusterA, []persistence.Task{newTestReplicationTask(testDomainID, 14, now.Add(2*time.Second))}, 14)
+
+	// task 14 is sent but not acknowledged yet
+	task, ok = tracker.getLastReplicated(testClusterA, testDomainID, 13)
+	assert.True(t, ok)
+	assert.Equal(t, int64(13), task.taskID)
+	task, ok = tracker.getLastReplicated(testClusterA, "other-domain-id", 13)
+	assert.True(t, ok)
+	assert.Equal(t, int64(12), task.taskID)
+}
+
+func TestTaskAckManager_GetDomainReplicationStatus(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ackLevels := &fakeAckLevelStore{
+		readLevel: 20,
+		remote: map[string]persistence.HistoryTaskKey{
+			testClusterA: persistence.NewImmediateTaskKey(10),
+			testClusterB: persistence.NewImmediateTaskKey(12),
+		},
+	}
+	reader := fakeTaskReader{
+		newTestReplicationTask(testDomainID, 11, now.Add(-time.Minute)),
+		newTestReplicationTask("other-domain-id", 12, now.Add(-time.Minute)),
+		newTestReplicationTask(testDomainID, 13, now.Add(-time.Second)),
+	}
+	ackManager := NewTaskAckManager(
+		testShardID,
+		ackLevels,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoop(),
+		reader,
+		nil,
+		clock.NewMockedTimeSourceAt(now),
+		testConfig,
+		proto.ReplicationMessagesSize,
+		fakeDynamicTaskBatchSizer(10),
+	)
+	ackManager.replicatedTasks.send(testClusterB, []persistence.Task{newTestReplicationTask(testDomainID, 9, now.Add(-time.Hour))}, 9)
+
+	status, err := ackManager.GetDomainReplicationStatus(context.Background(), testDomainID, []string{testClusterA, testClusterB})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*types.ReplicationClusterStatus{
+		testClusterA: {
+			ClusterName:      testClusterA,
+			PendingTaskCount: 2,
+			EstimatedLag:     time.Minute,
+		},
+		testClusterB: {
+			ClusterName:             testClusterB,
+			LastReplicatedTaskID:    9,
+			LastReplicatedTimestamp: common.Ptr(now.Add(-time.Hour).UnixNano()),
+			PendingTaskCount:        1,
+			EstimatedLag:            time.Second,
+		},
+	}, status)
+}
+
+func TestTaskAckManager_GetDomainReplicationStatus_Truncated(t *testing.T) {
+	now := time.Unix(1000, 0)
+	var reader fakeTaskReader
+	for taskID := int64(1); taskID <= domainStatusMaxReadTaskCount+domainStatusReadBatchSize; taskID++ {
+		reader = append(reader, newTestReplicationTask(testDomainID, taskID, now))
+	}
+	ackManager := NewTaskAckManager(
+		testShardID,
+		&fakeAckLevelStore{
+			readLevel: domainStatusMaxReadTaskCount + domainStatusReadBatchSize + 1,
+			remote:    map[string]persistence.HistoryTaskKey{testClusterA: persistence.NewImmediateTaskKey(0)},
+		},
+		metrics.NewNoopMetricsClient(),
+		log.NewNoop(),
+		reader,
+		nil,
+		clock.NewMockedTimeSourceAt(now),
+		testConfig,
+		proto.ReplicationMessagesSize,
+		fakeDynamicTaskBatchSizer(10),
+	)
+
+	status, err := ackManager.GetDomainReplicationStatus(context.Background(), testDomainID, []string{testClusterA})
+	require.NoError(t, err)
+	assert.True(t, status[testClusterA].HasMorePendingTasks)
+	assert.GreaterOrEqual(t, status[testClusterA].PendingTaskCount, int64(domainStatusMaxReadTaskCount))
+}
+
+func TestTaskAckManager_GetDomainReplicationStatus_ReadError(t *testing.T) {
+	ackManager := NewTaskAckManager(
+		testShardID,
+		&fakeAckLevelStore{
+			readLevel: 20,
+			remote:    map[string]persistence.HistoryTaskKey{testClusterA: persistence.NewImmediateTaskKey(10)},
+		},
+		metrics.NewNoopMetricsClient(),
+		log.NewNoop(),
+		fakeTaskReader(nil),
+		nil,
+		clock.NewMockedTimeSource(),
+		testConfig,
+		proto.ReplicationMessagesSize,
+		fakeDynamicTaskBatchSizer(10),
+	)
+
+	_, err := ackManager.GetDomainReplicationStatus(context.Background(), testDomainID, []string{testClusterA})
+	assert.Error(t, err)
+}
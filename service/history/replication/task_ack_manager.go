@@ -59,6 +59,8 @@ type (
 
 		dynamicTaskBatchSizer DynamicTaskBatchSizer
 		timeSource            clock.TimeSource
+
+		replicatedTasks *replicatedTaskTracker
 	}
 
 	ackLevelStore interface {
@@ -99,6 +101,7 @@ func NewTaskAckManager(
 		maxReplicationMessagesSize: config.MaxResponseSize,
 		replicationMessagesSizeFn:  replicationMessagesSizeFn,
 		dynamicTaskBatchSizer:      dynamicTaskBatchSizer,
+		replicatedTasks:            newReplicatedTaskTracker(),
 	}
 }
 
@@ -222,6 +225,8 @@ func (t *TaskAckManager) getTasks(ctx context.Context, pollingCluster string, la
 	t.scope.AddCounter(metrics.ReplicationTasksReturnedDiffCounter, int64(tasksReturnedDiff))
 
 	t.ackLevel(pollingCluster, lastReadTaskID)
+	t.replicatedTasks.ack(pollingCluster, lastReadTaskID)
+	t.replicatedTasks.send(pollingCluster, taskInfos, msgs.LastRetrievedMessageID)
 
 	t.logger.Debug(
 		"Get replication tasks",
@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/wrappers/sampled"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/engine"
@@ -1831,6 +1832,18 @@ func acquireShard(
 	if err != nil {
 		return nil, err
 	}
+	if shardItem.writeAuditWriter != nil {
+		executionMgr = sampled.NewExecutionManager(executionMgr, sampled.WriteAuditParams{
+			Config: &sampled.WriteAuditConfig{
+				EnableWriteAudit: shardItem.config.EnablePersistenceWriteAudit,
+				WriteAuditMaxQPS: shardItem.config.PersistenceWriteAuditMaxQPS,
+			},
+			Writer:                 shardItem.writeAuditWriter,
+			HostName:               shardItem.GetHostInfo().Identity(),
+			TimeSource:             shardItem.GetTimeSource(),
+			RateLimiterFactoryFunc: sampled.NewDomainToBucketMap,
+		})
+	}
 
 	context := &contextImpl{
 		Resource:                     shardItem.Resource,
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/wrappers/sampled"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
@@ -95,6 +96,9 @@ type (
 		config                   *config.Config
 		metricsScope             metrics.Scope
 		replicationBudgetManager cache.Manager
+		// writeAuditWriter writes the persistence write audit records of all the shards of the host, nil when the
+		// write audit is not configured
+		writeAuditWriter sampled.WriteAuditWriter
 
 		sync.RWMutex
 		historyShards   map[int]*historyShardsItem
@@ -112,6 +116,7 @@ type (
 		throttledLogger          log.Logger
 		engineFactory            EngineFactory
 		replicationBudgetManager cache.Manager
+		writeAuditWriter         sampled.WriteAuditWriter
 
 		sync.RWMutex
 		status historyShardsItemStatus
@@ -133,6 +138,14 @@ func NewShardController(
 	replicationBudgetManager cache.Manager,
 ) Controller {
 	hostAddress := resource.GetHostInfo().GetAddress()
+	var writeAuditWriter sampled.WriteAuditWriter
+	if blobstoreClient := resource.GetBlobstoreClient(); blobstoreClient != nil && config.EnablePersistenceWriteAudit != nil {
+		writeAuditWriter = sampled.NewAsyncWriteAuditWriter(
+			sampled.NewBlobstoreWriteAuditStore(blobstoreClient, config.PersistenceWriteAuditMaxRecords),
+			resource.GetLogger().WithTags(tag.ComponentShardController),
+			resource.GetTimeSource(),
+		)
+	}
 	return &controller{
 		Resource:                 resource,
 		status:                   common.DaemonStatusInitialized,
@@ -145,6 +158,7 @@ func NewShardController(
 		config:                   config,
 		metricsScope:             resource.GetMetricsClient().Scope(metrics.HistoryShardControllerScope),
 		replicationBudgetManager: replicationBudgetManager,
+		writeAuditWriter:         writeAuditWriter,
 	}
 }
 
@@ -154,6 +168,7 @@ func newHistoryShardsItem(
 	factory EngineFactory,
 	config *config.Config,
 	replicationBudgetManager cache.Manager,
+	writeAuditWriter sampled.WriteAuditWriter,
 ) (*historyShardsItem, error) {
 
 	hostAddress := resource.GetHostInfo().GetAddress()
@@ -166,6 +181,7 @@ func newHistoryShardsItem(
 		logger:                   resource.GetLogger().WithTags(tag.ShardID(shardID), tag.Address(hostAddress)),
 		throttledLogger:          resource.GetThrottledLogger().WithTags(tag.ShardID(shardID), tag.Address(hostAddress)),
 		replicationBudgetManager: replicationBudgetManager,
+		writeAuditWriter:         writeAuditWriter,
 	}, nil
 }
 
@@ -174,6 +190,9 @@ func (c *controller) Start() {
 		return
 	}
 
+	if c.writeAuditWriter != nil {
+		c.writeAuditWriter.Start()
+	}
 	c.acquireShards()
 	c.shutdownWG.Add(1)
 	go c.shardManagementPump()
@@ -204,6 +223,10 @@ func (c *controller) Stop() {
 	if success := common.AwaitWaitGroup(&c.shutdownWG, time.Minute); !success {
 		c.logger.Warn("", tag.LifeCycleStopTimedout)
 	}
+	// stopped after the shards, so that it writes the records of their last mutations
+	if c.writeAuditWriter != nil {
+		c.writeAuditWriter.Stop()
+	}
 
 	c.logger.Info("Shard controller state changed", tag.LifeCycleStopped)
 }
@@ -344,6 +367,7 @@ func (c *controller) getOrCreateHistoryShardItem(shardID int) (*historyShardsIte
 			c.engineFactory,
 			c.config,
 			c.replicationBudgetManager,
+			c.writeAuditWriter,
 		)
 		if err != nil {
 			return nil, err
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
@@ -78,9 +79,10 @@ func NewPriorityAssigner(
 
 func (a *priorityAssignerImpl) Assign(queueTask Task) error {
 	if priority := queueTask.Priority(); priority != noPriority {
-		if priority != lowTaskPriority && queueTask.GetAttempt() > a.config.TaskCriticalRetryCount() {
+		if constants.GetTaskPriorityClass(priority) != constants.LowPriorityClass && queueTask.GetAttempt() > a.config.TaskCriticalRetryCount() {
 			// automatically lower the priority if task attempt exceeds certain threshold
-			queueTask.SetPriority(lowTaskPriority)
+			// the subclass is kept so that retried user facing tasks are still scheduled before background tasks
+			queueTask.SetPriority(constants.GetTaskPriority(constants.LowPriorityClass, constants.GetTaskPrioritySubclass(priority)))
 		}
 		return nil
 	}
@@ -105,9 +107,14 @@ func (a *priorityAssignerImpl) Assign(queueTask Task) error {
 	// 3. standby task for active domain
 	// 4. standby task for standby domain
 
+	subclass := constants.DefaultPrioritySubclass
+	if a.config.EnableTaskPriorityByTaskType(domainName) {
+		subclass = getTaskPrioritySubclass(queueType, queueTask.GetTaskType())
+	}
+
 	if !isActiveTask && !isActiveDomain {
 		// only assign low priority to tasks in the fourth case
-		queueTask.SetPriority(lowTaskPriority)
+		queueTask.SetPriority(constants.GetTaskPriority(constants.LowPriorityClass, subclass))
 		return nil
 	}
 
@@ -116,7 +123,7 @@ func (a *priorityAssignerImpl) Assign(queueTask Task) error {
 	// it can be quickly verified/acked and won't prevent the ack level in the processor from advancing
 	// (especially for active processor)
 	if !a.rateLimiters.For(domainName).Allow() {
-		queueTask.SetPriority(defaultTaskPriority)
+		queueTask.SetPriority(constants.GetTaskPriority(constants.DefaultPriorityClass, subclass))
 		taggedScope := a.scope.Tagged(metrics.DomainTag(domainName))
 		switch queueType {
 		case QueueTypeActiveTransfer, QueueTypeStandbyTransfer:
@@ -127,10 +134,42 @@ func (a *priorityAssignerImpl) Assign(queueTask Task) error {
 		return nil
 	}
 
-	queueTask.SetPriority(highTaskPriority)
+	queueTask.SetPriority(constants.GetTaskPriority(constants.HighPriorityClass, subclass))
 	return nil
 }
 
+// getTaskPrioritySubclass returns the priority subclass of a task by its type. Within the priority class assigned
+// by domain activeness and rate limit, tasks driving workflow progress get the high subclass and background tasks
+// like retention deletion and visibility updates get the low subclass, so a backlog of background tasks
+// doesn't delay user facing tasks. There is no archival task type, history archival is done by the delete history
+// event timer task, so it gets the low subclass along with retention deletion.
+func getTaskPrioritySubclass(queueType QueueType, taskType int) int {
+	switch queueType {
+	case QueueTypeActiveTransfer, QueueTypeStandbyTransfer, QueueTypeTransfer:
+		switch taskType {
+		case persistence.TransferTaskTypeDecisionTask, persistence.TransferTaskTypeActivityTask:
+			return constants.HighPrioritySubclass
+		case persistence.TransferTaskTypeRecordWorkflowStarted,
+			persistence.TransferTaskTypeUpsertWorkflowSearchAttributes,
+			persistence.TransferTaskTypeRecordWorkflowClosed:
+			return constants.LowPrioritySubclass
+		}
+	case QueueTypeActiveTimer, QueueTypeStandbyTimer, QueueTypeTimer:
+		switch taskType {
+		case persistence.TaskTypeDecisionTimeout,
+			persistence.TaskTypeActivityTimeout,
+			persistence.TaskTypeUserTimer,
+			persistence.TaskTypeWorkflowTimeout,
+			persistence.TaskTypeActivityRetryTimer,
+			persistence.TaskTypeWorkflowBackoffTimer:
+			return constants.HighPrioritySubclass
+		case persistence.TaskTypeDeleteHistoryEvent:
+			return constants.LowPrioritySubclass
+		}
+	}
+	return constants.DefaultPrioritySubclass
+}
+
 // getDomainInfo returns three pieces of information:
 //  1. domain name
 //  2. if domain is active
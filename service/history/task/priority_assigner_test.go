@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
@@ -248,7 +249,7 @@ func (s *taskPriorityAssignerSuite) TestAssign_ThrottledTask() {
 }
 
 func (s *taskPriorityAssignerSuite) TestAssign_AlreadyAssigned() {
-	priority := 5
+	priority := commonconstants.GetTaskPriority(commonconstants.DefaultPriorityClass, commonconstants.DefaultPrioritySubclass)
 
 	// case 1: task attempt less than critical retry count
 	mockTask := NewMockTask(s.controller)
@@ -265,6 +266,107 @@ func (s *taskPriorityAssignerSuite) TestAssign_AlreadyAssigned() {
 	s.NoError(err)
 }
 
+func (s *taskPriorityAssignerSuite) TestAssign_AlreadyAssigned_KeepSubclass() {
+	priority := commonconstants.GetTaskPriority(commonconstants.HighPriorityClass, commonconstants.LowPrioritySubclass)
+
+	mockTask := NewMockTask(s.controller)
+	mockTask.EXPECT().Priority().Return(priority).Times(1)
+	mockTask.EXPECT().GetAttempt().Return(s.config.TaskCriticalRetryCount() + 1).Times(1)
+	mockTask.EXPECT().SetPriority(commonconstants.GetTaskPriority(commonconstants.LowPriorityClass, commonconstants.LowPrioritySubclass)).Times(1)
+	err := s.priorityAssigner.Assign(mockTask)
+	s.NoError(err)
+}
+
+func (s *taskPriorityAssignerSuite) TestAssign_PriorityByTaskType() {
+	s.config.EnableTaskPriorityByTaskType = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
+	s.mockDomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(constants.TestGlobalDomainEntry, nil).AnyTimes()
+	s.mockActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID).Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil).AnyTimes()
+
+	testCases := []struct {
+		queueType        QueueType
+		taskType         int
+		expectedPriority int
+	}{
+		{
+			queueType:        QueueTypeActiveTimer,
+			taskType:         persistence.TaskTypeUserTimer,
+			expectedPriority: commonconstants.GetTaskPriority(commonconstants.HighPriorityClass, commonconstants.HighPrioritySubclass),
+		},
+		{
+			queueType:        QueueTypeActiveTimer,
+			taskType:         persistence.TaskTypeDeleteHistoryEvent,
+			expectedPriority: commonconstants.GetTaskPriority(commonconstants.HighPriorityClass, commonconstants.LowPrioritySubclass),
+		},
+		{
+			queueType:        QueueTypeActiveTransfer,
+			taskType:         persistence.TransferTaskTypeRecordWorkflowClosed,
+			expectedPriority: commonconstants.GetTaskPriority(commonconstants.HighPriorityClass, commonconstants.LowPrioritySubclass),
+		},
+		{
+			queueType:        QueueTypeActiveTransfer,
+			taskType:         persistence.TransferTaskTypeCloseExecution,
+			expectedPriority: commonconstants.GetTaskPriority(commonconstants.HighPriorityClass, commonconstants.DefaultPrioritySubclass),
+		},
+	}
+
+	for _, tc := range testCases {
+		mockTask := NewMockTask(s.controller)
+		mockTask.EXPECT().GetQueueType().Return(tc.queueType).AnyTimes()
+		mockTask.EXPECT().GetTaskType().Return(tc.taskType).Times(1)
+		mockTask.EXPECT().GetDomainID().Return(constants.TestDomainID).Times(1)
+		mockTask.EXPECT().GetWorkflowID().Return(constants.TestWorkflowID).Times(1)
+		mockTask.EXPECT().GetRunID().Return(constants.TestRunID).Times(1)
+		mockTask.EXPECT().Priority().Return(noPriority).Times(1)
+		mockTask.EXPECT().SetPriority(tc.expectedPriority).Times(1)
+
+		err := s.priorityAssigner.Assign(mockTask)
+		s.NoError(err)
+	}
+}
+
+func (s *taskPriorityAssignerSuite) TestGetTaskPrioritySubclass() {
+	testCases := []struct {
+		queueType        QueueType
+		taskType         int
+		expectedSubclass int
+	}{
+		{
+			queueType:        QueueTypeTransfer,
+			taskType:         persistence.TransferTaskTypeDecisionTask,
+			expectedSubclass: commonconstants.HighPrioritySubclass,
+		},
+		{
+			queueType:        QueueTypeStandbyTransfer,
+			taskType:         persistence.TransferTaskTypeUpsertWorkflowSearchAttributes,
+			expectedSubclass: commonconstants.LowPrioritySubclass,
+		},
+		{
+			queueType:        QueueTypeTransfer,
+			taskType:         persistence.TransferTaskTypeSignalExecution,
+			expectedSubclass: commonconstants.DefaultPrioritySubclass,
+		},
+		{
+			queueType:        QueueTypeTimer,
+			taskType:         persistence.TaskTypeActivityTimeout,
+			expectedSubclass: commonconstants.HighPrioritySubclass,
+		},
+		{
+			queueType:        QueueTypeStandbyTimer,
+			taskType:         persistence.TaskTypeDeleteHistoryEvent,
+			expectedSubclass: commonconstants.LowPrioritySubclass,
+		},
+		{
+			queueType:        QueueTypeReplication,
+			taskType:         persistence.ReplicationTaskTypeHistory,
+			expectedSubclass: commonconstants.DefaultPrioritySubclass,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.expectedSubclass, getTaskPrioritySubclass(tc.queueType, tc.taskType))
+	}
+}
+
 func (s *taskPriorityAssignerSuite) TestGetTaskPriority() {
 	testCases := []struct {
 		class            int
@@ -290,5 +392,7 @@ func (s *taskPriorityAssignerSuite) TestGetTaskPriority() {
 
 	for _, tc := range testCases {
 		s.Equal(tc.expectedPriority, commonconstants.GetTaskPriority(tc.class, tc.subClass))
+		s.Equal(tc.class, commonconstants.GetTaskPriorityClass(tc.expectedPriority))
+		s.Equal(tc.subClass, commonconstants.GetTaskPrioritySubclass(tc.expectedPriority))
 	}
 }
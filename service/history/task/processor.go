@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -122,7 +123,7 @@ func NewProcessor(
 				logger.Error("failed to get domain name from cache", tag.Error(err))
 				domainName = ""
 			}
-			if !config.EnableTaskListAwareTaskSchedulerByDomain(domainName) || t.Priority() != highTaskPriority {
+			if !config.EnableTaskListAwareTaskSchedulerByDomain(domainName) || constants.GetTaskPriorityClass(t.Priority()) != constants.HighPriorityClass {
 				return []task.WeightedKey[any]{
 					{
 						Key:    key,
@@ -252,6 +253,10 @@ func getDomainPriorityWeight(
 		}
 	}
 	weight, ok := weights[k.Priority]
+	if !ok {
+		// weights configured before task priority subclasses were introduced only have the default subclass
+		weight, ok = weights[constants.GetTaskPriority(constants.GetTaskPriorityClass(k.Priority), constants.DefaultPrioritySubclass)]
+	}
 	if !ok {
 		logger.Error("weights not found for task priority, default to 1", tag.Dynamic("priority", k.Priority), tag.Dynamic("weights", weights))
 		weight = 1
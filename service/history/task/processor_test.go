@@ -210,3 +210,27 @@ func TestGetDomainPriorityWeight(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDomainPriorityWeight_FallbackToDefaultSubclass(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockDomainCache := cache.NewMockDomainCache(mockCtrl)
+	mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain-name", nil).Times(2)
+	client := dynamicconfig.NewInMemoryClient()
+	require.NoError(t, client.UpdateValue(dynamicproperties.TaskSchedulerDomainRoundRobinWeights, map[string]interface{}{"1": 10, "2": 3}))
+	config := config.New(
+		dynamicconfig.NewCollection(
+			client,
+			testlogger.New(t),
+		),
+		1024,
+		1024,
+		false,
+		"hostname",
+	)
+
+	// high priority class with high subclass is not configured, so it uses the weight of the default subclass
+	weight := getDomainPriorityWeight(testlogger.New(t), config, mockDomainCache, DomainPriorityKey{DomainID: "test-domain-id", Priority: 0})
+	require.Equal(t, 10, weight)
+	weight = getDomainPriorityWeight(testlogger.New(t), config, mockDomainCache, DomainPriorityKey{DomainID: "test-domain-id", Priority: 2})
+	require.Equal(t, 3, weight)
+}
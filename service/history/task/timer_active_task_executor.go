@@ -703,10 +703,22 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		}
 		return nil
 	}
+	if activityInfo.Paused {
+		// a paused activity is dispatched again when it is unpaused
+		return nil
+	}
 	ok, err = verifyTaskVersion(t.shard, t.logger, task.DomainID, activityInfo.Version, task.Version, task)
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the activity is dispatched again when the workflow is resumed
+		if activityInfo.DispatchSuppressed {
+			return nil
+		}
+		mutableState.SuppressActivityDispatch(activityInfo)
+		return t.updateWorkflowExecution(ctx, wfContext, mutableState, false)
+	}
 
 	domainID := task.DomainID
 	targetDomainID := domainID
@@ -206,6 +206,19 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the activity is dispatched again when the workflow is resumed
+		if ai.DispatchSuppressed {
+			return nil
+		}
+		return updateWorkflowExecution(ctx, t.logger, wfContext, false,
+			func(ctx context.Context, mutableState execution.MutableState) error {
+				mutableState.SuppressActivityDispatch(ai)
+				return nil
+			},
+			t.shard.GetTimeSource().Now(),
+		)
+	}
 
 	timeout := min(ai.ScheduleToStartTimeout, constants.MaxTaskTimeout)
 
@@ -270,6 +283,19 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	if err != nil || !ok {
 		return err
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// the decision is dispatched again when the workflow is resumed
+		if mutableState.GetExecutionInfo().DecisionDispatchSuppressed {
+			return nil
+		}
+		return updateWorkflowExecution(ctx, t.logger, wfContext, false,
+			func(ctx context.Context, mutableState execution.MutableState) error {
+				mutableState.SuppressDecisionDispatch()
+				return nil
+			},
+			t.shard.GetTimeSource().Now(),
+		)
+	}
 
 	domainName := mutableState.GetDomainEntry().GetInfo().Name
 	executionInfo := mutableState.GetExecutionInfo()
@@ -33,6 +33,12 @@ var (
 	ErrMaxAttemptsExceeded = errors.New("maximum attempts exceeded to update history")
 	// ErrActivityTaskNotFound is the error to indicate activity task could be duplicate and activity already completed
 	ErrActivityTaskNotFound = &types.EntityNotExistsError{Message: "activity task not found"}
+	// ErrActivityTaskPaused is the error to indicate activity is paused and its task should be dropped
+	ErrActivityTaskPaused = &types.EntityNotExistsError{Message: "activity task is paused"}
+	// ErrWorkflowExecutionPaused is the error to indicate workflow is paused and its decision or activity task should be dropped
+	ErrWorkflowExecutionPaused = &types.EntityNotExistsError{Message: "workflow execution is paused"}
+	// ErrWorkflowNotPaused is the error to indicate workflow can't be resumed since it is not paused
+	ErrWorkflowNotPaused = &types.BadRequestError{Message: "workflow execution is not paused"}
 	// ErrNotExists is the error to indicate workflow doesn't exist
 	ErrNotExists = &types.EntityNotExistsError{Message: "workflow execution already completed"}
 	// ErrAlreadyCompleted is the error to indicate workflow execution already completed
@@ -60,6 +60,11 @@ func (g GRPCHandler) GetDLQReplicationMessages(ctx context.Context, request *his
 	return proto.FromHistoryGetDLQReplicationMessagesResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) GetDomainReplicationStatus(ctx context.Context, request *historyv1.GetDomainReplicationStatusRequest) (*historyv1.GetDomainReplicationStatusResponse, error) {
+	response, err := g.h.GetDomainReplicationStatus(ctx, proto.ToHistoryGetDomainReplicationStatusRequest(request))
+	return proto.FromHistoryGetDomainReplicationStatusResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) GetFailoverInfo(ctx context.Context, request *historyv1.GetFailoverInfoRequest) (*historyv1.GetFailoverInfoResponse, error) {
 	response, err := g.h.GetFailoverInfo(ctx, proto.ToHistoryGetFailoverInfoRequest(request))
 	return proto.FromHistoryGetFailoverInfoResponse(response), proto.FromError(err)
@@ -85,6 +90,16 @@ func (g GRPCHandler) NotifyFailoverMarkers(ctx context.Context, request *history
 	return &historyv1.NotifyFailoverMarkersResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) PauseActivity(ctx context.Context, request *historyv1.PauseActivityRequest) (*historyv1.PauseActivityResponse, error) {
+	response, err := g.h.PauseActivity(ctx, proto.ToHistoryPauseActivityRequest(request))
+	return proto.FromHistoryPauseActivityResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) PauseWorkflowExecution(ctx context.Context, request *historyv1.PauseWorkflowExecutionRequest) (*historyv1.PauseWorkflowExecutionResponse, error) {
+	response, err := g.h.PauseWorkflowExecution(ctx, proto.ToHistoryPauseWorkflowExecutionRequest(request))
+	return proto.FromHistoryPauseWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) PollMutableState(ctx context.Context, request *historyv1.PollMutableStateRequest) (*historyv1.PollMutableStateResponse, error) {
 	response, err := g.h.PollMutableState(ctx, proto.ToHistoryPollMutableStateRequest(request))
 	return proto.FromHistoryPollMutableStateResponse(response), proto.FromError(err)
@@ -160,6 +175,11 @@ func (g GRPCHandler) RequestCancelWorkflowExecution(ctx context.Context, request
 	return &historyv1.RequestCancelWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) ResetActivity(ctx context.Context, request *historyv1.ResetActivityRequest) (*historyv1.ResetActivityResponse, error) {
+	response, err := g.h.ResetActivity(ctx, proto.ToHistoryResetActivityRequest(request))
+	return proto.FromHistoryResetActivityResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) ResetQueue(ctx context.Context, request *historyv1.ResetQueueRequest) (*historyv1.ResetQueueResponse, error) {
 	err := g.h.ResetQueue(ctx, proto.ToHistoryResetQueueRequest(request))
 	return &historyv1.ResetQueueResponse{}, proto.FromError(err)
@@ -205,6 +225,11 @@ func (g GRPCHandler) RespondDecisionTaskFailed(ctx context.Context, request *his
 	return &historyv1.RespondDecisionTaskFailedResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) ResumeWorkflowExecution(ctx context.Context, request *historyv1.ResumeWorkflowExecutionRequest) (*historyv1.ResumeWorkflowExecutionResponse, error) {
+	response, err := g.h.ResumeWorkflowExecution(ctx, proto.ToHistoryResumeWorkflowExecutionRequest(request))
+	return proto.FromHistoryResumeWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) ScheduleDecisionTask(ctx context.Context, request *historyv1.ScheduleDecisionTaskRequest) (*historyv1.ScheduleDecisionTaskResponse, error) {
 	err := g.h.ScheduleDecisionTask(ctx, proto.ToHistoryScheduleDecisionTaskRequest(request))
 	return &historyv1.ScheduleDecisionTaskResponse{}, proto.FromError(err)
@@ -239,3 +264,18 @@ func (g GRPCHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g GRPCHandler) UnpauseActivity(ctx context.Context, request *historyv1.UnpauseActivityRequest) (*historyv1.UnpauseActivityResponse, error) {
+	response, err := g.h.UnpauseActivity(ctx, proto.ToHistoryUnpauseActivityRequest(request))
+	return proto.FromHistoryUnpauseActivityResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) UpdateActivityOptions(ctx context.Context, request *historyv1.UpdateActivityOptionsRequest) (*historyv1.UpdateActivityOptionsResponse, error) {
+	response, err := g.h.UpdateActivityOptions(ctx, proto.ToHistoryUpdateActivityOptionsRequest(request))
+	return proto.FromHistoryUpdateActivityOptionsResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) UpdateDomainIsolation(ctx context.Context, request *historyv1.UpdateDomainIsolationRequest) (*historyv1.UpdateDomainIsolationResponse, error) {
+	response, err := g.h.UpdateDomainIsolation(ctx, proto.ToHistoryUpdateDomainIsolationRequest(request))
+	return proto.FromHistoryUpdateDomainIsolationResponse(response), proto.FromError(err)
+}
@@ -97,6 +97,10 @@ func (h *historyHandler) GetDLQReplicationMessages(ctx context.Context, gp1 *typ
 	return h.wrapped.GetDLQReplicationMessages(ctx, gp1)
 }
 
+func (h *historyHandler) GetDomainReplicationStatus(ctx context.Context, hp1 *types.HistoryGetDomainReplicationStatusRequest) (hp2 *types.HistoryGetDomainReplicationStatusResponse, err error) {
+	return h.wrapped.GetDomainReplicationStatus(ctx, hp1)
+}
+
 func (h *historyHandler) GetFailoverInfo(ctx context.Context, gp1 *types.GetFailoverInfoRequest) (gp2 *types.GetFailoverInfoResponse, err error) {
 	return h.wrapped.GetFailoverInfo(ctx, gp1)
 }
@@ -121,6 +125,60 @@ func (h *historyHandler) NotifyFailoverMarkers(ctx context.Context, np1 *types.N
 	return h.wrapped.NotifyFailoverMarkers(ctx, np1)
 }
 
+func (h *historyHandler) PauseActivity(ctx context.Context, hp1 *types.HistoryPauseActivityRequest) (pp1 *types.PauseActivityResponse, err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.Request.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.Request.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.PauseActivity(ctx, hp1)
+}
+
+func (h *historyHandler) PauseWorkflowExecution(ctx context.Context, hp1 *types.HistoryPauseWorkflowExecutionRequest) (pp1 *types.PauseWorkflowExecutionResponse, err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.Request.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.Request.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.PauseWorkflowExecution(ctx, hp1)
+}
+
 func (h *historyHandler) PollMutableState(ctx context.Context, pp1 *types.PollMutableStateRequest) (pp2 *types.PollMutableStateResponse, err error) {
 	return h.wrapped.PollMutableState(ctx, pp1)
 }
@@ -185,6 +243,33 @@ func (h *historyHandler) RequestCancelWorkflowExecution(ctx context.Context, hp1
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, hp1)
 }
 
+func (h *historyHandler) ResetActivity(ctx context.Context, hp1 *types.HistoryResetActivityRequest) (rp1 *types.ResetActivityResponse, err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.Request.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.Request.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.ResetActivity(ctx, hp1)
+}
+
 func (h *historyHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequest) (err error) {
 	return h.wrapped.ResetQueue(ctx, rp1)
 }
@@ -221,6 +306,33 @@ func (h *historyHandler) RespondDecisionTaskFailed(ctx context.Context, hp1 *typ
 	return h.wrapped.RespondDecisionTaskFailed(ctx, hp1)
 }
 
+func (h *historyHandler) ResumeWorkflowExecution(ctx context.Context, hp1 *types.HistoryResumeWorkflowExecutionRequest) (rp1 *types.ResumeWorkflowExecutionResponse, err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.Request.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.Request.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.ResumeWorkflowExecution(ctx, hp1)
+}
+
 func (h *historyHandler) ScheduleDecisionTask(ctx context.Context, sp1 *types.ScheduleDecisionTaskRequest) (err error) {
 	return h.wrapped.ScheduleDecisionTask(ctx, sp1)
 }
@@ -327,3 +439,61 @@ func (h *historyHandler) SyncShardStatus(ctx context.Context, sp1 *types.SyncSha
 func (h *historyHandler) TerminateWorkflowExecution(ctx context.Context, hp1 *types.HistoryTerminateWorkflowExecutionRequest) (err error) {
 	return h.wrapped.TerminateWorkflowExecution(ctx, hp1)
 }
+
+func (h *historyHandler) UnpauseActivity(ctx context.Context, hp1 *types.HistoryUnpauseActivityRequest) (up1 *types.UnpauseActivityResponse, err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.Request.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.Request.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.UnpauseActivity(ctx, hp1)
+}
+
+func (h *historyHandler) UpdateActivityOptions(ctx context.Context, hp1 *types.HistoryUpdateActivityOptionsRequest) (up1 *types.UpdateActivityOptionsResponse, err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.Request.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.Request.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.UpdateActivityOptions(ctx, hp1)
+}
+
+func (h *historyHandler) UpdateDomainIsolation(ctx context.Context, hp1 *types.HistoryUpdateDomainIsolationRequest) (hp2 *types.HistoryUpdateDomainIsolationResponse, err error) {
+	return h.wrapped.UpdateDomainIsolation(ctx, hp1)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/client"
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
+)
+
+// stores holds the managers of one persistence store
+type stores struct {
+	factory        client.Factory
+	shardManager   persistence.ShardManager
+	historyManager persistence.HistoryManager
+	taskManager    persistence.TaskManager
+	domainManager  persistence.DomainManager
+}
+
+func newStores(factory client.Factory) (*stores, error) {
+	s := &stores{factory: factory}
+	var err error
+	if s.shardManager, err = factory.NewShardManager(); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	if s.historyManager, err = factory.NewHistoryManager(); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	if s.taskManager, err = factory.NewTaskManager(); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	if s.domainManager, err = factory.NewDomainManager(); err != nil {
+		factory.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *stores) close() {
+	s.shardManager.Close()
+	s.historyManager.Close()
+	s.taskManager.Close()
+	s.domainManager.Close()
+	s.factory.Close()
+}
+
+// GetShardIDsActivity returns the IDs of all the shards of the cluster
+func GetShardIDsActivity(ctx context.Context) ([]int, error) {
+	migrator, err := getMigrator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	shardIDs := make([]int, migrator.cfg.PersistenceConfig.NumHistoryShards)
+	for i := range shardIDs {
+		shardIDs[i] = i
+	}
+	return shardIDs, nil
+}
+
+// BackfillDomainsActivity copies the domains missing or stale in the target store
+func BackfillDomainsActivity(ctx context.Context, params *BackfillStoreParams) (*DomainsResult, error) {
+	b, err := newActivityBackfiller(ctx, params.Reverse)
+	if err != nil {
+		return nil, err
+	}
+	return b.backfillDomains(ctx)
+}
+
+// BackfillShardActivity copies the shard record, the executions with their history and the history tasks of a shard
+func BackfillShardActivity(ctx context.Context, params *BackfillShardParams) (*ShardResult, error) {
+	b, err := newActivityBackfiller(ctx, params.Reverse)
+	if err != nil {
+		return nil, err
+	}
+	b.logger = b.logger.WithTags(tag.ShardID(params.ShardID))
+
+	// resume from the last listed page when the activity is retried
+	var progress shardProgress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			b.logger.Warn("Failed to load persistence migration shard progress, starting over", tag.Error(err))
+			progress = shardProgress{}
+		}
+	}
+	return b.backfillShard(ctx, params.ShardID, &progress)
+}
+
+// BackfillTaskListsActivity copies the task lists and their pending tasks
+func BackfillTaskListsActivity(ctx context.Context, params *BackfillStoreParams) (*TaskListsResult, error) {
+	b, err := newActivityBackfiller(ctx, params.Reverse)
+	if err != nil {
+		return nil, err
+	}
+	return b.backfillTaskLists(ctx)
+}
+
+func getMigrator(ctx context.Context) (*Migrator, error) {
+	migrator, ok := ctx.Value(migratorContextKey).(*Migrator)
+	if !ok {
+		return nil, cadence.NewCustomError(errMsgMigrationUnavailable)
+	}
+	return migrator, nil
+}
+
+func newActivityBackfiller(ctx context.Context, reverse bool) (*backfiller, error) {
+	migrator, err := getMigrator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	source, target := migrator.getStores(reverse)
+	var markers *migration.DivergenceMarkers
+	if !reverse {
+		markers = migration.NewDivergenceMarkers(migrator.configStore)
+	}
+	return &backfiller{
+		source:  source,
+		target:  target,
+		markers: markers,
+		logger:  migrator.logger,
+		heartbeat: func(details interface{}) {
+			activity.RecordHeartbeat(ctx, details)
+		},
+	}, nil
+}
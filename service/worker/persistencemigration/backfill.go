@@ -0,0 +1,839 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	listPageSize    = 100
+	historyPageSize = 100
+	taskPageSize    = 1000
+
+	// maxExecutionWrites is the number of times an execution is written to the target store before giving up
+	// when the source one keeps changing
+	maxExecutionWrites = 3
+)
+
+const (
+	executionSkipped executionCopyResult = iota
+	executionCopied
+	executionUpdated
+)
+
+var historyTaskCategories = []persistence.HistoryTaskCategory{
+	persistence.HistoryTaskCategoryTransfer,
+	persistence.HistoryTaskCategoryTimer,
+	persistence.HistoryTaskCategoryReplication,
+}
+
+type (
+	// backfiller copies the records of the source store which are missing in the target store.
+	// Records written to both stores by the migration wrappers are left as they are, except for the executions
+	// which are compared with the source ones as a write to the target store may have failed.
+	backfiller struct {
+		source *stores
+		target *stores
+		// markers are the divergence markers of the shards, they are only cleared when copying to the migration store
+		markers   *migration.DivergenceMarkers
+		logger    log.Logger
+		heartbeat func(details interface{})
+	}
+
+	// shardProgress is the heartbeat details of the shard backfill
+	shardProgress struct {
+		StartedAt time.Time
+		PageToken []byte
+		Result    ShardResult
+	}
+
+	// executionCopyResult tells whether an execution was written to the target store
+	executionCopyResult int
+)
+
+var errShardDiverged = errors.New("a write to the migration store of the shard failed during the backfill")
+
+var thriftEncoder = codec.NewThriftRWEncoder()
+
+func (b *backfiller) backfillDomains(ctx context.Context) (*DomainsResult, error) {
+	result := &DomainsResult{}
+	var lastDomain *persistence.GetDomainResponse
+	var pageToken []byte
+	for {
+		resp, err := b.source.domainManager.ListDomains(ctx, &persistence.ListDomainsRequest{
+			PageSize:      listPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range resp.Domains {
+			if err := b.copyDomain(ctx, domain, result); err != nil {
+				return nil, err
+			}
+			lastDomain = domain
+		}
+		b.heartbeat(result)
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			break
+		}
+	}
+	if lastDomain == nil {
+		return result, nil
+	}
+	return result, b.alignDomainNotificationVersion(ctx, lastDomain.Info.ID)
+}
+
+func (b *backfiller) copyDomain(ctx context.Context, domain *persistence.GetDomainResponse, result *DomainsResult) error {
+	existing, err := b.target.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{ID: domain.Info.ID})
+	if isNotExists(err) {
+		_, err = b.target.domainManager.CreateDomain(ctx, &persistence.CreateDomainRequest{
+			Info:              domain.Info,
+			Config:            domain.Config,
+			ReplicationConfig: domain.ReplicationConfig,
+			IsGlobalDomain:    domain.IsGlobalDomain,
+			ConfigVersion:     domain.ConfigVersion,
+			FailoverVersion:   domain.FailoverVersion,
+			LastUpdatedTime:   domain.LastUpdatedTime,
+			CurrentTimeStamp:  time.Now(),
+		})
+		if err == nil {
+			result.CopiedDomains++
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if existing.ConfigVersion == domain.ConfigVersion &&
+		existing.FailoverVersion == domain.FailoverVersion &&
+		existing.LastUpdatedTime >= domain.LastUpdatedTime {
+		return nil
+	}
+	if err := b.updateDomain(ctx, domain); err != nil {
+		return err
+	}
+	result.UpdatedDomains++
+	return nil
+}
+
+// alignDomainNotificationVersion bumps the domain notification version of the target store up to the
+// one of the source store, as it is compared with the notification version recorded in the shards
+func (b *backfiller) alignDomainNotificationVersion(ctx context.Context, domainID string) error {
+	sourceMetadata, err := b.source.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		targetMetadata, err := b.target.domainManager.GetMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		if targetMetadata.NotificationVersion >= sourceMetadata.NotificationVersion {
+			return nil
+		}
+		domain, err := b.target.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{ID: domainID})
+		if err != nil {
+			return err
+		}
+		if err := b.updateDomain(ctx, domain); err != nil {
+			return err
+		}
+	}
+}
+
+func (b *backfiller) updateDomain(ctx context.Context, domain *persistence.GetDomainResponse) error {
+	metadata, err := b.target.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	return b.target.domainManager.UpdateDomain(ctx, &persistence.UpdateDomainRequest{
+		Info:                        domain.Info,
+		Config:                      domain.Config,
+		ReplicationConfig:           domain.ReplicationConfig,
+		ConfigVersion:               domain.ConfigVersion,
+		FailoverVersion:             domain.FailoverVersion,
+		FailoverNotificationVersion: domain.FailoverNotificationVersion,
+		PreviousFailoverVersion:     domain.PreviousFailoverVersion,
+		FailoverEndTime:             domain.FailoverEndTime,
+		LastUpdatedTime:             domain.LastUpdatedTime,
+		NotificationVersion:         metadata.NotificationVersion,
+	})
+}
+
+// backfillShard copies the shard record, the executions and the history tasks of a shard. Once all the executions
+// were verified, the divergence marker of the shard is cleared unless a write to the migration store failed since
+// the backfill started.
+func (b *backfiller) backfillShard(ctx context.Context, shardID int, progress *shardProgress) (*ShardResult, error) {
+	if progress.StartedAt.IsZero() {
+		progress.StartedAt = time.Now()
+	}
+	rangeID, err := b.copyShardRecord(ctx, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceExecutionManager, err := b.source.factory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	defer sourceExecutionManager.Close()
+	targetExecutionManager, err := b.target.factory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	defer targetExecutionManager.Close()
+
+	copier := &executionCopier{
+		backfiller: b,
+		shardID:    shardID,
+		rangeID:    rangeID,
+		source:     sourceExecutionManager,
+		target:     targetExecutionManager,
+	}
+	for {
+		resp, err := sourceExecutionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   &shardID,
+			PageSize:  listPageSize,
+			PageToken: progress.PageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, entity := range resp.Executions {
+			result, err := copier.copyExecution(ctx, entity.ExecutionInfo)
+			switch {
+			case isShardOwnershipLost(err):
+				return nil, err
+			case err != nil:
+				progress.Result.FailedExecutions++
+				b.logger.Error("Failed to copy workflow execution",
+					tag.WorkflowDomainID(entity.ExecutionInfo.DomainID),
+					tag.WorkflowID(entity.ExecutionInfo.WorkflowID),
+					tag.WorkflowRunID(entity.ExecutionInfo.RunID),
+					tag.Error(err))
+			case result == executionCopied:
+				progress.Result.CopiedExecutions++
+			case result == executionUpdated:
+				progress.Result.UpdatedExecutions++
+			default:
+				progress.Result.SkippedExecutions++
+			}
+		}
+		progress.PageToken = resp.PageToken
+		b.heartbeat(progress)
+		if len(progress.PageToken) == 0 {
+			break
+		}
+	}
+
+	for _, category := range historyTaskCategories {
+		copied, err := copier.copyHistoryTasks(ctx, category)
+		if err != nil {
+			return nil, err
+		}
+		progress.Result.CopiedTasks += copied
+	}
+
+	// the executions which failed to be copied may still miss data
+	if b.markers != nil && progress.Result.FailedExecutions == 0 {
+		cleared, err := b.markers.Clear(ctx, shardID, progress.StartedAt)
+		if err != nil {
+			return nil, err
+		}
+		if !cleared {
+			// verify the executions again
+			*progress = shardProgress{}
+			b.heartbeat(progress)
+			return nil, errShardDiverged
+		}
+	}
+	return &progress.Result, nil
+}
+
+// copyShardRecord copies the shard record unless the target one is already up to date,
+// it returns the range ID the target store expects for the writes of the shard
+func (b *backfiller) copyShardRecord(ctx context.Context, shardID int) (int64, error) {
+	sourceShard, err := b.source.shardManager.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return 0, err
+	}
+	targetShard, err := b.target.shardManager.GetShard(ctx, &persistence.GetShardRequest{ShardID: shardID})
+	if isNotExists(err) {
+		err = b.target.shardManager.CreateShard(ctx, &persistence.CreateShardRequest{ShardInfo: sourceShard.ShardInfo})
+		return sourceShard.ShardInfo.RangeID, err
+	}
+	if err != nil {
+		return 0, err
+	}
+	if targetShard.ShardInfo.RangeID >= sourceShard.ShardInfo.RangeID {
+		return targetShard.ShardInfo.RangeID, nil
+	}
+	err = b.target.shardManager.UpdateShard(ctx, &persistence.UpdateShardRequest{
+		ShardInfo:       sourceShard.ShardInfo,
+		PreviousRangeID: targetShard.ShardInfo.RangeID,
+	})
+	return sourceShard.ShardInfo.RangeID, err
+}
+
+func (b *backfiller) backfillTaskLists(ctx context.Context) (*TaskListsResult, error) {
+	result := &TaskListsResult{}
+	var pageToken []byte
+	for {
+		resp, err := b.source.taskManager.ListTaskList(ctx, &persistence.ListTaskListRequest{
+			PageSize:  listPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.Items {
+			info := &resp.Items[i]
+			copied, err := b.copyTaskList(ctx, info)
+			if err != nil {
+				result.FailedTaskLists++
+				b.logger.Error("Failed to copy task list",
+					tag.WorkflowDomainID(info.DomainID),
+					tag.WorkflowTaskListName(info.Name),
+					tag.WorkflowTaskListType(info.TaskType),
+					tag.Error(err))
+				continue
+			}
+			result.CopiedTaskLists++
+			result.CopiedTasks += copied
+		}
+		b.heartbeat(result)
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			return result, nil
+		}
+	}
+}
+
+// copyTaskList leases the task list in the target store until its range ID catches up with the source one,
+// then copies the ack level and the tasks older than the ones already written to both stores
+func (b *backfiller) copyTaskList(ctx context.Context, info *persistence.TaskListInfo) (int, error) {
+	lease := &persistence.LeaseTaskListRequest{
+		DomainID:     info.DomainID,
+		TaskList:     info.Name,
+		TaskType:     info.TaskType,
+		TaskListKind: info.Kind,
+	}
+	var targetInfo *persistence.TaskListInfo
+	targetResp, err := b.target.taskManager.GetTaskList(ctx, &persistence.GetTaskListRequest{
+		DomainID: info.DomainID,
+		TaskList: info.Name,
+		TaskType: info.TaskType,
+	})
+	switch {
+	case err == nil:
+		targetInfo = targetResp.TaskListInfo
+	case !isNotExists(err):
+		return 0, err
+	}
+	for targetInfo == nil || targetInfo.RangeID < info.RangeID {
+		if targetInfo != nil {
+			lease.RangeID = targetInfo.RangeID
+		}
+		lease.CurrentTimeStamp = time.Now()
+		leaseResp, err := b.target.taskManager.LeaseTaskList(ctx, lease)
+		if err != nil {
+			return 0, err
+		}
+		targetInfo = leaseResp.TaskListInfo
+	}
+
+	if targetInfo.AckLevel < info.AckLevel {
+		updated := *info
+		updated.RangeID = targetInfo.RangeID
+		if _, err := b.target.taskManager.UpdateTaskList(ctx, &persistence.UpdateTaskListRequest{
+			TaskListInfo:     &updated,
+			CurrentTimeStamp: time.Now(),
+		}); err != nil {
+			return 0, err
+		}
+		targetInfo = &updated
+	}
+
+	// tasks written to both stores are not copied again
+	firstTargetTasks, err := b.target.taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
+		DomainID:  info.DomainID,
+		TaskList:  info.Name,
+		TaskType:  info.TaskType,
+		ReadLevel: info.AckLevel,
+		BatchSize: 1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var maxReadLevel *int64
+	if len(firstTargetTasks.Tasks) > 0 {
+		level := firstTargetTasks.Tasks[0].TaskID - 1
+		maxReadLevel = &level
+	}
+
+	copied := 0
+	readLevel := info.AckLevel
+	for {
+		resp, err := b.source.taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
+			DomainID:     info.DomainID,
+			TaskList:     info.Name,
+			TaskType:     info.TaskType,
+			ReadLevel:    readLevel,
+			MaxReadLevel: maxReadLevel,
+			BatchSize:    taskPageSize,
+		})
+		if err != nil {
+			return copied, err
+		}
+		if len(resp.Tasks) == 0 {
+			return copied, nil
+		}
+		tasks := make([]*persistence.CreateTaskInfo, 0, len(resp.Tasks))
+		for _, task := range resp.Tasks {
+			tasks = append(tasks, &persistence.CreateTaskInfo{Data: task, TaskID: task.TaskID})
+		}
+		if _, err := b.target.taskManager.CreateTasks(ctx, &persistence.CreateTasksRequest{
+			TaskListInfo:     targetInfo,
+			Tasks:            tasks,
+			CurrentTimeStamp: time.Now(),
+		}); err != nil {
+			return copied, err
+		}
+		copied += len(tasks)
+		readLevel = resp.Tasks[len(resp.Tasks)-1].TaskID
+	}
+}
+
+// executionCopier copies the executions of a shard
+type executionCopier struct {
+	*backfiller
+	shardID int
+	rangeID int64
+	source  persistence.ExecutionManager
+	target  persistence.ExecutionManager
+}
+
+// copyExecution copies an execution along with its history. An execution already in the target store is compared
+// with the source one and replaced when they differ, as a write of the migration wrappers to the target store may
+// have failed. The execution is read again after each write, in case the source one changed in the meantime.
+func (c *executionCopier) copyExecution(ctx context.Context, info *persistence.WorkflowExecutionInfo) (executionCopyResult, error) {
+	request := &persistence.GetWorkflowExecutionRequest{
+		ShardID:  &c.shardID,
+		DomainID: info.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: info.WorkflowID,
+			RunID:      info.RunID,
+		},
+	}
+	result := executionSkipped
+	for writes := 0; ; writes++ {
+		targetResp, err := c.target.GetWorkflowExecution(ctx, request)
+		if isNotExists(err) {
+			targetResp = nil
+		} else if err != nil {
+			return result, err
+		}
+		resp, err := c.source.GetWorkflowExecution(ctx, request)
+		if isNotExists(err) {
+			// deleted since it was listed
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+		if targetResp != nil && isSameExecution(resp.State.ExecutionInfo, targetResp.State.ExecutionInfo) {
+			return result, nil
+		}
+		if writes == maxExecutionWrites {
+			return result, errors.New("the workflow execution kept changing while it was copied")
+		}
+
+		if err := c.copyHistory(ctx, resp.State); err != nil {
+			return result, err
+		}
+		if targetResp == nil {
+			err = c.createExecution(ctx, resp.State)
+			if result == executionSkipped {
+				result = executionCopied
+			}
+		} else {
+			err = c.resetExecution(ctx, resp.State, targetResp.State.ExecutionInfo.NextEventID)
+			if result == executionSkipped {
+				result = executionUpdated
+			}
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+}
+
+// isSameExecution compares the fields changed by the writes of an execution, an open run of the source store
+// which is not the current one of the target store is a zombie there
+func isSameExecution(source *persistence.WorkflowExecutionInfo, target *persistence.WorkflowExecutionInfo) bool {
+	sameState := source.State == target.State ||
+		(target.State == persistence.WorkflowStateZombie && source.State != persistence.WorkflowStateCompleted)
+	// the stores keep timestamps with different precisions
+	return sameState &&
+		source.CloseStatus == target.CloseStatus &&
+		source.NextEventID == target.NextEventID &&
+		source.LastUpdatedTimestamp.Truncate(time.Millisecond).Equal(target.LastUpdatedTimestamp.Truncate(time.Millisecond))
+}
+
+// createExecution creates the execution in the target store, as closed executions can't be created directly
+// they are created open and then closed with an update carrying the final state
+func (c *executionCopier) createExecution(ctx context.Context, state *persistence.WorkflowMutableState) error {
+	info := state.ExecutionInfo
+	mode, previous, err := c.getCreateMode(ctx, info)
+	if err != nil {
+		return err
+	}
+
+	createInfo := *info
+	createInfo.CloseStatus = persistence.WorkflowCloseStatusNone
+	switch {
+	case mode == persistence.CreateWorkflowModeZombie:
+		createInfo.State = persistence.WorkflowStateZombie
+	case info.State == persistence.WorkflowStateCompleted:
+		createInfo.State = persistence.WorkflowStateRunning
+	}
+	request := &persistence.CreateWorkflowExecutionRequest{
+		ShardID:             &c.shardID,
+		RangeID:             c.rangeID,
+		Mode:                mode,
+		NewWorkflowSnapshot: newWorkflowSnapshot(state, &createInfo, info.NextEventID),
+	}
+	if previous != nil {
+		request.PreviousRunID = previous.RunID
+		request.PreviousLastWriteVersion = previous.LastWriteVersion
+	}
+	if _, err := c.target.CreateWorkflowExecution(ctx, request); err != nil {
+		return err
+	}
+
+	finalInfo := *info
+	updateMode := persistence.UpdateWorkflowModeUpdateCurrent
+	if mode == persistence.CreateWorkflowModeZombie {
+		updateMode = persistence.UpdateWorkflowModeBypassCurrent
+		if info.State != persistence.WorkflowStateCompleted {
+			// an open run which is not the current one stays a zombie
+			finalInfo.State = persistence.WorkflowStateZombie
+			finalInfo.CloseStatus = persistence.WorkflowCloseStatusNone
+		}
+	}
+	if finalInfo.State == createInfo.State && len(state.BufferedEvents) == 0 {
+		return nil
+	}
+	return c.updateExecution(ctx, state, &finalInfo, updateMode)
+}
+
+// resetExecution replaces an execution of the target store which differs from the source one,
+// provided the target one was not changed since it was read
+func (c *executionCopier) resetExecution(ctx context.Context, state *persistence.WorkflowMutableState, targetNextEventID int64) error {
+	info := *state.ExecutionInfo
+	mode := persistence.ConflictResolveWorkflowModeBypassCurrent
+	updateMode := persistence.UpdateWorkflowModeBypassCurrent
+	targetCurrent, err := c.target.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:    &c.shardID,
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+	})
+	switch {
+	case err != nil && !isNotExists(err):
+		return err
+	case err == nil && targetCurrent.RunID == info.RunID && info.State != persistence.WorkflowStateZombie:
+		mode = persistence.ConflictResolveWorkflowModeUpdateCurrent
+		updateMode = persistence.UpdateWorkflowModeUpdateCurrent
+	case info.State != persistence.WorkflowStateCompleted:
+		// an open run which is not the current one stays a zombie
+		info.State = persistence.WorkflowStateZombie
+		info.CloseStatus = persistence.WorkflowCloseStatusNone
+	}
+
+	if _, err := c.target.ConflictResolveWorkflowExecution(ctx, &persistence.ConflictResolveWorkflowExecutionRequest{
+		ShardID:               &c.shardID,
+		RangeID:               c.rangeID,
+		Mode:                  mode,
+		ResetWorkflowSnapshot: newWorkflowSnapshot(state, &info, targetNextEventID),
+	}); err != nil {
+		return err
+	}
+	// the buffered events are dropped by the reset
+	if len(state.BufferedEvents) == 0 {
+		return nil
+	}
+	return c.updateExecution(ctx, state, &info, updateMode)
+}
+
+// updateExecution writes the execution info and the buffered events of the execution to the target store
+func (c *executionCopier) updateExecution(
+	ctx context.Context,
+	state *persistence.WorkflowMutableState,
+	info *persistence.WorkflowExecutionInfo,
+	mode persistence.UpdateWorkflowMode,
+) error {
+	_, err := c.target.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+		ShardID: &c.shardID,
+		RangeID: c.rangeID,
+		Mode:    mode,
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo:     info,
+			ExecutionStats:    state.ExecutionStats,
+			VersionHistories:  state.VersionHistories,
+			NewBufferedEvents: state.BufferedEvents,
+			Condition:         state.ExecutionInfo.NextEventID,
+			Checksum:          state.Checksum,
+		},
+	})
+	return err
+}
+
+// newWorkflowSnapshot returns the snapshot of the execution written to the target store with the given execution info
+func newWorkflowSnapshot(state *persistence.WorkflowMutableState, info *persistence.WorkflowExecutionInfo, condition int64) persistence.WorkflowSnapshot {
+	return persistence.WorkflowSnapshot{
+		ExecutionInfo:       info,
+		ExecutionStats:      state.ExecutionStats,
+		VersionHistories:    state.VersionHistories,
+		ActivityInfos:       slices.Collect(maps.Values(state.ActivityInfos)),
+		TimerInfos:          slices.Collect(maps.Values(state.TimerInfos)),
+		ChildExecutionInfos: slices.Collect(maps.Values(state.ChildExecutionInfos)),
+		RequestCancelInfos:  slices.Collect(maps.Values(state.RequestCancelInfos)),
+		SignalInfos:         slices.Collect(maps.Values(state.SignalInfos)),
+		SignalRequestedIDs:  slices.Collect(maps.Keys(state.SignalRequestedIDs)),
+		Condition:           condition,
+		Checksum:            state.Checksum,
+	}
+}
+
+// getCreateMode returns how the execution is created in the target store: the current run of the workflow
+// takes over the current record unless the target store has a newer open run, other runs are zombies
+func (c *executionCopier) getCreateMode(
+	ctx context.Context,
+	info *persistence.WorkflowExecutionInfo,
+) (persistence.CreateWorkflowMode, *persistence.GetCurrentExecutionResponse, error) {
+	currentRequest := &persistence.GetCurrentExecutionRequest{
+		ShardID:    &c.shardID,
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+	}
+	if info.State == persistence.WorkflowStateZombie {
+		return persistence.CreateWorkflowModeZombie, nil, nil
+	}
+	sourceCurrent, err := c.source.GetCurrentExecution(ctx, currentRequest)
+	if isNotExists(err) || (err == nil && sourceCurrent.RunID != info.RunID) {
+		return persistence.CreateWorkflowModeZombie, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	targetCurrent, err := c.target.GetCurrentExecution(ctx, currentRequest)
+	switch {
+	case isNotExists(err):
+		return persistence.CreateWorkflowModeBrandNew, nil, nil
+	case err != nil:
+		return 0, nil, err
+	case targetCurrent.State == persistence.WorkflowStateCompleted:
+		return persistence.CreateWorkflowModeWorkflowIDReuse, targetCurrent, nil
+	default:
+		return persistence.CreateWorkflowModeZombie, nil, nil
+	}
+}
+
+// copyHistory copies the history branches of the execution, including the nodes of their ancestor branches
+func (c *executionCopier) copyHistory(ctx context.Context, state *persistence.WorkflowMutableState) error {
+	if state.VersionHistories == nil {
+		return c.copyHistoryBranch(ctx, state.ExecutionInfo, state.ExecutionInfo.BranchToken, state.ExecutionInfo.NextEventID)
+	}
+	for _, versionHistory := range state.VersionHistories.Histories {
+		lastItem, err := versionHistory.GetLastItem()
+		if err != nil {
+			return err
+		}
+		if err := c.copyHistoryBranch(ctx, state.ExecutionInfo, versionHistory.BranchToken, lastItem.EventID+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *executionCopier) copyHistoryBranch(
+	ctx context.Context,
+	info *persistence.WorkflowExecutionInfo,
+	branchToken []byte,
+	nextEventID int64,
+) error {
+	var branch workflow.HistoryBranch
+	if err := thriftEncoder.Decode(branchToken, &branch); err != nil {
+		return err
+	}
+	// the nodes are written to the branch owning them, reading a branch returns the nodes of its ancestors too
+	branchTokens, err := getOwnerBranchTokens(&branch)
+	if err != nil {
+		return err
+	}
+	newBranches := make(map[int]bool, len(branchTokens))
+
+	request := &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  1,
+		MaxEventID:  nextEventID,
+		PageSize:    historyPageSize,
+		ShardID:     &c.shardID,
+	}
+	for {
+		resp, err := c.backfiller.source.historyManager.ReadHistoryBranchByBatch(ctx, request)
+		if isNotExists(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, batch := range resp.History {
+			if len(batch.Events) == 0 {
+				continue
+			}
+			owner := getOwnerBranchIndex(&branch, batch.Events[0].ID)
+			_, err := c.backfiller.target.historyManager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+				IsNewBranch:   !newBranches[owner],
+				Info:          persistence.BuildHistoryGarbageCleanupInfo(info.DomainID, info.WorkflowID, info.RunID),
+				BranchToken:   branchTokens[owner],
+				Events:        batch.Events,
+				TransactionID: batch.Events[len(batch.Events)-1].TaskID,
+				ShardID:       &c.shardID,
+			})
+			// nodes shared with another run or written by a previous attempt are already there
+			if err != nil && !isConditionFailed(err) {
+				return err
+			}
+			newBranches[owner] = true
+		}
+		if request.NextPageToken = resp.NextPageToken; len(request.NextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// getOwnerBranchTokens returns a branch token for each ancestor of the branch followed by the branch itself
+func getOwnerBranchTokens(branch *workflow.HistoryBranch) ([][]byte, error) {
+	tokens := make([][]byte, 0, len(branch.Ancestors)+1)
+	for i := range branch.Ancestors {
+		token, err := thriftEncoder.Encode(&workflow.HistoryBranch{
+			TreeID:    branch.TreeID,
+			BranchID:  branch.Ancestors[i].BranchID,
+			Ancestors: branch.Ancestors[:i],
+		})
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	token, err := thriftEncoder.Encode(branch)
+	if err != nil {
+		return nil, err
+	}
+	return append(tokens, token), nil
+}
+
+// getOwnerBranchIndex returns the index of the token returned by getOwnerBranchTokens owning the node
+func getOwnerBranchIndex(branch *workflow.HistoryBranch, nodeID int64) int {
+	for i, ancestor := range branch.Ancestors {
+		if nodeID < ancestor.GetEndNodeID() {
+			return i
+		}
+	}
+	return len(branch.Ancestors)
+}
+
+// copyHistoryTasks copies the history tasks older than the first one written to both stores
+func (c *executionCopier) copyHistoryTasks(ctx context.Context, category persistence.HistoryTaskCategory) (int, error) {
+	maxTaskKey := persistence.MaximumHistoryTaskKey
+	targetTasks, err := c.target.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+		ShardID:             &c.shardID,
+		TaskCategory:        category,
+		InclusiveMinTaskKey: persistence.MinimumHistoryTaskKey,
+		ExclusiveMaxTaskKey: persistence.MaximumHistoryTaskKey,
+		PageSize:            1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(targetTasks.Tasks) > 0 {
+		maxTaskKey = targetTasks.Tasks[0].GetTaskKey()
+	}
+
+	copied := 0
+	request := &persistence.GetHistoryTasksRequest{
+		ShardID:             &c.shardID,
+		TaskCategory:        category,
+		InclusiveMinTaskKey: persistence.MinimumHistoryTaskKey,
+		ExclusiveMaxTaskKey: maxTaskKey,
+		PageSize:            taskPageSize,
+	}
+	for {
+		resp, err := c.source.GetHistoryTasks(ctx, request)
+		if err != nil {
+			return copied, err
+		}
+		if len(resp.Tasks) > 0 {
+			if err := c.target.CreateHistoryTasks(ctx, &persistence.CreateHistoryTasksRequest{
+				ShardID:          &c.shardID,
+				RangeID:          c.rangeID,
+				TasksByCategory:  map[persistence.HistoryTaskCategory][]persistence.Task{category: resp.Tasks},
+				CurrentTimeStamp: time.Now(),
+			}); err != nil {
+				return copied, err
+			}
+			copied += len(resp.Tasks)
+		}
+		if request.NextPageToken = resp.NextPageToken; len(request.NextPageToken) == 0 {
+			return copied, nil
+		}
+	}
+}
+
+func isNotExists(err error) bool {
+	var notExistsErr *types.EntityNotExistsError
+	return errors.As(err, &notExistsErr)
+}
+
+func isConditionFailed(err error) bool {
+	var conditionFailedErr *persistence.ConditionFailedError
+	return errors.As(err, &conditionFailedErr)
+}
+
+func isShardOwnershipLost(err error) bool {
+	var shardOwnershipLostErr *persistence.ShardOwnershipLostError
+	return errors.As(err, &shardOwnershipLostErr)
+}
@@ -0,0 +1,436 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/client"
+	"github.com/uber/cadence/common/types"
+)
+
+type testStores struct {
+	*stores
+	factory          *client.MockFactory
+	shardManager     *persistence.MockShardManager
+	historyManager   *persistence.MockHistoryManager
+	taskManager      *persistence.MockTaskManager
+	domainManager    *persistence.MockDomainManager
+	executionManager *persistence.MockExecutionManager
+}
+
+func newTestStores(ctrl *gomock.Controller) *testStores {
+	s := &testStores{
+		factory:          client.NewMockFactory(ctrl),
+		shardManager:     persistence.NewMockShardManager(ctrl),
+		historyManager:   persistence.NewMockHistoryManager(ctrl),
+		taskManager:      persistence.NewMockTaskManager(ctrl),
+		domainManager:    persistence.NewMockDomainManager(ctrl),
+		executionManager: persistence.NewMockExecutionManager(ctrl),
+	}
+	s.stores = &stores{
+		factory:        s.factory,
+		shardManager:   s.shardManager,
+		historyManager: s.historyManager,
+		taskManager:    s.taskManager,
+		domainManager:  s.domainManager,
+	}
+	return s
+}
+
+func newTestBackfiller(t *testing.T) (*backfiller, *testStores, *testStores) {
+	ctrl := gomock.NewController(t)
+	source := newTestStores(ctrl)
+	target := newTestStores(ctrl)
+	return &backfiller{
+		source:    source.stores,
+		target:    target.stores,
+		logger:    log.NewNoop(),
+		heartbeat: func(interface{}) {},
+	}, source, target
+}
+
+func TestCopyShardRecord(t *testing.T) {
+	sourceShard := &persistence.ShardInfo{ShardID: 1, RangeID: 10, TransferAckLevel: 100}
+	tests := []struct {
+		name         string
+		targetShard  *persistence.ShardInfo
+		targetErr    error
+		prepareMocks func(target *testStores)
+		wantRangeID  int64
+	}{
+		{
+			name:      "missing",
+			targetErr: &types.EntityNotExistsError{},
+			prepareMocks: func(target *testStores) {
+				target.shardManager.EXPECT().CreateShard(gomock.Any(), &persistence.CreateShardRequest{ShardInfo: sourceShard}).Return(nil)
+			},
+			wantRangeID: 10,
+		},
+		{
+			name:        "stale",
+			targetShard: &persistence.ShardInfo{ShardID: 1, RangeID: 8},
+			prepareMocks: func(target *testStores) {
+				target.shardManager.EXPECT().UpdateShard(gomock.Any(), &persistence.UpdateShardRequest{ShardInfo: sourceShard, PreviousRangeID: 8}).Return(nil)
+			},
+			wantRangeID: 10,
+		},
+		{
+			name:         "up to date",
+			targetShard:  &persistence.ShardInfo{ShardID: 1, RangeID: 11},
+			prepareMocks: func(target *testStores) {},
+			wantRangeID:  11,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, source, target := newTestBackfiller(t)
+			source.shardManager.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).
+				Return(&persistence.GetShardResponse{ShardInfo: sourceShard}, nil)
+			var targetResp *persistence.GetShardResponse
+			if tc.targetShard != nil {
+				targetResp = &persistence.GetShardResponse{ShardInfo: tc.targetShard}
+			}
+			target.shardManager.EXPECT().GetShard(gomock.Any(), &persistence.GetShardRequest{ShardID: 1}).Return(targetResp, tc.targetErr)
+			tc.prepareMocks(target)
+
+			rangeID, err := b.copyShardRecord(context.Background(), 1)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRangeID, rangeID)
+		})
+	}
+}
+
+func TestCopyExecution(t *testing.T) {
+	branchToken, err := persistence.NewHistoryBranchTokenByBranchID("tree", "branch")
+	require.NoError(t, err)
+	info := &persistence.WorkflowExecutionInfo{
+		DomainID:    "domain",
+		WorkflowID:  "workflow",
+		RunID:       "run",
+		State:       persistence.WorkflowStateCompleted,
+		CloseStatus: persistence.WorkflowCloseStatusCompleted,
+		NextEventID: 4,
+		BranchToken: branchToken,
+	}
+	versionHistories := persistence.NewVersionHistories(persistence.NewVersionHistory(branchToken, []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(3, 1),
+	}))
+	state := &persistence.WorkflowMutableState{
+		ExecutionInfo:    info,
+		ExecutionStats:   &persistence.ExecutionStats{},
+		VersionHistories: versionHistories,
+	}
+	events := []*types.HistoryEvent{
+		{ID: 1, Version: 1, TaskID: 11},
+		{ID: 2, Version: 1, TaskID: 11},
+		{ID: 3, Version: 1, TaskID: 12},
+	}
+
+	t.Run("completed current run", func(t *testing.T) {
+		b, source, target := newTestBackfiller(t)
+		copier := &executionCopier{backfiller: b, shardID: 1, rangeID: 10, source: source.executionManager, target: target.executionManager}
+
+		gomock.InOrder(
+			target.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}),
+			// the copy is verified
+			target.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+				Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil),
+		)
+		source.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil).Times(2)
+		source.historyManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+			BranchToken: branchToken,
+			MinEventID:  1,
+			MaxEventID:  4,
+			PageSize:    historyPageSize,
+			ShardID:     common.IntPtr(1),
+		}).Return(&persistence.ReadHistoryBranchByBatchResponse{
+			History: []*types.History{{Events: events[:2]}, {Events: events[2:]}},
+		}, nil)
+		gomock.InOrder(
+			target.historyManager.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+					assert.True(t, request.IsNewBranch)
+					assert.Equal(t, int64(11), request.TransactionID)
+					assert.Equal(t, events[:2], request.Events)
+					return &persistence.AppendHistoryNodesResponse{}, nil
+				}),
+			target.historyManager.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *persistence.AppendHistoryNodesRequest) (*persistence.AppendHistoryNodesResponse, error) {
+					assert.False(t, request.IsNewBranch)
+					assert.Equal(t, int64(12), request.TransactionID)
+					return nil, &persistence.ConditionFailedError{}
+				}),
+		)
+		source.executionManager.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.GetCurrentExecutionResponse{RunID: "run"}, nil)
+		target.executionManager.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+		target.executionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+				assert.Equal(t, persistence.CreateWorkflowModeBrandNew, request.Mode)
+				assert.Equal(t, int64(10), request.RangeID)
+				assert.Equal(t, persistence.WorkflowStateRunning, request.NewWorkflowSnapshot.ExecutionInfo.State)
+				assert.Equal(t, persistence.WorkflowCloseStatusNone, request.NewWorkflowSnapshot.ExecutionInfo.CloseStatus)
+				assert.Equal(t, int64(4), request.NewWorkflowSnapshot.Condition)
+				return &persistence.CreateWorkflowExecutionResponse{}, nil
+			})
+		target.executionManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+				assert.Equal(t, persistence.UpdateWorkflowModeUpdateCurrent, request.Mode)
+				assert.Equal(t, info, request.UpdateWorkflowMutation.ExecutionInfo)
+				assert.Equal(t, int64(4), request.UpdateWorkflowMutation.Condition)
+				return &persistence.UpdateWorkflowExecutionResponse{}, nil
+			})
+
+		result, err := copier.copyExecution(context.Background(), info)
+		require.NoError(t, err)
+		assert.Equal(t, executionCopied, result)
+	})
+
+	t.Run("already copied", func(t *testing.T) {
+		b, source, target := newTestBackfiller(t)
+		copier := &executionCopier{backfiller: b, shardID: 1, rangeID: 10, source: source.executionManager, target: target.executionManager}
+		target.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil)
+		source.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil)
+
+		result, err := copier.copyExecution(context.Background(), info)
+		require.NoError(t, err)
+		assert.Equal(t, executionSkipped, result)
+	})
+
+	t.Run("stale copy", func(t *testing.T) {
+		b, source, target := newTestBackfiller(t)
+		copier := &executionCopier{backfiller: b, shardID: 1, rangeID: 10, source: source.executionManager, target: target.executionManager}
+		staleInfo := *info
+		staleInfo.State = persistence.WorkflowStateRunning
+		staleInfo.CloseStatus = persistence.WorkflowCloseStatusNone
+		staleInfo.NextEventID = 3
+		gomock.InOrder(
+			target.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+				Return(&persistence.GetWorkflowExecutionResponse{State: &persistence.WorkflowMutableState{ExecutionInfo: &staleInfo}}, nil),
+			target.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+				Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil),
+		)
+		source.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil).Times(2)
+		source.historyManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchByBatchResponse{
+			History: []*types.History{{Events: events}},
+		}, nil)
+		target.historyManager.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).Return(nil, &persistence.ConditionFailedError{})
+		target.executionManager.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.GetCurrentExecutionResponse{RunID: "run"}, nil)
+		target.executionManager.EXPECT().ConflictResolveWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.ConflictResolveWorkflowExecutionRequest) (*persistence.ConflictResolveWorkflowExecutionResponse, error) {
+				assert.Equal(t, persistence.ConflictResolveWorkflowModeUpdateCurrent, request.Mode)
+				assert.Equal(t, info, request.ResetWorkflowSnapshot.ExecutionInfo)
+				// conditioned on the stale copy
+				assert.Equal(t, int64(3), request.ResetWorkflowSnapshot.Condition)
+				return &persistence.ConflictResolveWorkflowExecutionResponse{}, nil
+			})
+
+		result, err := copier.copyExecution(context.Background(), info)
+		require.NoError(t, err)
+		assert.Equal(t, executionUpdated, result)
+	})
+
+	t.Run("source keeps changing", func(t *testing.T) {
+		b, source, target := newTestBackfiller(t)
+		copier := &executionCopier{backfiller: b, shardID: 1, rangeID: 10, source: source.executionManager, target: target.executionManager}
+		staleInfo := *info
+		staleInfo.NextEventID = 3
+		target.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.GetWorkflowExecutionResponse{State: &persistence.WorkflowMutableState{ExecutionInfo: &staleInfo}}, nil).
+			Times(maxExecutionWrites + 1)
+		source.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.GetWorkflowExecutionResponse{State: state}, nil).Times(maxExecutionWrites + 1)
+		source.historyManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).
+			Return(&persistence.ReadHistoryBranchByBatchResponse{}, nil).Times(maxExecutionWrites)
+		target.executionManager.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.GetCurrentExecutionResponse{RunID: "run"}, nil).Times(maxExecutionWrites)
+		target.executionManager.EXPECT().ConflictResolveWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&persistence.ConflictResolveWorkflowExecutionResponse{}, nil).Times(maxExecutionWrites)
+
+		result, err := copier.copyExecution(context.Background(), info)
+		assert.Error(t, err)
+		assert.Equal(t, executionUpdated, result)
+	})
+}
+
+func TestOwnerBranches(t *testing.T) {
+	branch := &workflow.HistoryBranch{
+		TreeID:   common.StringPtr("tree"),
+		BranchID: common.StringPtr("branch"),
+		Ancestors: []*workflow.HistoryBranchRange{
+			{BranchID: common.StringPtr("a1"), BeginNodeID: common.Int64Ptr(1), EndNodeID: common.Int64Ptr(5)},
+			{BranchID: common.StringPtr("a2"), BeginNodeID: common.Int64Ptr(5), EndNodeID: common.Int64Ptr(9)},
+		},
+	}
+	assert.Equal(t, 0, getOwnerBranchIndex(branch, 1))
+	assert.Equal(t, 0, getOwnerBranchIndex(branch, 4))
+	assert.Equal(t, 1, getOwnerBranchIndex(branch, 5))
+	assert.Equal(t, 2, getOwnerBranchIndex(branch, 9))
+
+	tokens, err := getOwnerBranchTokens(branch)
+	require.NoError(t, err)
+	require.Len(t, tokens, 3)
+	var second workflow.HistoryBranch
+	require.NoError(t, thriftEncoder.Decode(tokens[1], &second))
+	assert.Equal(t, "a2", second.GetBranchID())
+	assert.Equal(t, branch.Ancestors[:1], second.Ancestors)
+}
+
+func TestCopyHistoryTasks(t *testing.T) {
+	b, source, target := newTestBackfiller(t)
+	copier := &executionCopier{backfiller: b, shardID: 1, rangeID: 10, source: source.executionManager, target: target.executionManager}
+	sourceTasks := []persistence.Task{
+		&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 1}},
+		&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 2}},
+	}
+	target.executionManager.EXPECT().GetHistoryTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []persistence.Task{&persistence.DecisionTask{TaskData: persistence.TaskData{TaskID: 3}}},
+	}, nil)
+	source.executionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             common.IntPtr(1),
+		TaskCategory:        persistence.HistoryTaskCategoryTransfer,
+		InclusiveMinTaskKey: persistence.MinimumHistoryTaskKey,
+		ExclusiveMaxTaskKey: persistence.NewImmediateTaskKey(3),
+		PageSize:            taskPageSize,
+	}).Return(&persistence.GetHistoryTasksResponse{Tasks: sourceTasks}, nil)
+	target.executionManager.EXPECT().CreateHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateHistoryTasksRequest) error {
+			assert.Equal(t, int64(10), request.RangeID)
+			assert.Equal(t, map[persistence.HistoryTaskCategory][]persistence.Task{persistence.HistoryTaskCategoryTransfer: sourceTasks}, request.TasksByCategory)
+			return nil
+		})
+
+	copied, err := copier.copyHistoryTasks(context.Background(), persistence.HistoryTaskCategoryTransfer)
+	require.NoError(t, err)
+	assert.Equal(t, 2, copied)
+}
+
+func TestBackfillDomains(t *testing.T) {
+	b, source, target := newTestBackfiller(t)
+	missing := &persistence.GetDomainResponse{Info: &persistence.DomainInfo{ID: "d1", Name: "missing"}, ConfigVersion: 1}
+	stale := &persistence.GetDomainResponse{Info: &persistence.DomainInfo{ID: "d2", Name: "stale"}, ConfigVersion: 2, LastUpdatedTime: 20}
+	source.domainManager.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(&persistence.ListDomainsResponse{
+		Domains: []*persistence.GetDomainResponse{missing, stale},
+	}, nil)
+	target.domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "d1"}).Return(nil, &types.EntityNotExistsError{})
+	target.domainManager.EXPECT().CreateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateDomainRequest) (*persistence.CreateDomainResponse, error) {
+			assert.Equal(t, missing.Info, request.Info)
+			assert.Equal(t, int64(1), request.ConfigVersion)
+			return &persistence.CreateDomainResponse{ID: "d1"}, nil
+		})
+	target.domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "d2"}).
+		Return(&persistence.GetDomainResponse{Info: stale.Info, ConfigVersion: 1, LastUpdatedTime: 10}, nil)
+
+	// the stale domain is updated, then updated once more to align the notification versions
+	source.domainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	gomock.InOrder(
+		target.domainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 5}, nil),
+		target.domainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 6}, nil),
+		target.domainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 6}, nil),
+		target.domainManager.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil),
+	)
+	target.domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "d2"}).Return(stale, nil)
+	gomock.InOrder(
+		target.domainManager.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.UpdateDomainRequest) error {
+				assert.Equal(t, int64(2), request.ConfigVersion)
+				assert.Equal(t, int64(5), request.NotificationVersion)
+				return nil
+			}),
+		target.domainManager.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.UpdateDomainRequest) error {
+				assert.Equal(t, int64(6), request.NotificationVersion)
+				return nil
+			}),
+	)
+
+	result, err := b.backfillDomains(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &DomainsResult{CopiedDomains: 1, UpdatedDomains: 1}, result)
+}
+
+func TestCopyTaskList(t *testing.T) {
+	b, source, target := newTestBackfiller(t)
+	info := &persistence.TaskListInfo{DomainID: "domain", Name: "tl", TaskType: persistence.TaskListTypeDecision, RangeID: 3, AckLevel: 10}
+
+	target.taskManager.EXPECT().GetTaskList(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	gomock.InOrder(
+		target.taskManager.EXPECT().LeaseTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
+				assert.Equal(t, int64(0), request.RangeID)
+				return &persistence.LeaseTaskListResponse{TaskListInfo: &persistence.TaskListInfo{RangeID: 1}}, nil
+			}),
+		target.taskManager.EXPECT().LeaseTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.LeaseTaskListRequest) (*persistence.LeaseTaskListResponse, error) {
+				assert.Equal(t, int64(1), request.RangeID)
+				return &persistence.LeaseTaskListResponse{TaskListInfo: &persistence.TaskListInfo{RangeID: 3}}, nil
+			}),
+	)
+	target.taskManager.EXPECT().UpdateTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
+			assert.Equal(t, int64(10), request.TaskListInfo.AckLevel)
+			assert.Equal(t, int64(3), request.TaskListInfo.RangeID)
+			return &persistence.UpdateTaskListResponse{}, nil
+		})
+	target.taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{
+		Tasks: []*persistence.TaskInfo{{TaskID: 13}},
+	}, nil)
+	maxReadLevel := int64(12)
+	source.taskManager.EXPECT().GetTasks(gomock.Any(), &persistence.GetTasksRequest{
+		DomainID:     "domain",
+		TaskList:     "tl",
+		TaskType:     persistence.TaskListTypeDecision,
+		ReadLevel:    10,
+		MaxReadLevel: &maxReadLevel,
+		BatchSize:    taskPageSize,
+	}).Return(&persistence.GetTasksResponse{
+		Tasks: []*persistence.TaskInfo{{TaskID: 11, CreatedTime: time.Unix(1, 0)}, {TaskID: 12}},
+	}, nil)
+	source.taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{}, nil)
+	target.taskManager.EXPECT().CreateTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+			require.Len(t, request.Tasks, 2)
+			assert.Equal(t, int64(11), request.Tasks[0].TaskID)
+			assert.Equal(t, int64(3), request.TaskListInfo.RangeID)
+			return &persistence.CreateTasksResponse{}, nil
+		})
+
+	copied, err := b.copyTaskList(context.Background(), info)
+	require.NoError(t, err)
+	assert.Equal(t, 2, copied)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/client"
+)
+
+type (
+	// Config defines the configuration for persistence migration
+	Config struct {
+		// PersistenceConfig is the persistence config of the cluster, its MigrationStore is the store data is copied to
+		PersistenceConfig config.Persistence
+		// ClusterName is the name of the current cluster
+		ClusterName string
+		// DynamicConfig is the dynamic config used by the persistence layer
+		DynamicConfig *persistence.DynamicConfiguration
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the persistence migration worker
+	BootstrapParams struct {
+		// Config contains the configuration for persistence migration
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+	}
+
+	// Migrator of cadence worker service, it copies the data of the default store to the migration store
+	Migrator struct {
+		cfg       Config
+		svcClient workflowserviceclient.Interface
+		logger    log.Logger
+		scope     tally.Scope
+		worker    worker.Worker
+
+		defaultStores   *stores
+		migrationStores *stores
+		// configStore is the config store of the default store, which holds the divergence markers of the shards
+		configStore persistence.ConfigStoreManager
+	}
+)
+
+// New returns a new instance of Migrator
+func New(params *BootstrapParams) *Migrator {
+	return &Migrator{
+		cfg:       params.Config,
+		svcClient: params.ServiceClient,
+		logger:    params.Logger.WithTags(tag.ComponentPersistenceMigration),
+		scope:     params.TallyScope,
+	}
+}
+
+// Start starts the worker
+func (m *Migrator) Start() error {
+	var err error
+	if m.defaultStores, err = newStores(m.newFactory(m.cfg.PersistenceConfig.DefaultStore)); err != nil {
+		return err
+	}
+	if m.migrationStores, err = newStores(m.newFactory(m.cfg.PersistenceConfig.MigrationStore)); err != nil {
+		m.defaultStores.close()
+		return err
+	}
+	if m.configStore, err = m.defaultStores.factory.NewConfigStoreManager(); err != nil {
+		m.defaultStores.close()
+		m.migrationStores.close()
+		return err
+	}
+
+	ctx := context.WithValue(context.Background(), migratorContextKey, m)
+	workerOpts := worker.Options{
+		MetricsScope:              m.scope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	migrationWorker := worker.New(m.svcClient, constants.SystemLocalDomainName, TaskListName, workerOpts)
+	migrationWorker.RegisterWorkflowWithOptions(BackfillWorkflow, workflow.RegisterOptions{Name: BackfillWorkflowTypeName})
+	migrationWorker.RegisterActivityWithOptions(GetShardIDsActivity, activity.RegisterOptions{Name: getShardIDsActivityName})
+	migrationWorker.RegisterActivityWithOptions(BackfillDomainsActivity, activity.RegisterOptions{Name: backfillDomainsActivityName})
+	migrationWorker.RegisterActivityWithOptions(BackfillShardActivity, activity.RegisterOptions{Name: backfillShardActivityName})
+	migrationWorker.RegisterActivityWithOptions(BackfillTaskListsActivity, activity.RegisterOptions{Name: backfillTaskListsActivityName})
+	m.worker = migrationWorker
+	return migrationWorker.Start()
+}
+
+// Stop stops the worker
+func (m *Migrator) Stop() {
+	if m.worker != nil {
+		m.worker.Stop()
+	}
+	if m.defaultStores != nil {
+		m.defaultStores.close()
+	}
+	if m.migrationStores != nil {
+		m.migrationStores.close()
+	}
+	if m.configStore != nil {
+		m.configStore.Close()
+	}
+}
+
+// newFactory returns a factory of the given store without any migration, rate limiting or error injection,
+// so that the backfill reads and writes each store directly
+func (m *Migrator) newFactory(storeName string) client.Factory {
+	cfg := m.cfg.PersistenceConfig
+	cfg.DefaultStore = storeName
+	cfg.MigrationStore = ""
+	cfg.ErrorInjectionRate = dynamicproperties.GetFloatPropertyFn(0)
+	return client.NewFactory(&cfg, nil, m.cfg.ClusterName, nil, m.logger, m.cfg.DynamicConfig)
+}
+
+// getStores returns the stores to copy data from and to
+func (m *Migrator) getStores(reverse bool) (source *stores, target *stores) {
+	if reverse {
+		return m.migrationStores, m.defaultStores
+	}
+	return m.defaultStores, m.migrationStores
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+type contextKey string
+
+const (
+	migratorContextKey contextKey = "persistenceMigrationContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-persistence-migration-tasklist"
+	// BackfillWorkflowTypeName workflow type name
+	BackfillWorkflowTypeName = "cadence-sys-persistence-migration-backfill-workflow"
+	// BackfillWorkflowID will be reused to ensure only one workflow running
+	BackfillWorkflowID = "cadence-persistence-migration-backfill"
+
+	getShardIDsActivityName       = "cadence-sys-persistence-migration-getShardIDs-activity"
+	backfillDomainsActivityName   = "cadence-sys-persistence-migration-backfillDomains-activity"
+	backfillShardActivityName     = "cadence-sys-persistence-migration-backfillShard-activity"
+	backfillTaskListsActivityName = "cadence-sys-persistence-migration-backfillTaskLists-activity"
+
+	defaultConcurrency = 10
+
+	errMsgParamsIsNil          = "params is nil"
+	errMsgInvalidShardID       = "shard ID cannot be negative"
+	errMsgMigrationUnavailable = "persistence migration is not configured on this worker"
+
+	// QueryType for backfill workflow
+	QueryType = "state"
+
+	// workflow states for query
+
+	// WorkflowInitialized state
+	WorkflowInitialized = "initialized"
+	// WorkflowRunning state
+	WorkflowRunning = "running"
+	// WorkflowCompleted state
+	WorkflowCompleted = "complete"
+)
+
+type (
+	// BackfillParams is the arg for BackfillWorkflow
+	BackfillParams struct {
+		// ShardIDs to backfill, all shards of the cluster are backfilled when it's empty
+		ShardIDs []int
+		// Concurrency is the number of shards backfilled at the same time
+		Concurrency int
+		// Reverse copies the data of the migration store back to the default store
+		Reverse bool
+		// SkipDomains skips the domain backfill
+		SkipDomains bool
+		// SkipTaskLists skips the task list backfill
+		SkipTaskLists bool
+	}
+
+	// BackfillResult is workflow result
+	BackfillResult struct {
+		Domains      DomainsResult
+		Shards       ShardResult
+		TaskLists    TaskListsResult
+		FailedShards []int
+	}
+
+	// QueryResult for backfill progress
+	QueryResult struct {
+		State           string
+		Reverse         bool
+		TotalShards     int
+		CompletedShards []int
+		FailedShards    []int
+		Domains         DomainsResult
+		Shards          ShardResult
+		TaskLists       TaskListsResult
+	}
+
+	// BackfillShardParams params for backfill shard activity
+	BackfillShardParams struct {
+		ShardID int
+		Reverse bool
+	}
+
+	// BackfillStoreParams params for the domain and task list backfill activities
+	BackfillStoreParams struct {
+		Reverse bool
+	}
+
+	// ShardResult counts the records copied by shard backfills
+	ShardResult struct {
+		CopiedExecutions int
+		// UpdatedExecutions were in the target store but differed from the source ones
+		UpdatedExecutions int
+		SkippedExecutions int
+		FailedExecutions  int
+		CopiedTasks       int
+	}
+
+	// DomainsResult counts the domains copied by the domain backfill
+	DomainsResult struct {
+		CopiedDomains  int
+		UpdatedDomains int
+	}
+
+	// TaskListsResult counts the task lists copied by the task list backfill
+	TaskListsResult struct {
+		CopiedTaskLists int
+		FailedTaskLists int
+		CopiedTasks     int
+	}
+)
+
+// BackfillWorkflow copies the data of the default store to the migration store, it is expected to run
+// when the shards are in dual-write mode so that writes happening during the backfill reach both stores
+func BackfillWorkflow(ctx workflow.Context, params *BackfillParams) (*BackfillResult, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+
+	progress := &QueryResult{
+		State:   WorkflowInitialized,
+		Reverse: params.Reverse,
+	}
+	err := workflow.SetQueryHandler(ctx, QueryType, func(input []byte) (*QueryResult, error) {
+		return progress, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	progress.State = WorkflowRunning
+
+	ao := workflow.WithActivityOptions(ctx, getBackfillActivityOptions())
+	storeParams := &BackfillStoreParams{Reverse: params.Reverse}
+	// domains go first as the shards refer to them
+	if !params.SkipDomains {
+		if err := workflow.ExecuteActivity(ao, BackfillDomainsActivity, storeParams).Get(ctx, &progress.Domains); err != nil {
+			return nil, err
+		}
+	}
+
+	shardIDs := params.ShardIDs
+	if len(shardIDs) == 0 {
+		if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, getShardIDsActivityOptions()), GetShardIDsActivity).Get(ctx, &shardIDs); err != nil {
+			return nil, err
+		}
+	}
+	progress.TotalShards = len(shardIDs)
+
+	selector := workflow.NewSelector(ctx)
+	pending := 0
+	for _, shardID := range shardIDs {
+		if pending >= params.Concurrency {
+			selector.Select(ctx)
+			pending--
+		}
+		shardID := shardID
+		future := workflow.ExecuteActivity(ao, BackfillShardActivity, &BackfillShardParams{ShardID: shardID, Reverse: params.Reverse})
+		pending++
+		selector.AddFuture(future, func(f workflow.Future) {
+			var result ShardResult
+			if err := f.Get(ctx, &result); err != nil {
+				workflow.GetLogger(ctx).Error("Failed to backfill shard", zap.Int("ShardID", shardID), zap.Error(err))
+				progress.FailedShards = append(progress.FailedShards, shardID)
+				return
+			}
+			progress.CompletedShards = append(progress.CompletedShards, shardID)
+			progress.Shards.CopiedExecutions += result.CopiedExecutions
+			progress.Shards.UpdatedExecutions += result.UpdatedExecutions
+			progress.Shards.SkippedExecutions += result.SkippedExecutions
+			progress.Shards.FailedExecutions += result.FailedExecutions
+			progress.Shards.CopiedTasks += result.CopiedTasks
+		})
+	}
+	for ; pending > 0; pending-- {
+		selector.Select(ctx)
+	}
+
+	if !params.SkipTaskLists {
+		if err := workflow.ExecuteActivity(ao, BackfillTaskListsActivity, storeParams).Get(ctx, &progress.TaskLists); err != nil {
+			return nil, err
+		}
+	}
+
+	progress.State = WorkflowCompleted
+	return &BackfillResult{
+		Domains:      progress.Domains,
+		Shards:       progress.Shards,
+		TaskLists:    progress.TaskLists,
+		FailedShards: progress.FailedShards,
+	}, nil
+}
+
+func validateParams(params *BackfillParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	for _, shardID := range params.ShardIDs {
+		if shardID < 0 {
+			return errors.New(errMsgInvalidShardID)
+		}
+	}
+	if params.Concurrency <= 0 {
+		params.Concurrency = defaultConcurrency
+	}
+	return nil
+}
+
+func getShardIDsActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    10 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          2 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          time.Minute,
+			ExpirationInterval:       10 * time.Minute,
+			NonRetriableErrorReasons: []string{errMsgMigrationUnavailable},
+		},
+	}
+}
+
+func getBackfillActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    24 * time.Hour,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       24 * time.Hour,
+			NonRetriableErrorReasons: []string{errMsgMigrationUnavailable},
+		},
+	}
+}
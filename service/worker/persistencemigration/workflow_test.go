@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencemigration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/config"
+)
+
+type backfillWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	activityEnv *testsuite.TestActivityEnvironment
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestBackfillWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(backfillWorkflowTestSuite))
+}
+
+func (s *backfillWorkflowTestSuite) SetupTest() {
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(BackfillWorkflow, workflow.RegisterOptions{Name: BackfillWorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(GetShardIDsActivity, activity.RegisterOptions{Name: getShardIDsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(BackfillDomainsActivity, activity.RegisterOptions{Name: backfillDomainsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(BackfillShardActivity, activity.RegisterOptions{Name: backfillShardActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(BackfillTaskListsActivity, activity.RegisterOptions{Name: backfillTaskListsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(GetShardIDsActivity, activity.RegisterOptions{Name: getShardIDsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(BackfillShardActivity, activity.RegisterOptions{Name: backfillShardActivityName})
+}
+
+func (s *backfillWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *backfillWorkflowTestSuite) TestValidateParams() {
+	s.Error(validateParams(nil))
+	s.Error(validateParams(&BackfillParams{ShardIDs: []int{1, -1}}))
+
+	params := &BackfillParams{ShardIDs: []int{0, 1}}
+	s.NoError(validateParams(params))
+	s.Equal(defaultConcurrency, params.Concurrency)
+}
+
+func (s *backfillWorkflowTestSuite) TestWorkflow() {
+	s.workflowEnv.OnActivity(backfillDomainsActivityName, mock.Anything, &BackfillStoreParams{}).
+		Return(&DomainsResult{CopiedDomains: 2, UpdatedDomains: 1}, nil).Once()
+	s.workflowEnv.OnActivity(getShardIDsActivityName, mock.Anything).Return([]int{0, 1, 2}, nil).Once()
+	s.workflowEnv.OnActivity(backfillShardActivityName, mock.Anything, &BackfillShardParams{ShardID: 0}).
+		Return(&ShardResult{CopiedExecutions: 3, SkippedExecutions: 1, CopiedTasks: 5}, nil).Once()
+	s.workflowEnv.OnActivity(backfillShardActivityName, mock.Anything, &BackfillShardParams{ShardID: 1}).
+		Return(nil, errors.New("mockErr")).Once()
+	s.workflowEnv.OnActivity(backfillShardActivityName, mock.Anything, &BackfillShardParams{ShardID: 2}).
+		Return(&ShardResult{CopiedExecutions: 1, FailedExecutions: 1}, nil).Once()
+	s.workflowEnv.OnActivity(backfillTaskListsActivityName, mock.Anything, &BackfillStoreParams{}).
+		Return(&TaskListsResult{CopiedTaskLists: 4, CopiedTasks: 10}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(BackfillWorkflowTypeName, &BackfillParams{Concurrency: 2})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var result BackfillResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(BackfillResult{
+		Domains:      DomainsResult{CopiedDomains: 2, UpdatedDomains: 1},
+		Shards:       ShardResult{CopiedExecutions: 4, SkippedExecutions: 1, FailedExecutions: 1, CopiedTasks: 5},
+		TaskLists:    TaskListsResult{CopiedTaskLists: 4, CopiedTasks: 10},
+		FailedShards: []int{1},
+	}, result)
+
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var progress QueryResult
+	s.NoError(queryResult.Get(&progress))
+	s.Equal(WorkflowCompleted, progress.State)
+	s.Equal(3, progress.TotalShards)
+	s.ElementsMatch([]int{0, 2}, progress.CompletedShards)
+	s.Equal([]int{1}, progress.FailedShards)
+}
+
+func (s *backfillWorkflowTestSuite) TestWorkflow_SkipDomainsAndTaskLists() {
+	s.workflowEnv.OnActivity(backfillShardActivityName, mock.Anything, &BackfillShardParams{ShardID: 5, Reverse: true}).
+		Return(&ShardResult{CopiedExecutions: 1}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(BackfillWorkflowTypeName, &BackfillParams{
+		ShardIDs:      []int{5},
+		Reverse:       true,
+		SkipDomains:   true,
+		SkipTaskLists: true,
+	})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var result BackfillResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(ShardResult{CopiedExecutions: 1}, result.Shards)
+	s.Empty(result.FailedShards)
+}
+
+func (s *backfillWorkflowTestSuite) TestWorkflow_DomainsActivityError() {
+	s.workflowEnv.OnActivity(backfillDomainsActivityName, mock.Anything, mock.Anything).Return(nil, errors.New("mockErr"))
+
+	s.workflowEnv.ExecuteWorkflow(BackfillWorkflowTypeName, &BackfillParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *backfillWorkflowTestSuite) TestGetShardIDsActivity() {
+	migrator := &Migrator{cfg: Config{PersistenceConfig: config.Persistence{NumHistoryShards: 4}}}
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), migratorContextKey, migrator),
+	})
+
+	result, err := s.activityEnv.ExecuteActivity(getShardIDsActivityName)
+	s.NoError(err)
+	var shardIDs []int
+	s.NoError(result.Get(&shardIDs))
+	s.Equal([]int{0, 1, 2, 3}, shardIDs)
+}
+
+func (s *backfillWorkflowTestSuite) TestActivityWithoutMigrator() {
+	_, err := s.activityEnv.ExecuteActivity(backfillShardActivityName, &BackfillShardParams{ShardID: 1})
+	s.ErrorContains(err, errMsgMigrationUnavailable)
+}
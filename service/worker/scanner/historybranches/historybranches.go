@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package historybranches
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/pagination"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/fetcher"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/reconciliation/store"
+	"github.com/uber/cadence/service/worker/scanner/shardscanner"
+)
+
+const (
+	// ScannerWFTypeName defines workflow type name for history branches scanner
+	ScannerWFTypeName   = "cadence-sys-history-branches-scanner-workflow"
+	wfid                = "cadence-sys-history-branches-scanner"
+	scannerTaskListName = "cadence-sys-history-branches-scanner-tasklist-0"
+
+	// FixerWFTypeName defines workflow type name for history branches fixer
+	FixerWFTypeName   = "cadence-sys-history-branches-fixer-workflow"
+	fixerTaskListName = "cadence-sys-history-branches-fixer-tasklist-0"
+	fixerwfid         = "cadence-sys-history-branches-fixer"
+	minBranchAgeKey   = "min_branch_age"
+)
+
+// ScannerWorkflow starts history branches scanner.
+func ScannerWorkflow(
+	ctx workflow.Context,
+	params shardscanner.ScannerWorkflowParams,
+) error {
+	wf, err := shardscanner.NewScannerWorkflow(ctx, ScannerWFTypeName, params)
+	if err != nil {
+		return err
+	}
+
+	return wf.Start(ctx)
+}
+
+// FixerWorkflow starts history branches fixer.
+func FixerWorkflow(
+	ctx workflow.Context,
+	params shardscanner.FixerWorkflowParams,
+) error {
+	wf, err := shardscanner.NewFixerWorkflow(ctx, FixerWFTypeName, params)
+	if err != nil {
+		return err
+	}
+
+	return wf.Start(ctx)
+}
+
+// ScannerHooks provides hooks for history branches scanner.
+func ScannerHooks() *shardscanner.ScannerHooks {
+	h, err := shardscanner.NewScannerHooks(Manager, Iterator, Config)
+	if err != nil {
+		return nil
+	}
+
+	return h
+}
+
+// FixerHooks provides hooks needed for history branches fixer.
+func FixerHooks() *shardscanner.FixerHooks {
+	h, err := shardscanner.NewFixerHooks(FixerManager, FixerIterator, fixerCustomConfig)
+	if err != nil {
+		return nil
+	}
+	return h
+}
+
+func fixerCustomConfig(ctx shardscanner.FixerContext) shardscanner.CustomScannerConfig {
+	// must be non-empty to pass backwards-compat check.
+	// the min branch age is recorded with the invariant so that the fixer
+	// re-checks the branches with the same age the workflow started with.
+	return map[string]string{
+		string(invariant.OrphanedHistoryBranches): "true",
+		minBranchAgeKey: ctx.Config.DynamicCollection.GetDurationProperty(dynamicproperties.HistoryBranchesScannerMinBranchAge)().String(),
+	}
+}
+
+// Manager provides invariant manager for history branches scanner.
+func Manager(
+	_ context.Context,
+	pr persistence.Retryer,
+	params shardscanner.ScanShardActivityParams,
+	cache cache.DomainCache,
+) invariant.Manager {
+	return invariant.NewInvariantManager(getInvariants(pr, cache, params.ScannerConfig))
+}
+
+// Iterator provides iterator for history branches scanner.
+func Iterator(
+	ctx context.Context,
+	pr persistence.Retryer,
+	params shardscanner.ScanShardActivityParams,
+) pagination.Iterator {
+	return fetcher.ConcreteExecutionIterator(ctx, pr, params.PageSize)
+}
+
+// FixerIterator provides iterator for history branches fixer.
+func FixerIterator(
+	ctx context.Context,
+	client blobstore.Client,
+	keys store.Keys,
+	_ shardscanner.FixShardActivityParams,
+) store.ScanOutputIterator {
+	return store.NewBlobstoreIterator(ctx, client, keys, &entity.ConcreteExecution{})
+}
+
+// FixerManager provides invariant manager for history branches fixer.
+func FixerManager(
+	_ context.Context,
+	pr persistence.Retryer,
+	params shardscanner.FixShardActivityParams,
+	cache cache.DomainCache,
+) invariant.Manager {
+	return invariant.NewInvariantManager(getInvariants(pr, cache, params.EnabledInvariants))
+}
+
+// Config resolves dynamic config for history branches scanner.
+func Config(ctx shardscanner.ScannerContext) shardscanner.CustomScannerConfig {
+	res := shardscanner.CustomScannerConfig{}
+	res[minBranchAgeKey] = ctx.Config.DynamicCollection.GetDurationProperty(dynamicproperties.HistoryBranchesScannerMinBranchAge)().String()
+	return res
+}
+
+// ScannerConfig configures history branches scanner
+func ScannerConfig(dc *dynamicconfig.Collection) *shardscanner.ScannerConfig {
+	return &shardscanner.ScannerConfig{
+		ScannerWFTypeName: ScannerWFTypeName,
+		FixerWFTypeName:   FixerWFTypeName,
+		DynamicParams: shardscanner.DynamicParams{
+			ScannerEnabled:          dc.GetBoolProperty(dynamicproperties.HistoryBranchesScannerEnabled),
+			FixerEnabled:            dc.GetBoolProperty(dynamicproperties.HistoryBranchesFixerEnabled),
+			Concurrency:             dc.GetIntProperty(dynamicproperties.HistoryBranchesScannerConcurrency),
+			PageSize:                dc.GetIntProperty(dynamicproperties.HistoryBranchesScannerPersistencePageSize),
+			BlobstoreFlushThreshold: dc.GetIntProperty(dynamicproperties.HistoryBranchesScannerBlobstoreFlushThreshold),
+			ActivityBatchSize:       dc.GetIntProperty(dynamicproperties.HistoryBranchesScannerActivityBatchSize),
+			AllowDomain:             dc.GetBoolPropertyFilteredByDomain(dynamicproperties.HistoryBranchesFixerDomainAllow),
+		},
+		DynamicCollection: dc,
+		ScannerHooks:      ScannerHooks,
+		FixerHooks:        FixerHooks,
+
+		StartWorkflowOptions: client.StartWorkflowOptions{
+			ID:                           wfid,
+			TaskList:                     scannerTaskListName,
+			ExecutionStartToCloseTimeout: 20 * 365 * 24 * time.Hour,
+			WorkflowIDReusePolicy:        client.WorkflowIDReusePolicyAllowDuplicate,
+			CronSchedule:                 "*/5 * * * *",
+		},
+		StartFixerOptions: client.StartWorkflowOptions{
+			ID:                           fixerwfid,
+			TaskList:                     fixerTaskListName,
+			ExecutionStartToCloseTimeout: 20 * 365 * 24 * time.Hour,
+			WorkflowIDReusePolicy:        client.WorkflowIDReusePolicyAllowDuplicate,
+			CronSchedule:                 "*/5 * * * *",
+		},
+	}
+}
+
+// minBranchAge reads the min branch age recorded in the custom config,
+// falling back to the default when it's missing or invalid.
+func minBranchAge(cfg shardscanner.CustomScannerConfig) time.Duration {
+	d, err := time.ParseDuration(cfg[minBranchAgeKey])
+	if err != nil || d <= 0 {
+		return invariant.DefaultOrphanedHistoryBranchMinAge
+	}
+	return d
+}
+
+func getInvariants(pr persistence.Retryer, cache cache.DomainCache, cfg shardscanner.CustomScannerConfig) []invariant.Invariant {
+	return []invariant.Invariant{
+		invariant.NewOrphanedHistoryBranches(pr, cache, minBranchAge(cfg)),
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package historybranches
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/service/worker/scanner/shardscanner"
+)
+
+func TestScannerConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dcClient := dynamicconfig.NewMockClient(ctrl)
+	dc := dynamicconfig.NewCollection(dcClient, log.NewNoop())
+
+	cfg := ScannerConfig(dc)
+	assert.Equal(t, ScannerWFTypeName, cfg.ScannerWFTypeName)
+	assert.Equal(t, FixerWFTypeName, cfg.FixerWFTypeName)
+	assert.NotNil(t, cfg.ScannerHooks())
+	assert.NotNil(t, cfg.FixerHooks())
+}
+
+func TestCustomConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dcClient := dynamicconfig.NewMockClient(ctrl)
+	dcClient.EXPECT().GetDurationValue(dynamicproperties.HistoryBranchesScannerMinBranchAge, gomock.Any()).Return(2*time.Hour, nil).Times(2)
+	cfg := ScannerConfig(dynamicconfig.NewCollection(dcClient, log.NewNoop()))
+
+	assert.Equal(t, shardscanner.CustomScannerConfig{
+		minBranchAgeKey: "2h0m0s",
+	}, Config(shardscanner.ScannerContext{Config: cfg}))
+	assert.Equal(t, shardscanner.CustomScannerConfig{
+		string(invariant.OrphanedHistoryBranches): "true",
+		minBranchAgeKey: "2h0m0s",
+	}, fixerCustomConfig(shardscanner.FixerContext{Config: cfg}))
+}
+
+func TestMinBranchAge(t *testing.T) {
+	tests := map[string]struct {
+		cfg  shardscanner.CustomScannerConfig
+		want time.Duration
+	}{
+		"recorded": {
+			cfg:  shardscanner.CustomScannerConfig{minBranchAgeKey: "30m"},
+			want: 30 * time.Minute,
+		},
+		"missing": {
+			cfg:  shardscanner.CustomScannerConfig{},
+			want: invariant.DefaultOrphanedHistoryBranchMinAge,
+		},
+		"invalid": {
+			cfg:  shardscanner.CustomScannerConfig{minBranchAgeKey: "soon"},
+			want: invariant.DefaultOrphanedHistoryBranchMinAge,
+		},
+		"not positive": {
+			cfg:  shardscanner.CustomScannerConfig{minBranchAgeKey: "0s"},
+			want: invariant.DefaultOrphanedHistoryBranchMinAge,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, minBranchAge(tc.cfg))
+		})
+	}
+}
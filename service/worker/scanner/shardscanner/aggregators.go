@@ -481,6 +481,9 @@ func (a *ShardScanResultAggregator) updateDomainStats(report ScanReport) {
 		for corruptionType, count := range domainStats.CorruptionByType {
 			aggregateStats.CorruptionByType[corruptionType] += count
 		}
+		for corruptionType, size := range domainStats.CorruptedSizeByType {
+			aggregateStats.addCorruptedSize(corruptionType, size)
+		}
 
 	}
 }
@@ -565,6 +568,12 @@ func (a *ShardScanResultAggregator) adjustAggregation(stats ScanStats, fn func(a
 	for k, v := range stats.CorruptionByType {
 		a.aggregation.CorruptionByType[k] = fn(a.aggregation.CorruptionByType[k], v)
 	}
+	for k, v := range stats.CorruptedSizeByType {
+		if a.aggregation.CorruptedSizeByType == nil {
+			a.aggregation.CorruptedSizeByType = make(map[invariant.Name]int64)
+		}
+		a.aggregation.CorruptedSizeByType[k] = fn(a.aggregation.CorruptedSizeByType[k], v)
+	}
 }
 
 func (a *ShardScanResultAggregator) GetAllScanResults() (map[int]ScanResult, error) {
@@ -287,6 +287,45 @@ func (s *aggregatorsSuite) TestShardScanResultAggregator() {
 	}, allDomainsReport)
 }
 
+func (s *aggregatorsSuite) TestShardScanResultAggregator_CorruptedSize() {
+	agg := NewShardScanResultAggregator([]int{1, 2}, 1, 2)
+	newReport := func(shardID int, domainSizes map[string]int64) ScanReport {
+		report := ScanReport{
+			ShardID: shardID,
+			Stats: ScanStats{
+				CorruptionByType: map[invariant.Name]int64{},
+			},
+			Result: ScanResult{
+				ShardScanKeys: &ScanKeys{},
+			},
+			DomainStats: map[string]*ScanStats{},
+		}
+		for domainID, size := range domainSizes {
+			report.Stats.CorruptedCount++
+			report.Stats.CorruptionByType[invariant.OrphanedHistoryBranches]++
+			report.Stats.addCorruptedSize(invariant.OrphanedHistoryBranches, size)
+			domainStats := &ScanStats{
+				CorruptedCount:   1,
+				CorruptionByType: map[invariant.Name]int64{invariant.OrphanedHistoryBranches: 1},
+			}
+			domainStats.addCorruptedSize(invariant.OrphanedHistoryBranches, size)
+			report.DomainStats[domainID] = domainStats
+		}
+		return report
+	}
+	agg.AddReport(newReport(1, map[string]int64{"ABC": 10, "DEF": 20}))
+	agg.AddReport(newReport(2, map[string]int64{"ABC": 30}))
+
+	s.Equal(map[invariant.Name]int64{invariant.OrphanedHistoryBranches: 60}, agg.GetAggregateReport().CorruptedSizeByType)
+	domainReport, err := agg.GetDomainStatus(DomainReportQueryRequest{})
+	s.NoError(err)
+	s.Len(domainReport.Reports, 2)
+	s.Equal("ABC", domainReport.Reports[0].DomainID)
+	s.Equal(map[invariant.Name]int64{invariant.OrphanedHistoryBranches: 40}, domainReport.Reports[0].Stats.CorruptedSizeByType)
+	s.Equal("DEF", domainReport.Reports[1].DomainID)
+	s.Equal(map[invariant.Name]int64{invariant.OrphanedHistoryBranches: 20}, domainReport.Reports[1].Stats.CorruptedSizeByType)
+}
+
 func (s *aggregatorsSuite) TestShardFixResultAggregator() {
 	agg := NewShardFixResultAggregator([]CorruptedKeysEntry{{ShardID: 1}, {ShardID: 2}, {ShardID: 3}}, 1, 3)
 	expected := &ShardFixResultAggregator{
@@ -157,6 +157,12 @@ func (s *ShardScanner) Scan(ctx context.Context) ScanReport {
 			result.Stats.CorruptionByType[*checkResult.DeterminingInvariantType]++
 			result.DomainStats[*domainID].CorruptedCount++
 			result.DomainStats[*domainID].CorruptionByType[*checkResult.DeterminingInvariantType]++
+			for _, invariantResult := range checkResult.CheckResults {
+				if invariantResult.CheckResultType == invariant.CheckResultTypeCorrupted {
+					result.Stats.addCorruptedSize(invariantResult.InvariantName, invariantResult.CorruptedSize)
+					result.DomainStats[*domainID].addCorruptedSize(invariantResult.InvariantName, invariantResult.CorruptedSize)
+				}
+			}
 		case invariant.CheckResultTypeFailed:
 			if err := s.failedWriter.Add(store.ScanOutputEntity{
 				Execution: execution,
@@ -505,6 +505,64 @@ func (s *ScannerSuite) TestScan_Success() {
 	}, result)
 }
 
+func (s *ScannerSuite) TestScan_CorruptedSize() {
+	executions := []*entity.ConcreteExecution{
+		{Execution: entity.Execution{DomainID: "domain1", RunID: "run1"}},
+		{Execution: entity.Execution{DomainID: "domain1", RunID: "run2"}},
+		{Execution: entity.Execution{DomainID: "domain2", RunID: "run3"}},
+	}
+	sizes := []int64{10, 20, 40}
+	mockItr := pagination.NewMockIterator(s.controller)
+	next := 0
+	mockItr.EXPECT().HasNext().DoAndReturn(func() bool {
+		return next < len(executions)
+	}).Times(len(executions) + 1)
+	mockItr.EXPECT().Next().DoAndReturn(func() (*entity.ConcreteExecution, error) {
+		next++
+		return executions[next-1], nil
+	}).Times(len(executions))
+	mockInvariantManager := invariant.NewMockManager(s.controller)
+	mockCorruptedWriter := store.NewMockExecutionWriter(s.controller)
+	for i, execution := range executions {
+		checkResult := invariant.ManagerCheckResult{
+			CheckResultType:          invariant.CheckResultTypeCorrupted,
+			DeterminingInvariantType: invariant.NamePtr(invariant.OrphanedHistoryBranches),
+			CheckResults: []invariant.CheckResult{
+				{
+					CheckResultType: invariant.CheckResultTypeCorrupted,
+					InvariantName:   invariant.OrphanedHistoryBranches,
+					CorruptedSize:   sizes[i],
+				},
+			},
+		}
+		mockInvariantManager.EXPECT().RunChecks(context.Background(), execution).Return(checkResult)
+		mockCorruptedWriter.EXPECT().Add(store.ScanOutputEntity{Execution: execution, Result: checkResult}).Return(nil)
+	}
+	mockFailedWriter := store.NewMockExecutionWriter(s.controller)
+	mockCorruptedWriter.EXPECT().Flush().Return(nil)
+	mockFailedWriter.EXPECT().Flush().Return(nil)
+	mockCorruptedWriter.EXPECT().FlushedKeys().Return(&store.Keys{UUID: "corrupt_keys_uuid"})
+	mockFailedWriter.EXPECT().FlushedKeys().Return(nil)
+	domainCache := cache.NewMockDomainCache(s.controller)
+	domainCache.EXPECT().GetDomainName(gomock.Any()).Return("test-domain", nil).AnyTimes()
+
+	scanner := &ShardScanner{
+		shardID:          0,
+		invariantManager: mockInvariantManager,
+		corruptedWriter:  mockCorruptedWriter,
+		failedWriter:     mockFailedWriter,
+		itr:              mockItr,
+		progressReportFn: func() {},
+		domainCache:      domainCache,
+		scope:            metrics.NoopScope,
+	}
+	result := scanner.Scan(context.Background())
+	s.Nil(result.Result.ControlFlowFailure)
+	s.Equal(map[invariant.Name]int64{invariant.OrphanedHistoryBranches: 70}, result.Stats.CorruptedSizeByType)
+	s.Equal(map[invariant.Name]int64{invariant.OrphanedHistoryBranches: 30}, result.DomainStats["domain1"].CorruptedSizeByType)
+	s.Equal(map[invariant.Name]int64{invariant.OrphanedHistoryBranches: 40}, result.DomainStats["domain2"].CorruptedSizeByType)
+}
+
 func (s *ScannerSuite) TestGetDomainIDFromEntity() {
 	scanner := &ShardScanner{}
 
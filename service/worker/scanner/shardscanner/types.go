@@ -146,6 +146,8 @@ type (
 		CorruptedCount   int64
 		CheckFailedCount int64
 		CorruptionByType map[invariant.Name]int64
+		// CorruptedSizeByType is the size in bytes of the corrupted data, for the invariants measuring it
+		CorruptedSizeByType map[invariant.Name]int64 `json:",omitempty"`
 	}
 
 	// ScanResult indicates the result of running scan on a shard.
@@ -521,6 +523,17 @@ func GetFixerContext(
 	return val, nil
 }
 
+// addCorruptedSize adds the size of the data found corrupted by an invariant, if it measured it
+func (s *ScanStats) addCorruptedSize(invariantName invariant.Name, size int64) {
+	if size <= 0 {
+		return
+	}
+	if s.CorruptedSizeByType == nil {
+		s.CorruptedSizeByType = make(map[invariant.Name]int64)
+	}
+	s.CorruptedSizeByType[invariantName] += size
+}
+
 // Empty returns true if this ScanResult has no "real" data, e.g. only nils or empty values.
 func (s *ScanResult) Empty() bool {
 	if s == nil {
@@ -0,0 +1,238 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/batcher"
+)
+
+// schedulerIdentity is reported as the caller identity on requests the
+// scheduler makes on behalf of a schedule.
+const schedulerIdentity = "cadence-scheduler"
+
+// batchDecisionTimeout is the decision timeout of batch jobs started by a
+// BatchOperation action, matching the CLI's default for batch jobs.
+const batchDecisionTimeout = 60 * time.Second
+
+// ProcessActionRequest is the input to processScheduleActionActivity. It is
+// used for the actions other than StartWorkflow, which do not go through the
+// overlap policy: each fire signals or starts a batch job unconditionally.
+type ProcessActionRequest struct {
+	Domain         string                      `json:"domain"`
+	ScheduleID     string                      `json:"scheduleId"`
+	ScheduledTime  time.Time                   `json:"scheduledTime"`
+	TriggerSource  TriggerSource               `json:"triggerSource"`
+	SignalWorkflow *types.SignalWorkflowAction `json:"signalWorkflow,omitempty"`
+	BatchOperation *types.BatchOperationAction `json:"batchOperation,omitempty"`
+}
+
+// processScheduleActionActivity performs a SignalWorkflow or BatchOperation
+// action for a single fire. RequestIDs are derived from the scheduled time so
+// local-activity retries are de-duplicated by the server. The returned
+// StartedWorkflow identifies the signalled workflow or the batch job.
+func processScheduleActionActivity(ctx context.Context, req ProcessActionRequest) (result *ProcessFireResult, err error) {
+	sc, ok := ctx.Value(schedulerContextKey).(schedulerContext)
+	if !ok {
+		return nil, fmt.Errorf("scheduler context not found in activity context")
+	}
+
+	scope := sc.MetricsClient.Scope(metrics.SchedulerActivityScope, metrics.DomainTag(req.Domain))
+	defer func() {
+		if err != nil {
+			scope.IncCounter(metrics.SchedulerFireErrorCountPerDomain)
+		}
+	}()
+
+	switch {
+	case req.SignalWorkflow != nil:
+		result, err = signalScheduledWorkflow(ctx, sc, req)
+	case req.BatchOperation != nil:
+		result, err = startScheduledBatchOperation(ctx, sc, req)
+	default:
+		return nil, fmt.Errorf("schedule action has no SignalWorkflow or BatchOperation configuration")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if result.TotalDelta > 0 {
+		scope.Tagged(metrics.TriggerSourceTag(string(req.TriggerSource))).IncCounter(metrics.SchedulerFireStartedCountPerDomain)
+	}
+	return result, nil
+}
+
+func signalScheduledWorkflow(ctx context.Context, sc schedulerContext, req ProcessActionRequest) (*ProcessFireResult, error) {
+	action := req.SignalWorkflow
+	requestID := generateRequestID(req.ScheduleID, req.ScheduledTime.UnixNano(), req.TriggerSource)
+
+	sw := action.StartWorkflow
+	if sw == nil {
+		err := sc.FrontendClient.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+			Domain:            req.Domain,
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: action.WorkflowID},
+			SignalName:        action.SignalName,
+			Input:             action.SignalInput,
+			Identity:          schedulerIdentity,
+			RequestID:         requestID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to signal workflow: %w", err)
+		}
+		return &ProcessFireResult{
+			TotalDelta:      1,
+			StartedWorkflow: &RunningWorkflowInfo{WorkflowID: action.WorkflowID},
+		}, nil
+	}
+
+	resp, err := sc.FrontendClient.SignalWithStartWorkflowExecution(ctx, &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              req.Domain,
+		WorkflowID:                          action.WorkflowID,
+		WorkflowType:                        sw.WorkflowType,
+		TaskList:                            sw.TaskList,
+		Input:                               sw.Input,
+		ExecutionStartToCloseTimeoutSeconds: sw.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      sw.TaskStartToCloseTimeoutSeconds,
+		Identity:                            schedulerIdentity,
+		RequestID:                           requestID,
+		SignalName:                          action.SignalName,
+		SignalInput:                         action.SignalInput,
+		RetryPolicy:                         sw.RetryPolicy,
+		Memo:                                sw.Memo,
+		SearchAttributes:                    sw.SearchAttributes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to signal-with-start workflow: %w", err)
+	}
+	return &ProcessFireResult{
+		TotalDelta:      1,
+		StartedWorkflow: &RunningWorkflowInfo{WorkflowID: action.WorkflowID, RunID: resp.GetRunID()},
+	}, nil
+}
+
+// startScheduledBatchOperation starts a batcher workflow in the batcher system
+// domain. The job's WorkflowID includes the schedule's domain because all
+// schedules share that domain.
+func startScheduledBatchOperation(ctx context.Context, sc schedulerContext, req ProcessActionRequest) (*ProcessFireResult, error) {
+	params, err := buildBatchParams(req.Domain, req.BatchOperation)
+	if err != nil {
+		return nil, err
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode batch parameters: %w", err)
+	}
+	memo, err := encodeJSONFields(map[string]interface{}{"Reason": params.Reason})
+	if err != nil {
+		return nil, err
+	}
+	searchAttributes, err := encodeJSONFields(map[string]interface{}{
+		"CustomDomain": req.Domain,
+		"Operator":     schedulerIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workflowID := generateWorkflowID(batchWorkflowIDPrefix(req.Domain, req.ScheduleID), req.ScheduleID, req.ScheduledTime)
+	executionTimeout := int32(batcher.InfiniteDuration.Seconds())
+	decisionTimeout := int32(batchDecisionTimeout.Seconds())
+	resp, err := sc.FrontendClient.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              constants.BatcherLocalDomainName,
+		WorkflowID:                          workflowID,
+		WorkflowType:                        &types.WorkflowType{Name: batcher.BatchWFTypeName},
+		TaskList:                            &types.TaskList{Name: batcher.BatcherTaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: &executionTimeout,
+		TaskStartToCloseTimeoutSeconds:      &decisionTimeout,
+		Identity:                            schedulerIdentity,
+		RequestID:                           generateRequestID(req.ScheduleID, req.ScheduledTime.UnixNano(), req.TriggerSource),
+		Memo:                                &types.Memo{Fields: memo},
+		SearchAttributes:                    &types.SearchAttributes{IndexedFields: searchAttributes},
+	})
+	if err != nil {
+		var alreadyStarted *types.WorkflowExecutionAlreadyStartedError
+		if errors.As(err, &alreadyStarted) {
+			return &ProcessFireResult{
+				SkippedDelta:    1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: workflowID, RunID: alreadyStarted.RunID},
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to start batch operation: %w", err)
+	}
+	return &ProcessFireResult{
+		TotalDelta:      1,
+		StartedWorkflow: &RunningWorkflowInfo{WorkflowID: workflowID, RunID: resp.GetRunID()},
+	}, nil
+}
+
+// buildBatchParams converts a BatchOperationAction into batcher workflow
+// parameters for domain. Unset tuning knobs are left to the batcher defaults.
+func buildBatchParams(domain string, action *types.BatchOperationAction) (batcher.BatchParams, error) {
+	params := batcher.BatchParams{
+		DomainName:  domain,
+		Query:       action.GetQuery(),
+		Reason:      action.GetReason(),
+		RPS:         int(action.GetRPS()),
+		Concurrency: int(action.GetConcurrency()),
+	}
+	if params.Reason == "" {
+		params.Reason = "scheduled batch operation"
+	}
+	switch action.GetOperationType() {
+	case types.ScheduleBatchOperationTypeTerminate:
+		params.BatchType = batcher.BatchTypeTerminate
+	case types.ScheduleBatchOperationTypeCancel:
+		params.BatchType = batcher.BatchTypeCancel
+	case types.ScheduleBatchOperationTypeSignal:
+		params.BatchType = batcher.BatchTypeSignal
+		params.SignalParams = batcher.SignalParams{
+			SignalName: action.GetSignalName(),
+			Input:      action.GetSignalInput(),
+		}
+	default:
+		return params, fmt.Errorf("unsupported batch operation type %v", action.GetOperationType())
+	}
+	return params, nil
+}
+
+func batchWorkflowIDPrefix(domain, scheduleID string) string {
+	return fmt.Sprintf("%s-%s-batch", domain, scheduleID)
+}
+
+func encodeJSONFields(values map[string]interface{}) (map[string][]byte, error) {
+	fields := make(map[string][]byte, len(values))
+	for k, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", k, err)
+		}
+		fields[k] = data
+	}
+	return fields, nil
+}
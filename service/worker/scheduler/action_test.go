@@ -0,0 +1,297 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/batcher"
+)
+
+func TestProcessScheduleActionActivity(t *testing.T) {
+	scheduledTime := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	batchWfID := "test-domain-sched-1-batch-" + formatTime(scheduledTime)
+
+	signalReq := ProcessActionRequest{
+		Domain:        "test-domain",
+		ScheduleID:    "sched-1",
+		ScheduledTime: scheduledTime,
+		TriggerSource: TriggerSourceSchedule,
+		SignalWorkflow: &types.SignalWorkflowAction{
+			WorkflowID:  "target-wf",
+			SignalName:  "refresh",
+			SignalInput: []byte(`"now"`),
+		},
+	}
+	signalWithStartReq := signalReq
+	signalWithStartReq.SignalWorkflow = &types.SignalWorkflowAction{
+		WorkflowID: "target-wf",
+		SignalName: "refresh",
+		StartWorkflow: &types.StartWorkflowAction{
+			WorkflowType: &types.WorkflowType{Name: "my-workflow"},
+			TaskList:     &types.TaskList{Name: "my-tasklist"},
+		},
+	}
+	batchReq := ProcessActionRequest{
+		Domain:        "test-domain",
+		ScheduleID:    "sched-1",
+		ScheduledTime: scheduledTime,
+		TriggerSource: TriggerSourceSchedule,
+		BatchOperation: &types.BatchOperationAction{
+			OperationType: types.ScheduleBatchOperationTypeTerminate,
+			Query:         "CloseTime = missing",
+			Reason:        "nightly cleanup",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		req        ProcessActionRequest
+		setupMock  func(m *frontend.MockClient)
+		wantResult *ProcessFireResult
+		wantErr    bool
+		noContext  bool
+	}{
+		{
+			name: "signals existing workflow",
+			req:  signalReq,
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.SignalWorkflowExecutionRequest, _ ...interface{}) error {
+						assert.Equal(t, "test-domain", req.Domain)
+						assert.Equal(t, "target-wf", req.WorkflowExecution.GetWorkflowID())
+						assert.Equal(t, "refresh", req.SignalName)
+						assert.Equal(t, []byte(`"now"`), req.Input)
+						assert.NotEmpty(t, req.RequestID)
+						return nil
+					})
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "target-wf"},
+			},
+		},
+		{
+			name: "signal failure returns error",
+			req:  signalReq,
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.EntityNotExistsError{Message: "workflow not found"})
+			},
+			wantErr: true,
+		},
+		{
+			name: "signal-with-start returns run ID",
+			req:  signalWithStartReq,
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.SignalWithStartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, "target-wf", req.WorkflowID)
+						assert.Equal(t, "my-workflow", req.WorkflowType.GetName())
+						assert.Equal(t, "my-tasklist", req.TaskList.GetName())
+						assert.Equal(t, "refresh", req.SignalName)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-sws"}, nil
+					})
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "target-wf", RunID: "run-sws"},
+			},
+		},
+		{
+			name: "starts batch job in batcher domain",
+			req:  batchReq,
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...interface{}) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, constants.BatcherLocalDomainName, req.Domain)
+						assert.Equal(t, batchWfID, req.WorkflowID)
+						assert.Equal(t, batcher.BatchWFTypeName, req.WorkflowType.GetName())
+						assert.Equal(t, batcher.BatcherTaskListName, req.TaskList.GetName())
+
+						var params batcher.BatchParams
+						require.NoError(t, json.Unmarshal(req.Input, &params))
+						assert.Equal(t, "test-domain", params.DomainName)
+						assert.Equal(t, batcher.BatchTypeTerminate, params.BatchType)
+						assert.Equal(t, "CloseTime = missing", params.Query)
+						assert.Equal(t, "nightly cleanup", params.Reason)
+
+						var domain string
+						require.NoError(t, json.Unmarshal(req.SearchAttributes.IndexedFields["CustomDomain"], &domain))
+						assert.Equal(t, "test-domain", domain)
+						return &types.StartWorkflowExecutionResponse{RunID: "run-batch"}, nil
+					})
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: batchWfID, RunID: "run-batch"},
+			},
+		},
+		{
+			name: "batch job already started is skipped",
+			req:  batchReq,
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.WorkflowExecutionAlreadyStartedError{RunID: "run-existing"})
+			},
+			wantResult: &ProcessFireResult{
+				SkippedDelta:    1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: batchWfID, RunID: "run-existing"},
+			},
+		},
+		{
+			name: "batch start failure returns error",
+			req:  batchReq,
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid batch type returns error",
+			req: func() ProcessActionRequest {
+				r := batchReq
+				r.BatchOperation = &types.BatchOperationAction{Query: "q"}
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {},
+			wantErr:   true,
+		},
+		{
+			name: "no action returns error",
+			req: ProcessActionRequest{
+				Domain:     "test-domain",
+				ScheduleID: "sched-1",
+			},
+			setupMock: func(m *frontend.MockClient) {},
+			wantErr:   true,
+		},
+		{
+			name:      "missing context returns error",
+			req:       signalReq,
+			noContext: true,
+			setupMock: func(m *frontend.MockClient) {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := frontend.NewMockClient(ctrl)
+			tc.setupMock(mockClient)
+
+			var ctx context.Context
+			if tc.noContext {
+				ctx = context.Background()
+			} else {
+				ctx = context.WithValue(context.Background(), schedulerContextKey, schedulerContext{
+					FrontendClient: mockClient,
+					MetricsClient:  metrics.NewNoopMetricsClient(),
+				})
+			}
+
+			result, err := processScheduleActionActivity(ctx, tc.req)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+		})
+	}
+}
+
+func TestBuildBatchParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		action  *types.BatchOperationAction
+		want    batcher.BatchParams
+		wantErr bool
+	}{
+		{
+			name: "cancel uses default reason",
+			action: &types.BatchOperationAction{
+				OperationType: types.ScheduleBatchOperationTypeCancel,
+				Query:         "WorkflowType = 'stale'",
+				RPS:           10,
+				Concurrency:   2,
+			},
+			want: batcher.BatchParams{
+				DomainName:  "test-domain",
+				Query:       "WorkflowType = 'stale'",
+				Reason:      "scheduled batch operation",
+				BatchType:   batcher.BatchTypeCancel,
+				RPS:         10,
+				Concurrency: 2,
+			},
+		},
+		{
+			name: "signal carries signal parameters",
+			action: &types.BatchOperationAction{
+				OperationType: types.ScheduleBatchOperationTypeSignal,
+				Query:         "q",
+				Reason:        "poke",
+				SignalName:    "wake",
+				SignalInput:   `{"a":1}`,
+			},
+			want: batcher.BatchParams{
+				DomainName: "test-domain",
+				Query:      "q",
+				Reason:     "poke",
+				BatchType:  batcher.BatchTypeSignal,
+				SignalParams: batcher.SignalParams{
+					SignalName: "wake",
+					Input:      `{"a":1}`,
+				},
+			},
+		},
+		{
+			name:    "invalid type",
+			action:  &types.BatchOperationAction{Query: "q"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := buildBatchParams("test-domain", tc.action)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -76,6 +76,26 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 		policy = types.ScheduleOverlapPolicySkipNew
 	}
 
+	// Under PauseOnFailure, find out how the previous run ended before applying
+	// the overlap policy. A run reported closed here is known not to be running.
+	lastRunClosed := false
+	if req.CheckLastRunOutcome && req.LastStartedWorkflow != nil {
+		outcome, err := describeRunOutcome(ctx, sc.FrontendClient, req.Domain, req.LastStartedWorkflow)
+		if err != nil {
+			return nil, err
+		}
+		if outcome != nil {
+			lastRunClosed = true
+			result.LastRunOutcome = outcome
+			if outcome.failed() && req.PauseIfLastRunFailed {
+				scope.IncCounter(metrics.SchedulerPausedOnFailureCountPerDomain)
+				result.PausedOnFailure = true
+				result.SkippedDelta = 1
+				return result, nil
+			}
+		}
+	}
+
 	// Bounded CONCURRENT: describe each tracked in-flight workflow, prune
 	// completed entries, and enforce the cap. When under the cap, falls through
 	// to the shared start block; stillRunning is used there to build
@@ -102,7 +122,7 @@ func processScheduleFireActivity(ctx context.Context, req ProcessFireRequest) (r
 		}
 	}
 
-	if policy != types.ScheduleOverlapPolicyConcurrent && req.LastStartedWorkflow != nil {
+	if policy != types.ScheduleOverlapPolicyConcurrent && req.LastStartedWorkflow != nil && !lastRunClosed {
 		running, err := isWorkflowRunning(ctx, sc.FrontendClient, req.Domain, req.LastStartedWorkflow)
 		if err != nil {
 			return nil, err
@@ -231,6 +251,57 @@ func isWorkflowRunning(ctx context.Context, client frontend.Client, domain strin
 	return running, nil
 }
 
+// describeRunOutcome returns how the workflow closed, or nil when it is still
+// running or no longer exists. For failed and timed-out runs the failure
+// reason is read from the close event.
+func describeRunOutcome(ctx context.Context, client frontend.Client, domain string, wf *RunningWorkflowInfo) (*RunOutcome, error) {
+	execution := &types.WorkflowExecution{
+		WorkflowID: wf.WorkflowID,
+		RunID:      wf.RunID,
+	}
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    domain,
+		Execution: execution,
+	})
+	if err != nil {
+		if isEntityNotExistsError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to describe workflow: %w", err)
+	}
+	if resp.WorkflowExecutionInfo == nil || resp.WorkflowExecutionInfo.CloseStatus == nil {
+		return nil, nil
+	}
+
+	outcome := &RunOutcome{
+		WorkflowID:  wf.WorkflowID,
+		RunID:       wf.RunID,
+		CloseStatus: *resp.WorkflowExecutionInfo.CloseStatus,
+	}
+	if !outcome.failed() {
+		return outcome, nil
+	}
+
+	history, err := client.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 domain,
+		Execution:              execution,
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+		SkipArchival:           true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow close event: %w", err)
+	}
+	for _, event := range history.GetHistory().GetEvents() {
+		if attr := event.WorkflowExecutionFailedEventAttributes; attr != nil {
+			outcome.FailureReason = attr.GetReason()
+		}
+		if attr := event.WorkflowExecutionTimedOutEventAttributes; attr != nil {
+			outcome.FailureReason = fmt.Sprintf("timeout: %s", attr.GetTimeoutType())
+		}
+	}
+	return outcome, nil
+}
+
 // Cancel is cooperative: the previous workflow receives a cancellation signal
 // but may continue running while it handles cleanup. A brief overlap with the
 // new run is expected. Use TERMINATE_PREVIOUS for a hard guarantee of no
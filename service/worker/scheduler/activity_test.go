@@ -647,6 +647,146 @@ func TestProcessScheduleFireActivity(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "PauseOnFailure reports successful previous run and starts",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.LastStartedWorkflow = &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"}
+				r.CheckLastRunOutcome = true
+				r.PauseIfLastRunFailed = true
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				status := types.WorkflowExecutionCloseStatusCompleted
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: &status},
+					}, nil).Times(1)
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.StartWorkflowExecutionResponse{RunID: "run-new"}, nil)
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: expectedWfID, RunID: "run-new"},
+				LastRunOutcome: &RunOutcome{
+					WorkflowID:  "old-wf",
+					RunID:       "old-run",
+					CloseStatus: types.WorkflowExecutionCloseStatusCompleted,
+				},
+			},
+		},
+		{
+			name: "PauseOnFailure below threshold reports failure and starts",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.LastStartedWorkflow = &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"}
+				r.CheckLastRunOutcome = true
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				status := types.WorkflowExecutionCloseStatusTimedOut
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: &status},
+					}, nil)
+				m.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+					Return(&types.GetWorkflowExecutionHistoryResponse{
+						History: &types.History{Events: []*types.HistoryEvent{{
+							WorkflowExecutionTimedOutEventAttributes: &types.WorkflowExecutionTimedOutEventAttributes{
+								TimeoutType: types.TimeoutTypeStartToClose.Ptr(),
+							},
+						}}},
+					}, nil)
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.StartWorkflowExecutionResponse{RunID: "run-new"}, nil)
+			},
+			wantResult: &ProcessFireResult{
+				TotalDelta:      1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: expectedWfID, RunID: "run-new"},
+				LastRunOutcome: &RunOutcome{
+					WorkflowID:    "old-wf",
+					RunID:         "old-run",
+					CloseStatus:   types.WorkflowExecutionCloseStatusTimedOut,
+					FailureReason: "timeout: START_TO_CLOSE",
+				},
+			},
+		},
+		{
+			name: "PauseOnFailure at threshold skips the fire when previous run failed",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.LastStartedWorkflow = &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"}
+				r.CheckLastRunOutcome = true
+				r.PauseIfLastRunFailed = true
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				status := types.WorkflowExecutionCloseStatusFailed
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: &status},
+					}, nil)
+				m.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.GetWorkflowExecutionHistoryRequest, _ ...interface{}) (*types.GetWorkflowExecutionHistoryResponse, error) {
+						assert.Equal(t, types.HistoryEventFilterTypeCloseEvent, req.GetHistoryEventFilterType())
+						reason := "boom"
+						return &types.GetWorkflowExecutionHistoryResponse{
+							History: &types.History{Events: []*types.HistoryEvent{{
+								WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{Reason: &reason},
+							}}},
+						}, nil
+					})
+			},
+			wantResult: &ProcessFireResult{
+				SkippedDelta:    1,
+				PausedOnFailure: true,
+				LastRunOutcome: &RunOutcome{
+					WorkflowID:    "old-wf",
+					RunID:         "old-run",
+					CloseStatus:   types.WorkflowExecutionCloseStatusFailed,
+					FailureReason: "boom",
+				},
+			},
+		},
+		{
+			name: "PauseOnFailure with previous run still running falls through to overlap policy",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.LastStartedWorkflow = &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"}
+				r.CheckLastRunOutcome = true
+				r.PauseIfLastRunFailed = true
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: nil},
+					}, nil).Times(2)
+			},
+			wantResult: &ProcessFireResult{
+				SkippedDelta:    1,
+				StartedWorkflow: &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"},
+			},
+		},
+		{
+			name: "PauseOnFailure close event error is retried",
+			req: func() ProcessFireRequest {
+				r := baseReq
+				r.LastStartedWorkflow = &RunningWorkflowInfo{WorkflowID: "old-wf", RunID: "old-run"}
+				r.CheckLastRunOutcome = true
+				return r
+			}(),
+			setupMock: func(m *frontend.MockClient) {
+				status := types.WorkflowExecutionCloseStatusFailed
+				m.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: &status},
+					}, nil)
+				m.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
 		{
 			name:      "missing context returns error",
 			req:       baseReq,
@@ -116,6 +116,46 @@ func countCronFires(sched cron.Schedule, start, end time.Time, spec types.Schedu
 	return count, true
 }
 
+// MatchingTimes is the result of ListMatchingTimes.
+type MatchingTimes struct {
+	// Times holds the fire times in ascending order, at most maxTimes of them.
+	Times []time.Time
+	// TotalCount is the number of fire times in the range, capped at
+	// maxBackfillRunsTotalCount.
+	TotalCount int
+	// TotalCountCapped is true when the range holds more fires than the cap.
+	TotalCountCapped bool
+}
+
+// ListMatchingTimes returns the fire times of spec within [start, end] using
+// the same next-fire computation as backfill, so a preview matches what
+// BackfillSchedule would fire for the same range. Spec.StartTime and
+// Spec.EndTime bound the result just as they bound a running schedule.
+func ListMatchingTimes(spec types.ScheduleSpec, start, end time.Time, maxTimes int) (*MatchingTimes, error) {
+	sched, err := ParseScheduleSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	// Backfill treats the start of its range as inclusive by walking from
+	// one second before it; do the same here.
+	from := start.Add(-time.Second)
+	total, capped := countCronFires(sched, from, end, spec, maxBackfillRunsTotalCount)
+	result := &MatchingTimes{
+		TotalCount:       total,
+		TotalCountCapped: capped,
+	}
+	t := from
+	for len(result.Times) < maxTimes {
+		next := computeNextRunTime(sched, t, spec)
+		if next.IsZero() || next.After(end) {
+			break
+		}
+		result.Times = append(result.Times, next)
+		t = next
+	}
+	return result, nil
+}
+
 // processBackfills drains pending backfill requests from state, computing
 // cron fire times for each request's time range and executing them.
 // Returns true when more backfill work remains (budget exhausted or scan cap
@@ -161,6 +201,13 @@ func processBackfills(ctx workflow.Context, logger *zap.Logger, scope tally.Scop
 		fires := computeMissedFireTimes(sched, bf.StartTime.Add(-time.Second), bf.EndTime, input.Spec)
 
 		for _, t := range fires.times {
+			if state.Paused {
+				// PauseOnFailure paused the schedule mid-backfill; the
+				// remaining fires run after unpause.
+				bf.StartTime = t
+				scope.Counter(SchedulerBackfillFiredCountPerDomain).Inc(int64(fired))
+				return true
+			}
 			if *budget <= 0 {
 				bf.StartTime = t
 				logger.Info("activity budget exhausted mid-backfill, continuing after ContinueAsNew",
@@ -0,0 +1,237 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// cronStarBit mirrors the unexported starBit in robfig/cron. When it is set
+	// on either Dom or Dow, SpecSchedule.Next matches days with AND instead of
+	// the traditional cron OR, which is the semantic calendar specs expose.
+	cronStarBit = 1 << 63
+
+	// maxExcludedCandidates bounds how many consecutive excluded fire times
+	// compositeSchedule.Next skips before giving up. It covers e.g. a
+	// per-minute schedule with a full month excluded (~44k candidates).
+	maxExcludedCandidates = 100000
+
+	// minScheduleInterval is the smallest accepted ScheduleIntervalSpec.Interval.
+	minScheduleInterval = time.Second
+)
+
+var calendarParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// ParseScheduleSpec compiles every fire-time source of a ScheduleSpec (cron
+// expression, calendars, intervals) and its exclusion calendars into a single
+// cron.Schedule. StartTime, EndTime and Jitter are not part of the result;
+// computeNextRunTime applies the time bounds on top of it.
+func ParseScheduleSpec(spec types.ScheduleSpec) (cron.Schedule, error) {
+	loc := time.UTC
+	if spec.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(spec.Timezone); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", spec.Timezone, err)
+		}
+	}
+
+	var includes []cron.Schedule
+	if spec.CronExpression != "" {
+		expr := spec.CronExpression
+		if spec.Timezone != "" && !hasCronTimezonePrefix(expr) {
+			expr = "CRON_TZ=" + spec.Timezone + " " + expr
+		}
+		sched, err := cron.ParseStandard(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", spec.CronExpression, err)
+		}
+		includes = append(includes, sched)
+	}
+	for i, cal := range spec.Calendars {
+		if cal == nil {
+			continue
+		}
+		sched, err := parseCalendarSpec(cal, loc, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar spec #%d: %w", i, err)
+		}
+		includes = append(includes, sched)
+	}
+	for i, iv := range spec.Intervals {
+		if iv == nil {
+			continue
+		}
+		sched, err := newIntervalSchedule(iv)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval spec #%d: %w", i, err)
+		}
+		includes = append(includes, sched)
+	}
+	if len(includes) == 0 {
+		return nil, errors.New("spec must set at least one of CronExpression, Calendars or Intervals")
+	}
+
+	var excludes []*cron.SpecSchedule
+	for i, cal := range spec.ExcludeCalendars {
+		if cal == nil {
+			continue
+		}
+		sched, err := parseCalendarSpec(cal, loc, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude calendar spec #%d: %w", i, err)
+		}
+		excludes = append(excludes, sched)
+	}
+
+	if len(includes) == 1 && len(excludes) == 0 {
+		return includes[0], nil
+	}
+	return &compositeSchedule{includes: includes, excludes: excludes}, nil
+}
+
+func hasCronTimezonePrefix(expr string) bool {
+	return strings.HasPrefix(expr, "TZ=") || strings.HasPrefix(expr, "CRON_TZ=")
+}
+
+// parseCalendarSpec converts a calendar spec into a robfig SpecSchedule with
+// AND semantics for day-of-month and day-of-week. For exclusions, empty
+// time-of-day fields default to "*" so that excluding a date covers the
+// whole day rather than only its midnight.
+func parseCalendarSpec(cal *types.ScheduleCalendarSpec, loc *time.Location, exclude bool) (*cron.SpecSchedule, error) {
+	timeDefault := "0"
+	if exclude {
+		timeDefault = "*"
+	}
+	fields := []string{
+		calendarField(cal.Second, timeDefault),
+		calendarField(cal.Minute, timeDefault),
+		calendarField(cal.Hour, timeDefault),
+		calendarField(cal.DayOfMonth, "*"),
+		calendarField(cal.Month, "*"),
+		calendarField(cal.DayOfWeek, "*"),
+	}
+	parsed, err := calendarParser.Parse(strings.Join(fields, " "))
+	if err != nil {
+		return nil, err
+	}
+	sched, ok := parsed.(*cron.SpecSchedule)
+	if !ok {
+		return nil, fmt.Errorf("unexpected schedule type %T", parsed)
+	}
+	sched.Dom |= cronStarBit
+	sched.Location = loc
+	return sched, nil
+}
+
+func calendarField(v, def string) string {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return def
+	}
+	if strings.ContainsAny(v, " \t") {
+		// Keep the field count stable for the parser; a space inside a field
+		// would otherwise shift every following field.
+		return strings.Join(strings.Fields(v), ",")
+	}
+	return v
+}
+
+// calendarMatches reports whether t falls on a second matched by sched.
+func calendarMatches(sched *cron.SpecSchedule, t time.Time) bool {
+	sec := t.Truncate(time.Second)
+	return sched.Next(sec.Add(-time.Second)).Equal(sec)
+}
+
+// intervalSchedule fires at every multiple of interval after the Unix epoch,
+// shifted by phase.
+type intervalSchedule struct {
+	interval time.Duration
+	phase    time.Duration
+}
+
+var unixEpoch = time.Unix(0, 0).UTC()
+
+func newIntervalSchedule(iv *types.ScheduleIntervalSpec) (*intervalSchedule, error) {
+	if iv.Interval < minScheduleInterval {
+		return nil, fmt.Errorf("interval must be at least %s, got %s", minScheduleInterval, iv.Interval)
+	}
+	if iv.Phase < 0 || iv.Phase >= iv.Interval {
+		return nil, fmt.Errorf("phase must be in [0, %s), got %s", iv.Interval, iv.Phase)
+	}
+	return &intervalSchedule{interval: iv.Interval, phase: iv.Phase}, nil
+}
+
+// Next returns the first grid point strictly after t.
+func (s *intervalSchedule) Next(t time.Time) time.Time {
+	offset := t.Sub(unixEpoch) - s.phase
+	n := offset / s.interval
+	if offset < 0 && offset%s.interval != 0 {
+		n--
+	}
+	return unixEpoch.Add(s.phase + (n+1)*s.interval)
+}
+
+// compositeSchedule fires at the earliest time produced by any of its
+// includes that is not matched by any of its excludes.
+type compositeSchedule struct {
+	includes []cron.Schedule
+	excludes []*cron.SpecSchedule
+}
+
+// Next returns the next non-excluded fire time after t, or the zero time when
+// none of the includes fire again or maxExcludedCandidates consecutive
+// candidates were all excluded.
+func (s *compositeSchedule) Next(t time.Time) time.Time {
+	for i := 0; i < maxExcludedCandidates; i++ {
+		var next time.Time
+		for _, sched := range s.includes {
+			candidate := sched.Next(t)
+			if candidate.IsZero() {
+				continue
+			}
+			if next.IsZero() || candidate.Before(next) {
+				next = candidate
+			}
+		}
+		if next.IsZero() || !s.excluded(next) {
+			return next
+		}
+		t = next
+	}
+	return time.Time{}
+}
+
+func (s *compositeSchedule) excluded(t time.Time) bool {
+	for _, ex := range s.excludes {
+		if calendarMatches(ex, t) {
+			return true
+		}
+	}
+	return false
+}
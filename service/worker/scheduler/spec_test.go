@@ -0,0 +1,242 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestParseScheduleSpec(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		spec    types.ScheduleSpec
+		want    []time.Time
+		wantErr bool
+	}{
+		{
+			name: "cron expression only",
+			spec: types.ScheduleSpec{CronExpression: "0 * * * *"},
+			want: []time.Time{
+				time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "interval aligned to the epoch",
+			spec: types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute}}},
+			want: []time.Time{
+				time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 13, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "interval with phase",
+			spec: types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour, Phase: 15 * time.Minute}}},
+			want: []time.Time{
+				time.Date(2026, 1, 15, 11, 15, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 12, 15, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "calendar matches day of month AND day of week",
+			spec: types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{
+				{Hour: "9", DayOfMonth: "8-14", DayOfWeek: "TUE"},
+			}},
+			// second Tuesday of February and March; plain cron would also fire on Jan 20
+			want: []time.Time{
+				time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "multiple sources are merged in order",
+			spec: types.ScheduleSpec{
+				CronExpression: "0 12 * * *",
+				Calendars:      []*types.ScheduleCalendarSpec{{Hour: "11"}},
+			},
+			want: []time.Time{
+				time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 16, 11, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "exclude calendar skips the whole day",
+			spec: types.ScheduleSpec{
+				CronExpression:   "0 9 * * *",
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{{Month: "1", DayOfMonth: "16"}},
+			},
+			want: []time.Time{
+				time.Date(2026, 1, 17, 9, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 18, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "timezone applies to cron expression",
+			spec: types.ScheduleSpec{CronExpression: "0 9 * * *", Timezone: "America/New_York"},
+			want: []time.Time{
+				time.Date(2026, 1, 15, 14, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 16, 14, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "timezone applies to calendars",
+			spec: types.ScheduleSpec{
+				Calendars: []*types.ScheduleCalendarSpec{{Hour: "9"}},
+				Timezone:  "Asia/Tokyo",
+			},
+			want: []time.Time{
+				time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 17, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "explicit CRON_TZ prefix wins over timezone",
+			spec: types.ScheduleSpec{CronExpression: "CRON_TZ=UTC 0 9 * * *", Timezone: "America/New_York"},
+			want: []time.Time{
+				time.Date(2026, 1, 16, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "no fire time source",
+			spec:    types.ScheduleSpec{ExcludeCalendars: []*types.ScheduleCalendarSpec{{Hour: "9"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cron expression",
+			spec:    types.ScheduleSpec{CronExpression: "not a cron"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timezone",
+			spec:    types.ScheduleSpec{CronExpression: "0 * * * *", Timezone: "Not/AZone"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid calendar field",
+			spec:    types.ScheduleSpec{Calendars: []*types.ScheduleCalendarSpec{{Hour: "25"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid exclude calendar field",
+			spec:    types.ScheduleSpec{CronExpression: "0 * * * *", ExcludeCalendars: []*types.ScheduleCalendarSpec{{Month: "13"}}},
+			wantErr: true,
+		},
+		{
+			name:    "interval below minimum",
+			spec:    types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Millisecond}}},
+			wantErr: true,
+		},
+		{
+			name:    "phase not smaller than interval",
+			spec:    types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Hour, Phase: time.Hour}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := ParseScheduleSpec(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			cur := now
+			for _, want := range tt.want {
+				cur = sched.Next(cur)
+				assert.True(t, want.Equal(cur), "want %v, got %v", want, cur)
+			}
+		})
+	}
+}
+
+func TestIntervalScheduleNext(t *testing.T) {
+	sched := &intervalSchedule{interval: time.Hour}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{
+			name: "on a grid point returns the following one",
+			t:    time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "between grid points",
+			t:    time.Date(2026, 1, 15, 10, 0, 0, 1, time.UTC),
+			want: time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "before the epoch",
+			t:    unixEpoch.Add(-time.Second),
+			want: unixEpoch,
+		},
+		{
+			name: "on a grid point before the epoch",
+			t:    unixEpoch.Add(-time.Hour),
+			want: unixEpoch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sched.Next(tt.t))
+		})
+	}
+}
+
+func TestCompositeScheduleAllExcluded(t *testing.T) {
+	sched, err := ParseScheduleSpec(types.ScheduleSpec{
+		CronExpression:   "* * * * *",
+		ExcludeCalendars: []*types.ScheduleCalendarSpec{{}},
+	})
+	require.NoError(t, err)
+	assert.True(t, sched.Next(time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)).IsZero())
+}
+
+func TestComputeNextRunTimeWithIntervalSpec(t *testing.T) {
+	spec := types.ScheduleSpec{
+		Intervals: []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute}},
+		StartTime: time.Date(2026, 1, 15, 11, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 1, 15, 13, 0, 0, 0, time.UTC),
+	}
+	sched, err := ParseScheduleSpec(spec)
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC),
+		computeNextRunTime(sched, time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), spec))
+	assert.True(t, computeNextRunTime(sched, time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC), spec).IsZero())
+
+	missed := computeMissedFireTimes(sched, time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 15, 12, 30, 0, 0, time.UTC), spec)
+	assert.Equal(t, []time.Time{time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)}, missed.times)
+}
@@ -36,6 +36,7 @@ const (
 	SignalNameUpdate   = "scheduler-update"
 	SignalNameBackfill = "scheduler-backfill"
 	SignalNameDelete   = "scheduler-delete"
+	SignalNameTrigger  = "scheduler-trigger"
 
 	QueryTypeDescribe = "scheduler-describe"
 
@@ -103,6 +104,7 @@ const (
 	signalTypeTagUpdate   = "update"
 	signalTypeTagBackfill = "backfill"
 	signalTypeTagDelete   = "delete"
+	signalTypeTagTrigger  = "trigger"
 
 	// Search attribute keys set on target workflows started by the scheduler.
 	// The string values are defined in common/definition to make them part of
@@ -114,10 +116,12 @@ const (
 
 	// Search attribute keys set on the scheduler workflow itself for ListSchedules.
 	// CadenceScheduleState is a Keyword SA holding the current lifecycle state
-	// ("active" or "paused"). Modeled as a string rather than a boolean so it can
-	// be extended to additional states (e.g. "expired") without introducing new
-	// search attributes. "Deleted" is not a value because a deleted schedule's
-	// workflow is closed and filtered by workflow status instead.
+	// ("active", "paused" or "completed"). Modeled as a string rather than a
+	// boolean so it can be extended to additional states without introducing
+	// new search attributes. "completed" is written just before the scheduler
+	// workflow closes because it ran out of actions or passed its end time.
+	// "Deleted" is not a value because a deleted schedule's workflow is closed
+	// and filtered by workflow status instead.
 	SearchAttrScheduleState = definition.CadenceScheduleState
 	// CadenceScheduleCron holds the current cron expression so ListSchedules
 	// can display it without querying each scheduler workflow. Refreshed on
@@ -127,8 +131,9 @@ const (
 	// schedule starts on each fire. Same refresh semantics as the cron SA.
 	SearchAttrScheduleWorkflowType = definition.CadenceScheduleWorkflowType
 
-	ScheduleStateActive = "active"
-	ScheduleStatePaused = "paused"
+	ScheduleStateActive    = "active"
+	ScheduleStatePaused    = "paused"
+	ScheduleStateCompleted = "completed"
 
 	maxIterationsBeforeContinueAsNew = 500
 	// maxActivitiesPerExecution is the per-execution ceiling for local-activity
@@ -137,6 +142,12 @@ const (
 	// bounded by this value before ContinueAsNew.
 	maxActivitiesPerExecution = 500
 	maxPendingBackfills       = 10
+	// maxPendingTriggers caps manual triggers queued between loop iterations.
+	// Triggers beyond the cap are dropped and logged.
+	maxPendingTriggers = 10
+	// maxRecentActions bounds SchedulerWorkflowState.RecentActions so the
+	// history carried across ContinueAsNew stays small.
+	maxRecentActions = 10
 
 	// maxBackfillRunsTotalCount caps the cron walk that populates
 	// BackfillRequest.RunsTotal. When a backfill range produces more fires
@@ -221,6 +232,29 @@ type SchedulerWorkflowState struct {
 	// PausedAt is the wall-clock time when the schedule was most recently paused.
 	// Zero when the schedule is not paused (or was never paused).
 	PausedAt time.Time `json:"pausedAt,omitempty"`
+	// PendingTriggers holds manual triggers received but not yet fired.
+	// Normally drained in the same iteration they arrive in; persisted so a
+	// trigger received alongside a state-changing signal survives ContinueAsNew.
+	PendingTriggers []TriggerRequest `json:"pendingTriggers,omitempty"`
+	// RecentActions is a bounded history of the most recent fires, oldest
+	// first, capped at maxRecentActions.
+	RecentActions []types.ScheduleActionResult `json:"recentActions,omitempty"`
+	// Completed is set just before the workflow closes because the schedule
+	// has no more actions to take. It is only observed by describe queries
+	// against the closed workflow.
+	Completed bool `json:"completed,omitempty"`
+	// ConsecutiveFailures counts started workflows that failed or timed out
+	// in a row. Only tracked under PauseOnFailure; reset by a successful run
+	// and by unpausing.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+	// LastFailure is the most recent failed run observed under PauseOnFailure.
+	LastFailure *types.ScheduleFailureInfo `json:"lastFailure,omitempty"`
+	// LastCheckedRunID is the RunID of the last started workflow whose outcome
+	// has been recorded, so the same run is never counted twice.
+	LastCheckedRunID string `json:"lastCheckedRunId,omitempty"`
+	// AutoUnpauseAt is set when the schedule paused itself on failure with a
+	// PauseOnFailureCooldown; the workflow unpauses at that time. Zero otherwise.
+	AutoUnpauseAt time.Time `json:"autoUnpauseAt,omitempty"`
 }
 
 // BufferedFire is a schedule fire queued for sequential execution by the BUFFER
@@ -268,6 +302,14 @@ type BackfillRequest struct {
 	RunsTotalComputed bool `json:"runsTotalComputed,omitempty"`
 }
 
+// TriggerRequest is a queued manual trigger. ScheduledTime is the workflow time
+// at which the trigger signal was received; it is fixed at receipt so retries
+// derive the same WorkflowID and RequestID.
+type TriggerRequest struct {
+	ScheduledTime time.Time                   `json:"scheduledTime"`
+	OverlapPolicy types.ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+}
+
 // PauseSignal is the payload sent with a pause signal.
 type PauseSignal struct {
 	Reason   string `json:"reason,omitempty"`
@@ -296,6 +338,14 @@ type BackfillSignal struct {
 	BackfillID    string                      `json:"backfillId,omitempty"`
 }
 
+// TriggerSignal is the payload sent with a trigger signal. OverlapPolicy
+// overrides the schedule's policy for this fire; zero (INVALID) means use
+// the schedule's policy.
+type TriggerSignal struct {
+	OverlapPolicy types.ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+	TriggeredBy   string                      `json:"triggeredBy,omitempty"`
+}
+
 // ScheduleDescription is the query result returned by the describe query handler.
 // It provides a snapshot of the schedule's current configuration and runtime state.
 type ScheduleDescription struct {
@@ -322,6 +372,12 @@ type ScheduleDescription struct {
 	// OngoingBackfills mirrors SchedulerWorkflowState.PendingBackfills at the
 	// time of the describe query.
 	OngoingBackfills []types.BackfillInfo `json:"ongoingBackfills,omitempty"`
+	// RecentActions mirrors SchedulerWorkflowState.RecentActions, oldest first.
+	RecentActions       []types.ScheduleActionResult `json:"recentActions,omitempty"`
+	Completed           bool                         `json:"completed,omitempty"`
+	ConsecutiveFailures int32                        `json:"consecutiveFailures,omitempty"`
+	LastFailure         *types.ScheduleFailureInfo   `json:"lastFailure,omitempty"`
+	AutoUnpauseAt       time.Time                    `json:"autoUnpauseAt,omitempty"`
 }
 
 // TriggerSource identifies what caused a schedule fire, used to differentiate
@@ -332,6 +388,7 @@ type TriggerSource string
 const (
 	TriggerSourceSchedule TriggerSource = "schedule"
 	TriggerSourceBackfill TriggerSource = "backfill"
+	TriggerSourceTrigger  TriggerSource = "trigger"
 )
 
 // fireOutcome is the result of attempting to fire a single schedule run. It
@@ -370,6 +427,27 @@ type ProcessFireRequest struct {
 	RunningWorkflows []RunningWorkflowInfo `json:"runningWorkflows,omitempty"`
 	// BackfillID is non-empty only for fires driven by a schedule backfill (matches RPC BackfillID).
 	BackfillID string `json:"backfillId,omitempty"`
+	// CheckLastRunOutcome asks the activity to report how LastStartedWorkflow
+	// closed. Set under PauseOnFailure until that run's outcome is recorded.
+	CheckLastRunOutcome bool `json:"checkLastRunOutcome,omitempty"`
+	// PauseIfLastRunFailed is set when one more failure reaches the
+	// PauseOnFailureThreshold. If LastStartedWorkflow failed, the activity
+	// does not start a new run and the workflow pauses the schedule.
+	PauseIfLastRunFailed bool `json:"pauseIfLastRunFailed,omitempty"`
+}
+
+// RunOutcome describes how a target workflow started by the scheduler closed.
+type RunOutcome struct {
+	WorkflowID    string                             `json:"workflowId"`
+	RunID         string                             `json:"runId"`
+	CloseStatus   types.WorkflowExecutionCloseStatus `json:"closeStatus"`
+	FailureReason string                             `json:"failureReason,omitempty"`
+}
+
+// failed reports whether the run counts as a failure for PauseOnFailure.
+func (o *RunOutcome) failed() bool {
+	return o.CloseStatus == types.WorkflowExecutionCloseStatusFailed ||
+		o.CloseStatus == types.WorkflowExecutionCloseStatusTimedOut
 }
 
 // ProcessFireResult is the output of processScheduleFireActivity. The workflow
@@ -386,4 +464,10 @@ type ProcessFireResult struct {
 	// ActiveWorkflows is the updated in-flight set for bounded CONCURRENT; the workflow
 	// replaces state.RunningWorkflows with it after each fire. Nil for all other policies.
 	ActiveWorkflows []RunningWorkflowInfo `json:"activeWorkflows,omitempty"`
+	// LastRunOutcome is set when CheckLastRunOutcome was requested and
+	// LastStartedWorkflow has closed.
+	LastRunOutcome *RunOutcome `json:"lastRunOutcome,omitempty"`
+	// PausedOnFailure is true when the fire was not started because the last
+	// run failed and PauseIfLastRunFailed was set.
+	PausedOnFailure bool `json:"pausedOnFailure,omitempty"`
 }
@@ -41,12 +41,13 @@ type signalChannels struct {
 	update   workflow.Channel
 	backfill workflow.Channel
 	delete   workflow.Channel
+	trigger  workflow.Channel
 }
 
 // SchedulerWorkflow is a long-running workflow that manages a single schedule.
-// It computes the next fire time from the cron expression, waits via a timer,
+// It computes the next fire time from the schedule spec, waits via a timer,
 // and dispatches the configured action. Signals control pause/unpause, update,
-// backfill, and deletion.
+// backfill, manual triggers, and deletion.
 //
 // The main loop follows a state-machine pattern: all inputs (signals and timer)
 // uniformly mutate state, and then a single decision point inspects the resulting
@@ -96,12 +97,13 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		update:   workflow.GetSignalChannel(ctx, SignalNameUpdate),
 		backfill: workflow.GetSignalChannel(ctx, SignalNameBackfill),
 		delete:   workflow.GetSignalChannel(ctx, SignalNameDelete),
+		trigger:  workflow.GetSignalChannel(ctx, SignalNameTrigger),
 	}
 
-	sched, err := cron.ParseStandard(input.Spec.CronExpression)
+	sched, err := ParseScheduleSpec(input.Spec)
 	if err != nil {
-		logger.Error("invalid cron expression, terminating", zap.String("cron", input.Spec.CronExpression), zap.Error(err))
-		return fmt.Errorf("invalid cron expression %q: %w", input.Spec.CronExpression, err)
+		logger.Error("invalid schedule spec, terminating", zap.String("cron", input.Spec.CronExpression), zap.Error(err))
+		return fmt.Errorf("invalid schedule spec: %w", err)
 	}
 
 	// activityBudget is the per-execution ceiling for local-activity dispatches.
@@ -133,6 +135,10 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 	for {
 		state.Iterations++
 
+		// Manual triggers fire regardless of pause state, mirroring an
+		// operator explicitly asking for a run now.
+		processPendingTriggers(ctx, logger, scope, &input, state)
+
 		// Drain buffered fires at the top of every iteration. If the budget is
 		// exhausted with work remaining, ContinueAsNew immediately rather than
 		// entering the blocking selector.
@@ -170,8 +176,21 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 			}
 		}
 
+		// A schedule that has used up its limited actions completes once every
+		// fire it already accepted into the BUFFER queue has been started.
+		if actionsExhausted(&input.Policies) && len(state.BufferedFires) == 0 {
+			if watcherCancel != nil {
+				watcherCancel()
+			}
+			logger.Info("schedule has no remaining actions, completing")
+			completeSchedule(ctx, logger, state)
+			return nil
+		}
+
 		// Set up timer only when not paused. When paused, applyAllInputs
-		// blocks on signals alone until an unpause or delete arrives.
+		// blocks on signals alone until an unpause or delete arrives, unless
+		// the schedule paused itself on failure with a cool-down, in which
+		// case the timer wakes it up to unpause automatically.
 		var timerFuture workflow.Future
 		var timerCancel func()
 		if !state.Paused {
@@ -179,6 +198,7 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 			nextRun := computeNextRunTime(sched, now, input.Spec)
 			if nextRun.IsZero() {
 				logger.Info("schedule has no more runs (past end time), completing")
+				completeSchedule(ctx, logger, state)
 				return nil
 			}
 			state.NextRunTime = nextRun
@@ -190,6 +210,14 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 			var timerCtx workflow.Context
 			timerCtx, timerCancel = workflow.WithCancel(ctx)
 			timerFuture = workflow.NewTimer(timerCtx, dur)
+		} else if !state.AutoUnpauseAt.IsZero() {
+			dur := state.AutoUnpauseAt.Sub(workflow.Now(ctx))
+			if dur < 0 {
+				dur = 0
+			}
+			var timerCtx workflow.Context
+			timerCtx, timerCancel = workflow.WithCancel(ctx)
+			timerFuture = workflow.NewTimer(timerCtx, dur)
 		}
 
 		previousPaused := state.Paused
@@ -203,6 +231,16 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 			watcherCancel = nil
 		}
 
+		// A timer armed while paused is the auto-unpause timer; it never
+		// fires a scheduled run.
+		if timerFired && previousPaused {
+			timerFired = false
+			if !state.AutoUnpauseAt.IsZero() &&
+				handleUnpause(logger, UnpauseSignal{Reason: "automatic unpause after failure cool-down"}, state) {
+				changed = true
+			}
+		}
+
 		if state.Paused != previousPaused {
 			if err := workflow.UpsertSearchAttributes(ctx, map[string]interface{}{
 				SearchAttrScheduleState: scheduleStateFromPaused(state.Paused),
@@ -228,7 +266,13 @@ func SchedulerWorkflow(ctx workflow.Context, input SchedulerWorkflowInput) error
 		// is the effective ceiling. The budget covers only the high-throughput pre-loop
 		// paths (backfill and drain) where many fires occur in a tight loop.
 		if timerFired && !state.Paused {
-			processScheduleFire(ctx, logger, scope, &input, state, state.NextRunTime, TriggerSourceSchedule, input.Policies.OverlapPolicy, "")
+			if takeScheduledAction(&input.Policies) {
+				processScheduleFire(ctx, logger, scope, &input, state, state.NextRunTime, TriggerSourceSchedule, input.Policies.OverlapPolicy, "")
+			} else {
+				// Exhausted but still draining buffered fires.
+				advanceLastRunTime(state, state.NextRunTime, TriggerSourceSchedule)
+				state.SkippedRuns++
+			}
 		}
 
 		if changed || state.Iterations >= maxIterationsBeforeContinueAsNew {
@@ -314,6 +358,13 @@ func applyAllInputs(
 		}
 	})
 
+	selector.AddReceive(chs.trigger, func(c workflow.Channel, more bool) {
+		var sig TriggerSignal
+		c.Receive(ctx, &sig)
+		scope.Tagged(map[string]string{SignalTypeTag: signalTypeTagTrigger}).Counter(SchedulerSignalReceivedCountPerDomain).Inc(1)
+		handleTrigger(logger, sig, state, workflow.Now(ctx))
+	})
+
 	selector.AddReceive(chs.delete, func(c workflow.Channel, more bool) {
 		c.Receive(ctx, nil)
 		scope.Tagged(map[string]string{SignalTypeTag: signalTypeTagDelete}).Counter(SchedulerSignalReceivedCountPerDomain).Inc(1)
@@ -389,10 +440,51 @@ func drainBufferedSignals(
 			stateChanged = true
 		}
 	}
+	for {
+		var sig TriggerSignal
+		if !chs.trigger.ReceiveAsync(&sig) {
+			break
+		}
+		scope.Tagged(map[string]string{SignalTypeTag: signalTypeTagTrigger}).Counter(SchedulerSignalReceivedCountPerDomain).Inc(1)
+		handleTrigger(logger, sig, state, now)
+	}
 
 	return stateChanged
 }
 
+// takeScheduledAction consumes one of the schedule's limited actions for a
+// scheduled fire and reports whether the fire may proceed. It always allows
+// the fire when LimitedActions is not set. Manual triggers and backfills do
+// not call it.
+func takeScheduledAction(policies *types.SchedulePolicies) bool {
+	if !policies.LimitedActions {
+		return true
+	}
+	if policies.RemainingActions <= 0 {
+		return false
+	}
+	policies.RemainingActions--
+	return true
+}
+
+// actionsExhausted reports whether a schedule with LimitedActions has no
+// scheduled actions left.
+func actionsExhausted(policies *types.SchedulePolicies) bool {
+	return policies.LimitedActions && policies.RemainingActions <= 0
+}
+
+// completeSchedule marks the schedule as completed before the workflow
+// returns, so describe queries against the closed run and visibility
+// queries on CadenceScheduleState can tell it apart from a deleted one.
+func completeSchedule(ctx workflow.Context, logger *zap.Logger, state *SchedulerWorkflowState) {
+	state.Completed = true
+	if err := workflow.UpsertSearchAttributes(ctx, map[string]interface{}{
+		SearchAttrScheduleState: ScheduleStateCompleted,
+	}); err != nil {
+		logger.Warn("failed to upsert schedule state search attribute", zap.Error(err))
+	}
+}
+
 // scheduleStateFromPaused maps the workflow's boolean Paused flag to the
 // keyword value stored in the CadenceScheduleState search attribute.
 func scheduleStateFromPaused(paused bool) string {
@@ -450,6 +542,10 @@ func handleUnpause(logger *zap.Logger, sig UnpauseSignal, state *SchedulerWorkfl
 	state.PauseReason = ""
 	state.PausedBy = ""
 	state.PausedAt = time.Time{}
+	// Start a fresh failure streak so the next failure does not re-pause
+	// the schedule immediately. LastFailure is kept for DescribeSchedule.
+	state.ConsecutiveFailures = 0
+	state.AutoUnpauseAt = time.Time{}
 	if sig.CatchUpPolicy != types.ScheduleCatchUpPolicyInvalid {
 		state.UnpauseCatchUpPolicy = sig.CatchUpPolicy
 	}
@@ -464,8 +560,8 @@ func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflow
 	}
 	changed := false
 	if sig.Spec != nil {
-		if _, err := cron.ParseStandard(sig.Spec.CronExpression); err != nil {
-			logger.Error("ignoring update with invalid cron expression",
+		if _, err := ParseScheduleSpec(*sig.Spec); err != nil {
+			logger.Error("ignoring update with invalid schedule spec",
 				zap.String("cron", sig.Spec.CronExpression), zap.Error(err))
 		} else {
 			input.Spec = *sig.Spec
@@ -530,6 +626,54 @@ func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflow
 	return changed
 }
 
+// handleTrigger queues a manual trigger to be fired at the top of the next loop
+// iteration. The fire time is pinned to now so the target WorkflowID and
+// RequestID stay stable across retries. Triggers are not state-changing: they
+// do not force a ContinueAsNew on their own.
+func handleTrigger(logger *zap.Logger, sig TriggerSignal, state *SchedulerWorkflowState, now time.Time) {
+	if len(state.PendingTriggers) >= maxPendingTriggers {
+		logger.Warn("ignoring trigger signal, too many pending triggers",
+			zap.Int("pending", len(state.PendingTriggers)),
+			zap.String("triggeredBy", sig.TriggeredBy),
+		)
+		return
+	}
+	state.PendingTriggers = append(state.PendingTriggers, TriggerRequest{
+		ScheduledTime: now,
+		OverlapPolicy: sig.OverlapPolicy,
+	})
+	logger.Info("schedule trigger queued",
+		zap.Time("scheduledTime", now),
+		zap.String("overlapPolicy", sig.OverlapPolicy.String()),
+		zap.String("triggeredBy", sig.TriggeredBy),
+	)
+}
+
+// processPendingTriggers fires every queued manual trigger in arrival order.
+// Each trigger goes through processScheduleFire so the effective overlap
+// policy (the trigger's override, or the schedule's policy) is honored.
+func processPendingTriggers(ctx workflow.Context, logger *zap.Logger, scope tally.Scope, input *SchedulerWorkflowInput, state *SchedulerWorkflowState) {
+	for len(state.PendingTriggers) > 0 {
+		tr := state.PendingTriggers[0]
+		state.PendingTriggers = state.PendingTriggers[1:]
+		overlap := tr.OverlapPolicy
+		if overlap == types.ScheduleOverlapPolicyInvalid {
+			overlap = input.Policies.OverlapPolicy
+		}
+		processScheduleFire(ctx, logger, scope, input, state, tr.ScheduledTime, TriggerSourceTrigger, overlap, "")
+	}
+	state.PendingTriggers = nil
+}
+
+// recordRecentAction appends an action to state.RecentActions, evicting the
+// oldest entries beyond maxRecentActions.
+func recordRecentAction(state *SchedulerWorkflowState, action types.ScheduleActionResult) {
+	state.RecentActions = append(state.RecentActions, action)
+	if over := len(state.RecentActions) - maxRecentActions; over > 0 {
+		state.RecentActions = append([]types.ScheduleActionResult(nil), state.RecentActions[over:]...)
+	}
+}
+
 // processScheduleFire executes the configured action for a single schedule fire.
 // All side effects (overlap check, cancel/terminate, start) are encapsulated in
 // a single activity so that the overlap logic can evolve without introducing
@@ -550,14 +694,16 @@ func handleUpdate(logger *zap.Logger, sig UpdateSignal, input *SchedulerWorkflow
 func processScheduleFire(ctx workflow.Context, logger *zap.Logger, scope tally.Scope, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, overlapPolicy types.ScheduleOverlapPolicy, backfillID string) {
 	if overlapPolicy == types.ScheduleOverlapPolicyBuffer && len(state.BufferedFires) > 0 {
 		// Skipping tryStartFire, so advance LastRunTime here.
-		if scheduledTime.After(state.LastRunTime) {
-			state.LastRunTime = scheduledTime
-		}
-		enqueueBufferedFire(logger, scope, input, state, scheduledTime, trigger, overlapPolicy, backfillID)
+		advanceLastRunTime(state, scheduledTime, trigger)
+	} else if tryStartFire(ctx, logger, input, state, scheduledTime, trigger, overlapPolicy, backfillID) != fireOutcomeBuffered {
 		return
 	}
-	if tryStartFire(ctx, logger, input, state, scheduledTime, trigger, overlapPolicy, backfillID) == fireOutcomeBuffered {
-		enqueueBufferedFire(logger, scope, input, state, scheduledTime, trigger, overlapPolicy, backfillID)
+	if !enqueueBufferedFire(logger, scope, input, state, scheduledTime, trigger, overlapPolicy, backfillID) {
+		recordRecentAction(state, types.ScheduleActionResult{
+			ScheduledTime: scheduledTime,
+			ActualTime:    workflow.Now(ctx),
+			Outcome:       types.ScheduleActionOutcomeSkipped,
+		})
 	}
 }
 
@@ -566,19 +712,19 @@ func processScheduleFire(ctx workflow.Context, logger *zap.Logger, scope tally.S
 // live-fire and drain-buffered-fire paths; the caller decides how to handle
 // a buffered outcome.
 func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, overlapPolicy types.ScheduleOverlapPolicy, backfillID string) fireOutcome {
-	// LastRunTime moves forward only. Under BUFFER, an older queued fire can
-	// drain after a newer fire has already been processed.
-	if scheduledTime.After(state.LastRunTime) {
-		state.LastRunTime = scheduledTime
-	}
+	advanceLastRunTime(state, scheduledTime, trigger)
 
 	logger.Info("schedule fired",
 		zap.Time("scheduledTime", scheduledTime),
 	)
 
 	if input.Action.StartWorkflow == nil {
+		if input.Action.SignalWorkflow != nil || input.Action.BatchOperation != nil {
+			runScheduleAction(ctx, logger, input, state, scheduledTime, trigger)
+			return fireOutcomeDone
+		}
 		state.MissedRuns++
-		logger.Error("schedule action has no StartWorkflow configuration")
+		logger.Error("schedule action has no StartWorkflow, SignalWorkflow or BatchOperation configuration")
 		return fireOutcomeDone
 	}
 
@@ -596,10 +742,19 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 		RunningWorkflows:    state.RunningWorkflows,
 		BackfillID:          backfillID,
 	}
+	if input.Policies.PauseOnFailure && state.LastStartedWorkflow != nil && state.LastStartedWorkflow.RunID != state.LastCheckedRunID {
+		req.CheckLastRunOutcome = true
+		req.PauseIfLastRunFailed = !state.Paused && state.ConsecutiveFailures+1 >= pauseOnFailureThreshold(&input.Policies)
+	}
 
 	var result ProcessFireResult
 	if err := workflow.ExecuteLocalActivity(actCtx, processScheduleFireActivity, req).Get(ctx, &result); err != nil {
 		state.MissedRuns++
+		recordRecentAction(state, types.ScheduleActionResult{
+			ScheduledTime: scheduledTime,
+			ActualTime:    workflow.Now(ctx),
+			Outcome:       types.ScheduleActionOutcomeFailed,
+		})
 		logger.Error("processScheduleFireActivity failed",
 			zap.Time("scheduledTime", scheduledTime),
 			zap.Error(err),
@@ -607,6 +762,14 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 		return fireOutcomeDone
 	}
 
+	if recordLastRunOutcome(logger, &input.Policies, state, result.LastRunOutcome, workflow.Now(ctx)) {
+		if err := workflow.UpsertSearchAttributes(ctx, map[string]interface{}{
+			SearchAttrScheduleState: ScheduleStatePaused,
+		}); err != nil {
+			logger.Warn("failed to upsert schedule state search attribute", zap.Error(err))
+		}
+	}
+
 	if result.Buffered {
 		return fireOutcomeBuffered
 	}
@@ -619,6 +782,9 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 	if result.ActiveWorkflows != nil {
 		state.RunningWorkflows = result.ActiveWorkflows
 	}
+	if action, ok := actionResultFromFire(scheduledTime, workflow.Now(ctx), &result); ok {
+		recordRecentAction(state, action)
+	}
 
 	if result.TotalDelta > 0 && result.StartedWorkflow != nil {
 		logger.Info("scheduled workflow started",
@@ -633,11 +799,145 @@ func tryStartFire(ctx workflow.Context, logger *zap.Logger, input *SchedulerWork
 	return fireOutcomeDone
 }
 
+// pauseOnFailureThreshold returns the number of consecutive failed runs
+// after which PauseOnFailure pauses the schedule. Zero means the first failure.
+func pauseOnFailureThreshold(policies *types.SchedulePolicies) int32 {
+	return max(policies.PauseOnFailureThreshold, 1)
+}
+
+// recordLastRunOutcome applies the outcome of the previously started run to
+// the failure streak and pauses the schedule once PauseOnFailureThreshold
+// consecutive runs have failed or timed out. Each run is counted at most
+// once. Returns true when the schedule was paused.
+func recordLastRunOutcome(logger *zap.Logger, policies *types.SchedulePolicies, state *SchedulerWorkflowState, outcome *RunOutcome, now time.Time) bool {
+	if outcome == nil || outcome.RunID == state.LastCheckedRunID {
+		return false
+	}
+	state.LastCheckedRunID = outcome.RunID
+	if !outcome.failed() {
+		state.ConsecutiveFailures = 0
+		return false
+	}
+
+	state.ConsecutiveFailures++
+	state.LastFailure = &types.ScheduleFailureInfo{
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: outcome.WorkflowID,
+			RunID:      outcome.RunID,
+		},
+		CloseStatus:         outcome.CloseStatus,
+		FailureReason:       outcome.FailureReason,
+		ObservedTime:        now,
+		ConsecutiveFailures: state.ConsecutiveFailures,
+	}
+	logger.Warn("scheduled workflow failed",
+		zap.String("workflowId", outcome.WorkflowID),
+		zap.String("runId", outcome.RunID),
+		zap.String("closeStatus", outcome.CloseStatus.String()),
+		zap.Int32("consecutiveFailures", state.ConsecutiveFailures),
+	)
+
+	if state.Paused || state.ConsecutiveFailures < pauseOnFailureThreshold(policies) {
+		return false
+	}
+	state.Paused = true
+	state.PauseReason = fmt.Sprintf("paused after %d consecutive failed runs; last run %s closed with %s",
+		state.ConsecutiveFailures, outcome.RunID, outcome.CloseStatus)
+	if outcome.FailureReason != "" {
+		state.PauseReason += ": " + outcome.FailureReason
+	}
+	state.PausedBy = schedulerIdentity
+	state.PausedAt = now
+	if policies.PauseOnFailureCooldown > 0 {
+		state.AutoUnpauseAt = now.Add(policies.PauseOnFailureCooldown)
+	}
+	logger.Info("schedule paused on failure",
+		zap.String("reason", state.PauseReason),
+		zap.Time("autoUnpauseAt", state.AutoUnpauseAt),
+	)
+	return true
+}
+
+// runScheduleAction performs a SignalWorkflow or BatchOperation action for a
+// single fire. These actions bypass the overlap policy and do not update the
+// overlap tracking state (LastStartedWorkflow, RunningWorkflows).
+func runScheduleAction(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource) {
+	actCtx := workflow.WithLocalActivityOptions(ctx, defaultActivityOptions())
+	req := ProcessActionRequest{
+		Domain:         input.Domain,
+		ScheduleID:     input.ScheduleID,
+		ScheduledTime:  scheduledTime,
+		TriggerSource:  trigger,
+		SignalWorkflow: input.Action.SignalWorkflow,
+		BatchOperation: input.Action.BatchOperation,
+	}
+
+	var result ProcessFireResult
+	if err := workflow.ExecuteLocalActivity(actCtx, processScheduleActionActivity, req).Get(ctx, &result); err != nil {
+		state.MissedRuns++
+		recordRecentAction(state, types.ScheduleActionResult{
+			ScheduledTime: scheduledTime,
+			ActualTime:    workflow.Now(ctx),
+			Outcome:       types.ScheduleActionOutcomeFailed,
+		})
+		logger.Error("processScheduleActionActivity failed",
+			zap.Time("scheduledTime", scheduledTime),
+			zap.Error(err),
+		)
+		return
+	}
+
+	state.TotalRuns += result.TotalDelta
+	state.SkippedRuns += result.SkippedDelta
+	if action, ok := actionResultFromFire(scheduledTime, workflow.Now(ctx), &result); ok {
+		recordRecentAction(state, action)
+	}
+}
+
+// advanceLastRunTime moves LastRunTime forward only. Under BUFFER, an older
+// queued fire can drain after a newer fire has already been processed. Manual
+// triggers are excluded: LastRunTime is the catch-up watermark, and a trigger
+// must not hide scheduled fires missed before it.
+func advanceLastRunTime(state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource) {
+	if trigger == TriggerSourceTrigger {
+		return
+	}
+	if scheduledTime.After(state.LastRunTime) {
+		state.LastRunTime = scheduledTime
+	}
+}
+
+// actionResultFromFire converts a non-buffered activity result into a recent
+// action entry. An already-running target is reported as skipped together with
+// the existing run. Returns false when the result carries no outcome.
+func actionResultFromFire(scheduledTime, actualTime time.Time, result *ProcessFireResult) (types.ScheduleActionResult, bool) {
+	action := types.ScheduleActionResult{
+		ScheduledTime: scheduledTime,
+		ActualTime:    actualTime,
+	}
+	switch {
+	case result.TotalDelta > 0:
+		action.Outcome = types.ScheduleActionOutcomeStarted
+	case result.SkippedDelta > 0:
+		action.Outcome = types.ScheduleActionOutcomeSkipped
+	default:
+		return action, false
+	}
+	if result.StartedWorkflow != nil {
+		action.WorkflowExecution = &types.WorkflowExecution{
+			WorkflowID: result.StartedWorkflow.WorkflowID,
+			RunID:      result.StartedWorkflow.RunID,
+		}
+	}
+	return action, true
+}
+
 // enqueueBufferedFire appends a fire to state.BufferedFires, enforcing both the
 // user-configured buffer_limit and the MaxBufferedFiresSystemLimit ceiling.
 // Drops increment SkippedRuns and emit scheduler_buffer_overflow_count_per_domain
-// tagged with the binding limit (user_limit vs. system_limit).
-func enqueueBufferedFire(logger *zap.Logger, scope tally.Scope, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, overlapPolicy types.ScheduleOverlapPolicy, backfillID string) {
+// tagged with the binding limit (user_limit vs. system_limit). Returns false
+// when the fire was dropped.
+func enqueueBufferedFire(logger *zap.Logger, scope tally.Scope, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, scheduledTime time.Time, trigger TriggerSource, overlapPolicy types.ScheduleOverlapPolicy, backfillID string) bool {
 	effective, reason := effectiveBufferLimit(input.Policies.BufferLimit)
 	if len(state.BufferedFires) >= effective {
 		state.SkippedRuns++
@@ -651,7 +951,7 @@ func enqueueBufferedFire(logger *zap.Logger, scope tally.Scope, input *Scheduler
 			zap.Int("systemLimit", MaxBufferedFiresSystemLimit),
 			zap.Int("bufferSize", len(state.BufferedFires)),
 		)
-		return
+		return false
 	}
 	state.BufferedFires = append(state.BufferedFires, BufferedFire{
 		ScheduledTime: scheduledTime,
@@ -663,6 +963,7 @@ func enqueueBufferedFire(logger *zap.Logger, scope tally.Scope, input *Scheduler
 		zap.Time("scheduledTime", scheduledTime),
 		zap.Int("bufferSize", len(state.BufferedFires)),
 	)
+	return true
 }
 
 // effectiveBufferLimit returns the queue cap actually enforced for the BUFFER
@@ -698,7 +999,7 @@ func effectiveConcurrencyLimit(userLimit int32) int32 {
 // scheduledTime and triggerSource, so the server de-duplicates on replay.
 func drainBufferedFires(ctx workflow.Context, logger *zap.Logger, input *SchedulerWorkflowInput, state *SchedulerWorkflowState, budget *int) (drained int, headBlocked bool) {
 	for len(state.BufferedFires) > 0 {
-		if *budget <= 0 {
+		if *budget <= 0 || state.Paused {
 			return drained, false
 		}
 		head := state.BufferedFires[0]
@@ -746,8 +1047,8 @@ func defaultActivityOptions() workflow.LocalActivityOptions {
 	}
 }
 
-// computeNextRunTime determines the next fire time for the cron schedule,
-// respecting the spec's StartTime and EndTime boundaries.
+// computeNextRunTime determines the next fire time for the schedule compiled
+// by ParseScheduleSpec, respecting the spec's StartTime and EndTime boundaries.
 func computeNextRunTime(sched cron.Schedule, now time.Time, spec types.ScheduleSpec) time.Time {
 	if !spec.StartTime.IsZero() && now.Before(spec.StartTime) {
 		now = spec.StartTime.Add(-time.Second)
@@ -905,12 +1206,22 @@ func processMissedRunsAt(ctx workflow.Context, logger *zap.Logger, scope tally.S
 		effectivePolicy = state.UnpauseCatchUpPolicy
 	}
 	result := applyMissedRunPolicy(effectivePolicy, input.Policies.CatchUpWindow, fires.times, now, logger)
+	if input.Policies.LimitedActions && int64(len(result.toFire)) > input.Policies.RemainingActions {
+		// Catch-up fires consume limited actions like live fires; the ones
+		// beyond the remaining count are skipped.
+		allowed := max(input.Policies.RemainingActions, 0)
+		result.skipped += int64(len(result.toFire)) - allowed
+		result.toFire = result.toFire[:allowed]
+	}
 
 	fired := 0
 	for _, t := range result.toFire {
-		if *budget <= 0 {
+		// A fire can pause the schedule under PauseOnFailure; the rest are
+		// caught up after unpause.
+		if *budget <= 0 || state.Paused {
 			break
 		}
+		takeScheduledAction(&input.Policies)
 		processScheduleFire(ctx, logger, scope, input, state, t, TriggerSourceSchedule, input.Policies.OverlapPolicy, "")
 		fired++
 		*budget--
@@ -991,6 +1302,11 @@ func buildScheduleDescription(input *SchedulerWorkflowInput, state *SchedulerWor
 		Memo:                 input.Memo,
 		SearchAttributes:     input.SearchAttributes,
 		OngoingBackfills:     ongoing,
+		RecentActions:        state.RecentActions,
+		Completed:            state.Completed,
+		ConsecutiveFailures:  state.ConsecutiveFailures,
+		LastFailure:          state.LastFailure,
+		AutoUnpauseAt:        state.AutoUnpauseAt,
 	}
 }
 
@@ -516,6 +516,90 @@ func TestBuildScheduleDescription(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "schedule with recent actions",
+			input: SchedulerWorkflowInput{ScheduleID: "sched-ra", Domain: "dev"},
+			state: SchedulerWorkflowState{
+				RecentActions: []types.ScheduleActionResult{
+					{
+						ScheduledTime:     lastRun,
+						ActualTime:        lastRun.Add(time.Second),
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf-1", RunID: "run-1"},
+						Outcome:           types.ScheduleActionOutcomeStarted,
+					},
+				},
+			},
+			want: &ScheduleDescription{
+				ScheduleID: "sched-ra",
+				Domain:     "dev",
+				RecentActions: []types.ScheduleActionResult{
+					{
+						ScheduledTime:     lastRun,
+						ActualTime:        lastRun.Add(time.Second),
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf-1", RunID: "run-1"},
+						Outcome:           types.ScheduleActionOutcomeStarted,
+					},
+				},
+			},
+		},
+		{
+			name: "completed schedule with limited actions",
+			input: SchedulerWorkflowInput{
+				ScheduleID: "sched-done",
+				Domain:     "dev",
+				Policies:   types.SchedulePolicies{LimitedActions: true},
+			},
+			state: SchedulerWorkflowState{TotalRuns: 3, Completed: true},
+			want: &ScheduleDescription{
+				ScheduleID: "sched-done",
+				Domain:     "dev",
+				Policies:   types.SchedulePolicies{LimitedActions: true},
+				TotalRuns:  3,
+				Completed:  true,
+			},
+		},
+		{
+			name: "paused on failure with auto-unpause",
+			input: SchedulerWorkflowInput{
+				ScheduleID: "sched-failing",
+				Domain:     "dev",
+				Policies:   types.SchedulePolicies{PauseOnFailure: true, PauseOnFailureThreshold: 2},
+			},
+			state: SchedulerWorkflowState{
+				Paused:              true,
+				PauseReason:         "paused after 2 consecutive failed runs",
+				PausedBy:            schedulerIdentity,
+				PausedAt:            pauseTime,
+				ConsecutiveFailures: 2,
+				LastFailure: &types.ScheduleFailureInfo{
+					WorkflowExecution:   &types.WorkflowExecution{WorkflowID: "wf", RunID: "run-2"},
+					CloseStatus:         types.WorkflowExecutionCloseStatusFailed,
+					FailureReason:       "boom",
+					ObservedTime:        pauseTime,
+					ConsecutiveFailures: 2,
+				},
+				LastCheckedRunID: "run-2",
+				AutoUnpauseAt:    pauseTime.Add(time.Hour),
+			},
+			want: &ScheduleDescription{
+				ScheduleID:          "sched-failing",
+				Domain:              "dev",
+				Policies:            types.SchedulePolicies{PauseOnFailure: true, PauseOnFailureThreshold: 2},
+				Paused:              true,
+				PauseReason:         "paused after 2 consecutive failed runs",
+				PausedBy:            schedulerIdentity,
+				PausedAt:            pauseTime,
+				ConsecutiveFailures: 2,
+				LastFailure: &types.ScheduleFailureInfo{
+					WorkflowExecution:   &types.WorkflowExecution{WorkflowID: "wf", RunID: "run-2"},
+					CloseStatus:         types.WorkflowExecutionCloseStatusFailed,
+					FailureReason:       "boom",
+					ObservedTime:        pauseTime,
+					ConsecutiveFailures: 2,
+				},
+				AutoUnpauseAt: pauseTime.Add(time.Hour),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1028,6 +1112,90 @@ func TestCountCronFires(t *testing.T) {
 	}
 }
 
+func TestListMatchingTimes(t *testing.T) {
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return day.Add(time.Duration(h) * time.Hour) }
+
+	tests := map[string]struct {
+		spec       types.ScheduleSpec
+		start, end time.Time
+		maxTimes   int
+		wantTimes  []time.Time
+		wantTotal  int
+		wantCapped bool
+		wantErr    bool
+	}{
+		"range bounds are inclusive": {
+			spec:      types.ScheduleSpec{CronExpression: "0 * * * *"},
+			start:     hour(0),
+			end:       hour(3),
+			maxTimes:  10,
+			wantTimes: []time.Time{hour(0), hour(1), hour(2), hour(3)},
+			wantTotal: 4,
+		},
+		"maxTimes limits returned times but not the total": {
+			spec:      types.ScheduleSpec{CronExpression: "0 * * * *"},
+			start:     hour(0),
+			end:       hour(23),
+			maxTimes:  2,
+			wantTimes: []time.Time{hour(0), hour(1)},
+			wantTotal: 24,
+		},
+		"spec start and end time clip the range": {
+			spec: types.ScheduleSpec{
+				CronExpression: "0 * * * *",
+				StartTime:      hour(2),
+				EndTime:        hour(4),
+			},
+			start:     hour(0),
+			end:       hour(23),
+			maxTimes:  10,
+			wantTimes: []time.Time{hour(2), hour(3), hour(4)},
+			wantTotal: 3,
+		},
+		"exclusions are honoured": {
+			spec: types.ScheduleSpec{
+				CronExpression:   "0 * * * *",
+				ExcludeCalendars: []*types.ScheduleCalendarSpec{{Hour: "1"}},
+			},
+			start:     hour(0),
+			end:       hour(2),
+			maxTimes:  10,
+			wantTimes: []time.Time{hour(0), hour(2)},
+			wantTotal: 2,
+		},
+		"total is capped for very large ranges": {
+			spec:       types.ScheduleSpec{Intervals: []*types.ScheduleIntervalSpec{{Interval: time.Second}}},
+			start:      day,
+			end:        day.Add(48 * time.Hour),
+			maxTimes:   1,
+			wantTimes:  []time.Time{day},
+			wantTotal:  maxBackfillRunsTotalCount,
+			wantCapped: true,
+		},
+		"invalid spec": {
+			spec:     types.ScheduleSpec{CronExpression: "not a cron"},
+			start:    hour(0),
+			end:      hour(1),
+			maxTimes: 10,
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ListMatchingTimes(tt.spec, tt.start, tt.end, tt.maxTimes)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTimes, got.Times)
+			assert.Equal(t, tt.wantTotal, got.TotalCount)
+			assert.Equal(t, tt.wantCapped, got.TotalCountCapped)
+		})
+	}
+}
+
 func TestEffectiveFireOverlap(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -2344,3 +2512,340 @@ func TestEnsurePolicyDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleTrigger(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("trigger is queued at receipt time", func(t *testing.T) {
+		state := &SchedulerWorkflowState{}
+		handleTrigger(testLogger, TriggerSignal{OverlapPolicy: types.ScheduleOverlapPolicyConcurrent, TriggeredBy: "admin"}, state, now)
+		require.Len(t, state.PendingTriggers, 1)
+		assert.Equal(t, TriggerRequest{ScheduledTime: now, OverlapPolicy: types.ScheduleOverlapPolicyConcurrent}, state.PendingTriggers[0])
+	})
+
+	t.Run("trigger is dropped when the queue is full", func(t *testing.T) {
+		state := &SchedulerWorkflowState{PendingTriggers: make([]TriggerRequest, maxPendingTriggers)}
+		handleTrigger(testLogger, TriggerSignal{}, state, now)
+		assert.Len(t, state.PendingTriggers, maxPendingTriggers)
+	})
+}
+
+func TestProcessPendingTriggers(t *testing.T) {
+	t0 := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("triggers are consumed without moving the catch-up watermark", func(t *testing.T) {
+		// StartWorkflow nil short-circuits tryStartFire before the activity.
+		input := &SchedulerWorkflowInput{Spec: types.ScheduleSpec{CronExpression: "* * * * *"}}
+		state := &SchedulerWorkflowState{
+			LastRunTime: t0,
+			PendingTriggers: []TriggerRequest{
+				{ScheduledTime: t0.Add(time.Minute)},
+				{ScheduledTime: t0.Add(2 * time.Minute)},
+			},
+		}
+		processPendingTriggers(nil, testLogger, tally.NewTestScope("", nil), input, state)
+		assert.Empty(t, state.PendingTriggers)
+		assert.Equal(t, int64(2), state.MissedRuns)
+		assert.Equal(t, t0, state.LastRunTime)
+	})
+
+	t.Run("trigger inherits the schedule overlap policy", func(t *testing.T) {
+		input := &SchedulerWorkflowInput{
+			Spec: types.ScheduleSpec{CronExpression: "* * * * *"},
+			Action: types.ScheduleAction{
+				StartWorkflow: &types.StartWorkflowAction{
+					WorkflowType: &types.WorkflowType{Name: "wf"},
+					TaskList:     &types.TaskList{Name: "tl"},
+				},
+			},
+			Policies: types.SchedulePolicies{OverlapPolicy: types.ScheduleOverlapPolicyBuffer},
+		}
+		state := &SchedulerWorkflowState{
+			BufferedFires: []BufferedFire{
+				{ScheduledTime: t0, TriggerSource: TriggerSourceSchedule, OverlapPolicy: types.ScheduleOverlapPolicyBuffer},
+			},
+			PendingTriggers: []TriggerRequest{{ScheduledTime: t0.Add(time.Minute)}},
+		}
+		// The BUFFER fast path enqueues without running the activity.
+		processPendingTriggers(nil, testLogger, tally.NewTestScope("", nil), input, state)
+		require.Len(t, state.BufferedFires, 2)
+		assert.Equal(t, TriggerSourceTrigger, state.BufferedFires[1].TriggerSource)
+		assert.Equal(t, types.ScheduleOverlapPolicyBuffer, state.BufferedFires[1].OverlapPolicy)
+		assert.True(t, state.LastRunTime.IsZero())
+	})
+}
+
+func TestRecordRecentAction(t *testing.T) {
+	t0 := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	state := &SchedulerWorkflowState{}
+	for i := 0; i < maxRecentActions+3; i++ {
+		recordRecentAction(state, types.ScheduleActionResult{
+			ScheduledTime: t0.Add(time.Duration(i) * time.Minute),
+			Outcome:       types.ScheduleActionOutcomeStarted,
+		})
+	}
+	require.Len(t, state.RecentActions, maxRecentActions)
+	assert.Equal(t, t0.Add(3*time.Minute), state.RecentActions[0].ScheduledTime, "oldest entries are evicted first")
+	assert.Equal(t, t0.Add(time.Duration(maxRecentActions+2)*time.Minute), state.RecentActions[maxRecentActions-1].ScheduledTime)
+}
+
+func TestActionResultFromFire(t *testing.T) {
+	scheduled := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	actual := scheduled.Add(time.Second)
+	started := &RunningWorkflowInfo{WorkflowID: "wf-1", RunID: "run-1"}
+
+	tests := []struct {
+		name   string
+		result ProcessFireResult
+		want   types.ScheduleActionResult
+		wantOK bool
+	}{
+		{
+			name:   "started",
+			result: ProcessFireResult{TotalDelta: 1, StartedWorkflow: started},
+			want: types.ScheduleActionResult{
+				ScheduledTime:     scheduled,
+				ActualTime:        actual,
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf-1", RunID: "run-1"},
+				Outcome:           types.ScheduleActionOutcomeStarted,
+			},
+			wantOK: true,
+		},
+		{
+			name:   "skipped by overlap policy",
+			result: ProcessFireResult{SkippedDelta: 1},
+			want: types.ScheduleActionResult{
+				ScheduledTime: scheduled,
+				ActualTime:    actual,
+				Outcome:       types.ScheduleActionOutcomeSkipped,
+			},
+			wantOK: true,
+		},
+		{
+			name:   "already running reports the existing run",
+			result: ProcessFireResult{SkippedDelta: 1, StartedWorkflow: started},
+			want: types.ScheduleActionResult{
+				ScheduledTime:     scheduled,
+				ActualTime:        actual,
+				WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf-1", RunID: "run-1"},
+				Outcome:           types.ScheduleActionOutcomeSkipped,
+			},
+			wantOK: true,
+		},
+		{
+			name:   "no outcome",
+			result: ProcessFireResult{},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := actionResultFromFire(scheduled, actual, &tt.result)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTakeScheduledAction(t *testing.T) {
+	tests := []struct {
+		name          string
+		policies      types.SchedulePolicies
+		want          bool
+		wantRemaining int64
+		wantExhausted bool
+	}{
+		{
+			name:     "unlimited always allows",
+			policies: types.SchedulePolicies{},
+			want:     true,
+		},
+		{
+			name:          "consumes one remaining action",
+			policies:      types.SchedulePolicies{LimitedActions: true, RemainingActions: 2},
+			want:          true,
+			wantRemaining: 1,
+		},
+		{
+			name:          "last action exhausts the schedule",
+			policies:      types.SchedulePolicies{LimitedActions: true, RemainingActions: 1},
+			want:          true,
+			wantRemaining: 0,
+			wantExhausted: true,
+		},
+		{
+			name:          "no remaining actions",
+			policies:      types.SchedulePolicies{LimitedActions: true},
+			want:          false,
+			wantExhausted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.policies
+			assert.Equal(t, tt.want, takeScheduledAction(&p))
+			assert.Equal(t, tt.wantRemaining, p.RemainingActions)
+			assert.Equal(t, tt.wantExhausted, actionsExhausted(&p))
+		})
+	}
+}
+
+func TestProcessMissedRunsAt_LimitedActions(t *testing.T) {
+	// 4 fires missed: 11:00, 12:00, 13:00, 14:00; only 2 actions remain.
+	watermark := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 1, 15, 14, 0, 0, 0, time.UTC)
+
+	sched := mustParseCron(t, "0 * * * *")
+	scope := tally.NewTestScope("", nil)
+	input := &SchedulerWorkflowInput{
+		Spec: types.ScheduleSpec{CronExpression: "0 * * * *"},
+		Policies: types.SchedulePolicies{
+			CatchUpPolicy:    types.ScheduleCatchUpPolicyAll,
+			LimitedActions:   true,
+			RemainingActions: 2,
+		},
+		// Action.StartWorkflow intentionally nil: processScheduleFire returns
+		// early before using ctx, so nil ctx is safe here.
+	}
+	state := &SchedulerWorkflowState{}
+
+	budget := maxActivitiesPerExecution
+	moreMissed := processMissedRunsAt(nil, testLogger, scope, sched, input, state, watermark, now, &budget)
+
+	assert.False(t, moreMissed, "fires beyond the remaining actions are skipped, not deferred")
+	assert.Equal(t, int64(0), input.Policies.RemainingActions)
+	assert.True(t, actionsExhausted(&input.Policies))
+	assert.Equal(t, int64(2), state.SkippedRuns)
+	assert.Equal(t, now, state.LastProcessedTime)
+
+	c, ok := findCounter(scope.Snapshot().Counters(), SchedulerMissedFiredCountPerDomain, map[string]string{})
+	require.True(t, ok)
+	assert.Equal(t, int64(2), c.Value())
+}
+
+func TestRecordLastRunOutcome(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	failed := func(runID string) *RunOutcome {
+		return &RunOutcome{
+			WorkflowID:    "wf",
+			RunID:         runID,
+			CloseStatus:   types.WorkflowExecutionCloseStatusFailed,
+			FailureReason: "boom",
+		}
+	}
+
+	tests := []struct {
+		name                    string
+		policies                types.SchedulePolicies
+		initial                 SchedulerWorkflowState
+		outcome                 *RunOutcome
+		wantPaused              bool
+		wantConsecutiveFailures int32
+		wantAutoUnpauseAt       time.Time
+		wantLastFailureRunID    string
+	}{
+		{
+			name:                    "nil outcome is a no-op",
+			policies:                types.SchedulePolicies{PauseOnFailure: true},
+			initial:                 SchedulerWorkflowState{ConsecutiveFailures: 1},
+			wantConsecutiveFailures: 1,
+		},
+		{
+			name:     "success resets the streak",
+			policies: types.SchedulePolicies{PauseOnFailure: true, PauseOnFailureThreshold: 3},
+			initial:  SchedulerWorkflowState{ConsecutiveFailures: 2},
+			outcome: &RunOutcome{
+				WorkflowID:  "wf",
+				RunID:       "run-ok",
+				CloseStatus: types.WorkflowExecutionCloseStatusCompleted,
+			},
+			wantConsecutiveFailures: 0,
+		},
+		{
+			name:                    "failure below threshold counts without pausing",
+			policies:                types.SchedulePolicies{PauseOnFailure: true, PauseOnFailureThreshold: 3},
+			initial:                 SchedulerWorkflowState{ConsecutiveFailures: 1},
+			outcome:                 failed("run-2"),
+			wantConsecutiveFailures: 2,
+			wantLastFailureRunID:    "run-2",
+		},
+		{
+			name:                    "zero threshold pauses on the first failure",
+			policies:                types.SchedulePolicies{PauseOnFailure: true},
+			outcome:                 failed("run-1"),
+			wantPaused:              true,
+			wantConsecutiveFailures: 1,
+			wantLastFailureRunID:    "run-1",
+		},
+		{
+			name:                    "threshold reached pauses with cool-down",
+			policies:                types.SchedulePolicies{PauseOnFailure: true, PauseOnFailureThreshold: 2, PauseOnFailureCooldown: time.Hour},
+			initial:                 SchedulerWorkflowState{ConsecutiveFailures: 1},
+			outcome:                 failed("run-2"),
+			wantPaused:              true,
+			wantConsecutiveFailures: 2,
+			wantAutoUnpauseAt:       now.Add(time.Hour),
+			wantLastFailureRunID:    "run-2",
+		},
+		{
+			name:                    "already checked run is not counted twice",
+			policies:                types.SchedulePolicies{PauseOnFailure: true},
+			initial:                 SchedulerWorkflowState{LastCheckedRunID: "run-1", ConsecutiveFailures: 1},
+			outcome:                 failed("run-1"),
+			wantConsecutiveFailures: 1,
+		},
+		{
+			name:                    "failure while already paused does not re-pause",
+			policies:                types.SchedulePolicies{PauseOnFailure: true},
+			initial:                 SchedulerWorkflowState{Paused: true, PauseReason: "maintenance"},
+			outcome:                 failed("run-1"),
+			wantPaused:              true,
+			wantConsecutiveFailures: 1,
+			wantLastFailureRunID:    "run-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.initial
+			wasPaused := state.Paused
+			pausedNow := recordLastRunOutcome(testLogger, &tt.policies, &state, tt.outcome, now)
+			assert.Equal(t, tt.wantPaused && !wasPaused, pausedNow)
+			assert.Equal(t, tt.wantPaused, state.Paused)
+			assert.Equal(t, tt.wantConsecutiveFailures, state.ConsecutiveFailures)
+			assert.Equal(t, tt.wantAutoUnpauseAt, state.AutoUnpauseAt)
+			if tt.wantLastFailureRunID == "" {
+				assert.Nil(t, state.LastFailure)
+			} else {
+				require.NotNil(t, state.LastFailure)
+				assert.Equal(t, tt.wantLastFailureRunID, state.LastFailure.GetWorkflowExecution().GetRunID())
+				assert.Equal(t, tt.wantConsecutiveFailures, state.LastFailure.ConsecutiveFailures)
+				assert.Equal(t, now, state.LastFailure.ObservedTime)
+			}
+			if pausedNow {
+				assert.Equal(t, schedulerIdentity, state.PausedBy)
+				assert.Equal(t, now, state.PausedAt)
+				assert.Contains(t, state.PauseReason, "consecutive failed runs")
+				assert.Contains(t, state.PauseReason, "boom")
+			}
+		})
+	}
+}
+
+func TestHandleUnpause_ResetsFailureStreak(t *testing.T) {
+	lastFailure := &types.ScheduleFailureInfo{ConsecutiveFailures: 3}
+	state := SchedulerWorkflowState{
+		Paused:              true,
+		PausedBy:            schedulerIdentity,
+		ConsecutiveFailures: 3,
+		LastFailure:         lastFailure,
+		AutoUnpauseAt:       time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC),
+	}
+	require.True(t, handleUnpause(testLogger, UnpauseSignal{Reason: "automatic unpause after failure cool-down"}, &state))
+	assert.False(t, state.Paused)
+	assert.Equal(t, int32(0), state.ConsecutiveFailures)
+	assert.True(t, state.AutoUnpauseAt.IsZero())
+	assert.Equal(t, lastFailure, state.LastFailure, "LastFailure is kept for DescribeSchedule")
+}
@@ -48,9 +48,11 @@ import (
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
+	"github.com/uber/cadence/service/worker/persistencemigration"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
 	"github.com/uber/cadence/service/worker/scanner/executions"
+	"github.com/uber/cadence/service/worker/scanner/historybranches"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
@@ -81,6 +83,7 @@ type (
 		BatcherCfg                          *batcher.Config
 		ESAnalyzerCfg                       *esanalyzer.Config
 		failoverManagerCfg                  *failovermanager.Config
+		persistenceMigrationCfg             *persistencemigration.Config
 		ThrottledLogRPS                     dynamicproperties.IntPropertyFn
 		PersistenceGlobalMaxQPS             dynamicproperties.IntPropertyFn
 		PersistenceMaxQPS                   dynamicproperties.IntPropertyFn
@@ -157,6 +160,7 @@ func NewConfig(params *resource.Params) *Config {
 				executions.ConcreteExecutionConfig(dc),
 				executions.CurrentExecutionConfig(dc),
 				timers.ScannerConfig(dc),
+				historybranches.ScannerConfig(dc),
 			},
 			MaxWorkflowRetentionInDays: dc.GetIntProperty(dynamicproperties.MaxRetentionDays),
 		},
@@ -204,6 +208,14 @@ func NewConfig(params *resource.Params) *Config {
 		dynamicproperties.WriteVisibilityStoreName,
 	)
 
+	if params.PersistenceConfig.MigrationStore != "" {
+		config.persistenceMigrationCfg = &persistencemigration.Config{
+			PersistenceConfig: params.PersistenceConfig,
+			ClusterName:       params.ClusterMetadata.GetCurrentClusterName(),
+			DynamicConfig:     persistence.NewDynamicConfiguration(dc),
+		}
+	}
+
 	if shouldStartIndexer(params, advancedVisWritingMode) {
 		config.IndexerCfg = &indexer.Config{
 			IndexerConcurrency:             dc.GetIntProperty(dynamicproperties.WorkerIndexerConcurrency),
@@ -263,6 +275,10 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.config.persistenceMigrationCfg != nil {
+		migrator := s.startPersistenceMigration()
+		defer migrator.Stop()
+	}
 
 	cm := s.startAsyncWorkflowConsumerManager()
 	defer cm.Stop()
@@ -493,6 +509,21 @@ func (s *Service) startFailoverManager() {
 	}
 }
 
+func (s *Service) startPersistenceMigration() *persistencemigration.Migrator {
+	params := &persistencemigration.BootstrapParams{
+		Config:        *s.config.persistenceMigrationCfg,
+		ServiceClient: s.params.PublicClient,
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+	}
+	migrator := persistencemigration.New(params)
+	if err := migrator.Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting persistence migration", tag.Error(err))
+	}
+	return migrator
+}
+
 func (s *Service) startAsyncWorkflowConsumerManager() common.Daemon {
 	cm := asyncworkflow.NewConsumerManager(
 		s.GetLogger(),
@@ -35,6 +35,7 @@ import (
 	"go.uber.org/yarpc/transport/grpc"
 	"gopkg.in/yaml.v2"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
@@ -187,9 +188,11 @@ func (s *ReplicationSimulationConfig) MustInitClientsFor(t *testing.T, clusterNa
 		apiv1.NewWorkerAPIYARPCClient(clientConfig),
 		apiv1.NewVisibilityAPIYARPCClient(clientConfig),
 		apiv1.NewScheduleAPIYARPCClient(clientConfig),
+		frontendv1.NewScheduleAPIYARPCClient(clientConfig),
+		frontendv1.NewFrontendAPIYARPCClient(clientConfig),
 	)
 
-	cluster.AdminClient = grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig))
+	cluster.AdminClient = grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig), frontendv1.NewFrontendAdminAPIYARPCClient(clientConfig))
 	Logf(t, "Initialized clients for cluster %s", clusterName)
 }
 
@@ -88,6 +88,10 @@ func verifyPluginVersion(plugin *config.NoSQL, expectedCassandraVersion string,
 	// Use hardcoded instead of constant because of cycle dependency issue.
 	// However, this file will be refactor to support NoSQL soon. After the refactoring, cycle dependency issue
 	// should be gone and we can use constant at that time
+	if plugin.PluginName == "dynamodb" {
+		// the version of the dynamodb schema is verified by tools/dynamodb
+		return nil
+	}
 	if plugin.PluginName != "cassandra" {
 		return fmt.Errorf("unknown NoSQL plugin name: %q", plugin.PluginName)
 	}
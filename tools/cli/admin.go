@@ -28,6 +28,7 @@ import (
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
 	"github.com/uber/cadence/common/reconciliation/invariant"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 )
@@ -155,6 +156,32 @@ func newAdminWorkflowCommands() []*cli.Command {
 			},
 			Action: AdminMaintainCorruptWorkflow,
 		},
+		{
+			Name:    "write-audit",
+			Aliases: []string{"wa"},
+			Usage:   "Show the sampled persistence write audit trail of a workflow run, read from the blobstore of the service configuration",
+			Flags: append(getServiceConfigFlags(),
+				&cli.StringFlag{
+					Name:     FlagDomainID,
+					Usage:    "DomainID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  []string{"w", "wid"},
+					Usage:    "WorkflowID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagRunID,
+					Aliases:  []string{"r", "rid"},
+					Usage:    "RunID",
+					Required: true,
+				},
+				getFormatFlag(),
+			),
+			Action: AdminShowWriteAudit,
+		},
 	}
 }
 
@@ -491,6 +518,15 @@ func newAdminDomainCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Show the replication status of the domain to each of its remote clusters",
+			Description: "The last replicated task is kept in memory by each history shard, it is reset when the shard moves to another host. " +
+				"Shards whose status could not be read are reported and left out of the counts.",
+			Flags:  []cli.Flag{getFormatFlag()},
+			Action: AdminGetDomainReplicationStatus,
+		},
 	}
 }
 
@@ -900,6 +936,16 @@ func newAdminQueueCommands() []*cli.Command {
 			Flags:   getQueueCommandFlags(),
 			Action:  AdminDescribeQueue,
 		},
+		{
+			Name:   "isolate-domain",
+			Usage:  "isolate the history tasks of a domain into a dedicated virtual queue on all shards, requires --domain",
+			Action: AdminIsolateDomain,
+		},
+		{
+			Name:   "release-domain",
+			Usage:  "move the history tasks of an isolated domain back to the default virtual queue, requires --domain",
+			Action: AdminReleaseDomain,
+		},
 	}
 }
 
@@ -1013,6 +1059,37 @@ func newDBCommands() []*cli.Command {
 			),
 			Action: AdminDBClean,
 		},
+		{
+			Name:  "history-branches",
+			Usage: "report the history branches forked by executions and no longer referenced by them, and optionally delete them",
+			Flags: append(getDBFlags(),
+				&cli.IntFlag{
+					Name:     FlagLowerShardBound,
+					Usage:    "FlagLowerShardBound for the start shard to scan. (Default: 0)",
+					Value:    0,
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:     FlagUpperShardBound,
+					Usage:    "FlagUpperShardBound for the end shard to scan. (Default: 16383)",
+					Value:    16383,
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagDomainID,
+					Usage: "Only scan the executions of this domain ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagMinBranchAge,
+					Usage: "Minimum age of the branches to report, younger branches may not be referenced by their execution yet. (Default: 1h)",
+				},
+				&cli.BoolFlag{
+					Name:  FlagDelete,
+					Usage: "Delete the reported branches, otherwise they are only reported",
+				},
+			),
+			Action: AdminDBHistoryBranches,
+		},
 		{
 			Name:  "decode_thrift",
 			Usage: "decode thrift object, print into JSON if the data is matching with any supported struct",
@@ -1458,6 +1535,75 @@ func newAdminConfigStoreCommands() []*cli.Command {
 	}
 }
 
+func newAdminPersistenceMigrationCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "Start the workflow copying the data of the default store to the migration store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagShards,
+					Usage: "Optional comma separated shard IDs or inclusive ranges to backfill, all shards are backfilled by default. Example: \"2,5-6,10\"",
+				},
+				&cli.IntFlag{
+					Name:  FlagConcurrency,
+					Usage: "Optional number of shards backfilled at the same time",
+					Value: defaultPersistenceMigrationConcurrency,
+				},
+				&cli.BoolFlag{
+					Name:  FlagReverse,
+					Usage: "Copy the data of the migration store back to the default store",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipDomains,
+					Usage: "Skip the domain backfill",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipTaskLists,
+					Usage: "Skip the task list backfill",
+				},
+				&cli.IntFlag{
+					Name:    FlagExecutionTimeout,
+					Aliases: []string{"et"},
+					Usage:   "Optional backfill workflow timeout in seconds",
+					Value:   defaultPersistenceMigrationTimeoutInSeconds,
+				},
+			},
+			Action: AdminPersistenceMigrationStart,
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe the progress of the backfill workflow",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"rid", "r"},
+					Usage:   "Optional backfill workflow runID, default is latest runID",
+				},
+			},
+			Action: AdminPersistenceMigrationDescribe,
+		},
+		{
+			Name:  "set-mode",
+			Usage: "Set the persistence migration mode of the cluster or of some shards. Requires the config store as dynamic config client, and must not be run concurrently",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagMigrationMode,
+					Usage:    fmt.Sprintf("Persistence migration mode, one of %v", migration.Modes),
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagShards,
+					Usage: "Optional comma separated shard IDs or inclusive ranges to set the mode of, the mode of the cluster is set by default. Example: \"2,5-6,10\"",
+				},
+			},
+			Action: AdminPersistenceMigrationSetMode,
+		},
+	}
+}
+
 func newAdminIsolationGroupCommands() []*cli.Command {
 	return []*cli.Command{
 		{
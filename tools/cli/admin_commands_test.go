@@ -91,6 +91,10 @@ func (td *cliTestData) consoleOutput() string {
 	return td.ioHandler.outputBytes.String()
 }
 
+func (td *cliTestData) progressOutput() string {
+	return td.ioHandler.progressBytes.String()
+}
+
 func TestAdminResetQueue(t *testing.T) {
 	tests := []struct {
 		name           string
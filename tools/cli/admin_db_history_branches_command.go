@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/fetcher"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+const historyBranchesPageSize = 1000
+
+// HistoryBranchesRow is a row of the history-branches command output
+type HistoryBranchesRow struct {
+	DomainID         string `header:"Domain ID" json:"domainID"`
+	OrphanedBranches int    `header:"Orphaned Branches" json:"orphanedBranches"`
+	Size             int64  `header:"Size (bytes)" json:"size"`
+	DeletedBranches  int    `header:"Deleted Branches" json:"deletedBranches"`
+	FailedExecutions int    `header:"Failed Executions" json:"failedExecutions"`
+}
+
+// AdminDBHistoryBranches scans the executions of a range of shards in database, reports the history branches
+// they forked and no longer reference, and deletes them when requested
+func AdminDBHistoryBranches(c *cli.Context) error {
+	startShardID := c.Int(FlagLowerShardBound)
+	endShardID := c.Int(FlagUpperShardBound)
+	if startShardID > endShardID {
+		return commoncli.Problem(fmt.Sprintf("%s must not be greater than %s", FlagLowerShardBound, FlagUpperShardBound), nil)
+	}
+	minAge := invariant.DefaultOrphanedHistoryBranchMinAge
+	if c.IsSet(FlagMinBranchAge) {
+		d, err := time.ParseDuration(c.String(FlagMinBranchAge))
+		if err != nil || d < 0 {
+			return commoncli.Problem(fmt.Sprintf("invalid %s, expected a non-negative duration like 1h", FlagMinBranchAge), err)
+		}
+		minAge = d
+	}
+
+	rows := map[string]*HistoryBranchesRow{}
+	for shardID := startShardID; shardID <= endShardID; shardID++ {
+		if err := scanHistoryBranchesByShardID(c, shardID, minAge, rows); err != nil {
+			return err
+		}
+	}
+
+	table := make([]HistoryBranchesRow, 0, len(rows))
+	for _, row := range rows {
+		table = append(table, *row)
+	}
+	sort.Slice(table, func(i, j int) bool {
+		if table[i].Size != table[j].Size {
+			return table[i].Size > table[j].Size
+		}
+		return table[i].DomainID < table[j].DomainID
+	})
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+func scanHistoryBranchesByShardID(
+	c *cli.Context,
+	shardID int,
+	minAge time.Duration,
+	rows map[string]*HistoryBranchesRow,
+) error {
+	execManager, err := getDeps(c).initializeExecutionManager(c, shardID)
+	if err != nil {
+		return commoncli.Problem("initialize execution manager", err)
+	}
+	defer execManager.Close()
+
+	historyManager, err := getDeps(c).initializeHistoryManager(c)
+	if err != nil {
+		return commoncli.Problem("initialize history manager", err)
+	}
+	defer historyManager.Close()
+
+	pr := persistence.NewPersistenceRetryerWithShardID(
+		execManager,
+		historyManager,
+		common.CreatePersistenceRetryPolicy(),
+		shardID,
+	)
+	iv := invariant.NewOrphanedHistoryBranches(pr, cache.NewNoOpDomainCache(), minAge)
+	domainID := c.String(FlagDomainID)
+	deleteBranches := c.Bool(FlagDelete)
+
+	it := fetcher.ConcreteExecutionIterator(c.Context, pr, historyBranchesPageSize)
+	for it.HasNext() {
+		e, err := it.Next()
+		if err != nil {
+			return commoncli.Problem(fmt.Sprintf("Failed to scan shard ID: %v for history branches. Please retry.", shardID), err)
+		}
+		execution := e.(*entity.ConcreteExecution)
+		if domainID != "" && execution.DomainID != domainID {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(c.Context, listContextTimeout)
+		details, failure := runOrphanedHistoryBranches(ctx, iv, execution, deleteBranches)
+		cancel()
+		if failure != "" {
+			historyBranchesRow(rows, execution.DomainID).FailedExecutions++
+			fmt.Fprintf(getDeps(c).Output(), "Failed to process the history branches of workflow %v, run %v: %v\n",
+				execution.WorkflowID, execution.RunID, failure)
+			continue
+		}
+		if details == "" {
+			continue
+		}
+
+		var orphaned invariant.OrphanedHistoryBranchesDetails
+		if err := json.Unmarshal([]byte(details), &orphaned); err != nil {
+			return commoncli.Problem("Failed to decode orphaned history branches", err)
+		}
+		row := historyBranchesRow(rows, execution.DomainID)
+		row.OrphanedBranches += len(orphaned.Branches)
+		row.Size += orphaned.Size
+		if deleteBranches {
+			row.DeletedBranches += len(orphaned.Branches)
+		}
+	}
+	return nil
+}
+
+// runOrphanedHistoryBranches checks the execution, or deletes its orphaned branches when deleteBranches is set,
+// and returns the details of the orphaned branches, empty if there are none, or the reason of the failure
+func runOrphanedHistoryBranches(
+	ctx context.Context,
+	iv invariant.Invariant,
+	execution *entity.ConcreteExecution,
+	deleteBranches bool,
+) (details string, failure string) {
+	if deleteBranches {
+		result := iv.Fix(ctx, execution)
+		switch result.FixResultType {
+		case invariant.FixResultTypeSkipped:
+			return "", ""
+		case invariant.FixResultTypeFailed:
+			return "", result.Info + ": " + result.InfoDetails
+		}
+		return result.InfoDetails, ""
+	}
+
+	result := iv.Check(ctx, execution)
+	switch result.CheckResultType {
+	case invariant.CheckResultTypeHealthy:
+		return "", ""
+	case invariant.CheckResultTypeFailed:
+		return "", result.Info + ": " + result.InfoDetails
+	}
+	return result.InfoDetails, ""
+}
+
+func historyBranchesRow(rows map[string]*HistoryBranchesRow, domainID string) *HistoryBranchesRow {
+	row, ok := rows[domainID]
+	if !ok {
+		row = &HistoryBranchesRow{DomainID: domainID}
+		rows[domainID] = row
+	}
+	return row
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminDBHistoryBranches(t *testing.T) {
+	const (
+		shardID    = 7
+		domainID   = "test-domain-id"
+		workflowID = "test-workflow-id"
+		runID      = "test-run-id"
+		treeID     = "test-tree-id"
+	)
+	ownInfo := persistence.BuildHistoryGarbageCleanupInfo(domainID, workflowID, runID)
+	current := &shared.HistoryBranch{TreeID: common.StringPtr(treeID), BranchID: common.StringPtr("current-branch-id")}
+	orphaned := &shared.HistoryBranch{
+		TreeID:   common.StringPtr(treeID),
+		BranchID: common.StringPtr("orphaned-branch-id"),
+		Ancestors: []*shared.HistoryBranchRange{
+			{BranchID: common.StringPtr("current-branch-id"), BeginNodeID: common.Int64Ptr(1), EndNodeID: common.Int64Ptr(10)},
+		},
+	}
+	encoder := codec.NewThriftRWEncoder()
+	currentToken, err := encoder.Encode(current)
+	require.NoError(t, err)
+	versionHistories := &persistence.VersionHistories{
+		Histories: []*persistence.VersionHistory{{BranchToken: currentToken}},
+	}
+	forkTime := time.Now().Add(-2 * time.Hour)
+
+	expectScan := func(td *cliTestData, deleteErr *error) {
+		execManager := persistence.NewMockExecutionManager(td.ctrl)
+		historyManager := persistence.NewMockHistoryManager(td.ctrl)
+		td.mockManagerFactory.EXPECT().initializeExecutionManager(gomock.Any(), shardID).Return(execManager, nil)
+		td.mockManagerFactory.EXPECT().initializeHistoryManager(gomock.Any()).Return(historyManager, nil)
+		execManager.EXPECT().Close()
+		historyManager.EXPECT().Close()
+
+		execManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:   domainID,
+					WorkflowID: workflowID,
+					RunID:      runID,
+				},
+				VersionHistories: versionHistories,
+			}},
+		}, nil)
+		execManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{
+			State: &persistence.WorkflowMutableState{
+				ExecutionInfo:    &persistence.WorkflowExecutionInfo{},
+				VersionHistories: versionHistories,
+			},
+		}, nil)
+		historyManager.EXPECT().GetHistoryTree(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTreeResponse{
+			Branches: []*shared.HistoryBranch{current, orphaned},
+			BranchDetails: []persistence.HistoryBranchDetail{
+				{TreeID: treeID, BranchID: "current-branch-id", ForkTime: forkTime, Info: ownInfo},
+				{TreeID: treeID, BranchID: "orphaned-branch-id", ForkTime: forkTime, Info: ownInfo},
+			},
+		}, nil)
+		historyManager.EXPECT().ReadRawHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.ReadRawHistoryBranchResponse{
+			Size: 42,
+		}, nil)
+		if deleteErr != nil {
+			historyManager.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(*deleteErr)
+		}
+	}
+
+	tests := map[string]struct {
+		arguments   []clitest.CliArgument
+		setup       func(td *cliTestData)
+		contains    []string
+		errContains string
+	}{
+		"report": {
+			setup: func(td *cliTestData) {
+				expectScan(td, nil)
+			},
+			contains: []string{domainID, "42"},
+		},
+		"delete": {
+			arguments: []clitest.CliArgument{clitest.BoolArgument(FlagDelete, true)},
+			setup: func(td *cliTestData) {
+				var noErr error
+				expectScan(td, &noErr)
+			},
+			contains: []string{domainID, "42"},
+		},
+		"delete failure": {
+			arguments: []clitest.CliArgument{clitest.BoolArgument(FlagDelete, true)},
+			setup: func(td *cliTestData) {
+				deleteErr := fmt.Errorf("delete failed")
+				expectScan(td, &deleteErr)
+			},
+			contains: []string{"Failed to process the history branches of workflow test-workflow-id, run test-run-id: failed to delete history branch orphaned-branch-id: delete failed"},
+		},
+		"domain filter": {
+			arguments: []clitest.CliArgument{clitest.StringArgument(FlagDomainID, "other-domain-id")},
+			setup: func(td *cliTestData) {
+				execManager := persistence.NewMockExecutionManager(td.ctrl)
+				historyManager := persistence.NewMockHistoryManager(td.ctrl)
+				td.mockManagerFactory.EXPECT().initializeExecutionManager(gomock.Any(), shardID).Return(execManager, nil)
+				td.mockManagerFactory.EXPECT().initializeHistoryManager(gomock.Any()).Return(historyManager, nil)
+				execManager.EXPECT().Close()
+				historyManager.EXPECT().Close()
+				execManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+					Executions: []*persistence.ListConcreteExecutionsEntity{{
+						ExecutionInfo: &persistence.WorkflowExecutionInfo{
+							DomainID:   domainID,
+							WorkflowID: workflowID,
+							RunID:      runID,
+						},
+						VersionHistories: versionHistories,
+					}},
+				}, nil)
+			},
+		},
+		"init execution manager error": {
+			setup: func(td *cliTestData) {
+				td.mockManagerFactory.EXPECT().initializeExecutionManager(gomock.Any(), shardID).Return(nil, fmt.Errorf("init failed"))
+			},
+			errContains: "initialize execution manager",
+		},
+		"invalid min branch age": {
+			arguments:   []clitest.CliArgument{clitest.StringArgument(FlagMinBranchAge, "soon")},
+			setup:       func(td *cliTestData) {},
+			errContains: "invalid min_branch_age",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tc.setup(td)
+
+			arguments := append([]clitest.CliArgument{
+				clitest.IntArgument(FlagLowerShardBound, shardID),
+				clitest.IntArgument(FlagUpperShardBound, shardID),
+			}, tc.arguments...)
+			err := AdminDBHistoryBranches(clitest.NewCLIContext(t, td.app, arguments...))
+			if tc.errContains != "" {
+				assert.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			assert.NoError(t, err)
+			for _, s := range tc.contains {
+				assert.Contains(t, td.consoleOutput(), s)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+const lastReplicatedTaskNote = "NOTE: the last replicated task is kept in memory by each history shard. " +
+	"It only covers the tasks acknowledged since the shard was loaded, and is reset when the shard moves to another host."
+
+// DomainReplicationStatusRow is the replication status of a domain to one of its remote clusters
+type DomainReplicationStatusRow struct {
+	Cluster              string `header:"Cluster" json:"cluster"`
+	PendingTasks         string `header:"Pending Tasks" json:"pendingTasks"`
+	DLQMessages          int64  `header:"DLQ Messages" json:"dlqMessages"`
+	LastReplicatedTaskID int64  `header:"Last Replicated Task ID" json:"lastReplicatedTaskID"`
+	LastReplicatedTime   string `header:"Last Replicated Time" json:"lastReplicatedTime"`
+	EstimatedLag         string `header:"Estimated Lag" json:"estimatedLag"`
+}
+
+// AdminGetDomainReplicationStatus shows the replication status of a domain to each of its remote clusters
+func AdminGetDomainReplicationStatus(c *cli.Context) error {
+	adminClient, err := grpcAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := adminClient.GetDomainReplicationStatus(ctx, &types.GetDomainReplicationStatusRequest{
+		Domain: domainName,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to get domain replication status", err)
+	}
+
+	// the notes are written to the progress output so that they are not mixed with a json output
+	progress := getDeps(c).Progress()
+	if len(resp.FailedShards) > 0 {
+		fmt.Fprintf(progress, "WARNING: the status of shards %v could not be read, their tasks are not included below\n", resp.FailedShards)
+	}
+
+	if len(resp.Clusters) == 0 {
+		fmt.Fprintf(getDeps(c).Output(), "Domain %s is not replicated to any remote cluster\n", domainName)
+		return nil
+	}
+
+	table := make([]DomainReplicationStatusRow, 0, len(resp.Clusters))
+	for _, status := range resp.Clusters {
+		table = append(table, newDomainReplicationStatusRow(status))
+	}
+	if err := Render(c, table, RenderOptions{Color: true, DefaultTemplate: templateTable}); err != nil {
+		return err
+	}
+	fmt.Fprintln(progress, lastReplicatedTaskNote)
+	return nil
+}
+
+func newDomainReplicationStatusRow(status *types.ReplicationClusterStatus) DomainReplicationStatusRow {
+	row := DomainReplicationStatusRow{
+		Cluster:              status.ClusterName,
+		PendingTasks:         fmt.Sprintf("%d", status.PendingTaskCount),
+		DLQMessages:          status.DLQMessageCount,
+		LastReplicatedTaskID: status.LastReplicatedTaskID,
+		LastReplicatedTime:   "-",
+		EstimatedLag:         status.EstimatedLag.Round(time.Second).String(),
+	}
+	// the pending tasks are only counted up to a limit on each shard
+	if status.HasMorePendingTasks {
+		row.PendingTasks += "+"
+	}
+	if status.LastReplicatedTimestamp != nil {
+		row.LastReplicatedTime = time.Unix(0, *status.LastReplicatedTimestamp).Format(defaultDateTimeFormat)
+	}
+	return row
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminGetDomainReplicationStatus(t *testing.T) {
+	lastReplicatedTimestamp := time.Unix(1000, 0).UnixNano()
+
+	tests := []struct {
+		name             string
+		cmdline          string
+		setupMock        func(td *cliTestData)
+		expectedOutput   string
+		expectedJSON     string
+		expectedProgress string
+		errContains      string // empty if no error is expected
+	}{
+		{
+			name:        "domain is missing",
+			cmdline:     "cadence admin domain replication-status",
+			setupMock:   func(td *cliTestData) {},
+			errContains: "Required flag not found",
+		},
+		{
+			name:    "failed to get replication status",
+			cmdline: "cadence --domain test-domain admin domain replication-status",
+			setupMock: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().GetDomainReplicationStatus(gomock.Any(), &types.GetDomainReplicationStatusRequest{Domain: "test-domain"}).
+					Return(nil, assert.AnError)
+			},
+			errContains: "Failed to get domain replication status",
+		},
+		{
+			name:    "domain is not replicated",
+			cmdline: "cadence --domain test-domain admin domain replication-status",
+			setupMock: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().GetDomainReplicationStatus(gomock.Any(), &types.GetDomainReplicationStatusRequest{Domain: "test-domain"}).
+					Return(&types.GetDomainReplicationStatusResponse{Domain: "test-domain"}, nil)
+			},
+			expectedOutput: "Domain test-domain is not replicated to any remote cluster\n",
+		},
+		{
+			name:    "success",
+			cmdline: "cadence --domain test-domain admin domain replication-status --format json",
+			setupMock: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().GetDomainReplicationStatus(gomock.Any(), &types.GetDomainReplicationStatusRequest{Domain: "test-domain"}).
+					Return(&types.GetDomainReplicationStatusResponse{
+						Domain: "test-domain",
+						Clusters: []*types.ReplicationClusterStatus{
+							{
+								ClusterName: "cluster-a",
+							},
+							{
+								ClusterName:             "cluster-b",
+								LastReplicatedTaskID:    20,
+								LastReplicatedTimestamp: &lastReplicatedTimestamp,
+								PendingTaskCount:        10000,
+								HasMorePendingTasks:     true,
+								DLQMessageCount:         2,
+								EstimatedLag:            90*time.Second + 400*time.Millisecond,
+							},
+						},
+					}, nil)
+			},
+			expectedJSON: `[{"cluster":"cluster-a","pendingTasks":"0","dlqMessages":0,"lastReplicatedTaskID":0,"lastReplicatedTime":"-","estimatedLag":"0s"},` +
+				`{"cluster":"cluster-b","pendingTasks":"10000+","dlqMessages":2,"lastReplicatedTaskID":20,"lastReplicatedTime":"` +
+				time.Unix(1000, 0).Format(defaultDateTimeFormat) + `","estimatedLag":"1m30s"}]`,
+			expectedProgress: lastReplicatedTaskNote + "\n",
+		},
+		{
+			name:    "some shards failed",
+			cmdline: "cadence --domain test-domain admin domain replication-status --format json",
+			setupMock: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().GetDomainReplicationStatus(gomock.Any(), &types.GetDomainReplicationStatusRequest{Domain: "test-domain"}).
+					Return(&types.GetDomainReplicationStatusResponse{
+						Domain:       "test-domain",
+						Clusters:     []*types.ReplicationClusterStatus{{ClusterName: "cluster-a"}},
+						FailedShards: []int32{3, 7},
+					}, nil)
+			},
+			expectedJSON: `[{"cluster":"cluster-a","pendingTasks":"0","dlqMessages":0,"lastReplicatedTaskID":0,"lastReplicatedTime":"-","estimatedLag":"0s"}]`,
+			expectedProgress: "WARNING: the status of shards [3 7] could not be read, their tasks are not included below\n" +
+				lastReplicatedTaskNote + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.setupMock(td)
+
+			err := clitest.RunCommandLine(t, td.app, tt.cmdline)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				if tt.expectedJSON != "" {
+					assert.JSONEq(t, tt.expectedJSON, td.consoleOutput())
+				} else {
+					assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+				}
+				assert.Equal(t, tt.expectedProgress, td.progressOutput())
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/persistencemigration"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+const (
+	defaultPersistenceMigrationConcurrency      = 10
+	defaultPersistenceMigrationTimeoutInSeconds = 7 * 24 * 60 * 60
+)
+
+// AdminPersistenceMigrationStart starts the persistence migration backfill workflow
+func AdminPersistenceMigrationStart(c *cli.Context) error {
+	params := persistencemigration.BackfillParams{
+		Concurrency:   c.Int(FlagConcurrency),
+		Reverse:       c.Bool(FlagReverse),
+		SkipDomains:   c.Bool(FlagSkipDomains),
+		SkipTaskLists: c.Bool(FlagSkipTaskLists),
+	}
+	if c.IsSet(FlagShards) {
+		shardIDs, err := parseIntMultiRange(c.String(FlagShards))
+		if err != nil {
+			return commoncli.Problem("Failed to parse shard IDs", err)
+		}
+		params.ShardIDs = shardIDs
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return commoncli.Problem("Failed to serialize backfill params", err)
+	}
+
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	op, err := getOperatorFn()
+	if err != nil {
+		return commoncli.Problem("Error in getting operator: ", err)
+	}
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		constants.MemoKeyForOperator: op,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to serialize memo", err)
+	}
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              constants.SystemLocalDomainName,
+		RequestID:                           uuidFn(),
+		WorkflowID:                          persistencemigration.BackfillWorkflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: persistencemigration.TaskListName},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(c.Int(FlagExecutionTimeout))),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: persistencemigration.BackfillWorkflowTypeName},
+		Input:                               input,
+	}
+	wf, err := client.StartWorkflowExecution(tcCtx, request)
+	if err != nil {
+		return commoncli.Problem("Failed to start persistence migration backfill workflow", err)
+	}
+	fmt.Println("Persistence migration backfill workflow started")
+	fmt.Println("wid: " + persistencemigration.BackfillWorkflowID)
+	fmt.Println("rid: " + wf.GetRunID())
+	return nil
+}
+
+// AdminPersistenceMigrationDescribe queries the progress of the persistence migration backfill workflow
+func AdminPersistenceMigrationDescribe(c *cli.Context) error {
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return err
+	}
+	tcCtx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	queryResp, err := client.QueryWorkflow(tcCtx, &types.QueryWorkflowRequest{
+		Domain: constants.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: persistencemigration.BackfillWorkflowID,
+			RunID:      getRunID(c),
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: persistencemigration.QueryType,
+		},
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to query persistence migration backfill workflow", err)
+	}
+	if queryResp.GetQueryResult() == nil {
+		return commoncli.Problem("QueryResult has no value", nil)
+	}
+	var queryResult persistencemigration.QueryResult
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &queryResult); err != nil {
+		return commoncli.Problem("Unable to deserialize QueryResult", err)
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), queryResult)
+	return nil
+}
+
+// AdminPersistenceMigrationSetMode sets the persistence migration mode of the cluster or of the given shards,
+// the values of the other shards are kept. The values are read and written back without any concurrency control,
+// so the command must not be run concurrently. It requires the dynamic config to be kept in the config store,
+// as the other dynamic config clients can't list the values.
+func AdminPersistenceMigrationSetMode(c *cli.Context) error {
+	mode, err := migration.ParseMode(c.String(FlagMigrationMode))
+	if err != nil {
+		return commoncli.Problem("Invalid persistence migration mode", err)
+	}
+	var shardIDs []int
+	if c.IsSet(FlagShards) {
+		if shardIDs, err = parseIntMultiRange(c.String(FlagShards)); err != nil {
+			return commoncli.Problem("Failed to parse shard IDs", err)
+		}
+	}
+
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	configName := dynamicproperties.PersistenceMigrationMode.String()
+	listResp, err := adminClient.ListDynamicConfig(ctx, &types.ListDynamicConfigRequest{ConfigName: configName})
+	if err != nil {
+		return commoncli.Problem("Failed to list the persistence migration modes, "+
+			"set-mode requires the frontend to use the config store as its dynamic config client (dynamicconfig.client: configstore)", err)
+	}
+	var existing []*types.DynamicConfigValue
+	for _, entry := range listResp.Entries {
+		if entry.Name == configName {
+			existing = entry.Values
+		}
+	}
+	values, err := mergePersistenceMigrationModeValues(existing, mode, shardIDs)
+	if err != nil {
+		return commoncli.Problem("Failed to merge dynamic config values", err)
+	}
+
+	err = adminClient.UpdateDynamicConfig(ctx, &types.UpdateDynamicConfigRequest{
+		ConfigName:   configName,
+		ConfigValues: values,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to update dynamic config value", err)
+	}
+	if len(shardIDs) == 0 {
+		fmt.Printf("Persistence migration mode set to %q\n", mode)
+	} else {
+		fmt.Printf("Persistence migration mode of shards %v set to %q\n", shardIDs, mode)
+	}
+	return nil
+}
+
+// mergePersistenceMigrationModeValues replaces the value of the cluster when shardIDs is empty,
+// the values of the given shards otherwise
+func mergePersistenceMigrationModeValues(
+	existing []*types.DynamicConfigValue,
+	mode migration.Mode,
+	shardIDs []int,
+) ([]*types.DynamicConfigValue, error) {
+	replaced := make(map[int]bool, len(shardIDs))
+	for _, shardID := range shardIDs {
+		replaced[shardID] = true
+	}
+
+	values := make([]*types.DynamicConfigValue, 0, len(existing)+len(shardIDs)+1)
+	for _, value := range existing {
+		if len(value.Filters) == 0 {
+			if len(shardIDs) > 0 {
+				values = append(values, value)
+			}
+			continue
+		}
+		if len(value.Filters) == 1 && value.Filters[0].Name == dynamicproperties.ShardID.String() {
+			var shardID int
+			if err := json.Unmarshal(value.Filters[0].Value.GetData(), &shardID); err != nil {
+				return nil, err
+			}
+			if replaced[shardID] {
+				continue
+			}
+		}
+		values = append(values, value)
+	}
+
+	if len(shardIDs) == 0 {
+		value, err := convertFromInputValue(&cliValue{Value: string(mode)})
+		if err != nil {
+			return nil, err
+		}
+		return append(values, value), nil
+	}
+	for _, shardID := range shardIDs {
+		value, err := convertFromInputValue(&cliValue{
+			Value:   string(mode),
+			Filters: []*cliFilter{{Name: dynamicproperties.ShardID.String(), Value: shardID}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
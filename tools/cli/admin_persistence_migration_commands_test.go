@@ -0,0 +1,224 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence/wrappers/migration"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/persistencemigration"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminPersistenceMigrationStart(t *testing.T) {
+	oldUUIDFn := uuidFn
+	uuidFn = func() string { return "test-uuid" }
+	oldGetOperatorFn := getOperatorFn
+	getOperatorFn = func() (string, error) { return "test-user", nil }
+	defer func() {
+		uuidFn = oldUUIDFn
+		getOperatorFn = oldGetOperatorFn
+	}()
+
+	tests := []struct {
+		name        string
+		cmdline     string
+		setupMock   func(t *testing.T, td *cliTestData)
+		errContains string // empty if no error is expected
+	}{
+		{
+			name:    "all shards",
+			cmdline: `cadence admin persistence-migration start`,
+			setupMock: func(t *testing.T, td *cliTestData) {
+				td.mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, &types.StartWorkflowExecutionRequest{
+							Domain:                              constants.SystemLocalDomainName,
+							RequestID:                           "test-uuid",
+							WorkflowID:                          persistencemigration.BackfillWorkflowID,
+							WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+							TaskList:                            &types.TaskList{Name: persistencemigration.TaskListName},
+							Input:                               []byte(`{"ShardIDs":null,"Concurrency":10,"Reverse":false,"SkipDomains":false,"SkipTaskLists":false}`),
+							ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(defaultPersistenceMigrationTimeoutInSeconds),
+							TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+							Memo: mustGetWorkflowMemo(t, map[string]interface{}{
+								constants.MemoKeyForOperator: "test-user",
+							}),
+							WorkflowType: &types.WorkflowType{Name: persistencemigration.BackfillWorkflowTypeName},
+						}, request)
+						return &types.StartWorkflowExecutionResponse{RunID: "run"}, nil
+					})
+			},
+		},
+		{
+			name:    "some shards in reverse",
+			cmdline: `cadence admin persistence-migration start --shards 1,3-4 --concurrency 2 --reverse --skip_domains --skip_tasklists`,
+			setupMock: func(t *testing.T, td *cliTestData) {
+				td.mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, `{"ShardIDs":[1,3,4],"Concurrency":2,"Reverse":true,"SkipDomains":true,"SkipTaskLists":true}`, string(request.Input))
+						return &types.StartWorkflowExecutionResponse{RunID: "run"}, nil
+					})
+			},
+		},
+		{
+			name:        "invalid shards",
+			cmdline:     `cadence admin persistence-migration start --shards a-b`,
+			setupMock:   func(t *testing.T, td *cliTestData) {},
+			errContains: "Failed to parse shard IDs",
+		},
+		{
+			name:    "failed to start the workflow",
+			cmdline: `cadence admin persistence-migration start`,
+			setupMock: func(t *testing.T, td *cliTestData) {
+				td.mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			errContains: "Failed to start persistence migration backfill workflow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.setupMock(t, td)
+
+			err := clitest.RunCommandLine(t, td.app, tt.cmdline)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestAdminPersistenceMigrationDescribe(t *testing.T) {
+	td := newCLITestData(t)
+	td.mockFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.QueryWorkflowRequest, _ ...yarpc.CallOption) (*types.QueryWorkflowResponse, error) {
+			assert.Equal(t, persistencemigration.BackfillWorkflowID, request.Execution.WorkflowID)
+			assert.Equal(t, persistencemigration.QueryType, request.Query.QueryType)
+			return &types.QueryWorkflowResponse{
+				QueryResult: []byte(`{"State":"running","TotalShards":4,"CompletedShards":[0]}`),
+			}, nil
+		})
+
+	err := clitest.RunCommandLine(t, td.app, `cadence admin persistence-migration describe`)
+	require.NoError(t, err)
+	assert.Contains(t, td.consoleOutput(), `"TotalShards": 4`)
+}
+
+func TestAdminPersistenceMigrationSetMode(t *testing.T) {
+	shardValue := func(shardID int, mode migration.Mode) *types.DynamicConfigValue {
+		value, err := convertFromInputValue(&cliValue{
+			Value:   string(mode),
+			Filters: []*cliFilter{{Name: "shardID", Value: shardID}},
+		})
+		require.NoError(t, err)
+		return value
+	}
+	clusterValue := func(mode migration.Mode) *types.DynamicConfigValue {
+		value, err := convertFromInputValue(&cliValue{Value: string(mode)})
+		require.NoError(t, err)
+		return value
+	}
+	existing := []*types.DynamicConfigValue{
+		clusterValue(migration.ModeDisabled),
+		shardValue(1, migration.ModeDualWrite),
+		shardValue(2, migration.ModeDualWrite),
+	}
+
+	tests := []struct {
+		name        string
+		cmdline     string
+		listErr     error
+		wantValues  []*types.DynamicConfigValue
+		errContains string // empty if no error is expected
+	}{
+		{
+			name:    "cluster",
+			cmdline: `cadence admin persistence-migration set-mode --mode shadow-read`,
+			wantValues: []*types.DynamicConfigValue{
+				shardValue(1, migration.ModeDualWrite),
+				shardValue(2, migration.ModeDualWrite),
+				clusterValue(migration.ModeShadowRead),
+			},
+		},
+		{
+			name:    "shards",
+			cmdline: `cadence admin persistence-migration set-mode --mode cutover --shards 2-3`,
+			wantValues: []*types.DynamicConfigValue{
+				clusterValue(migration.ModeDisabled),
+				shardValue(1, migration.ModeDualWrite),
+				shardValue(2, migration.ModeCutover),
+				shardValue(3, migration.ModeCutover),
+			},
+		},
+		{
+			name:        "invalid mode",
+			cmdline:     `cadence admin persistence-migration set-mode --mode unknown`,
+			errContains: "Invalid persistence migration mode",
+		},
+		{
+			name:        "no config store",
+			cmdline:     `cadence admin persistence-migration set-mode --mode cutover`,
+			listErr:     &types.InternalServiceError{Message: "not supported for file based client"},
+			errContains: "set-mode requires the frontend to use the config store",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			if tt.listErr != nil {
+				td.mockAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), gomock.Any()).Return(nil, tt.listErr)
+			}
+			if tt.wantValues != nil {
+				td.mockAdminClient.EXPECT().ListDynamicConfig(gomock.Any(), &types.ListDynamicConfigRequest{ConfigName: "system.persistenceMigrationMode"}).
+					Return(&types.ListDynamicConfigResponse{
+						Entries: []*types.DynamicConfigEntry{{Name: "system.persistenceMigrationMode", Values: existing}},
+					}, nil)
+				td.mockAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *types.UpdateDynamicConfigRequest, _ ...yarpc.CallOption) error {
+						assert.Equal(t, "system.persistenceMigrationMode", request.ConfigName)
+						assert.Equal(t, tt.wantValues, request.ConfigValues)
+						return nil
+					})
+			}
+
+			err := clitest.RunCommandLine(t, td.app, tt.cmdline)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
+}
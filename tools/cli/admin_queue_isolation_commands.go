@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// AdminIsolateDomain isolates the history tasks of a domain into a dedicated virtual queue on all shards
+func AdminIsolateDomain(c *cli.Context) error {
+	return updateDomainIsolation(c, true)
+}
+
+// AdminReleaseDomain moves the history tasks of an isolated domain back to the default virtual queue on all shards
+func AdminReleaseDomain(c *cli.Context) error {
+	return updateDomainIsolation(c, false)
+}
+
+func updateDomainIsolation(c *cli.Context, isolated bool) error {
+	adminClient, err := grpcAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := adminClient.UpdateDomainIsolation(ctx, &types.UpdateDomainIsolationRequest{
+		Domain:   domainName,
+		Isolated: isolated,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to update domain isolation", err)
+	}
+
+	output := getDeps(c).Output()
+	if len(resp.GetFailedShards()) > 0 {
+		fmt.Fprintf(output, "WARNING: shards %v could not be updated, run the command again to retry them\n", resp.GetFailedShards())
+	}
+	if isolated {
+		fmt.Fprintf(output, "Domain %s is isolated, the rate and pending task limits of its queue can be set in the %s dynamic config\n",
+			domainName, dynamicproperties.QueueIsolatedDomainSettings.String())
+	} else {
+		fmt.Fprintf(output, "Domain %s is released\n", domainName)
+	}
+	return nil
+}
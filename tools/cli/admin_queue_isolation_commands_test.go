@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminUpdateDomainIsolation(t *testing.T) {
+	tests := []struct {
+		name           string
+		cmdline        string
+		setupMock      func(td *cliTestData)
+		expectedOutput string
+		errContains    string // empty if no error is expected
+	}{
+		{
+			name:        "isolate: domain is missing",
+			cmdline:     "cadence admin queue isolate-domain",
+			setupMock:   func(td *cliTestData) {},
+			errContains: "Required flag not found",
+		},
+		{
+			name:    "isolate: failed to update domain isolation",
+			cmdline: "cadence --domain test-domain admin queue isolate-domain",
+			setupMock: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().UpdateDomainIsolation(gomock.Any(), &types.UpdateDomainIsolationRequest{Domain: "test-domain", Isolated: true}).
+					Return(nil, assert.AnError)
+			},
+			errContains: "Failed to update domain isolation",
+		},
+		{
+			name:    "isolate: success",
+			cmdline: "cadence --domain test-domain admin queue isolate-domain",
+			setupMock: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().UpdateDomainIsolation(gomock.Any(), &types.UpdateDomainIsolationRequest{Domain: "test-domain", Isolated: true}).
+					Return(&types.UpdateDomainIsolationResponse{}, nil)
+			},
+			expectedOutput: "Domain test-domain is isolated, the rate and pending task limits of its queue can be set in the history.queueIsolatedDomainSettings dynamic config\n",
+		},
+		{
+			name:    "release: some shards failed",
+			cmdline: "cadence --domain test-domain admin queue release-domain",
+			setupMock: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().UpdateDomainIsolation(gomock.Any(), &types.UpdateDomainIsolationRequest{Domain: "test-domain", Isolated: false}).
+					Return(&types.UpdateDomainIsolationResponse{FailedShards: []int32{3, 7}}, nil)
+			},
+			expectedOutput: "WARNING: shards [3 7] could not be updated, run the command again to retry them\n" +
+				"Domain test-domain is released\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.setupMock(td)
+
+			err := clitest.RunCommandLine(t, td.app, tt.cmdline)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, td.consoleOutput())
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
+}
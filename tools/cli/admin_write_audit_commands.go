@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence/wrappers/sampled"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// WriteAuditRow is a record of the persistence write audit trail of a workflow run
+type WriteAuditRow struct {
+	Timestamp   time.Time `header:"Timestamp" json:"timestamp"`
+	Host        string    `header:"Host" json:"host"`
+	Operation   string    `header:"Operation" json:"operation"`
+	ShardID     int       `header:"Shard" json:"shardID"`
+	RangeID     int64     `header:"Range ID" json:"rangeID"`
+	Mode        int       `header:"Mode" json:"mode"`
+	State       int       `header:"State" json:"state"`
+	NextEventID int64     `header:"Next Event ID" json:"nextEventID"`
+	Condition   int64     `header:"Condition" json:"condition"`
+	Error       string    `header:"Error" json:"error,omitempty"`
+}
+
+// AdminShowWriteAudit shows the sampled persistence write audit trail of a workflow run,
+// read from the blobstore of the service configuration
+func AdminShowWriteAudit(c *cli.Context) error {
+	domainID, err := getRequiredOption(c, FlagDomainID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	workflowID, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	runID, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+
+	cfg, err := getDeps(c).ServerConfig(c)
+	if err != nil {
+		return err
+	}
+	if cfg.Blobstore.Filestore == nil {
+		return commoncli.Problem("No blobstore is configured in the service configuration", nil)
+	}
+	client, err := filestore.NewFilestoreClient(cfg.Blobstore.Filestore)
+	if err != nil {
+		return commoncli.Problem("Error in creating blobstore client: ", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	store := sampled.NewBlobstoreWriteAuditStore(client, dynamicproperties.GetIntPropertyFn(0))
+	records, err := store.Get(ctx, domainID, workflowID, runID)
+	if err != nil {
+		return commoncli.Problem("Error in reading write audit trail: ", err)
+	}
+	if len(records) == 0 {
+		fmt.Fprintln(getDeps(c).Output(), "No write audit record found, check that history.enablePersistenceWriteAudit is enabled for the domain")
+		return nil
+	}
+
+	table := make([]WriteAuditRow, 0, len(records))
+	for _, record := range records {
+		table = append(table, WriteAuditRow{
+			Timestamp:   record.Timestamp,
+			Host:        record.Host,
+			Operation:   record.Operation,
+			ShardID:     record.ShardID,
+			RangeID:     record.RangeID,
+			Mode:        record.Mode,
+			State:       record.State,
+			NextEventID: record.NextEventID,
+			Condition:   record.Condition,
+			Error:       record.Error,
+		})
+	}
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true, PrintDateTime: true})
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence/wrappers/sampled"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+func TestAdminShowWriteAudit(t *testing.T) {
+	const cmdline = `cadence admin workflow write-audit --domain_id test-domain-id --wid test-workflow-id --rid test-run-id`
+
+	newApp := func(cfg *config.Config) (*testIOHandler, func(string) error) {
+		ioHandler := &testIOHandler{}
+		app := NewCliApp(&clientFactoryMock{config: cfg}, WithIOHandler(ioHandler))
+		return ioHandler, func(cmdline string) error {
+			return clitest.RunCommandLine(t, app, cmdline)
+		}
+	}
+	blobstoreConfig := func(dir string) *config.Config {
+		return &config.Config{
+			Blobstore: config.Blobstore{
+				Filestore: &config.FileBlobstore{OutputDirectory: dir},
+			},
+		}
+	}
+
+	t.Run("records", func(t *testing.T) {
+		cfg := blobstoreConfig(t.TempDir())
+		client, err := filestore.NewFilestoreClient(cfg.Blobstore.Filestore)
+		require.NoError(t, err)
+		store := sampled.NewBlobstoreWriteAuditStore(client, dynamicproperties.GetIntPropertyFn(10))
+		require.NoError(t, store.Append(context.Background(), []*sampled.WriteAuditRecord{{
+			Timestamp:   time.Unix(1700000000, 0),
+			Host:        "test-host",
+			Operation:   "UpdateWorkflowExecution",
+			ShardID:     1,
+			RangeID:     2,
+			DomainID:    "test-domain-id",
+			WorkflowID:  "test-workflow-id",
+			RunID:       "test-run-id",
+			NextEventID: 10,
+			Condition:   5,
+		}}))
+
+		ioHandler, run := newApp(cfg)
+		require.NoError(t, run(cmdline))
+		assert.Contains(t, ioHandler.outputBytes.String(), "test-host")
+		assert.Contains(t, ioHandler.outputBytes.String(), "UpdateWorkflowExecution")
+	})
+
+	t.Run("no record", func(t *testing.T) {
+		ioHandler, run := newApp(blobstoreConfig(t.TempDir()))
+		require.NoError(t, run(cmdline))
+		assert.Contains(t, ioHandler.outputBytes.String(), "No write audit record found")
+	})
+
+	t.Run("no blobstore", func(t *testing.T) {
+		_, run := newApp(&config.Config{})
+		assert.ErrorContains(t, run(cmdline), "No blobstore is configured")
+	})
+
+	t.Run("missing run ID", func(t *testing.T) {
+		_, run := newApp(blobstoreConfig(t.TempDir()))
+		assert.Error(t, run(`cadence admin workflow write-audit --domain_id test-domain-id --wid test-workflow-id`))
+	})
+}
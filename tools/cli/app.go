@@ -239,6 +239,12 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 					Usage:       "Run admin operation on config store",
 					Subcommands: newAdminConfigStoreCommands(),
 				},
+				{
+					Name:        "persistence-migration",
+					Aliases:     []string{"pm"},
+					Usage:       "Run admin operation on persistence migration",
+					Subcommands: newAdminPersistenceMigrationCommands(),
+				},
 			},
 		},
 		{
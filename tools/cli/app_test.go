@@ -108,7 +108,8 @@ var domainName = "cli-test-domain"
 
 // Implements IOHandler to be used for validation in tests
 type testIOHandler struct {
-	outputBytes bytes.Buffer
+	outputBytes   bytes.Buffer
+	progressBytes bytes.Buffer
 }
 
 func (t *testIOHandler) Input() io.Reader {
@@ -120,7 +121,7 @@ func (t *testIOHandler) Output() io.Writer {
 }
 
 func (t *testIOHandler) Progress() io.Writer {
-	return os.Stdout
+	return &t.progressBytes
 }
 
 func TestCLIAppSuite(t *testing.T) {
@@ -472,6 +473,30 @@ func (s *cliAppSuite) TestCancelWorkflow_Failed() {
 	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"}))
 }
 
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), &types.PauseWorkflowExecutionRequest{
+		Domain:            domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		Reason:            "incident",
+		Identity:          "operator",
+	}).Return(&types.PauseWorkflowExecutionResponse{}, nil)
+	s.Nil(s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid", "-r", "rid", "--reason", "incident", "--identity", "operator"}))
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{"faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"}))
+}
+
+func (s *cliAppSuite) TestResumeWorkflow() {
+	s.serverFrontendClient.EXPECT().ResumeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.ResumeWorkflowExecutionResponse{}, nil)
+	s.Nil(s.app.Run([]string{"", "--do", domainName, "workflow", "resume", "-w", "wid"}))
+}
+
+func (s *cliAppSuite) TestResumeWorkflow_MissingWorkflowID() {
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "resume"}))
+}
+
 func (s *cliAppSuite) TestSignalWorkflow() {
 	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name"})
@@ -483,6 +508,54 @@ func (s *cliAppSuite) TestSignalWorkflow_Failed() {
 	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name"}))
 }
 
+func (s *cliAppSuite) TestPauseActivity() {
+	s.serverFrontendClient.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).Return(&types.PauseActivityResponse{}, nil)
+	s.Nil(s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid", "--aid", "aid", "--reason", "investigating"}))
+}
+
+func (s *cliAppSuite) TestPauseActivity_MissingActivityID() {
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid"}))
+}
+
+func (s *cliAppSuite) TestUnpauseActivity() {
+	s.serverFrontendClient.EXPECT().UnpauseActivity(gomock.Any(), gomock.Any()).Return(&types.UnpauseActivityResponse{}, nil)
+	s.Nil(s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "unpause", "-w", "wid", "--aid", "aid", "--reset_attempts"}))
+}
+
+func (s *cliAppSuite) TestResetActivity() {
+	s.serverFrontendClient.EXPECT().ResetActivity(gomock.Any(), gomock.Any()).Return(&types.ResetActivityResponse{}, nil)
+	s.Nil(s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "reset", "-w", "wid", "--aid", "aid"}))
+}
+
+func (s *cliAppSuite) TestResetActivity_Failed() {
+	s.serverFrontendClient.EXPECT().ResetActivity(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{"faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "reset", "-w", "wid", "--aid", "aid"}))
+}
+
+func (s *cliAppSuite) TestUpdateActivityOptions() {
+	s.serverFrontendClient.EXPECT().UpdateActivityOptions(gomock.Any(), &types.UpdateActivityOptionsRequest{
+		Domain:            domainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid"},
+		ActivityID:        "aid",
+		Options: &types.ActivityOptions{
+			TaskList:                   &types.TaskList{Name: "new-tl"},
+			StartToCloseTimeoutSeconds: common.Int32Ptr(60),
+			RetryPolicy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 10,
+				BackoffCoefficient:       1.0,
+				MaximumAttempts:          3,
+			},
+		},
+		Identity: "operator",
+	}).Return(&types.UpdateActivityOptionsResponse{}, nil)
+	s.Nil(s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "update-options", "-w", "wid", "--aid", "aid",
+		"--tl", "new-tl", "--start_to_close_timeout_seconds", "60", "--retry_attempts", "3", "--identity", "operator"}))
+}
+
+func (s *cliAppSuite) TestUpdateActivityOptions_NoOptions() {
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "update-options", "-w", "wid", "--aid", "aid"}))
+}
+
 func (s *cliAppSuite) TestQueryWorkflowUsingStackTrace() {
 	resp := &types.QueryWorkflowResponse{
 		QueryResult: []byte("query-result"),
@@ -845,13 +918,13 @@ func (s *cliAppSuite) TestAnyToString_DecodeMapValues() {
 	execution := &types.WorkflowExecutionInfo{
 		Memo: &types.Memo{Fields: fields},
 	}
-	s.Equal("{HistoryLength:0, Memo:{Fields:map{TestKey:testValue}}, IsCron:false, PartitionConfig:map{}}", anyToString(execution, true, 0))
+	s.Equal("{HistoryLength:0, Memo:{Fields:map{TestKey:testValue}}, IsCron:false, PartitionConfig:map{}, IsPaused:false}", anyToString(execution, true, 0))
 
 	fields["TestKey2"] = []byte(`anotherTestValue`)
 	execution.Memo = &types.Memo{Fields: fields}
 	got := anyToString(execution, true, 0)
-	expected := got == "{HistoryLength:0, Memo:{Fields:map{TestKey2:anotherTestValue, TestKey:testValue}}, IsCron:false, PartitionConfig:map{}}" ||
-		got == "{HistoryLength:0, Memo:{Fields:map{TestKey:testValue, TestKey2:anotherTestValue}}, IsCron:false, PartitionConfig:map{}}"
+	expected := got == "{HistoryLength:0, Memo:{Fields:map{TestKey2:anotherTestValue, TestKey:testValue}}, IsCron:false, PartitionConfig:map{}, IsPaused:false}" ||
+		got == "{HistoryLength:0, Memo:{Fields:map{TestKey:testValue, TestKey2:anotherTestValue}}, IsCron:false, PartitionConfig:map{}, IsPaused:false}"
 	s.True(expected)
 }
 
@@ -43,7 +43,7 @@ import (
 
 var supportedDBs = append(sql.GetRegisteredPluginNames(), "cassandra")
 
-func getDBFlags() []cli.Flag {
+func getServiceConfigFlags() []cli.Flag {
 	return []cli.Flag{
 		&cli.StringFlag{
 			Name:    FlagServiceConfigDir,
@@ -64,6 +64,11 @@ func getDBFlags() []cli.Flag {
 			Usage:   "service zone for loading service configuration",
 			EnvVars: []string{config.EnvKeyAvailabilityZone},
 		},
+	}
+}
+
+func getDBFlags() []cli.Flag {
+	return append(getServiceConfigFlags(),
 		&cli.StringFlag{
 			Name:  FlagDBType,
 			Value: "cassandra",
@@ -150,7 +155,7 @@ func getDBFlags() []cli.Flag {
 			Usage: "target rps of database queries",
 			Value: 100,
 		},
-	}
+	)
 }
 
 type ManagerFactory interface {
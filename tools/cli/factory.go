@@ -45,6 +45,7 @@ import (
 
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
@@ -132,6 +133,8 @@ func (b *clientFactory) ServerFrontendClient(c *cli.Context) (frontend.Client, e
 			apiv1.NewWorkerAPIYARPCClient(clientConfig),
 			apiv1.NewVisibilityAPIYARPCClient(clientConfig),
 			apiv1.NewScheduleAPIYARPCClient(clientConfig),
+			frontendv1.NewScheduleAPIYARPCClient(clientConfig),
+			frontendv1.NewFrontendAPIYARPCClient(clientConfig),
 		), nil
 	}
 	return thrift.NewFrontendClient(serverFrontend.New(clientConfig)), nil
@@ -145,7 +148,7 @@ func (b *clientFactory) ServerAdminClient(c *cli.Context) (admin.Client, error)
 	}
 	clientConfig := b.dispatcher.ClientConfig(cadenceFrontendService)
 	if c.String(FlagTransport) == grpcTransport {
-		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig)), nil
+		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig), frontendv1.NewFrontendAdminAPIYARPCClient(clientConfig)), nil
 	}
 	return thrift.NewAdminClient(serverAdmin.New(clientConfig)), nil
 }
@@ -164,6 +167,8 @@ func (b *clientFactory) ServerFrontendClientForMigration(c *cli.Context) (fronte
 			apiv1.NewWorkerAPIYARPCClient(clientConfig),
 			apiv1.NewVisibilityAPIYARPCClient(clientConfig),
 			apiv1.NewScheduleAPIYARPCClient(clientConfig),
+			frontendv1.NewScheduleAPIYARPCClient(clientConfig),
+			frontendv1.NewFrontendAPIYARPCClient(clientConfig),
 		), nil
 	}
 	return thrift.NewFrontendClient(serverFrontend.New(clientConfig)), nil
@@ -177,11 +182,38 @@ func (b *clientFactory) ServerAdminClientForMigration(c *cli.Context) (admin.Cli
 	}
 	clientConfig := b.dispatcherMigration.ClientConfig(cadenceFrontendService)
 	if c.String(FlagTransport) == grpcTransport {
-		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig)), nil
+		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig), frontendv1.NewFrontendAdminAPIYARPCClient(clientConfig)), nil
 	}
 	return thrift.NewAdminClient(serverAdmin.New(clientConfig)), nil
 }
 
+// grpcFrontendClient builds a frontend client over gRPC whatever the transport flag is set to,
+// for the commands calling APIs which are not served over TChannel
+func grpcFrontendClient(c *cli.Context) (frontend.Client, error) {
+	if err := useGRPCTransport(c); err != nil {
+		return nil, err
+	}
+	return getDeps(c).ServerFrontendClient(c)
+}
+
+// grpcAdminClient builds an admin client over gRPC whatever the transport flag is set to,
+// for the commands calling APIs which are not served over TChannel
+func grpcAdminClient(c *cli.Context) (admin.Client, error) {
+	if err := useGRPCTransport(c); err != nil {
+		return nil, err
+	}
+	return getDeps(c).ServerAdminClient(c)
+}
+
+func useGRPCTransport(c *cli.Context) error {
+	if c.String(FlagTransport) != grpcTransport {
+		if err := c.Set(FlagTransport, grpcTransport); err != nil {
+			return commoncli.Problem("This command requires gRPC transport but failed to set it", err)
+		}
+	}
+	return nil
+}
+
 // ElasticSearchClient builds an ElasticSearch client
 func (b *clientFactory) ElasticSearchClient(c *cli.Context) (*elastic.Client, error) {
 
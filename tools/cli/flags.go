@@ -67,10 +67,18 @@ const (
 	FlagStartTime                      = "start_time"
 	FlagEndTime                        = "end_time"
 	FlagJitter                         = "jitter"
+	FlagScheduleInterval               = "interval"
+	FlagScheduleCalendar               = "calendar"
+	FlagScheduleExcludeCalendar        = "exclude_calendar"
+	FlagScheduleTimezone               = "timezone"
 	FlagWorkflowIDPrefix               = "workflow_id_prefix"
 	FlagCatchUpWindow                  = "catch_up_window"
 	FlagPauseOnFailure                 = "pause_on_failure"
 	FlagBufferLimit                    = "buffer_limit"
+	FlagSignalWithStart                = "signal_with_start"
+	FlagRemainingActions               = "remaining_actions"
+	FlagPauseOnFailureThreshold        = "pause_on_failure_threshold"
+	FlagPauseOnFailureCooldown         = "pause_on_failure_cooldown"
 	FlagCronSchedule                   = "cron"
 	FlagWorkflowType                   = "workflow_type"
 	FlagWorkflowStatus                 = "status"
@@ -171,6 +179,7 @@ const (
 	FlagResetPointsOnly                = "reset_points_only"
 	FlagResetBadBinaryChecksum         = "reset_bad_binary_checksum"
 	FlagSkipSignalReapply              = "skip_signal_reapply"
+	FlagResetAttempts                  = "reset_attempts"
 	FlagListQuery                      = "query"
 	FlagExcludeWorkflowIDByQuery       = "exclude_query"
 	FlagBatchType                      = "batch_type"
@@ -205,6 +214,9 @@ const (
 	FlagFailoverType                   = "failover_type"
 	FlagFailoverTimeout                = "failover_timeout_seconds"
 	FlagActivityHeartBeatTimeout       = "heart_beat_timeout_seconds"
+	FlagScheduleToCloseTimeout         = "schedule_to_close_timeout_seconds"
+	FlagScheduleToStartTimeout         = "schedule_to_start_timeout_seconds"
+	FlagStartToCloseTimeout            = "start_to_close_timeout_seconds"
 	FlagFailoverWaitTime               = "failover_wait_time_second"
 	FlagFailoverBatchSize              = "failover_batch_size"
 	FlagFailoverDomains                = "domains"
@@ -247,6 +259,12 @@ const (
 	FlagClusterAttributeName           = "cluster_attribute_name"
 	FlagClusterAttributesJSON          = "cluster_attributes_json"
 	FlagBatchV2                        = "v2"
+	FlagMigrationMode                  = "mode"
+	FlagReverse                        = "reverse"
+	FlagSkipDomains                    = "skip_domains"
+	FlagSkipTaskLists                  = "skip_tasklists"
+	FlagMinBranchAge                   = "min_branch_age"
+	FlagDelete                         = "delete"
 
 	FlagClustersUsage = "Clusters (example: --clusters clusterA,clusterB or --cl clusterA --cl clusterB)"
 )
@@ -561,6 +579,27 @@ func getFlagsForCancel() []cli.Flag {
 	})
 }
 
+func getFlagsForPause() []cli.Flag {
+	return append(flagsForExecution,
+		&cli.StringFlag{
+			Name:    FlagReason,
+			Aliases: []string{"re"},
+			Usage:   "The reason you want to pause the workflow",
+		},
+		&cli.StringFlag{
+			Name:  FlagIdentity,
+			Usage: "Identity of the operator",
+		},
+	)
+}
+
+func getFlagsForResume() []cli.Flag {
+	return append(flagsForExecution, &cli.StringFlag{
+		Name:  FlagIdentity,
+		Usage: "Identity of the operator",
+	})
+}
+
 func getFormatFlag() cli.Flag {
 	return &cli.StringFlag{
 		Name:  FlagFormat,
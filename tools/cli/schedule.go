@@ -20,7 +20,11 @@
 
 package cli
 
-import cli "github.com/urfave/cli/v2"
+import (
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/tools/common/flag"
+)
 
 var (
 	scheduleIDFlag = &cli.StringFlag{
@@ -33,16 +37,14 @@ var (
 	createScheduleFlags = []cli.Flag{
 		scheduleIDFlag,
 		&cli.StringFlag{
-			Name:     FlagCronExpression,
-			Aliases:  []string{"ce"},
-			Usage:    "Cron expression for the schedule (e.g. '*/5 * * * *')",
-			Required: true,
+			Name:    FlagCronExpression,
+			Aliases: []string{"ce"},
+			Usage:   "Cron expression for the schedule (e.g. '*/5 * * * *'). One of --cron_expression, --calendar or --interval is required",
 		},
 		&cli.StringFlag{
-			Name:     FlagWorkflowType,
-			Aliases:  []string{"wt"},
-			Usage:    "Target workflow type name",
-			Required: true,
+			Name:    FlagWorkflowType,
+			Aliases: []string{"wt"},
+			Usage:   "Target workflow type name. Required unless --signal_name or --batch_type is set",
 		},
 		&cli.StringFlag{
 			Name:    FlagTaskList,
@@ -81,6 +83,22 @@ var (
 			Name:  FlagJitter,
 			Usage: "Random jitter applied to each trigger time (e.g. '30s', '5m')",
 		},
+		&cli.StringSliceFlag{
+			Name:  FlagScheduleInterval,
+			Usage: "Fixed interval to trigger at, optionally with a phase offset (e.g. '90m' or '1h/15m'). Can be repeated",
+		},
+		&flag.RepeatedStringFlag{
+			Name:  FlagScheduleCalendar,
+			Usage: `Calendar spec as JSON (e.g. '{"hour":"9","dayOfMonth":"8-14","dayOfWeek":"TUE"}'). Can be repeated`,
+		},
+		&flag.RepeatedStringFlag{
+			Name:  FlagScheduleExcludeCalendar,
+			Usage: `Calendar spec as JSON for times to skip (e.g. '{"month":"12","dayOfMonth":"25"}'). Can be repeated`,
+		},
+		&cli.StringFlag{
+			Name:  FlagScheduleTimezone,
+			Usage: "IANA timezone used to interpret the cron expression and calendars (e.g. 'America/New_York'), defaults to UTC",
+		},
 		// action extras
 		&cli.StringFlag{
 			Name:  FlagWorkflowIDPrefix,
@@ -128,6 +146,44 @@ var (
 			Name:  FlagRetryMaxInterval,
 			Usage: "Max retry interval in seconds",
 		},
+		// signal action
+		&cli.StringFlag{
+			Name:  FlagSignalName,
+			Usage: "Signal --workflow_id with this signal on each fire instead of starting a workflow",
+		},
+		&cli.StringFlag{
+			Name:  FlagWorkflowID,
+			Usage: "WorkflowID to signal (with --signal_name)",
+		},
+		&cli.StringFlag{
+			Name:  FlagSignalInput,
+			Usage: "Signal input (JSON string), used with --signal_name",
+		},
+		&cli.BoolFlag{
+			Name:  FlagSignalWithStart,
+			Usage: "Start the signalled workflow with --workflow_type if it is not running",
+		},
+		// batch operation action
+		&cli.StringFlag{
+			Name:  FlagBatchType,
+			Usage: "Run a batch operation on each fire instead of starting a workflow: terminate, cancel, signal",
+		},
+		&cli.StringFlag{
+			Name:  FlagListQuery,
+			Usage: "Visibility query selecting the workflows to operate on (with --batch_type)",
+		},
+		&cli.StringFlag{
+			Name:  FlagReason,
+			Usage: "Reason recorded for the batch operation",
+		},
+		&cli.IntFlag{
+			Name:  FlagRPS,
+			Usage: "RPS of the batch operation (0 = batcher default)",
+		},
+		&cli.IntFlag{
+			Name:  FlagConcurrency,
+			Usage: "Concurrency of the batch operation (0 = batcher default)",
+		},
 		// policy flags
 		&cli.StringFlag{
 			Name:  FlagOverlapPolicy,
@@ -149,10 +205,22 @@ var (
 			Name:  FlagPauseOnFailure,
 			Usage: "Pause the schedule when a triggered workflow fails",
 		},
+		&cli.IntFlag{
+			Name:  FlagPauseOnFailureThreshold,
+			Usage: "Consecutive failed runs before --pause_on_failure pauses the schedule (0 = first failure)",
+		},
+		&cli.StringFlag{
+			Name:  FlagPauseOnFailureCooldown,
+			Usage: "Unpause automatically this long after pausing on failure (e.g. '1h'; 0 = stay paused)",
+		},
 		&cli.IntFlag{
 			Name:  FlagBufferLimit,
 			Usage: "Max buffered runs (only with --overlap_policy buffer; 0 = unlimited)",
 		},
+		&cli.IntFlag{
+			Name:  FlagRemainingActions,
+			Usage: "Number of scheduled runs after which the schedule completes (0 = unlimited). Manual triggers and backfills do not count",
+		},
 	}
 
 	describeScheduleFlags = []cli.Flag{
@@ -169,7 +237,7 @@ var (
 		&cli.StringFlag{
 			Name:    FlagCronExpression,
 			Aliases: []string{"ce"},
-			Usage:   "New cron expression (required when updating the schedule spec)",
+			Usage:   "New cron expression",
 		},
 		// spec extras
 		&cli.StringFlag{
@@ -186,6 +254,22 @@ var (
 			Name:  FlagJitter,
 			Usage: "New jitter (e.g. '30s', '5m')",
 		},
+		&cli.StringSliceFlag{
+			Name:  FlagScheduleInterval,
+			Usage: "New intervals, replacing the existing ones (e.g. '90m' or '1h/15m'). Can be repeated",
+		},
+		&flag.RepeatedStringFlag{
+			Name:  FlagScheduleCalendar,
+			Usage: "New calendar specs as JSON, replacing the existing ones. Can be repeated",
+		},
+		&flag.RepeatedStringFlag{
+			Name:  FlagScheduleExcludeCalendar,
+			Usage: "New exclusion calendar specs as JSON, replacing the existing ones. Can be repeated",
+		},
+		&cli.StringFlag{
+			Name:  FlagScheduleTimezone,
+			Usage: "New IANA timezone for the cron expression and calendars",
+		},
 		// policy flags
 		&cli.StringFlag{
 			Name:  FlagOverlapPolicy,
@@ -207,10 +291,22 @@ var (
 			Name:  FlagPauseOnFailure,
 			Usage: "Pause the schedule when a triggered workflow fails",
 		},
+		&cli.IntFlag{
+			Name:  FlagPauseOnFailureThreshold,
+			Usage: "Consecutive failed runs before --pause_on_failure pauses the schedule (0 = first failure)",
+		},
+		&cli.StringFlag{
+			Name:  FlagPauseOnFailureCooldown,
+			Usage: "Unpause automatically this long after pausing on failure (e.g. '1h'; 0 = stay paused)",
+		},
 		&cli.IntFlag{
 			Name:  FlagBufferLimit,
 			Usage: "New max buffered runs (only with --overlap_policy buffer; 0 = unlimited)",
 		},
+		&cli.IntFlag{
+			Name:  FlagRemainingActions,
+			Usage: "Reset the number of scheduled runs left before the schedule completes (0 = unlimited)",
+		},
 	}
 
 	pauseScheduleFlags = []cli.Flag{
@@ -259,6 +355,73 @@ var (
 		},
 	}
 
+	triggerScheduleFlags = []cli.Flag{
+		scheduleIDFlag,
+		&cli.StringFlag{
+			Name:  FlagOverlapPolicy,
+			Usage: "Overlap policy for this run, overriding the schedule's: SkipNew, Buffer, Concurrent, CancelPrevious, TerminatePrevious",
+		},
+	}
+
+	previewScheduleFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagScheduleID,
+			Aliases: []string{"sid"},
+			Usage:   "Schedule to preview. Spec flags, if set, override its current spec",
+		},
+		&cli.StringFlag{
+			Name:     FlagStartTime,
+			Aliases:  []string{"st"},
+			Usage:    "Start of the preview range, inclusive (RFC3339, e.g. '2024-01-01T00:00:00Z')",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     FlagEndTime,
+			Aliases:  []string{"endt"},
+			Usage:    "End of the preview range, inclusive (RFC3339, e.g. '2024-01-02T00:00:00Z')",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    FlagCronExpression,
+			Aliases: []string{"ce"},
+			Usage:   "Cron expression to preview",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagScheduleInterval,
+			Usage: "Intervals to preview (e.g. '90m' or '1h/15m'). Can be repeated",
+		},
+		&flag.RepeatedStringFlag{
+			Name:  FlagScheduleCalendar,
+			Usage: "Calendar specs as JSON to preview. Can be repeated",
+		},
+		&flag.RepeatedStringFlag{
+			Name:  FlagScheduleExcludeCalendar,
+			Usage: "Exclusion calendar specs as JSON to preview. Can be repeated",
+		},
+		&cli.StringFlag{
+			Name:  FlagScheduleTimezone,
+			Usage: "IANA timezone for the cron expression and calendars",
+		},
+		&cli.IntFlag{
+			Name:  FlagLimit,
+			Usage: "Max number of fire times to print (0 = server default of 100; at most 1000)",
+		},
+		&cli.BoolFlag{
+			Name:    FlagPrintJSON,
+			Aliases: []string{"pjson"},
+			Usage:   "Print output in JSON format",
+		},
+	}
+
+	scheduleHistoryFlags = []cli.Flag{
+		scheduleIDFlag,
+		&cli.BoolFlag{
+			Name:    FlagPrintJSON,
+			Aliases: []string{"pjson"},
+			Usage:   "Print output in JSON format",
+		},
+	}
+
 	deleteScheduleFlags = []cli.Flag{
 		scheduleIDFlag,
 	}
@@ -373,6 +536,48 @@ func newScheduleCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "trigger",
+			Aliases: []string{"t"},
+			Usage:   "Trigger a schedule to run immediately",
+			Flags:   triggerScheduleFlags,
+			Action: func(c *cli.Context) error {
+				if err := checkNoAdditionalArgsPassed(c); err != nil {
+					return err
+				}
+				return withScheduleClient(c, func(sc *scheduleCLIImpl) error {
+					return sc.TriggerSchedule(c)
+				})
+			},
+		},
+		{
+			Name:    "preview",
+			Aliases: []string{"pv"},
+			Usage:   "List the times a schedule, or a proposed spec, would fire within a time range",
+			Flags:   previewScheduleFlags,
+			Action: func(c *cli.Context) error {
+				if err := checkNoAdditionalArgsPassed(c); err != nil {
+					return err
+				}
+				return withScheduleClient(c, func(sc *scheduleCLIImpl) error {
+					return sc.PreviewSchedule(c)
+				})
+			},
+		},
+		{
+			Name:    "history",
+			Aliases: []string{"hist"},
+			Usage:   "Show the most recent actions taken by a schedule",
+			Flags:   scheduleHistoryFlags,
+			Action: func(c *cli.Context) error {
+				if err := checkNoAdditionalArgsPassed(c); err != nil {
+					return err
+				}
+				return withScheduleClient(c, func(sc *scheduleCLIImpl) error {
+					return sc.ScheduleHistory(c)
+				})
+			},
+		},
 		{
 			Name:    "list",
 			Aliases: []string{"l"},
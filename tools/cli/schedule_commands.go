@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/types"
 	commoncli "github.com/uber/cadence/tools/common/commoncli"
+	"github.com/uber/cadence/tools/common/flag"
 )
 
 type scheduleCLIImpl struct {
@@ -38,12 +39,7 @@ type scheduleCLIImpl struct {
 }
 
 func withScheduleClient(c *cli.Context, cb func(sc *scheduleCLIImpl) error) error {
-	if c.String(FlagTransport) != grpcTransport {
-		if err := c.Set(FlagTransport, grpcTransport); err != nil {
-			return commoncli.Problem("Schedule commands require gRPC transport but failed to set it", err)
-		}
-	}
-	client, err := initializeFrontendClient(c)
+	client, err := grpcFrontendClient(c)
 	if err != nil {
 		return err
 	}
@@ -57,52 +53,10 @@ func (sc *scheduleCLIImpl) CreateSchedule(c *cli.Context) error {
 	}
 	scheduleID := c.String(FlagScheduleID)
 	cronExpr := c.String(FlagCronExpression)
-	workflowType := c.String(FlagWorkflowType)
-	taskList := c.String(FlagTaskList)
-	executionTimeout := int32(c.Int(FlagExecutionTimeout))
-	decisionTimeout := int32(c.Int(FlagDecisionTimeout))
 
-	action := &types.StartWorkflowAction{
-		WorkflowType:                        &types.WorkflowType{Name: workflowType},
-		ExecutionStartToCloseTimeoutSeconds: &executionTimeout,
-		TaskStartToCloseTimeoutSeconds:      &decisionTimeout,
-	}
-	if taskList != "" {
-		action.TaskList = &types.TaskList{Name: taskList}
-	}
-	if inputStr := c.String(FlagInput); inputStr != "" {
-		if !json.Valid([]byte(inputStr)) {
-			return commoncli.Problem("Input is not valid JSON", nil)
-		}
-		action.Input = []byte(inputStr)
-	}
-	if c.IsSet(FlagWorkflowIDPrefix) {
-		action.WorkflowIDPrefix = c.String(FlagWorkflowIDPrefix)
-	}
-	if memoFields, err := processMemo(c); err != nil {
-		return err
-	} else if len(memoFields) > 0 {
-		action.Memo = &types.Memo{Fields: memoFields}
-	}
-	if saFields, err := processSearchAttr(c); err != nil {
+	action, err := buildScheduleActionFromFlags(c)
+	if err != nil {
 		return err
-	} else if len(saFields) > 0 {
-		action.SearchAttributes = &types.SearchAttributes{IndexedFields: saFields}
-	}
-	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) || c.IsSet(FlagRetryInterval) || c.IsSet(FlagRetryBackoff) || c.IsSet(FlagRetryMaxInterval) {
-		action.RetryPolicy = &types.RetryPolicy{
-			InitialIntervalInSeconds: int32(c.Int(FlagRetryInterval)),
-			BackoffCoefficient:       c.Float64(FlagRetryBackoff),
-		}
-		if c.IsSet(FlagRetryAttempts) {
-			action.RetryPolicy.MaximumAttempts = int32(c.Int(FlagRetryAttempts))
-		}
-		if c.IsSet(FlagRetryExpiration) {
-			action.RetryPolicy.ExpirationIntervalInSeconds = int32(c.Int(FlagRetryExpiration))
-		}
-		if c.IsSet(FlagRetryMaxInterval) {
-			action.RetryPolicy.MaximumIntervalInSeconds = int32(c.Int(FlagRetryMaxInterval))
-		}
 	}
 
 	spec := &types.ScheduleSpec{CronExpression: cronExpr}
@@ -127,12 +81,18 @@ func (sc *scheduleCLIImpl) CreateSchedule(c *cli.Context) error {
 		}
 		spec.Jitter = d
 	}
+	if err := applyScheduleSpecSourceFlags(c, spec); err != nil {
+		return err
+	}
+	if spec.CronExpression == "" && len(spec.Calendars) == 0 && len(spec.Intervals) == 0 {
+		return commoncli.Problem("One of --cron_expression, --calendar or --interval is required", nil)
+	}
 
 	request := &types.CreateScheduleRequest{
 		Domain:     domain,
 		ScheduleID: scheduleID,
 		Spec:       spec,
-		Action:     &types.ScheduleAction{StartWorkflow: action},
+		Action:     action,
 	}
 
 	policies, err := buildPoliciesFromFlags(c, nil)
@@ -211,7 +171,7 @@ func (sc *scheduleCLIImpl) UpdateSchedule(c *cli.Context) error {
 		ScheduleID: scheduleID,
 	}
 
-	specFlags := []string{FlagCronExpression, FlagStartTime, FlagEndTime, FlagJitter}
+	specFlags := []string{FlagCronExpression, FlagStartTime, FlagEndTime, FlagJitter, FlagScheduleInterval, FlagScheduleCalendar, FlagScheduleExcludeCalendar, FlagScheduleTimezone}
 	specSet := false
 	for _, f := range specFlags {
 		if c.IsSet(f) {
@@ -219,7 +179,7 @@ func (sc *scheduleCLIImpl) UpdateSchedule(c *cli.Context) error {
 			break
 		}
 	}
-	policyFlags := []string{FlagOverlapPolicy, FlagCatchUpPolicy, FlagConcurrencyLimit, FlagCatchUpWindow, FlagPauseOnFailure, FlagBufferLimit}
+	policyFlags := []string{FlagOverlapPolicy, FlagCatchUpPolicy, FlagConcurrencyLimit, FlagCatchUpWindow, FlagPauseOnFailure, FlagPauseOnFailureThreshold, FlagPauseOnFailureCooldown, FlagBufferLimit, FlagRemainingActions}
 	policySet := false
 	for _, f := range policyFlags {
 		if c.IsSet(f) {
@@ -257,8 +217,11 @@ func (sc *scheduleCLIImpl) UpdateSchedule(c *cli.Context) error {
 		if c.IsSet(FlagCronExpression) {
 			spec.CronExpression = c.String(FlagCronExpression)
 		}
-		if spec.CronExpression == "" {
-			return commoncli.Problem("--cron_expression is required: the existing schedule has no cron expression set", nil)
+		if err := applyScheduleSpecSourceFlags(c, spec); err != nil {
+			return err
+		}
+		if spec.CronExpression == "" && len(spec.Calendars) == 0 && len(spec.Intervals) == 0 {
+			return commoncli.Problem("--cron_expression is required: the existing schedule has no cron expression, calendar or interval set", nil)
 		}
 		if c.IsSet(FlagStartTime) {
 			t, err := time.Parse(time.RFC3339, c.String(FlagStartTime))
@@ -451,6 +414,174 @@ func (sc *scheduleCLIImpl) BackfillSchedule(c *cli.Context) error {
 	return nil
 }
 
+func (sc *scheduleCLIImpl) TriggerSchedule(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return err
+	}
+	scheduleID := c.String(FlagScheduleID)
+
+	request := &types.TriggerScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+		Identity:   getCliIdentity(),
+	}
+
+	if c.IsSet(FlagOverlapPolicy) {
+		policy, err := parseOverlapPolicy(c.String(FlagOverlapPolicy))
+		if err != nil {
+			return err
+		}
+		request.OverlapPolicy = policy
+	}
+
+	ctx, cancel, err := newContext(c)
+	if err != nil {
+		return commoncli.Problem("Error creating context", err)
+	}
+	defer cancel()
+
+	_, err = sc.frontendClient.TriggerSchedule(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Failed to trigger schedule", err)
+	}
+
+	fmt.Printf("Trigger requested for schedule %q.\n", scheduleID)
+	return nil
+}
+
+func (sc *scheduleCLIImpl) PreviewSchedule(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return err
+	}
+	scheduleID := c.String(FlagScheduleID)
+
+	startTime, err := time.Parse(time.RFC3339, c.String(FlagStartTime))
+	if err != nil {
+		return commoncli.Problem("Invalid start_time format, expected RFC3339", err)
+	}
+	endTime, err := time.Parse(time.RFC3339, c.String(FlagEndTime))
+	if err != nil {
+		return commoncli.Problem("Invalid end_time format, expected RFC3339", err)
+	}
+	if !startTime.Before(endTime) {
+		return commoncli.Problem("start_time must be before end_time", nil)
+	}
+
+	request := &types.ListScheduleMatchingTimesRequest{
+		Domain:       domain,
+		ScheduleID:   scheduleID,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		MaximumTimes: int32(c.Int(FlagLimit)),
+	}
+
+	specFlags := []string{FlagCronExpression, FlagScheduleInterval, FlagScheduleCalendar, FlagScheduleExcludeCalendar, FlagScheduleTimezone}
+	specSet := false
+	for _, f := range specFlags {
+		if c.IsSet(f) {
+			specSet = true
+			break
+		}
+	}
+	if !specSet && scheduleID == "" {
+		return commoncli.Problem("Either --schedule_id or one of --cron_expression, --calendar or --interval is required", nil)
+	}
+
+	if specSet {
+		// Overlay the flags on the schedule's current spec, the same way
+		// update does, so a cron change can be previewed together with the
+		// schedule's existing start/end time and exclusions.
+		spec := &types.ScheduleSpec{}
+		if scheduleID != "" {
+			ctx, cancel, err := newContext(c)
+			if err != nil {
+				return commoncli.Problem("Error creating context", err)
+			}
+			current, err := sc.frontendClient.DescribeSchedule(ctx, &types.DescribeScheduleRequest{
+				Domain:     domain,
+				ScheduleID: scheduleID,
+			})
+			cancel()
+			if err != nil {
+				return commoncli.Problem("Failed to describe schedule", err)
+			}
+			if current.GetSpec() != nil {
+				*spec = *current.GetSpec()
+			}
+		}
+		if c.IsSet(FlagCronExpression) {
+			spec.CronExpression = c.String(FlagCronExpression)
+		}
+		if err := applyScheduleSpecSourceFlags(c, spec); err != nil {
+			return err
+		}
+		if spec.CronExpression == "" && len(spec.Calendars) == 0 && len(spec.Intervals) == 0 {
+			return commoncli.Problem("One of --cron_expression, --calendar or --interval is required", nil)
+		}
+		request.Spec = spec
+	}
+
+	ctx, cancel, err := newContext(c)
+	if err != nil {
+		return commoncli.Problem("Error creating context", err)
+	}
+	defer cancel()
+
+	resp, err := sc.frontendClient.ListScheduleMatchingTimes(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Failed to preview schedule", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		data, err := json.MarshalIndent(resp, "", "  ")
+		if err != nil {
+			return commoncli.Problem("Failed to marshal response", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	printScheduleMatchingTimes(resp)
+	return nil
+}
+
+func (sc *scheduleCLIImpl) ScheduleHistory(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return err
+	}
+	scheduleID := c.String(FlagScheduleID)
+
+	ctx, cancel, err := newContext(c)
+	if err != nil {
+		return commoncli.Problem("Error creating context", err)
+	}
+	defer cancel()
+
+	resp, err := sc.frontendClient.DescribeSchedule(ctx, &types.DescribeScheduleRequest{
+		Domain:     domain,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to describe schedule", err)
+	}
+
+	actions := resp.GetInfo().GetRecentActions()
+	if c.Bool(FlagPrintJSON) {
+		data, err := json.MarshalIndent(actions, "", "  ")
+		if err != nil {
+			return commoncli.Problem("Failed to marshal response", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	printScheduleHistory(actions)
+	return nil
+}
+
 func (sc *scheduleCLIImpl) ListSchedules(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
 	if err != nil {
@@ -505,7 +636,11 @@ func buildPoliciesFromFlags(c *cli.Context, base *types.SchedulePolicies) (*type
 	hasCatchUpWindow := c.IsSet(FlagCatchUpWindow)
 	hasPauseOnFailure := c.IsSet(FlagPauseOnFailure)
 	hasBufferLimit := c.IsSet(FlagBufferLimit)
-	if !hasOverlap && !hasCatchUp && !hasLimit && !hasCatchUpWindow && !hasPauseOnFailure && !hasBufferLimit {
+	hasRemainingActions := c.IsSet(FlagRemainingActions)
+	hasFailureThreshold := c.IsSet(FlagPauseOnFailureThreshold)
+	hasFailureCooldown := c.IsSet(FlagPauseOnFailureCooldown)
+	if !hasOverlap && !hasCatchUp && !hasLimit && !hasCatchUpWindow && !hasPauseOnFailure && !hasBufferLimit && !hasRemainingActions &&
+		!hasFailureThreshold && !hasFailureCooldown {
 		if base != nil {
 			cloned := *base
 			return &cloned, nil
@@ -548,6 +683,20 @@ func buildPoliciesFromFlags(c *cli.Context, base *types.SchedulePolicies) (*type
 	if hasPauseOnFailure {
 		policies.PauseOnFailure = c.Bool(FlagPauseOnFailure)
 	}
+	if hasFailureThreshold {
+		threshold := int32(c.Int(FlagPauseOnFailureThreshold))
+		if threshold < 0 {
+			return nil, commoncli.Problem("--pause_on_failure_threshold must be >= 0", nil)
+		}
+		policies.PauseOnFailureThreshold = threshold
+	}
+	if hasFailureCooldown {
+		d, err := time.ParseDuration(c.String(FlagPauseOnFailureCooldown))
+		if err != nil || d < 0 {
+			return nil, commoncli.Problem("Invalid pause_on_failure_cooldown, expected non-negative Go duration (e.g. '1h', '30m')", err)
+		}
+		policies.PauseOnFailureCooldown = d
+	}
 	if hasBufferLimit {
 		limit := int32(c.Int(FlagBufferLimit))
 		if limit < 0 {
@@ -555,9 +704,147 @@ func buildPoliciesFromFlags(c *cli.Context, base *types.SchedulePolicies) (*type
 		}
 		policies.BufferLimit = limit
 	}
+	if hasRemainingActions {
+		remaining := int64(c.Int(FlagRemainingActions))
+		if remaining < 0 {
+			return nil, commoncli.Problem("--remaining_actions must be >= 0", nil)
+		}
+		policies.LimitedActions = remaining > 0
+		policies.RemainingActions = remaining
+	}
 	return policies, nil
 }
 
+// buildScheduleActionFromFlags builds the action of a new schedule. --batch_type
+// selects a batch operation and --signal_name a signal (optionally
+// signal-with-start); otherwise the schedule starts --workflow_type.
+func buildScheduleActionFromFlags(c *cli.Context) (*types.ScheduleAction, error) {
+	if c.IsSet(FlagBatchType) {
+		batch, err := buildBatchOperationActionFromFlags(c)
+		if err != nil {
+			return nil, err
+		}
+		return &types.ScheduleAction{BatchOperation: batch}, nil
+	}
+
+	if c.IsSet(FlagSignalName) {
+		workflowID := c.String(FlagWorkflowID)
+		if workflowID == "" {
+			return nil, commoncli.Problem("--workflow_id is required with --signal_name", nil)
+		}
+		signal := &types.SignalWorkflowAction{
+			WorkflowID: workflowID,
+			SignalName: c.String(FlagSignalName),
+		}
+		if inputStr := c.String(FlagSignalInput); inputStr != "" {
+			if !json.Valid([]byte(inputStr)) {
+				return nil, commoncli.Problem("Signal input is not valid JSON", nil)
+			}
+			signal.SignalInput = []byte(inputStr)
+		}
+		if c.Bool(FlagSignalWithStart) {
+			start, err := buildStartWorkflowActionFromFlags(c)
+			if err != nil {
+				return nil, err
+			}
+			signal.StartWorkflow = start
+		}
+		return &types.ScheduleAction{SignalWorkflow: signal}, nil
+	}
+
+	if c.Bool(FlagSignalWithStart) {
+		return nil, commoncli.Problem("--signal_with_start requires --signal_name", nil)
+	}
+	start, err := buildStartWorkflowActionFromFlags(c)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ScheduleAction{StartWorkflow: start}, nil
+}
+
+func buildStartWorkflowActionFromFlags(c *cli.Context) (*types.StartWorkflowAction, error) {
+	workflowType := c.String(FlagWorkflowType)
+	if workflowType == "" {
+		return nil, commoncli.Problem("--workflow_type is required", nil)
+	}
+	taskList := c.String(FlagTaskList)
+	executionTimeout := int32(c.Int(FlagExecutionTimeout))
+	decisionTimeout := int32(c.Int(FlagDecisionTimeout))
+
+	action := &types.StartWorkflowAction{
+		WorkflowType:                        &types.WorkflowType{Name: workflowType},
+		ExecutionStartToCloseTimeoutSeconds: &executionTimeout,
+		TaskStartToCloseTimeoutSeconds:      &decisionTimeout,
+	}
+	if taskList != "" {
+		action.TaskList = &types.TaskList{Name: taskList}
+	}
+	if inputStr := c.String(FlagInput); inputStr != "" {
+		if !json.Valid([]byte(inputStr)) {
+			return nil, commoncli.Problem("Input is not valid JSON", nil)
+		}
+		action.Input = []byte(inputStr)
+	}
+	if c.IsSet(FlagWorkflowIDPrefix) {
+		action.WorkflowIDPrefix = c.String(FlagWorkflowIDPrefix)
+	}
+	if memoFields, err := processMemo(c); err != nil {
+		return nil, err
+	} else if len(memoFields) > 0 {
+		action.Memo = &types.Memo{Fields: memoFields}
+	}
+	if saFields, err := processSearchAttr(c); err != nil {
+		return nil, err
+	} else if len(saFields) > 0 {
+		action.SearchAttributes = &types.SearchAttributes{IndexedFields: saFields}
+	}
+	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) || c.IsSet(FlagRetryInterval) || c.IsSet(FlagRetryBackoff) || c.IsSet(FlagRetryMaxInterval) {
+		action.RetryPolicy = &types.RetryPolicy{
+			InitialIntervalInSeconds: int32(c.Int(FlagRetryInterval)),
+			BackoffCoefficient:       c.Float64(FlagRetryBackoff),
+		}
+		if c.IsSet(FlagRetryAttempts) {
+			action.RetryPolicy.MaximumAttempts = int32(c.Int(FlagRetryAttempts))
+		}
+		if c.IsSet(FlagRetryExpiration) {
+			action.RetryPolicy.ExpirationIntervalInSeconds = int32(c.Int(FlagRetryExpiration))
+		}
+		if c.IsSet(FlagRetryMaxInterval) {
+			action.RetryPolicy.MaximumIntervalInSeconds = int32(c.Int(FlagRetryMaxInterval))
+		}
+	}
+	return action, nil
+}
+
+func buildBatchOperationActionFromFlags(c *cli.Context) (*types.BatchOperationAction, error) {
+	opType, err := parseBatchOperationType(c.String(FlagBatchType))
+	if err != nil {
+		return nil, err
+	}
+	query := c.String(FlagListQuery)
+	if query == "" {
+		return nil, commoncli.Problem("--query is required with --batch_type", nil)
+	}
+	batch := &types.BatchOperationAction{
+		OperationType: opType,
+		Query:         query,
+		Reason:        c.String(FlagReason),
+		RPS:           int32(c.Int(FlagRPS)),
+		Concurrency:   int32(c.Int(FlagConcurrency)),
+	}
+	if opType != types.ScheduleBatchOperationTypeSignal && c.IsSet(FlagSignalName) {
+		return nil, commoncli.Problem("--signal_name can only be combined with --batch_type signal", nil)
+	}
+	if opType == types.ScheduleBatchOperationTypeSignal {
+		batch.SignalName = c.String(FlagSignalName)
+		if batch.SignalName == "" {
+			return nil, commoncli.Problem("--signal_name is required with --batch_type signal", nil)
+		}
+		batch.SignalInput = c.String(FlagSignalInput)
+	}
+	return batch, nil
+}
+
 func parseOverlapPolicy(s string) (types.ScheduleOverlapPolicy, error) {
 	switch strings.ToLower(s) {
 	case "skipnew", "skip_new":
@@ -588,11 +875,133 @@ func parseCatchUpPolicy(s string) (types.ScheduleCatchUpPolicy, error) {
 	}
 }
 
+func parseBatchOperationType(s string) (types.ScheduleBatchOperationType, error) {
+	switch strings.ToLower(s) {
+	case "terminate":
+		return types.ScheduleBatchOperationTypeTerminate, nil
+	case "cancel":
+		return types.ScheduleBatchOperationTypeCancel, nil
+	case "signal":
+		return types.ScheduleBatchOperationTypeSignal, nil
+	default:
+		return 0, commoncli.Problem(fmt.Sprintf("Unknown batch type %q. Valid: terminate, cancel, signal", s), nil)
+	}
+}
+
+// applyScheduleSpecSourceFlags sets the interval, calendar, exclusion and
+// timezone fields of spec from their flags. Repeated flags replace the
+// existing list as a whole rather than appending to it.
+func applyScheduleSpecSourceFlags(c *cli.Context, spec *types.ScheduleSpec) error {
+	if c.IsSet(FlagScheduleInterval) {
+		intervals, err := parseScheduleIntervals(c.StringSlice(FlagScheduleInterval))
+		if err != nil {
+			return err
+		}
+		spec.Intervals = intervals
+	}
+	if c.IsSet(FlagScheduleCalendar) {
+		calendars, err := parseScheduleCalendars(FlagScheduleCalendar, c.Generic(FlagScheduleCalendar).(*flag.StringSlice).Value())
+		if err != nil {
+			return err
+		}
+		spec.Calendars = calendars
+	}
+	if c.IsSet(FlagScheduleExcludeCalendar) {
+		calendars, err := parseScheduleCalendars(FlagScheduleExcludeCalendar, c.Generic(FlagScheduleExcludeCalendar).(*flag.StringSlice).Value())
+		if err != nil {
+			return err
+		}
+		spec.ExcludeCalendars = calendars
+	}
+	if c.IsSet(FlagScheduleTimezone) {
+		tz := c.String(FlagScheduleTimezone)
+		if _, err := time.LoadLocation(tz); err != nil {
+			return commoncli.Problem(fmt.Sprintf("Invalid timezone %q", tz), err)
+		}
+		spec.Timezone = tz
+	}
+	return nil
+}
+
+// parseScheduleIntervals parses interval flag values of the form
+// "<interval>" or "<interval>/<phase>", e.g. "90m" or "1h/15m".
+func parseScheduleIntervals(values []string) ([]*types.ScheduleIntervalSpec, error) {
+	var intervals []*types.ScheduleIntervalSpec
+	for _, v := range values {
+		intervalStr, phaseStr, hasPhase := strings.Cut(strings.TrimSpace(v), "/")
+		interval, err := time.ParseDuration(intervalStr)
+		if err != nil {
+			return nil, commoncli.Problem(fmt.Sprintf("Invalid interval %q, expected Go duration (e.g. '90m' or '1h/15m')", v), err)
+		}
+		spec := &types.ScheduleIntervalSpec{Interval: interval}
+		if hasPhase {
+			phase, err := time.ParseDuration(phaseStr)
+			if err != nil {
+				return nil, commoncli.Problem(fmt.Sprintf("Invalid interval phase %q, expected Go duration (e.g. '1h/15m')", v), err)
+			}
+			spec.Phase = phase
+		}
+		intervals = append(intervals, spec)
+	}
+	return intervals, nil
+}
+
+func parseScheduleCalendars(flagName string, values []string) ([]*types.ScheduleCalendarSpec, error) {
+	var calendars []*types.ScheduleCalendarSpec
+	for _, v := range values {
+		var cal types.ScheduleCalendarSpec
+		if err := json.Unmarshal([]byte(v), &cal); err != nil {
+			return nil, commoncli.Problem(fmt.Sprintf("Invalid --%s value %q, expected a JSON calendar spec", flagName, v), err)
+		}
+		calendars = append(calendars, &cal)
+	}
+	return calendars, nil
+}
+
+func formatScheduleCalendar(cal *types.ScheduleCalendarSpec) string {
+	var parts []string
+	for _, f := range []struct{ name, value string }{
+		{"second", cal.Second},
+		{"minute", cal.Minute},
+		{"hour", cal.Hour},
+		{"dayOfMonth", cal.DayOfMonth},
+		{"month", cal.Month},
+		{"dayOfWeek", cal.DayOfWeek},
+	} {
+		if f.value != "" {
+			parts = append(parts, f.name+"="+f.value)
+		}
+	}
+	out := strings.Join(parts, " ")
+	if cal.Comment != "" {
+		out += " (" + cal.Comment + ")"
+	}
+	return out
+}
+
 func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 	fmt.Println("Schedule Configuration:")
 
 	if spec := resp.GetSpec(); spec != nil {
-		fmt.Printf("  Cron Expression:    %s\n", spec.CronExpression)
+		if spec.CronExpression != "" {
+			fmt.Printf("  Cron Expression:    %s\n", spec.CronExpression)
+		}
+		for _, cal := range spec.Calendars {
+			fmt.Printf("  Calendar:           %s\n", formatScheduleCalendar(cal))
+		}
+		for _, iv := range spec.Intervals {
+			if iv.Phase > 0 {
+				fmt.Printf("  Interval:           every %s (phase %s)\n", iv.Interval, iv.Phase)
+			} else {
+				fmt.Printf("  Interval:           every %s\n", iv.Interval)
+			}
+		}
+		for _, cal := range spec.ExcludeCalendars {
+			fmt.Printf("  Exclude Calendar:   %s\n", formatScheduleCalendar(cal))
+		}
+		if spec.Timezone != "" {
+			fmt.Printf("  Timezone:           %s\n", spec.Timezone)
+		}
 		if !spec.StartTime.IsZero() {
 			fmt.Printf("  Start Time:         %s\n", spec.StartTime.UTC().Format(time.RFC3339))
 		}
@@ -605,6 +1014,20 @@ func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 	}
 
 	if action := resp.GetAction(); action != nil {
+		if sig := action.SignalWorkflow; sig != nil {
+			fmt.Printf("  Signal Workflow:    %s\n", sig.WorkflowID)
+			fmt.Printf("  Signal Name:        %s\n", sig.SignalName)
+			if sig.StartWorkflow != nil && sig.StartWorkflow.WorkflowType != nil {
+				fmt.Printf("  Signal With Start:  %s\n", sig.StartWorkflow.WorkflowType.Name)
+			}
+		}
+		if batch := action.BatchOperation; batch != nil {
+			fmt.Printf("  Batch Operation:    %s\n", batch.OperationType)
+			fmt.Printf("  Batch Query:        %s\n", batch.Query)
+			if batch.SignalName != "" {
+				fmt.Printf("  Signal Name:        %s\n", batch.SignalName)
+			}
+		}
 		if sw := action.StartWorkflow; sw != nil {
 			if sw.WorkflowType != nil {
 				fmt.Printf("  Workflow Type:      %s\n", sw.WorkflowType.Name)
@@ -643,6 +1066,10 @@ func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 		}
 		if policies.PauseOnFailure {
 			fmt.Printf("  Pause On Failure:   true\n")
+			fmt.Printf("  Failure Threshold:  %d\n", max(policies.PauseOnFailureThreshold, 1))
+			if policies.PauseOnFailureCooldown > 0 {
+				fmt.Printf("  Failure Cool-down:  %s\n", policies.PauseOnFailureCooldown)
+			}
 		}
 		if policies.BufferLimit > 0 || policies.OverlapPolicy == types.ScheduleOverlapPolicyBuffer {
 			fmt.Printf("  Buffer Limit:       %d (0=unlimited)\n", policies.BufferLimit)
@@ -650,10 +1077,15 @@ func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 		if policies.ConcurrencyLimit > 0 || policies.OverlapPolicy == types.ScheduleOverlapPolicyConcurrent {
 			fmt.Printf("  Concurrency Limit:  %d (0=unlimited)\n", policies.ConcurrencyLimit)
 		}
+		if policies.LimitedActions {
+			fmt.Printf("  Remaining Actions:  %d\n", policies.RemainingActions)
+		}
 	}
 
 	if state := resp.GetState(); state != nil {
-		if state.Paused {
+		if state.Completed {
+			fmt.Printf("  Status:             COMPLETED\n")
+		} else if state.Paused {
 			fmt.Printf("  Status:             PAUSED\n")
 			if pi := state.PauseInfo; pi != nil {
 				if pi.Reason != "" {
@@ -665,6 +1097,9 @@ func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 				if !pi.PausedAt.IsZero() {
 					fmt.Printf("  Paused At:          %s\n", pi.PausedAt.UTC().Format(time.RFC3339))
 				}
+				if !pi.AutoUnpauseTime.IsZero() {
+					fmt.Printf("  Auto Unpause At:    %s\n", pi.AutoUnpauseTime.UTC().Format(time.RFC3339))
+				}
 			}
 		} else {
 			fmt.Printf("  Status:             ACTIVE\n")
@@ -715,5 +1150,64 @@ func printDescribeSchedule(resp *types.DescribeScheduleResponse) {
 				)
 			}
 		}
+		if len(info.RecentActions) > 0 {
+			fmt.Printf("  Recent Actions:     %d (see 'schedule history')\n", len(info.RecentActions))
+		}
+		if info.ConsecutiveFailures > 0 {
+			fmt.Printf("  Consecutive Fails:  %d\n", info.ConsecutiveFailures)
+		}
+		if lf := info.LastFailure; lf != nil {
+			fmt.Printf("  Last Failure:       %s", lf.CloseStatus)
+			if we := lf.WorkflowExecution; we != nil {
+				fmt.Printf(" %s (%s)", we.WorkflowID, we.RunID)
+			}
+			if !lf.ObservedTime.IsZero() {
+				fmt.Printf(" at %s", lf.ObservedTime.UTC().Format(time.RFC3339))
+			}
+			fmt.Println()
+			if lf.FailureReason != "" {
+				fmt.Printf("  Failure Reason:     %s\n", lf.FailureReason)
+			}
+		}
+	}
+}
+
+// printScheduleHistory prints the recent actions of a schedule, oldest first.
+func printScheduleHistory(actions []*types.ScheduleActionResult) {
+	if len(actions) == 0 {
+		fmt.Println("No recent actions.")
+		return
+	}
+	fmt.Printf("  %-20s  %-20s  %-8s  %s\n", "SCHEDULED", "STARTED", "OUTCOME", "WORKFLOW")
+	for _, a := range actions {
+		if a == nil {
+			continue
+		}
+		actual := "-"
+		if !a.ActualTime.IsZero() {
+			actual = a.ActualTime.UTC().Format(time.RFC3339)
+		}
+		wf := "-"
+		if we := a.WorkflowExecution; we != nil {
+			wf = fmt.Sprintf("%s (%s)", we.WorkflowID, we.RunID)
+		}
+		fmt.Printf("  %-20s  %-20s  %-8s  %s\n",
+			a.ScheduledTime.UTC().Format(time.RFC3339), actual, a.Outcome, wf)
+	}
+}
+
+func printScheduleMatchingTimes(resp *types.ListScheduleMatchingTimesResponse) {
+	times := resp.GetStartTimes()
+	if len(times) == 0 {
+		fmt.Println("No fire times in range.")
+		return
+	}
+	for _, t := range times {
+		fmt.Printf("  %s\n", t.Format(time.RFC3339))
+	}
+	total := fmt.Sprintf("%d", resp.GetTotalCount())
+	if resp.GetTotalCountCapped() {
+		total = "more than " + total
 	}
+	fmt.Printf("\nShowing %d of %s fire times.\n", len(times), total)
 }
@@ -36,7 +36,9 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
+	commonFlag "github.com/uber/cadence/tools/common/flag"
 )
 
 func newScheduleTestApp(t *testing.T, mockClient *frontend.MockClient) *cli.App {
@@ -1043,6 +1045,9 @@ func TestScheduleCLI_BuildPoliciesFromFlags_WithNewFields(t *testing.T) {
 		set.String(FlagCatchUpWindow, "", "")
 		set.Bool(FlagPauseOnFailure, false, "")
 		set.Int(FlagBufferLimit, 0, "")
+		set.Int(FlagRemainingActions, 0, "")
+		set.Int(FlagPauseOnFailureThreshold, 0, "")
+		set.String(FlagPauseOnFailureCooldown, "", "")
 		_ = set.Parse(args)
 		return cli.NewContext(app, set, nil)
 	}
@@ -1091,6 +1096,49 @@ func TestScheduleCLI_BuildPoliciesFromFlags_WithNewFields(t *testing.T) {
 			args:    []string{"--" + FlagBufferLimit, "-1"},
 			wantErr: true,
 		},
+		{
+			name:       "remaining_actions enables limited actions",
+			args:       []string{"--" + FlagRemainingActions, "10"},
+			wantResult: &types.SchedulePolicies{LimitedActions: true, RemainingActions: 10},
+		},
+		{
+			name:       "remaining_actions zero removes the limit",
+			args:       []string{"--" + FlagRemainingActions, "0"},
+			wantResult: &types.SchedulePolicies{},
+		},
+		{
+			name:    "negative remaining_actions returns error",
+			args:    []string{"--" + FlagRemainingActions, "-1"},
+			wantErr: true,
+		},
+		{
+			name: "pause_on_failure threshold and cooldown",
+			args: []string{
+				"--" + FlagPauseOnFailure,
+				"--" + FlagPauseOnFailureThreshold, "3",
+				"--" + FlagPauseOnFailureCooldown, "1h",
+			},
+			wantResult: &types.SchedulePolicies{
+				PauseOnFailure:          true,
+				PauseOnFailureThreshold: 3,
+				PauseOnFailureCooldown:  time.Hour,
+			},
+		},
+		{
+			name:    "negative pause_on_failure_threshold returns error",
+			args:    []string{"--" + FlagPauseOnFailureThreshold, "-1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid pause_on_failure_cooldown returns error",
+			args:    []string{"--" + FlagPauseOnFailureCooldown, "soon"},
+			wantErr: true,
+		},
+		{
+			name:    "negative pause_on_failure_cooldown returns error",
+			args:    []string{"--" + FlagPauseOnFailureCooldown, "-1m"},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1254,3 +1302,575 @@ func TestScheduleCLI_CreateSchedule_InvalidJitter(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "jitter")
 }
+
+func TestScheduleCLI_CreateSchedule_CalendarsAndIntervals(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	app := newScheduleTestApp(t, mockClient)
+
+	mockClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *types.CreateScheduleRequest, _ ...interface{}) (*types.CreateScheduleResponse, error) {
+			assert.Empty(t, req.Spec.CronExpression)
+			assert.Equal(t, []*types.ScheduleIntervalSpec{
+				{Interval: 90 * time.Minute},
+				{Interval: time.Hour, Phase: 15 * time.Minute},
+			}, req.Spec.Intervals)
+			assert.Equal(t, []*types.ScheduleCalendarSpec{
+				{Hour: "9", DayOfMonth: "8-14", DayOfWeek: "TUE"},
+			}, req.Spec.Calendars)
+			assert.Equal(t, []*types.ScheduleCalendarSpec{
+				{Month: "12", DayOfMonth: "25", Comment: "christmas"},
+			}, req.Spec.ExcludeCalendars)
+			assert.Equal(t, "America/New_York", req.Spec.Timezone)
+			return &types.CreateScheduleResponse{ScheduleID: "s"}, nil
+		})
+
+	set := flag.NewFlagSet("test", 0)
+	set.String(FlagDomain, "", "")
+	set.String(FlagTransport, "", "")
+	set.String(FlagScheduleID, "", "")
+	set.String(FlagWorkflowType, "", "")
+	set.Int(FlagExecutionTimeout, 0, "")
+	set.Int(FlagDecisionTimeout, 0, "")
+	set.Var(&cli.StringSlice{}, FlagScheduleInterval, "")
+	set.Var(&commonFlag.StringSlice{}, FlagScheduleCalendar, "")
+	set.Var(&commonFlag.StringSlice{}, FlagScheduleExcludeCalendar, "")
+	set.String(FlagScheduleTimezone, "", "")
+	_ = set.Parse([]string{
+		"--" + FlagDomain, "test-domain",
+		"--" + FlagTransport, grpcTransport,
+		"--" + FlagScheduleID, "s",
+		"--" + FlagWorkflowType, "wf",
+		"--" + FlagExecutionTimeout, "3600",
+		"--" + FlagDecisionTimeout, "10",
+		"--" + FlagScheduleInterval, "90m",
+		"--" + FlagScheduleInterval, "1h/15m",
+		"--" + FlagScheduleCalendar, `{"hour":"9","dayOfMonth":"8-14","dayOfWeek":"TUE"}`,
+		"--" + FlagScheduleExcludeCalendar, `{"month":"12","dayOfMonth":"25","comment":"christmas"}`,
+		"--" + FlagScheduleTimezone, "America/New_York",
+	})
+	c := cli.NewContext(app, set, nil)
+	sc := &scheduleCLIImpl{frontendClient: mockClient}
+	assert.NoError(t, sc.CreateSchedule(c))
+}
+
+func TestScheduleCLI_CreateSchedule_MissingSpecSource(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	app := newScheduleTestApp(t, mockClient)
+
+	set := flag.NewFlagSet("test", 0)
+	set.String(FlagDomain, "", "")
+	set.String(FlagTransport, "", "")
+	set.String(FlagScheduleID, "", "")
+	set.String(FlagWorkflowType, "", "")
+	set.Int(FlagExecutionTimeout, 0, "")
+	set.Int(FlagDecisionTimeout, 0, "")
+	_ = set.Parse([]string{
+		"--" + FlagDomain, "test-domain",
+		"--" + FlagTransport, grpcTransport,
+		"--" + FlagScheduleID, "s",
+		"--" + FlagWorkflowType, "wf",
+		"--" + FlagExecutionTimeout, "3600",
+		"--" + FlagDecisionTimeout, "10",
+	})
+	c := cli.NewContext(app, set, nil)
+	sc := &scheduleCLIImpl{frontendClient: mockClient}
+	err := sc.CreateSchedule(c)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "One of --cron_expression, --calendar or --interval is required")
+}
+
+func TestScheduleCLI_ParseScheduleIntervals(t *testing.T) {
+	tests := map[string]struct {
+		values  []string
+		want    []*types.ScheduleIntervalSpec
+		wantErr bool
+	}{
+		"interval only": {
+			values: []string{"90m"},
+			want:   []*types.ScheduleIntervalSpec{{Interval: 90 * time.Minute}},
+		},
+		"interval with phase": {
+			values: []string{"1h/15m", "24h"},
+			want: []*types.ScheduleIntervalSpec{
+				{Interval: time.Hour, Phase: 15 * time.Minute},
+				{Interval: 24 * time.Hour},
+			},
+		},
+		"invalid interval": {
+			values:  []string{"hourly"},
+			wantErr: true,
+		},
+		"invalid phase": {
+			values:  []string{"1h/soon"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseScheduleIntervals(tc.values)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestScheduleCLI_TriggerSchedule(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+
+	mockClient.EXPECT().TriggerSchedule(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *types.TriggerScheduleRequest, _ ...interface{}) (*types.TriggerScheduleResponse, error) {
+			assert.Equal(t, "test-domain", req.Domain)
+			assert.Equal(t, "my-sched", req.ScheduleID)
+			assert.Equal(t, types.ScheduleOverlapPolicyConcurrent, req.OverlapPolicy)
+			return &types.TriggerScheduleResponse{}, nil
+		})
+
+	app := newScheduleTestApp(t, mockClient)
+	set := flag.NewFlagSet("test", 0)
+	set.String(FlagDomain, "", "")
+	set.String(FlagTransport, "", "")
+	set.String(FlagScheduleID, "", "")
+	set.String(FlagOverlapPolicy, "", "")
+	set.Parse([]string{
+		"--" + FlagDomain, "test-domain",
+		"--" + FlagTransport, grpcTransport,
+		"--" + FlagScheduleID, "my-sched",
+		"--" + FlagOverlapPolicy, "concurrent",
+	})
+	c := cli.NewContext(app, set, nil)
+
+	sc := &scheduleCLIImpl{frontendClient: mockClient}
+	err := sc.TriggerSchedule(c)
+	assert.NoError(t, err)
+}
+
+func TestScheduleCLI_TriggerSchedule_Error(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	app := newScheduleTestApp(t, mockClient)
+
+	mockClient.EXPECT().TriggerSchedule(gomock.Any(), gomock.Any()).
+		Return(nil, &types.EntityNotExistsError{Message: "schedule not found"})
+
+	c := newScheduleCLIContext(app, map[string]string{
+		FlagScheduleID: "my-sched",
+	})
+	sc := &scheduleCLIImpl{frontendClient: mockClient}
+	err := sc.TriggerSchedule(c)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed to trigger schedule")
+}
+
+func TestScheduleCLI_ScheduleHistory(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := frontend.NewMockClient(mockCtrl)
+	app := newScheduleTestApp(t, mockClient)
+
+	mockClient.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).
+		Return(&types.DescribeScheduleResponse{
+			Info: &types.ScheduleInfo{
+				RecentActions: []*types.ScheduleActionResult{
+					{
+						ScheduledTime:     time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+						ActualTime:        time.Date(2026, 3, 1, 10, 0, 1, 0, time.UTC),
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf-1", RunID: "run-1"},
+						Outcome:           types.ScheduleActionOutcomeStarted,
+					},
+					nil, // nil entries must be tolerated
+					{
+						ScheduledTime: time.Date(2026, 3, 1, 11, 0, 0, 0, time.UTC),
+						ActualTime:    time.Date(2026, 3, 1, 11, 0, 0, 0, time.UTC),
+						Outcome:       types.ScheduleActionOutcomeSkipped,
+					},
+				},
+			},
+		}, nil)
+
+	c := newScheduleCLIContext(app, map[string]string{
+		FlagScheduleID: "my-sched",
+	})
+	sc := &scheduleCLIImpl{frontendClient: mockClient}
+	out := captureStdout(t, func() { assert.NoError(t, sc.ScheduleHistory(c)) })
+	assert.Contains(t, out, "2026-03-01T10:00:00Z")
+	assert.Contains(t, out, "wf-1 (run-1)")
+	assert.Contains(t, out, "STARTED")
+	assert.Contains(t, out, "SKIPPED")
+}
+
+func TestScheduleCLI_PreviewSchedule(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		flags   map[string]string
+		mockFn  func(*frontend.MockClient)
+		wantErr string
+		wantOut []string
+	}{
+		"existing schedule": {
+			flags: map[string]string{
+				FlagScheduleID: "my-sched",
+				FlagStartTime:  "2026-03-01T00:00:00Z",
+				FlagEndTime:    "2026-03-02T00:00:00Z",
+			},
+			mockFn: func(m *frontend.MockClient) {
+				m.EXPECT().ListScheduleMatchingTimes(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req *types.ListScheduleMatchingTimesRequest, _ ...interface{}) (*types.ListScheduleMatchingTimesResponse, error) {
+						assert.Equal(t, "test-domain", req.Domain)
+						assert.Equal(t, "my-sched", req.ScheduleID)
+						assert.Nil(t, req.Spec)
+						assert.Equal(t, start, req.StartTime)
+						assert.Equal(t, start.Add(24*time.Hour), req.EndTime)
+						return &types.ListScheduleMatchingTimesResponse{
+							StartTimes: []time.Time{start, start.Add(time.Hour)},
+							TotalCount: 25,
+						}, nil
+					})
+			},
+			wantOut: []string{"2026-03-01T00:00:00Z", "2026-03-01T01:00:00Z", "Showing 2 of 25 fire times."},
+		},
+		"cron override is merged into the current spec": {
+			flags: map[string]string{
+				FlagScheduleID:     "my-sched",
+				FlagStartTime:      "2026-03-01T00:00:00Z",
+				FlagEndTime:        "2026-03-02T00:00:00Z",
+				FlagCronExpression: "0 */2 * * *",
+			},
+			mockFn: func(m *frontend.MockClient) {
+				m.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeScheduleResponse{
+						Spec: &types.ScheduleSpec{CronExpression: "0 * * * *", Timezone: "America/New_York"},
+					}, nil)
+				m.EXPECT().ListScheduleMatchingTimes(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req *types.ListScheduleMatchingTimesRequest, _ ...interface{}) (*types.ListScheduleMatchingTimesResponse, error) {
+						require.NotNil(t, req.Spec)
+						assert.Equal(t, "0 */2 * * *", req.Spec.CronExpression)
+						assert.Equal(t, "America/New_York", req.Spec.Timezone)
+						return &types.ListScheduleMatchingTimesResponse{
+							StartTimes:       []time.Time{start},
+							TotalCount:       100000,
+							TotalCountCapped: true,
+						}, nil
+					})
+			},
+			wantOut: []string{"Showing 1 of more than 100000 fire times."},
+		},
+		"proposed spec without a schedule": {
+			flags: map[string]string{
+				FlagStartTime:      "2026-03-01T00:00:00Z",
+				FlagEndTime:        "2026-03-02T00:00:00Z",
+				FlagCronExpression: "0 12 * * *",
+			},
+			mockFn: func(m *frontend.MockClient) {
+				m.EXPECT().ListScheduleMatchingTimes(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req *types.ListScheduleMatchingTimesRequest, _ ...interface{}) (*types.ListScheduleMatchingTimesResponse, error) {
+						assert.Empty(t, req.ScheduleID)
+						assert.Equal(t, &types.ScheduleSpec{CronExpression: "0 12 * * *"}, req.Spec)
+						return &types.ListScheduleMatchingTimesResponse{}, nil
+					})
+			},
+			wantOut: []string{"No fire times in range."},
+		},
+		"neither schedule nor spec": {
+			flags: map[string]string{
+				FlagStartTime: "2026-03-01T00:00:00Z",
+				FlagEndTime:   "2026-03-02T00:00:00Z",
+			},
+			mockFn:  func(m *frontend.MockClient) {},
+			wantErr: "Either --schedule_id or one of",
+		},
+		"end before start": {
+			flags: map[string]string{
+				FlagScheduleID: "my-sched",
+				FlagStartTime:  "2026-03-02T00:00:00Z",
+				FlagEndTime:    "2026-03-01T00:00:00Z",
+			},
+			mockFn:  func(m *frontend.MockClient) {},
+			wantErr: "start_time must be before end_time",
+		},
+		"server error": {
+			flags: map[string]string{
+				FlagScheduleID: "my-sched",
+				FlagStartTime:  "2026-03-01T00:00:00Z",
+				FlagEndTime:    "2026-03-02T00:00:00Z",
+			},
+			mockFn: func(m *frontend.MockClient) {
+				m.EXPECT().ListScheduleMatchingTimes(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("boom"))
+			},
+			wantErr: "Failed to preview schedule",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockClient := frontend.NewMockClient(mockCtrl)
+			tt.mockFn(mockClient)
+			app := newScheduleTestApp(t, mockClient)
+
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagDomain, "", "")
+			set.String(FlagTransport, "", "")
+			args := []string{"--" + FlagDomain, "test-domain", "--" + FlagTransport, grpcTransport}
+			for k, v := range tt.flags {
+				set.String(k, "", "")
+				args = append(args, "--"+k, v)
+			}
+			require.NoError(t, set.Parse(args))
+			c := cli.NewContext(app, set, nil)
+
+			sc := &scheduleCLIImpl{frontendClient: mockClient}
+			var err error
+			out := captureStdout(t, func() { err = sc.PreviewSchedule(c) })
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
+
+func TestPrintScheduleHistory_Empty(t *testing.T) {
+	out := captureStdout(t, func() { printScheduleHistory(nil) })
+	assert.Contains(t, out, "No recent actions.")
+}
+
+func TestScheduleCLI_CreateSchedule_NonStartActions(t *testing.T) {
+	makeCtx := func(app *cli.App, extraArgs []string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.String(FlagDomain, "", "")
+		set.String(FlagTransport, "", "")
+		set.String(FlagScheduleID, "", "")
+		set.String(FlagCronExpression, "", "")
+		set.String(FlagWorkflowType, "", "")
+		set.String(FlagTaskList, "", "")
+		set.Int(FlagExecutionTimeout, 0, "")
+		set.Int(FlagDecisionTimeout, 0, "")
+		set.String(FlagWorkflowID, "", "")
+		set.String(FlagSignalName, "", "")
+		set.String(FlagSignalInput, "", "")
+		set.Bool(FlagSignalWithStart, false, "")
+		set.String(FlagBatchType, "", "")
+		set.String(FlagListQuery, "", "")
+		set.String(FlagReason, "", "")
+		set.Int(FlagRPS, 0, "")
+		set.Int(FlagConcurrency, 0, "")
+		baseArgs := []string{
+			"--" + FlagDomain, "test-domain",
+			"--" + FlagTransport, grpcTransport,
+			"--" + FlagScheduleID, "s",
+			"--" + FlagCronExpression, "0 2 * * *",
+			"--" + FlagExecutionTimeout, "3600",
+			"--" + FlagDecisionTimeout, "10",
+		}
+		_ = set.Parse(append(baseArgs, extraArgs...))
+		return cli.NewContext(app, set, nil)
+	}
+
+	tests := []struct {
+		name        string
+		extraArgs   []string
+		wantAction  *types.ScheduleAction
+		errContains string
+	}{
+		{
+			name:      "signal",
+			extraArgs: []string{"--" + FlagSignalName, "refresh", "--" + FlagWorkflowID, "wf-1", "--" + FlagSignalInput, `{"a":1}`},
+			wantAction: &types.ScheduleAction{SignalWorkflow: &types.SignalWorkflowAction{
+				WorkflowID:  "wf-1",
+				SignalName:  "refresh",
+				SignalInput: []byte(`{"a":1}`),
+			}},
+		},
+		{
+			name: "signal with start",
+			extraArgs: []string{
+				"--" + FlagSignalName, "refresh", "--" + FlagWorkflowID, "wf-1", "--" + FlagSignalWithStart,
+				"--" + FlagWorkflowType, "my-wf", "--" + FlagTaskList, "my-tl",
+			},
+			wantAction: &types.ScheduleAction{SignalWorkflow: &types.SignalWorkflowAction{
+				WorkflowID: "wf-1",
+				SignalName: "refresh",
+				StartWorkflow: &types.StartWorkflowAction{
+					WorkflowType:                        &types.WorkflowType{Name: "my-wf"},
+					TaskList:                            &types.TaskList{Name: "my-tl"},
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+				},
+			}},
+		},
+		{
+			name: "batch terminate",
+			extraArgs: []string{
+				"--" + FlagBatchType, "terminate", "--" + FlagListQuery, "CloseTime = missing",
+				"--" + FlagReason, "nightly", "--" + FlagRPS, "5",
+			},
+			wantAction: &types.ScheduleAction{BatchOperation: &types.BatchOperationAction{
+				OperationType: types.ScheduleBatchOperationTypeTerminate,
+				Query:         "CloseTime = missing",
+				Reason:        "nightly",
+				RPS:           5,
+			}},
+		},
+		{
+			name: "batch signal",
+			extraArgs: []string{
+				"--" + FlagBatchType, "signal", "--" + FlagListQuery, "q", "--" + FlagSignalName, "wake",
+			},
+			wantAction: &types.ScheduleAction{BatchOperation: &types.BatchOperationAction{
+				OperationType: types.ScheduleBatchOperationTypeSignal,
+				Query:         "q",
+				SignalName:    "wake",
+			}},
+		},
+		{
+			name:        "signal without workflow_id",
+			extraArgs:   []string{"--" + FlagSignalName, "refresh"},
+			errContains: "--workflow_id is required",
+		},
+		{
+			name:        "signal with start without workflow_type",
+			extraArgs:   []string{"--" + FlagSignalName, "refresh", "--" + FlagWorkflowID, "wf-1", "--" + FlagSignalWithStart},
+			errContains: "--workflow_type is required",
+		},
+		{
+			name:        "signal_with_start without signal_name",
+			extraArgs:   []string{"--" + FlagSignalWithStart, "--" + FlagWorkflowType, "my-wf"},
+			errContains: "--signal_with_start requires --signal_name",
+		},
+		{
+			name:        "batch without query",
+			extraArgs:   []string{"--" + FlagBatchType, "cancel"},
+			errContains: "--query is required",
+		},
+		{
+			name:        "batch signal without signal_name",
+			extraArgs:   []string{"--" + FlagBatchType, "signal", "--" + FlagListQuery, "q"},
+			errContains: "--signal_name is required",
+		},
+		{
+			name:        "signal_name with non-signal batch",
+			extraArgs:   []string{"--" + FlagBatchType, "cancel", "--" + FlagListQuery, "q", "--" + FlagSignalName, "wake"},
+			errContains: "--signal_name can only be combined with --batch_type signal",
+		},
+		{
+			name:        "unknown batch type",
+			extraArgs:   []string{"--" + FlagBatchType, "reset", "--" + FlagListQuery, "q"},
+			errContains: "Unknown batch type",
+		},
+		{
+			name:        "no action",
+			errContains: "--workflow_type is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockClient := frontend.NewMockClient(mockCtrl)
+			app := newScheduleTestApp(t, mockClient)
+			if tt.wantAction != nil {
+				mockClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req *types.CreateScheduleRequest, _ ...interface{}) (*types.CreateScheduleResponse, error) {
+						assert.Equal(t, tt.wantAction, req.Action)
+						return &types.CreateScheduleResponse{ScheduleID: "s"}, nil
+					})
+			}
+			sc := &scheduleCLIImpl{frontendClient: mockClient}
+			err := sc.CreateSchedule(makeCtx(app, tt.extraArgs))
+			if tt.errContains != "" {
+				assert.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPrintDescribeSchedule_NonStartActions(t *testing.T) {
+	out := captureStdout(t, func() {
+		printDescribeSchedule(&types.DescribeScheduleResponse{
+			Action: &types.ScheduleAction{SignalWorkflow: &types.SignalWorkflowAction{
+				WorkflowID:    "wf-1",
+				SignalName:    "refresh",
+				StartWorkflow: &types.StartWorkflowAction{WorkflowType: &types.WorkflowType{Name: "my-wf"}},
+			}},
+		})
+	})
+	assert.Contains(t, out, "Signal Workflow:    wf-1")
+	assert.Contains(t, out, "Signal Name:        refresh")
+	assert.Contains(t, out, "Signal With Start:  my-wf")
+
+	out = captureStdout(t, func() {
+		printDescribeSchedule(&types.DescribeScheduleResponse{
+			Action: &types.ScheduleAction{BatchOperation: &types.BatchOperationAction{
+				OperationType: types.ScheduleBatchOperationTypeTerminate,
+				Query:         "CloseTime = missing",
+			}},
+		})
+	})
+	assert.Contains(t, out, "Batch Operation:    TERMINATE")
+	assert.Contains(t, out, "Batch Query:        CloseTime = missing")
+}
+
+func TestPrintDescribeSchedule_CompletedWithLimitedActions(t *testing.T) {
+	out := captureStdout(t, func() {
+		printDescribeSchedule(&types.DescribeScheduleResponse{
+			Policies: &types.SchedulePolicies{LimitedActions: true},
+			State:    &types.ScheduleState{Completed: true},
+		})
+	})
+	assert.Contains(t, out, "Remaining Actions:  0")
+	assert.Contains(t, out, "Status:             COMPLETED")
+	assert.NotContains(t, out, "ACTIVE")
+}
+
+func TestPrintDescribeSchedule_PausedOnFailure(t *testing.T) {
+	pausedAt := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	out := captureStdout(t, func() {
+		printDescribeSchedule(&types.DescribeScheduleResponse{
+			Policies: &types.SchedulePolicies{
+				PauseOnFailure:          true,
+				PauseOnFailureThreshold: 3,
+				PauseOnFailureCooldown:  time.Hour,
+			},
+			State: &types.ScheduleState{
+				Paused: true,
+				PauseInfo: &types.SchedulePauseInfo{
+					Reason:          "paused after 3 consecutive failed runs",
+					PausedBy:        "cadence-scheduler",
+					PausedAt:        pausedAt,
+					AutoUnpauseTime: pausedAt.Add(time.Hour),
+				},
+			},
+			Info: &types.ScheduleInfo{
+				ConsecutiveFailures: 3,
+				LastFailure: &types.ScheduleFailureInfo{
+					WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wf-1", RunID: "run-3"},
+					CloseStatus:       types.WorkflowExecutionCloseStatusFailed,
+					FailureReason:     "boom",
+					ObservedTime:      pausedAt,
+				},
+			},
+		})
+	})
+	assert.Contains(t, out, "Failure Threshold:  3")
+	assert.Contains(t, out, "Failure Cool-down:  1h0m0s")
+	assert.Contains(t, out, "Paused By:          cadence-scheduler")
+	assert.Contains(t, out, "Auto Unpause At:    2024-06-01T10:00:00Z")
+	assert.Contains(t, out, "Consecutive Fails:  3")
+	assert.Contains(t, out, "Last Failure:       FAILED wf-1 (run-3) at 2024-06-01T09:00:00Z")
+	assert.Contains(t, out, "Failure Reason:     boom")
+}
@@ -104,6 +104,18 @@ func newWorkflowCommands() []*cli.Command {
 			Flags:   getFlagsForSignal(),
 			Action:  SignalWorkflow,
 		},
+		{
+			Name:   "pause",
+			Usage:  "stop dispatching decision and activity tasks of a workflow execution until it is resumed, signals are still accepted. Workflows of global domains are only paused in the active cluster",
+			Flags:  getFlagsForPause(),
+			Action: PauseWorkflow,
+		},
+		{
+			Name:   "resume",
+			Usage:  "resume dispatching decision and activity tasks of a paused workflow execution",
+			Flags:  getFlagsForResume(),
+			Action: ResumeWorkflow,
+		},
 		{
 			Name:   "signalwithstart",
 			Usage:  "signal the current open workflow if exists, or attempt to start a new run based on IDResuePolicy and signals it",
@@ -441,6 +453,162 @@ func newActivityCommands() []*cli.Command {
 			},
 			Action: FailActivity,
 		},
+		{
+			Name:  "pause",
+			Usage: "pause an activity, no new attempt is dispatched until it is unpaused. Activities of global domain workflows are only paused in the active cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"wid", "w"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"rid", "r"},
+					Usage:   "RunID, the current run is used if not set",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: []string{"aid"},
+					Usage:   "The activityID to operate on",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason to pause the activity",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: PauseActivity,
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause an activity, an attempt waiting for its retry backoff is dispatched right away",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"wid", "w"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"rid", "r"},
+					Usage:   "RunID, the current run is used if not set",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: []string{"aid"},
+					Usage:   "The activityID to operate on",
+				},
+				&cli.BoolFlag{
+					Name:  FlagResetAttempts,
+					Usage: "Reset the attempt count and retry backoff of the activity",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: UnpauseActivity,
+		},
+		{
+			Name:  "reset",
+			Usage: "reset the attempt count and retry backoff of an activity",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"wid", "w"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"rid", "r"},
+					Usage:   "RunID, the current run is used if not set",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: []string{"aid"},
+					Usage:   "The activityID to operate on",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: ResetActivity,
+		},
+		{
+			Name:  "update-options",
+			Usage: "update the timeouts, retry policy or task list of a pending activity, options which are not set are kept. The options of global domain workflows are only changed in the active cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: []string{"wid", "w"},
+					Usage:   "WorkflowID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"rid", "r"},
+					Usage:   "RunID, the current run is used if not set",
+				},
+				&cli.StringFlag{
+					Name:    FlagActivityID,
+					Aliases: []string{"aid"},
+					Usage:   "The activityID to operate on",
+				},
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "TaskList the next attempts of the activity are dispatched to",
+				},
+				&cli.IntFlag{
+					Name:  FlagScheduleToCloseTimeout,
+					Usage: "Schedule to close timeout of the activity in seconds",
+				},
+				&cli.IntFlag{
+					Name:  FlagScheduleToStartTimeout,
+					Usage: "Schedule to start timeout of the activity in seconds",
+				},
+				&cli.IntFlag{
+					Name:  FlagStartToCloseTimeout,
+					Usage: "Start to close timeout of the activity in seconds",
+				},
+				&cli.IntFlag{
+					Name:    FlagActivityHeartBeatTimeout,
+					Aliases: []string{"hbt"},
+					Usage:   "Heartbeat timeout of the activity in seconds, 0 turns heartbeat timeouts off",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryAttempts,
+					Usage: "Maximum attempts of the new retry policy. retry_attempts and retry_expiration must not both be 0.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryExpiration,
+					Usage: "Expiration of the new retry policy in seconds, counted from the update. retry_attempts and retry_expiration must not both be 0.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryInterval,
+					Value: 10,
+					Usage: "Initial interval of the new retry policy in seconds",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRetryBackoff,
+					Value: 1.0,
+					Usage: "Backoff coefficient of the new retry policy. Must be or equal or greater than 1.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryMaxInterval,
+					Usage: "Maximum interval of the new retry policy in seconds. Must be equal or greater than retry interval.",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: UpdateActivityOptions,
+		},
 	}
 }
 
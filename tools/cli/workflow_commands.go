@@ -743,6 +743,76 @@ func TerminateWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// PauseWorkflow stops dispatching decision and activity tasks of a workflow execution
+func PauseWorkflow(c *cli.Context) error {
+	domain, wfExecution, err := getWorkflowOperationOptions(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := grpcFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	_, err = frontendClient.PauseWorkflowExecution(ctx, &types.PauseWorkflowExecutionRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		Reason:            c.String(FlagReason),
+		Identity:          getOperatorIdentity(c),
+	})
+	if err != nil {
+		return commoncli.Problem("Pause workflow failed.", err)
+	}
+	fmt.Println("Pause workflow succeeded.")
+	return nil
+}
+
+// ResumeWorkflow resumes dispatching decision and activity tasks of a paused workflow execution
+func ResumeWorkflow(c *cli.Context) error {
+	domain, wfExecution, err := getWorkflowOperationOptions(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := grpcFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	_, err = frontendClient.ResumeWorkflowExecution(ctx, &types.ResumeWorkflowExecutionRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		Identity:          getOperatorIdentity(c),
+	})
+	if err != nil {
+		return commoncli.Problem("Resume workflow failed.", err)
+	}
+	fmt.Println("Resume workflow succeeded.")
+	return nil
+}
+
+func getWorkflowOperationOptions(c *cli.Context) (string, *types.WorkflowExecution, error) {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return "", nil, commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return "", nil, commoncli.Problem("Required flag not found: ", err)
+	}
+	return domain, &types.WorkflowExecution{
+		WorkflowID: wid,
+		RunID:      c.String(FlagRunID),
+	}, nil
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) error {
 	wfClient, err := getWorkflowClient(c)
@@ -1205,6 +1275,7 @@ type workflowExecutionInfo struct {
 	PartitionConfig              map[string]string
 	CronOverlapPolicy            *types.CronOverlapPolicy
 	ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+	IsPaused                     bool
 }
 
 // pendingActivityInfo has same fields as types.PendingActivityInfo, but different field type for better display
@@ -1257,6 +1328,7 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 		PartitionConfig:              info.PartitionConfig,
 		CronOverlapPolicy:            info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy: info.ActiveClusterSelectionPolicy,
+		IsPaused:                     info.IsPaused,
 	}
 
 	var pendingActs []*pendingActivityInfo
@@ -2695,6 +2767,176 @@ func FailActivity(c *cli.Context) error {
 	return nil
 }
 
+// PauseActivity pauses an activity
+func PauseActivity(c *cli.Context) error {
+	domain, wfExecution, activityID, err := getActivityOperationOptions(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := grpcFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	_, err = frontendClient.PauseActivity(ctx, &types.PauseActivityRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		ActivityID:        activityID,
+		Identity:          getOperatorIdentity(c),
+		Reason:            c.String(FlagReason),
+	})
+	if err != nil {
+		return commoncli.Problem("Pausing activity failed", err)
+	}
+	fmt.Println("Pause activity successfully.")
+	return nil
+}
+
+// UnpauseActivity unpauses an activity
+func UnpauseActivity(c *cli.Context) error {
+	domain, wfExecution, activityID, err := getActivityOperationOptions(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := grpcFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	_, err = frontendClient.UnpauseActivity(ctx, &types.UnpauseActivityRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		ActivityID:        activityID,
+		Identity:          getOperatorIdentity(c),
+		ResetAttempts:     c.Bool(FlagResetAttempts),
+	})
+	if err != nil {
+		return commoncli.Problem("Unpausing activity failed", err)
+	}
+	fmt.Println("Unpause activity successfully.")
+	return nil
+}
+
+// ResetActivity resets the attempts of an activity
+func ResetActivity(c *cli.Context) error {
+	domain, wfExecution, activityID, err := getActivityOperationOptions(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := grpcFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	_, err = frontendClient.ResetActivity(ctx, &types.ResetActivityRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		ActivityID:        activityID,
+		Identity:          getOperatorIdentity(c),
+	})
+	if err != nil {
+		return commoncli.Problem("Resetting activity failed", err)
+	}
+	fmt.Println("Reset activity successfully.")
+	return nil
+}
+
+// UpdateActivityOptions changes the options of a pending activity
+func UpdateActivityOptions(c *cli.Context) error {
+	domain, wfExecution, activityID, err := getActivityOperationOptions(c)
+	if err != nil {
+		return err
+	}
+	options := &types.ActivityOptions{}
+	if c.IsSet(FlagTaskList) {
+		options.TaskList = &types.TaskList{Name: c.String(FlagTaskList)}
+	}
+	if c.IsSet(FlagScheduleToCloseTimeout) {
+		options.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagScheduleToCloseTimeout)))
+	}
+	if c.IsSet(FlagScheduleToStartTimeout) {
+		options.ScheduleToStartTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagScheduleToStartTimeout)))
+	}
+	if c.IsSet(FlagStartToCloseTimeout) {
+		options.StartToCloseTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagStartToCloseTimeout)))
+	}
+	if c.IsSet(FlagActivityHeartBeatTimeout) {
+		options.HeartbeatTimeoutSeconds = common.Int32Ptr(int32(c.Int(FlagActivityHeartBeatTimeout)))
+	}
+	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) {
+		options.RetryPolicy = &types.RetryPolicy{
+			InitialIntervalInSeconds:    int32(c.Int(FlagRetryInterval)),
+			BackoffCoefficient:          c.Float64(FlagRetryBackoff),
+			MaximumIntervalInSeconds:    int32(c.Int(FlagRetryMaxInterval)),
+			MaximumAttempts:             int32(c.Int(FlagRetryAttempts)),
+			ExpirationIntervalInSeconds: int32(c.Int(FlagRetryExpiration)),
+		}
+	}
+	if *options == (types.ActivityOptions{}) {
+		return commoncli.Problem("At least one activity option has to be set", nil)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	frontendClient, err := grpcFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	_, err = frontendClient.UpdateActivityOptions(ctx, &types.UpdateActivityOptionsRequest{
+		Domain:            domain,
+		WorkflowExecution: wfExecution,
+		ActivityID:        activityID,
+		Options:           options,
+		Identity:          getOperatorIdentity(c),
+	})
+	if err != nil {
+		return commoncli.Problem("Updating activity options failed", err)
+	}
+	fmt.Println("Update activity options successfully.")
+	return nil
+}
+
+func getActivityOperationOptions(c *cli.Context) (string, *types.WorkflowExecution, string, error) {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return "", nil, "", commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return "", nil, "", commoncli.Problem("Required flag not found: ", err)
+	}
+	activityID, err := getRequiredOption(c, FlagActivityID)
+	if err != nil {
+		return "", nil, "", commoncli.Problem("Required flag not found: ", err)
+	}
+	return domain, &types.WorkflowExecution{
+		WorkflowID: wid,
+		RunID:      c.String(FlagRunID),
+	}, activityID, nil
+}
+
+func getOperatorIdentity(c *cli.Context) string {
+	if identity := c.String(FlagIdentity); identity != "" {
+		return identity
+	}
+	return getCliIdentity()
+}
+
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) error {
 	domain, err := getRequiredOption(c, FlagDomain)
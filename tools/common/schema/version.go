@@ -96,3 +96,9 @@ func parseValidateVersion(ver string) (string, error) {
 	}
 	return ver, nil
 }
+
+// CompareVersion compares two version strings of the form x.x
+// returns 0 if a == b, < 0 if a < b and > 0 if a > b
+func CompareVersion(a, b string) int {
+	return cmpVersion(a, b)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+
+	"github.com/uber/cadence/common/config"
+	dynamodbplugin "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/dynamodb"
+)
+
+const (
+	schemaVersionTableName       = "schema_version"
+	schemaUpdateHistoryTableName = "schema_update_history"
+)
+
+type (
+	// ClientConfig contains the configuration of the dynamodb client
+	ClientConfig struct {
+		Endpoint string
+		Port     int
+		Region   string
+		User     string
+		Password string
+		Keyspace string
+		Timeout  int
+	}
+
+	schemaClient struct {
+		cfg         *ClientConfig
+		client      dynamodbiface.DynamoDBAPI
+		tablePrefix string
+	}
+)
+
+func newSchemaClient(cfg *ClientConfig) (*schemaClient, error) {
+	client, err := dynamodbplugin.NewClient(&config.NoSQL{
+		Hosts:    cfg.Endpoint,
+		Port:     cfg.Port,
+		Region:   cfg.Region,
+		User:     cfg.User,
+		Password: cfg.Password,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &schemaClient{
+		cfg:         cfg,
+		client:      client,
+		tablePrefix: dynamodbplugin.TablePrefix(cfg.Keyspace),
+	}, nil
+}
+
+func (cfg *ClientConfig) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), time.Duration(cfg.Timeout)*time.Second)
+}
+
+// applySchema creates and updates the tables of a schema file, the file is a JSON array of table schemas
+func (c *schemaClient) applySchema(data []byte) error {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	return dynamodbplugin.ApplySchema(ctx, c.client, c.tablePrefix, data)
+}
+
+// dropAllTables deletes all the tables of the keyspace
+func (c *schemaClient) dropAllTables() error {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	var tableNames []*string
+	err := c.client.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{}, func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+		for _, name := range page.TableNames {
+			if strings.HasPrefix(aws.StringValue(name), c.tablePrefix) {
+				tableNames = append(tableNames, name)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, name := range tableNames {
+		if _, err := c.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{TableName: name}); err != nil {
+			return err
+		}
+		if err := c.client.WaitUntilTableNotExistsWithContext(ctx, &dynamodb.DescribeTableInput{TableName: name}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createSchemaVersionTables creates the tables of the schema version and of the schema update history,
+// the tables which exist already are kept
+func (c *schemaClient) createSchemaVersionTables() error {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	tables := []*dynamodb.CreateTableInput{
+		{
+			TableName: c.tableName(schemaVersionTableName),
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: aws.String("keyspace_name"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			},
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String("keyspace_name"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+			},
+			BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		},
+		{
+			TableName: c.tableName(schemaUpdateHistoryTableName),
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: aws.String("keyspace_name"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+				{AttributeName: aws.String("update_time"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+			},
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String("keyspace_name"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+				{AttributeName: aws.String("update_time"), KeyType: aws.String(dynamodb.KeyTypeRange)},
+			},
+			BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		},
+	}
+	for _, table := range tables {
+		_, err := c.client.CreateTableWithContext(ctx, table)
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeResourceInUseException {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := c.client.WaitUntilTableExistsWithContext(ctx, &dynamodb.DescribeTableInput{TableName: table.TableName}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *schemaClient) readSchemaVersion() (string, error) {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	resp, err := c.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName: c.tableName(schemaVersionTableName),
+		Key: map[string]*dynamodb.AttributeValue{
+			"keyspace_name": {S: aws.String(c.cfg.Keyspace)},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return "", err
+	}
+	version, ok := resp.Item["curr_version"]
+	if !ok {
+		return "", fmt.Errorf("no schema version found for keyspace %v", c.cfg.Keyspace)
+	}
+	return aws.StringValue(version.S), nil
+}
+
+func (c *schemaClient) updateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	_, err := c.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: c.tableName(schemaVersionTableName),
+		Item: map[string]*dynamodb.AttributeValue{
+			"keyspace_name":          {S: aws.String(c.cfg.Keyspace)},
+			"curr_version":           {S: aws.String(newVersion)},
+			"min_compatible_version": {S: aws.String(minCompatibleVersion)},
+			"creation_time":          {S: aws.String(time.Now().UTC().Format(time.RFC3339Nano))},
+		},
+	})
+	return err
+}
+
+func (c *schemaClient) writeSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	_, err := c.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: c.tableName(schemaUpdateHistoryTableName),
+		Item: map[string]*dynamodb.AttributeValue{
+			"keyspace_name": {S: aws.String(c.cfg.Keyspace)},
+			"update_time":   {S: aws.String(time.Now().UTC().Format(time.RFC3339Nano))},
+			"old_version":   {S: aws.String(oldVersion)},
+			"new_version":   {S: aws.String(newVersion)},
+			"manifest_md5":  {S: aws.String(manifestMD5)},
+			"description":   {S: aws.String(desc)},
+		},
+	})
+	return err
+}
+
+func (c *schemaClient) tableName(table string) *string {
+	return aws.String(c.tablePrefix + table)
+}
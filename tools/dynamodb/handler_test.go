@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+	dynamodbplugin "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/dynamodb"
+	"github.com/uber/cadence/environment"
+	dynamodbschema "github.com/uber/cadence/schema/dynamodb"
+	"github.com/uber/cadence/testflags"
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+const testSchemaDir = "../../schema/dynamodb/cadence/versioned"
+
+func TestBuildChangeSet(t *testing.T) {
+	changes, err := buildChangeSet(testSchemaDir, "0.0", "")
+	require.NoError(t, err)
+	require.NotEmpty(t, changes)
+	assert.Equal(t, "0.1", changes[0].manifest.CurrVersion)
+	assert.Equal(t, dynamodbschema.Version, changes[len(changes)-1].manifest.CurrVersion, "the latest version is the one expected by the server")
+	assert.NotEmpty(t, changes[0].manifest.md5)
+
+	changes, err = buildChangeSet(testSchemaDir, "0.0", "0.1")
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+
+	changes, err = buildChangeSet(testSchemaDir, dynamodbschema.Version, "")
+	require.NoError(t, err)
+	assert.Empty(t, changes, "nothing to apply at the latest version")
+
+	_, err = buildChangeSet(testSchemaDir, "0.0", "9.9")
+	assert.Error(t, err, "unknown target version")
+}
+
+func TestValidateSetupConfig(t *testing.T) {
+	assert.NoError(t, validateSetupConfig(&schema.SetupConfig{InitialVersion: "0.0"}))
+	assert.NoError(t, validateSetupConfig(&schema.SetupConfig{SchemaFilePath: "schema.json", DisableVersioning: true}))
+	assert.Error(t, validateSetupConfig(&schema.SetupConfig{DisableVersioning: true}))
+	assert.Error(t, validateSetupConfig(&schema.SetupConfig{}))
+	assert.Error(t, validateSetupConfig(&schema.SetupConfig{InitialVersion: "0.0", DisableVersioning: true}))
+}
+
+func TestSetupAndUpdateSchema(t *testing.T) {
+	testflags.RequireDynamoDB(t)
+	port, err := environment.GetDynamoDBPort()
+	require.NoError(t, err)
+
+	clientConfig := ClientConfig{
+		Endpoint: environment.GetDynamoDBAddress(),
+		Port:     port,
+		// DynamoDB local accepts any static credentials
+		User:     "cadence",
+		Password: "cadence",
+		Keyspace: fmt.Sprintf("schema_tool_test_%v", time.Now().UnixNano()),
+		Timeout:  DefaultTimeout,
+	}
+	client, err := newSchemaClient(&clientConfig)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, client.dropAllTables()) }()
+
+	persistenceConfig := config.Persistence{
+		DefaultStore: "default",
+		DataStores: map[string]config.DataStore{
+			"default": {NoSQL: &config.NoSQL{
+				PluginName: dynamodbplugin.PluginName,
+				Hosts:      clientConfig.Endpoint,
+				Port:       clientConfig.Port,
+				User:       clientConfig.User,
+				Password:   clientConfig.Password,
+				Keyspace:   clientConfig.Keyspace,
+			}},
+		},
+	}
+	assert.Error(t, VerifyCompatibleVersion(persistenceConfig), "no schema version before the setup")
+
+	require.NoError(t, SetupSchema(&SetupSchemaConfig{
+		ClientConfig: clientConfig,
+		SetupConfig:  schema.SetupConfig{InitialVersion: "0.0"},
+	}))
+	version, err := client.readSchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "0.0", version)
+	assert.Error(t, VerifyCompatibleVersion(persistenceConfig), "the schema is older than the server")
+
+	require.NoError(t, UpdateSchema(&UpdateSchemaConfig{
+		ClientConfig: clientConfig,
+		SchemaDir:    testSchemaDir,
+	}))
+	version, err = client.readSchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, dynamodbschema.Version, version)
+	assert.NoError(t, VerifyCompatibleVersion(persistenceConfig))
+
+	require.NoError(t, UpdateSchema(&UpdateSchemaConfig{
+		ClientConfig: clientConfig,
+		SchemaDir:    testSchemaDir,
+	}), "updating to the current version is a no-op")
+}
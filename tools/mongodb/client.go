@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/config"
+	mongodbplugin "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/mongodb"
+)
+
+const (
+	schemaVersionCollectionName       = "schema_version"
+	schemaUpdateHistoryCollectionName = "schema_update_history"
+
+	// the error code of creating a collection that exists already
+	namespaceExistsErrorCode = 48
+)
+
+type (
+	// ClientConfig contains the configuration of the mongodb client
+	ClientConfig struct {
+		Hosts             string
+		Port              int
+		User              string
+		Password          string
+		Database          string
+		ConnectAttributes map[string]string
+		Timeout           int
+	}
+
+	schemaClient struct {
+		cfg    *ClientConfig
+		client *mongo.Client
+		db     *mongo.Database
+	}
+
+	schemaVersionEntry struct {
+		Database             string    `bson:"_id"`
+		CurrVersion          string    `bson:"currversion"`
+		MinCompatibleVersion string    `bson:"mincompatibleversion"`
+		CreationTime         time.Time `bson:"creationtime"`
+	}
+
+	schemaUpdateHistoryEntry struct {
+		Database    string    `bson:"database"`
+		UpdateTime  time.Time `bson:"updatetime"`
+		OldVersion  string    `bson:"oldversion"`
+		NewVersion  string    `bson:"newversion"`
+		ManifestMD5 string    `bson:"manifestmd5"`
+		Description string    `bson:"description"`
+	}
+)
+
+func newSchemaClient(cfg *ClientConfig) (*schemaClient, error) {
+	uri := mongodbplugin.BuildConnectionURI(&config.NoSQL{
+		Hosts:             cfg.Hosts,
+		Port:              cfg.Port,
+		User:              cfg.User,
+		Password:          cfg.Password,
+		ConnectAttributes: cfg.ConnectAttributes,
+	})
+	ctx, cancel := cfg.context()
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		client.Disconnect(context.Background())
+		return nil, err
+	}
+	return &schemaClient{
+		cfg:    cfg,
+		client: client,
+		db:     client.Database(cfg.Database),
+	}, nil
+}
+
+func (cfg *ClientConfig) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), time.Duration(cfg.Timeout)*time.Second)
+}
+
+// execCommands runs the commands of a schema file one by one, the file is a JSON array of database commands
+func (c *schemaClient) execCommands(data []byte) error {
+	var commands []bson.D
+	if err := bson.UnmarshalExtJSON(data, false, &commands); err != nil {
+		return err
+	}
+	for _, cmd := range commands {
+		ctx, cancel := c.cfg.context()
+		err := c.db.RunCommand(ctx, cmd).Err()
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *schemaClient) dropDatabase() error {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	return c.db.Drop(ctx)
+}
+
+func (c *schemaClient) createSchemaVersionCollections() error {
+	for _, name := range []string{schemaVersionCollectionName, schemaUpdateHistoryCollectionName} {
+		ctx, cancel := c.cfg.context()
+		err := c.db.RunCommand(ctx, bson.D{{Key: "create", Value: name}}).Err()
+		cancel()
+		if cmdErr, ok := err.(mongo.CommandError); ok && cmdErr.Code == namespaceExistsErrorCode {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *schemaClient) readSchemaVersion() (string, error) {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	var doc schemaVersionEntry
+	err := c.db.Collection(schemaVersionCollectionName).FindOne(ctx, bson.D{{Key: "_id", Value: c.cfg.Database}}).Decode(&doc)
+	if err != nil {
+		return "", err
+	}
+	return doc.CurrVersion, nil
+}
+
+func (c *schemaClient) updateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	_, err := c.db.Collection(schemaVersionCollectionName).ReplaceOne(
+		ctx,
+		bson.D{{Key: "_id", Value: c.cfg.Database}},
+		&schemaVersionEntry{
+			Database:             c.cfg.Database,
+			CurrVersion:          newVersion,
+			MinCompatibleVersion: minCompatibleVersion,
+			CreationTime:         time.Now().UTC(),
+		},
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+func (c *schemaClient) writeSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	ctx, cancel := c.cfg.context()
+	defer cancel()
+	_, err := c.db.Collection(schemaUpdateHistoryCollectionName).InsertOne(ctx, &schemaUpdateHistoryEntry{
+		Database:    c.cfg.Database,
+		UpdateTime:  time.Now().UTC(),
+		OldVersion:  oldVersion,
+		NewVersion:  newVersion,
+		ManifestMD5: manifestMD5,
+		Description: desc,
+	})
+	return err
+}
+
+func (c *schemaClient) close() {
+	c.client.Disconnect(context.Background())
+}
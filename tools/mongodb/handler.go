@@ -0,0 +1,349 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+const manifestFileName = "manifest.json"
+
+// represents names of the form vx.x where x.x is a (major, minor) version pair
+var versionDirRegex = regexp.MustCompile(`^v\d+(\.\d+)?$`)
+
+type (
+	// SetupSchemaConfig contains the configuration params needed to setup schema collections
+	SetupSchemaConfig struct {
+		ClientConfig
+		schema.SetupConfig
+	}
+
+	// UpdateSchemaConfig contains the configuration params needed to update schema collections
+	UpdateSchemaConfig struct {
+		ClientConfig
+		TargetVersion string
+		SchemaDir     string
+		IsDryRun      bool
+	}
+
+	// manifest is the same as the one of the cassandra and sql schemas, the update files are json files here
+	manifest struct {
+		CurrVersion          string
+		MinCompatibleVersion string
+		Description          string
+		SchemaUpdateCqlFiles []string
+		md5                  string
+	}
+
+	changeSet struct {
+		dir      string
+		manifest *manifest
+	}
+)
+
+// SetupSchema setups the mongodb schema
+func SetupSchema(config *SetupSchemaConfig) error {
+	if err := validateSetupConfig(&config.SetupConfig); err != nil {
+		return err
+	}
+	client, err := newSchemaClient(&config.ClientConfig)
+	if err != nil {
+		return err
+	}
+	defer client.close()
+	return doSetupSchema(&config.SetupConfig, client)
+}
+
+// UpdateSchema updates the mongodb schema to the target version
+func UpdateSchema(config *UpdateSchemaConfig) error {
+	if len(config.SchemaDir) == 0 {
+		return schema.NewConfigError("missing " + flag(schema.CLIOptSchemaDir) + " argument ")
+	}
+	if len(config.TargetVersion) > 0 {
+		config.TargetVersion = strings.TrimPrefix(config.TargetVersion, "v")
+	}
+	client, err := newSchemaClient(&config.ClientConfig)
+	if err != nil {
+		return err
+	}
+	defer client.close()
+	return doUpdateSchema(config, client)
+}
+
+// setupSchema executes the setup schema task
+// using the given command line arguments
+// as input
+func setupSchema(cli *cli.Context) error {
+	clientConfig, err := newClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	config := &SetupSchemaConfig{
+		ClientConfig: *clientConfig,
+		SetupConfig: schema.SetupConfig{
+			SchemaFilePath:    cli.String(schema.CLIOptSchemaFile),
+			InitialVersion:    cli.String(schema.CLIOptVersion),
+			Overwrite:         cli.Bool(schema.CLIOptOverwrite),
+			DisableVersioning: cli.Bool(schema.CLIOptDisableVersioning),
+		},
+	}
+	if err := SetupSchema(config); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// updateSchema executes the update schema task
+// using the given command line args as input
+func updateSchema(cli *cli.Context) error {
+	clientConfig, err := newClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	config := &UpdateSchemaConfig{
+		ClientConfig:  *clientConfig,
+		TargetVersion: cli.String(schema.CLIOptTargetVersion),
+		SchemaDir:     cli.String(schema.CLIOptSchemaDir),
+		IsDryRun:      cli.Bool(schema.CLIOptDryrun),
+	}
+	if err := UpdateSchema(config); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+func doSetupSchema(config *schema.SetupConfig, client *schemaClient) error {
+	log.Printf("Starting schema setup, config=%+v\n", config)
+
+	if config.Overwrite {
+		if err := client.dropDatabase(); err != nil {
+			return err
+		}
+	}
+
+	if !config.DisableVersioning {
+		log.Printf("Setting up version collections\n")
+		if err := client.createSchemaVersionCollections(); err != nil {
+			return err
+		}
+	}
+
+	if len(config.SchemaFilePath) > 0 {
+		data, err := os.ReadFile(config.SchemaFilePath)
+		if err != nil {
+			return err
+		}
+		log.Println("----- Creating collections and indexes -----")
+		if err := client.execCommands(data); err != nil {
+			return err
+		}
+		log.Println("----- Done -----")
+	}
+
+	if !config.DisableVersioning {
+		version := strings.TrimPrefix(config.InitialVersion, "v")
+		log.Printf("Setting initial schema version to %v\n", version)
+		if err := client.updateSchemaVersion(version, version); err != nil {
+			return err
+		}
+		log.Printf("Updating schema update log\n")
+		if err := client.writeSchemaUpdateLog("0", version, "", "initial version"); err != nil {
+			return err
+		}
+	}
+
+	log.Println("Schema setup complete")
+	return nil
+}
+
+func doUpdateSchema(config *UpdateSchemaConfig, client *schemaClient) error {
+	currVer, err := client.readSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+
+	changes, err := buildChangeSet(config.SchemaDir, currVer, config.TargetVersion)
+	if err != nil {
+		return err
+	}
+
+	for _, cs := range changes {
+		m := cs.manifest
+		log.Printf("---- Executing updates for version %v ----\n", m.CurrVersion)
+		for _, file := range m.SchemaUpdateCqlFiles {
+			data, err := os.ReadFile(filepath.Join(cs.dir, file))
+			if err != nil {
+				return err
+			}
+			if config.IsDryRun {
+				log.Println(string(data))
+				continue
+			}
+			if err := client.execCommands(data); err != nil {
+				return err
+			}
+		}
+		if config.IsDryRun {
+			continue
+		}
+		if err := client.updateSchemaVersion(m.CurrVersion, m.MinCompatibleVersion); err != nil {
+			return fmt.Errorf("failed to update schema_version collection, err=%v", err.Error())
+		}
+		if err := client.writeSchemaUpdateLog(currVer, m.CurrVersion, m.md5, m.Description); err != nil {
+			return fmt.Errorf("failed to add entry to schema_update_history, err=%v", err.Error())
+		}
+		log.Printf("---- Done ----\n")
+		currVer = m.CurrVersion
+	}
+
+	log.Printf("Schema updated to version %v\n", currVer)
+	return nil
+}
+
+// buildChangeSet returns the versions after currVer and up to targetVer in order, targetVer defaults to the latest
+func buildChangeSet(schemaDir string, currVer string, targetVer string) ([]changeSet, error) {
+	entries, err := os.ReadDir(schemaDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []changeSet
+	targetFound := len(targetVer) == 0 || schema.CompareVersion(currVer, targetVer) == 0
+	for _, entry := range entries {
+		if !entry.IsDir() || !versionDirRegex.MatchString(entry.Name()) {
+			continue
+		}
+		version := entry.Name()[1:]
+		if schema.CompareVersion(version, currVer) <= 0 {
+			continue
+		}
+		if len(targetVer) > 0 && schema.CompareVersion(version, targetVer) > 0 {
+			continue
+		}
+		dir := filepath.Join(schemaDir, entry.Name())
+		m, err := readManifest(dir)
+		if err != nil {
+			return nil, fmt.Errorf("error processing manifest for version %v:%v", entry.Name(), err.Error())
+		}
+		if m.CurrVersion != version {
+			return nil, fmt.Errorf("manifest version doesn't match with dirname, dir=%v,manifest.version=%v", entry.Name(), m.CurrVersion)
+		}
+		if len(m.SchemaUpdateCqlFiles) == 0 {
+			return nil, fmt.Errorf("found 0 updates in dir %v", dir)
+		}
+		if version == targetVer {
+			targetFound = true
+		}
+		result = append(result, changeSet{dir: dir, manifest: m})
+	}
+	if !targetFound {
+		return nil, fmt.Errorf("version dir not found for target version %v", targetVer)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return schema.CompareVersion(result[i].manifest.CurrVersion, result[j].manifest.CurrVersion) < 0
+	})
+	return result, nil
+}
+
+func readManifest(dir string) (*manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFileName))
+	if err != nil {
+		return nil, err
+	}
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	if len(m.CurrVersion) == 0 {
+		return nil, fmt.Errorf("manifest missing CurrVersion")
+	}
+	if len(m.MinCompatibleVersion) == 0 {
+		return nil, fmt.Errorf("manifest missing MinCompatibleVersion")
+	}
+	sum := md5.Sum(data)
+	m.md5 = hex.EncodeToString(sum[:])
+	return &m, nil
+}
+
+func validateSetupConfig(config *schema.SetupConfig) error {
+	if len(config.SchemaFilePath) == 0 && config.DisableVersioning {
+		return schema.NewConfigError("nothing to do, specify either schema file or initial version")
+	}
+	if !config.DisableVersioning && len(config.InitialVersion) == 0 {
+		return schema.NewConfigError("missing " + flag(schema.CLIOptVersion) + " argument ")
+	}
+	if config.DisableVersioning && len(config.InitialVersion) > 0 {
+		return schema.NewConfigError("version cannot be specified with " + flag(schema.CLIOptDisableVersioning))
+	}
+	return nil
+}
+
+func newClientConfig(cli *cli.Context) (*ClientConfig, error) {
+	config := &ClientConfig{
+		Hosts:    cli.String(schema.CLIOptEndpoint),
+		Port:     cli.Int(schema.CLIOptPort),
+		User:     cli.String(schema.CLIOptUser),
+		Password: cli.String(schema.CLIOptPassword),
+		Database: cli.String(schema.CLIOptDatabase),
+		Timeout:  cli.Int(schema.CLIOptTimeout),
+	}
+	if attributes := cli.String(schema.CLIOptConnectAttributes); len(attributes) > 0 {
+		values, err := url.ParseQuery(attributes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v argument: %v", flag(schema.CLIOptConnectAttributes), err)
+		}
+		config.ConnectAttributes = make(map[string]string, len(values))
+		for k := range values {
+			config.ConnectAttributes[k] = values.Get(k)
+		}
+	}
+	if len(config.Hosts) == 0 {
+		return nil, fmt.Errorf("missing %v argument", flag(schema.CLIOptEndpoint))
+	}
+	if len(config.Database) == 0 {
+		return nil, fmt.Errorf("missing %v argument", flag(schema.CLIOptDatabase))
+	}
+	return config, nil
+}
+
+func flag(opt string) string {
+	return "(-" + opt + ")"
+}
+
+func handleErr(err error) error {
+	log.Println(err)
+	return err
+}
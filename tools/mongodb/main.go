@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+const (
+	// DefaultMongoDBPort is the default port of MongoDB
+	DefaultMongoDBPort = 27017
+	// DefaultTimeout is the default timeout in seconds for the commands
+	DefaultTimeout = 30
+)
+
+// RunTool runs the cadence-mongodb-tool command line tool
+func RunTool(args []string) error {
+	app := BuildCLIOptions()
+	return app.Run(args) // exits on error
+}
+
+// root handler for all cli commands
+func cliHandler(c *cli.Context, handler func(c *cli.Context) error) error {
+	quiet := c.Bool(schema.CLIOptQuiet)
+	err := handler(c)
+	if err != nil {
+		if quiet { // if quiet, don't return error
+			fmt.Println("fail to run tool: ", err)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// BuildCLIOptions builds the options of cadence-mongodb-tool
+func BuildCLIOptions() *cli.App {
+
+	app := cli.NewApp()
+	app.Name = "cadence-mongodb-tool"
+	app.Usage = "Command line tool for cadence mongodb operations"
+	app.Version = "0.0.1"
+
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{
+			Name:    schema.CLIFlagEndpoint,
+			Aliases: []string{"ep"},
+			Value:   "127.0.0.1",
+			Usage:   "csv of hostnames or ip addresses of mongodb hosts to connect to",
+			EnvVars: []string{"MONGODB_HOST"},
+		},
+		&cli.IntFlag{
+			Name:    schema.CLIFlagPort,
+			Aliases: []string{"p"},
+			Value:   DefaultMongoDBPort,
+			Usage:   "Port of mongodb hosts to connect to",
+			EnvVars: []string{"MONGODB_PORT"},
+		},
+		&cli.StringFlag{
+			Name:    schema.CLIFlagUser,
+			Aliases: []string{"u"},
+			Value:   "",
+			Usage:   "User name used for authentication for connecting to mongodb",
+			EnvVars: []string{"MONGODB_USER"},
+		},
+		&cli.StringFlag{
+			Name:    schema.CLIFlagPassword,
+			Aliases: []string{"pw"},
+			Value:   "",
+			Usage:   "Password used for authentication for connecting to mongodb",
+			EnvVars: []string{"MONGODB_PASSWORD"},
+		},
+		&cli.StringFlag{
+			Name:    schema.CLIFlagDatabase,
+			Aliases: []string{"db"},
+			Value:   "cadence",
+			Usage:   "name of the mongodb database",
+			EnvVars: []string{"MONGODB_DATABASE"},
+		},
+		&cli.StringFlag{
+			Name:    schema.CLIFlagConnectAttributes,
+			Aliases: []string{"ca"},
+			Usage:   "options of the connection string, as a url query string, e.g. replicaSet=rs0&authSource=admin",
+			EnvVars: []string{"MONGODB_CONNECT_ATTRIBUTES"},
+		},
+		&cli.IntFlag{
+			Name:    schema.CLIFlagTimeout,
+			Aliases: []string{"t"},
+			Value:   DefaultTimeout,
+			Usage:   "request Timeout in seconds used for mongodb client",
+			EnvVars: []string{"MONGODB_TIMEOUT"},
+		},
+		&cli.BoolFlag{
+			Name:    schema.CLIFlagQuiet,
+			Aliases: []string{"q"},
+			Usage:   "Don't set exit status to 1 on error",
+		},
+	}
+
+	app.Commands = []*cli.Command{
+		{
+			Name:    "setup-schema",
+			Aliases: []string{"setup"},
+			Usage:   "setup initial version of mongodb schema",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    schema.CLIFlagVersion,
+					Aliases: []string{"v"},
+					Usage:   "initial version of the schema, cannot be used with disable-versioning",
+				},
+				&cli.StringFlag{
+					Name:    schema.CLIFlagSchemaFile,
+					Aliases: []string{"f"},
+					Usage:   "path to the .json schema file; if un-specified, will just setup versioning collections",
+				},
+				&cli.BoolFlag{
+					Name:    schema.CLIFlagDisableVersioning,
+					Aliases: []string{"d"},
+					Usage:   "disable setup of schema versioning",
+				},
+				&cli.BoolFlag{
+					Name:    schema.CLIFlagOverwrite,
+					Aliases: []string{"o"},
+					Usage:   "drop the database before setting up new schema",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return cliHandler(c, setupSchema)
+			},
+		},
+		{
+			Name:    "update-schema",
+			Aliases: []string{"update"},
+			Usage:   "update mongodb schema to a specific version",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    schema.CLIFlagTargetVersion,
+					Aliases: []string{"v"},
+					Usage:   "target version for the schema update, defaults to latest",
+				},
+				&cli.StringFlag{
+					Name:    schema.CLIFlagSchemaDir,
+					Aliases: []string{"d"},
+					Usage:   "path to directory containing versioned schema",
+				},
+				&cli.BoolFlag{
+					Name:  schema.CLIFlagDryrun,
+					Usage: "do a dryrun",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return cliHandler(c, updateSchema)
+			},
+		},
+	}
+
+	return app
+}